@@ -32,6 +32,7 @@ const (
 	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_DenomOwnersByQuery_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
 	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_SendPolicies_FullMethodName               = "/cosmos.bank.v1beta1.Query/SendPolicies"
 )
 
 // QueryClient is the client API for Query service.
@@ -93,6 +94,10 @@ type QueryClient interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// SendPolicies queries for the send policies of denoms.
+	//
+	// Only denominations with a send policy are returned.
+	SendPolicies(ctx context.Context, in *QuerySendPoliciesRequest, opts ...grpc.CallOption) (*QuerySendPoliciesResponse, error)
 }
 
 type queryClient struct {
@@ -233,6 +238,16 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) SendPolicies(ctx context.Context, in *QuerySendPoliciesRequest, opts ...grpc.CallOption) (*QuerySendPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySendPoliciesResponse)
+	err := c.cc.Invoke(ctx, Query_SendPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -292,6 +307,10 @@ type QueryServer interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// SendPolicies queries for the send policies of denoms.
+	//
+	// Only denominations with a send policy are returned.
+	SendPolicies(context.Context, *QuerySendPoliciesRequest) (*QuerySendPoliciesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -341,6 +360,9 @@ func (UnimplementedQueryServer) DenomOwnersByQuery(context.Context, *QueryDenomO
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) SendPolicies(context.Context, *QuerySendPoliciesRequest) (*QuerySendPoliciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendPolicies not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -596,6 +618,24 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SendPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySendPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SendPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendPolicies(ctx, req.(*QuerySendPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -655,6 +695,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "SendPolicies",
+			Handler:    _Query_SendPolicies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
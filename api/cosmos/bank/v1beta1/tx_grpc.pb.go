@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName            = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName       = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_UpdateParams_FullMethodName    = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName  = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_SetSendPolicies_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendPolicies"
 )
 
 // MsgClient is the client API for Msg service.
@@ -43,6 +44,10 @@ type MsgClient interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// SetSendPolicies is a governance operation for setting the send policies of
+	// any number of denoms. Only the policies to add or replace, and the denoms
+	// whose policy should be removed, need to be included.
+	SetSendPolicies(ctx context.Context, in *MsgSetSendPolicies, opts ...grpc.CallOption) (*MsgSetSendPoliciesResponse, error)
 }
 
 type msgClient struct {
@@ -93,6 +98,16 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) SetSendPolicies(ctx context.Context, in *MsgSetSendPolicies, opts ...grpc.CallOption) (*MsgSetSendPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetSendPoliciesResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSendPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -111,6 +126,10 @@ type MsgServer interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SetSendPolicies is a governance operation for setting the send policies of
+	// any number of denoms. Only the policies to add or replace, and the denoms
+	// whose policy should be removed, need to be included.
+	SetSendPolicies(context.Context, *MsgSetSendPolicies) (*MsgSetSendPoliciesResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -133,6 +152,9 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) SetSendPolicies(context.Context, *MsgSetSendPolicies) (*MsgSetSendPoliciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSendPolicies not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -226,6 +248,24 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetSendPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSendPolicies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSendPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSendPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSendPolicies(ctx, req.(*MsgSetSendPolicies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -249,6 +289,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "SetSendPolicies",
+			Handler:    _Msg_SetSendPolicies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -873,9 +873,9 @@ const (
 
 // PubKey is an bls12_381 public key for handling CometBFT keys in SDK.
 // It's needed for Any serialization and SDK compatibility.
-// It must not be used in a non Tendermint key context because it doesn't implement
-// ADR-28. Nevertheless, you will like to use bls12_381 in app user level
-// then you must create a new proto message and follow ADR-28 for Address construction.
+// It can also be used as an account key, in which case, as for secp256k1 keys,
+// its address is the first 20 bytes of the SHA-256 hash of the key and does not
+// follow ADR-28.
 type PubKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -965,21 +965,21 @@ var file_cosmos_crypto_bls12_381_keys_proto_rawDesc = []byte{
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79,
 	0x3a, 0x2c, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f,
 	0x50, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x42, 0x6c, 0x73, 0x31, 0x32, 0x5f, 0x33, 0x38, 0x31,
-	0x92, 0xe7, 0xb0, 0x2a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0xcc,
+	0x92, 0xe7, 0xb0, 0x2a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0xd6,
 	0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72,
 	0x79, 0x70, 0x74, 0x6f, 0x2e, 0x62, 0x6c, 0x73, 0x31, 0x32, 0x5f, 0x33, 0x38, 0x31, 0x42, 0x09,
-	0x4b, 0x65, 0x79, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73,
+	0x4b, 0x65, 0x79, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x73, 0x31,
-	0x32, 0x5f, 0x33, 0x38, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x42, 0xaa, 0x02, 0x16, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x73, 0x31,
-	0x32, 0x33, 0x38, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x5c, 0x42, 0x6c, 0x73, 0x31, 0x32, 0x33, 0x38, 0x31, 0xe2, 0x02, 0x22,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x42, 0x6c,
-	0x73, 0x31, 0x32, 0x33, 0x38, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x3a, 0x3a, 0x42, 0x6c, 0x73, 0x31, 0x32, 0x33, 0x38, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x32, 0x5f, 0x33, 0x38, 0x31, 0x3b, 0x62, 0x6c, 0x73, 0x31, 0x32, 0x5f, 0x33, 0x38, 0x31, 0xa2,
+	0x02, 0x03, 0x43, 0x43, 0x42, 0xaa, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x73, 0x31, 0x32, 0x33, 0x38, 0x31, 0xca, 0x02,
+	0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x42,
+	0x6c, 0x73, 0x31, 0x32, 0x33, 0x38, 0x31, 0xe2, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x42, 0x6c, 0x73, 0x31, 0x32, 0x33, 0x38, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x3a, 0x3a, 0x42,
+	0x6c, 0x73, 0x31, 0x32, 0x33, 0x38, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.mint.v1beta1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName          = "/cosmos.mint.v1beta1.Msg/UpdateParams"
+	Msg_UpdateEpochMintParams_FullMethodName = "/cosmos.mint.v1beta1.Msg/UpdateEpochMintParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +32,10 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/mint module
 	// parameters. The authority is defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateEpochMintParams defines a governance operation for updating the
+	// x/mint epoch-driven minting parameters. The authority defaults to the x/gov
+	// module account.
+	UpdateEpochMintParams(ctx context.Context, in *MsgUpdateEpochMintParams, opts ...grpc.CallOption) (*MsgUpdateEpochMintParamsResponse, error)
 }
 
 type msgClient struct {
@@ -51,6 +56,16 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateEpochMintParams(ctx context.Context, in *MsgUpdateEpochMintParams, opts ...grpc.CallOption) (*MsgUpdateEpochMintParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateEpochMintParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateEpochMintParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -60,6 +75,10 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/mint module
 	// parameters. The authority is defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateEpochMintParams defines a governance operation for updating the
+	// x/mint epoch-driven minting parameters. The authority defaults to the x/gov
+	// module account.
+	UpdateEpochMintParams(context.Context, *MsgUpdateEpochMintParams) (*MsgUpdateEpochMintParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -73,6 +92,9 @@ type UnimplementedMsgServer struct{}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateEpochMintParams(context.Context, *MsgUpdateEpochMintParams) (*MsgUpdateEpochMintParamsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateEpochMintParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -112,6 +134,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateEpochMintParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEpochMintParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEpochMintParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateEpochMintParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEpochMintParams(ctx, req.(*MsgUpdateEpochMintParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -123,6 +163,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateEpochMintParams",
+			Handler:    _Msg_UpdateEpochMintParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/tx.proto",
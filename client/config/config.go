@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 )
 
 // DefaultConfig returns default config for the client.toml
@@ -21,12 +22,21 @@ func DefaultConfig() *ClientConfig {
 }
 
 type ClientConfig struct {
-	ChainID               string `mapstructure:"chain-id" json:"chain-id"`
-	KeyringBackend        string `mapstructure:"keyring-backend" json:"keyring-backend"`
-	KeyringDefaultKeyName string `mapstructure:"keyring-default-keyname" json:"keyring-default-keyname"`
-	Output                string `mapstructure:"output" json:"output"`
-	Node                  string `mapstructure:"node" json:"node"`
-	BroadcastMode         string `mapstructure:"broadcast-mode" json:"broadcast-mode"`
+	ChainID               string             `mapstructure:"chain-id" json:"chain-id"`
+	KeyringBackend        string             `mapstructure:"keyring-backend" json:"keyring-backend"`
+	KeyringDefaultKeyName string             `mapstructure:"keyring-default-keyname" json:"keyring-default-keyname"`
+	Output                string             `mapstructure:"output" json:"output"`
+	Node                  string             `mapstructure:"node" json:"node"`
+	BroadcastMode         string             `mapstructure:"broadcast-mode" json:"broadcast-mode"`
+	RemoteSigner          RemoteSignerConfig `mapstructure:"remote-signer" json:"remote-signer"`
+}
+
+// RemoteSignerConfig defines the remote signer of the remote keyring backend.
+type RemoteSignerConfig struct {
+	Address  string `mapstructure:"address" json:"address"`
+	CertFile string `mapstructure:"cert-file" json:"cert-file"`
+	KeyFile  string `mapstructure:"key-file" json:"key-file"`
+	CAFile   string `mapstructure:"ca-file" json:"ca-file"`
 }
 
 func (c *ClientConfig) SetChainID(chainID string) {
@@ -100,6 +110,15 @@ func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 		WithKeyringDir(ctx.HomeDir).
 		WithKeyringDefaultKeyName(conf.KeyringDefaultKeyName)
 
+	if conf.RemoteSigner.Address != "" {
+		ctx = ctx.WithKeyringOptions(append(ctx.KeyringOptions, keyring.WithRemoteSigner(keyring.RemoteSignerConfig{
+			Address:  conf.RemoteSigner.Address,
+			CertFile: configFile(configPath, conf.RemoteSigner.CertFile),
+			KeyFile:  configFile(configPath, conf.RemoteSigner.KeyFile),
+			CAFile:   configFile(configPath, conf.RemoteSigner.CAFile),
+		}))...)
+	}
+
 	keyring, err := client.NewKeyringFromBackend(ctx, conf.KeyringBackend)
 	if err != nil {
 		return ctx, fmt.Errorf("couldn't get keyring: %w", err)
@@ -119,3 +138,11 @@ func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 
 	return ctx, nil
 }
+
+// configFile resolves a file path relative to the config directory.
+func configFile(configPath, file string) string {
+	if file == "" || filepath.IsAbs(file) {
+		return file
+	}
+	return filepath.Join(configPath, file)
+}
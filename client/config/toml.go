@@ -17,7 +17,7 @@ const defaultConfigTemplate = `# This is a TOML config file.
 
 # The network chain ID
 chain-id = "{{ .ChainID }}"
-# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory)
+# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory|remote)
 keyring-backend = "{{ .KeyringBackend }}"
 # Default key name, if set, defines the default key to use for signing transaction when the --from flag is not specified
 keyring-default-keyname = "{{ .KeyringDefaultKeyName }}"
@@ -27,6 +27,22 @@ output = "{{ .Output }}"
 node = "{{ .Node }}"
 # Transaction broadcasting mode (sync|async)
 broadcast-mode = "{{ .BroadcastMode }}"
+
+###############################################################################
+###                         Remote Signer Configuration                     ###
+###############################################################################
+
+# The remote signer holding the keys of the "remote" keyring backend.
+# The connection is authenticated with mutual TLS. Relative file paths are
+# resolved against the config directory.
+[remote-signer]
+# <host>:<port> of the remote signer
+address = "{{ .RemoteSigner.Address }}"
+# PEM encoded client certificate and key presented to the remote signer
+cert-file = "{{ .RemoteSigner.CertFile }}"
+key-file = "{{ .RemoteSigner.KeyFile }}"
+# PEM encoded certificate authority the remote signer certificate is verified against
+ca-file = "{{ .RemoteSigner.CAFile }}"
 `
 
 // writeConfigToFile parses defaultConfigTemplate, renders config using the template and writes it to
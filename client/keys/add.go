@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -38,6 +39,7 @@ const (
 	flagNoSort       = "nosort"
 	flagHDPath       = "hd-path"
 	flagPubKeyBase64 = "pubkey-base64"
+	flagPasskey      = "passkey"
 	flagMnemonicSrc  = "source"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
@@ -61,6 +63,13 @@ local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
 multisig transactions.
 
+Use the --passkey flag to add the public key of a WebAuthn credential (passkey), encoded
+in base64 or base64url as returned by the browser. Transactions of the key are signed by
+the browser with a WebAuthn assertion whose challenge is the SHA-256 hash of the sign bytes.
+Example:
+
+    keys add mypasskey --passkey MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE...
+
 Use the --source flag to import mnemonic from a file in recover or interactive mode. 
 Example:
 
@@ -82,6 +91,7 @@ Example:
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
 	f.String(flagPubKeyBase64, "", "Parse a public key in base64 format and saves key info.")
+	f.String(flagPasskey, "", "Parse the public key of a passkey in base64 or base64url format and saves key info.")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	f.Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
 	f.Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
@@ -221,9 +231,31 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 
 	pubKey, _ := cmd.Flags().GetString(FlagPublicKey)
 	pubKeyBase64, _ := cmd.Flags().GetString(flagPubKeyBase64)
+	passkey, _ := cmd.Flags().GetString(flagPasskey)
 	if pubKey != "" && pubKeyBase64 != "" {
 		return fmt.Errorf(`flags %s and %s cannot be used simultaneously`, FlagPublicKey, flagPubKeyBase64)
 	}
+	if passkey != "" && (pubKey != "" || pubKeyBase64 != "") {
+		return fmt.Errorf(`flag %s cannot be used with %s or %s`, flagPasskey, FlagPublicKey, flagPubKeyBase64)
+	}
+	if passkey != "" {
+		bz, err := decodePasskey(passkey)
+		if err != nil {
+			return err
+		}
+
+		pk, err := webauthn.ParsePubKey(bz)
+		if err != nil {
+			return err
+		}
+
+		k, err := kb.SaveOfflineKey(name, pk)
+		if err != nil {
+			return fmt.Errorf("failed to save offline key: %w", err)
+		}
+
+		return printCreate(cmd, k, false, "", outputFormat)
+	}
 	if pubKey != "" {
 		var pk cryptotypes.PubKey
 		if err = ctx.Codec.UnmarshalInterfaceJSON([]byte(pubKey), &pk); err != nil {
@@ -433,3 +465,17 @@ func readMnemonicFromFile(filePath string) (string, error) {
 	}
 	return string(bz), nil
 }
+
+// decodePasskey decodes the public key of a passkey encoded in base64, as
+// commonly done by servers, or in base64url, as done by WebAuthn libraries.
+func decodePasskey(passkey string) ([]byte, error) {
+	if bz, err := base64.StdEncoding.DecodeString(passkey); err == nil {
+		return bz, nil
+	}
+
+	bz, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(passkey, "="))
+	if err != nil {
+		return nil, fmt.Errorf("passkey must be encoded in base64 or base64url: %w", err)
+	}
+	return bz, nil
+}
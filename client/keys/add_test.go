@@ -3,6 +3,11 @@ package keys
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"testing"
@@ -14,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -327,6 +333,59 @@ func Test_runAddCmdDryRun(t *testing.T) {
 	}
 }
 
+func Test_runAddCmdPasskey(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	spki, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	expected, err := webauthn.ParsePubKey(spki)
+	require.NoError(t, err)
+
+	for name, passkey := range map[string]string{
+		"base64":    base64.StdEncoding.EncodeToString(spki),
+		"base64url": base64.RawURLEncoding.EncodeToString(spki),
+	} {
+		t.Run(name, func(t *testing.T) {
+			cmd := AddKeyCommand()
+			cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+
+			kbHome := t.TempDir()
+			mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+			kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+			require.NoError(t, err)
+
+			clientCtx := client.Context{}.
+				WithCodec(cdc).
+				WithKeyringDir(kbHome).
+				WithKeyring(kb)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			cmd.SetArgs([]string{"passkey", fmt.Sprintf("--%s=%s", flagPasskey, passkey)})
+			require.NoError(t, cmd.ExecuteContext(ctx))
+
+			k, err := kb.Key("passkey")
+			require.NoError(t, err)
+			require.Equal(t, keyring.TypeOffline, k.GetType())
+			pk, err := k.GetPubKey()
+			require.NoError(t, err)
+			require.True(t, expected.Equals(pk))
+		})
+	}
+
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, t.TempDir(), mockIn, cdc)
+	require.NoError(t, err)
+	clientCtx := client.Context{}.WithCodec(cdc).WithKeyring(kb)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	cmd.SetArgs([]string{"passkey", fmt.Sprintf("--%s=%s", flagPasskey, "AQID")})
+	require.Error(t, cmd.ExecuteContext(ctx))
+}
+
 func TestAddRecoverFileBackend(t *testing.T) {
 	cmd := AddKeyCommand()
 	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
@@ -0,0 +1,365 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/v2/snapshots/types"
+)
+
+const (
+	// ManifestFileName is the name of the manifest of an archive, its first
+	// file since archive version 1.
+	ManifestFileName = "manifest.json"
+
+	// ArchiveVersion is the version of the archive format written by dump.
+	ArchiveVersion = 1
+
+	// Compressions of archives.
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// Manifest describes the snapshot of an archive, so that the archive can be
+// validated before the snapshot is loaded.
+type Manifest struct {
+	Version      uint32          `json:"version"`
+	ChainID      string          `json:"chain_id"`
+	Height       uint64          `json:"height"`
+	Format       uint32          `json:"format"`
+	AppHash      string          `json:"app_hash,omitempty"`
+	AppVersion   string          `json:"app_version,omitempty"`
+	SnapshotHash string          `json:"snapshot_hash"`
+	Compression  string          `json:"compression"`
+	Chunks       []ManifestChunk `json:"chunks"`
+}
+
+// ManifestChunk describes a chunk of a snapshot, by its size in bytes and its
+// hex encoded SHA-256 hash.
+type ManifestChunk struct {
+	Size int64  `json:"size"`
+	Hash string `json:"hash"`
+}
+
+// Validate checks that the manifest describes snapshot.
+func (m *Manifest) Validate(snapshot *snapshottypes.Snapshot) error {
+	if m.Version != ArchiveVersion {
+		return fmt.Errorf("unsupported archive version %d", m.Version)
+	}
+	if m.Height != snapshot.Height || m.Format != snapshot.Format {
+		return fmt.Errorf("manifest of height %d and format %d, snapshot of height %d and format %d", m.Height, m.Format, snapshot.Height, snapshot.Format)
+	}
+	if m.SnapshotHash != hex.EncodeToString(snapshot.Hash) {
+		return fmt.Errorf("manifest snapshot hash %s, snapshot hash %X", m.SnapshotHash, snapshot.Hash)
+	}
+	if len(m.Chunks) != int(snapshot.Chunks) || len(snapshot.Metadata.ChunkHashes) != int(snapshot.Chunks) {
+		return fmt.Errorf("manifest of %d chunks, snapshot of %d chunks", len(m.Chunks), snapshot.Chunks)
+	}
+	for i, chunk := range m.Chunks {
+		if chunk.Hash != hex.EncodeToString(snapshot.Metadata.ChunkHashes[i]) {
+			return fmt.Errorf("manifest hash %s of chunk %d, snapshot hash %X", chunk.Hash, i, snapshot.Metadata.ChunkHashes[i])
+		}
+	}
+
+	return nil
+}
+
+// newCompressor returns a writer compressing to w. Since chunks are already
+// compressed, the fastest compression level is used.
+func newCompressor(w io.Writer, compression string) (io.WriteCloser, error) {
+	switch compression {
+	case CompressionGzip:
+		return gzip.NewWriterLevel(w, gzip.BestSpeed)
+	case CompressionZstd:
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedFastest))
+	default:
+		return nil, fmt.Errorf("unsupported compression %s", compression)
+	}
+}
+
+// newDecompressor returns a reader decompressing r, detecting its compression.
+func newDecompressor(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(zstdMagic))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		return gzip.NewReader(br)
+	case bytes.HasPrefix(magic, zstdMagic):
+		dec, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return dec.IOReadCloser(), nil
+	default:
+		return nil, errors.New("unknown archive compression, expected gzip or zstd")
+	}
+}
+
+// writeArchive writes the archive of snapshot to w: its manifest, the snapshot
+// and its chunks, read through openChunk and checked against the hashes of the
+// snapshot.
+func writeArchive(w io.Writer, manifest *Manifest, snapshot *snapshottypes.Snapshot, openChunk func(index uint32) (io.ReadCloser, error)) error {
+	compressor, err := newCompressor(w, manifest.Compression)
+	if err != nil {
+		return err
+	}
+	tarWriter := tar.NewWriter(compressor)
+
+	writeFile := func(name string, bz []byte) error {
+		if err := tarWriter.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0o644,
+			Size: int64(len(bz)),
+		}); err != nil {
+			return fmt.Errorf("failed to write %s header to tar: %w", name, err)
+		}
+		if _, err := tarWriter.Write(bz); err != nil {
+			return fmt.Errorf("failed to write %s to tar: %w", name, err)
+		}
+		return nil
+	}
+
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFile(ManifestFileName, bz); err != nil {
+		return err
+	}
+
+	if bz, err = snapshot.Marshal(); err != nil {
+		return err
+	}
+	if err := writeFile(SnapshotFileName, bz); err != nil {
+		return err
+	}
+
+	for i, chunk := range manifest.Chunks {
+		if err := tarWriter.WriteHeader(&tar.Header{
+			Name: strconv.Itoa(i),
+			Mode: 0o644,
+			Size: chunk.Size,
+		}); err != nil {
+			return fmt.Errorf("failed to write chunk header to tar: %w", err)
+		}
+
+		file, err := openChunk(uint32(i))
+		if err != nil {
+			return err
+		}
+		hasher := sha256.New()
+		_, err = io.Copy(io.MultiWriter(tarWriter, hasher), file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("failed to write chunk to tar: %w", err)
+		}
+		if hex.EncodeToString(hasher.Sum(nil)) != chunk.Hash {
+			return fmt.Errorf("chunk %d of the local snapshot is corrupted", i)
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("failed to close tar writer: %w", err)
+	}
+
+	return compressor.Close()
+}
+
+// readArchive reads and validates the archive of r, calling onChunk with
+// each chunk when set. The chunks are checked against the hashes of the
+// snapshot, and of the manifest which archives before version 1 don't have.
+func readArchive(r io.Reader, onChunk func(index uint32, chunk []byte) error) (*Manifest, *snapshottypes.Snapshot, error) {
+	decompressor, err := newDecompressor(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer decompressor.Close()
+
+	tr := tar.NewReader(decompressor)
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read archive header: %w", err)
+	}
+
+	var manifest *Manifest
+	if hdr.Name == ManifestFileName {
+		manifest = &Manifest{}
+		if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+			return nil, nil, fmt.Errorf("failed to read manifest: %w", err)
+		}
+		if hdr, err = tr.Next(); err != nil {
+			return nil, nil, fmt.Errorf("failed to read snapshot file header: %w", err)
+		}
+	}
+
+	if hdr.Name != SnapshotFileName {
+		return nil, nil, fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
+	}
+	bz, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read snapshot file: %w", err)
+	}
+	snapshot := &snapshottypes.Snapshot{}
+	if err := snapshot.Unmarshal(bz); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+	if len(snapshot.Metadata.ChunkHashes) != int(snapshot.Chunks) {
+		return nil, nil, fmt.Errorf("invalid snapshot, %d chunk hashes for %d chunks", len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+	if manifest != nil {
+		if err := manifest.Validate(snapshot); err != nil {
+			return nil, nil, fmt.Errorf("invalid manifest: %w", err)
+		}
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		hdr, err = tr.Next()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read chunk %d: %w", i, err)
+		}
+		if hdr.Name != strconv.FormatInt(int64(i), 10) {
+			return nil, nil, fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
+		}
+
+		bz, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read chunk file: %w", err)
+		}
+		hash := sha256.Sum256(bz)
+		if !bytes.Equal(hash[:], snapshot.Metadata.ChunkHashes[i]) {
+			return nil, nil, fmt.Errorf("chunk %d is corrupted: hash %X, expected %X", i, hash, snapshot.Metadata.ChunkHashes[i])
+		}
+
+		if onChunk != nil {
+			if err := onChunk(i, bz); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	return manifest, snapshot, nil
+}
+
+// archivePartName returns the name of the part index of an archive split
+// into several files.
+func archivePartName(path string, index int) string {
+	return fmt.Sprintf("%s.%03d", path, index)
+}
+
+// partWriter writes to the parts of an archive, of at most size bytes each.
+type partWriter struct {
+	path    string
+	size    int64
+	parts   []string
+	file    *os.File
+	written int64
+}
+
+func (w *partWriter) Write(p []byte) (int, error) {
+	var n int
+	for len(p) > 0 {
+		if w.file == nil || w.written == w.size {
+			if err := w.next(); err != nil {
+				return n, err
+			}
+		}
+
+		m, err := w.file.Write(p[:min(int64(len(p)), w.size-w.written)])
+		n += m
+		w.written += int64(m)
+		if err != nil {
+			return n, err
+		}
+		p = p[m:]
+	}
+
+	return n, nil
+}
+
+func (w *partWriter) next() error {
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	file, err := os.Create(archivePartName(w.path, len(w.parts)))
+	if err != nil {
+		return err
+	}
+	w.file, w.written = file, 0
+	w.parts = append(w.parts, file.Name())
+	return nil
+}
+
+func (w *partWriter) Close() error {
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+// openArchive opens the archive of path, which is either a file, or the
+// first part of an archive split into several files, named path.000 or path.
+func openArchive(path string) (io.ReadCloser, error) {
+	if _, err := os.Stat(path); err == nil && !strings.HasSuffix(path, archivePartName("", 0)) {
+		return os.Open(path)
+	}
+
+	base := strings.TrimSuffix(path, archivePartName("", 0))
+	var files []*os.File
+	closeAll := func() {
+		for _, file := range files {
+			file.Close()
+		}
+	}
+	for i := 0; ; i++ {
+		file, err := os.Open(archivePartName(base, i))
+		if errors.Is(err, os.ErrNotExist) && i > 0 {
+			break
+		}
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("failed to open archive file: %w", err)
+		}
+		files = append(files, file)
+	}
+
+	readers := make([]io.Reader, len(files))
+	for i, file := range files {
+		readers[i] = file
+	}
+	return &multiFileReader{Reader: io.MultiReader(readers...), close: closeAll}, nil
+}
+
+type multiFileReader struct {
+	io.Reader
+	close func()
+}
+
+func (r *multiFileReader) Close() error {
+	r.close()
+	return nil
+}
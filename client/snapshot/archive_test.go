@@ -0,0 +1,155 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/v2/snapshots/types"
+)
+
+func testArchiveSnapshot(chunks [][]byte) (*snapshottypes.Snapshot, *Manifest) {
+	snapshot := &snapshottypes.Snapshot{Height: 10, Format: 3, Chunks: uint32(len(chunks)), Hash: []byte{0x01, 0x02}}
+	manifest := &Manifest{
+		Version:      ArchiveVersion,
+		ChainID:      "test-chain",
+		Height:       10,
+		Format:       3,
+		AppHash:      "abcd",
+		SnapshotHash: "0102",
+		Compression:  CompressionGzip,
+	}
+	for _, chunk := range chunks {
+		hash := sha256.Sum256(chunk)
+		snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, hash[:])
+		manifest.Chunks = append(manifest.Chunks, ManifestChunk{Size: int64(len(chunk)), Hash: hex.EncodeToString(hash[:])})
+	}
+	return snapshot, manifest
+}
+
+func openTestChunk(chunks [][]byte) func(index uint32) (io.ReadCloser, error) {
+	return func(index uint32) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(chunks[index])), nil
+	}
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	chunks := [][]byte{bytes.Repeat([]byte{0xAA}, 300), bytes.Repeat([]byte{0xBB}, 500), {0xCC}}
+
+	for _, compression := range []string{CompressionGzip, CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			snapshot, manifest := testArchiveSnapshot(chunks)
+			manifest.Compression = compression
+
+			// single file
+			path := filepath.Join(t.TempDir(), "archive")
+			fp, err := os.Create(path)
+			require.NoError(t, err)
+			require.NoError(t, writeArchive(fp, manifest, snapshot, openTestChunk(chunks)))
+			require.NoError(t, fp.Close())
+
+			var read [][]byte
+			gotManifest, gotSnapshot, err := verifyArchive(path)
+			require.NoError(t, err)
+			require.Equal(t, manifest, gotManifest)
+			require.Equal(t, snapshot, gotSnapshot)
+
+			// split into parts of 100 bytes
+			path = filepath.Join(t.TempDir(), "archive")
+			pw := &partWriter{path: path, size: 100}
+			require.NoError(t, writeArchive(pw, manifest, snapshot, openTestChunk(chunks)))
+			require.NoError(t, pw.Close())
+			require.Greater(t, len(pw.parts), 1)
+			require.Equal(t, path+".000", pw.parts[0])
+
+			for _, name := range []string{path, path + ".000"} {
+				fp, err := openArchive(name)
+				require.NoError(t, err)
+				read = nil
+				gotManifest, _, err = readArchive(fp, func(index uint32, chunk []byte) error {
+					require.Equal(t, uint32(len(read)), index)
+					read = append(read, chunk)
+					return nil
+				})
+				require.NoError(t, fp.Close())
+				require.NoError(t, err)
+				require.Equal(t, manifest, gotManifest)
+				require.Equal(t, chunks, read)
+			}
+
+			// a missing part fails the archive
+			require.NoError(t, os.Remove(pw.parts[len(pw.parts)-1]))
+			_, _, err = verifyArchive(path)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestWriteArchiveCorruptedChunk(t *testing.T) {
+	chunks := [][]byte{{0x01}, {0x02}}
+	snapshot, manifest := testArchiveSnapshot(chunks)
+	chunks[1] = []byte{0x03}
+
+	err := writeArchive(io.Discard, manifest, snapshot, openTestChunk(chunks))
+	require.ErrorContains(t, err, "chunk 1 of the local snapshot is corrupted")
+}
+
+func TestReadArchiveInvalid(t *testing.T) {
+	chunks := [][]byte{{0x01}, {0x02}}
+
+	// writeLegacyArchive writes an archive without manifest, as dumped before
+	// archive version 1.
+	writeLegacyArchive := func(snapshot *snapshottypes.Snapshot, chunks [][]byte) []byte {
+		var buf bytes.Buffer
+		gzipWriter := gzip.NewWriter(&buf)
+		tarWriter := tar.NewWriter(gzipWriter)
+		bz, err := snapshot.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: SnapshotFileName, Mode: 0o644, Size: int64(len(bz))}))
+		_, err = tarWriter.Write(bz)
+		require.NoError(t, err)
+		for i, chunk := range chunks {
+			require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: strconv.Itoa(i), Mode: 0o644, Size: int64(len(chunk))}))
+			_, err = tarWriter.Write(chunk)
+			require.NoError(t, err)
+		}
+		require.NoError(t, tarWriter.Close())
+		require.NoError(t, gzipWriter.Close())
+		return buf.Bytes()
+	}
+
+	snapshot, _ := testArchiveSnapshot(chunks)
+	manifest, gotSnapshot, err := readArchive(bytes.NewReader(writeLegacyArchive(snapshot, chunks)), nil)
+	require.NoError(t, err)
+	require.Nil(t, manifest)
+	require.Equal(t, snapshot, gotSnapshot)
+
+	// legacy archives are checked against the chunk hashes of their snapshot
+	_, _, err = readArchive(bytes.NewReader(writeLegacyArchive(snapshot, [][]byte{{0x01}, {0x03}})), nil)
+	require.ErrorContains(t, err, "chunk 1 is corrupted")
+
+	_, _, err = readArchive(bytes.NewReader(writeLegacyArchive(snapshot, chunks[:1])), nil)
+	require.ErrorContains(t, err, "failed to read chunk 1")
+
+	// the manifest must describe the snapshot
+	snapshot, manifest = testArchiveSnapshot(chunks)
+	manifest.Chunks[0].Hash = manifest.Chunks[1].Hash
+	var buf bytes.Buffer
+	require.NoError(t, writeArchive(&buf, manifest, snapshot, func(index uint32) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(chunks[1])), nil
+	}))
+	_, _, err = readArchive(&buf, nil)
+	require.ErrorContains(t, err, "invalid manifest: manifest hash")
+
+	_, _, err = readArchive(bytes.NewReader([]byte("not an archive")), nil)
+	require.ErrorContains(t, err, "unknown archive compression")
+}
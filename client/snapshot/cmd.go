@@ -18,6 +18,7 @@ func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
 		ExportSnapshotCmd(appCreator),
 		DumpArchiveCmd(),
 		LoadArchiveCmd(),
+		VerifyArchiveCmd(),
 		DeleteSnapshotCmd(),
 	)
 	return cmd
@@ -1,8 +1,7 @@
 package snapshot
 
 import (
-	"archive/tar"
-	"compress/gzip"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -11,7 +10,17 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagCompression = "compression"
+	flagSplitSize   = "split-size"
 )
 
 // DumpArchiveCmd returns a command to dump the snapshot as a portable archive format
@@ -19,7 +28,15 @@ func DumpArchiveCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "dump <height> <format>",
 		Short: "Dump the snapshot as portable archive format",
-		Args:  cobra.ExactArgs(2),
+		Long: `Dump the snapshot as portable archive format.
+The archive starts with a manifest holding the chain-id, height, format and app hash
+of the snapshot, and the size and hash of its chunks, which load validates before
+loading the snapshot. The app hash is read from the application database, and is
+not recorded when the node is running.
+
+The archive is compressed with gzip or zstd, and can be split into files of at most
+--split-size MiB, named <output>.000, <output>.001 and so on.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := server.GetServerContextFromCmd(cmd)
 			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
@@ -31,6 +48,14 @@ func DumpArchiveCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			compression, err := cmd.Flags().GetString(flagCompression)
+			if err != nil {
+				return err
+			}
+			splitSize, err := cmd.Flags().GetInt64(flagSplitSize)
+			if err != nil {
+				return err
+			}
 
 			height, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
@@ -43,6 +68,9 @@ func DumpArchiveCmd() *cobra.Command {
 
 			if output == "" {
 				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+				if compression == CompressionZstd {
+					output = fmt.Sprintf("%d-%d.tar.zst", height, format)
+				}
 			}
 
 			snapshot, err := snapshotStore.Get(height, uint32(format))
@@ -54,73 +82,88 @@ func DumpArchiveCmd() *cobra.Command {
 				return errors.New("snapshot doesn't exist")
 			}
 
-			bz, err := snapshot.Marshal()
+			chainID, err := localChainID(cmd, ctx)
 			if err != nil {
 				return err
 			}
 
-			fp, err := os.Create(output)
-			if err != nil {
-				return err
+			manifest := &Manifest{
+				Version:      ArchiveVersion,
+				ChainID:      chainID,
+				Height:       snapshot.Height,
+				Format:       snapshot.Format,
+				AppVersion:   version.Version,
+				SnapshotHash: hex.EncodeToString(snapshot.Hash),
+				Compression:  compression,
+				Chunks:       make([]ManifestChunk, snapshot.Chunks),
+			}
+			if len(snapshot.Metadata.ChunkHashes) != int(snapshot.Chunks) {
+				return fmt.Errorf("invalid snapshot, %d chunk hashes for %d chunks", len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+			}
+			for i := range manifest.Chunks {
+				path := snapshotStore.PathChunk(height, uint32(format), uint32(i))
+				st, err := os.Stat(path)
+				if err != nil {
+					return fmt.Errorf("failed to stat chunk file %s: %w", path, err)
+				}
+				manifest.Chunks[i] = ManifestChunk{Size: st.Size(), Hash: hex.EncodeToString(snapshot.Metadata.ChunkHashes[i])}
 			}
-			defer fp.Close()
 
-			// since the chunk files are already compressed, we just use fastest compression here
-			gzipWriter, err := gzip.NewWriterLevel(fp, gzip.BestSpeed)
+			appHash, err := snapshotAppHash(ctx, int64(height))
 			if err != nil {
-				return err
-			}
-			tarWriter := tar.NewWriter(gzipWriter)
-			if err := tarWriter.WriteHeader(&tar.Header{
-				Name: SnapshotFileName,
-				Mode: 0o644,
-				Size: int64(len(bz)),
-			}); err != nil {
-				return fmt.Errorf("failed to write snapshot header to tar: %w", err)
+				cmd.PrintErrf("The app hash is not recorded in the manifest: %v\n", err)
 			}
-			if _, err := tarWriter.Write(bz); err != nil {
-				return fmt.Errorf("failed to write snapshot to tar: %w", err)
+			manifest.AppHash = hex.EncodeToString(appHash)
+
+			var w io.WriteCloser
+			if splitSize > 0 {
+				w = &partWriter{path: output, size: splitSize << 20}
+			} else if w, err = os.Create(output); err != nil {
+				return err
 			}
+			defer w.Close()
 
-			for i := uint32(0); i < snapshot.Chunks; i++ {
-				path := snapshotStore.PathChunk(height, uint32(format), i)
+			if err := writeArchive(w, manifest, snapshot, func(index uint32) (io.ReadCloser, error) {
+				path := snapshotStore.PathChunk(height, uint32(format), index)
 				file, err := os.Open(path)
 				if err != nil {
-					return fmt.Errorf("failed to open chunk file %s: %w", path, err)
-				}
-				defer file.Close()
-
-				st, err := file.Stat()
-				if err != nil {
-					return fmt.Errorf("failed to stat chunk file %s: %w", path, err)
-				}
-
-				if err := tarWriter.WriteHeader(&tar.Header{
-					Name: strconv.FormatUint(uint64(i), 10),
-					Mode: 0o644,
-					Size: st.Size(),
-				}); err != nil {
-					return fmt.Errorf("failed to write chunk header to tar: %w", err)
-				}
-
-				if _, err := io.Copy(tarWriter, file); err != nil {
-					return fmt.Errorf("failed to write chunk to tar: %w", err)
+					return nil, fmt.Errorf("failed to open chunk file %s: %w", path, err)
 				}
+				return file, nil
+			}); err != nil {
+				return err
 			}
 
-			if err := tarWriter.Close(); err != nil {
-				return fmt.Errorf("failed to close tar writer: %w", err)
+			if err := w.Close(); err != nil {
+				return err
 			}
 
-			if err := gzipWriter.Close(); err != nil {
-				return fmt.Errorf("failed to close gzip writer: %w", err)
+			if pw, ok := w.(*partWriter); ok {
+				cmd.Printf("Snapshot dumped to %d files, from %s to %s\n", len(pw.parts), pw.parts[0], pw.parts[len(pw.parts)-1])
 			}
-
-			return fp.Close()
+			return nil
 		},
 	}
 
 	cmd.Flags().StringP("output", "o", "", "output file")
+	cmd.Flags().String(flagCompression, CompressionGzip, "Compression of the archive (gzip|zstd)")
+	cmd.Flags().Int64(flagSplitSize, 0, "Split the archive into files of at most this many MiB")
+	cmd.Flags().String(flags.FlagChainID, "", "The chain-id of the snapshot, read from the genesis file by default")
 
 	return cmd
 }
+
+// snapshotAppHash reads the app hash of height from the application database.
+func snapshotAppHash(ctx *server.Context, height int64) ([]byte, error) {
+	db, err := openDB(ctx.Config.RootDir, server.GetAppDBBackend(ctx.Viper))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open application database, make sure the node is not running: %w", err)
+	}
+	defer db.Close()
+
+	info, err := rootmulti.NewStore(db, log.NewNopLogger()).GetCommitInfo(height)
+	if err != nil {
+		return nil, err
+	}
+	return info.Hash(), nil
+}
@@ -1,30 +1,41 @@
 package snapshot
 
 import (
-	"archive/tar"
 	"bytes"
-	"compress/gzip"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"reflect"
-	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	snapshottypes "github.com/cosmos/cosmos-sdk/store/v2/snapshots/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
-const SnapshotFileName = "_snapshot"
+const (
+	SnapshotFileName = "_snapshot"
+
+	flagAppHash = "app-hash"
+)
 
 // LoadArchiveCmd loads a portable archive format snapshot into snapshot store
 func LoadArchiveCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "load <archive-file>",
-		Short: "Load a snapshot archive file (.tar.gz) into snapshot store",
-		Args:  cobra.ExactArgs(1),
+		Short: "Load a snapshot archive file (.tar.gz or .tar.zst) into snapshot store",
+		Long: `Load a snapshot archive file (.tar.gz or .tar.zst) into snapshot store.
+The whole archive is validated before the snapshot is loaded: the hash of each chunk,
+and the manifest of the archive, whose chain-id must match the one of the node.
+Archives dumped before manifests were introduced are validated against the chunk
+hashes of their snapshot only.
+
+An archive split into several files is loaded from its first file, <archive>.000.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := server.GetServerContextFromCmd(cmd)
 			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
@@ -32,35 +43,40 @@ func LoadArchiveCmd() *cobra.Command {
 				return err
 			}
 
-			path := args[0]
-			fp, err := os.Open(path)
+			appHash, err := cmd.Flags().GetString(flagAppHash)
 			if err != nil {
-				return fmt.Errorf("failed to open archive file: %w", err)
+				return err
 			}
-			defer fp.Close()
-			reader, err := gzip.NewReader(fp)
+
+			path := args[0]
+			manifest, snapshot, err := verifyArchive(path)
 			if err != nil {
-				return fmt.Errorf("failed to create gzip reader: %w", err)
+				return err
 			}
-			defer reader.Close()
-
-			var snapshot snapshottypes.Snapshot
-			tr := tar.NewReader(reader)
 
-			hdr, err := tr.Next()
-			if err != nil {
-				return fmt.Errorf("failed to read snapshot file header: %w", err)
+			if manifest != nil {
+				chainID, err := localChainID(cmd, ctx)
+				if err != nil {
+					return err
+				}
+				if manifest.ChainID != chainID {
+					return fmt.Errorf("invalid archive, snapshot of chain %s, expected chain %s", manifest.ChainID, chainID)
+				}
 			}
-			if hdr.Name != SnapshotFileName {
-				return fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
+			if appHash != "" {
+				if manifest == nil || manifest.AppHash == "" {
+					return errors.New("invalid archive, no app hash recorded to check against")
+				}
+				if !strings.EqualFold(manifest.AppHash, appHash) {
+					return fmt.Errorf("invalid archive, snapshot of app hash %s, expected app hash %s", strings.ToUpper(manifest.AppHash), strings.ToUpper(appHash))
+				}
 			}
-			bz, err := io.ReadAll(tr)
+
+			fp, err := openArchive(path)
 			if err != nil {
-				return fmt.Errorf("failed to read snapshot file: %w", err)
-			}
-			if err := snapshot.Unmarshal(bz); err != nil {
-				return fmt.Errorf("failed to unmarshal snapshot: %w", err)
+				return err
 			}
+			defer fp.Close()
 
 			// make sure the channel is unbuffered, because the tar reader can't do concurrency
 			chunks := make(chan io.ReadCloser)
@@ -76,33 +92,28 @@ func LoadArchiveCmd() *cobra.Command {
 				quitChan <- savedSnapshot
 			}()
 
-			for i := uint32(0); i < snapshot.Chunks; i++ {
-				hdr, err = tr.Next()
-				if err != nil {
-					if errors.Is(err, io.EOF) {
-						break
-					}
-					return err
+			_, _, err = readArchive(fp, func(_ uint32, chunk []byte) error {
+				select {
+				case chunks <- io.NopCloser(bytes.NewReader(chunk)):
+					return nil
+				case <-quitChan:
+					return errors.New("failed to save snapshot")
 				}
-
-				if hdr.Name != strconv.FormatInt(int64(i), 10) {
-					return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
-				}
-
-				bz, err := io.ReadAll(tr)
-				if err != nil {
-					return fmt.Errorf("failed to read chunk file: %w", err)
-				}
-				chunks <- io.NopCloser(bytes.NewReader(bz))
-			}
+			})
 			close(chunks)
 
 			savedSnapshot := <-quitChan
+			if err != nil {
+				if savedSnapshot != nil {
+					_ = snapshotStore.Delete(snapshot.Height, snapshot.Format)
+				}
+				return err
+			}
 			if savedSnapshot == nil {
 				return fmt.Errorf("failed to save snapshot")
 			}
 
-			if !reflect.DeepEqual(&snapshot, savedSnapshot) {
+			if !reflect.DeepEqual(snapshot, savedSnapshot) {
 				_ = snapshotStore.Delete(snapshot.Height, snapshot.Format)
 				return fmt.Errorf("invalid archive, the saved snapshot is not equal to the original one")
 			}
@@ -110,4 +121,40 @@ func LoadArchiveCmd() *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().String(flagAppHash, "", "Check that the archive is a snapshot of this trusted app hash")
+	cmd.Flags().String(flags.FlagChainID, "", "The chain-id of the node, read from the genesis file by default")
+
+	return cmd
+}
+
+// verifyArchive reads and validates the whole archive of path.
+func verifyArchive(path string) (*Manifest, *snapshottypes.Snapshot, error) {
+	fp, err := openArchive(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer fp.Close()
+
+	return readArchive(fp, nil)
+}
+
+// localChainID returns the chain-id of the node, from the chain-id flag of
+// cmd or from its genesis file.
+func localChainID(cmd *cobra.Command, ctx *server.Context) (string, error) {
+	if chainID, _ := cmd.Flags().GetString(flags.FlagChainID); chainID != "" {
+		return chainID, nil
+	}
+
+	reader, err := os.Open(ctx.Config.GenesisFile())
+	if err != nil {
+		return "", fmt.Errorf("failed to read the chain-id, set --%s: %w", flags.FlagChainID, err)
+	}
+	defer reader.Close()
+
+	chainID, err := genutiltypes.ParseChainIDFromGenesis(reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse chain-id from genesis file: %w", err)
+	}
+	return chainID, nil
 }
@@ -0,0 +1,38 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// VerifyArchiveCmd returns a command to validate a portable archive format snapshot
+func VerifyArchiveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify <archive-file>",
+		Short: "Validate a snapshot archive file and print its manifest",
+		Long: `Validate a snapshot archive file and print its manifest.
+The hash of each chunk is checked against the snapshot and the manifest of the archive,
+as load does before loading the snapshot.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifest, snapshot, err := verifyArchive(args[0])
+			if err != nil {
+				return err
+			}
+
+			if manifest == nil {
+				cmd.Printf("Archive without manifest of snapshot at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+				return nil
+			}
+
+			bz, err := json.MarshalIndent(manifest, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+}
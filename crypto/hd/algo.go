@@ -3,6 +3,7 @@ package hd
 import (
 	"github.com/cosmos/go-bip39"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -19,12 +20,17 @@ const (
 	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519Type = PubKeyType("ed25519")
 	// Bls12_381Type represents the Bls12_381Type signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
+	// It is currently not supported for ledgers.
 	Bls12_381Type = PubKeyType("bls12_381")
 )
 
-// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
-var Secp256k1 = secp256k1Algo{}
+var (
+	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
+	Secp256k1 = secp256k1Algo{}
+
+	// Bls12_381 uses the BLS12-381 signature scheme of CometBFT and Ethereum.
+	Bls12_381 = bls12_381Algo{}
+)
 
 type (
 	DeriveFn   func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error)
@@ -69,3 +75,23 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type bls12_381Algo struct{}
+
+func (s bls12_381Algo) Name() PubKeyType {
+	return Bls12_381Type
+}
+
+// Derive derives the 32 bytes secret of the bls12_381 private key for the given
+// seed and HD path. The secret is derived as a secp256k1 private key would be.
+func (s bls12_381Algo) Derive() DeriveFn {
+	return Secp256k1.Derive()
+}
+
+// Generate generates a bls12_381 private key from the given secret.
+func (s bls12_381Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		privKey := bls12_381.GenPrivKeyFromSecret(bz)
+		return &privKey
+	}
+}
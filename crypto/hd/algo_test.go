@@ -6,6 +6,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestDefaults(t *testing.T) {
@@ -14,3 +17,21 @@ func TestDefaults(t *testing.T) {
 	require.Equal(t, hd.PubKeyType("ed25519"), hd.Ed25519Type)
 	require.Equal(t, hd.PubKeyType("bls12_381"), hd.Bls12_381Type)
 }
+
+func TestBls12_381Algo(t *testing.T) {
+	if !bls12_381.Enabled {
+		t.Skip("bls12_381 keys require the bls12381 build tag")
+	}
+
+	require.Equal(t, hd.Bls12_381Type, hd.Bls12_381.Name())
+
+	secret, err := hd.Bls12_381.Derive()(testdata.TestMnemonic, "", sdk.GetConfig().GetFullBIP44Path())
+	require.NoError(t, err)
+	privKey := hd.Bls12_381.Generate()(secret)
+	require.Equal(t, privKey, hd.Bls12_381.Generate()(secret))
+
+	msg := []byte("sign bytes")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature(msg, sig))
+}
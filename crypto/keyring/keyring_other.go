@@ -25,6 +25,8 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// define the remote signer used by the remote backend
+	RemoteSigner RemoteSignerConfig
 }
 
 func New(
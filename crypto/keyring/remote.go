@@ -0,0 +1,354 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// remoteSignerTimeout is the timeout of the calls to a remote signer.
+const remoteSignerTimeout = time.Minute
+
+// ErrRemoteUnsupported is raised when calling an operation the remote
+// keyring does not support, the keys being managed on the remote signer host.
+var ErrRemoteUnsupported = errors.New("operation not supported by the remote keyring, manage the keys on the remote signer")
+
+var _ Keyring = remoteKeystore{}
+
+// RemoteSignerConfig defines the connection to the remote signer of the
+// remote backend. The connection is authenticated with mutual TLS.
+type RemoteSignerConfig struct {
+	// Address is the <host>:<port> of the remote signer.
+	Address string
+	// CertFile and KeyFile are the PEM encoded certificate and key presented
+	// to the remote signer.
+	CertFile string
+	KeyFile  string
+	// CAFile is the PEM encoded certificate authority the remote signer
+	// certificate is verified against.
+	CAFile string
+}
+
+// WithRemoteSigner sets the remote signer used by the remote backend.
+func WithRemoteSigner(config RemoteSignerConfig) Option {
+	return func(options *Options) {
+		options.RemoteSigner = config
+	}
+}
+
+// TLSConfig returns the mutual TLS configuration of the connection to the
+// remote signer.
+func (c RemoteSignerConfig) TLSConfig() (*tls.Config, error) {
+	if c.CertFile == "" || c.KeyFile == "" || c.CAFile == "" {
+		return nil, errors.New("remote signer certificate, key and CA files are required")
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load remote signer client certificate: %w", err)
+	}
+
+	ca, err := os.ReadFile(c.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read remote signer CA: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificate found in remote signer CA file %s", c.CAFile)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// newRemoteKeyring dials the remote signer of the options. The connection is
+// established on the first call.
+func newRemoteKeyring(cdc codec.Codec, opts ...Option) (Keyring, error) {
+	var options Options
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	if options.RemoteSigner.Address == "" {
+		return nil, errors.New("remote signer address is not set")
+	}
+
+	tlsConfig, err := options.RemoteSigner.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(options.RemoteSigner.Address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to remote signer: %w", err)
+	}
+
+	return NewRemote(conn, cdc, opts...), nil
+}
+
+// NewRemote creates a keyring whose keys are held by the remote signer
+// served on the given connection. The keys can be listed and used to sign,
+// but not created, imported, exported or deleted.
+func NewRemote(conn grpc.ClientConnInterface, cdc codec.Codec, opts ...Option) Keyring {
+	options := Options{
+		SupportedAlgos:       SigningAlgoList{},
+		SupportedAlgosLedger: SigningAlgoList{},
+	}
+
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	return remoteKeystore{
+		client:  NewRemoteSignerClient(conn),
+		cdc:     cdc,
+		options: options,
+	}
+}
+
+type remoteKeystore struct {
+	client  RemoteSignerClient
+	cdc     codec.Codec
+	options Options
+}
+
+// Backend returns the keyring backend option used in the config
+func (ks remoteKeystore) Backend() string {
+	return BackendRemote
+}
+
+func (ks remoteKeystore) List() ([]*Record, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.List(ctx, &SignerListRequest{})
+	if err != nil {
+		return nil, wrapRemoteError(err)
+	}
+
+	records := make([]*Record, 0, len(res.Keys))
+	for _, key := range res.Keys {
+		k, err := ks.toRecord(key)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, k)
+	}
+
+	return records, nil
+}
+
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (*Record, error) {
+	return ks.key(&SignerKeyRequest{Uid: uid})
+}
+
+func (ks remoteKeystore) KeyByAddress(address sdk.Address) (*Record, error) {
+	return ks.key(&SignerKeyRequest{Address: address.Bytes()})
+}
+
+func (ks remoteKeystore) key(req *SignerKeyRequest) (*Record, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Key(ctx, req)
+	if err != nil {
+		return nil, wrapRemoteError(err)
+	}
+
+	return ks.toRecord(res.Key)
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	return ks.sign(&SignerSignRequest{Uid: uid, Msg: msg, SignMode: signMode})
+}
+
+func (ks remoteKeystore) SignByAddress(address sdk.Address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	return ks.sign(&SignerSignRequest{Address: address.Bytes(), Msg: msg, SignMode: signMode})
+}
+
+func (ks remoteKeystore) sign(req *SignerSignRequest) ([]byte, types.PubKey, error) {
+	k, err := ks.key(&SignerKeyRequest{Uid: req.Uid, Address: req.Address})
+	if err != nil {
+		return nil, nil, err
+	}
+	expected, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Sign(ctx, req)
+	if err != nil {
+		return nil, nil, wrapRemoteError(err)
+	}
+
+	pk, err := ks.unpackPubKey(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// a signature of another key of the signer must not be used in place of
+	// the one of the requested key
+	if !pk.Equals(expected) {
+		return nil, nil, errors.New("remote signer signed with another key than the requested one")
+	}
+
+	if !pk.VerifySignature(req.Msg, res.Signature) {
+		return nil, nil, errors.New("remote signer returned an invalid signature")
+	}
+
+	return res.Signature, pk, nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.exportPubKeyArmor(k)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.exportPubKeyArmor(k)
+}
+
+func (ks remoteKeystore) exportPubKeyArmor(k *Record) (string, error) {
+	key, err := k.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := ks.cdc.MarshalInterface(key)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(bz, key.Type()), nil
+}
+
+// MigrateAll is a no-op, the keys of the remote keyring are not stored locally.
+func (ks remoteKeystore) MigrateAll() ([]*Record, error) {
+	return nil, nil
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) DeleteByAddress(sdk.Address) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) Rename(string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportPrivKeyHex(string, string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) toRecord(key *RemoteKey) (*Record, error) {
+	if key == nil {
+		return nil, errors.New("remote signer returned no key")
+	}
+
+	pk, err := ks.unpackPubKey(key.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRemoteRecord(key.Name, pk)
+}
+
+func (ks remoteKeystore) unpackPubKey(any *codectypes.Any) (types.PubKey, error) {
+	var pk types.PubKey
+	if err := ks.cdc.UnpackAny(any, &pk); err != nil {
+		return nil, err
+	}
+	if pk == nil {
+		return nil, errors.New("remote signer returned no public key")
+	}
+
+	return pk, nil
+}
+
+// wrapRemoteError converts the key not found status of a remote signer to
+// sdkerrors.ErrKeyNotFound.
+func wrapRemoteError(err error) error {
+	if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, s.Message())
+	}
+
+	return fmt.Errorf("remote signer: %w", err)
+}
@@ -0,0 +1,104 @@
+package keyring
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ RemoteSignerServer = remoteSignerServer{}
+
+type remoteSignerServer struct {
+	kr Keyring
+}
+
+// NewRemoteSignerServer returns a RemoteSigner service serving the keys of the
+// given keyring. Only the local and Ledger keys, which the keyring can sign
+// with, are served.
+func NewRemoteSignerServer(kr Keyring) RemoteSignerServer {
+	return remoteSignerServer{kr: kr}
+}
+
+func (s remoteSignerServer) List(context.Context, *SignerListRequest) (*SignerListResponse, error) {
+	records, err := s.kr.List()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &SignerListResponse{}
+	for _, k := range records {
+		if !isSigningRecord(k) {
+			continue
+		}
+		res.Keys = append(res.Keys, toRemoteKey(k))
+	}
+
+	return res, nil
+}
+
+func (s remoteSignerServer) Key(_ context.Context, req *SignerKeyRequest) (*SignerKeyResponse, error) {
+	k, err := s.key(req.Uid, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignerKeyResponse{Key: toRemoteKey(k)}, nil
+}
+
+func (s remoteSignerServer) Sign(_ context.Context, req *SignerSignRequest) (*SignerSignResponse, error) {
+	k, err := s.key(req.Uid, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, pk, err := s.kr.Sign(k.Name, req.Msg, req.SignMode)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	anyPk, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SignerSignResponse{Signature: sig, PubKey: anyPk}, nil
+}
+
+// key returns the signing record looked up by address when it is set and by
+// name otherwise.
+func (s remoteSignerServer) key(uid string, address []byte) (*Record, error) {
+	var (
+		k   *Record
+		err error
+	)
+	if len(address) > 0 {
+		k, err = s.kr.KeyByAddress(sdk.AccAddress(address))
+	} else {
+		k, err = s.kr.Key(uid)
+	}
+
+	switch {
+	case errors.Is(err, sdkerrors.ErrKeyNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	case !isSigningRecord(k):
+		return nil, status.Errorf(codes.NotFound, "key %s cannot sign", k.Name)
+	}
+
+	return k, nil
+}
+
+func isSigningRecord(k *Record) bool {
+	return k.GetLocal() != nil || k.GetLedger() != nil
+}
+
+func toRemoteKey(k *Record) *RemoteKey {
+	return &RemoteKey{Name: k.Name, PubKey: k.PubKey}
+}
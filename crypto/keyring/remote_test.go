@@ -0,0 +1,217 @@
+package keyring
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// testCert creates a certificate signed by the parent, or self-signed when the
+// parent is nil, and writes it and its key to dir.
+func testCert(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, isCA bool) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+
+	return cert, key
+}
+
+// startRemoteSigner serves the remote signer with mutual TLS and returns the
+// remote signer configuration of a client.
+func startRemoteSigner(t *testing.T, signer RemoteSignerServer) RemoteSignerConfig {
+	t.Helper()
+
+	dir := t.TempDir()
+	ca, caKey := testCert(t, dir, "ca", nil, nil, true)
+	testCert(t, dir, "server", ca, caKey, false)
+	testCert(t, dir, "client", ca, caKey, false)
+
+	serverCert, err := tls.LoadX509KeyPair(filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"))
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	})))
+	RegisterRemoteSignerServer(server, signer)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	return RemoteSignerConfig{
+		Address:  lis.Addr().String(),
+		CertFile: filepath.Join(dir, "client.crt"),
+		KeyFile:  filepath.Join(dir, "client.key"),
+		CAFile:   filepath.Join(dir, "ca.crt"),
+	}
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	cdc := getCodec()
+	signerKr := NewInMemory(cdc)
+	local, _, err := signerKr.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = signerKr.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	config := startRemoteSigner(t, NewRemoteSignerServer(signerKr))
+	kr, err := New("cosmos", BackendRemote, t.TempDir(), nil, cdc, WithRemoteSigner(config))
+	require.NoError(t, err)
+	require.Equal(t, BackendRemote, kr.Backend())
+
+	localPk, err := local.GetPubKey()
+	require.NoError(t, err)
+	localAddr, err := local.GetAddress()
+	require.NoError(t, err)
+
+	// only the keys the signer can sign with are served
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "local", records[0].Name)
+	require.Equal(t, TypeRemote, records[0].GetType())
+
+	k, err := kr.Key("local")
+	require.NoError(t, err)
+	pk, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, localPk.Equals(pk))
+
+	k, err = kr.KeyByAddress(localAddr)
+	require.NoError(t, err)
+	require.Equal(t, "local", k.Name)
+
+	_, err = kr.Key("offline")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = kr.Key("unknown")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	msg := []byte("message")
+	sig, pk, err := kr.Sign("local", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, localPk.Equals(pk))
+	require.True(t, localPk.VerifySignature(msg, sig))
+
+	sig, _, err = kr.SignByAddress(localAddr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, localPk.VerifySignature(msg, sig))
+
+	_, _, err = kr.Sign("offline", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	armor, err := kr.ExportPubKeyArmor("local")
+	require.NoError(t, err)
+	require.NotEmpty(t, armor)
+
+	// key management stays on the signer host
+	_, _, err = kr.NewMnemonic("new", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+	require.ErrorIs(t, kr.Delete("local"), ErrRemoteUnsupported)
+	_, err = kr.ExportPrivKeyArmor("local", "passphrase")
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+}
+
+func TestRemoteKeyringTLS(t *testing.T) {
+	cdc := getCodec()
+	config := startRemoteSigner(t, NewRemoteSignerServer(NewInMemory(cdc)))
+
+	_, err := New("cosmos", BackendRemote, t.TempDir(), nil, cdc)
+	require.ErrorContains(t, err, "remote signer address is not set")
+
+	_, err = New("cosmos", BackendRemote, t.TempDir(), nil, cdc, WithRemoteSigner(RemoteSignerConfig{Address: config.Address}))
+	require.ErrorContains(t, err, "remote signer certificate, key and CA files are required")
+
+	// a client certificate not signed by the client CA is rejected
+	dir := t.TempDir()
+	testCert(t, dir, "other", nil, nil, true)
+	kr, err := New("cosmos", BackendRemote, t.TempDir(), nil, cdc, WithRemoteSigner(RemoteSignerConfig{
+		Address:  config.Address,
+		CertFile: filepath.Join(dir, "other.crt"),
+		KeyFile:  filepath.Join(dir, "other.key"),
+		CAFile:   config.CAFile,
+	}))
+	require.NoError(t, err)
+	_, err = kr.List()
+	require.Error(t, err)
+}
+
+// otherKeySigner signs every request with the key named other.
+type otherKeySigner struct {
+	RemoteSignerServer
+}
+
+func (s otherKeySigner) Sign(ctx context.Context, req *SignerSignRequest) (*SignerSignResponse, error) {
+	return s.RemoteSignerServer.Sign(ctx, &SignerSignRequest{Uid: "other", Msg: req.Msg, SignMode: req.SignMode})
+}
+
+func TestRemoteKeyringSignedByOtherKey(t *testing.T) {
+	cdc := getCodec()
+	signerKr := NewInMemory(cdc)
+	_, _, err := signerKr.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = signerKr.NewMnemonic("other", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	config := startRemoteSigner(t, otherKeySigner{NewRemoteSignerServer(signerKr)})
+	kr, err := New("cosmos", BackendRemote, t.TempDir(), nil, cdc, WithRemoteSigner(config))
+	require.NoError(t, err)
+
+	_, _, err = kr.Sign("local", []byte("message"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, "remote signer signed with another key than the requested one")
+
+	_, _, err = kr.Sign("other", []byte("message"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+}
@@ -14,6 +14,10 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
+// Enabled indicates if BLS12-381 keys are enabled, which requires the
+// bls12381 build tag.
+const Enabled = false
+
 // ===============================================================================================
 // Private Key
 // ===============================================================================================
@@ -32,6 +36,12 @@ func NewPrivateKeyFromBytes(bz []byte) (PrivKey, error) {
 	panic("not implemented, build flags are required to use bls12_381 keys")
 }
 
+// GenPrivKeyFromSecret generates a new key deterministically from the given
+// secret, which is hashed if it is not 32 bytes long.
+func GenPrivKeyFromSecret(secret []byte) PrivKey {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
 // GenPrivKey generates a new key.
 func GenPrivKey() (PrivKey, error) {
 	panic("not implemented, build flags are required to use bls12_381 keys")
@@ -132,3 +142,20 @@ func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
 func (pubKey PubKey) String() string {
 	return fmt.Sprintf("PubKeyBLS12_381{%X}", pubKey.Key)
 }
+
+// ===============================================================================================
+// Aggregate Signatures
+// ===============================================================================================
+
+// AggregateSignatures aggregates the given signatures into a single signature
+// of the same size.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// VerifyAggregateSignature verifies that sig aggregates the signatures of msgs[i]
+// by pubKeys[i] with a single pairing check. The messages must be distinct, which
+// prevents rogue public key attacks.
+func VerifyAggregateSignature(pubKeys []*PubKey, msgs [][]byte, sig []byte) bool {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
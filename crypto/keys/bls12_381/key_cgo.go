@@ -10,11 +10,20 @@ import (
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	blst "github.com/supranational/blst/bindings/go"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
+// Enabled indicates if BLS12-381 keys are enabled, which requires the
+// bls12381 build tag.
+const Enabled = true
+
+// dstMinPk is the domain separation tag of CometBFT signatures, with public
+// keys in G1 and signatures in G2.
+var dstMinPk = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+
 // ===============================================================================================
 // Private Key
 // ===============================================================================================
@@ -39,6 +48,18 @@ func NewPrivateKeyFromBytes(bz []byte) (PrivKey, error) {
 	}, nil
 }
 
+// GenPrivKeyFromSecret generates a new key deterministically from the given
+// secret, which is hashed if it is not 32 bytes long.
+func GenPrivKeyFromSecret(secret []byte) PrivKey {
+	secretKey, err := bls12381.GenPrivKeyFromSecret(secret)
+	if err != nil {
+		panic(err)
+	}
+	return PrivKey{
+		Key: secretKey.Bytes(),
+	}
+}
+
 // GenPrivKey generates a new key.
 func GenPrivKey() (PrivKey, error) {
 	secretKey, err := bls12381.GenPrivKey()
@@ -167,3 +188,60 @@ func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
 func (pubKey PubKey) String() string {
 	return fmt.Sprintf("PubKeyBLS12_381{%X}", pubKey.Key)
 }
+
+// ===============================================================================================
+// Aggregate Signatures
+// ===============================================================================================
+
+// AggregateSignatures aggregates the given signatures into a single signature
+// of the same size.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signature to aggregate")
+	}
+
+	var agg blst.P2Aggregate
+	if !agg.AggregateCompressed(sigs, true) {
+		return nil, errors.New("invalid bls12_381 signature")
+	}
+	return agg.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature verifies that sig aggregates the signatures of msgs[i]
+// by pubKeys[i] with a single pairing check. The messages are verified as
+// PrivKey.Sign signs them, and must be distinct, which prevents rogue public key
+// attacks.
+func VerifyAggregateSignature(pubKeys []*PubKey, msgs [][]byte, sig []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) || len(sig) != bls12381.SignatureLength {
+		return false
+	}
+
+	seen := make(map[string]struct{}, len(msgs))
+	for _, msg := range msgs {
+		if _, ok := seen[string(msg)]; ok {
+			return false
+		}
+		seen[string(msg)] = struct{}{}
+	}
+
+	points := make([]*blst.P1Affine, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		pk := new(blst.P1Affine).Deserialize(pubKey.Key)
+		if pk == nil || !pk.KeyValidate() { // subgroup and infinity check
+			return false
+		}
+		points[i] = pk
+	}
+
+	signature := new(blst.P2Affine).Uncompress(sig)
+	if signature == nil {
+		return false
+	}
+
+	blstMsgs := make([]blst.Message, len(msgs))
+	for i, msg := range msgs {
+		blstMsgs[i] = msg
+	}
+
+	return signature.AggregateVerify(true, points, false, blstMsgs, dstMinPk)
+}
@@ -0,0 +1,128 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey()
+
+	msg := []byte("sign bytes")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, 96)
+	require.Len(t, pubKey.Address(), 20)
+
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.False(t, pubKey.VerifySignature([]byte("other"), sig))
+	require.False(t, pubKey.VerifySignature(msg, sig[1:]))
+
+	otherKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	require.False(t, otherKey.PubKey().VerifySignature(msg, sig))
+
+	restored, err := bls12_381.NewPrivateKeyFromBytes(privKey.Bytes())
+	require.NoError(t, err)
+	require.True(t, restored.Equals(&privKey))
+
+	require.Equal(t, bls12_381.GenPrivKeyFromSecret([]byte("secret")), bls12_381.GenPrivKeyFromSecret([]byte("secret")))
+}
+
+func TestCometBFTCompatibility(t *testing.T) {
+	cmtKey, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	privKey, err := bls12_381.NewPrivateKeyFromBytes(cmtKey.Bytes())
+	require.NoError(t, err)
+	pubKey := privKey.PubKey().(*bls12_381.PubKey)
+	require.Equal(t, cmtKey.PubKey().Bytes(), pubKey.Bytes())
+
+	// the signatures of CometBFT keys, over short and long messages, verify
+	// individually and aggregated
+	for _, msg := range [][]byte{[]byte("sign bytes"), bytes.Repeat([]byte("sign bytes"), 100)} {
+		sig, err := cmtKey.Sign(msg)
+		require.NoError(t, err)
+		require.True(t, pubKey.VerifySignature(msg, sig))
+
+		ownSig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+		require.True(t, cmtKey.PubKey().VerifySignature(msg, ownSig))
+
+		aggSig, err := bls12_381.AggregateSignatures([][]byte{sig})
+		require.NoError(t, err)
+		require.True(t, bls12_381.VerifyAggregateSignature([]*bls12_381.PubKey{pubKey}, [][]byte{msg}, aggSig))
+	}
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	const n = 3
+	pubKeys := make([]*bls12_381.PubKey, n)
+	msgs := make([][]byte, n)
+	sigs := make([][]byte, n)
+	for i := range n {
+		privKey, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		pubKeys[i] = privKey.PubKey().(*bls12_381.PubKey)
+		msgs[i] = []byte{byte(i)}
+		sigs[i], err = privKey.Sign(msgs[i])
+		require.NoError(t, err)
+	}
+
+	aggSig, err := bls12_381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.Len(t, aggSig, 96)
+	require.True(t, bls12_381.VerifyAggregateSignature(pubKeys, msgs, aggSig))
+
+	// wrong message
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, [][]byte{msgs[0], msgs[1], []byte("other")}, aggSig))
+	// missing signer
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys[:2], msgs[:2], aggSig))
+	// duplicate messages are rejected to prevent rogue public key attacks
+	sameMsgSigs := make([][]byte, n)
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	for i := range n {
+		sameMsgSigs[i], err = privKey.Sign(msgs[0])
+		require.NoError(t, err)
+	}
+	aggSig, err = bls12_381.AggregateSignatures(sameMsgSigs)
+	require.NoError(t, err)
+	samePubKey := privKey.PubKey().(*bls12_381.PubKey)
+	require.False(t, bls12_381.VerifyAggregateSignature(
+		[]*bls12_381.PubKey{samePubKey, samePubKey, samePubKey}, [][]byte{msgs[0], msgs[0], msgs[0]}, aggSig))
+
+	_, err = bls12_381.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = bls12_381.AggregateSignatures([][]byte{{1, 2, 3}})
+	require.Error(t, err)
+}
+
+func TestPubKeyCodec(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey()
+
+	bz, err := cdc.MarshalInterface(pubKey)
+	require.NoError(t, err)
+	var decoded cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterface(bz, &decoded))
+	require.True(t, pubKey.Equals(decoded))
+	require.Equal(t, pubKey.Address(), decoded.Address())
+}
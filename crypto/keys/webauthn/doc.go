@@ -0,0 +1,29 @@
+// Package webauthn implements Cosmos-SDK compatible public keys of WebAuthn
+// credentials (passkeys). A passkey does not sign the transaction sign bytes
+// directly: it signs an assertion made of the authenticator data and the client
+// data JSON. The signature of a PubKey is therefore a serialized Signature whose
+// client data challenge is the SHA-256 hash of the sign bytes, so that browsers
+// can sign transactions with navigator.credentials.get.
+package webauthn
+
+import (
+	"crypto/elliptic"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	// fieldSize is the curve domain size.
+	fieldSize  = 32
+	pubKeySize = fieldSize + 1
+
+	name = "webauthn"
+)
+
+var secp256r1 = elliptic.P256()
+
+// RegisterInterfaces adds webauthn PubKey to pubkey registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+}
@@ -0,0 +1,211 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	internalecdsa "github.com/cosmos/cosmos-sdk/crypto/keys/internal/ecdsa"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ClientDataTypeGet is the client data type of WebAuthn assertions.
+	ClientDataTypeGet = "webauthn.get"
+
+	// minAuthenticatorDataSize is the size of the RP ID hash, the flags and
+	// the signature counter of the authenticator data.
+	minAuthenticatorDataSize = 37
+
+	// flagsIndex is the index of the flags in the authenticator data.
+	flagsIndex = 32
+
+	// flagUserPresent is the flag set when the user was present.
+	flagUserPresent = 0x01
+)
+
+// customProtobufType is here to make sure that ecdsaPK implements the
+// gogoproto customtype interface.
+type customProtobufType interface {
+	Marshal() ([]byte, error)
+	MarshalTo(data []byte) (n int, err error)
+	Unmarshal(data []byte) error
+	Size() int
+
+	MarshalJSON() ([]byte, error)
+	UnmarshalJSON(data []byte) error
+}
+
+var (
+	_ cryptotypes.PubKey = (*PubKey)(nil)
+	_ customProtobufType = (*ecdsaPK)(nil)
+)
+
+// String implements proto.Message interface.
+func (m *PubKey) String() string {
+	return m.Key.String(name)
+}
+
+// Bytes implements SDK PubKey interface.
+func (m *PubKey) Bytes() []byte {
+	if m == nil {
+		return nil
+	}
+	return m.Key.Bytes()
+}
+
+// Equals implements SDK PubKey interface.
+func (m *PubKey) Equals(other cryptotypes.PubKey) bool {
+	pk2, ok := other.(*PubKey)
+	if !ok {
+		return false
+	}
+	return m.Key.Equal(&pk2.Key.PublicKey)
+}
+
+// Address implements SDK PubKey interface.
+func (m *PubKey) Address() cmtcrypto.Address {
+	return m.Key.Address(proto.MessageName(m))
+}
+
+// Type returns key type name. Implements SDK PubKey interface.
+func (m *PubKey) Type() string {
+	return name
+}
+
+// VerifySignature implements SDK PubKey interface. sig must be a serialized
+// Signature whose client data is of type webauthn.get with the challenge set to
+// the unpadded base64url encoding of the SHA-256 hash of msg, and whose
+// authenticator data has the user present flag set.
+func (m *PubKey) VerifySignature(msg, sig []byte) bool {
+	var assertion Signature
+	if err := assertion.Unmarshal(sig); err != nil {
+		return false
+	}
+
+	var clientData struct {
+		Type      string `json:"type"`
+		Challenge string `json:"challenge"`
+	}
+	if err := json.Unmarshal(assertion.ClientDataJson, &clientData); err != nil {
+		return false
+	}
+	if clientData.Type != ClientDataTypeGet {
+		return false
+	}
+
+	challenge, err := base64.RawURLEncoding.DecodeString(clientData.Challenge)
+	if err != nil {
+		return false
+	}
+	msgHash := sha256.Sum256(msg)
+	if !bytes.Equal(challenge, msgHash[:]) {
+		return false
+	}
+
+	if len(assertion.AuthenticatorData) < minAuthenticatorDataSize ||
+		assertion.AuthenticatorData[flagsIndex]&flagUserPresent == 0 {
+		return false
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJson)
+	signed := make([]byte, 0, len(assertion.AuthenticatorData)+len(clientDataHash))
+	signed = append(signed, assertion.AuthenticatorData...)
+	signed = append(signed, clientDataHash[:]...)
+
+	return m.Key.VerifySignature(signed, assertion.Signature)
+}
+
+type ecdsaPK struct {
+	internalecdsa.PubKey
+}
+
+// Marshal implements customProtobufType.
+func (pk ecdsaPK) Marshal() ([]byte, error) {
+	return pk.Bytes(), nil
+}
+
+// MarshalJSON implements customProtobufType.
+func (pk ecdsaPK) MarshalJSON() ([]byte, error) {
+	b64 := base64.StdEncoding.EncodeToString(pk.Bytes())
+	return []byte("\"" + b64 + "\""), nil
+}
+
+// UnmarshalJSON implements customProtobufType.
+func (pk *ecdsaPK) UnmarshalJSON(data []byte) error {
+	// the string is quoted so we need to remove them
+	bz, err := base64.StdEncoding.DecodeString(string(data[1 : len(data)-1]))
+	if err != nil {
+		return err
+	}
+
+	return pk.PubKey.Unmarshal(bz, secp256r1, pubKeySize)
+}
+
+// Size implements proto.Marshaler interface
+func (pk *ecdsaPK) Size() int {
+	if pk == nil {
+		return 0
+	}
+	return pubKeySize
+}
+
+// Unmarshal implements proto.Marshaler interface
+func (pk *ecdsaPK) Unmarshal(bz []byte) error {
+	return pk.PubKey.Unmarshal(bz, secp256r1, pubKeySize)
+}
+
+// NewPubKeyFromBytes creates a webauthn PubKey from a secp256r1 point in
+// compressed form.
+func NewPubKeyFromBytes(bytes []byte) (*PubKey, error) {
+	if len(bytes) != pubKeySize {
+		return nil, errorsmod.Wrapf(errors.ErrInvalidPubKey,
+			"wrong webauthn pubkey size, expecting %d bytes, got %d",
+			pubKeySize, len(bytes))
+	}
+	pk := &ecdsaPK{}
+	err := pk.Unmarshal(bytes)
+	if err != nil {
+		return nil, errorsmod.Wrap(errors.ErrInvalidPubKey, err.Error())
+	}
+	return &PubKey{Key: pk}, nil
+}
+
+// ParsePubKey creates a webauthn PubKey from the public key of a passkey as
+// returned by the browser. bz can be a DER encoded SubjectPublicKeyInfo, as
+// returned by AuthenticatorAttestationResponse.getPublicKey, or a secp256r1
+// point in compressed or uncompressed form.
+func ParsePubKey(bz []byte) (*PubKey, error) {
+	switch {
+	case len(bz) == pubKeySize:
+		return NewPubKeyFromBytes(bz)
+
+	case len(bz) == 2*fieldSize+1 && bz[0] == 4:
+		x, y := elliptic.Unmarshal(secp256r1, bz) //nolint:staticcheck // the point is re-encoded in compressed form
+		if x == nil {
+			return nil, errorsmod.Wrap(errors.ErrInvalidPubKey, "invalid secp256r1 point")
+		}
+		return NewPubKeyFromBytes(elliptic.MarshalCompressed(secp256r1, x, y))
+
+	default:
+		key, err := x509.ParsePKIXPublicKey(bz)
+		if err != nil {
+			return nil, errorsmod.Wrapf(errors.ErrInvalidPubKey, "invalid webauthn pubkey: %s", err)
+		}
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok || ecKey.Curve != secp256r1 {
+			return nil, errorsmod.Wrap(errors.ErrInvalidPubKey, "webauthn pubkey must be a secp256r1 ECDSA key")
+		}
+		return NewPubKeyFromBytes(elliptic.MarshalCompressed(secp256r1, ecKey.X, ecKey.Y))
+	}
+}
@@ -0,0 +1,205 @@
+package webauthn_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// passkey simulates a WebAuthn authenticator.
+type passkey struct {
+	key *ecdsa.PrivateKey
+}
+
+func newPasskey(t *testing.T) passkey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return passkey{key: key}
+}
+
+func (p passkey) pubKey(t *testing.T) *webauthn.PubKey {
+	t.Helper()
+	pk, err := webauthn.NewPubKeyFromBytes(elliptic.MarshalCompressed(elliptic.P256(), p.key.X, p.key.Y))
+	require.NoError(t, err)
+	return pk
+}
+
+// assert returns the assertion of the given client data and authenticator
+// data, with a low s signature.
+func (p passkey) assert(t *testing.T, clientDataJSON, authenticatorData []byte) *webauthn.Signature {
+	t.Helper()
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authenticatorData...), clientDataHash[:]...))
+	r, s, err := ecdsa.Sign(rand.Reader, p.key, digest[:])
+	require.NoError(t, err)
+
+	order := elliptic.P256().Params().N
+	if s.Cmp(new(big.Int).Rsh(order, 1)) > 0 {
+		s.Sub(order, s)
+	}
+
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return &webauthn.Signature{
+		AuthenticatorData: authenticatorData,
+		ClientDataJson:    clientDataJSON,
+		Signature:         sig,
+	}
+}
+
+func clientDataJSON(typ string, msg []byte) []byte {
+	hash := sha256.Sum256(msg)
+	return fmt.Appendf(nil, `{"type":%q,"challenge":%q,"origin":"https://wallet.example","crossOrigin":false}`,
+		typ, base64.RawURLEncoding.EncodeToString(hash[:]))
+}
+
+func authenticatorData(flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte("wallet.example"))
+	return append(rpIDHash[:], flags, 0, 0, 0, 1)
+}
+
+func TestVerifySignature(t *testing.T) {
+	p := newPasskey(t)
+	pk := p.pubKey(t)
+	msg := []byte("sign bytes")
+
+	testCases := []struct {
+		name    string
+		sig     func() *webauthn.Signature
+		expPass bool
+	}{
+		{
+			name: "valid assertion",
+			sig: func() *webauthn.Signature {
+				return p.assert(t, clientDataJSON(webauthn.ClientDataTypeGet, msg), authenticatorData(0x05))
+			},
+			expPass: true,
+		},
+		{
+			name: "challenge of other sign bytes",
+			sig: func() *webauthn.Signature {
+				return p.assert(t, clientDataJSON(webauthn.ClientDataTypeGet, []byte("other")), authenticatorData(0x05))
+			},
+		},
+		{
+			name: "registration client data",
+			sig: func() *webauthn.Signature {
+				return p.assert(t, clientDataJSON("webauthn.create", msg), authenticatorData(0x05))
+			},
+		},
+		{
+			name: "user not present",
+			sig: func() *webauthn.Signature {
+				return p.assert(t, clientDataJSON(webauthn.ClientDataTypeGet, msg), authenticatorData(0x04))
+			},
+		},
+		{
+			name: "truncated authenticator data",
+			sig: func() *webauthn.Signature {
+				return p.assert(t, clientDataJSON(webauthn.ClientDataTypeGet, msg), authenticatorData(0x05)[:36])
+			},
+		},
+		{
+			name: "tampered authenticator data",
+			sig: func() *webauthn.Signature {
+				sig := p.assert(t, clientDataJSON(webauthn.ClientDataTypeGet, msg), authenticatorData(0x05))
+				sig.AuthenticatorData[36]++
+				return sig
+			},
+		},
+		{
+			name: "high s",
+			sig: func() *webauthn.Signature {
+				sig := p.assert(t, clientDataJSON(webauthn.ClientDataTypeGet, msg), authenticatorData(0x05))
+				s := new(big.Int).SetBytes(sig.Signature[32:])
+				s.Sub(elliptic.P256().Params().N, s).FillBytes(sig.Signature[32:])
+				return sig
+			},
+		},
+		{
+			name: "invalid client data",
+			sig: func() *webauthn.Signature {
+				return p.assert(t, []byte("not json"), authenticatorData(0x05))
+			},
+		},
+		{
+			name: "other passkey",
+			sig: func() *webauthn.Signature {
+				return newPasskey(t).assert(t, clientDataJSON(webauthn.ClientDataTypeGet, msg), authenticatorData(0x05))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := tc.sig().Marshal()
+			require.NoError(t, err)
+			require.Equal(t, tc.expPass, pk.VerifySignature(msg, bz))
+		})
+	}
+
+	require.False(t, pk.VerifySignature(msg, []byte{1, 2, 3}))
+}
+
+func TestParsePubKey(t *testing.T) {
+	p := newPasskey(t)
+	expected := p.pubKey(t)
+
+	spki, err := x509.MarshalPKIXPublicKey(&p.key.PublicKey)
+	require.NoError(t, err)
+	uncompressed := elliptic.Marshal(elliptic.P256(), p.key.X, p.key.Y) //nolint:staticcheck // passkeys may be exported uncompressed
+
+	for _, bz := range [][]byte{spki, uncompressed, expected.Bytes()} {
+		pk, err := webauthn.ParsePubKey(bz)
+		require.NoError(t, err)
+		require.True(t, expected.Equals(pk))
+	}
+
+	otherCurve, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	spki, err = x509.MarshalPKIXPublicKey(&otherCurve.PublicKey)
+	require.NoError(t, err)
+	_, err = webauthn.ParsePubKey(spki)
+	require.Error(t, err)
+
+	_, err = webauthn.ParsePubKey([]byte{1, 2, 3})
+	require.Error(t, err)
+}
+
+func TestPubKeyCodec(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	pk := newPasskey(t).pubKey(t)
+	require.Equal(t, "webauthn", pk.Type())
+	require.Len(t, pk.Address(), 32)
+
+	bz, err := cdc.MarshalInterface(pk)
+	require.NoError(t, err)
+	var decoded cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterface(bz, &decoded))
+	require.True(t, pk.Equals(decoded))
+	require.Equal(t, pk.Address(), decoded.Address())
+
+	jsonBz, err := cdc.MarshalInterfaceJSON(pk)
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(jsonBz, &decoded))
+	require.True(t, pk.Equals(decoded))
+}
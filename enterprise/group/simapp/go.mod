@@ -289,5 +289,6 @@ replace github.com/cosmos/cosmos-sdk/enterprise/group => ..
 replace (
 	github.com/99designs/keyring => github.com/cosmos/keyring v1.2.0
 	github.com/cosmos/cosmos-sdk => ../../../.
+	cosmossdk.io/api => ../../../api
 	github.com/syndtr/goleveldb => github.com/syndtr/goleveldb v1.0.1-0.20210819022825-2ae1ddf74ef7
 )
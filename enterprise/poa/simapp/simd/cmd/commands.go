@@ -122,7 +122,7 @@ func initRootCmd(
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(basicManager, simapp.DefaultNodeHome),
 		debug.Cmd(),
-		NewTestnetCmd(basicManager, banktypes.GenesisBalancesIterator{}),
+		newTestnetCmd(basicManager),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, simapp.DefaultNodeHome),
 		snapshot.Cmd(newApp),
@@ -143,6 +143,14 @@ func initRootCmd(
 	)
 }
 
+// newTestnetCmd builds the `simd testnet` command, including the testnet fork command.
+func newTestnetCmd(basicManager module.BasicManager) *cobra.Command {
+	cmd := NewTestnetCmd(basicManager, banktypes.GenesisBalancesIterator{})
+	server.AddTestnetForkCommand(cmd, newApp, func(*cobra.Command) {})
+
+	return cmd
+}
+
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter
 func genesisCommand(txConfig client.TxConfig, basicManager module.BasicManager, cmds ...*cobra.Command) *cobra.Command {
 	cmd := genutilcli.Commands(txConfig, basicManager, simapp.DefaultNodeHome)
@@ -189,6 +197,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetSignBatchCommand(),
 		authcmd.GetMultiSignCommand(),
 		authcmd.GetMultiSignBatchCmd(),
+		authcmd.GetMultisigSessionCommand(),
 		authcmd.GetValidateSignaturesCommand(),
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
@@ -206,11 +215,20 @@ func newApp(
 	appOpts servertypes.AppOptions,
 ) servertypes.Application {
 	baseappOptions := server.DefaultBaseappOptions(appOpts)
-	return simapp.NewSimApp(
+	app := simapp.NewSimApp(
 		logger, db, true,
 		appOpts,
 		baseappOptions...,
 	)
+
+	// rewrite the local state when starting a testnet fork
+	if spec, ok := appOpts.Get(server.KeyTestnetForkSpec).(*module.TestnetForkSpec); ok {
+		if err := app.TestnetFork(spec); err != nil {
+			panic(err)
+		}
+	}
+
+	return app
 }
 
 // appExport creates a new simapp (optionally at a given height) and exports state.
@@ -0,0 +1,65 @@
+// IMPORTANT LICENSE NOTICE
+//
+// SPDX-License-Identifier: CosmosLabs-Evaluation-Only
+//
+// This file is NOT licensed under the Apache License 2.0.
+//
+// Licensed under the Cosmos Labs Source Available Evaluation License, which forbids:
+// - commercial use,
+// - production use, and
+// - redistribution.
+//
+// See https://github.com/cosmos/cosmos-sdk/blob/main/enterprise/poa/LICENSE for full terms.
+// Copyright (c) 2026 Cosmos Labs US Inc.
+
+package simapp
+
+import (
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	poatypes "github.com/cosmos/cosmos-sdk/enterprise/poa/x/poa/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// TestnetFork rewrites the state of the application for a testnet fork spec.
+// The validators of the spec replace the PoA validator set, with the operator
+// of each validator as its operator address. The changes are written to the
+// latest state, and committed with the first block of the testnet.
+func (app *SimApp) TestnetFork(spec *module.TestnetForkSpec) error {
+	ctx := app.NewUncachedContext(false, cmtproto.Header{
+		ChainID: spec.ChainID,
+		Height:  app.LastBlockHeight(),
+		Time:    time.Now(),
+	})
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	if err := spec.Validate(); err != nil {
+		return err
+	}
+
+	validators := make([]poatypes.Validator, len(spec.Validators))
+	for i, val := range spec.Validators {
+		pubKey, err := codectypes.NewAnyWithValue(val.ConsPubKey)
+		if err != nil {
+			return err
+		}
+
+		validators[i] = poatypes.Validator{
+			PubKey: pubKey,
+			Power:  val.Power,
+			Metadata: &poatypes.ValidatorMetadata{
+				Moniker:         val.Moniker,
+				OperatorAddress: val.Operator,
+			},
+		}
+	}
+
+	if err := app.POAKeeper.ForkValidators(ctx, validators); err != nil {
+		return err
+	}
+
+	return app.ModuleManager.TestnetFork(ctx, spec)
+}
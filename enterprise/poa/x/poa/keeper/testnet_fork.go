@@ -0,0 +1,99 @@
+// IMPORTANT LICENSE NOTICE
+//
+// SPDX-License-Identifier: CosmosLabs-Evaluation-Only
+//
+// This file is NOT licensed under the Apache License 2.0.
+//
+// Licensed under the Cosmos Labs Source Available Evaluation License, which forbids:
+// - commercial use,
+// - production use, and
+// - redistribution.
+//
+// See https://github.com/cosmos/cosmos-sdk/blob/main/enterprise/poa/LICENSE for full terms.
+// Copyright (c) 2026 Cosmos Labs US Inc.
+
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/enterprise/poa/x/poa/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ForkValidators replaces the active validator set when the state of a network
+// is forked into a local testnet. The pending fees are allocated to the current
+// validators first, which keep their accrued fees but lose their power. Unlike
+// CreateValidator and UpdateValidator, no validator update is queued, as the
+// validator set of CometBFT is replaced along with the state.
+func (k *Keeper) ForkValidators(ctx sdk.Context, validators []types.Validator) error {
+	if len(validators) == 0 {
+		return errors.New("at least one validator is required")
+	}
+
+	if err := k.checkpointAllValidators(ctx); err != nil {
+		return err
+	}
+
+	// the validators of the network leave the validator set
+	var active []sdk.ConsAddress
+	err := k.IterateActiveValidators(ctx, func(consAddr sdk.ConsAddress, _ int64, _ types.Validator) (bool, error) {
+		active = append(active, consAddr)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, consAddr := range active {
+		validator, err := k.validators.Get(ctx, consAddr)
+		if err != nil {
+			return err
+		}
+		validator.Power = 0
+		if err := k.validators.Set(ctx, consAddr, validator); err != nil {
+			return err
+		}
+	}
+
+	totalPower := int64(0)
+	for _, validator := range validators {
+		if validator.Power <= 0 {
+			return types.ErrNegativeValidatorPower
+		}
+		if validator.Metadata == nil {
+			return errors.New("validator metadata is required")
+		}
+
+		var pubKey cryptotypes.PubKey
+		if err := k.cdc.UnpackAny(validator.PubKey, &pubKey); err != nil {
+			return err
+		}
+		if err := k.validatePubkeyType(ctx, pubKey); err != nil {
+			return err
+		}
+		if err := k.ValidateOperatorAndConsensusPubKeyDifferent(validator.Metadata.OperatorAddress, validator.PubKey); err != nil {
+			return err
+		}
+
+		// a validator of the network may be part of the testnet
+		consAddr := sdk.GetConsAddress(pubKey)
+		existing, err := k.validators.Get(ctx, consAddr)
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+		case err != nil:
+			return err
+		case existing.Power > 0:
+			return types.ErrValidatorAlreadyExists
+		}
+
+		if err := k.validators.Set(ctx, consAddr, validator); err != nil {
+			return err
+		}
+		totalPower += validator.Power
+	}
+
+	return k.totalPower.Set(ctx, totalPower)
+}
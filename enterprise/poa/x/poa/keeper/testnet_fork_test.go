@@ -0,0 +1,92 @@
+// IMPORTANT LICENSE NOTICE
+//
+// SPDX-License-Identifier: CosmosLabs-Evaluation-Only
+//
+// This file is NOT licensed under the Apache License 2.0.
+//
+// Licensed under the Cosmos Labs Source Available Evaluation License, which forbids:
+// - commercial use,
+// - production use, and
+// - redistribution.
+//
+// See https://github.com/cosmos/cosmos-sdk/blob/main/enterprise/poa/LICENSE for full terms.
+// Copyright (c) 2026 Cosmos Labs US Inc.
+
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/enterprise/poa/x/poa/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newTestValidator(t *testing.T, operator string, power int64) (sdk.ConsAddress, types.Validator) {
+	t.Helper()
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+
+	return sdk.GetConsAddress(pubKey), types.Validator{
+		PubKey: pubKeyAny,
+		Power:  power,
+		Metadata: &types.ValidatorMetadata{
+			Moniker:         operator,
+			OperatorAddress: sdk.AccAddress(operator).String(),
+		},
+	}
+}
+
+func TestForkValidators(t *testing.T) {
+	f := setupTest(t)
+
+	oldConsAddr, oldValidator := newTestValidator(t, "old-operator", 100)
+	require.NoError(t, f.poaKeeper.CreateValidator(f.ctx, oldConsAddr, oldValidator, false))
+	queued := len(f.poaKeeper.ReapValidatorUpdates(f.ctx))
+
+	consAddr1, validator1 := newTestValidator(t, "operator1", 10)
+	consAddr2, validator2 := newTestValidator(t, "operator2", 20)
+	require.NoError(t, f.poaKeeper.ForkValidators(f.ctx, []types.Validator{validator1, validator2}))
+
+	// the validator of the network is kept without power
+	old, err := f.poaKeeper.validators.Get(f.ctx, oldConsAddr)
+	require.NoError(t, err)
+	require.Zero(t, old.Power)
+
+	for consAddr, power := range map[string]int64{consAddr1.String(): 10, consAddr2.String(): 20} {
+		addr, err := sdk.ConsAddressFromBech32(consAddr)
+		require.NoError(t, err)
+		validator, err := f.poaKeeper.validators.Get(f.ctx, addr)
+		require.NoError(t, err)
+		require.Equal(t, power, validator.Power)
+	}
+
+	totalPower, err := f.poaKeeper.GetTotalPower(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), totalPower)
+
+	// no update is queued for CometBFT
+	require.Len(t, f.poaKeeper.ReapValidatorUpdates(f.ctx), queued)
+
+	// a validator of the network can join the testnet
+	oldValidator.Power = 5
+	require.NoError(t, f.poaKeeper.ForkValidators(f.ctx, []types.Validator{oldValidator}))
+	totalPower, err = f.poaKeeper.GetTotalPower(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), totalPower)
+
+	// but not twice
+	err = f.poaKeeper.ForkValidators(f.ctx, []types.Validator{oldValidator, oldValidator})
+	require.ErrorIs(t, err, types.ErrValidatorAlreadyExists)
+
+	_, zeroPower := newTestValidator(t, "operator3", 0)
+	err = f.poaKeeper.ForkValidators(f.ctx, []types.Validator{zeroPower})
+	require.ErrorIs(t, err, types.ErrNegativeValidatorPower)
+
+	require.Error(t, f.poaKeeper.ForkValidators(f.ctx, nil))
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 
@@ -20,6 +21,7 @@ const (
 	FlagForZeroHeight    = "for-zero-height"
 	FlagJailAllowedAddrs = "jail-allowed-addrs"
 	FlagModulesToExport  = "modules-to-export"
+	FlagOutputDir        = "output-dir"
 )
 
 // ExportCmd dumps app state to JSON.
@@ -71,6 +73,10 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 			jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(FlagJailAllowedAddrs)
 			modulesToExport, _ := cmd.Flags().GetStringSlice(FlagModulesToExport)
 			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			outputDir, _ := cmd.Flags().GetString(FlagOutputDir)
+			if outputDir != "" && outputDocument != "" {
+				return fmt.Errorf("--%s and --%s are mutually exclusive", FlagOutputDir, flags.FlagOutputDocument)
+			}
 
 			exported, err := appExporter(serverCtx.Logger, db, height, forZeroHeight, jailAllowedAddrs, serverCtx.Viper, modulesToExport)
 			if err != nil {
@@ -90,6 +96,12 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 			appGenesis.InitialHeight = exported.Height
 			appGenesis.Consensus = genutiltypes.NewConsensusGenesis(exported.ConsensusParams, exported.Validators)
 
+			if outputDir != "" {
+				// the app exporter wrote the modules state to the app state
+				// directory, which the exported app state references
+				return appGenesis.SaveAs(filepath.Join(outputDir, genutiltypes.StreamingGenesisFile))
+			}
+
 			out, err := json.Marshal(appGenesis)
 			if err != nil {
 				return err
@@ -115,6 +127,7 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 	cmd.Flags().StringSlice(FlagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
 	cmd.Flags().StringSlice(FlagModulesToExport, []string{}, "Comma-separated list of modules to export. If empty, will export all modules")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "Exported state is written to the given file instead of STDOUT")
+	cmd.Flags().String(FlagOutputDir, "", "Exported state is written as a streaming genesis to the given directory, one module at a time")
 
 	return cmd
 }
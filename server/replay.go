@@ -0,0 +1,398 @@
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagReplayFrom         = "from"
+	flagReplayTo           = "to"
+	flagTraceKVFile        = "trace-kv-file"
+	flagGasDumpFile        = "gas-dump-file"
+	flagContinueOnMismatch = "continue-on-mismatch"
+)
+
+// ReplayedBlock is a block re-executed by ReplayBlocks.
+type ReplayedBlock struct {
+	Height   int64
+	Request  *abci.RequestFinalizeBlock
+	Response *abci.ResponseFinalizeBlock
+	// AppHash is the application hash committed by the replay of the block.
+	AppHash []byte
+	// ExpectedAppHash is the application hash recorded by the node for the
+	// block, nil when neither the block store nor the state store has it.
+	ExpectedAppHash []byte
+	// Changes are the store writes of the block, only collected when
+	// ReplayOptions.TraceKV is set.
+	Changes []*storetypes.StoreKVPair
+}
+
+// Verified returns whether the expected application hash of the block is known.
+func (b *ReplayedBlock) Verified() bool {
+	return b.ExpectedAppHash != nil
+}
+
+// Mismatch returns whether the replay of the block committed another
+// application hash than the one recorded by the node.
+func (b *ReplayedBlock) Mismatch() bool {
+	return b.Verified() && !bytes.Equal(b.AppHash, b.ExpectedAppHash)
+}
+
+// ReplayHook is called by ReplayBlocks after each replayed block is committed.
+// An error stops the replay.
+type ReplayHook func(block *ReplayedBlock) error
+
+// ReplayOptions are the options of ReplayBlocks.
+type ReplayOptions struct {
+	Hooks []ReplayHook
+	// TraceKV collects the store writes of each block, through listeners of
+	// the stores of the application.
+	TraceKV bool
+	// ContinueOnMismatch continues the replay after an application hash
+	// mismatch rather than returning an error.
+	ContinueOnMismatch bool
+}
+
+// ReplayResult is the outcome of ReplayBlocks.
+type ReplayResult struct {
+	Replayed   int64
+	Mismatches []int64
+}
+
+// ReplayBlocks re-executes the blocks of blockStore from height from+1 up to
+// height to included, driving FinalizeBlock and Commit of app directly, and
+// compares the resulting application hashes with the ones recorded by the
+// node. The application must have committed height from.
+func ReplayBlocks(
+	app types.Application,
+	blockStore sm.BlockStore,
+	stateStore sm.Store,
+	initialHeight, from, to int64,
+	opts ReplayOptions,
+) (*ReplayResult, error) {
+	if to <= from {
+		return nil, fmt.Errorf("nothing to replay from height %d to height %d", from, to)
+	}
+	if height := app.CommitMultiStore().LastCommitID().Version; height != from {
+		return nil, fmt.Errorf("the application state is at height %d, not at height %d: roll it back with rollback --to-height %d, or restore a snapshot of height %d first", height, from, from, from)
+	}
+
+	var cms *rootmulti.Store
+	if opts.TraceKV {
+		var ok bool
+		if cms, ok = app.CommitMultiStore().(*rootmulti.Store); !ok {
+			return nil, fmt.Errorf("expected rootmulti.Store, got %T", app.CommitMultiStore())
+		}
+
+		keys := make([]storetypes.StoreKey, 0, len(cms.StoreKeysByName()))
+		for _, key := range cms.StoreKeysByName() {
+			keys = append(keys, key)
+		}
+		cms.AddListeners(keys)
+		// drop the writes made while loading the application
+		cms.PopStateCache()
+	}
+
+	result := &ReplayResult{}
+	for height := from + 1; height <= to; height++ {
+		block := blockStore.LoadBlock(height)
+		if block == nil {
+			return result, fmt.Errorf("block %d not found in the block store", height)
+		}
+
+		req, err := replayRequest(block, stateStore, initialHeight)
+		if err != nil {
+			return result, err
+		}
+
+		res, err := app.FinalizeBlock(req)
+		if err != nil {
+			return result, fmt.Errorf("failed to finalize block %d: %w", height, err)
+		}
+		if _, err := app.Commit(); err != nil {
+			return result, fmt.Errorf("failed to commit block %d: %w", height, err)
+		}
+
+		replayed := &ReplayedBlock{
+			Height:          height,
+			Request:         req,
+			Response:        res,
+			AppHash:         res.AppHash,
+			ExpectedAppHash: expectedAppHash(blockStore, stateStore, height),
+		}
+		if cms != nil {
+			replayed.Changes = cms.PopStateCache()
+		}
+		result.Replayed++
+
+		for _, hook := range opts.Hooks {
+			if err := hook(replayed); err != nil {
+				return result, fmt.Errorf("replay hook failed at height %d: %w", height, err)
+			}
+		}
+
+		if replayed.Mismatch() {
+			result.Mismatches = append(result.Mismatches, height)
+			if !opts.ContinueOnMismatch {
+				return result, fmt.Errorf("app hash mismatch at height %d: got %X, expected %X", height, replayed.AppHash, replayed.ExpectedAppHash)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// replayRequest builds the FinalizeBlock request of block, as CometBFT does
+// when executing it.
+func replayRequest(block *cmttypes.Block, stateStore sm.Store, initialHeight int64) (*abci.RequestFinalizeBlock, error) {
+	var lastCommit abci.CommitInfo
+	if block.Height > initialHeight {
+		lastValSet, err := stateStore.LoadValidators(block.Height - 1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the validator set of height %d: %w", block.Height-1, err)
+		}
+		if block.LastCommit.Size() != lastValSet.Size() {
+			return nil, fmt.Errorf("last commit of block %d has %d signatures for %d validators", block.Height, block.LastCommit.Size(), lastValSet.Size())
+		}
+		lastCommit = sm.BuildLastCommitInfo(block, lastValSet, initialHeight)
+	}
+
+	return &abci.RequestFinalizeBlock{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  lastCommit,
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+	}, nil
+}
+
+// expectedAppHash returns the application hash recorded by the node after
+// block height: the one of the header of the next block, or the one of the
+// FinalizeBlock response of the block when the next block is not stored.
+func expectedAppHash(blockStore sm.BlockStore, stateStore sm.Store, height int64) []byte {
+	if meta := blockStore.LoadBlockMeta(height + 1); meta != nil {
+		return meta.Header.AppHash
+	}
+
+	res, err := stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil || res == nil {
+		return nil
+	}
+	return res.AppHash
+}
+
+// NewKVTraceHook returns a replay hook writing the store writes of each block
+// to w as JSON lines. It requires ReplayOptions.TraceKV.
+func NewKVTraceHook(w io.Writer) ReplayHook {
+	enc := json.NewEncoder(w)
+	return func(block *ReplayedBlock) error {
+		for _, change := range block.Changes {
+			op := "write"
+			if change.Delete {
+				op = "delete"
+			}
+			if err := enc.Encode(kvTraceEntry{
+				Height:    block.Height,
+				Store:     change.StoreKey,
+				Operation: op,
+				Key:       strings.ToUpper(hex.EncodeToString(change.Key)),
+				Value:     strings.ToUpper(hex.EncodeToString(change.Value)),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+type kvTraceEntry struct {
+	Height    int64  `json:"height"`
+	Store     string `json:"store"`
+	Operation string `json:"operation"`
+	Key       string `json:"key"`
+	Value     string `json:"value,omitempty"`
+}
+
+// NewGasDumpHook returns a replay hook writing the gas used by each
+// transaction of each block to w as JSON lines.
+func NewGasDumpHook(w io.Writer) ReplayHook {
+	enc := json.NewEncoder(w)
+	return func(block *ReplayedBlock) error {
+		for i, tx := range block.Request.Txs {
+			if i >= len(block.Response.TxResults) {
+				break
+			}
+			txRes := block.Response.TxResults[i]
+			if err := enc.Encode(gasDumpEntry{
+				Height:    block.Height,
+				Index:     i,
+				TxHash:    strings.ToUpper(hex.EncodeToString(cmttypes.Tx(tx).Hash())),
+				Code:      txRes.Code,
+				GasWanted: txRes.GasWanted,
+				GasUsed:   txRes.GasUsed,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+type gasDumpEntry struct {
+	Height    int64  `json:"height"`
+	Index     int    `json:"index"`
+	TxHash    string `json:"tx_hash"`
+	Code      uint32 `json:"code"`
+	GasWanted int64  `json:"gas_wanted"`
+	GasUsed   int64  `json:"gas_used"`
+}
+
+// ReplayCmd re-executes blocks of the local CometBFT block store against the
+// local application state, for debugging.
+func ReplayCmd(appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Re-execute blocks of the local block store against the local application state",
+		Long: `Re-execute blocks of the local block store against the local application state.
+The application is loaded at height --from, which must be its latest height, and the
+blocks from --from+1 up to --to are read from the CometBFT block store and executed
+through FinalizeBlock and Commit, without consensus nor networking. The app hash of
+each block is compared with the one recorded by the node.
+
+The state is committed as blocks are replayed: back up the data directory, or roll
+it back afterwards, to replay the same blocks again.
+
+The store writes of each block can be traced to --trace-kv-file, and the gas used by
+each transaction dumped to --gas-dump-file, both as JSON lines. Store writes are not
+traced when the application streams them itself through ABCI listeners.
+
+Nodes should not be running when calling this command.`,
+		Example: fmt.Sprintf(`%[1]s debug replay --to 1200
+%[1]s debug replay --from 1000 --to 1010 --trace-kv-file kv.jsonl --gas-dump-file gas.jsonl`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			from, _ := cmd.Flags().GetInt64(flagReplayFrom)
+			to, _ := cmd.Flags().GetInt64(flagReplayTo)
+			traceKVFile, _ := cmd.Flags().GetString(flagTraceKVFile)
+			gasDumpFile, _ := cmd.Flags().GetString(flagGasDumpFile)
+			continueOnMismatch, _ := cmd.Flags().GetBool(flagContinueOnMismatch)
+
+			db, err := openDB(config.RootDir, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return fmt.Errorf("error opening application DB, make sure the node is not running: %w", err)
+			}
+			app := appCreator(serverCtx.Logger, db, serverCtx.Viper)
+			defer app.Close()
+
+			blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: config})
+			if err != nil {
+				return err
+			}
+			blockStore := store.NewBlockStore(blockStoreDB)
+			defer blockStore.Close()
+
+			stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: config})
+			if err != nil {
+				return err
+			}
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+				DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+			})
+			defer stateStore.Close()
+
+			state, err := stateStore.Load()
+			if err != nil {
+				return err
+			}
+			if state.IsEmpty() {
+				return errors.New("no CometBFT state found, make sure the node has been started")
+			}
+
+			if from == 0 {
+				from = app.CommitMultiStore().LastCommitID().Version
+			}
+			if to == 0 {
+				to = blockStore.Height()
+			}
+			if from < blockStore.Base()-1 {
+				return fmt.Errorf("block %d was pruned from the block store, whose base is %d", from+1, blockStore.Base())
+			}
+
+			opts := ReplayOptions{ContinueOnMismatch: continueOnMismatch}
+			if traceKVFile != "" {
+				f, err := os.Create(traceKVFile)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+
+				opts.TraceKV = true
+				opts.Hooks = append(opts.Hooks, NewKVTraceHook(f))
+			}
+			if gasDumpFile != "" {
+				f, err := os.Create(gasDumpFile)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+
+				opts.Hooks = append(opts.Hooks, NewGasDumpHook(f))
+			}
+			opts.Hooks = append(opts.Hooks, func(block *ReplayedBlock) error {
+				status := "ok"
+				switch {
+				case !block.Verified():
+					status = "unverified"
+				case block.Mismatch():
+					status = fmt.Sprintf("MISMATCH, expected %X", block.ExpectedAppHash)
+				}
+				_, err := fmt.Fprintf(cmd.OutOrStdout(), "height %d: %d txs, app hash %X %s\n", block.Height, len(block.Request.Txs), block.AppHash, status)
+				return err
+			})
+
+			result, err := ReplayBlocks(app, blockStore, stateStore, state.InitialHeight, from, to, opts)
+			if err != nil {
+				return err
+			}
+
+			if len(result.Mismatches) > 0 {
+				return fmt.Errorf("replayed %d blocks, app hash mismatches at heights %v", result.Replayed, result.Mismatches)
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "replayed %d blocks from height %d to height %d\n", result.Replayed, from+1, to)
+			return err
+		},
+	}
+
+	cmd.Flags().Int64(flagReplayFrom, 0, "The height the application is loaded at, defaults to its latest height")
+	cmd.Flags().Int64(flagReplayTo, 0, "The last height replayed, defaults to the latest height of the block store")
+	cmd.Flags().String(flagTraceKVFile, "", "Trace the store writes of each block to this file")
+	cmd.Flags().String(flagGasDumpFile, "", "Dump the gas used by each transaction to this file")
+	cmd.Flags().Bool(flagContinueOnMismatch, false, "Continue replaying blocks after an app hash mismatch")
+
+	return cmd
+}
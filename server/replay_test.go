@@ -0,0 +1,183 @@
+package server_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+)
+
+// replayTestApp stores each transaction of a block as a key of its store.
+type replayTestApp struct {
+	types.Application
+	rs  *rootmulti.Store
+	key *storetypes.KVStoreKey
+}
+
+func newReplayTestApp(t *testing.T) *replayTestApp {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey("test")
+	return &replayTestApp{rs: newStateDiffStore(t, key), key: key}
+}
+
+func (app *replayTestApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	cms := app.rs.CacheMultiStore()
+	res := &abci.ResponseFinalizeBlock{}
+	for _, tx := range req.Txs {
+		cms.GetKVStore(app.key).Set(tx, []byte{byte(req.Height)})
+		res.TxResults = append(res.TxResults, &abci.ExecTxResult{GasWanted: 100, GasUsed: int64(len(tx))})
+	}
+	cms.Write()
+
+	res.AppHash = app.rs.WorkingHash()
+	return res, nil
+}
+
+func (app *replayTestApp) Commit() (*abci.ResponseCommit, error) {
+	app.rs.Commit()
+	return &abci.ResponseCommit{}, nil
+}
+
+func (app *replayTestApp) CommitMultiStore() storetypes.CommitMultiStore {
+	return app.rs
+}
+
+type replayTestBlockStore struct {
+	sm.BlockStore
+	blocks map[int64]*cmttypes.Block
+}
+
+func (s replayTestBlockStore) LoadBlock(height int64) *cmttypes.Block {
+	return s.blocks[height]
+}
+
+func (s replayTestBlockStore) LoadBlockMeta(height int64) *cmttypes.BlockMeta {
+	if block, ok := s.blocks[height]; ok {
+		return &cmttypes.BlockMeta{Header: block.Header}
+	}
+	return nil
+}
+
+// replayTestStateStore has an empty validator set and discards the ABCI responses.
+type replayTestStateStore struct {
+	sm.Store
+}
+
+func (replayTestStateStore) LoadValidators(int64) (*cmttypes.ValidatorSet, error) {
+	return cmttypes.NewValidatorSet(nil), nil
+}
+
+func (replayTestStateStore) LoadFinalizeBlockResponse(int64) (*abci.ResponseFinalizeBlock, error) {
+	return nil, errors.New("ABCI responses discarded")
+}
+
+// replayTestBlocks returns blocks 1 to 4, whose headers record the app hashes
+// of a replayTestApp executing them.
+func replayTestBlocks(t *testing.T) map[int64]*cmttypes.Block {
+	t.Helper()
+
+	app := newReplayTestApp(t)
+	blocks := map[int64]*cmttypes.Block{}
+	var appHash []byte
+	for height := int64(1); height <= 4; height++ {
+		txs := []cmttypes.Tx{[]byte(strings.Repeat("a", int(height))), []byte(strings.Repeat("b", int(height)))}
+		block := cmttypes.MakeBlock(height, txs, &cmttypes.Commit{Height: height - 1}, nil)
+		block.AppHash = appHash
+		blocks[height] = block
+
+		res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Txs: block.Txs.ToSliceOfBytes()})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+		appHash = res.AppHash
+	}
+
+	return blocks
+}
+
+// newReplayedTestApp returns a replayTestApp having executed block 1.
+func newReplayedTestApp(t *testing.T, blocks map[int64]*cmttypes.Block) *replayTestApp {
+	t.Helper()
+
+	app := newReplayTestApp(t)
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: blocks[1].Txs.ToSliceOfBytes()})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	return app
+}
+
+func TestReplayBlocks(t *testing.T) {
+	blocks := replayTestBlocks(t)
+	blockStore := replayTestBlockStore{blocks: blocks}
+
+	var replayed []*server.ReplayedBlock
+	var kvTrace, gasDump bytes.Buffer
+	opts := server.ReplayOptions{
+		TraceKV: true,
+		Hooks: []server.ReplayHook{
+			server.NewKVTraceHook(&kvTrace),
+			server.NewGasDumpHook(&gasDump),
+			func(block *server.ReplayedBlock) error {
+				replayed = append(replayed, block)
+				return nil
+			},
+		},
+	}
+
+	app := newReplayedTestApp(t, blocks)
+	result, err := server.ReplayBlocks(app, blockStore, replayTestStateStore{}, 1, 1, 4, opts)
+	require.NoError(t, err)
+	require.Equal(t, &server.ReplayResult{Replayed: 3}, result)
+
+	require.Len(t, replayed, 3)
+	for i, block := range replayed {
+		require.Equal(t, int64(i+2), block.Height)
+		require.Len(t, block.Changes, 2)
+		require.False(t, block.Mismatch())
+	}
+	// the app hash of the last block is recorded by no header
+	require.True(t, replayed[1].Verified())
+	require.False(t, replayed[2].Verified())
+
+	require.Equal(t, 6, strings.Count(kvTrace.String(), "\n"))
+	require.Contains(t, kvTrace.String(), `{"height":2,"store":"test","operation":"write","key":"6161","value":"02"}`)
+	require.Equal(t, 6, strings.Count(gasDump.String(), "\n"))
+	require.Contains(t, gasDump.String(), `"height":4,"index":1,"tx_hash":"`+fmt.Sprintf("%X", blocks[4].Txs[1].Hash()))
+	require.Contains(t, gasDump.String(), `"code":0,"gas_wanted":100,"gas_used":4}`)
+
+	// the application must be at the height replayed from
+	_, err = server.ReplayBlocks(app, blockStore, replayTestStateStore{}, 1, 1, 4, server.ReplayOptions{})
+	require.ErrorContains(t, err, "the application state is at height 4, not at height 1")
+
+	_, err = server.ReplayBlocks(newReplayedTestApp(t, blocks), blockStore, replayTestStateStore{}, 1, 1, 5, server.ReplayOptions{})
+	require.ErrorContains(t, err, "block 5 not found")
+}
+
+func TestReplayBlocksMismatch(t *testing.T) {
+	blocks := replayTestBlocks(t)
+	blocks[3].AppHash = []byte("wrong")
+	blockStore := replayTestBlockStore{blocks: blocks}
+
+	result, err := server.ReplayBlocks(newReplayedTestApp(t, blocks), blockStore, replayTestStateStore{}, 1, 1, 4, server.ReplayOptions{})
+	require.ErrorContains(t, err, "app hash mismatch at height 2")
+	require.Equal(t, &server.ReplayResult{Replayed: 1, Mismatches: []int64{2}}, result)
+
+	opts := server.ReplayOptions{ContinueOnMismatch: true}
+	result, err = server.ReplayBlocks(newReplayedTestApp(t, blocks), blockStore, replayTestStateStore{}, 1, 1, 4, opts)
+	require.NoError(t, err)
+	require.Equal(t, &server.ReplayResult{Replayed: 3, Mismatches: []int64{2}}, result)
+}
@@ -1,22 +1,36 @@
 package server
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/iavl"
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
 )
 
-// NewRollbackCmd creates a command to rollback CometBFT and multistore state by one height.
+const flagToHeight = "to-height"
+
+// NewRollbackCmd creates a command to rollback CometBFT and multistore state by one height,
+// or to a given height.
 func NewRollbackCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
-	var removeBlock bool
+	var (
+		removeBlock bool
+		toHeight    int64
+	)
 
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback Cosmos SDK and CometBFT state by one height",
+		Short: "rollback Cosmos SDK and CometBFT state by one height, or to a given height",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when CometBFT has persisted an incorrect app hash and is thus unable to make
@@ -24,6 +38,13 @@ progress. Rollback overwrites a state at height n with the state at height n - 1
 The application also rolls back to height n - 1. No blocks are removed, so upon
 restarting CometBFT the transactions in block n will be re-executed against the
 application.
+
+With --to-height, both states are rolled back to the given height, which must still
+be retained by the pruning settings of the application and of CometBFT. The blocks
+above the next height are removed, and the next block too with --hard. Every check
+is done before any state is changed. The application state is rolled back first and
+checked against the app hash recorded by CometBFT, then the CometBFT state; should
+the rollback fail midway, running the same command again completes it.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := GetServerContextFromCmd(cmd)
@@ -34,6 +55,15 @@ application.
 				return err
 			}
 			app := appCreator(ctx.Logger, db, ctx.Viper)
+
+			if toHeight != 0 {
+				summary, err := rollbackToHeight(cfg, app, toHeight, removeBlock)
+				if err != nil {
+					return err
+				}
+				return summary.Print(cmd.OutOrStdout())
+			}
+
 			// rollback CometBFT state
 			height, hash, err := cmtcmd.RollbackState(ctx.Config, removeBlock)
 			if err != nil {
@@ -52,5 +82,240 @@ application.
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.Flags().BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	cmd.Flags().Int64Var(&toHeight, flagToHeight, 0, "Roll back to this height rather than by one height")
 	return cmd
 }
+
+// rollbackToHeight opens the CometBFT stores of cfg, and rolls them back to
+// height together with the application state.
+func rollbackToHeight(cfg *cmtcfg.Config, app types.Application, height int64, removeBlock bool) (*RollbackSummary, error) {
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	blockStore := store.NewBlockStore(blockStoreDB)
+	defer blockStore.Close()
+
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+	defer stateStore.Close()
+
+	return RollbackToHeight(app, blockStore, stateStore, height, removeBlock)
+}
+
+// RollbackSummary describes the heights discarded by RollbackToHeight. Ranges
+// are empty when their last height is lower than their first height.
+type RollbackSummary struct {
+	Height  int64
+	AppHash []byte
+	// CometBFT states and application versions above Height were discarded,
+	// up to these heights.
+	CometHeight int64
+	AppHeight   int64
+	// Blocks from FirstRemovedBlock up to LastRemovedBlock were removed.
+	FirstRemovedBlock int64
+	LastRemovedBlock  int64
+}
+
+// Print writes the summary to w.
+func (s *RollbackSummary) Print(w io.Writer) error {
+	heights := func(from, to int64) string {
+		switch {
+		case to < from:
+			return "none"
+		case to == from:
+			return fmt.Sprintf("height %d", from)
+		default:
+			return fmt.Sprintf("heights %d to %d", from, to)
+		}
+	}
+
+	_, err := fmt.Fprintf(w, `Rolled back state to height %d and hash %X
+discarded CometBFT states: %s
+discarded application versions: %s
+removed blocks: %s
+`,
+		s.Height, s.AppHash,
+		heights(s.Height+1, s.CometHeight),
+		heights(s.Height+1, s.AppHeight),
+		heights(s.FirstRemovedBlock, s.LastRemovedBlock),
+	)
+	return err
+}
+
+// RollbackToHeight rolls the CometBFT state and the application state back to
+// height. The blocks above height+1 are removed, and block height+1 too when
+// removeBlock is set. Every height needed is checked to be retained by both
+// before any state is changed. The rollback is staged: the application state is
+// rolled back first and its app hash verified against the one recorded by
+// CometBFT for height, and the CometBFT state is only changed then. A rollback
+// failing midway is completed by calling RollbackToHeight again.
+func RollbackToHeight(app types.Application, blockStore sm.BlockStore, stateStore sm.Store, height int64, removeBlock bool) (*RollbackSummary, error) {
+	state, err := stateStore.Load()
+	if err != nil {
+		return nil, err
+	}
+	if state.IsEmpty() {
+		return nil, errors.New("no CometBFT state found")
+	}
+
+	summary := &RollbackSummary{
+		Height:            height,
+		CometHeight:       state.LastBlockHeight,
+		AppHeight:         app.CommitMultiStore().LastCommitID().Version,
+		FirstRemovedBlock: height + 2,
+		LastRemovedBlock:  blockStore.Height(),
+	}
+	if removeBlock {
+		summary.FirstRemovedBlock = height + 1
+	}
+	if summary.CometHeight <= height && summary.AppHeight <= height && summary.LastRemovedBlock < summary.FirstRemovedBlock {
+		return nil, fmt.Errorf("nothing to roll back: the CometBFT state is at height %d and the application state at height %d", summary.CometHeight, summary.AppHeight)
+	}
+
+	if err := checkCometRollback(blockStore, stateStore, state, height); err != nil {
+		return nil, fmt.Errorf("cannot roll CometBFT state back to height %d: %w", height, err)
+	}
+	if err := checkAppRollback(app, height); err != nil {
+		return nil, fmt.Errorf("cannot roll application state back to height %d: %w", height, err)
+	}
+
+	// the app hash of height is the one of the header of the next block, or
+	// the one of the state when the CometBFT state is already at height
+	expectedAppHash := []byte(state.AppHash)
+	if state.LastBlockHeight > height {
+		expectedAppHash = blockStore.LoadBlockMeta(height + 1).Header.AppHash
+	}
+	if err := verifyAppHash(app, height, expectedAppHash); err != nil {
+		return nil, fmt.Errorf("cannot roll application state back to height %d: %w", height, err)
+	}
+
+	if summary.AppHeight > height {
+		if err := app.CommitMultiStore().RollbackToVersion(height); err != nil {
+			return nil, fmt.Errorf("failed to rollback to version: %w", err)
+		}
+	}
+	if appHash := app.CommitMultiStore().LastCommitID().Hash; !bytes.Equal(appHash, expectedAppHash) {
+		return nil, fmt.Errorf("the application state rolled back to height %d has app hash %X, CometBFT recorded %X; the CometBFT state was not changed", height, appHash, expectedAppHash)
+	}
+
+	// the CometBFT state is rolled back one height at a time, removing the
+	// blocks above it but the next one unless removeBlock is set
+	for {
+		if state.LastBlockHeight == height {
+			if blockStore.Height() > height+1 || (removeBlock && blockStore.Height() > height) {
+				if err := blockStore.DeleteLatestBlock(); err != nil {
+					return nil, fmt.Errorf("failed to remove block %d: %w", blockStore.Height(), err)
+				}
+				continue
+			}
+			break
+		}
+
+		remove := removeBlock || state.LastBlockHeight > height+1 || blockStore.Height() > state.LastBlockHeight
+		if _, _, err := sm.Rollback(blockStore, stateStore, remove); err != nil {
+			return nil, fmt.Errorf("failed to rollback CometBFT state from height %d: %w", state.LastBlockHeight, err)
+		}
+		if state, err = stateStore.Load(); err != nil {
+			return nil, err
+		}
+	}
+	summary.AppHash = state.AppHash
+
+	return summary, nil
+}
+
+// verifyAppHash checks that the app hash committed by app at height is the
+// expected one.
+func verifyAppHash(app types.Application, height int64, expected []byte) error {
+	rs, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return fmt.Errorf("expected rootmulti.Store, got %T", app.CommitMultiStore())
+	}
+
+	info, err := rs.GetCommitInfo(height)
+	if err != nil {
+		return err
+	}
+	if appHash := info.Hash(); !bytes.Equal(appHash, expected) {
+		return fmt.Errorf("the app hash of height %d is %X, CometBFT recorded %X", height, appHash, expected)
+	}
+
+	return nil
+}
+
+// checkCometRollback checks that the CometBFT block and state stores retain
+// the blocks, validator sets and consensus params needed to roll state back to
+// height.
+func checkCometRollback(blockStore sm.BlockStore, stateStore sm.Store, state sm.State, height int64) error {
+	if height > state.LastBlockHeight {
+		return fmt.Errorf("the state is at height %d", state.LastBlockHeight)
+	}
+	if height < state.InitialHeight {
+		return fmt.Errorf("the initial height is %d", state.InitialHeight)
+	}
+	if base := blockStore.Base(); height < base {
+		return fmt.Errorf("block %d was pruned, the lowest retained block is %d", height, base)
+	}
+
+	for h := height; h <= state.LastBlockHeight; h++ {
+		if blockStore.LoadBlockMeta(h) == nil {
+			return fmt.Errorf("block %d not found", h)
+		}
+		if h == state.LastBlockHeight {
+			break
+		}
+		if _, err := stateStore.LoadValidators(h); err != nil {
+			return fmt.Errorf("validator set of height %d: %w", h, err)
+		}
+		if _, err := stateStore.LoadConsensusParams(h + 1); err != nil {
+			return fmt.Errorf("consensus params of height %d: %w", h+1, err)
+		}
+	}
+
+	return nil
+}
+
+// checkAppRollback checks that every IAVL store of app retains height.
+func checkAppRollback(app types.Application, height int64) error {
+	rs, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return fmt.Errorf("expected rootmulti.Store, got %T", app.CommitMultiStore())
+	}
+
+	if latest := rs.LatestVersion(); height > latest {
+		return fmt.Errorf("the state is at height %d", latest)
+	}
+
+	pruning := rs.GetPruning()
+	if earliest := rs.EarliestVersion(); height < earliest {
+		return fmt.Errorf("height %d was pruned with pruning keep-recent %d, the earliest retained height is %d", height, pruning.KeepRecent, earliest)
+	}
+
+	info, err := rs.GetCommitInfo(height)
+	if err != nil {
+		return fmt.Errorf("height %d is not retained with pruning keep-recent %d: %w", height, pruning.KeepRecent, err)
+	}
+
+	keys := rs.StoreKeysByName()
+	for _, storeInfo := range info.StoreInfos {
+		key, ok := keys[storeInfo.Name]
+		if !ok {
+			continue
+		}
+		store, ok := rs.GetStore(key).(*iavl.Store)
+		if !ok {
+			continue
+		}
+		if !store.VersionExists(height) {
+			return fmt.Errorf("store %s was pruned at height %d with pruning keep-recent %d", storeInfo.Name, height, pruning.KeepRecent)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package server_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/v2/pruning/types"
+)
+
+// newRollbackTestChain returns in-memory CometBFT block and state stores of
+// a chain of the given height, whose blocks are executed by app.
+func newRollbackTestChain(t *testing.T, app *replayTestApp, height int64) (*store.BlockStore, sm.Store) {
+	t.Helper()
+
+	cfg := cmtcfg.TestConfig()
+	cfg.DBBackend = "memdb"
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+	require.NoError(t, err)
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	require.NoError(t, err)
+	blockStore, stateStore := store.NewBlockStore(blockStoreDB), sm.NewStore(stateDB, sm.StoreOptions{})
+
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(ed25519.GenPrivKey().PubKey(), 10)})
+	state := sm.State{
+		Version:                          sm.InitStateVersion,
+		ChainID:                          "test-chain",
+		InitialHeight:                    1,
+		LastBlockTime:                    time.Unix(1700000000, 0).UTC(),
+		Validators:                       valSet,
+		NextValidators:                   valSet.CopyIncrementProposerPriority(1),
+		LastValidators:                   cmttypes.NewValidatorSet(nil),
+		LastHeightValidatorsChanged:      1,
+		ConsensusParams:                  *cmttypes.DefaultConsensusParams(),
+		LastHeightConsensusParamsChanged: 1,
+	}
+	require.NoError(t, stateStore.Save(state))
+
+	for h := int64(1); h <= height; h++ {
+		block := cmttypes.MakeBlock(h, []cmttypes.Tx{[]byte{byte(h)}}, &cmttypes.Commit{Height: h - 1, BlockID: state.LastBlockID}, nil)
+		block.Populate(
+			state.Version.Consensus, state.ChainID,
+			state.LastBlockTime.Add(time.Second), state.LastBlockID,
+			state.Validators.Hash(), state.NextValidators.Hash(),
+			state.ConsensusParams.Hash(), state.AppHash, state.LastResultsHash,
+			state.Validators.Proposer.Address,
+		)
+		parts, err := block.MakePartSet(cmttypes.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := cmttypes.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+		blockStore.SaveBlock(block, parts, &cmttypes.Commit{Height: h, BlockID: blockID})
+
+		res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: h, Txs: block.Txs.ToSliceOfBytes()})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+
+		state.LastBlockHeight = h
+		state.LastBlockID = blockID
+		state.LastBlockTime = block.Time
+		state.LastValidators = state.Validators.Copy()
+		state.Validators = state.NextValidators.Copy()
+		state.NextValidators = state.NextValidators.CopyIncrementProposerPriority(1)
+		state.AppHash = res.AppHash
+		require.NoError(t, stateStore.Save(state))
+	}
+
+	return blockStore, stateStore
+}
+
+func TestRollbackToHeight(t *testing.T) {
+	app := newReplayTestApp(t)
+	blockStore, stateStore := newRollbackTestChain(t, app, 6)
+	appHash, err := app.rs.GetCommitInfo(3)
+	require.NoError(t, err)
+
+	summary, err := server.RollbackToHeight(app, blockStore, stateStore, 3, false)
+	require.NoError(t, err)
+	require.Equal(t, &server.RollbackSummary{
+		Height:            3,
+		AppHash:           appHash.Hash(),
+		CometHeight:       6,
+		AppHeight:         6,
+		FirstRemovedBlock: 5,
+		LastRemovedBlock:  6,
+	}, summary)
+
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), state.LastBlockHeight)
+	require.Equal(t, appHash.Hash(), []byte(state.AppHash))
+	// block 4 is kept to be executed again
+	require.Equal(t, int64(4), blockStore.Height())
+	require.Equal(t, int64(3), app.rs.LatestVersion())
+	require.Equal(t, appHash.Hash(), app.rs.LastCommitID().Hash)
+
+	var buf bytes.Buffer
+	require.NoError(t, summary.Print(&buf))
+	require.Equal(t, fmt.Sprintf(`Rolled back state to height 3 and hash %X
+discarded CometBFT states: heights 4 to 6
+discarded application versions: heights 4 to 6
+removed blocks: heights 5 to 6
+`, appHash.Hash()), buf.String())
+
+	// the next block is removed too with removeBlock
+	summary, err = server.RollbackToHeight(app, blockStore, stateStore, 2, true)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), summary.FirstRemovedBlock)
+	require.Equal(t, int64(4), summary.LastRemovedBlock)
+	require.Equal(t, int64(2), blockStore.Height())
+	require.Equal(t, int64(2), app.rs.LatestVersion())
+
+	_, err = server.RollbackToHeight(app, blockStore, stateStore, 2, true)
+	require.ErrorContains(t, err, "nothing to roll back")
+
+	_, err = server.RollbackToHeight(app, blockStore, stateStore, 0, true)
+	require.ErrorContains(t, err, "the initial height is 1")
+}
+
+func TestRollbackToHeightPruned(t *testing.T) {
+	app := newReplayTestApp(t)
+	app.rs.SetPruning(pruningtypes.NewCustomPruningOptions(2, 1))
+	blockStore, stateStore := newRollbackTestChain(t, app, 6)
+
+	_, err := server.RollbackToHeight(app, blockStore, stateStore, 2, false)
+	require.ErrorContains(t, err, "cannot roll application state back to height 2: height 2 was pruned")
+
+	// nothing was rolled back
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(6), state.LastBlockHeight)
+	require.Equal(t, int64(6), blockStore.Height())
+	require.Equal(t, int64(6), app.rs.LatestVersion())
+}
+
+// failingStateStore fails to save the CometBFT state.
+type failingStateStore struct {
+	sm.Store
+}
+
+func (failingStateStore) Save(sm.State) error {
+	return errors.New("disk full")
+}
+
+// tamperedBlockStore returns the block metas of a block store with another
+// app hash.
+type tamperedBlockStore struct {
+	sm.BlockStore
+}
+
+func (s tamperedBlockStore) LoadBlockMeta(height int64) *cmttypes.BlockMeta {
+	meta := s.BlockStore.LoadBlockMeta(height)
+	if meta != nil {
+		meta.Header.AppHash = []byte("tampered")
+	}
+	return meta
+}
+
+func TestRollbackToHeightFailingMidway(t *testing.T) {
+	app := newReplayTestApp(t)
+	blockStore, stateStore := newRollbackTestChain(t, app, 6)
+	appHash, err := app.rs.GetCommitInfo(3)
+	require.NoError(t, err)
+
+	// the application state is rolled back before the CometBFT state
+	_, err = server.RollbackToHeight(app, blockStore, failingStateStore{stateStore}, 3, false)
+	require.ErrorContains(t, err, "disk full")
+	require.Equal(t, int64(3), app.rs.LatestVersion())
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(6), state.LastBlockHeight)
+
+	// running the rollback again completes it
+	summary, err := server.RollbackToHeight(app, blockStore, stateStore, 3, false)
+	require.NoError(t, err)
+	require.Equal(t, appHash.Hash(), summary.AppHash)
+	state, err = stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), state.LastBlockHeight)
+	require.Equal(t, int64(4), blockStore.Height())
+	require.Equal(t, int64(3), app.rs.LatestVersion())
+	require.Equal(t, appHash.Hash(), app.rs.LastCommitID().Hash)
+}
+
+func TestRollbackToHeightAppHashMismatch(t *testing.T) {
+	app := newReplayTestApp(t)
+	blockStore, stateStore := newRollbackTestChain(t, app, 6)
+
+	_, err := server.RollbackToHeight(app, tamperedBlockStore{blockStore}, stateStore, 3, false)
+	require.ErrorContains(t, err, "cannot roll application state back to height 3: the app hash of height 3 is")
+
+	// nothing was rolled back
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(6), state.LastBlockHeight)
+	require.Equal(t, int64(6), blockStore.Height())
+	require.Equal(t, int64(6), app.rs.LatestVersion())
+}
@@ -20,7 +20,6 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
-	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/proxy"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/cometbft/cometbft/rpc/client/local"
@@ -50,6 +49,7 @@ import (
 	pruningtypes "github.com/cosmos/cosmos-sdk/store/v2/pruning/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
@@ -117,6 +117,7 @@ const (
 	KeyNewValAddr            = "new-validator-addr"
 	KeyUserPubKey            = "user-pub-key"
 	KeyTriggerTestnetUpgrade = "trigger-testnet-upgrade"
+	KeyTestnetForkSpec       = "testnet-fork-spec"
 )
 
 // StartCmdOptions defines options that can be customized in `StartCmdWithOptions`,
@@ -695,47 +696,13 @@ you want to test the upgrade handler itself.
 				return err
 			}
 
-			withCMT, _ := cmd.Flags().GetBool(flagWithComet)
-			if !withCMT {
-				serverCtx.Logger.Info("starting ABCI without CometBFT")
-			}
-
 			newChainID := args[0]
 			newOperatorAddress := args[1]
 
-			skipConfirmation, _ := cmd.Flags().GetBool("skip-confirmation")
-
-			if !skipConfirmation {
-				// Confirmation prompt to prevent accidental modification of state.
-				reader := bufio.NewReader(os.Stdin)
-				fmt.Println("This operation will modify state in your data folder and cannot be undone. Do you want to continue? (y/n)")
-				text, _ := reader.ReadString('\n')
-				response := strings.TrimSpace(strings.ToLower(text))
-				if response != "y" && response != "yes" {
-					fmt.Println("Operation canceled.")
-					return nil
-				}
-			}
-
-			// Set testnet keys to be used by the application.
-			// This is done to prevent changes to existing start API.
-			serverCtx.Viper.Set(KeyIsTestnet, true)
-			serverCtx.Viper.Set(KeyNewChainID, newChainID)
-			serverCtx.Viper.Set(KeyNewOpAddr, newOperatorAddress)
-
-			err = wrapCPUProfile(serverCtx, func() error {
-				return opts.StartCommandHandler(serverCtx, clientCtx, testnetAppCreator, withCMT, opts)
+			return startTestnet(cmd, serverCtx, clientCtx, testnetAppCreator, opts, func() {
+				serverCtx.Viper.Set(KeyNewChainID, newChainID)
+				serverCtx.Viper.Set(KeyNewOpAddr, newOperatorAddress)
 			})
-
-			serverCtx.Logger.Debug("received quit signal")
-			graceDuration, _ := cmd.Flags().GetDuration(FlagShutdownGrace)
-			if graceDuration > 0 {
-				serverCtx.Logger.Info("graceful shutdown start", FlagShutdownGrace, graceDuration)
-				<-time.After(graceDuration)
-				serverCtx.Logger.Info("graceful shutdown complete")
-			}
-
-			return err
 		},
 	}
 
@@ -745,6 +712,48 @@ you want to test the upgrade handler itself.
 	return cmd
 }
 
+// startTestnet asks for confirmation, unless skipped, before starting a testnet
+// from the local state. setKeys sets the testnet keys used by the application.
+func startTestnet(cmd *cobra.Command, serverCtx *Context, clientCtx client.Context, testnetAppCreator types.AppCreator, opts StartCmdOptions, setKeys func()) error {
+	withCMT, _ := cmd.Flags().GetBool(flagWithComet)
+	if !withCMT {
+		serverCtx.Logger.Info("starting ABCI without CometBFT")
+	}
+
+	skipConfirmation, _ := cmd.Flags().GetBool("skip-confirmation")
+
+	if !skipConfirmation {
+		// Confirmation prompt to prevent accidental modification of state.
+		reader := bufio.NewReader(os.Stdin)
+		fmt.Println("This operation will modify state in your data folder and cannot be undone. Do you want to continue? (y/n)")
+		text, _ := reader.ReadString('\n')
+		response := strings.TrimSpace(strings.ToLower(text))
+		if response != "y" && response != "yes" {
+			fmt.Println("Operation canceled.")
+			return nil
+		}
+	}
+
+	// Set testnet keys to be used by the application.
+	// This is done to prevent changes to existing start API.
+	serverCtx.Viper.Set(KeyIsTestnet, true)
+	setKeys()
+
+	err := wrapCPUProfile(serverCtx, func() error {
+		return opts.StartCommandHandler(serverCtx, clientCtx, testnetAppCreator, withCMT, opts)
+	})
+
+	serverCtx.Logger.Debug("received quit signal")
+	graceDuration, _ := cmd.Flags().GetDuration(FlagShutdownGrace)
+	if graceDuration > 0 {
+		serverCtx.Logger.Info("graceful shutdown start", FlagShutdownGrace, graceDuration)
+		<-time.After(graceDuration)
+		serverCtx.Logger.Info("graceful shutdown complete")
+	}
+
+	return err
+}
+
 // testnetify modifies both state and blockStore, allowing the provided operator address and local validator key to control the network
 // that the state in the data folder represents. The chainID of the local genesis file is modified to match the provided chainID.
 func testnetify(ctx *Context, testnetAppCreator types.AppCreator, db dbm.DB) (types.Application, error) {
@@ -884,57 +893,35 @@ func testnetify(ctx *Context, testnetAppCreator types.AppCreator, db dbm.DB) (ty
 	block.LastBlockID = state.LastBlockID
 	block.LastCommit.BlockID = state.LastBlockID
 
-	// Create a vote from our validator
-	vote := cmttypes.Vote{
-		Type:             cmtproto.PrecommitType,
-		Height:           state.LastBlockHeight,
-		Round:            0,
-		BlockID:          state.LastBlockID,
-		Timestamp:        time.Now(),
-		ValidatorAddress: validatorAddress,
-		ValidatorIndex:   0,
-		Signature:        []byte{},
-	}
-
-	// Sign the vote and copy the proto changes from the act of signing to the vote itself
-	voteProto := vote.ToProto()
-	err = privValidator.SignVote(newChainID, voteProto)
+	// The network is controlled by our validator, or by the validators of the
+	// testnet fork spec if any.
+	validators := []testnetValidator{{privKey: privValidator.Key.PrivKey, power: 900000000000000}}
+	if spec, ok := ctx.Viper.Get(KeyTestnetForkSpec).(*module.TestnetForkSpec); ok {
+		validators, err = loadTestnetForkValidators(spec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newValSet, commitSigs, err := signTestnetCommit(newChainID, state, validators)
 	if err != nil {
 		return nil, err
 	}
-	vote.Signature = voteProto.Signature
-	vote.Timestamp = voteProto.Timestamp
 
-	// Modify the block's lastCommit to be signed only by our validator
-	block.LastCommit.Signatures[0].ValidatorAddress = validatorAddress
-	block.LastCommit.Signatures[0].Signature = vote.Signature
-	block.LastCommit.Signatures = []cmttypes.CommitSig{block.LastCommit.Signatures[0]}
+	// Modify the block's lastCommit to be signed only by our validators
+	block.LastCommit.Signatures = commitSigs
 
-	// Load the seenCommit of the lastBlockHeight and modify it to be signed from our validator
+	// Load the seenCommit of the lastBlockHeight and modify it to be signed from our validators
 	seenCommit := blockStore.LoadSeenCommit(state.LastBlockHeight)
 	seenCommit.BlockID = state.LastBlockID
-	seenCommit.Round = vote.Round
-	seenCommit.Signatures[0].Signature = vote.Signature
-	seenCommit.Signatures[0].ValidatorAddress = validatorAddress
-	seenCommit.Signatures[0].Timestamp = vote.Timestamp
-	seenCommit.Signatures = []cmttypes.CommitSig{seenCommit.Signatures[0]}
+	seenCommit.Round = 0
+	seenCommit.Signatures = commitSigs
 	err = blockStore.SaveSeenCommit(state.LastBlockHeight, seenCommit)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create ValidatorSet struct containing just our validator.
-	newVal := &cmttypes.Validator{
-		Address:     validatorAddress,
-		PubKey:      userPubKey,
-		VotingPower: 900000000000000,
-	}
-	newValSet := &cmttypes.ValidatorSet{
-		Validators: []*cmttypes.Validator{newVal},
-		Proposer:   newVal,
-	}
-
-	// Replace all valSets in state to be the valSet with just our validator.
+	// Replace all valSets in state to be the valSet of our validators.
 	state.Validators = newValSet
 	state.LastValidators = newValSet
 	state.NextValidators = newValSet
@@ -0,0 +1,463 @@
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/schema"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/iavl"
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagHomeB   = "home-b"
+	flagHeightA = "height-a"
+	flagHeightB = "height-b"
+	flagStores  = "stores"
+	flagMaxKeys = "max-keys"
+)
+
+// Statuses of a key in a state diff.
+const (
+	KeyChanged = "changed"
+	KeyOnlyA   = "only-a"
+	KeyOnlyB   = "only-b"
+)
+
+// HasStoreSchemas is implemented by applications exposing the collections
+// schemas of their modules by store name, which state-diff uses to decode the
+// keys and values of their stores.
+type HasStoreSchemas interface {
+	StoreSchemas() map[string]collections.Schema
+}
+
+// StateDiff is the difference between the application state at two heights,
+// possibly of two nodes.
+type StateDiff struct {
+	HeightA  int64       `json:"height_a"`
+	HeightB  int64       `json:"height_b"`
+	AppHashA string      `json:"app_hash_a"`
+	AppHashB string      `json:"app_hash_b"`
+	Stores   []StoreDiff `json:"stores"`
+}
+
+// StoreDiff is the difference between two versions of a store. A store missing
+// from one of the states has no hash in that state and no keys listed.
+type StoreDiff struct {
+	Name      string    `json:"name"`
+	HashA     string    `json:"hash_a,omitempty"`
+	HashB     string    `json:"hash_b,omitempty"`
+	Keys      []KeyDiff `json:"keys,omitempty"`
+	Truncated bool      `json:"truncated,omitempty"`
+}
+
+// KeyDiff is a key of a store whose value differs between two states. The key
+// and values are decoded through the collections schema of the store when
+// possible, and hex encoded otherwise.
+type KeyDiff struct {
+	Status     string `json:"status"`
+	Key        string `json:"key"`
+	Collection string `json:"collection,omitempty"`
+	DecodedKey string `json:"decoded_key,omitempty"`
+	ValueA     string `json:"value_a,omitempty"`
+	ValueB     string `json:"value_b,omitempty"`
+}
+
+// StateDiffOptions are the options of DiffStates.
+type StateDiffOptions struct {
+	// Stores restricts the comparison to the named stores, when not empty.
+	Stores []string
+	// MaxKeys is the maximum number of differing keys listed by store, 0
+	// listing them all.
+	MaxKeys int
+	// Schemas are the collections schemas of the stores by store name.
+	Schemas map[string]collections.Schema
+}
+
+// StateDiffCmd compares the application state of two heights of a node, or of
+// two nodes, store by store.
+func StateDiffCmd(appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff",
+		Short: "Compare the application state of two heights or two nodes, store by store",
+		Long: `Compare the application state of two heights or two nodes, store by store.
+The state of the node of --home at --height-a is compared with the state of the node
+of --home-b, or of the same node, at --height-b. Heights default to the latest height
+of each node.
+
+Only the stores whose hashes differ are walked, and their differing keys listed.
+Keys and values are decoded through the collections schemas of the modules when the
+application exposes them, and hex encoded otherwise.
+
+Nodes should not be running when calling this command.`,
+		Example: fmt.Sprintf(`%[1]s debug state-diff --height-a 100 --height-b 99
+%[1]s debug state-diff --home-b ~/.simapp-b --stores bank,staking --output json`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			homeB, _ := cmd.Flags().GetString(flagHomeB)
+			heightA, _ := cmd.Flags().GetInt64(flagHeightA)
+			heightB, _ := cmd.Flags().GetInt64(flagHeightB)
+			stores, _ := cmd.Flags().GetStringSlice(flagStores)
+			maxKeys, _ := cmd.Flags().GetInt(flagMaxKeys)
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+			if output != flags.OutputFormatText && output != flags.OutputFormatJSON {
+				return fmt.Errorf("unsupported output format %s", output)
+			}
+
+			appA, err := openStateDiffApp(serverCtx, appCreator, serverCtx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer appA.Close()
+
+			appB := appA
+			if homeB != "" && homeB != serverCtx.Config.RootDir {
+				if appB, err = openStateDiffApp(serverCtx, appCreator, homeB); err != nil {
+					return err
+				}
+				defer appB.Close()
+			}
+
+			storeA, ok := appA.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("expected rootmulti.Store, got %T", appA.CommitMultiStore())
+			}
+			storeB, ok := appB.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("expected rootmulti.Store, got %T", appB.CommitMultiStore())
+			}
+			if heightA == 0 {
+				heightA = storeA.LatestVersion()
+			}
+			if heightB == 0 {
+				heightB = storeB.LatestVersion()
+			}
+			if appA == appB && heightA == heightB {
+				return errors.New("nothing to compare: set --home-b, or different --height-a and --height-b")
+			}
+
+			opts := StateDiffOptions{Stores: stores, MaxKeys: maxKeys}
+			if app, ok := appA.(HasStoreSchemas); ok {
+				opts.Schemas = app.StoreSchemas()
+			}
+
+			diff, err := DiffStates(storeA, heightA, storeB, heightB, opts)
+			if err != nil {
+				return err
+			}
+
+			if output == flags.OutputFormatJSON {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(diff)
+			}
+
+			return diff.Print(cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().String(flagHomeB, "", "The application home directory of the second node, defaults to the first node")
+	cmd.Flags().Int64(flagHeightA, 0, "The height of the first state, defaults to the latest height")
+	cmd.Flags().Int64(flagHeightB, 0, "The height of the second state, defaults to the latest height")
+	cmd.Flags().StringSlice(flagStores, nil, "Compare only these stores")
+	cmd.Flags().Int(flagMaxKeys, 100, "Maximum number of differing keys listed by store, 0 listing them all")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+func openStateDiffApp(svrCtx *Context, appCreator types.AppCreator, home string) (types.Application, error) {
+	db, err := openDB(home, GetAppDBBackend(svrCtx.Viper))
+	if err != nil {
+		return nil, fmt.Errorf("error opening DB of %s, make sure the node is not running: %w", home, err)
+	}
+
+	return appCreator(svrCtx.Logger, db, svrCtx.Viper), nil
+}
+
+// DiffStates compares the state of a at heightA with the state of b at
+// heightB, which may be the same store. Only the IAVL stores whose hashes
+// differ are walked.
+func DiffStates(a *rootmulti.Store, heightA int64, b *rootmulti.Store, heightB int64, opts StateDiffOptions) (*StateDiff, error) {
+	infoA, err := a.GetCommitInfo(heightA)
+	if err != nil {
+		return nil, fmt.Errorf("height %d of the first state: %w", heightA, err)
+	}
+	infoB, err := b.GetCommitInfo(heightB)
+	if err != nil {
+		return nil, fmt.Errorf("height %d of the second state: %w", heightB, err)
+	}
+
+	diff := &StateDiff{
+		HeightA:  heightA,
+		HeightB:  heightB,
+		AppHashA: strings.ToUpper(hex.EncodeToString(infoA.Hash())),
+		AppHashB: strings.ToUpper(hex.EncodeToString(infoB.Hash())),
+	}
+
+	hashesA, hashesB := storeHashes(infoA), storeHashes(infoB)
+	var names []string
+	for name := range hashesA {
+		names = append(names, name)
+	}
+	for name := range hashesB {
+		if _, ok := hashesA[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if len(opts.Stores) > 0 && !slices.Contains(opts.Stores, name) {
+			continue
+		}
+
+		hashA, okA := hashesA[name]
+		hashB, okB := hashesB[name]
+		if okA && okB && bytes.Equal(hashA, hashB) {
+			continue
+		}
+
+		storeDiff := StoreDiff{
+			Name:  name,
+			HashA: strings.ToUpper(hex.EncodeToString(hashA)),
+			HashB: strings.ToUpper(hex.EncodeToString(hashB)),
+		}
+		if okA && okB {
+			kvA, err := loadStoreVersion(a, name, heightA)
+			if err != nil {
+				return nil, err
+			}
+			kvB, err := loadStoreVersion(b, name, heightB)
+			if err != nil {
+				return nil, err
+			}
+
+			storeSchema, ok := opts.Schemas[name]
+			storeDiff.Keys, storeDiff.Truncated = diffKVStores(kvA, kvB, newStateDecoder(storeSchema, ok), opts.MaxKeys)
+		}
+
+		diff.Stores = append(diff.Stores, storeDiff)
+	}
+
+	return diff, nil
+}
+
+// Print prints the state diff as text.
+func (d *StateDiff) Print(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "A: height %d, app hash %s\n", d.HeightA, d.AppHashA)
+	fmt.Fprintf(&b, "B: height %d, app hash %s\n", d.HeightB, d.AppHashB)
+	if len(d.Stores) == 0 {
+		b.WriteString("no differences\n")
+	}
+
+	for _, store := range d.Stores {
+		switch {
+		case store.HashA == "":
+			fmt.Fprintf(&b, "\nstore %s: only in B\n", store.Name)
+			continue
+		case store.HashB == "":
+			fmt.Fprintf(&b, "\nstore %s: only in A\n", store.Name)
+			continue
+		}
+
+		fmt.Fprintf(&b, "\nstore %s: hash %s != %s\n", store.Name, store.HashA, store.HashB)
+		for _, key := range store.Keys {
+			fmt.Fprintf(&b, "  %-7s %s", key.Status, key.Key)
+			if key.Collection != "" {
+				fmt.Fprintf(&b, " (%s", key.Collection)
+				if key.DecodedKey != "" {
+					fmt.Fprintf(&b, " %s", key.DecodedKey)
+				}
+				b.WriteString(")")
+			}
+			b.WriteString("\n")
+			if key.Status != KeyOnlyB {
+				fmt.Fprintf(&b, "    A: %s\n", key.ValueA)
+			}
+			if key.Status != KeyOnlyA {
+				fmt.Fprintf(&b, "    B: %s\n", key.ValueB)
+			}
+		}
+		if store.Truncated {
+			b.WriteString("  ...\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func storeHashes(info *storetypes.CommitInfo) map[string][]byte {
+	hashes := make(map[string][]byte, len(info.StoreInfos))
+	for _, storeInfo := range info.StoreInfos {
+		hashes[storeInfo.Name] = storeInfo.CommitId.Hash
+	}
+
+	return hashes
+}
+
+// loadStoreVersion loads the named IAVL store of rs at the given version.
+func loadStoreVersion(rs *rootmulti.Store, name string, version int64) (storetypes.KVStore, error) {
+	key, ok := rs.StoreKeysByName()[name]
+	if !ok {
+		return nil, fmt.Errorf("store %s is not mounted", name)
+	}
+
+	store, ok := rs.GetStore(key).(*iavl.Store)
+	if !ok {
+		return nil, fmt.Errorf("store %s is not an IAVL store", name)
+	}
+
+	immutable, err := store.GetImmutable(version)
+	if err != nil {
+		return nil, fmt.Errorf("store %s at height %d: %w", name, version, err)
+	}
+
+	return immutable, nil
+}
+
+// diffKVStores walks a and b in key order, and returns their differing keys,
+// up to maxKeys when positive.
+func diffKVStores(a, b storetypes.KVStore, decoder stateDecoder, maxKeys int) (keys []KeyDiff, truncated bool) {
+	iterA := a.Iterator(nil, nil)
+	defer iterA.Close()
+	iterB := b.Iterator(nil, nil)
+	defer iterB.Close()
+
+	for iterA.Valid() || iterB.Valid() {
+		var cmp int
+		switch {
+		case !iterA.Valid():
+			cmp = 1
+		case !iterB.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(iterA.Key(), iterB.Key())
+		}
+
+		var key, valueA, valueB []byte
+		switch {
+		case cmp < 0:
+			key, valueA = iterA.Key(), iterA.Value()
+			iterA.Next()
+		case cmp > 0:
+			key, valueB = iterB.Key(), iterB.Value()
+			iterB.Next()
+		default:
+			key, valueA, valueB = iterA.Key(), iterA.Value(), iterB.Value()
+			iterA.Next()
+			iterB.Next()
+			if bytes.Equal(valueA, valueB) {
+				continue
+			}
+		}
+
+		if maxKeys > 0 && len(keys) == maxKeys {
+			return keys, true
+		}
+
+		status := KeyChanged
+		if valueB == nil {
+			status = KeyOnlyA
+		} else if valueA == nil {
+			status = KeyOnlyB
+		}
+		keys = append(keys, decoder.decode(status, key, valueA, valueB))
+	}
+
+	return keys, false
+}
+
+// stateDecoder decodes keys and values through a collections schema.
+type stateDecoder struct {
+	colls     []collections.Collection
+	kvDecoder schema.KVDecoder
+}
+
+func newStateDecoder(s collections.Schema, ok bool) stateDecoder {
+	if !ok {
+		return stateDecoder{}
+	}
+
+	decoder := stateDecoder{colls: s.ListCollections()}
+	// keys are decoded by the module codec, which not every schema supports,
+	// e.g. when the fields of its composite keys are not named
+	if moduleCodec, err := s.ModuleCodec(collections.IndexingOptions{}); err == nil {
+		decoder.kvDecoder = moduleCodec.KVDecoder
+	}
+
+	return decoder
+}
+
+func (d stateDecoder) decode(status string, key, valueA, valueB []byte) KeyDiff {
+	keyDiff := KeyDiff{
+		Status: status,
+		Key:    strings.ToUpper(hex.EncodeToString(key)),
+		ValueA: strings.ToUpper(hex.EncodeToString(valueA)),
+		ValueB: strings.ToUpper(hex.EncodeToString(valueB)),
+	}
+
+	// the collection with the longest matching prefix owns the key
+	var coll collections.Collection
+	for _, c := range d.colls {
+		if bytes.HasPrefix(key, c.GetPrefix()) && (coll == nil || len(c.GetPrefix()) > len(coll.GetPrefix())) {
+			coll = c
+		}
+	}
+	if coll == nil {
+		return keyDiff
+	}
+	keyDiff.Collection = coll.GetName()
+
+	// the key of an item is its prefix
+	if d.kvDecoder != nil && len(key) > len(coll.GetPrefix()) {
+		value := valueA
+		if value == nil {
+			value = valueB
+		}
+		if updates, err := d.kvDecoder(schema.KVPairUpdate{Key: key, Value: value}); err == nil && len(updates) == 1 {
+			keyDiff.DecodedKey = fmt.Sprint(updates[0].Key)
+		}
+	}
+
+	if valueA != nil {
+		keyDiff.ValueA = stringifyValue(coll, valueA)
+	}
+	if valueB != nil {
+		keyDiff.ValueB = stringifyValue(coll, valueB)
+	}
+
+	return keyDiff
+}
+
+// stringifyValue decodes a value of the collection, falling back to hex.
+func stringifyValue(coll collections.Collection, value []byte) string {
+	v, err := coll.ValueCodec().Decode(value)
+	if err != nil {
+		return strings.ToUpper(hex.EncodeToString(value))
+	}
+	s, err := coll.ValueCodec().Stringify(v)
+	if err != nil {
+		return strings.ToUpper(hex.EncodeToString(value))
+	}
+
+	return s
+}
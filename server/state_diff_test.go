@@ -0,0 +1,107 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+)
+
+func newStateDiffStore(t *testing.T, keys ...*storetypes.KVStoreKey) *rootmulti.Store {
+	t.Helper()
+
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	for _, key := range keys {
+		rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, rs.LoadLatestVersion())
+
+	return rs
+}
+
+func TestDiffStates(t *testing.T) {
+	bankKey, stakingKey := storetypes.NewKVStoreKey("bank"), storetypes.NewKVStoreKey("staking")
+
+	sb := collections.NewSchemaBuilderFromAccessor(func(context.Context) corestore.KVStore { return nil })
+	balances := collections.NewMap(sb, collections.NewPrefix(1), "balances", collections.StringKey, collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	balanceKey := func(addr string) []byte {
+		key, err := collections.EncodeKeyWithPrefix(balances.GetPrefix(), collections.StringKey, addr)
+		require.NoError(t, err)
+		return key
+	}
+	balance := func(amount uint64) []byte {
+		value, err := collections.Uint64Value.Encode(amount)
+		require.NoError(t, err)
+		return value
+	}
+
+	rs := newStateDiffStore(t, bankKey, stakingKey)
+	bank, staking := rs.GetKVStore(bankKey), rs.GetKVStore(stakingKey)
+	bank.Set(balanceKey("alice"), balance(100))
+	bank.Set(balanceKey("bob"), balance(50))
+	staking.Set([]byte{0x21, 0x01}, []byte{0x01})
+	rs.Commit()
+
+	bank.Set(balanceKey("alice"), balance(90))
+	bank.Delete(balanceKey("bob"))
+	bank.Set(balanceKey("carol"), balance(10))
+	bank.Set([]byte{0x02}, []byte{0xAB})
+	rs.Commit()
+
+	opts := server.StateDiffOptions{Schemas: map[string]collections.Schema{"bank": schema}}
+	diff, err := server.DiffStates(rs, 1, rs, 2, opts)
+	require.NoError(t, err)
+	require.Len(t, diff.Stores, 1)
+
+	// the staking store did not change
+	store := diff.Stores[0]
+	require.Equal(t, "bank", store.Name)
+	require.NotEqual(t, store.HashA, store.HashB)
+	require.Equal(t, []server.KeyDiff{
+		{Status: server.KeyChanged, Key: "01616C696365", Collection: "balances", DecodedKey: "alice", ValueA: "100", ValueB: "90"},
+		{Status: server.KeyOnlyA, Key: "01626F62", Collection: "balances", DecodedKey: "bob", ValueA: "50"},
+		{Status: server.KeyOnlyB, Key: "016361726F6C", Collection: "balances", DecodedKey: "carol", ValueB: "10"},
+		{Status: server.KeyOnlyB, Key: "02", ValueB: "AB"},
+	}, store.Keys)
+
+	var buf bytes.Buffer
+	require.NoError(t, diff.Print(&buf))
+	require.Contains(t, buf.String(), "  changed 01616C696365 (balances alice)\n    A: 100\n    B: 90\n")
+
+	opts.MaxKeys = 2
+	diff, err = server.DiffStates(rs, 1, rs, 2, opts)
+	require.NoError(t, err)
+	require.Len(t, diff.Stores[0].Keys, 2)
+	require.True(t, diff.Stores[0].Truncated)
+
+	// a store missing from one of the states is listed without keys
+	other := newStateDiffStore(t, stakingKey)
+	other.GetKVStore(stakingKey).Set([]byte{0x21, 0x01}, []byte{0x01})
+	other.Commit()
+	diff, err = server.DiffStates(rs, 1, other, 1, server.StateDiffOptions{})
+	require.NoError(t, err)
+	require.Len(t, diff.Stores, 1)
+	require.Equal(t, "bank", diff.Stores[0].Name)
+	require.Empty(t, diff.Stores[0].HashB)
+	require.Empty(t, diff.Stores[0].Keys)
+
+	buf.Reset()
+	require.NoError(t, diff.Print(&buf))
+	require.Contains(t, buf.String(), "store bank: only in A")
+
+	_, err = server.DiffStates(rs, 3, rs, 2, opts)
+	require.ErrorContains(t, err, "height 3 of the first state")
+}
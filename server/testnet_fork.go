@@ -0,0 +1,226 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	pvm "github.com/cometbft/cometbft/privval"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// testnetValidator is a validator controlling a testnet created from local state.
+type testnetValidator struct {
+	privKey crypto.PrivKey
+	power   int64
+}
+
+// TestnetForkCmd creates and starts a testnet from the current local state,
+// rewritten according to a YAML testnet fork spec: the validator set is replaced
+// by the validators of the spec, and the modules implementing
+// module.HasTestnetFork apply the account balances and params overrides.
+func TestnetForkCmd(testnetAppCreator types.AppCreator) *cobra.Command {
+	opts := StartCmdOptions{
+		DBOpener:            openDB,
+		StartCommandHandler: start,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "fork [spec-file]",
+		Short: "Create and start a testnet from current local state, described by a YAML spec",
+		Long: `Create and start a testnet from current local state, described by a YAML spec.
+Unlike in-place-testnet, the validator set is replaced by any number of validators,
+each signing with its own CometBFT private validator key, which suits both staking
+and proof-of-authority chains. The spec can also override account balances and
+module params, so that e.g. governance proposals pass in minutes.
+
+The operator of each validator must hold the tokens of its self-delegation on
+staking chains, which can be provided through the accounts of the spec.
+Key files are resolved relative to the directory of the spec.
+
+Example spec:
+
+  chain_id: mainnet-fork-1
+  validators:
+    - moniker: val1
+      operator: cosmos1...
+      power: 100
+      priv_validator_key_file: val1/priv_validator_key.json
+  accounts:
+    - address: cosmos1...
+      balance: 1000000000stake
+  params:
+    gov:
+      voting_period: 60s
+
+After utilizing this command the network will start. If the network is stopped,
+the normal "start" command should be used.
+`,
+		Example: "fork testnet.yaml",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			_, err := GetPruningOptionsFromFlags(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spec, err := LoadTestnetForkSpec(args[0])
+			if err != nil {
+				return err
+			}
+
+			return startTestnet(cmd, serverCtx, clientCtx, testnetAppCreator, opts, func() {
+				serverCtx.Viper.Set(KeyNewChainID, spec.ChainID)
+				serverCtx.Viper.Set(KeyTestnetForkSpec, spec)
+			})
+		},
+	}
+
+	addStartNodeFlags(cmd, opts)
+	cmd.Flags().String(KeyTriggerTestnetUpgrade, "", "If set (example: \"v21\"), triggers the v21 upgrade handler to run on the first block of the testnet")
+	cmd.Flags().Bool("skip-confirmation", false, "Skip the confirmation prompt")
+	return cmd
+}
+
+// LoadTestnetForkSpec reads and validates a YAML testnet fork spec, and loads
+// the consensus public keys of its validators from their key files.
+func LoadTestnetForkSpec(path string) (*module.TestnetForkSpec, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &module.TestnetForkSpec{}
+	if err := yaml.UnmarshalStrict(bz, spec); err != nil {
+		return nil, fmt.Errorf("failed to parse testnet fork spec %s: %w", path, err)
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid testnet fork spec %s: %w", path, err)
+	}
+
+	consAddrs := map[string]bool{}
+	for i := range spec.Validators {
+		val := &spec.Validators[i]
+		if !filepath.IsAbs(val.PrivValidatorKeyFile) {
+			val.PrivValidatorKeyFile = filepath.Join(filepath.Dir(path), val.PrivValidatorKeyFile)
+		}
+
+		pvKey, err := loadPrivValidatorKey(val.PrivValidatorKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("validator %s: %w", val.Moniker, err)
+		}
+		if consAddrs[string(pvKey.Address)] {
+			return nil, fmt.Errorf("validator %s: duplicate consensus key %s", val.Moniker, pvKey.Address)
+		}
+		consAddrs[string(pvKey.Address)] = true
+
+		if val.ConsPubKey, err = cryptocodec.FromCmtPubKeyInterface(pvKey.PubKey); err != nil {
+			return nil, fmt.Errorf("validator %s: %w", val.Moniker, err)
+		}
+	}
+
+	return spec, nil
+}
+
+// loadPrivValidatorKey reads a CometBFT private validator key file. Unlike
+// privval.LoadFilePV, it returns an error rather than exiting on failure.
+func loadPrivValidatorKey(path string) (pvm.FilePVKey, error) {
+	var pvKey pvm.FilePVKey
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return pvKey, err
+	}
+	if err := cmtjson.Unmarshal(bz, &pvKey); err != nil {
+		return pvKey, fmt.Errorf("failed to read private validator key file %s: %w", path, err)
+	}
+	if pvKey.PrivKey == nil {
+		return pvKey, fmt.Errorf("private validator key file %s has no private key", path)
+	}
+
+	pvKey.PubKey = pvKey.PrivKey.PubKey()
+	pvKey.Address = pvKey.PubKey.Address()
+	return pvKey, nil
+}
+
+// loadTestnetForkValidators loads the private keys of the validators of spec.
+func loadTestnetForkValidators(spec *module.TestnetForkSpec) ([]testnetValidator, error) {
+	validators := make([]testnetValidator, len(spec.Validators))
+	for i, val := range spec.Validators {
+		pvKey, err := loadPrivValidatorKey(val.PrivValidatorKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("validator %s: %w", val.Moniker, err)
+		}
+		if val.ConsPubKey != nil && !bytes.Equal(val.ConsPubKey.Bytes(), pvKey.PubKey.Bytes()) {
+			return nil, fmt.Errorf("validator %s: private validator key file %s changed", val.Moniker, val.PrivValidatorKeyFile)
+		}
+
+		validators[i] = testnetValidator{privKey: pvKey.PrivKey, power: val.Power}
+	}
+
+	return validators, nil
+}
+
+// signTestnetCommit returns the validator set of the testnet validators, and
+// their signatures of the last block of state, making up its last commit.
+func signTestnetCommit(chainID string, state sm.State, validators []testnetValidator) (*cmttypes.ValidatorSet, []cmttypes.CommitSig, error) {
+	if len(validators) == 0 {
+		return nil, nil, errors.New("no testnet validators")
+	}
+
+	vals := make([]*cmttypes.Validator, len(validators))
+	privKeys := make(map[string]crypto.PrivKey, len(validators))
+	for i, val := range validators {
+		vals[i] = cmttypes.NewValidator(val.privKey.PubKey(), val.power)
+		privKeys[string(vals[i].Address)] = val.privKey
+	}
+	valSet := cmttypes.NewValidatorSet(vals)
+
+	// the signatures are in the order of the validator set
+	commitSigs := make([]cmttypes.CommitSig, len(valSet.Validators))
+	for i, val := range valSet.Validators {
+		vote := cmttypes.Vote{
+			Type:             cmtproto.PrecommitType,
+			Height:           state.LastBlockHeight,
+			Round:            0,
+			BlockID:          state.LastBlockID,
+			Timestamp:        time.Now(),
+			ValidatorAddress: val.Address,
+			ValidatorIndex:   int32(i),
+		}
+
+		sig, err := privKeys[string(val.Address)].Sign(cmttypes.VoteSignBytes(chainID, vote.ToProto()))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		commitSigs[i] = cmttypes.CommitSig{
+			BlockIDFlag:      cmttypes.BlockIDFlagCommit,
+			ValidatorAddress: val.Address,
+			Timestamp:        vote.Timestamp,
+			Signature:        sig,
+		}
+	}
+
+	return valSet, commitSigs, nil
+}
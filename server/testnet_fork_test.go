@@ -0,0 +1,75 @@
+package server_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cometbft/cometbft/privval"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+func TestLoadTestnetForkSpec(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "val1"), 0o755))
+	pv := privval.GenFilePV(filepath.Join(dir, "val1", "priv_validator_key.json"), filepath.Join(dir, "val1", "priv_validator_state.json"))
+	pv.Key.Save()
+
+	writeSpec := func(spec string) string {
+		path := filepath.Join(dir, "testnet.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(spec), 0o600))
+		return path
+	}
+
+	spec, err := server.LoadTestnetForkSpec(writeSpec(`
+chain_id: mainnet-fork-1
+validators:
+  - moniker: val1
+    operator: cosmos1operator
+    power: 100
+    priv_validator_key_file: val1/priv_validator_key.json
+accounts:
+  - address: cosmos1account
+    balance: 1000stake
+params:
+  gov:
+    voting_period: 60s
+`))
+	require.NoError(t, err)
+	require.Equal(t, "mainnet-fork-1", spec.ChainID)
+	require.Len(t, spec.Validators, 1)
+	require.Equal(t, filepath.Join(dir, "val1", "priv_validator_key.json"), spec.Validators[0].PrivValidatorKeyFile)
+	require.Equal(t, pv.Key.PubKey.Bytes(), spec.Validators[0].ConsPubKey.Bytes())
+	require.JSONEq(t, `{"voting_period": "60s"}`, string(spec.Params["gov"]))
+
+	_, err = server.LoadTestnetForkSpec(writeSpec(`
+chain_id: mainnet-fork-1
+validators:
+  - moniker: val1
+    operator: cosmos1operator
+    power: 100
+    priv_validator_key_file: val1/priv_validator_key.json
+  - moniker: val2
+    operator: cosmos1operator2
+    power: 100
+    priv_validator_key_file: ./val1/priv_validator_key.json
+`))
+	require.ErrorContains(t, err, "duplicate consensus key")
+
+	_, err = server.LoadTestnetForkSpec(writeSpec(`
+chain_id: mainnet-fork-1
+validators:
+  - moniker: val1
+    operator: cosmos1operator
+    power: 100
+    priv_validator_key_file: missing.json
+`))
+	require.ErrorContains(t, err, "validator val1")
+
+	_, err = server.LoadTestnetForkSpec(writeSpec(`
+chain-id: mainnet-fork-1
+`))
+	require.ErrorContains(t, err, "failed to parse testnet fork spec")
+}
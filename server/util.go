@@ -393,6 +393,14 @@ func AddTestnetCreatorCommand(rootCmd *cobra.Command, appCreator types.AppCreato
 	rootCmd.AddCommand(testnetCreateCmd)
 }
 
+// AddTestnetForkCommand adds the testnet fork command, creating a testnet from
+// the local state described by a YAML spec, to the testnet command.
+func AddTestnetForkCommand(testnetCmd *cobra.Command, appCreator types.AppCreator, addStartFlags types.ModuleInitFlags) {
+	testnetForkCmd := TestnetForkCmd(appCreator)
+	addStartFlags(testnetForkCmd)
+	testnetCmd.AddCommand(testnetForkCmd)
+}
+
 // ExternalIP gets the external IP address of the machine.
 //
 // https://stackoverflow.com/questions/23558425/how-do-i-get-the-local-ip-address-in-go
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"path/filepath"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
@@ -14,8 +15,10 @@ import (
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 	"cosmossdk.io/client/v2/autocli"
 	clienthelpers "cosmossdk.io/client/v2/helpers"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log/v2"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -54,6 +57,9 @@ import (
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory"
+	tokenfactorykeeper "github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/keeper"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/consensus"
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
@@ -70,6 +76,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -108,9 +117,11 @@ var (
 		minttypes.ModuleName:                        {authtypes.Minter},
 		stakingtypes.BondedPoolName:                 {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:              {authtypes.Burner, authtypes.Staking},
+		stakingtypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
 		govtypes.ModuleName:                         {authtypes.Burner},
 		protocolpooltypes.ModuleName:                nil,
 		protocolpooltypes.ProtocolPoolEscrowAccount: nil,
+		tokenfactorytypes.ModuleName:                {authtypes.Minter, authtypes.Burner},
 	}
 )
 
@@ -128,6 +139,7 @@ type SimApp struct {
 	appCodec          codec.Codec
 	txConfig          client.TxConfig
 	interfaceRegistry types.InterfaceRegistry
+	genesisDir        string
 
 	// keys to access the substores
 	keys map[string]*storetypes.KVStoreKey
@@ -149,6 +161,8 @@ type SimApp struct {
 	AuthzKeeper        authzkeeper.Keeper
 	EpochsKeeper       *epochskeeper.Keeper
 	ProtocolPoolKeeper protocolpoolkeeper.Keeper
+	TokenFactoryKeeper tokenfactorykeeper.Keeper
+	FeeMarketKeeper    feemarketkeeper.Keeper
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -252,6 +266,8 @@ func NewSimApp(
 		authzkeeper.StoreKey,
 		epochstypes.StoreKey,
 		protocolpooltypes.StoreKey,
+		tokenfactorytypes.StoreKey,
+		feemarkettypes.StoreKey,
 	)
 
 	// register streaming services
@@ -298,6 +314,19 @@ func NewSimApp(
 		logger,
 	)
 
+	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[tokenfactorytypes.StoreKey]),
+		app.AccountKeeper,
+		app.BankKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	// register denom hooks here with app.TokenFactoryKeeper.RegisterDenomHook
+
+	// apply the denom hooks attached to factory denoms on every transfer
+	app.BankKeeper.AppendSendRestriction(app.TokenFactoryKeeper.SendRestrictionFn)
+
 	// optional: enable sign mode textual by overwriting the default tx config (after setting the bank keeper)
 	enabledSignModes := append(authtx.DefaultSignModes, sigtypes.SignMode_SIGN_MODE_TEXTUAL)
 	txConfigOpts := authtx.ConfigOptions{
@@ -366,6 +395,12 @@ func NewSimApp(
 		app.AccountKeeper,
 	)
 
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[feemarkettypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper.SetHooks(
@@ -388,6 +423,15 @@ func NewSimApp(
 		skipUpgradeHeights[int64(h)] = true
 	}
 	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
+	// the genesis file is resolved as the genesis_file of the CometBFT config
+	genesisFile := cast.ToString(appOpts.Get("genesis_file"))
+	if genesisFile == "" {
+		genesisFile = filepath.Join("config", "genesis.json")
+	}
+	if !filepath.IsAbs(genesisFile) {
+		genesisFile = filepath.Join(homePath, genesisFile)
+	}
+	app.genesisDir = filepath.Dir(genesisFile)
 	// set the governance module account as the authority for conducting upgrades
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(
 		skipUpgradeHeights,
@@ -465,7 +509,7 @@ func NewSimApp(
 			txConfig,
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, nil),
-		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
+		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, nil),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, &app.GovKeeper, app.AccountKeeper, app.BankKeeper, nil),
@@ -479,6 +523,8 @@ func NewSimApp(
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
 		epochs.NewAppModule(app.EpochsKeeper),
 		protocolpool.NewAppModule(app.ProtocolPoolKeeper, app.AccountKeeper, app.BankKeeper),
+		tokenfactory.NewAppModule(app.TokenFactoryKeeper),
+		feemarket.NewAppModule(app.FeeMarketKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -523,11 +569,14 @@ func NewSimApp(
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
 		protocolpooltypes.ModuleName,
+		feemarkettypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	// NOTE: The genutils module must also occur after auth so that it can access the params from auth.
+	// NOTE: The genutils module must also occur after feemarket, whose params the ante handler reads
+	// when delivering the genesis transactions.
 	genesisModuleOrder := []string{
 		authtypes.ModuleName,
 		banktypes.ModuleName,
@@ -536,6 +585,7 @@ func NewSimApp(
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
+		feemarkettypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
@@ -545,6 +595,7 @@ func NewSimApp(
 		consensusparamtypes.ModuleName,
 		epochstypes.ModuleName,
 		protocolpooltypes.ModuleName,
+		tokenfactorytypes.ModuleName,
 	}
 
 	exportModuleOrder := []string{
@@ -564,6 +615,8 @@ func NewSimApp(
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		epochstypes.ModuleName,
+		tokenfactorytypes.ModuleName,
+		feemarkettypes.ModuleName,
 	}
 
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
@@ -646,6 +699,12 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 			SignModeHandler: txConfig.SignModeHandler(),
 			FeegrantKeeper:  app.FeeGrantKeeper,
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			// checks the fees against the base fee of x/feemarket when it is
+			// enabled, and against the validator minimum gas prices otherwise.
+			TxFeeChecker: app.FeeMarketKeeper.CheckTxFee,
+			// enables the account authenticators, remove to verify signatures
+			// against the account public keys only.
+			AuthenticatorKeeper: app.AccountKeeper,
 			SigVerifyOptions: []ante.SigVerificationDecoratorOption{
 				// change below as needed.
 				ante.WithUnorderedTxGasCost(ante.DefaultUnorderedTxGasCost),
@@ -663,7 +722,14 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 
 func (app *SimApp) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+		posthandler.HandlerOptions{
+			AuthenticatorKeeper: app.AccountKeeper,
+			BankKeeper:          app.BankKeeper,
+			GasRefund: &posthandler.GasRefundOptions{
+				RefundRatio:      math.LegacyNewDecWithPrec(5, 1),
+				MaxRefundableGas: 10_000_000,
+			},
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -696,14 +762,21 @@ func (a *SimApp) Configurator() module.Configurator {
 
 // InitChainer application update at chain initialization
 func (app *SimApp) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
-	var genesisState GenesisState
-	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
-		panic(err)
-	}
 	err := app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap())
 	if err != nil {
 		return nil, err
 	}
+
+	// the app state of a streaming genesis references the directory of the
+	// modules state, relative to the directory of the genesis file
+	if dir, ok := genutiltypes.ResolveStreamingAppState(req.AppStateBytes, app.genesisDir); ok {
+		return app.ModuleManager.InitGenesisStream(ctx, app.appCodec, genutiltypes.NewStreamingGenesis(dir))
+	}
+
+	var genesisState GenesisState
+	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+		panic(err)
+	}
 	return app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -771,6 +844,11 @@ func (app *SimApp) GetKey(storeKey string) *storetypes.KVStoreKey {
 	return app.keys[storeKey]
 }
 
+// GetUpgradeKeeper returns the upgrade keeper.
+func (app *SimApp) GetUpgradeKeeper() *upgradekeeper.Keeper {
+	return app.UpgradeKeeper
+}
+
 // GetStoreKeys returns all the stored store keys.
 func (app *SimApp) GetStoreKeys() []storetypes.StoreKey {
 	keys := make([]storetypes.StoreKey, 0, len(app.keys))
@@ -781,6 +859,24 @@ func (app *SimApp) GetStoreKeys() []storetypes.StoreKey {
 	return keys
 }
 
+// StoreSchemas returns the collections schemas of the modules by store name,
+// used by debug state-diff to decode their state.
+func (app *SimApp) StoreSchemas() map[string]collections.Schema {
+	return map[string]collections.Schema{
+		authtypes.StoreKey:         app.AccountKeeper.Schema,
+		banktypes.StoreKey:         app.BankKeeper.Schema,
+		tokenfactorytypes.StoreKey: app.TokenFactoryKeeper.Schema,
+		minttypes.StoreKey:         app.MintKeeper.Schema,
+		protocolpooltypes.StoreKey: app.ProtocolPoolKeeper.Schema,
+		distrtypes.StoreKey:        app.DistrKeeper.Schema,
+		feegrant.StoreKey:          app.FeeGrantKeeper.Schema,
+		feemarkettypes.StoreKey:    app.FeeMarketKeeper.Schema,
+		govtypes.StoreKey:          app.GovKeeper.Schema,
+		evidencetypes.StoreKey:     app.EvidenceKeeper.Schema,
+		epochstypes.StoreKey:       app.EpochsKeeper.Schema,
+	}
+}
+
 // SimulationManager implements the SimulationApp interface
 func (app *SimApp) SimulationManager() *module.SimulationManager {
 	return app.sm
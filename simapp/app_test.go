@@ -1,7 +1,9 @@
 package simapp
 
 import (
+	"context"
 	"encoding/json"
+	"path/filepath"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -17,6 +19,7 @@ import (
 	"cosmossdk.io/log/v2"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
@@ -31,6 +34,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -40,6 +45,8 @@ import (
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -53,6 +60,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradecli "github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -224,6 +232,8 @@ func TestRunMigrations(t *testing.T) {
 					genutiltypes.ModuleName:      genutil.AppModule{}.ConsensusVersion(),
 					epochstypes.ModuleName:       epochs.AppModule{}.ConsensusVersion(),
 					protocolpooltypes.ModuleName: protocolpool.AppModule{}.ConsensusVersion(),
+					tokenfactorytypes.ModuleName: tokenfactory.AppModule{}.ConsensusVersion(),
+					feemarkettypes.ModuleName:    feemarket.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -369,3 +379,102 @@ func TestAddressCodecFactory(t *testing.T) {
 	_, ok = consAddressCodec.(customAddressCodec)
 	require.True(t, ok)
 }
+
+func TestDryRunUpgrade(t *testing.T) {
+	db := dbm.NewMemDB()
+	logger := log.NewTestLogger(t)
+	app := NewSimappWithCustomOptions(t, false, SetupOptions{
+		Logger:  logger.With("instance", "first"),
+		DB:      db,
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+	for height := int64(1); height <= 2; height++ {
+		_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+	appHash := app.LastCommitID().Hash
+	bankVersion := app.ModuleManager.GetVersionMap()[banktypes.ModuleName]
+
+	var _ upgradecli.DryRunApp = app
+	dryRunApp := NewSimApp(logger.With("instance", "dry-run"), db, false, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+	dryRunApp.UpgradeKeeper.SetUpgradeHandler("bump", func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		vm[banktypes.ModuleName]++
+		return vm, nil
+	})
+	dryRunApp.UpgradeKeeper.SetUpgradeHandler("panic", func(context.Context, upgradetypes.Plan, module.VersionMap) (module.VersionMap, error) {
+		panic("migration bug")
+	})
+
+	_, err := upgradecli.DryRunUpgrade(dryRunApp, "test", "unknown", 3)
+	require.ErrorContains(t, err, "no upgrade handler registered")
+	_, err = upgradecli.DryRunUpgrade(dryRunApp, "test", "bump", 1)
+	require.ErrorContains(t, err, "invalid upgrade height")
+
+	result, err := upgradecli.DryRunUpgrade(dryRunApp, "test", "panic", 3)
+	require.NoError(t, err)
+	require.Contains(t, result.Error, "migration bug")
+
+	result, err = upgradecli.DryRunUpgrade(dryRunApp, "test", "bump", 3)
+	require.NoError(t, err)
+	require.Empty(t, result.Error)
+	require.Equal(t, appHash, result.FromAppHash.Bytes())
+	require.NotEqual(t, appHash, result.AppHash.Bytes())
+	require.Positive(t, result.GasUsed)
+	require.Contains(t, result.ModuleVersions, upgradecli.ModuleVersionChange{Module: banktypes.ModuleName, From: bankVersion, To: bankVersion + 1})
+
+	// nothing was committed
+	require.NoError(t, dryRunApp.Close())
+	app2 := NewSimApp(logger.With("instance", "second"), db, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+	require.Equal(t, appHash, app2.LastCommitID().Hash)
+	vm, err := app2.UpgradeKeeper.GetModuleVersionMap(app2.NewContext(true))
+	require.NoError(t, err)
+	require.Equal(t, bankVersion, vm[banktypes.ModuleName])
+}
+
+func TestStreamingGenesisRoundTrip(t *testing.T) {
+	logger := log.NewTestLogger(t)
+	app := NewSimappWithCustomOptions(t, false, SetupOptions{
+		Logger:  logger.With("instance", "exported"),
+		DB:      dbm.NewMemDB(),
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	// the genesis file is not in the config directory of the home
+	home := t.TempDir()
+	genesisDir := filepath.Join(home, "genesis")
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+	streamed, err := app.ExportAppStateAndValidatorsToDir(false, nil, nil, genesisDir)
+	require.NoError(t, err)
+
+	dir, ok := genutiltypes.ResolveStreamingAppState(streamed.AppState, genesisDir)
+	require.True(t, ok)
+	require.NoError(t, app.BasicModuleManager.ValidateGenesisStream(app.AppCodec(), app.TxConfig(), genutiltypes.NewStreamingGenesis(dir)))
+
+	initAppHash := func(name string, appState json.RawMessage) []byte {
+		appOpts := simtestutil.AppOptionsMap{
+			flags.FlagHome: home,
+			"genesis_file": filepath.Join("genesis", "genesis.json"),
+		}
+		app := NewSimApp(logger.With("instance", name), dbm.NewMemDB(), true, appOpts)
+		_, err := app.InitChain(&abci.RequestInitChain{
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: simtestutil.DefaultConsensusParams,
+			AppStateBytes:   appState,
+		})
+		require.NoError(t, err)
+		res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+		return res.AppHash
+	}
+
+	require.Equal(t, initAppHash("classic", exported.AppState), initAppHash("streaming", streamed.AppState))
+}
@@ -4,12 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"path/filepath"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -18,6 +20,32 @@ import (
 // ExportAppStateAndValidators exports the state of the application for a genesis
 // file.
 func (app *SimApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs, modulesToExport []string) (servertypes.ExportedApp, error) {
+	return app.exportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, func(ctx sdk.Context) (json.RawMessage, error) {
+		genState, err := app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
+		if err != nil {
+			return nil, err
+		}
+
+		return json.MarshalIndent(genState, "", "  ")
+	})
+}
+
+// ExportAppStateAndValidatorsToDir exports the state of the application for a
+// streaming genesis directory, writing the state of the modules to its app
+// state directory one module at a time. The returned app state references the
+// app state directory.
+func (app *SimApp) ExportAppStateAndValidatorsToDir(forZeroHeight bool, jailAllowedAddrs, modulesToExport []string, dir string) (servertypes.ExportedApp, error) {
+	return app.exportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, func(ctx sdk.Context) (json.RawMessage, error) {
+		writer := genutiltypes.NewStreamingGenesis(filepath.Join(dir, genutiltypes.StreamingAppStateDir))
+		if err := app.ModuleManager.ExportGenesisStream(ctx, app.appCodec, writer, modulesToExport); err != nil {
+			return nil, err
+		}
+
+		return genutiltypes.NewStreamingAppState(genutiltypes.StreamingAppStateDir), nil
+	})
+}
+
+func (app *SimApp) exportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs []string, exportState func(sdk.Context) (json.RawMessage, error)) (servertypes.ExportedApp, error) {
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
 
@@ -29,12 +57,7 @@ func (app *SimApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAd
 		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
 	}
 
-	genState, err := app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
-	if err != nil {
-		return servertypes.ExportedApp{}, err
-	}
-
-	appState, err := json.MarshalIndent(genState, "", "  ")
+	appState, err := exportState(ctx)
 	if err != nil {
 		return servertypes.ExportedApp{}, err
 	}
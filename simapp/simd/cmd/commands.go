@@ -5,6 +5,7 @@ import (
 
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -26,6 +27,7 @@ import (
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	upgradecli "github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
 )
 
 // initCometBFTConfig helps to override default CometBFT Config values.
@@ -107,11 +109,12 @@ func initRootCmd(
 
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(basicManager, simapp.DefaultNodeHome),
-		NewTestnetCmd(basicManager, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		newTestnetCmd(basicManager),
+		debugCommand(),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, simapp.DefaultNodeHome),
 		snapshot.Cmd(newApp),
+		upgradecli.NewUpgradeCmd(newDryRunApp, simapp.DefaultNodeHome),
 		NewBankSpeedTest(),
 	)
 
@@ -130,6 +133,25 @@ func initRootCmd(
 	)
 }
 
+// debugCommand builds the `simd debug` command, including the commands needing the application.
+func debugCommand() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(
+		server.StateDiffCmd(newApp),
+		server.ReplayCmd(newApp),
+	)
+
+	return cmd
+}
+
+// newTestnetCmd builds the `simd testnet` command, including the testnet fork command.
+func newTestnetCmd(basicManager module.BasicManager) *cobra.Command {
+	cmd := NewTestnetCmd(basicManager, banktypes.GenesisBalancesIterator{})
+	server.AddTestnetForkCommand(cmd, newApp, func(*cobra.Command) {})
+
+	return cmd
+}
+
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter
 func genesisCommand(txConfig client.TxConfig, basicManager module.BasicManager, cmds ...*cobra.Command) *cobra.Command {
 	cmd := genutilcli.Commands(txConfig, basicManager, simapp.DefaultNodeHome)
@@ -176,6 +198,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetSignBatchCommand(),
 		authcmd.GetMultiSignCommand(),
 		authcmd.GetMultiSignBatchCmd(),
+		authcmd.GetMultisigSessionCommand(),
 		authcmd.GetValidateSignaturesCommand(),
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
@@ -193,11 +216,35 @@ func newApp(
 	appOpts servertypes.AppOptions,
 ) servertypes.Application {
 	baseappOptions := server.DefaultBaseappOptions(appOpts)
-	return simapp.NewSimApp(
+	app := simapp.NewSimApp(
 		logger, db, true,
 		appOpts,
 		baseappOptions...,
 	)
+
+	// rewrite the local state when starting a testnet fork
+	if spec, ok := appOpts.Get(server.KeyTestnetForkSpec).(*module.TestnetForkSpec); ok {
+		if err := app.TestnetFork(spec); err != nil {
+			panic(err)
+		}
+	}
+
+	return app
+}
+
+// newDryRunApp creates the application without loading its latest version, for
+// dry-running upgrades.
+func newDryRunApp(
+	logger log.Logger,
+	db dbm.DB,
+	appOpts servertypes.AppOptions,
+) upgradecli.DryRunApp {
+	baseappOptions := server.DefaultBaseappOptions(appOpts)
+	return simapp.NewSimApp(
+		logger, db, false,
+		appOpts,
+		baseappOptions...,
+	)
 }
 
 // appExport creates a new simapp (optionally at a given height) and exports state.
@@ -230,5 +277,9 @@ func appExport(
 		simApp = simapp.NewSimApp(logger, db, true, appOpts)
 	}
 
+	if outputDir := cast.ToString(appOpts.Get(server.FlagOutputDir)); outputDir != "" {
+		return simApp.ExportAppStateAndValidatorsToDir(forZeroHeight, jailAllowedAddrs, modulesToExport, outputDir)
+	}
+
 	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
 }
@@ -0,0 +1,22 @@
+package simapp
+
+import (
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// TestnetFork rewrites the state of the application for a testnet fork spec.
+// The changes are written to the latest state, and committed with the first
+// block of the testnet.
+func (app *SimApp) TestnetFork(spec *module.TestnetForkSpec) error {
+	ctx := app.NewUncachedContext(false, cmtproto.Header{
+		ChainID: spec.ChainID,
+		Height:  app.LastBlockHeight(),
+		Time:    time.Now(),
+	})
+
+	return app.ModuleManager.TestnetFork(ctx, spec)
+}
@@ -0,0 +1,76 @@
+package simapp
+
+import (
+	"encoding/json"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTestnetFork(t *testing.T) {
+	app := Setup(t, false)
+
+	ctx := app.NewContext(false)
+	oldValidators, err := app.StakingKeeper.GetLastValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, oldValidators)
+
+	operators := []sdk.AccAddress{
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+	spec := &module.TestnetForkSpec{
+		ChainID: "testnet-fork-1",
+		Validators: []module.TestnetForkValidator{
+			{Moniker: "val1", Operator: operators[0].String(), Power: 10, PrivValidatorKeyFile: "val1.json", ConsPubKey: ed25519.GenPrivKey().PubKey()},
+			{Moniker: "val2", Operator: operators[1].String(), Power: 20, PrivValidatorKeyFile: "val2.json", ConsPubKey: ed25519.GenPrivKey().PubKey()},
+		},
+		Accounts: []module.TestnetForkAccount{
+			{Address: operators[0].String(), Balance: "10000000stake"},
+			{Address: operators[1].String(), Balance: "20000000stake"},
+		},
+		Params: map[string]json.RawMessage{
+			govtypes.ModuleName: json.RawMessage(`{"voting_period": "60s", "expedited_voting_period": "30s"}`),
+		},
+	}
+	require.NoError(t, app.TestnetFork(spec))
+
+	// the validators of the testnet replace the validators of the network
+	ctx = app.NewUncachedContext(false, ctx.BlockHeader())
+	lastValidators, err := app.StakingKeeper.GetLastValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, lastValidators, 2)
+	for _, val := range lastValidators {
+		require.Equal(t, stakingtypes.Bonded, val.GetStatus())
+	}
+
+	for _, val := range oldValidators {
+		valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+		require.NoError(t, err)
+		validator, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, stakingtypes.Unbonding, validator.GetStatus())
+		require.True(t, validator.IsJailed())
+	}
+
+	totalPower, err := app.StakingKeeper.GetLastTotalPower(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), totalPower.Int64())
+
+	govParams, err := app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "1m0s", govParams.VotingPeriod.String())
+
+	// the testnet keeps producing blocks without validator updates
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1})
+	require.NoError(t, err)
+	require.Empty(t, res.ValidatorUpdates)
+}
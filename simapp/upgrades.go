@@ -6,6 +6,8 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -26,17 +28,21 @@ func (app SimApp) RegisterUpgradeHandlers() {
 		},
 	)
 
+	// register the store upgrades so that they are also applied when dry-running the upgrade
+	app.UpgradeKeeper.SetStoreUpgrades(UpgradeName, storetypes.StoreUpgrades{
+		Added: []string{
+			tokenfactorytypes.StoreKey,
+			feemarkettypes.StoreKey,
+		},
+	})
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
 	}
 
 	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{
-			Added: []string{},
-		}
-
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
-		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, app.UpgradeKeeper.GetStoreUpgrades(UpgradeName)))
 	}
 }
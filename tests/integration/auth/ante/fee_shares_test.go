@@ -0,0 +1,306 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/simapp"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+const initialBalance = 1_000_000
+
+type feeSharesFixture struct {
+	app    *simapp.SimApp
+	valSet *cmttypes.ValidatorSet
+	time   time.Time
+
+	// refunds are the fees refunded to each account for the unused gas
+	refunds map[string]int64
+
+	// txResults are the results of the transactions of the last block
+	txResults []*abci.ExecTxResult
+
+	payer1, payer2, granter, recipient cryptotypes.PrivKey
+}
+
+func newFeeSharesFixture(t *testing.T) *feeSharesFixture {
+	t.Helper()
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	f := &feeSharesFixture{
+		valSet:    valSet,
+		time:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		refunds:   map[string]int64{},
+		payer1:    secp256k1.GenPrivKey(),
+		payer2:    secp256k1.GenPrivKey(),
+		granter:   secp256k1.GenPrivKey(),
+		recipient: secp256k1.GenPrivKey(),
+	}
+
+	var (
+		accs     []authtypes.GenesisAccount
+		balances []banktypes.Balance
+	)
+	for _, priv := range []cryptotypes.PrivKey{f.payer1, f.payer2, f.granter, f.recipient} {
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		accs = append(accs, authtypes.NewBaseAccount(addr, priv.PubKey(), uint64(len(accs)), 0))
+		balances = append(balances, banktypes.Balance{
+			Address: addr.String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, initialBalance)),
+		})
+	}
+
+	f.app = simapp.SetupWithGenesisValSet(t, valSet, accs, balances...)
+	_, err = f.app.Commit()
+	require.NoError(t, err)
+
+	return f
+}
+
+// ctx returns a context writing to the committed state, used to set up the
+// grants and to query the balances between blocks.
+func (f *feeSharesFixture) ctx() sdk.Context {
+	return f.app.NewUncachedContext(false, cmtproto.Header{Height: f.app.LastBlockHeight(), Time: f.time})
+}
+
+// balance returns the balance of the account, excluding the fees refunded for
+// the unused gas which depend on the gas consumed by the transactions.
+func (f *feeSharesFixture) balance(priv cryptotypes.PrivKey) int64 {
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	return f.app.BankKeeper.GetBalance(f.ctx(), addr, sdk.DefaultBondDenom).Amount.Int64() - f.refunds[addr.String()]
+}
+
+// deliver executes the transactions in a new block, advancing the block time
+// by the given duration, and returns their result codes.
+func (f *feeSharesFixture) deliver(t *testing.T, advance time.Duration, txs ...[]byte) []uint32 {
+	t.Helper()
+
+	f.time = f.time.Add(advance)
+	res, err := f.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             f.app.LastBlockHeight() + 1,
+		Hash:               f.app.LastCommitID().Hash,
+		NextValidatorsHash: f.valSet.Hash(),
+		Time:               f.time,
+		Txs:                txs,
+	})
+	require.NoError(t, err)
+	_, err = f.app.Commit()
+	require.NoError(t, err)
+
+	f.txResults = res.TxResults
+	codes := make([]uint32, len(res.TxResults))
+	for i, txRes := range res.TxResults {
+		codes[i] = txRes.Code
+		f.trackRefunds(t, txRes.Events)
+	}
+	return codes
+}
+
+// trackRefunds records the fees refunded for the unused gas in the events.
+func (f *feeSharesFixture) trackRefunds(t *testing.T, events []abci.Event) {
+	t.Helper()
+
+	for _, event := range events {
+		if event.Type != sdk.EventTypeTx {
+			continue
+		}
+
+		var refund, payer string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case sdk.AttributeKeyFeeRefund:
+				refund = attr.Value
+			case sdk.AttributeKeyFeePayer:
+				payer = attr.Value
+			}
+		}
+		if refund == "" {
+			continue
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(refund)
+		require.NoError(t, err)
+		f.refunds[payer] += coins.AmountOf(sdk.DefaultBondDenom).Int64()
+	}
+}
+
+// sendTx builds a transaction sending coins from payer1 to the recipient whose
+// fee is split between the shares. When unordered is set, the transaction is
+// unordered and the memo makes it unique.
+func (f *feeSharesFixture) sendTx(t *testing.T, unordered bool, memo string, amount int64, shares ...authtypes.FeeShare) []byte {
+	t.Helper()
+
+	txConfig := f.app.TxConfig()
+	builder := txConfig.NewTxBuilder()
+
+	from, to := sdk.AccAddress(f.payer1.PubKey().Address()), sdk.AccAddress(f.recipient.PubKey().Address())
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))))
+	builder.SetMemo(memo)
+	builder.SetGasLimit(400_000)
+
+	fee := sdk.NewCoins()
+	for _, share := range shares {
+		fee = fee.Add(share.Amount...)
+	}
+	builder.SetFeeAmount(fee)
+
+	opt, err := codectypes.NewAnyWithValue(authtypes.NewExtensionOptionFeeShares(shares...))
+	require.NoError(t, err)
+	builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(opt)
+
+	if unordered {
+		builder.SetUnordered(true)
+		builder.SetTimeoutTimestamp(f.time.Add(10 * time.Minute))
+	}
+
+	// payer1 signs the message, the other payers sign their shares
+	privs := []cryptotypes.PrivKey{f.payer1}
+	for _, share := range shares {
+		if share.Payer == sdk.AccAddress(f.payer2.PubKey().Address()).String() {
+			privs = append(privs, f.payer2)
+		}
+	}
+
+	ctx := f.ctx()
+	sigs := make([]signing.SignatureV2, len(privs))
+	signerData := make([]authsign.SignerData, len(privs))
+	for i, priv := range privs {
+		acc := f.app.AccountKeeper.GetAccount(ctx, sdk.AccAddress(priv.PubKey().Address()))
+		seq := acc.GetSequence()
+		if unordered {
+			seq = 0
+		}
+		sigs[i] = signing.SignatureV2{
+			PubKey:   priv.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: seq,
+		}
+		signerData[i] = authsign.SignerData{
+			Address:       acc.GetAddress().String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      seq,
+			PubKey:        priv.PubKey(),
+		}
+	}
+	require.NoError(t, builder.SetSignatures(sigs...))
+
+	for i, priv := range privs {
+		signBytes, err := authsign.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_DIRECT, signerData[i], builder.GetTx())
+		require.NoError(t, err)
+		sigs[i].Data.(*signing.SingleSignatureData).Signature, err = priv.Sign(signBytes)
+		require.NoError(t, err)
+	}
+	require.NoError(t, builder.SetSignatures(sigs...))
+
+	bz, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return bz
+}
+
+func share(priv cryptotypes.PrivKey, amount int64, granter cryptotypes.PrivKey) authtypes.FeeShare {
+	var granterAddr string
+	if granter != nil {
+		granterAddr = sdk.AccAddress(granter.PubKey().Address()).String()
+	}
+	return authtypes.NewFeeShare(sdk.AccAddress(priv.PubKey().Address()).String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)), granterAddr)
+}
+
+func TestFeeShares(t *testing.T) {
+	f := newFeeSharesFixture(t)
+
+	codes := f.deliver(t, time.Second, f.sendTx(t, false, "", 10, share(f.payer1, 300, nil), share(f.payer2, 200, nil)))
+	require.Equal(t, []uint32{0}, codes)
+	require.Equal(t, int64(initialBalance-300-10), f.balance(f.payer1))
+	require.Equal(t, int64(initialBalance-200), f.balance(f.payer2))
+
+	// the payers of the shares are required signers, so a share paid by an
+	// account which did not sign the transaction is rejected
+	codes = f.deliver(t, time.Second, f.sendTx(t, false, "", 10, share(f.payer1, 300, nil), share(f.granter, 200, nil)))
+	require.NotEqual(t, uint32(0), codes[0])
+	require.Equal(t, int64(initialBalance), f.balance(f.granter))
+}
+
+func TestFeeSharesUnorderedWithPeriodicAllowance(t *testing.T) {
+	f := newFeeSharesFixture(t)
+
+	granter, payer2 := sdk.AccAddress(f.granter.PubKey().Address()), sdk.AccAddress(f.payer2.PubKey().Address())
+	require.NoError(t, f.app.FeeGrantKeeper.GrantAllowance(f.ctx(), granter, payer2, &feegrant.PeriodicAllowance{
+		Period:           time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)),
+	}))
+
+	// the granter pays the share of payer2 within the period spend limit
+	tx1 := f.sendTx(t, true, "tx1", 10, share(f.payer1, 100, nil), share(f.payer2, 300, f.granter))
+	require.Equal(t, []uint32{0}, f.deliver(t, time.Second, tx1))
+	require.Equal(t, int64(initialBalance-100-10), f.balance(f.payer1))
+	require.Equal(t, int64(initialBalance), f.balance(f.payer2))
+	require.Equal(t, int64(initialBalance-300), f.balance(f.granter))
+
+	// replaying the unordered transaction is rejected
+	require.NotEqual(t, uint32(0), f.deliver(t, time.Second, tx1)[0])
+
+	// the second share exceeds what is left of the period spend limit
+	tx2 := f.sendTx(t, true, "tx2", 10, share(f.payer1, 100, nil), share(f.payer2, 300, f.granter))
+	require.NotEqual(t, uint32(0), f.deliver(t, time.Second, tx2)[0])
+	require.Equal(t, int64(initialBalance-300), f.balance(f.granter))
+
+	// once the period is reset, the same share is accepted again
+	f.deliver(t, time.Hour)
+	tx3 := f.sendTx(t, true, "tx3", 10, share(f.payer1, 100, nil), share(f.payer2, 300, f.granter))
+	require.Equal(t, []uint32{0}, f.deliver(t, time.Second, tx3))
+	require.Equal(t, int64(initialBalance-2*(100+10)), f.balance(f.payer1))
+	require.Equal(t, int64(initialBalance), f.balance(f.payer2))
+	require.Equal(t, int64(initialBalance-600), f.balance(f.granter))
+}
+
+func TestFeeSharesAllowedAddresses(t *testing.T) {
+	f := newFeeSharesFixture(t)
+
+	granter := sdk.AccAddress(f.granter.PubKey().Address())
+	grant := func(grantee cryptotypes.PrivKey, allowedAddress sdk.AccAddress) {
+		allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.PeriodicAllowance{
+			Period:           time.Hour,
+			PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)),
+		}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+		require.NoError(t, err)
+		allowance.AllowedAddresses = []string{allowedAddress.String()}
+		require.NoError(t, f.app.FeeGrantKeeper.GrantAllowance(f.ctx(), granter, sdk.AccAddress(grantee.PubKey().Address()), allowance))
+	}
+	grant(f.payer1, granter)
+	grant(f.payer2, sdk.AccAddress(f.recipient.PubKey().Address()))
+
+	// the message sends coins to the recipient, which is not an address
+	// allowed by the grant of payer1
+	tx := f.sendTx(t, true, "tx1", 10, share(f.payer1, 100, f.granter), share(f.payer2, 300, nil))
+	require.NotEqual(t, uint32(0), f.deliver(t, time.Second, tx)[0])
+	require.Equal(t, int64(initialBalance), f.balance(f.granter))
+
+	// the recipient is allowed by the grant of payer2
+	tx = f.sendTx(t, true, "tx2", 10, share(f.payer1, 100, nil), share(f.payer2, 300, f.granter))
+	require.Equal(t, []uint32{0}, f.deliver(t, time.Second, tx))
+	require.Equal(t, int64(initialBalance-300), f.balance(f.granter))
+	require.Equal(t, int64(initialBalance), f.balance(f.payer2))
+}
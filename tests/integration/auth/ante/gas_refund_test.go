@@ -0,0 +1,51 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// refundFraction returns the fraction of the fees refunded by simapp for the
+// unused gas of a transaction with a gas limit of 400000.
+func refundFraction(gasUsed int64) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(5, 1).MulInt64(400_000 - gasUsed).QuoInt64(400_000)
+}
+
+func TestGasRefund(t *testing.T) {
+	f := newFeeSharesFixture(t)
+	payer1, payer2 := sdk.AccAddress(f.payer1.PubKey().Address()), sdk.AccAddress(f.payer2.PubKey().Address())
+	granter := sdk.AccAddress(f.granter.PubKey().Address())
+
+	codes := f.deliver(t, time.Second, f.sendTx(t, false, "", 10, share(f.payer1, 300, nil), share(f.payer2, 200, nil)))
+	require.Equal(t, []uint32{0}, codes)
+
+	// each payer is refunded its share of the fees paid for the unused gas
+	fraction := refundFraction(f.txResults[0].GasUsed)
+	require.True(t, fraction.IsPositive())
+	refund1, refund2 := fraction.MulInt64(300).TruncateInt64(), fraction.MulInt64(200).TruncateInt64()
+	require.Equal(t, refund1, f.refunds[payer1.String()])
+	require.Equal(t, refund2, f.refunds[payer2.String()])
+	require.Equal(t, int64(initialBalance-300-10+refund1), f.app.BankKeeper.GetBalance(f.ctx(), payer1, sdk.DefaultBondDenom).Amount.Int64())
+	require.Equal(t, int64(initialBalance-200+refund2), f.app.BankKeeper.GetBalance(f.ctx(), payer2, sdk.DefaultBondDenom).Amount.Int64())
+
+	// the share paid by a granter is refunded to the granter
+	require.NoError(t, f.app.FeeGrantKeeper.GrantAllowance(f.ctx(), granter, payer1, &feegrant.BasicAllowance{}))
+	codes = f.deliver(t, time.Second, f.sendTx(t, false, "", 10, share(f.payer1, 300, f.granter)))
+	require.Equal(t, []uint32{0}, codes)
+	refund := refundFraction(f.txResults[0].GasUsed).MulInt64(300).TruncateInt64()
+	require.Positive(t, refund)
+	require.Equal(t, refund, f.refunds[granter.String()])
+	require.Equal(t, refund1, f.refunds[payer1.String()])
+
+	// a failed transaction is not refunded
+	codes = f.deliver(t, time.Second, f.sendTx(t, false, "", 2*initialBalance, share(f.payer1, 300, nil)))
+	require.NotEqual(t, uint32(0), codes[0])
+	require.Equal(t, refund1, f.refunds[payer1.String()])
+}
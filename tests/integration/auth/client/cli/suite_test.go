@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -770,6 +772,86 @@ func (s *CLITestSuite) TestCLIMultisign() {
 	s.Require().NoError(err)
 }
 
+func (s *CLITestSuite) TestCLIMultisigSession() {
+	multisigRecord, err := s.clientCtx.Keyring.Key("multi")
+	s.Require().NoError(err)
+	addr, err := multisigRecord.GetAddress()
+	s.Require().NoError(err)
+
+	// Generate multisig transaction.
+	multiGeneratedTx, err := clitestutil.MsgSendExec(
+		s.clientCtx,
+		addr,
+		s.val,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+		s.ac,
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10))).String()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err)
+	multiGeneratedTxFile := testutil.WriteToNewTempFile(s.T(), multiGeneratedTx.String())
+	defer multiGeneratedTxFile.Close()
+
+	sessionFile := filepath.Join(s.T().TempDir(), "session.json")
+	chainIDFlag := fmt.Sprintf("--%s=%s", flags.FlagChainID, s.clientCtx.ChainID)
+
+	_, err = authtestutil.TxMultisigSessionExec(s.clientCtx, "create", multiGeneratedTxFile.Name(), "newAccount1", sessionFile, chainIDFlag)
+	s.Require().ErrorContains(err, "not a multisig key")
+
+	_, err = authtestutil.TxMultisigSessionExec(s.clientCtx, "create", multiGeneratedTxFile.Name(), multisigRecord.Name, sessionFile, chainIDFlag, fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeDirect))
+	s.Require().ErrorContains(err, "sign mode SIGN_MODE_DIRECT is not supported by multisig signing sessions")
+	_, err = os.Stat(sessionFile)
+	s.Require().ErrorIs(err, os.ErrNotExist)
+
+	out, err := authtestutil.TxMultisigSessionExec(s.clientCtx, "create", multiGeneratedTxFile.Name(), multisigRecord.Name, sessionFile, chainIDFlag)
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), `"complete":false`)
+
+	_, err = authtestutil.TxMultisigSessionExec(s.clientCtx, "create", multiGeneratedTxFile.Name(), multisigRecord.Name, sessionFile, chainIDFlag)
+	s.Require().ErrorContains(err, "already exists")
+
+	// not enough signatures
+	_, err = authtestutil.TxMultisigSessionExec(s.clientCtx, "finalize", sessionFile, chainIDFlag, fmt.Sprintf("--%s=true", flags.FlagGenerateOnly))
+	s.Require().ErrorContains(err, "0 signatures out of the 2 required")
+
+	_, err = authtestutil.TxMultisigSessionExec(s.clientCtx, "sign", sessionFile, chainIDFlag, "--from=dummyAccount")
+	s.Require().ErrorContains(err, "not a member of multisig")
+
+	_, err = authtestutil.TxMultisigSessionExec(s.clientCtx, "sign", sessionFile, chainIDFlag, "--from=newAccount1")
+	s.Require().NoError(err)
+
+	_, err = authtestutil.TxMultisigSessionExec(s.clientCtx, "sign", sessionFile, chainIDFlag, "--from=newAccount1")
+	s.Require().ErrorContains(err, "already signed")
+
+	// the sequence of the multisig account moved on since the session was created
+	driftCtx := s.clientCtx.WithAccountRetriever(client.MockAccountRetriever{ReturnAccSeq: 1})
+	_, err = authtestutil.TxMultisigSessionExec(driftCtx, "sign", sessionFile, chainIDFlag, "--from=newAccount2")
+	s.Require().ErrorContains(err, "sequence drift")
+
+	_, err = authtestutil.TxMultisigSessionExec(s.clientCtx, "sign", sessionFile, chainIDFlag, "--from=newAccount2")
+	s.Require().NoError(err)
+
+	out, err = authtestutil.TxMultisigSessionExec(s.clientCtx, "status", sessionFile, fmt.Sprintf("--%s=json", flags.FlagOutput))
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), `"signatures":2`)
+	s.Require().Contains(out.String(), `"complete":true`)
+
+	_, err = authtestutil.TxMultisigSessionExec(driftCtx, "finalize", sessionFile, chainIDFlag, fmt.Sprintf("--%s=true", flags.FlagGenerateOnly))
+	s.Require().ErrorContains(err, "sequence drift")
+
+	out, err = authtestutil.TxMultisigSessionExec(s.clientCtx, "finalize", sessionFile, chainIDFlag, fmt.Sprintf("--%s=true", flags.FlagGenerateOnly))
+	s.Require().NoError(err)
+
+	signedTxFile := testutil.WriteToNewTempFile(s.T(), out.String())
+	defer signedTxFile.Close()
+	_, err = authtestutil.TxValidateSignaturesExec(s.clientCtx, signedTxFile.Name())
+	s.Require().NoError(err)
+
+	s.clientCtx.BroadcastMode = flags.BroadcastSync
+	_, err = authtestutil.TxMultisigSessionExec(s.clientCtx, "finalize", sessionFile, chainIDFlag)
+	s.Require().NoError(err)
+}
+
 func (s *CLITestSuite) TestSignBatchMultisig() {
 	// Fetch 2 accounts and a multisig.
 	account1, err := s.clientCtx.Keyring.Key("newAccount1")
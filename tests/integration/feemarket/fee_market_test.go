@@ -0,0 +1,167 @@
+package feemarket_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/simapp"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type fixture struct {
+	app    *simapp.SimApp
+	valSet *cmttypes.ValidatorSet
+	sender cryptotypes.PrivKey
+	time   time.Time
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	sender := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(sender.PubKey().Address())
+	acc := authtypes.NewBaseAccount(addr, sender.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: addr.String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000)),
+	}
+
+	f := &fixture{
+		app:    simapp.SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, balance),
+		valSet: valSet,
+		sender: sender,
+		time:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	_, err = f.app.Commit()
+	require.NoError(t, err)
+
+	return f
+}
+
+// ctx returns a context writing to the committed state.
+func (f *fixture) ctx() sdk.Context {
+	return f.app.NewUncachedContext(false, cmtproto.Header{Height: f.app.LastBlockHeight(), Time: f.time})
+}
+
+func (f *fixture) baseFee(t *testing.T) math.LegacyDec {
+	t.Helper()
+
+	baseFee, err := f.app.FeeMarketKeeper.BaseFee.Get(f.ctx())
+	require.NoError(t, err)
+	return baseFee
+}
+
+// deliver executes the transactions in a new block and returns their results.
+func (f *fixture) deliver(t *testing.T, txs ...[]byte) []*abci.ExecTxResult {
+	t.Helper()
+
+	f.time = f.time.Add(time.Second)
+	res, err := f.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             f.app.LastBlockHeight() + 1,
+		Hash:               f.app.LastCommitID().Hash,
+		NextValidatorsHash: f.valSet.Hash(),
+		Time:               f.time,
+		Txs:                txs,
+	})
+	require.NoError(t, err)
+	_, err = f.app.Commit()
+	require.NoError(t, err)
+
+	return res.TxResults
+}
+
+// sendTx builds a transaction of the sender paying the given fee for a gas
+// limit of 200000.
+func (f *fixture) sendTx(t *testing.T, fee int64) []byte {
+	t.Helper()
+
+	txConfig := f.app.TxConfig()
+	builder := txConfig.NewTxBuilder()
+
+	from := sdk.AccAddress(f.sender.PubKey().Address())
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(from, sdk.AccAddress("recipient"), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))))
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, fee)))
+	builder.SetGasLimit(200_000)
+
+	ctx := f.ctx()
+	acc := f.app.AccountKeeper.GetAccount(ctx, from)
+	sig := signing.SignatureV2{
+		PubKey:   f.sender.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: acc.GetSequence(),
+	}
+	require.NoError(t, builder.SetSignatures(sig))
+
+	signerData := authsign.SignerData{
+		Address:       from.String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+		PubKey:        f.sender.PubKey(),
+	}
+	signBytes, err := authsign.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_DIRECT, signerData, builder.GetTx())
+	require.NoError(t, err)
+	sig.Data.(*signing.SingleSignatureData).Signature, err = f.sender.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, builder.SetSignatures(sig))
+
+	bz, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return bz
+}
+
+func TestBaseFee(t *testing.T) {
+	f := newFixture(t)
+
+	// without the base fee, transactions paying no fee are accepted
+	res := f.deliver(t, f.sendTx(t, 0))
+	require.Equal(t, uint32(0), res[0].Code, res[0].Log)
+
+	minBaseFee := math.LegacyNewDecWithPrec(1, 2)
+	params := types.NewParams(true, sdk.DefaultBondDenom, minBaseFee, 1000, math.LegacyNewDecWithPrec(125, 3))
+	require.NoError(t, f.app.FeeMarketKeeper.Params.Set(f.ctx(), params))
+	require.NoError(t, f.app.FeeMarketKeeper.BaseFee.Set(f.ctx(), minBaseFee))
+
+	// the base fee for 200000 gas is 2000stake, which is enforced when the
+	// block is finalized
+	res = f.deliver(t, f.sendTx(t, 1999))
+	require.Equal(t, sdkerrors.ErrInsufficientFee.ABCICode(), res[0].Code, res[0].Log)
+
+	// the gas consumed by rejected transactions counts towards the block gas,
+	// which was more than twice the target, so the base fee increased by the
+	// max change rate
+	require.Equal(t, math.LegacyNewDecWithPrec(1125, 5), f.baseFee(t))
+	res = f.deliver(t, f.sendTx(t, 2000))
+	require.Equal(t, sdkerrors.ErrInsufficientFee.ABCICode(), res[0].Code, res[0].Log)
+
+	// empty blocks bring the base fee back to its floor
+	f.deliver(t)
+	f.deliver(t)
+	require.Equal(t, minBaseFee, f.baseFee(t))
+
+	res = f.deliver(t, f.sendTx(t, 2000))
+	require.Equal(t, uint32(0), res[0].Code, res[0].Log)
+	require.Equal(t, math.LegacyNewDecWithPrec(1125, 5), f.baseFee(t))
+}
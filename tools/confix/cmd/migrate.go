@@ -20,6 +20,8 @@ var (
 	FlagStdOut       bool
 	FlagVerbose      bool
 	FlagSkipValidate bool
+	FlagDryRun       bool
+	FlagVerify       bool
 )
 
 func MigrateCommand() *cobra.Command {
@@ -27,6 +29,8 @@ func MigrateCommand() *cobra.Command {
 		Use:   "migrate [target-version] <config-path>",
 		Short: "Migrate Cosmos SDK configuration file to the specified version",
 		Long: `Migrate the contents of the Cosmos SDK configuration (app.toml or client.toml) to the specified version. Configuration type is app by default.
+The migration plan is printed before being applied, and --dry-run only prints it.
+With --verify, the migrated configuration is checked against the schema of the target version before being written.
 The output is written in-place unless --stdout is provided.
 In case of any error in updating the file, no output is written.`,
 		Args: cobra.MinimumNArgs(1),
@@ -81,6 +85,17 @@ In case of any error in updating the file, no output is written.`,
 
 			// get transformation steps and formatDoc in which plan need to be applied
 			steps, formatDoc := plan(rawFile, targetVersion, configType)
+			confix.PrintPlan(cmd.ErrOrStderr(), steps)
+
+			if FlagVerify {
+				if err := confix.Verify(context.Background(), steps, formatDoc, targetVersion, configType); err != nil {
+					return fmt.Errorf("failed to verify config: %w", err)
+				}
+			}
+
+			if FlagDryRun {
+				return nil
+			}
 
 			if err := confix.Upgrade(ctx, steps, formatDoc, configPath, outputPath, FlagSkipValidate); err != nil {
 				return fmt.Errorf("failed to migrate config: %w", err)
@@ -93,6 +108,8 @@ In case of any error in updating the file, no output is written.`,
 	cmd.Flags().BoolVar(&FlagStdOut, "stdout", false, "print the updated config to stdout")
 	cmd.Flags().BoolVar(&FlagVerbose, "verbose", false, "log changes to stderr")
 	cmd.Flags().BoolVar(&FlagSkipValidate, "skip-validate", false, "skip configuration validation (allows to migrate unknown configurations)")
+	cmd.Flags().BoolVar(&FlagDryRun, "dry-run", false, "print the migration plan without applying it")
+	cmd.Flags().BoolVar(&FlagVerify, "verify", false, "check the migrated config against the schema of the target version")
 	cmd.Flags().Bool(confix.ClientConfigType, false, "migrate client.toml instead of app.toml")
 
 	return cmd
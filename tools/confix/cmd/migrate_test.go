@@ -1,6 +1,7 @@
 package cmd_test
 
 import (
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -36,3 +37,35 @@ func TestMigrateCmd(t *testing.T) {
 	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.MigrateCommand(), []string{"v0.53", filepath.Join(clientCtx.HomeDir, "config", "client.toml"), "--client", "--verbose"})
 	assert.NilError(t, err)
 }
+
+func TestMigrateCmdPlan(t *testing.T) {
+	clientCtx, cleanup := initClientContext(t)
+	defer cleanup()
+
+	appConfig := filepath.Join(clientCtx.HomeDir, "config", "app.toml")
+	original, err := os.ReadFile("../data/v0.53-app.toml")
+	assert.NilError(t, err)
+	assert.NilError(t, os.WriteFile(appConfig, original, 0o600))
+
+	// a dry run prints the plan and leaves the file untouched
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd.MigrateCommand(), []string{"v0.54", appConfig, "--dry-run", "--verify"})
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out.String(), `set telemetry.metrics-sink to ""`))
+	assert.Assert(t, strings.Contains(out.String(), "add grpc-web section"))
+	assert.Assert(t, strings.Contains(out.String(), "remove grpc.skip-check-header key"))
+
+	bz, err := os.ReadFile(appConfig)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, original, bz)
+
+	// no plan leads to v0.45 from a v0.53 config
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.MigrateCommand(), []string{"v0.45", appConfig, "--verify"})
+	assert.ErrorContains(t, err, "unknown key app-db-backend")
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.MigrateCommand(), []string{"v0.54", appConfig, "--verify"})
+	assert.NilError(t, err)
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.MigrateCommand(), []string{"v0.54", appConfig, "--dry-run", "--verify"})
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out.String(), "no changes needed"))
+}
@@ -3,6 +3,7 @@ package confix
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/creachadair/tomledit"
@@ -31,6 +32,7 @@ var Migrations = MigrationMap{
 	"v0.47": defaultPlanBuilder,
 	"v0.50": defaultPlanBuilder,
 	"v0.53": defaultPlanBuilder,
+	"v0.54": defaultPlanBuilder,
 }
 
 func defaultPlanBuilder(from *tomledit.Document, to, planType string) (transform.Plan, *tomledit.Document) {
@@ -38,8 +40,9 @@ func defaultPlanBuilder(from *tomledit.Document, to, planType string) (transform
 }
 
 // PlanBuilder is a function that returns a transformation plan for a given diff between two files.
+// The migration rules of the target version are planned first, and the keys left
+// are then added or removed by diffing against the target version.
 func PlanBuilder(from *tomledit.Document, to, planType string, loadFn loadDestConfigFile) (transform.Plan, *tomledit.Document) {
-	plan := transform.Plan{}
 	deletedSections := map[string]bool{}
 
 	target, err := loadFn(to, planType)
@@ -47,7 +50,14 @@ func PlanBuilder(from *tomledit.Document, to, planType string, loadFn loadDestCo
 		panic(fmt.Errorf("failed to parse file: %w. This file should have been valid", err))
 	}
 
-	diffs := DiffKeys(from, target)
+	// the rules are applied to a copy of the document, diffed against the target
+	migrated, err := cloneDocument(from)
+	if err != nil {
+		panic(fmt.Errorf("failed to copy document: %w", err))
+	}
+	plan := ruleSteps(context.Background(), migrated, MigrationRules[to][planType])
+
+	diffs := DiffKeys(migrated, target)
 	for _, diff := range diffs {
 		kv := diff.KV
 
@@ -123,6 +133,19 @@ func PlanBuilder(from *tomledit.Document, to, planType string, loadFn loadDestCo
 	return plan, from
 }
 
+// PrintPlan prints the steps of a transformation plan, one per line.
+func PrintPlan(w io.Writer, plan transform.Plan) {
+	if len(plan) == 0 {
+		fmt.Fprintln(w, "no changes needed")
+		return
+	}
+
+	fmt.Fprintf(w, "migration plan (%d steps):\n", len(plan))
+	for i, step := range plan {
+		fmt.Fprintf(w, "%3d. %s\n", i+1, step.Desc)
+	}
+}
+
 // NoPlan returns a no-op plan.
 func NoPlan(from *tomledit.Document, to, planType string) (transform.Plan, *tomledit.Document) {
 	fmt.Printf("no migration needed to %s\n", to)
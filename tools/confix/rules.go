@@ -0,0 +1,124 @@
+package confix
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/creachadair/tomledit"
+	"github.com/creachadair/tomledit/parser"
+	"github.com/creachadair/tomledit/transform"
+)
+
+// MigrationRule is a semantic change of a configuration file between two
+// versions, such as a renamed key or a transformed value. Rules are applied
+// before the keys are diffed against the target version, so that a renamed
+// key keeps its value instead of being removed and added back with its default.
+type MigrationRule interface {
+	// Step returns the step applying the rule to doc, and false when the rule
+	// does not apply to doc.
+	Step(doc *tomledit.Document) (transform.Step, bool)
+}
+
+// MigrationRules are the migration rules to a version, by configuration type.
+var MigrationRules = map[string]map[string][]MigrationRule{
+	"v0.54": {
+		AppConfigType: {
+			TransformValue{
+				Key:  "telemetry.metrics-sink",
+				Desc: `the in-memory metrics sink is the default, written as ""`,
+				Fn: func(_ *tomledit.Document, value string) (string, error) {
+					if value == `"mem"` {
+						return `""`, nil
+					}
+
+					return value, nil
+				},
+			},
+		},
+	},
+}
+
+// TransformValue rewrites the value of a key. Fn receives the document and
+// the TOML representation of the value, e.g. `"mem"`, and returns the new
+// representation. The rule does not apply when the key is not set, or when
+// Fn leaves its value unchanged.
+type TransformValue struct {
+	Key  string
+	Desc string
+	Fn   func(doc *tomledit.Document, value string) (string, error)
+}
+
+func (r TransformValue) Step(doc *tomledit.Document) (transform.Step, bool) {
+	keys := strings.Split(r.Key, ".")
+	entry := doc.First(keys...)
+	if entry == nil || entry.KeyValue == nil {
+		return transform.Step{}, false
+	}
+
+	value, err := r.Fn(doc, entry.Value.String())
+	if err != nil {
+		return transform.Step{
+			Desc: fmt.Sprintf("transform %s key", r.Key),
+			T: transform.Func(func(context.Context, *tomledit.Document) error {
+				return fmt.Errorf("transforming %s: %w", r.Key, err)
+			}),
+		}, true
+	} else if value == entry.Value.String() {
+		return transform.Step{}, false
+	}
+
+	return transform.Step{
+		Desc: fmt.Sprintf("set %s to %s: %s", r.Key, value, r.Desc),
+		T: transform.Func(func(_ context.Context, doc *tomledit.Document) error {
+			entry := doc.First(keys...)
+			if entry == nil || entry.KeyValue == nil {
+				return fmt.Errorf("key %s not found", r.Key)
+			}
+
+			value, err := r.Fn(doc, entry.Value.String())
+			if err != nil {
+				return fmt.Errorf("transforming %s: %w", r.Key, err)
+			}
+
+			v, err := parser.ParseValue(value)
+			if err != nil {
+				return fmt.Errorf("invalid value of %s: %w", r.Key, err)
+			}
+			entry.Value = v
+
+			return nil
+		}),
+	}, true
+}
+
+// ruleSteps returns the steps of the rules applying to doc, applying them to
+// doc along the way so that each rule sees the changes of the previous ones.
+// A failing step ends the plan, and reports its error when the plan is applied.
+func ruleSteps(ctx context.Context, doc *tomledit.Document, rules []MigrationRule) transform.Plan {
+	var plan transform.Plan
+	for _, rule := range rules {
+		step, ok := rule.Step(doc)
+		if !ok {
+			continue
+		}
+
+		plan = append(plan, step)
+		if err := step.T.Apply(ctx, doc); err != nil {
+			break
+		}
+	}
+
+	return plan
+}
+
+// cloneDocument returns a deep copy of doc.
+func cloneDocument(doc *tomledit.Document) (*tomledit.Document, error) {
+	var buf bytes.Buffer
+	if err := tomledit.Format(&buf, doc); err != nil {
+		return nil, err
+	}
+
+	return tomledit.Parse(&buf)
+}
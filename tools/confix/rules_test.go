@@ -0,0 +1,99 @@
+package confix_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/creachadair/tomledit"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/tools/confix"
+)
+
+func mustParseDoc(t *testing.T, data string) *tomledit.Document {
+	t.Helper()
+	doc, err := tomledit.Parse(strings.NewReader(data))
+	assert.NilError(t, err)
+
+	return doc
+}
+
+func applyRule(t *testing.T, doc *tomledit.Document, rule confix.MigrationRule) (string, error) {
+	t.Helper()
+	step, ok := rule.Step(doc)
+	assert.Assert(t, ok)
+
+	return step.Desc, step.T.Apply(context.Background(), doc)
+}
+
+func TestTransformValue(t *testing.T) {
+	doc := mustParseDoc(t, `
+[telemetry]
+enabled = true
+metrics-sink = "mem"
+`)
+
+	rules := confix.MigrationRules["v0.54"][confix.AppConfigType]
+	assert.Equal(t, len(rules), 1)
+
+	desc, err := applyRule(t, doc, rules[0])
+	assert.NilError(t, err)
+	assert.Assert(t, strings.HasPrefix(desc, `set telemetry.metrics-sink to ""`))
+	assert.Equal(t, doc.First("telemetry", "metrics-sink").Value.String(), `""`)
+
+	// the value is already migrated
+	_, ok := rules[0].Step(doc)
+	assert.Assert(t, !ok)
+
+	failing := confix.TransformValue{
+		Key: "telemetry.enabled",
+		Fn: func(*tomledit.Document, string) (string, error) {
+			return "", errors.New("boom")
+		},
+	}
+	_, err = applyRule(t, doc, failing)
+	assert.ErrorContains(t, err, "boom")
+}
+
+func TestPlanBuilderRules(t *testing.T) {
+	doc, err := confix.LoadLocalConfig("v0.53", confix.AppConfigType)
+	assert.NilError(t, err)
+
+	plan, doc := confix.PlanBuilder(doc, "v0.54", confix.AppConfigType, confix.LoadLocalConfig)
+	assert.Assert(t, strings.HasPrefix(plan[0].Desc, "set telemetry.metrics-sink"))
+	assert.NilError(t, plan.Apply(context.Background(), doc))
+	assert.Equal(t, doc.First("telemetry", "metrics-sink").Value.String(), `""`)
+
+	var buf bytes.Buffer
+	confix.PrintPlan(&buf, plan)
+	assert.Assert(t, strings.Contains(buf.String(), "  2. "))
+
+	assert.NilError(t, confix.Verify(context.Background(), nil, doc, "v0.54", confix.AppConfigType))
+	err = confix.Verify(context.Background(), nil, doc, "v0.53", confix.AppConfigType)
+	assert.ErrorContains(t, err, "missing key grpc.skip-check-header")
+	assert.ErrorContains(t, err, "unknown key grpc.historical-grpc-address-block-range")
+}
+
+func TestPlanBuilderRemovedKeys(t *testing.T) {
+	// the keys removed in v0.54 have no replacement, the diff removes them
+	for configType, keys := range map[string][]string{
+		confix.AppConfigType:    {"grpc.skip-check-header"},
+		confix.ClientConfigType: {"grpc-address", "grpc-insecure"},
+	} {
+		doc, err := confix.LoadLocalConfig("v0.53", configType)
+		assert.NilError(t, err)
+
+		plan, doc := confix.PlanBuilder(doc, "v0.54", configType, confix.LoadLocalConfig)
+		var buf bytes.Buffer
+		confix.PrintPlan(&buf, plan)
+		for _, key := range keys {
+			assert.Assert(t, strings.Contains(buf.String(), "remove "+key+" key"), "%s: %s", configType, buf.String())
+		}
+
+		assert.NilError(t, plan.Apply(context.Background(), doc))
+		assert.NilError(t, confix.Verify(context.Background(), nil, doc, "v0.54", configType))
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/creachadair/atomicfile"
@@ -60,6 +61,52 @@ func Upgrade(ctx context.Context, plan transform.Plan, doc *tomledit.Document, c
 	return err
 }
 
+// Verify applies the plan to a copy of doc and checks the result against the
+// schema of the target version: every key of the target version must be set,
+// and the sections of the target version must not hold unknown keys. Sections
+// unknown to the target version, such as app-specific ones, are not checked.
+func Verify(ctx context.Context, plan transform.Plan, doc *tomledit.Document, to, configType string) error {
+	migrated, err := cloneDocument(doc)
+	if err != nil {
+		return fmt.Errorf("copying config: %w", err)
+	}
+
+	if err := plan.Apply(ctx, migrated); err != nil {
+		return fmt.Errorf("applying plan: %w", err)
+	}
+
+	target, err := LoadLocalConfig(to, configType)
+	if err != nil {
+		return err
+	}
+
+	// the keys of a missing or unknown section are not reported one by one
+	var problems, sections []string
+	for _, diff := range DiffKeys(migrated, target) {
+		if slices.ContainsFunc(sections, func(prefix string) bool { return strings.HasPrefix(diff.KV.Key, prefix) }) {
+			continue
+		}
+
+		switch {
+		case diff.Type == Section && diff.Deleted:
+			sections = append(sections, diff.KV.Key+".")
+		case diff.Type == Section:
+			sections = append(sections, diff.KV.Key+".")
+			problems = append(problems, fmt.Sprintf("missing section %s", diff.KV.Key))
+		case diff.Deleted:
+			problems = append(problems, fmt.Sprintf("unknown key %s", diff.KV.Key))
+		default:
+			problems = append(problems, fmt.Sprintf("missing key %s", diff.KV.Key))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("config does not match the %s schema: %s", to, strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
 // CheckValid checks whether the specified config appears to be a valid Cosmos SDK config file.
 // It tries to unmarshal the config into both the server and client config structs.
 func CheckValid(fileName string, data []byte) error {
@@ -40,6 +40,8 @@ const (
 	EnvTimeFormatLogs           = "COSMOVISOR_TIMEFORMAT_LOGS"
 	EnvCustomPreupgrade         = "COSMOVISOR_CUSTOM_PREUPGRADE"
 	EnvDisableRecase            = "COSMOVISOR_DISABLE_RECASE"
+	EnvTrustedKeys              = "COSMOVISOR_TRUSTED_KEYS"
+	EnvBackupRetention          = "COSMOVISOR_BACKUP_RETENTION"
 )
 
 const (
@@ -71,6 +73,8 @@ type Config struct {
 	TimeFormatLogs           string        `toml:"cosmovisor_timeformat_logs" mapstructure:"cosmovisor_timeformat_logs" default:"kitchen"`
 	CustomPreUpgrade         string        `toml:"cosmovisor_custom_preupgrade" mapstructure:"cosmovisor_custom_preupgrade" default:""`
 	DisableRecase            bool          `toml:"cosmovisor_disable_recase" mapstructure:"cosmovisor_disable_recase" default:"false"`
+	TrustedKeys              string        `toml:"cosmovisor_trusted_keys" mapstructure:"cosmovisor_trusted_keys" default:""`
+	BackupRetention          int           `toml:"cosmovisor_backup_retention" mapstructure:"cosmovisor_backup_retention" default:"0"`
 
 	// currently running upgrade
 	currentUpgrade upgradetypes.Plan
@@ -221,6 +225,7 @@ func GetConfigFromEnv(skipValidate bool) (*Config, error) {
 		Name:             os.Getenv(EnvName),
 		DataBackupPath:   os.Getenv(EnvDataBackupPath),
 		CustomPreUpgrade: os.Getenv(EnvCustomPreupgrade),
+		TrustedKeys:      os.Getenv(EnvTrustedKeys),
 	}
 
 	if cfg.DataBackupPath == "" {
@@ -292,6 +297,11 @@ func GetConfigFromEnv(skipValidate bool) (*Config, error) {
 		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvPreupgradeMaxRetries, err))
 	}
 
+	envBackupRetentionVal := os.Getenv(EnvBackupRetention)
+	if cfg.BackupRetention, err = strconv.Atoi(envBackupRetentionVal); err != nil && envBackupRetentionVal != "" {
+		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvBackupRetention, err))
+	}
+
 	cfg.GRPCAddress = os.Getenv(EnvGRPCAddress)
 	if cfg.GRPCAddress == "" {
 		cfg.GRPCAddress = "localhost:9090"
@@ -360,6 +370,15 @@ func (cfg *Config) validate() []error {
 		}
 	}
 
+	if cfg.BackupRetention < 0 {
+		errs = append(errs, fmt.Errorf("%s must not be negative", EnvBackupRetention))
+	}
+
+	// validate EnvTrustedKeys
+	if _, err := ParseTrustedKeys(cfg.TrustedKeys); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %w", EnvTrustedKeys, err))
+	}
+
 	// check the DataBackupPath
 	if cfg.UnsafeSkipBackup {
 		return errs
@@ -562,6 +581,8 @@ func (cfg Config) DetailString() string {
 		{EnvTimeFormatLogs, cfg.TimeFormatLogs},
 		{EnvCustomPreupgrade, cfg.CustomPreUpgrade},
 		{EnvDisableRecase, fmt.Sprintf("%t", cfg.DisableRecase)},
+		{EnvTrustedKeys, cfg.TrustedKeys},
+		{EnvBackupRetention, fmt.Sprintf("%d", cfg.BackupRetention)},
 	}
 
 	derivedEntries := []struct{ name, value string }{
@@ -0,0 +1,365 @@
+package cosmovisor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	sm "github.com/cometbft/cometbft/state"
+)
+
+const (
+	backupPrefix       = "data-backup-"
+	backupDataDir      = "data"
+	backupMetadataFile = "backup.json"
+	backupTimeFormat   = "20060102T150405"
+	preRestorePrefix   = "data-pre-restore-"
+)
+
+// BackupMetadata describes a backup of the data directory, taken before an upgrade.
+type BackupMetadata struct {
+	Name    string `json:"name"`
+	Upgrade string `json:"upgrade"`
+	// Height and AppHash are the last block height and app hash of the backed up state.
+	Height    int64             `json:"height"`
+	AppHash   cmtbytes.HexBytes `json:"app_hash"`
+	CreatedAt time.Time         `json:"created_at"`
+	// Files lists the files of the backup, used to check its integrity before a restore.
+	Files []BackupFile `json:"files"`
+}
+
+// BackupFile is a file of a backup, relative to its data directory.
+type BackupFile struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+	// Linked is true when the file is a hard link to the file of the data directory.
+	Linked bool `json:"linked,omitempty"`
+	// SHA256 is the hex encoded SHA-256 checksum of a copied file.
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// BackupsDir is the directory holding the backups of the data directory.
+func (cfg *Config) BackupsDir() string {
+	if cfg.DataBackupPath == "" {
+		return cfg.Home
+	}
+
+	return cfg.DataBackupPath
+}
+
+// CreateBackup backs up the data directory before the named upgrade, recording
+// the height and app hash of the state. Immutable database files (LevelDB, Pebble
+// and RocksDB tables) are hard linked when possible, like database checkpoints,
+// and the other files are copied.
+func (cfg *Config) CreateBackup(upgradeName string, height int64, appHash []byte) (*BackupMetadata, error) {
+	createdAt := time.Now().UTC()
+	metadata := &BackupMetadata{
+		Name:      fmt.Sprintf("%s%s-%s", backupPrefix, url.PathEscape(upgradeName), createdAt.Format(backupTimeFormat)),
+		Upgrade:   upgradeName,
+		Height:    height,
+		AppHash:   appHash,
+		CreatedAt: createdAt,
+	}
+
+	dst := filepath.Join(cfg.BackupsDir(), metadata.Name)
+	if _, err := os.Stat(dst); err == nil {
+		return nil, fmt.Errorf("backup %s already exists", metadata.Name)
+	}
+
+	files, err := linkOrCopyDir(filepath.Join(cfg.Home, "data"), filepath.Join(dst, backupDataDir))
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("error while taking data backup: %w", err), os.RemoveAll(dst))
+	}
+	metadata.Files = files
+
+	bz, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dst, backupMetadataFile), bz, 0o600); err != nil {
+		return nil, fmt.Errorf("error while writing backup metadata: %w", err)
+	}
+
+	return metadata, nil
+}
+
+// ListBackups returns the backups of the data directory, oldest first.
+func (cfg *Config) ListBackups() ([]BackupMetadata, error) {
+	entries, err := os.ReadDir(cfg.BackupsDir())
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []BackupMetadata
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), backupPrefix) {
+			continue
+		}
+
+		// backups without metadata are unmanaged copies and are ignored
+		metadata, err := cfg.GetBackup(entry.Name())
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		backups = append(backups, *metadata)
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.Before(backups[j].CreatedAt)
+	})
+
+	return backups, nil
+}
+
+// GetBackup returns the metadata of the named backup.
+func (cfg *Config) GetBackup(name string) (*BackupMetadata, error) {
+	if name != filepath.Base(name) || !strings.HasPrefix(name, backupPrefix) {
+		return nil, fmt.Errorf("invalid backup name %q", name)
+	}
+
+	bz, err := os.ReadFile(filepath.Join(cfg.BackupsDir(), name, backupMetadataFile))
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata BackupMetadata
+	if err := json.Unmarshal(bz, &metadata); err != nil {
+		return nil, fmt.Errorf("invalid metadata of backup %s: %w", name, err)
+	}
+
+	return &metadata, nil
+}
+
+// PruneBackups removes the oldest backups exceeding the retention count, and
+// returns their names. All backups are kept when the retention count is 0.
+func (cfg *Config) PruneBackups() ([]string, error) {
+	if cfg.BackupRetention <= 0 {
+		return nil, nil
+	}
+
+	backups, err := cfg.ListBackups()
+	if err != nil {
+		return nil, err
+	}
+
+	var pruned []string
+	for len(backups) > cfg.BackupRetention {
+		if err := os.RemoveAll(filepath.Join(cfg.BackupsDir(), backups[0].Name)); err != nil {
+			return pruned, err
+		}
+		pruned = append(pruned, backups[0].Name)
+		backups = backups[1:]
+	}
+
+	return pruned, nil
+}
+
+// VerifyBackup checks that the files of the backup match its metadata: their
+// size, and the checksum of the copied files.
+func (cfg *Config) VerifyBackup(metadata *BackupMetadata) error {
+	dataDir := filepath.Join(cfg.BackupsDir(), metadata.Name, backupDataDir)
+	for _, file := range metadata.Files {
+		info, err := os.Lstat(filepath.Join(dataDir, file.Path))
+		if err != nil {
+			return fmt.Errorf("backup %s is corrupted: %w", metadata.Name, err)
+		}
+		if info.Mode().IsRegular() && info.Size() != file.Size {
+			return fmt.Errorf("backup %s is corrupted: %s has size %d, expected %d", metadata.Name, file.Path, info.Size(), file.Size)
+		}
+		if info.Mode().IsRegular() && file.SHA256 != "" {
+			checksum, err := fileChecksum(filepath.Join(dataDir, file.Path))
+			if err != nil {
+				return fmt.Errorf("backup %s is corrupted: %w", metadata.Name, err)
+			}
+			if checksum != file.SHA256 {
+				return fmt.Errorf("backup %s is corrupted: %s has checksum %s, expected %s", metadata.Name, file.Path, checksum, file.SHA256)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RestoreBackup replaces the data directory with the named backup, once its
+// integrity is verified. The replaced data directory is moved aside, and its
+// path is returned. The backup itself is left untouched.
+func (cfg *Config) RestoreBackup(name string) (string, error) {
+	metadata, err := cfg.GetBackup(name)
+	if err != nil {
+		return "", err
+	}
+	if err := cfg.VerifyBackup(metadata); err != nil {
+		return "", err
+	}
+
+	dataDir := filepath.Join(cfg.Home, "data")
+	restoreDir := dataDir + ".restoring"
+	if err := os.RemoveAll(restoreDir); err != nil {
+		return "", err
+	}
+	if _, err := linkOrCopyDir(filepath.Join(cfg.BackupsDir(), name, backupDataDir), restoreDir); err != nil {
+		return "", errors.Join(fmt.Errorf("error while restoring backup %s: %w", name, err), os.RemoveAll(restoreDir))
+	}
+
+	var previousDir string
+	if _, err := os.Stat(dataDir); err == nil {
+		previousDir = filepath.Join(cfg.Home, preRestorePrefix+time.Now().UTC().Format(backupTimeFormat))
+		if err := os.Rename(dataDir, previousDir); err != nil {
+			return "", fmt.Errorf("error while moving the data directory aside: %w", err)
+		}
+	}
+
+	if err := os.Rename(restoreDir, dataDir); err != nil {
+		return "", fmt.Errorf("error while restoring backup %s: %w", name, err)
+	}
+
+	return previousDir, nil
+}
+
+// ReadChainState returns the last block height and app hash recorded by
+// CometBFT in the state database of the data directory.
+func ReadChainState(dataDir string, backend dbm.BackendType) (int64, []byte, error) {
+	// do not let the database create an empty state
+	if _, err := os.Stat(filepath.Join(dataDir, "state.db")); err != nil {
+		return 0, nil, err
+	}
+
+	stateDB, err := dbm.NewDB("state", backend, dataDir)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer stateDB.Close()
+
+	state, err := sm.NewStore(stateDB, sm.StoreOptions{}).Load()
+	if err != nil {
+		return 0, nil, err
+	}
+	if state.IsEmpty() {
+		return 0, nil, errors.New("no state found")
+	}
+
+	return state.LastBlockHeight, state.AppHash, nil
+}
+
+// dbBackend returns the database backend configured for the application.
+func dbBackend(bin, daemonHome string) dbm.BackendType {
+	result, err := exec.Command(bin, "config", "get", "config", "db_backend", "--home", daemonHome).CombinedOutput() //nolint:gosec // we want to execute the config command
+	if err != nil {
+		return dbm.GoLevelDBBackend // default value, old version may not have config command
+	}
+
+	return dbm.BackendType(strings.TrimSpace(string(result)))
+}
+
+// isImmutableDBFile returns true for the database files never modified once
+// written, which can safely be shared through hard links.
+func isImmutableDBFile(path string) bool {
+	switch filepath.Ext(path) {
+	case ".ldb", ".sst":
+		return true
+	default:
+		return false
+	}
+}
+
+// linkOrCopyDir copies the src directory to dst, hard linking immutable database
+// files when possible, and returns the files of dst.
+func linkOrCopyDir(src, dst string) ([]BackupFile, error) {
+	var files []BackupFile
+	err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm()|0o700)
+
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			files = append(files, BackupFile{Path: rel})
+			return os.Symlink(link, target)
+
+		case !info.Mode().IsRegular():
+			return nil
+		}
+
+		file := BackupFile{Path: rel, Size: info.Size()}
+		if isImmutableDBFile(path) && os.Link(path, target) == nil {
+			file.Linked = true
+		} else if file.SHA256, err = copyFile(path, target, info.Mode().Perm()); err != nil {
+			return err
+		}
+		files = append(files, file)
+
+		return nil
+	})
+
+	return files, err
+}
+
+// copyFile copies src to dst and returns the hex encoded SHA-256 checksum of
+// the copied content.
+func copyFile(src, dst string, perm fs.FileMode) (string, error) {
+	in, err := os.Open(filepath.Clean(src))
+	if err != nil {
+		return "", err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(filepath.Clean(dst), os.O_CREATE|os.O_EXCL|os.O_WRONLY, perm)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(out, h), in); err != nil {
+		return "", errors.Join(err, out.Close())
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), out.Close()
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
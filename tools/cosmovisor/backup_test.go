@@ -0,0 +1,124 @@
+package cosmovisor_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+func TestBackupRestore(t *testing.T) {
+	home := t.TempDir()
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", DataBackupPath: t.TempDir()}
+
+	dataDir := filepath.Join(home, "data")
+	require.NoError(t, os.MkdirAll(filepath.Join(dataDir, "application.db"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "application.db", "000001.ldb"), []byte("table"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "application.db", "000002.log"), []byte("log"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "upgrade-info.json"), []byte(`{"name":"v2","height":10}`), 0o600))
+
+	backup, err := cfg.CreateBackup("v2", 9, []byte{0xab, 0xcd})
+	require.NoError(t, err)
+	require.Equal(t, "v2", backup.Upgrade)
+	require.Equal(t, int64(9), backup.Height)
+	require.Equal(t, "ABCD", backup.AppHash.String())
+	require.Len(t, backup.Files, 3)
+
+	// immutable tables are hard linked, the other files are copied and checksummed
+	for _, file := range backup.Files {
+		require.Equal(t, filepath.Ext(file.Path) == ".ldb", file.Linked, file.Path)
+		require.Equal(t, file.Linked, file.SHA256 == "", file.Path)
+	}
+
+	backups, err := cfg.ListBackups()
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	require.Equal(t, backup.Name, backups[0].Name)
+
+	// the data directory changes after the backup
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "application.db", "000002.log"), []byte("log after the upgrade"), 0o600))
+	require.NoError(t, os.Remove(filepath.Join(dataDir, "application.db", "000001.ldb")))
+
+	previousDir, err := cfg.RestoreBackup(backup.Name)
+	require.NoError(t, err)
+	require.DirExists(t, previousDir)
+
+	bz, err := os.ReadFile(filepath.Join(dataDir, "application.db", "000002.log"))
+	require.NoError(t, err)
+	require.Equal(t, "log", string(bz))
+	require.FileExists(t, filepath.Join(dataDir, "application.db", "000001.ldb"))
+
+	// a corrupted backup is not restored, even when the size of the file is unchanged
+	backupDataDir := filepath.Join(cfg.DataBackupPath, backup.Name, "data")
+	require.NoError(t, os.WriteFile(filepath.Join(backupDataDir, "application.db", "000002.log"), []byte("gol"), 0o600))
+	_, err = cfg.RestoreBackup(backup.Name)
+	require.ErrorContains(t, err, "000002.log has checksum")
+
+	require.NoError(t, os.WriteFile(filepath.Join(backupDataDir, "upgrade-info.json"), []byte("{}"), 0o600))
+	_, err = cfg.RestoreBackup(backup.Name)
+	require.ErrorContains(t, err, "is corrupted")
+
+	_, err = cfg.RestoreBackup("../data")
+	require.ErrorContains(t, err, "invalid backup name")
+}
+
+func TestPruneBackups(t *testing.T) {
+	home := t.TempDir()
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", DataBackupPath: t.TempDir(), BackupRetention: 2}
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o755))
+
+	// unmanaged backups are left alone
+	require.NoError(t, os.MkdirAll(filepath.Join(cfg.DataBackupPath, "data-backup-2024-1-1"), 0o755))
+
+	var names []string
+	for _, upgrade := range []string{"v2", "v3", "v4"} {
+		backup, err := cfg.CreateBackup(upgrade, 1, nil)
+		require.NoError(t, err)
+		names = append(names, backup.Name)
+	}
+
+	pruned, err := cfg.PruneBackups()
+	require.NoError(t, err)
+	require.Equal(t, names[:1], pruned)
+
+	backups, err := cfg.ListBackups()
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	require.Equal(t, names[1], backups[0].Name)
+	require.Equal(t, names[2], backups[1].Name)
+	require.DirExists(t, filepath.Join(cfg.DataBackupPath, "data-backup-2024-1-1"))
+}
+
+func TestReadChainState(t *testing.T) {
+	dataDir := t.TempDir()
+
+	_, _, err := cosmovisor.ReadChainState(dataDir, dbm.GoLevelDBBackend)
+	require.Error(t, err)
+
+	db, err := dbm.NewDB("state", dbm.GoLevelDBBackend, dataDir)
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(ed25519.GenPrivKey().PubKey(), 10)})
+	state := sm.State{
+		ChainID:         "test",
+		InitialHeight:   1,
+		LastBlockHeight: 9,
+		AppHash:         []byte{0xab, 0xcd},
+		Validators:      valSet,
+		NextValidators:  valSet,
+		LastValidators:  valSet,
+	}
+	require.NoError(t, sm.NewStore(db, sm.StoreOptions{}).Save(state))
+	require.NoError(t, db.Close())
+
+	height, appHash, err := cosmovisor.ReadChainState(dataDir, dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+	require.Equal(t, int64(9), height)
+	require.Equal(t, []byte{0xab, 0xcd}, appHash)
+}
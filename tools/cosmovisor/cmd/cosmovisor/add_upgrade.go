@@ -25,12 +25,16 @@ func NewAddUpgradeCmd() *cobra.Command {
 
 	addUpgrade.Flags().Bool(cosmovisor.FlagForce, false, "overwrite existing upgrade binary / upgrade-info.json file")
 	addUpgrade.Flags().Int64(cosmovisor.FlagUpgradeHeight, 0, "define a height at which to upgrade the binary automatically (without governance proposal)")
+	addUpgrade.Flags().String(cosmovisor.FlagManifest, "", "path or URL of the signed release manifest the executable is verified against (required when trusted keys are configured)")
+	addUpgrade.Flags().String(cosmovisor.FlagManifestSignature, "", "path or URL of the minisign signature of the release manifest (defaults to the manifest location with the .minisig suffix)")
 
 	return addUpgrade
 }
 
-// addUpgrade adds upgrade info to manifest
-func addUpgrade(cfg *cosmovisor.Config, force bool, upgradeHeight int64, upgradeName, executablePath, upgradeInfoPath string) error {
+// addUpgrade adds upgrade info to manifest.
+// When trusted keys are configured, or a manifest is given, the executable must match the checksum of the
+// signed release manifest.
+func addUpgrade(cfg *cosmovisor.Config, force bool, upgradeHeight int64, upgradeName, executablePath, upgradeInfoPath string, manifest cosmovisor.ManifestInfo) error {
 	logger := cfg.Logger(os.Stdout)
 
 	if !cfg.DisableRecase {
@@ -45,6 +49,13 @@ func addUpgrade(cfg *cosmovisor.Config, force bool, upgradeHeight int64, upgrade
 		return fmt.Errorf("failed to load executable path: %w", err)
 	}
 
+	if cfg.TrustedKeys != "" || manifest.Manifest != "" {
+		if err := verifyExecutable(cfg, upgradeName, executablePath, manifest); err != nil {
+			return fmt.Errorf("refusing upgrade %s: %w", upgradeName, err)
+		}
+		logger.Info(fmt.Sprintf("Verified %s against the release manifest %s", executablePath, manifest.Manifest))
+	}
+
 	// create upgrade dir
 	upgradeLocation := cfg.UpgradeDir(upgradeName)
 	if err := os.MkdirAll(path.Join(upgradeLocation, "bin"), 0o755); err != nil {
@@ -119,7 +130,38 @@ func addUpgradeCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get upgrade-height flag: %w", err)
 	}
 
-	return addUpgrade(cfg, force, upgradeHeight, upgradeName, executablePath, cfg.UpgradeInfoFilePath())
+	manifest, err := cmd.Flags().GetString(cosmovisor.FlagManifest)
+	if err != nil {
+		return fmt.Errorf("failed to get manifest flag: %w", err)
+	}
+
+	manifestSignature, err := cmd.Flags().GetString(cosmovisor.FlagManifestSignature)
+	if err != nil {
+		return fmt.Errorf("failed to get manifest-signature flag: %w", err)
+	}
+
+	manifestInfo := cosmovisor.ManifestInfo{Manifest: manifest, ManifestSignature: manifestSignature}
+	return addUpgrade(cfg, force, upgradeHeight, upgradeName, executablePath, cfg.UpgradeInfoFilePath(), manifestInfo)
+}
+
+// verifyExecutable checks the executable against the checksum of the signed release manifest.
+func verifyExecutable(cfg *cosmovisor.Config, upgradeName, executablePath string, manifest cosmovisor.ManifestInfo) error {
+	trustedKeys, err := cosmovisor.ParseTrustedKeys(cfg.TrustedKeys)
+	if err != nil {
+		return err
+	}
+
+	releaseManifest, err := cosmovisor.FetchVerifiedManifest(manifest, upgradeName, trustedKeys)
+	if err != nil {
+		return err
+	}
+
+	bin, err := releaseManifest.Binary()
+	if err != nil {
+		return err
+	}
+
+	return bin.VerifyFile(executablePath)
 }
 
 // saveOrAbort saves data to path or aborts if file exists and force is false
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+func NewBackupCmd() *cobra.Command {
+	backupCmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Manage the data directory backups taken before upgrades",
+	}
+
+	backupCmd.AddCommand(NewBackupListCmd(), NewBackupRestoreCmd())
+
+	return backupCmd
+}
+
+func NewBackupListCmd() *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:          "list",
+		Short:        "List the data directory backups, oldest first",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := getConfigFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(cosmovisor.FlagOutput)
+			if err != nil {
+				return fmt.Errorf("failed to get output flag: %w", err)
+			}
+
+			backups, err := cfg.ListBackups()
+			if err != nil {
+				return fmt.Errorf("failed to list backups: %w", err)
+			}
+
+			if output == "json" {
+				// the file list is only needed to verify a backup
+				for i := range backups {
+					backups[i].Files = nil
+				}
+
+				bz, err := json.MarshalIndent(backups, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+				return nil
+			}
+
+			if len(backups) == 0 {
+				cmd.Printf("No backup found in %s\n", cfg.BackupsDir())
+				return nil
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tUPGRADE\tHEIGHT\tAPP HASH\tCREATED AT")
+			for _, backup := range backups {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", backup.Name, backup.Upgrade, backup.Height, backup.AppHash, backup.CreatedAt.Format("2006-01-02 15:04:05"))
+			}
+			return w.Flush()
+		},
+	}
+
+	listCmd.Flags().StringP(cosmovisor.FlagOutput, "o", "text", "Output format (text|json)")
+
+	return listCmd
+}
+
+func NewBackupRestoreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <name>",
+		Short: "Restore the data directory from a backup",
+		Long: `Restore the data directory from a backup, once its integrity is verified against its metadata.
+The current data directory is moved aside, and the backup is left untouched.
+The node must be stopped.`,
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := getConfigFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			logger := cfg.Logger(cmd.OutOrStdout())
+
+			previousDir, err := cfg.RestoreBackup(args[0])
+			if err != nil {
+				return err
+			}
+
+			if previousDir != "" {
+				logger.Info("previous data directory moved aside", "path", previousDir)
+			}
+			logger.Info("backup restored", "name", args[0])
+
+			return nil
+		},
+	}
+}
@@ -31,7 +31,8 @@ You can provide upgrades in two ways:
    c. upgrade-height: The block height at which the upgrade should occur
    This creates a batch upgrade JSON file with the upgrade-info objects in the upgrade directory.
 
-Note: You must provide either --upgrade-file or --upgrade-list.`,
+Note: You must provide either --upgrade-file or --upgrade-list.
+Batch upgrades are refused when COSMOVISOR_TRUSTED_KEYS is set, as they cannot be verified against a release manifest.`,
 		Example: `cosmovisor add-batch-upgrade --upgrade-list upgrade_v2:/path/to/v2/binary:1000000,upgrade_v3:/path/to/v3/binary:2000000
 
 cosmovisor add-batch-upgrade --upgrade-file /path/to/batch_upgrade.csv`,
@@ -71,6 +72,11 @@ func addBatchUpgrade(cmd *cobra.Command, args []string) error {
 
 // processUpgradeList takes in a list of upgrades and creates a batch upgrade file
 func processUpgradeList(cfg *cosmovisor.Config, upgradeList [][]string) error {
+	// the batch format has no release manifest to verify the executables against
+	if cfg.TrustedKeys != "" {
+		return fmt.Errorf("batch upgrades cannot be verified against a release manifest, add the upgrades one at a time with add-upgrade --manifest when %s is set", cosmovisor.EnvTrustedKeys)
+	}
+
 	upgradeInfoPaths := []string{}
 	for i, upgrade := range upgradeList {
 		if len(upgrade) != 3 {
@@ -84,7 +90,7 @@ func processUpgradeList(cfg *cosmovisor.Config, upgradeList [][]string) error {
 		}
 		upgradeInfoPath := cfg.UpgradeInfoFilePath() + "." + upgradeName
 		upgradeInfoPaths = append(upgradeInfoPaths, upgradeInfoPath)
-		if err := addUpgrade(cfg, true, upgradeHeight, upgradeName, upgradePath, upgradeInfoPath); err != nil {
+		if err := addUpgrade(cfg, true, upgradeHeight, upgradeName, upgradePath, upgradeInfoPath, cosmovisor.ManifestInfo{}); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+func TestBatchUpgradeWithTrustedKeys(t *testing.T) {
+	home := t.TempDir()
+	cfg := &cosmovisor.Config{
+		Home:        home,
+		Name:        "dummyd",
+		TrustedKeys: "RWQf6LRCGA9i53mlYecO4IzT51TGPpvWucNSCh1CBM0QTaLn73Y7GFO3",
+	}
+
+	executable := filepath.Join(home, "dummyd")
+	require.NoError(t, os.WriteFile(executable, []byte("#!/bin/sh\n"), 0o700))
+
+	err := processUpgradeList(cfg, [][]string{{"v2", executable, "100"}})
+	require.ErrorContains(t, err, "batch upgrades cannot be verified against a release manifest")
+	require.NoDirExists(t, cfg.UpgradeDir("v2"))
+	require.NoFileExists(t, cfg.UpgradeInfoBatchFilePath())
+}
@@ -24,6 +24,7 @@ func NewPrepareUpgradeCmd() *cobra.Command {
 		Short: "Prepare for the next upgrade",
 		Long: `Prepare for the next upgrade by downloading and verifying the upgrade binary.
 This command will query the chain for the current upgrade plan and download the specified binary.
+When trusted keys are configured, the binary is the one of the signed release manifest of the plan.
 gRPC must be enabled on the node for this command to work.`,
 		RunE:         prepareUpgradeHandler,
 		SilenceUsage: false,
@@ -61,14 +62,9 @@ func prepareUpgradeHandler(cmd *cobra.Command, _ []string) error {
 
 	logger.Info("Preparing for upgrade", "name", upgradeInfo.Name, "height", upgradeInfo.Height)
 
-	upgradeInfoParsed, err := plan.ParseInfo(upgradeInfo.Info, plan.ParseOptionEnforceChecksum(cfg.DownloadMustHaveChecksum))
+	binaryURL, err := cfg.GetUpgradeBinaryURL(*upgradeInfo)
 	if err != nil {
-		return fmt.Errorf("failed to parse upgrade info: %w", err)
-	}
-
-	binaryURL, err := cosmovisor.GetBinaryURL(upgradeInfoParsed.Binaries)
-	if err != nil {
-		return fmt.Errorf("binary URL not found in upgrade plan. Cannot prepare for upgrade: %w", err)
+		return fmt.Errorf("cannot prepare for upgrade: %w", err)
 	}
 
 	logger.Info("Downloading upgrade binary", "url", binaryURL)
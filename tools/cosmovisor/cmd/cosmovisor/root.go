@@ -22,6 +22,7 @@ func NewRootCmd() *cobra.Command {
 		NewShowUpgradeInfoCmd(),
 		NewBatchAddUpgradeCmd(),
 		NewPrepareUpgradeCmd(),
+		NewBackupCmd(),
 	)
 
 	rootCmd.PersistentFlags().StringP(cosmovisor.FlagCosmovisorConfig, "c", "", "path to cosmovisor config file")
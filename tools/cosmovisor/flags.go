@@ -7,4 +7,6 @@ const (
 	FlagForce             = "force"
 	FlagUpgradeHeight     = "upgrade-height"
 	FlagCosmovisorConfig  = "cosmovisor-config"
+	FlagManifest          = "manifest"
+	FlagManifestSignature = "manifest-signature"
 )
@@ -0,0 +1,407 @@
+package cosmovisor
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/cosmos/cosmos-sdk/x/upgrade/plan"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	// minisign signature algorithms: Ed signs the file, ED signs its BLAKE2b-512 hash.
+	minisignAlgorithm        = "Ed"
+	minisignHashedAlgorithm  = "ED"
+	minisignKeyIDSize        = 8
+	minisignPublicKeySize    = 2 + minisignKeyIDSize + ed25519.PublicKeySize
+	minisignSignatureSize    = 2 + minisignKeyIDSize + ed25519.SignatureSize
+	minisignTrustedComment   = "trusted comment: "
+	manifestSignatureSuffix  = ".minisig"
+	maxManifestDownloadBytes = 1 << 20
+)
+
+// ManifestInfo is the part of the Plan.Info string (as json) referencing the
+// signed release manifest of an upgrade.
+type ManifestInfo struct {
+	// Manifest is the URL or path of the release manifest.
+	Manifest string `json:"manifest"`
+	// ManifestSignature is the URL or path of the minisign signature of the
+	// manifest. It defaults to the manifest location with the .minisig suffix.
+	ManifestSignature string `json:"manifest_signature,omitempty"`
+}
+
+// SignatureLocation returns the location of the manifest signature.
+func (m ManifestInfo) SignatureLocation() string {
+	if m.ManifestSignature != "" {
+		return m.ManifestSignature
+	}
+
+	return m.Manifest + manifestSignatureSuffix
+}
+
+// ReleaseManifest lists the binaries of a release, per os/arch, along with
+// their checksums.
+type ReleaseManifest struct {
+	Name     string                    `json:"name"`
+	Binaries map[string]ManifestBinary `json:"binaries"`
+}
+
+// ManifestBinary is a binary of a release manifest.
+type ManifestBinary struct {
+	URL string `json:"url"`
+	// Checksum is the checksum of the file at URL, as <sha256|sha512>:<hex>.
+	Checksum string `json:"checksum"`
+}
+
+// Binary returns the binary of the manifest for the current os/arch, or for
+// any os/arch.
+func (m ReleaseManifest) Binary() (ManifestBinary, error) {
+	bin, ok := m.Binaries[OSArch()]
+	if !ok {
+		bin, ok = m.Binaries["any"]
+	}
+	if !ok {
+		return ManifestBinary{}, fmt.Errorf("release manifest %s has no binary for os/arch: neither %s, nor any", m.Name, OSArch())
+	}
+	if _, _, err := parseChecksum(bin.Checksum); err != nil {
+		return ManifestBinary{}, fmt.Errorf("release manifest %s: invalid checksum for %s: %w", m.Name, bin.URL, err)
+	}
+
+	return bin, nil
+}
+
+// DownloadURL returns the URL of the binary with its checksum set as the
+// checksum query parameter, so the download is verified against it.
+func (b ManifestBinary) DownloadURL() (string, error) {
+	u, err := neturl.Parse(b.URL)
+	if err != nil {
+		return "", fmt.Errorf("invalid binary url %q: %w", b.URL, err)
+	}
+
+	query := u.Query()
+	switch checksum := query.Get("checksum"); {
+	case checksum == "":
+		query.Set("checksum", b.Checksum)
+		u.RawQuery = query.Encode()
+	case !strings.EqualFold(checksum, b.Checksum):
+		return "", fmt.Errorf("checksum %s of binary url %q does not match the release manifest checksum %s", checksum, b.URL, b.Checksum)
+	}
+
+	return u.String(), nil
+}
+
+// VerifyFile checks that the checksum of the file at path matches the one of the binary.
+func (b ManifestBinary) VerifyFile(path string) error {
+	algo, expected, err := parseChecksum(b.Checksum)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := newChecksumHash(algo)
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("failed to compute checksum of %s: %w", path, err)
+	}
+
+	if !bytes.Equal(h.Sum(nil), expected) {
+		return fmt.Errorf("checksum of %s is %s:%x, the release manifest requires %s", path, algo, h.Sum(nil), b.Checksum)
+	}
+
+	return nil
+}
+
+func parseChecksum(checksum string) (string, []byte, error) {
+	algo, value, ok := strings.Cut(checksum, ":")
+	if !ok {
+		return "", nil, fmt.Errorf("checksum %q must have the format <sha256|sha512>:<hex>", checksum)
+	}
+
+	bz, err := hex.DecodeString(value)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid checksum %q: %w", checksum, err)
+	}
+
+	algo = strings.ToLower(algo)
+	if h := newChecksumHash(algo); h == nil || h.Size() != len(bz) {
+		return "", nil, fmt.Errorf("checksum %q must have the format <sha256|sha512>:<hex>", checksum)
+	}
+
+	return algo, bz, nil
+}
+
+func newChecksumHash(algo string) hash.Hash {
+	switch algo {
+	case "sha256":
+		return sha256.New()
+	case "sha512":
+		return sha512.New()
+	default:
+		return nil
+	}
+}
+
+// TrustedKey is a minisign ed25519 public key release manifests are verified against.
+type TrustedKey struct {
+	ID        [minisignKeyIDSize]byte
+	PublicKey ed25519.PublicKey
+}
+
+// String returns the key ID, as displayed by minisign.
+func (k TrustedKey) String() string {
+	return fmt.Sprintf("%016X", binary.LittleEndian.Uint64(k.ID[:]))
+}
+
+// ParseTrustedKeys parses a comma separated list of minisign public keys. Each
+// entry is either a base64 encoded public key or the path of a minisign public
+// key file.
+func ParseTrustedKeys(keys string) ([]TrustedKey, error) {
+	var trusted []TrustedKey
+	for _, entry := range strings.Split(keys, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		encoded := entry
+		if filepath.IsAbs(entry) {
+			bz, err := os.ReadFile(filepath.Clean(entry))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read trusted key file: %w", err)
+			}
+
+			if encoded, err = lastLine(bz); err != nil {
+				return nil, fmt.Errorf("invalid trusted key file %s: %w", entry, err)
+			}
+		}
+
+		key, err := parseMinisignPublicKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted key %q: %w", entry, err)
+		}
+		trusted = append(trusted, key)
+	}
+
+	return trusted, nil
+}
+
+func parseMinisignPublicKey(encoded string) (TrustedKey, error) {
+	bz, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return TrustedKey{}, err
+	}
+	if len(bz) != minisignPublicKeySize {
+		return TrustedKey{}, fmt.Errorf("expected %d bytes, got %d", minisignPublicKeySize, len(bz))
+	}
+	if string(bz[:2]) != minisignAlgorithm {
+		return TrustedKey{}, fmt.Errorf("unsupported key algorithm %q", bz[:2])
+	}
+
+	var key TrustedKey
+	copy(key.ID[:], bz[2:2+minisignKeyIDSize])
+	key.PublicKey = ed25519.PublicKey(bz[2+minisignKeyIDSize:])
+	return key, nil
+}
+
+// lastLine returns the last non-empty line of a minisign key file, holding the key.
+func lastLine(bz []byte) (string, error) {
+	var line string
+	scanner := bufio.NewScanner(bytes.NewReader(bz))
+	for scanner.Scan() {
+		if l := strings.TrimSpace(scanner.Text()); l != "" {
+			line = l
+		}
+	}
+	if line == "" {
+		return "", errors.New("no key found")
+	}
+
+	return line, scanner.Err()
+}
+
+// VerifyManifest verifies the minisign signature of the manifest against the
+// trusted keys, and parses it.
+func VerifyManifest(manifest, signature []byte, trustedKeys []TrustedKey) (*ReleaseManifest, error) {
+	if len(trustedKeys) == 0 {
+		return nil, errors.New("no trusted keys configured")
+	}
+
+	lines := strings.Split(strings.TrimRight(string(signature), "\r\n"), "\n")
+	if len(lines) != 4 {
+		return nil, fmt.Errorf("invalid manifest signature: expected 4 lines, got %d", len(lines))
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[1]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest signature: %w", err)
+	}
+	if len(sig) != minisignSignatureSize {
+		return nil, fmt.Errorf("invalid manifest signature: expected %d bytes, got %d", minisignSignatureSize, len(sig))
+	}
+
+	trustedComment, ok := strings.CutPrefix(strings.TrimRight(lines[2], "\r"), minisignTrustedComment)
+	if !ok {
+		return nil, errors.New("invalid manifest signature: missing trusted comment")
+	}
+	globalSig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[3]))
+	if err != nil || len(globalSig) != ed25519.SignatureSize {
+		return nil, errors.New("invalid manifest signature: invalid trusted comment signature")
+	}
+
+	message := manifest
+	switch algo := string(sig[:2]); algo {
+	case minisignAlgorithm:
+	case minisignHashedAlgorithm:
+		digest := blake2b.Sum512(manifest)
+		message = digest[:]
+	default:
+		return nil, fmt.Errorf("invalid manifest signature: unsupported algorithm %q", algo)
+	}
+
+	var key *TrustedKey
+	for i := range trustedKeys {
+		if bytes.Equal(trustedKeys[i].ID[:], sig[2:2+minisignKeyIDSize]) {
+			key = &trustedKeys[i]
+			break
+		}
+	}
+	if key == nil {
+		return nil, fmt.Errorf("manifest is signed by key %016X, which is not a trusted key", binary.LittleEndian.Uint64(sig[2:2+minisignKeyIDSize]))
+	}
+
+	sigBytes := sig[2+minisignKeyIDSize:]
+	if !ed25519.Verify(key.PublicKey, message, sigBytes) {
+		return nil, fmt.Errorf("manifest signature verification failed with trusted key %s", key)
+	}
+	if !ed25519.Verify(key.PublicKey, append(bytes.Clone(sigBytes), trustedComment...), globalSig) {
+		return nil, fmt.Errorf("manifest trusted comment signature verification failed with trusted key %s", key)
+	}
+
+	var releaseManifest ReleaseManifest
+	if err := json.Unmarshal(manifest, &releaseManifest); err != nil {
+		return nil, fmt.Errorf("could not parse release manifest: %w", err)
+	}
+
+	return &releaseManifest, nil
+}
+
+// FetchVerifiedManifest fetches the release manifest and its signature, verifies
+// it against the trusted keys and checks it is the manifest of the named upgrade.
+func FetchVerifiedManifest(info ManifestInfo, upgradeName string, trustedKeys []TrustedKey) (*ReleaseManifest, error) {
+	if info.Manifest == "" {
+		return nil, fmt.Errorf("upgrade %s has no release manifest, which is required as trusted keys are configured", upgradeName)
+	}
+
+	manifest, err := fetchFile(info.Manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch release manifest: %w", err)
+	}
+	signature, err := fetchFile(info.SignatureLocation())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch release manifest signature: %w", err)
+	}
+
+	releaseManifest, err := VerifyManifest(manifest, signature, trustedKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(releaseManifest.Name, upgradeName) {
+		return nil, fmt.Errorf("release manifest is for upgrade %q, expected %q", releaseManifest.Name, upgradeName)
+	}
+
+	return releaseManifest, nil
+}
+
+// fetchFile returns the content of the file at the http(s) URL or local path.
+func fetchFile(location string) ([]byte, error) {
+	u, err := neturl.Parse(location)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return os.ReadFile(filepath.Clean(strings.TrimPrefix(location, "file://")))
+	}
+
+	client := &http.Client{Timeout: time.Minute}
+	resp, err := client.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not download %q: %s", location, resp.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxManifestDownloadBytes))
+}
+
+// GetUpgradeBinaryURL returns the URL the upgrade binary is downloaded from.
+// When trusted keys are configured, the binary is the one listed in the signed
+// release manifest referenced by the plan info, and its URL carries the
+// manifest checksum. Otherwise, it is the binary of the plan info.
+func (cfg *Config) GetUpgradeBinaryURL(p upgradetypes.Plan) (string, error) {
+	info := strings.TrimSpace(p.Info)
+
+	// If it's a url, download it once, as both the binaries and the manifest are read from it.
+	if _, err := neturl.ParseRequestURI(info); err == nil {
+		if err := plan.ValidateURL(info, cfg.DownloadMustHaveChecksum); err != nil {
+			return "", fmt.Errorf("cannot parse upgrade info: %w", err)
+		}
+		if info, err = plan.DownloadURL(info); err != nil {
+			return "", fmt.Errorf("cannot parse upgrade info: %w", err)
+		}
+	}
+
+	if cfg.TrustedKeys == "" {
+		upgradeInfo, err := plan.ParseInfo(info, plan.ParseOptionEnforceChecksum(cfg.DownloadMustHaveChecksum))
+		if err != nil {
+			return "", fmt.Errorf("cannot parse upgrade info: %w", err)
+		}
+
+		return GetBinaryURL(upgradeInfo.Binaries)
+	}
+
+	trustedKeys, err := ParseTrustedKeys(cfg.TrustedKeys)
+	if err != nil {
+		return "", err
+	}
+
+	var manifestInfo ManifestInfo
+	if err := json.Unmarshal([]byte(info), &manifestInfo); err != nil {
+		return "", fmt.Errorf("cannot parse upgrade info: %w", err)
+	}
+
+	releaseManifest, err := FetchVerifiedManifest(manifestInfo, p.Name, trustedKeys)
+	if err != nil {
+		return "", fmt.Errorf("refusing upgrade %s: %w", p.Name, err)
+	}
+
+	bin, err := releaseManifest.Binary()
+	if err != nil {
+		return "", fmt.Errorf("refusing upgrade %s: %w", p.Name, err)
+	}
+
+	return bin.DownloadURL()
+}
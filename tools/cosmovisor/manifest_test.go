@@ -0,0 +1,177 @@
+//go:build linux || darwin
+
+package cosmovisor_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+// testSigner signs release manifests the way minisign does.
+type testSigner struct {
+	id      [8]byte
+	privKey ed25519.PrivateKey
+	pubKey  ed25519.PublicKey
+}
+
+func newTestSigner(t *testing.T) *testSigner {
+	t.Helper()
+
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	signer := &testSigner{privKey: privKey, pubKey: pubKey}
+	_, err = rand.Read(signer.id[:])
+	require.NoError(t, err)
+
+	return signer
+}
+
+// PublicKey returns the base64 encoded minisign public key.
+func (s *testSigner) PublicKey() string {
+	bz := append([]byte("Ed"), s.id[:]...)
+	return base64.StdEncoding.EncodeToString(append(bz, s.pubKey...))
+}
+
+// Sign returns the minisign signature file of data, prehashed or not.
+func (s *testSigner) Sign(data []byte, prehashed bool) []byte {
+	algo, message := "Ed", data
+	if prehashed {
+		digest := blake2b.Sum512(data)
+		algo, message = "ED", digest[:]
+	}
+
+	sig := ed25519.Sign(s.privKey, message)
+	trustedComment := "timestamp:1700000000\tfile:manifest.json"
+	globalSig := ed25519.Sign(s.privKey, append(bytes.Clone(sig), trustedComment...))
+
+	sigBz := append(append([]byte(algo), s.id[:]...), sig...)
+	return fmt.Appendf(nil, "untrusted comment: signature from minisign secret key\n%s\ntrusted comment: %s\n%s\n",
+		base64.StdEncoding.EncodeToString(sigBz), trustedComment, base64.StdEncoding.EncodeToString(globalSig))
+}
+
+func TestVerifyManifest(t *testing.T) {
+	signer, other := newTestSigner(t), newTestSigner(t)
+	trustedKeys, err := cosmovisor.ParseTrustedKeys(signer.PublicKey())
+	require.NoError(t, err)
+
+	manifest := []byte(`{"name":"v2","binaries":{"any":{"url":"https://example.com/v2","checksum":"sha256:e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d"}}}`)
+
+	tamperedComment := bytes.Replace(signer.Sign(manifest, true), []byte("manifest.json"), []byte("manifest.txt"), 1)
+
+	cases := map[string]struct {
+		manifest    []byte
+		signature   []byte
+		trustedKeys []cosmovisor.TrustedKey
+		expErr      string
+	}{
+		"prehashed signature": {
+			manifest:    manifest,
+			signature:   signer.Sign(manifest, true),
+			trustedKeys: trustedKeys,
+		},
+		"legacy signature": {
+			manifest:    manifest,
+			signature:   signer.Sign(manifest, false),
+			trustedKeys: trustedKeys,
+		},
+		"no trusted keys": {
+			manifest:  manifest,
+			signature: signer.Sign(manifest, true),
+			expErr:    "no trusted keys configured",
+		},
+		"untrusted key": {
+			manifest:    manifest,
+			signature:   other.Sign(manifest, true),
+			trustedKeys: trustedKeys,
+			expErr:      "which is not a trusted key",
+		},
+		"tampered manifest": {
+			manifest:    bytes.Replace(manifest, []byte("example.com"), []byte("example.org"), 1),
+			signature:   signer.Sign(manifest, true),
+			trustedKeys: trustedKeys,
+			expErr:      "manifest signature verification failed",
+		},
+		"tampered trusted comment": {
+			manifest:    manifest,
+			signature:   tamperedComment,
+			trustedKeys: trustedKeys,
+			expErr:      "trusted comment signature verification failed",
+		},
+		"malformed signature": {
+			manifest:    manifest,
+			signature:   []byte("not a signature"),
+			trustedKeys: trustedKeys,
+			expErr:      "invalid manifest signature",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			releaseManifest, err := cosmovisor.VerifyManifest(tc.manifest, tc.signature, tc.trustedKeys)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "v2", releaseManifest.Name)
+
+			bin, err := releaseManifest.Binary()
+			require.NoError(t, err)
+			require.Equal(t, "https://example.com/v2", bin.URL)
+		})
+	}
+}
+
+func TestParseTrustedKeys(t *testing.T) {
+	signer, other := newTestSigner(t), newTestSigner(t)
+
+	keyFile := filepath.Join(t.TempDir(), "minisign.pub")
+	require.NoError(t, os.WriteFile(keyFile, []byte("untrusted comment: minisign public key\n"+other.PublicKey()+"\n"), 0o600))
+
+	keys, err := cosmovisor.ParseTrustedKeys(signer.PublicKey() + ", " + keyFile)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.Equal(t, signer.id[:], keys[0].ID[:])
+	require.Equal(t, other.pubKey, keys[1].PublicKey)
+
+	keys, err = cosmovisor.ParseTrustedKeys("")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	_, err = cosmovisor.ParseTrustedKeys("not-a-key")
+	require.ErrorContains(t, err, "invalid trusted key")
+
+	_, err = cosmovisor.ParseTrustedKeys(filepath.Join(t.TempDir(), "missing.pub"))
+	require.ErrorContains(t, err, "failed to read trusted key file")
+}
+
+func TestManifestBinary(t *testing.T) {
+	checksum := "sha256:e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d"
+
+	url, err := cosmovisor.ManifestBinary{URL: "https://example.com/autod?x=1", Checksum: checksum}.DownloadURL()
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/autod?checksum=sha256%3Ae6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d&x=1", url)
+
+	_, err = cosmovisor.ManifestBinary{URL: "https://example.com/autod?checksum=sha256:00", Checksum: checksum}.DownloadURL()
+	require.ErrorContains(t, err, "does not match the release manifest checksum")
+
+	bin := cosmovisor.ManifestBinary{URL: "https://example.com/autod", Checksum: checksum}
+	require.NoError(t, bin.VerifyFile(filepath.Join(workDir, "testdata/repo/raw_binary/autod")))
+	require.ErrorContains(t, bin.VerifyFile(filepath.Join(workDir, "testdata/repo/chain2-zip_bin/autod")), "the release manifest requires")
+
+	_, err = cosmovisor.ReleaseManifest{Name: "v2", Binaries: map[string]cosmovisor.ManifestBinary{"any": {URL: bin.URL}}}.Binary()
+	require.ErrorContains(t, err, "invalid checksum")
+}
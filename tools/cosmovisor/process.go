@@ -18,7 +18,6 @@ import (
 	"time"
 
 	"github.com/fsnotify/fsnotify"
-	"github.com/otiai10/copy"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
@@ -339,16 +338,28 @@ func (l Launcher) doBackup() error {
 			return errors.New("upgrade-info.json is empty")
 		}
 
-		// a destination directory, Format YYYY-MM-DD
 		st := time.Now()
-		ymd := fmt.Sprintf("%d-%d-%d", st.Year(), st.Month(), st.Day())
-		dst := filepath.Join(l.cfg.DataBackupPath, fmt.Sprintf("data"+"-backup-%s", ymd))
-
 		l.logger.Info("starting to take backup of data directory", "backup start time", st)
 
-		// copy the $DAEMON_HOME/data to a backup dir
-		if err = copy.Copy(filepath.Join(l.cfg.Home, "data"), dst); err != nil {
-			return fmt.Errorf("error while taking data backup: %w", err)
+		height, appHash, err := l.readChainState()
+		if err != nil {
+			// the chain halted at the upgrade height, so the state is the one of the previous height
+			height = uInfo.Height - 1
+			l.logger.Warn("cannot read the chain state, the backup app hash is not recorded", "error", err)
+		}
+
+		backup, err := l.cfg.CreateBackup(uInfo.Name, height, appHash)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(l.cfg.BackupsDir(), backup.Name)
+
+		pruned, err := l.cfg.PruneBackups()
+		if err != nil {
+			l.logger.Warn("error while pruning backups", "error", err)
+		}
+		for _, name := range pruned {
+			l.logger.Info("backup pruned", "name", name)
 		}
 
 		// backup is done, lets check endtime to calculate total time taken for the backup process
@@ -359,6 +370,16 @@ func (l Launcher) doBackup() error {
 	return nil
 }
 
+// readChainState reads the last block height and app hash of the stopped application.
+func (l Launcher) readChainState() (int64, []byte, error) {
+	currentBin, err := l.cfg.CurrentBin()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return ReadChainState(filepath.Join(l.cfg.Home, "data"), dbBackend(currentBin, l.cfg.Home))
+}
+
 // doCustomPreUpgrade executes the custom preupgrade script if provided.
 func (l Launcher) doCustomPreUpgrade() error {
 	if l.cfg.CustomPreUpgrade == "" {
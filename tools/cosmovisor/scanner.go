@@ -200,11 +200,7 @@ func (fw *fileWatcher) checkHeight() (int64, error) {
 	}
 
 	if fw.IsStop() {
-		result, err := exec.Command(fw.currentBin, "config", "get", "config", "db_backend", "--home", fw.daemonHome).CombinedOutput() //nolint:gosec // we want to execute the config command
-		if err != nil {
-			result = []byte("goleveldb") // set default value, old version may not have config command
-		}
-		blockStoreDB, err := dbm.NewDB("blockstore", dbm.BackendType(result), filepath.Join(fw.daemonHome, "data"))
+		blockStoreDB, err := dbm.NewDB("blockstore", dbBackend(fw.currentBin, fw.daemonHome), filepath.Join(fw.daemonHome, "data"))
 		if err != nil {
 			return 0, err
 		}
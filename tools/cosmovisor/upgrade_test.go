@@ -4,6 +4,8 @@ package cosmovisor_test
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"slices"
@@ -241,6 +243,98 @@ func (s *upgradeTestSuite) TestUpgradeBinary() {
 	}
 }
 
+func (s *upgradeTestSuite) TestUpgradeBinaryWithManifest() {
+	logger := log.NewLogger(os.Stdout).With(log.ModuleKey, "cosmovisor")
+	signer, other := newTestSigner(s.T()), newTestSigner(s.T())
+
+	// sha256sum ./testdata/repo/raw_binary/autod
+	checksum := "sha256:e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d"
+
+	files := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/autod" {
+			http.ServeFile(w, r, filepath.Join(workDir, "testdata/repo/raw_binary/autod"))
+			return
+		}
+
+		bz, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(bz)
+	}))
+	defer server.Close()
+
+	addManifest := func(path, name, checksum string, signer *testSigner) {
+		manifest := fmt.Appendf(nil, `{"name":%q,"binaries":{%q:{"url":"%s/autod","checksum":%q}}}`, name, cosmovisor.OSArch(), server.URL, checksum)
+		files[path] = manifest
+		files[path+".minisig"] = signer.Sign(manifest, true)
+	}
+	addManifest("/valid.json", "amazonas", checksum, signer)
+	addManifest("/other-upgrade.json", "nile", checksum, signer)
+	addManifest("/untrusted.json", "amazonas", checksum, other)
+	addManifest("/bad-checksum.json", "amazonas", "sha256:73e2bd6cbb99261733caf137015d5cc58e3f96248d8b01da68be8564989dd906", signer)
+	files["/info.json"] = fmt.Appendf(nil, `{"manifest":"%s/valid.json"}`, server.URL)
+
+	cases := map[string]struct {
+		info   string
+		expErr string
+	}{
+		"valid manifest": {
+			info: fmt.Sprintf(`{"manifest":"%s/valid.json"}`, server.URL),
+		},
+		"valid manifest referenced by an info url": {
+			info: server.URL + "/info.json",
+		},
+		"missing manifest": {
+			info:   fmt.Sprintf(`{"binaries":{"%s":"%s/autod?checksum=%s"}}`, cosmovisor.OSArch(), server.URL, checksum),
+			expErr: "has no release manifest",
+		},
+		"missing manifest signature": {
+			info:   fmt.Sprintf(`{"manifest":"%s/valid.json","manifest_signature":"%s/missing.minisig"}`, server.URL, server.URL),
+			expErr: "failed to fetch release manifest signature",
+		},
+		"manifest of another upgrade": {
+			info:   fmt.Sprintf(`{"manifest":"%s/other-upgrade.json"}`, server.URL),
+			expErr: `release manifest is for upgrade "nile"`,
+		},
+		"manifest signed by an untrusted key": {
+			info:   fmt.Sprintf(`{"manifest":"%s/untrusted.json"}`, server.URL),
+			expErr: "not a trusted key",
+		},
+		"binary not matching the manifest checksum": {
+			info:   fmt.Sprintf(`{"manifest":"%s/bad-checksum.json"}`, server.URL),
+			expErr: "cannot download binary",
+		},
+	}
+
+	for label, tc := range cases {
+		s.Run(label, func() {
+			cfg := prepareConfig(
+				s.T(),
+				fmt.Sprintf("%s/%s", workDir, "testdata/download"),
+				cosmovisor.Config{
+					Name:                  "autod",
+					AllowDownloadBinaries: true,
+					TrustedKeys:           signer.PublicKey(),
+				},
+			)
+
+			err := cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "amazonas", Info: tc.info})
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			currentBin, err := cfg.CurrentBin()
+			s.Require().NoError(err)
+			s.Require().Equal(cfg.UpgradeBin("amazonas"), currentBin)
+		})
+	}
+}
+
 func (s *upgradeTestSuite) TestOsArch() {
 	// all download tests will fail if we are not on linux or darwin...
 	hosts := []string{
@@ -0,0 +1,133 @@
+// Package main implements remote-signer, a reference signer of the "remote"
+// keyring backend. It serves the keys of a local keyring over gRPC, with the
+// connection authenticated by mutual TLS.
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagHome           = "home"
+	flagKeyringBackend = "keyring-backend"
+	flagKeyringDir     = "keyring-dir"
+	flagListen         = "listen"
+	flagTLSCert        = "tls-cert"
+	flagTLSKey         = "tls-key"
+	flagClientCA       = "client-ca"
+)
+
+func main() {
+	if err := NewRootCmd().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// NewRootCmd returns the remote-signer command.
+func NewRootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-signer",
+		Short: "Serve the keys of a local keyring to the remote keyring backend",
+		Long: `Serve the keys of a local keyring over gRPC to the clients using the "remote"
+keyring backend. Only the clients presenting a certificate signed by the client
+certificate authority are accepted. The keyring passphrase, if any, is prompted
+before serving.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			home, _ := cmd.Flags().GetString(flagHome)
+			backend, _ := cmd.Flags().GetString(flagKeyringBackend)
+			keyringDir, _ := cmd.Flags().GetString(flagKeyringDir)
+			listen, _ := cmd.Flags().GetString(flagListen)
+			certFile, _ := cmd.Flags().GetString(flagTLSCert)
+			keyFile, _ := cmd.Flags().GetString(flagTLSKey)
+			clientCAFile, _ := cmd.Flags().GetString(flagClientCA)
+
+			if keyringDir == "" {
+				keyringDir = home
+			}
+
+			registry := codectypes.NewInterfaceRegistry()
+			cryptocodec.RegisterInterfaces(registry)
+			kr, err := keyring.New(sdk.KeyringServiceName(), backend, keyringDir, cmd.InOrStdin(), codec.NewProtoCodec(registry))
+			if err != nil {
+				return err
+			}
+
+			// unlock the keyring before serving
+			keys, err := kr.List()
+			if err != nil {
+				return err
+			}
+
+			tlsConfig, err := serverTLSConfig(certFile, keyFile, clientCAFile)
+			if err != nil {
+				return err
+			}
+
+			lis, err := net.Listen("tcp", listen)
+			if err != nil {
+				return err
+			}
+
+			server := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+			keyring.RegisterRemoteSignerServer(server, keyring.NewRemoteSignerServer(kr))
+
+			cmd.Printf("serving %d keys of the %s keyring on %s\n", len(keys), backend, lis.Addr())
+			return server.Serve(lis)
+		},
+	}
+
+	cmd.Flags().String(flagHome, os.ExpandEnv("$HOME/.remote-signer"), "The directory of the keyring")
+	cmd.Flags().String(flagKeyringBackend, keyring.BackendFile, "The keyring backend the keys are served from (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagKeyringDir, "", "The client Keyring directory; if omitted, the home directory is used")
+	cmd.Flags().String(flagListen, "localhost:9095", "The <host>:<port> to listen on")
+	cmd.Flags().String(flagTLSCert, "", "The PEM encoded server certificate")
+	cmd.Flags().String(flagTLSKey, "", "The PEM encoded server key")
+	cmd.Flags().String(flagClientCA, "", "The PEM encoded certificate authority the client certificates are verified against")
+
+	for _, flag := range []string{flagTLSCert, flagTLSKey, flagClientCA} {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+// serverTLSConfig returns the TLS configuration requiring the client
+// certificates to be signed by the client certificate authority.
+func serverTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	ca, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificate found in client CA file %s", clientCAFile)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
@@ -0,0 +1,347 @@
+package module
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasStreamingGenesisBasics is the extension interface for modules validating
+// their genesis state as a stream, without holding it in memory.
+type HasStreamingGenesisBasics interface {
+	ValidateGenesisStream(codec.JSONCodec, client.TxEncodingConfig, appmodule.GenesisSource) error
+}
+
+// HasStreamingGenesis is the extension interface for modules importing and
+// exporting their genesis state as a stream, without holding it in memory. The
+// fields of the genesis source and target are the fields of the module genesis
+// state JSON object, so that the streamed state has the same format as the one
+// of HasGenesis. Large fields should be JSON arrays read and written one
+// element at a time, typically while iterating over collections.
+type HasStreamingGenesis interface {
+	HasStreamingGenesisBasics
+	InitGenesisStream(context.Context, codec.JSONCodec, appmodule.GenesisSource) error
+	ExportGenesisStream(context.Context, codec.JSONCodec, appmodule.GenesisTarget) error
+}
+
+// GenesisReader reads the genesis state of an application module by module.
+type GenesisReader interface {
+	// ModuleSource returns the genesis source of the module, or nil when the
+	// genesis has no state for it.
+	ModuleSource(moduleName string) (appmodule.GenesisSource, error)
+
+	// ModuleJSON returns the genesis state of the module as a single JSON
+	// object, or nil when the genesis has no state for it. It is used for the
+	// modules which do not support streaming.
+	ModuleJSON(moduleName string) (json.RawMessage, error)
+}
+
+// GenesisWriter writes the genesis state of an application module by module.
+type GenesisWriter interface {
+	// ModuleTarget returns the genesis target of the module.
+	ModuleTarget(moduleName string) (appmodule.GenesisTarget, error)
+
+	// WriteModuleJSON writes the genesis state of the module given as a single
+	// JSON object. It is used for the modules which do not support streaming.
+	WriteModuleJSON(moduleName string, bz json.RawMessage) error
+}
+
+// ValidateGenesisStream performs genesis state validation for all modules,
+// reading their state from the genesis reader.
+func (bm BasicManager) ValidateGenesisStream(cdc codec.JSONCodec, txEncCfg client.TxEncodingConfig, reader GenesisReader) error {
+	for _, b := range bm {
+		if mod, ok := b.(HasStreamingGenesisBasics); ok {
+			source, err := reader.ModuleSource(b.Name())
+			if err != nil {
+				return err
+			}
+			if source == nil {
+				return fmt.Errorf("genesis state of module %s: %w", b.Name(), io.EOF)
+			}
+
+			if err := mod.ValidateGenesisStream(cdc, txEncCfg, source); err != nil {
+				return err
+			}
+		} else if mod, ok := b.(HasGenesisBasics); ok {
+			bz, err := reader.ModuleJSON(b.Name())
+			if err != nil {
+				return err
+			}
+
+			if err := mod.ValidateGenesis(cdc, txEncCfg, bz); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// InitGenesisStream performs init genesis functionality for modules, reading
+// their state from the genesis reader one module at a time. Exactly one module
+// must return a non-empty validator set update to correctly initialize the
+// chain.
+func (m *Manager) InitGenesisStream(ctx sdk.Context, cdc codec.JSONCodec, reader GenesisReader) (*abci.ResponseInitChain, error) {
+	var validatorUpdates []abci.ValidatorUpdate
+	ctx.Logger().Info("initializing blockchain state from streaming genesis")
+	for _, moduleName := range m.OrderInitGenesis {
+		switch module := m.Modules[moduleName].(type) {
+		case HasStreamingGenesis:
+			source, err := reader.ModuleSource(moduleName)
+			if err != nil {
+				return &abci.ResponseInitChain{}, err
+			}
+			if source == nil {
+				continue
+			}
+
+			ctx.Logger().Debug("running streaming initialization for module", "module", moduleName)
+			if err := module.InitGenesisStream(ctx, cdc, source); err != nil {
+				return &abci.ResponseInitChain{}, fmt.Errorf("genesis import error in %s: %w", moduleName, err)
+			}
+		case appmodule.HasGenesis:
+			source, err := reader.ModuleSource(moduleName)
+			if err != nil {
+				return &abci.ResponseInitChain{}, err
+			}
+			if source == nil {
+				continue
+			}
+
+			ctx.Logger().Debug("running initialization for module", "module", moduleName)
+			if err := module.InitGenesis(ctx, source); err != nil {
+				return &abci.ResponseInitChain{}, err
+			}
+		case HasGenesis:
+			bz, err := reader.ModuleJSON(moduleName)
+			if err != nil {
+				return &abci.ResponseInitChain{}, err
+			}
+			if bz == nil {
+				continue
+			}
+
+			ctx.Logger().Debug("running initialization for module", "module", moduleName)
+			module.InitGenesis(ctx, cdc, bz)
+		case HasABCIGenesis:
+			bz, err := reader.ModuleJSON(moduleName)
+			if err != nil {
+				return &abci.ResponseInitChain{}, err
+			}
+			if bz == nil {
+				continue
+			}
+
+			ctx.Logger().Debug("running initialization for module", "module", moduleName)
+			moduleValUpdates := module.InitGenesis(ctx, cdc, bz)
+
+			// use these validator updates if provided, the module manager assumes
+			// only one module will update the validator set
+			if len(moduleValUpdates) > 0 {
+				if len(validatorUpdates) > 0 {
+					return &abci.ResponseInitChain{}, errors.New("validator InitGenesis updates already set by a previous module")
+				}
+				validatorUpdates = moduleValUpdates
+			}
+		}
+	}
+
+	// a chain must initialize with a non-empty validator set
+	if len(validatorUpdates) == 0 {
+		return &abci.ResponseInitChain{}, fmt.Errorf("validator set is empty after InitGenesis, please ensure at least one validator is initialized with a delegation greater than or equal to the DefaultPowerReduction (%d)", sdk.DefaultPowerReduction)
+	}
+
+	return &abci.ResponseInitChain{
+		Validators: validatorUpdates,
+	}, nil
+}
+
+// ExportGenesisStream performs export genesis functionality for modules,
+// writing their state to the genesis writer one module at a time so that the
+// state of a single module is held in memory at most. All modules are exported
+// when modulesToExport is empty.
+func (m *Manager) ExportGenesisStream(ctx sdk.Context, cdc codec.JSONCodec, writer GenesisWriter, modulesToExport []string) error {
+	if len(modulesToExport) == 0 {
+		modulesToExport = m.OrderExportGenesis
+	}
+	// verify modules exist in app, so that we don't fail in the middle of an export
+	if err := m.checkModulesExists(modulesToExport); err != nil {
+		return err
+	}
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	for _, moduleName := range modulesToExport {
+		if err := m.exportModuleGenesisStream(ctx, cdc, writer, moduleName); err != nil {
+			return fmt.Errorf("genesis export error in %s: %w", moduleName, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) exportModuleGenesisStream(ctx sdk.Context, cdc codec.JSONCodec, writer GenesisWriter, moduleName string) error {
+	switch module := m.Modules[moduleName].(type) {
+	case HasStreamingGenesis:
+		target, err := writer.ModuleTarget(moduleName)
+		if err != nil {
+			return err
+		}
+		return module.ExportGenesisStream(ctx, cdc, target)
+	case appmodule.HasGenesis:
+		target, err := writer.ModuleTarget(moduleName)
+		if err != nil {
+			return err
+		}
+		return module.ExportGenesis(ctx, target)
+	case HasGenesis:
+		return writer.WriteModuleJSON(moduleName, module.ExportGenesis(ctx, cdc))
+	case HasABCIGenesis:
+		return writer.WriteModuleJSON(moduleName, module.ExportGenesis(ctx, cdc))
+	}
+
+	return nil
+}
+
+// WriteGenesisField writes the message as a field of the genesis target.
+func WriteGenesisField(cdc codec.JSONCodec, target appmodule.GenesisTarget, field string, msg proto.Message) error {
+	bz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return err
+	}
+
+	w, err := target(field)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(bz); err != nil {
+		_ = w.Close()
+		return err
+	}
+
+	return w.Close()
+}
+
+// WriteGenesisArray writes the messages emitted by iterate as a JSON array field
+// of the genesis target, one message at a time.
+func WriteGenesisArray(cdc codec.JSONCodec, target appmodule.GenesisTarget, field string, iterate func(emit func(proto.Message) error) error) error {
+	w, err := target(field)
+	if err != nil {
+		return err
+	}
+
+	if err := writeJSONArray(cdc, w, iterate); err != nil {
+		_ = w.Close()
+		return err
+	}
+
+	return w.Close()
+}
+
+func writeJSONArray(cdc codec.JSONCodec, w io.Writer, iterate func(emit func(proto.Message) error) error) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	first := true
+	err := iterate(func(msg proto.Message) error {
+		bz, err := cdc.MarshalJSON(msg)
+		if err != nil {
+			return err
+		}
+
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		_, err = w.Write(bz)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// ReadGenesisField reads a field of the genesis source into the message. It
+// returns false when the source has no such field.
+func ReadGenesisField(cdc codec.JSONCodec, source appmodule.GenesisSource, field string, msg proto.Message) (bool, error) {
+	r, err := source(field)
+	if err != nil || r == nil {
+		return false, err
+	}
+	defer r.Close()
+
+	bz, err := io.ReadAll(r)
+	if err != nil {
+		return false, err
+	}
+
+	if err := cdc.UnmarshalJSON(bz, msg); err != nil {
+		return false, fmt.Errorf("invalid genesis field %s: %w", field, err)
+	}
+
+	return true, nil
+}
+
+// ReadGenesisArray reads a JSON array field of the genesis source one element
+// at a time, decoding each of them into a new message passed to onElem. A
+// missing field is read as an empty array.
+func ReadGenesisArray[T proto.Message](cdc codec.JSONCodec, source appmodule.GenesisSource, field string, newElem func() T, onElem func(T) error) error {
+	r, err := source(field)
+	if err != nil || r == nil {
+		return err
+	}
+	defer r.Close()
+
+	decoder := json.NewDecoder(r)
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("invalid genesis field %s: %w", field, err)
+	}
+	if token == nil {
+		// null is read as an empty array
+		return nil
+	}
+	if token != json.Delim('[') {
+		return fmt.Errorf("invalid genesis field %s: expected [ got %v", field, token)
+	}
+
+	for decoder.More() {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return fmt.Errorf("invalid genesis field %s: %w", field, err)
+		}
+
+		elem := newElem()
+		if err := cdc.UnmarshalJSON(raw, elem); err != nil {
+			return fmt.Errorf("invalid genesis field %s: %w", field, err)
+		}
+
+		if err := onElem(elem); err != nil {
+			return err
+		}
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return fmt.Errorf("invalid genesis field %s: %w", field, err)
+	}
+
+	return nil
+}
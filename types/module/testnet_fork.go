@@ -0,0 +1,179 @@
+package module
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasTestnetFork is the extension interface for modules rewriting their state
+// when the state of a network is forked into a local testnet, e.g. to replace
+// the validator set with the validators of the testnet. The changes are
+// committed with the first block of the testnet.
+type HasTestnetFork interface {
+	TestnetFork(ctx context.Context, spec *TestnetForkSpec) error
+}
+
+// TestnetForkSpec describes the changes made to the state of a network forked
+// into a local testnet, e.g. to rehearse an upgrade against mainnet state.
+type TestnetForkSpec struct {
+	// ChainID is the chain ID of the testnet.
+	ChainID string `json:"chain_id"`
+	// Validators replace the validator set of the network.
+	Validators []TestnetForkValidator `json:"validators"`
+	// Accounts override the balances of accounts.
+	Accounts []TestnetForkAccount `json:"accounts,omitempty"`
+	// Params override fields of the module parameters by module name, using
+	// their proto JSON names, e.g. {"gov": {"voting_period": "60s"}}.
+	Params map[string]json.RawMessage `json:"params,omitempty"`
+}
+
+// TestnetForkValidator is a validator of a testnet fork.
+type TestnetForkValidator struct {
+	Moniker string `json:"moniker"`
+	// Operator is the account operating the validator.
+	Operator string `json:"operator"`
+	// Power is the consensus power of the validator.
+	Power int64 `json:"power"`
+	// PrivValidatorKeyFile is the CometBFT private validator key file of the
+	// validator, used to sign the last commit of the forked network.
+	PrivValidatorKeyFile string `json:"priv_validator_key_file"`
+
+	// ConsPubKey is the consensus public key of the validator, loaded from
+	// its private validator key file.
+	ConsPubKey cryptotypes.PubKey `json:"-"`
+}
+
+// TestnetForkAccount overrides the balance of an account of a testnet fork.
+type TestnetForkAccount struct {
+	Address string `json:"address"`
+	// Balance replaces the balance of the account for each of its denoms,
+	// e.g. "1000000stake,5000uatom". The other denoms are left untouched.
+	Balance string `json:"balance"`
+}
+
+// Validate performs a basic validation of the testnet fork spec.
+func (s *TestnetForkSpec) Validate() error {
+	if s.ChainID == "" {
+		return errors.New("chain_id is required")
+	}
+
+	if len(s.Validators) == 0 {
+		return errors.New("at least one validator is required")
+	}
+
+	operators, keyFiles := map[string]bool{}, map[string]bool{}
+	for i, val := range s.Validators {
+		switch {
+		case val.Operator == "":
+			return fmt.Errorf("validator %d: operator is required", i)
+		case val.PrivValidatorKeyFile == "":
+			return fmt.Errorf("validator %d: priv_validator_key_file is required", i)
+		case val.Power <= 0:
+			return fmt.Errorf("validator %d: power must be positive, got %d", i, val.Power)
+		case operators[val.Operator]:
+			return fmt.Errorf("validator %d: duplicate operator %s", i, val.Operator)
+		case keyFiles[val.PrivValidatorKeyFile]:
+			return fmt.Errorf("validator %d: duplicate priv_validator_key_file %s", i, val.PrivValidatorKeyFile)
+		}
+		operators[val.Operator], keyFiles[val.PrivValidatorKeyFile] = true, true
+	}
+
+	addresses := map[string]bool{}
+	for i, acc := range s.Accounts {
+		if acc.Address == "" {
+			return fmt.Errorf("account %d: address is required", i)
+		}
+		if addresses[acc.Address] {
+			return fmt.Errorf("account %d: duplicate address %s", i, acc.Address)
+		}
+		addresses[acc.Address] = true
+
+		if _, err := sdk.ParseCoinsNormalized(acc.Balance); err != nil {
+			return fmt.Errorf("account %s: invalid balance: %w", acc.Address, err)
+		}
+	}
+
+	for moduleName, override := range s.Params {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(override, &fields); err != nil {
+			return fmt.Errorf("params of module %s must be an object: %w", moduleName, err)
+		}
+	}
+
+	return nil
+}
+
+// ApplyParamsOverride overrides the fields of the module parameters with the
+// fields of the JSON object of the spec for the module, if any.
+func (s *TestnetForkSpec) ApplyParamsOverride(cdc codec.JSONCodec, moduleName string, params proto.Message) error {
+	override, ok := s.Params[moduleName]
+	if !ok {
+		return nil
+	}
+
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	var fields, overrideFields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(override, &overrideFields); err != nil {
+		return fmt.Errorf("params of module %s must be an object: %w", moduleName, err)
+	}
+
+	for name, value := range overrideFields {
+		if _, ok := fields[name]; !ok {
+			return fmt.Errorf("unknown parameter %s of module %s", name, moduleName)
+		}
+		fields[name] = value
+	}
+
+	if bz, err = json.Marshal(fields); err != nil {
+		return err
+	}
+
+	if err := cdc.UnmarshalJSON(bz, params); err != nil {
+		return fmt.Errorf("invalid params of module %s: %w", moduleName, err)
+	}
+
+	return nil
+}
+
+// TestnetFork rewrites the state of the modules for the testnet fork spec, in
+// the order of InitGenesis, so that e.g. account balances are set before the
+// validators are created.
+func (m *Manager) TestnetFork(ctx sdk.Context, spec *TestnetForkSpec) error {
+	if err := spec.Validate(); err != nil {
+		return err
+	}
+
+	// the params of a module can only be overridden by the module itself
+	for _, moduleName := range slices.Sorted(maps.Keys(spec.Params)) {
+		if _, ok := m.Modules[moduleName].(HasTestnetFork); !ok {
+			return fmt.Errorf("module %s does not support params overrides in testnet forks", moduleName)
+		}
+	}
+
+	for _, moduleName := range m.OrderInitGenesis {
+		if mod, ok := m.Modules[moduleName].(HasTestnetFork); ok {
+			if err := mod.TestnetFork(ctx, spec); err != nil {
+				return fmt.Errorf("testnet fork of module %s: %w", moduleName, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package module_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func newTestnetForkSpec() *module.TestnetForkSpec {
+	return &module.TestnetForkSpec{
+		ChainID: "testnet-1",
+		Validators: []module.TestnetForkValidator{
+			{Moniker: "val1", Operator: "operator1", Power: 10, PrivValidatorKeyFile: "val1.json"},
+			{Moniker: "val2", Operator: "operator2", Power: 20, PrivValidatorKeyFile: "val2.json"},
+		},
+		Accounts: []module.TestnetForkAccount{
+			{Address: "account1", Balance: "1000stake,10atom"},
+		},
+		Params: map[string]json.RawMessage{
+			authtypes.ModuleName: json.RawMessage(`{"max_memo_characters": "512"}`),
+		},
+	}
+}
+
+func TestTestnetForkSpecValidate(t *testing.T) {
+	require.NoError(t, newTestnetForkSpec().Validate())
+
+	testCases := []struct {
+		name     string
+		malleate func(spec *module.TestnetForkSpec)
+		expErr   string
+	}{
+		{"no chain id", func(spec *module.TestnetForkSpec) { spec.ChainID = "" }, "chain_id is required"},
+		{"no validators", func(spec *module.TestnetForkSpec) { spec.Validators = nil }, "at least one validator"},
+		{"no operator", func(spec *module.TestnetForkSpec) { spec.Validators[0].Operator = "" }, "operator is required"},
+		{"no key file", func(spec *module.TestnetForkSpec) { spec.Validators[1].PrivValidatorKeyFile = "" }, "priv_validator_key_file is required"},
+		{"zero power", func(spec *module.TestnetForkSpec) { spec.Validators[0].Power = 0 }, "power must be positive"},
+		{"duplicate operator", func(spec *module.TestnetForkSpec) { spec.Validators[1].Operator = "operator1" }, "duplicate operator"},
+		{"duplicate key file", func(spec *module.TestnetForkSpec) { spec.Validators[1].PrivValidatorKeyFile = "val1.json" }, "duplicate priv_validator_key_file"},
+		{
+			"duplicate account", func(spec *module.TestnetForkSpec) {
+				spec.Accounts = append(spec.Accounts, spec.Accounts[0])
+			}, "duplicate address",
+		},
+		{"invalid balance", func(spec *module.TestnetForkSpec) { spec.Accounts[0].Balance = "1000" }, "invalid balance"},
+		{
+			"params not an object", func(spec *module.TestnetForkSpec) {
+				spec.Params["gov"] = json.RawMessage(`"60s"`)
+			}, "params of module gov must be an object",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := newTestnetForkSpec()
+			tc.malleate(spec)
+			require.ErrorContains(t, spec.Validate(), tc.expErr)
+		})
+	}
+}
+
+func TestTestnetForkSpecApplyParamsOverride(t *testing.T) {
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	spec := newTestnetForkSpec()
+
+	params := authtypes.DefaultParams()
+	require.NoError(t, spec.ApplyParamsOverride(cdc, authtypes.ModuleName, &params))
+	require.Equal(t, uint64(512), params.MaxMemoCharacters)
+	require.Equal(t, authtypes.DefaultParams().TxSigLimit, params.TxSigLimit)
+
+	// modules without overrides are left untouched
+	params = authtypes.DefaultParams()
+	require.NoError(t, spec.ApplyParamsOverride(cdc, "bank", &params))
+	require.Equal(t, authtypes.DefaultParams(), params)
+
+	spec.Params[authtypes.ModuleName] = json.RawMessage(`{"max_memo_chars": "512"}`)
+	require.ErrorContains(t, spec.ApplyParamsOverride(cdc, authtypes.ModuleName, &params), "unknown parameter max_memo_chars")
+
+	spec.Params[authtypes.ModuleName] = json.RawMessage(`{"max_memo_characters": "many"}`)
+	require.ErrorContains(t, spec.ApplyParamsOverride(cdc, authtypes.ModuleName, &params), "invalid params of module auth")
+}
+
+func TestManagerTestnetForkParamsOverride(t *testing.T) {
+	mm := module.NewManager()
+
+	err := mm.TestnetFork(sdk.Context{}, newTestnetForkSpec())
+	require.ErrorContains(t, err, "module auth does not support params overrides")
+}
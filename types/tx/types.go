@@ -2,9 +2,12 @@ package tx
 
 import (
 	"fmt"
+	"strings"
 
 	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -118,6 +121,27 @@ func (t *Tx) GetSigners(cdc codec.Codec) ([][]byte, []protov2.Message, error) {
 		}
 	}
 
+	// extension options annotated with cosmos.msg.v1.signer, e.g. the fee shares
+	// of several payers, add their signers to the required signers
+	for _, opt := range t.Body.ExtensionOptions {
+		desc, err := cdc.InterfaceRegistry().FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(opt.TypeUrl, "/")))
+		if err != nil || !protov2.HasExtension(desc.Options(), msgv1.E_Signer) {
+			continue
+		}
+
+		xs, _, err := cdc.GetMsgAnySigners(opt)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, signer := range xs {
+			if !seen[string(signer)] {
+				signers = append(signers, signer)
+				seen[string(signer)] = true
+			}
+		}
+	}
+
 	// ensure any specified fee payer is included in the required signers (at the end)
 	feePayer := t.AuthInfo.Fee.Payer
 	var feePayerAddr []byte
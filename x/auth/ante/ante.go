@@ -20,6 +20,10 @@ type HandlerOptions struct {
 	SignModeHandler        *txsigning.HandlerMap
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+	// AuthenticatorKeeper enables the account authenticators when set. The
+	// signatures of accounts with registered authenticators are then verified
+	// by these authenticators instead of the account public key.
+	AuthenticatorKeeper AuthenticatorKeeper
 	// SigVerifyOptions are the options for the signature verification decorator.
 	// This allows for modification of signature verification behavior, such as how long an unordered transaction can
 	// be valid, or how much gas to charge for unordered transactions.
@@ -42,20 +46,38 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	extensionOptionChecker := options.ExtensionOptionChecker
+	if extensionOptionChecker == nil {
+		extensionOptionChecker = FeeSharesExtensionOptionChecker
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		NewExtensionOptionsDecorator(extensionOptionChecker),
 		NewValidateBasicDecorator(),
 		NewTxTimeoutHeightDecorator(),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+	}
+
+	if options.AuthenticatorKeeper != nil {
+		balanceKeeper, ok := options.BankKeeper.(BalanceKeeper)
+		if !ok {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper must implement GetAllBalances to use account authenticators")
+		}
+
+		// AuthenticatorDecorator must be called before all the public key based signature decorators
+		anteDecorators = append(anteDecorators, NewAuthenticatorDecorator(options.AccountKeeper, options.AuthenticatorKeeper, balanceKeeper, options.SignModeHandler, options.SigGasConsumer))
+	}
+
+	anteDecorators = append(anteDecorators,
 		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigVerifyOptions...),
 		NewIncrementSequenceDecorator(options.AccountKeeper),
-	}
+	)
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
@@ -0,0 +1,237 @@
+package ante
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	txsigning "github.com/cosmos/cosmos-sdk/x/tx/signing"
+)
+
+// authenticatedSignersKey is the context key of the signers authenticated by
+// the AuthenticatorDecorator.
+type authenticatedSignersKey struct{}
+
+// AuthenticatedSigner is a signer of a transaction that was authenticated by
+// one of the authenticators registered on its account.
+type AuthenticatedSigner struct {
+	// Address is the address of the signer.
+	Address sdk.AccAddress
+
+	// AuthenticatorID is the id of the authenticator that authenticated the
+	// signer.
+	AuthenticatorID uint64
+
+	// Balances are the balances of the signer when it was authenticated. They
+	// are only set when the authenticator tracks the coins spent.
+	Balances sdk.Coins
+}
+
+// GetAuthenticatedSigners returns the signers of the transaction authenticated
+// by the authenticators registered on their account.
+func GetAuthenticatedSigners(ctx sdk.Context) []AuthenticatedSigner {
+	signers, _ := ctx.Value(authenticatedSignersKey{}).([]AuthenticatedSigner)
+	return signers
+}
+
+// isAuthenticatedSigner returns true if the signer was authenticated by one of
+// its account authenticators, in which case the public key based signature
+// decorators skip it.
+func isAuthenticatedSigner(ctx sdk.Context, addr sdk.AccAddress) bool {
+	for _, signer := range GetAuthenticatedSigners(ctx) {
+		if signer.Address.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthenticatorDecorator dispatches the signature verification of the signers
+// whose account has registered authenticators to these authenticators. They are
+// tried in id order and the first one that passes authenticates the signer. The
+// signature gas is consumed for each public key a signature is verified against.
+//
+// Signers authenticated this way are recorded in the context and skipped by the
+// SetPubKeyDecorator, SigGasConsumeDecorator and SigVerificationDecorator, so
+// this decorator must run before them. Signers without authenticators are left
+// to these decorators.
+//
+// CONTRACT: Tx must implement SigVerifiableTx interface
+type AuthenticatorDecorator struct {
+	ak              AccountKeeper
+	authKeeper      AuthenticatorKeeper
+	bankKeeper      BalanceKeeper
+	signModeHandler *txsigning.HandlerMap
+	sigGasConsumer  SignatureVerificationGasConsumer
+}
+
+func NewAuthenticatorDecorator(ak AccountKeeper, authKeeper AuthenticatorKeeper, bankKeeper BalanceKeeper, signModeHandler *txsigning.HandlerMap, sigGasConsumer SignatureVerificationGasConsumer) AuthenticatorDecorator {
+	if sigGasConsumer == nil {
+		sigGasConsumer = DefaultSigVerificationGasConsumer
+	}
+
+	return AuthenticatorDecorator{
+		ak:              ak,
+		authKeeper:      authKeeper,
+		bankKeeper:      bankKeeper,
+		signModeHandler: signModeHandler,
+		sigGasConsumer:  sigGasConsumer,
+	}
+}
+
+func (ad AuthenticatorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	utx, ok := tx.(sdk.TxWithUnordered)
+	isUnordered := ok && utx.GetUnordered()
+
+	var authenticated []AuthenticatedSigner
+	for i, signer := range signers {
+		accAuthenticators, err := ad.authKeeper.GetAuthenticators(ctx, signer)
+		if err != nil {
+			return ctx, err
+		}
+		if len(accAuthenticators) == 0 {
+			continue
+		}
+
+		if len(sigs) != len(signers) {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+		}
+
+		acc, err := GetSignerAcc(ctx, ad.ak, signer)
+		if err != nil {
+			return ctx, err
+		}
+
+		if sigs[i].Sequence > 0 && isUnordered {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sequence is not allowed for unordered transactions")
+		}
+
+		if !isUnordered && sigs[i].Sequence != acc.GetSequence() {
+			return ctx, errorsmod.Wrapf(
+				sdkerrors.ErrWrongSequence,
+				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sigs[i].Sequence,
+			)
+		}
+
+		req := types.AuthenticationRequest{
+			Account:         signer,
+			Msgs:            tx.GetMsgs(),
+			VerifySignature: ad.signatureVerifier(ctx, tx, acc, sigs[i], simulate),
+		}
+
+		signerAddr, err := ad.ak.AddressCodec().BytesToString(signer)
+		if err != nil {
+			return ctx, err
+		}
+
+		authSigner, err := ad.authenticate(ctx, signerAddr, accAuthenticators, req)
+		if err != nil {
+			return ctx, err
+		}
+		authenticated = append(authenticated, authSigner)
+	}
+
+	if len(authenticated) > 0 {
+		ctx = ctx.WithValue(authenticatedSignersKey{}, authenticated)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// authenticate returns the signer authenticated by the first authenticator
+// accepting the request.
+func (ad AuthenticatorDecorator) authenticate(ctx sdk.Context, signerAddr string, accAuthenticators []types.AccountAuthenticator, req types.AuthenticationRequest) (AuthenticatedSigner, error) {
+	var errs []error
+	for _, accAuthenticator := range accAuthenticators {
+		authenticator, err := accAuthenticator.GetAuthenticator()
+		if err != nil {
+			return AuthenticatedSigner{}, err
+		}
+
+		if err := authenticator.Authenticate(ctx, req); err != nil {
+			errs = append(errs, fmt.Errorf("authenticator %d: %w", accAuthenticator.Id, err))
+			continue
+		}
+
+		signer := AuthenticatedSigner{Address: req.Account, AuthenticatorID: accAuthenticator.Id}
+		if tracker, ok := authenticator.(types.SpendTracker); ok && tracker.TracksSpend() {
+			signer.Balances = ad.bankKeeper.GetAllBalances(ctx, req.Account)
+		}
+
+		return signer, nil
+	}
+
+	return AuthenticatedSigner{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "no authenticator of account %s passed: %s", signerAddr, errors.Join(errs...))
+}
+
+// signatureVerifier returns the function verifying the signature of the signer
+// against the public key of an authenticator.
+func (ad AuthenticatorDecorator) signatureVerifier(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, simulate bool) func(cryptotypes.PubKey) error {
+	return func(pubKey cryptotypes.PubKey) error {
+		params := ad.ak.GetParams(ctx)
+		if err := ad.sigGasConsumer(ctx.GasMeter(), signing.SignatureV2{PubKey: pubKey, Data: sig.Data, Sequence: sig.Sequence}, params); err != nil {
+			return err
+		}
+
+		// no need to verify signatures on recheck tx
+		if simulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+			return nil
+		}
+
+		var accNum uint64
+		if ctx.BlockHeight() != 0 {
+			accNum = acc.GetAccountNumber()
+		}
+
+		anyPk, err := codectypes.NewAnyWithValue(pubKey)
+		if err != nil {
+			return err
+		}
+
+		signerData := txsigning.SignerData{
+			Address:       acc.GetAddress().String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: accNum,
+			Sequence:      sig.Sequence,
+			PubKey: &anypb.Any{
+				TypeUrl: anyPk.TypeUrl,
+				Value:   anyPk.Value,
+			},
+		}
+		adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+		if !ok {
+			return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+		}
+
+		if err := authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, ad.signModeHandler, adaptableTx.GetSigningTxData()); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, ctx.ChainID(), err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,125 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type balanceKeeper struct {
+	balances sdk.Coins
+}
+
+func (bk balanceKeeper) GetAllBalances(context.Context, sdk.AccAddress) sdk.Coins {
+	return bk.balances
+}
+
+func TestAuthenticatorDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.ctx = suite.ctx.WithBlockTime(time.Unix(100, 0))
+
+	accs := suite.CreateTestAccounts(2)
+	owner, other := accs[0], accs[1]
+	sessionPriv := secp256k1.GenPrivKey()
+
+	sigAuthenticator, err := types.NewSignatureAuthenticator(sessionPriv.PubKey())
+	require.NoError(t, err)
+	msg := testdata.NewTestMsg(owner.acc.GetAddress())
+	session, err := types.NewThresholdAuthenticator(3,
+		sigAuthenticator,
+		types.NewMessageFilterAuthenticator(sdk.MsgTypeURL(msg)),
+		&types.TimeWindowAuthenticator{NotAfter: time.Unix(200, 0)},
+	)
+	require.NoError(t, err)
+
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewAuthenticatorDecorator(suite.accountKeeper, suite.accountKeeper, balanceKeeper{}, suite.clientCtx.TxConfig.SignModeHandler(), nil),
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigGasConsumeDecorator(suite.accountKeeper, ante.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+	)
+
+	runTx := func(blockTime int64, priv cryptotypes.PrivKey, msgs ...sdk.Msg) (sdk.Context, error) {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{owner.acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+
+		return anteHandler(suite.ctx.WithBlockTime(time.Unix(blockTime, 0)), tx, false)
+	}
+
+	// without authenticators the session key cannot sign for the account
+	_, err = runTx(100, sessionPriv, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+
+	_, err = suite.accountKeeper.AddAuthenticator(suite.ctx, owner.acc.GetAddress(), session)
+	require.NoError(t, err)
+
+	ctx, err := runTx(100, sessionPriv, msg)
+	require.NoError(t, err)
+	signers := ante.GetAuthenticatedSigners(ctx)
+	require.Len(t, signers, 1)
+	require.Equal(t, owner.acc.GetAddress(), signers[0].Address)
+	require.Nil(t, signers[0].Balances)
+	// the session key is not set as the account public key
+	require.Nil(t, suite.accountKeeper.GetAccount(ctx, owner.acc.GetAddress()).GetPubKey())
+
+	// the account key no longer signs once authenticators are registered
+	_, err = runTx(100, owner.priv, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = runTx(200, sessionPriv, msg)
+	require.ErrorContains(t, err, "expired")
+
+	_, err = runTx(100, sessionPriv, msg, &types.MsgRemoveAuthenticator{Sender: owner.acc.GetAddress().String()})
+	require.ErrorContains(t, err, "is not allowed")
+
+	// the other account is still verified against its public key
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(other.acc.GetAddress())))
+	tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{other.priv}, []uint64{other.acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	ctx, err = anteHandler(suite.ctx, tx, false)
+	require.NoError(t, err)
+	require.Empty(t, ante.GetAuthenticatedSigners(ctx))
+}
+
+func TestAuthenticatorDecoratorSpendLimit(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	accs := suite.CreateTestAccounts(1)
+	owner := accs[0]
+	sessionPriv := secp256k1.GenPrivKey()
+
+	sigAuthenticator, err := types.NewSignatureAuthenticator(sessionPriv.PubKey())
+	require.NoError(t, err)
+	session, err := types.NewThresholdAuthenticator(2, sigAuthenticator, types.NewSpendLimitAuthenticator(sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	require.NoError(t, err)
+	id, err := suite.accountKeeper.AddAuthenticator(suite.ctx, owner.acc.GetAddress(), session)
+	require.NoError(t, err)
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	ad := ante.NewAuthenticatorDecorator(suite.accountKeeper, suite.accountKeeper, balanceKeeper{balances: balances}, suite.clientCtx.TxConfig.SignModeHandler(), nil)
+
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(owner.acc.GetAddress())))
+	tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{sessionPriv}, []uint64{owner.acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	ctx, err := sdk.ChainAnteDecorators(ad)(suite.ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, []ante.AuthenticatedSigner{{Address: owner.acc.GetAddress(), AuthenticatorID: id, Balances: balances}}, ante.GetAuthenticatedSigners(ctx))
+}
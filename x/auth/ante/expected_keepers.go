@@ -27,3 +27,16 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// AuthenticatorKeeper defines the contract needed to dispatch the signature
+// verification of accounts to their authenticators.
+type AuthenticatorKeeper interface {
+	GetAuthenticators(ctx context.Context, addr sdk.AccAddress) ([]types.AccountAuthenticator, error)
+	SetAuthenticator(ctx context.Context, addr sdk.AccAddress, authenticator types.AccountAuthenticator) error
+}
+
+// BalanceKeeper defines the contract needed to track the coins spent by the
+// transactions of accounts authenticated by a spend limited authenticator.
+type BalanceKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
@@ -4,6 +4,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 type HasExtensionOptionsTx interface {
@@ -20,6 +21,13 @@ func rejectExtensionOption(*codectypes.Any) bool {
 	return false
 }
 
+// FeeSharesExtensionOptionChecker accepts the fee shares extension option,
+// handled by the DeductFeeDecorator, and rejects all the other extensions. It
+// is the default extension check of NewAnteHandler.
+func FeeSharesExtensionOptionChecker(any *codectypes.Any) bool {
+	return any.TypeUrl == sdk.MsgTypeURL(&types.ExtensionOptionFeeShares{})
+}
+
 // RejectExtensionOptionsDecorator is an AnteDecorator that rejects all extension
 // options which can optionally be included in protobuf transactions. Users that
 // need extension options should create a custom AnteHandler chain that handles
@@ -21,6 +21,28 @@ var FeeRecipientModule string
 // the effective fee should be deducted later, and the priority should be returned in abci response.
 type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error)
 
+// deductedFeesKey is the context key of the fees deducted by the
+// DeductFeeDecorator.
+type deductedFeesKey struct{}
+
+// DeductedFee is a fee deducted by the DeductFeeDecorator and sent to the
+// FeeRecipientModule.
+type DeductedFee struct {
+	// Payer is the account the fee was deducted from, which is the fee granter
+	// when it is set.
+	Payer sdk.AccAddress
+
+	// Amount is the fee deducted.
+	Amount sdk.Coins
+}
+
+// GetDeductedFees returns the fees deducted from the payers of the
+// transaction, one per payer when the fee is split with fee shares.
+func GetDeductedFees(ctx sdk.Context) []DeductedFee {
+	fees, _ := ctx.Value(deductedFeesKey{}).([]DeductedFee)
+	return fees
+}
+
 // DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee granter (if specified) or first signer of the tx.
 // If the fee payer does not have the funds to pay for the fees, return an InsufficientFunds error.
 // Call next AnteHandler if fees successfully deducted.
@@ -35,7 +57,7 @@ type DeductFeeDecorator struct {
 
 func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) DeductFeeDecorator {
 	if tfc == nil {
-		tfc = checkTxFeeWithValidatorMinGasPrices
+		tfc = CheckTxFeeWithValidatorMinGasPrices
 	}
 
 	FeeRecipientModule = types.FeeCollectorName
@@ -83,23 +105,32 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 			return ctx, err
 		}
 	}
-	if err := dfd.checkDeductFee(ctx, tx, fee); err != nil {
+	deducted, err := dfd.checkDeductFee(ctx, tx, fee)
+	if err != nil {
 		return ctx, err
 	}
 
-	newCtx := ctx.WithPriority(priority)
+	newCtx := ctx.WithPriority(priority).WithValue(deductedFeesKey{}, deducted)
 
 	return next(newCtx, tx, simulate)
 }
 
-func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
+func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) ([]DeductedFee, error) {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
-		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
 	if addr := dfd.accountKeeper.GetModuleAddress(dfd.feeRecipientModule); addr == nil {
-		return fmt.Errorf("fee recipient module account (%s) has not been set", dfd.feeRecipientModule)
+		return nil, fmt.Errorf("fee recipient module account (%s) has not been set", dfd.feeRecipientModule)
+	}
+
+	feeShares, err := getFeeShares(sdkTx)
+	if err != nil {
+		return nil, err
+	}
+	if feeShares != nil {
+		return dfd.deductFeeShares(ctx, sdkTx, fee, feeShares)
 	}
 
 	feePayer := feeTx.FeePayer()
@@ -112,11 +143,11 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 		feeGranterAddr := sdk.AccAddress(feeGranter)
 
 		if dfd.feegrantKeeper == nil {
-			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
 		} else if !bytes.Equal(feeGranterAddr, feePayer) {
 			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranterAddr, feePayer, fee, sdkTx.GetMsgs())
 			if err != nil {
-				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+				return nil, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
 			}
 		}
 
@@ -125,14 +156,14 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 
 	deductFeesFromAcc := dfd.accountKeeper.GetAccount(ctx, deductFeesFrom)
 	if deductFeesFromAcc == nil {
-		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
 	}
 
 	// deduct the fees
 	if !fee.IsZero() {
 		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -145,7 +176,92 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	}
 	ctx.EventManager().EmitEvents(events)
 
-	return nil
+	return []DeductedFee{{Payer: deductFeesFrom, Amount: fee}}, nil
+}
+
+// deductFeeShares deducts the share of the fee of each payer of the fee shares
+// extension option, from its fee granter when it is set. The payers are
+// signers of the transaction.
+func (dfd DeductFeeDecorator) deductFeeShares(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins, feeShares *types.ExtensionOptionFeeShares) ([]DeductedFee, error) {
+	feeTx := sdkTx.(sdk.FeeTx)
+	if feeTx.FeeGranter() != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("fee granter cannot be set with fee shares, set the granters of the shares instead")
+	}
+
+	addressCodec := dfd.accountKeeper.AddressCodec()
+	if err := feeShares.Validate(addressCodec, fee); err != nil {
+		return nil, err
+	}
+
+	deducted := make([]DeductedFee, 0, len(feeShares.Shares))
+	events := make(sdk.Events, 0, len(feeShares.Shares))
+	for _, share := range feeShares.Shares {
+		payer, err := addressCodec.StringToBytes(share.Payer)
+		if err != nil {
+			return nil, err
+		}
+		deductFeesFrom := sdk.AccAddress(payer)
+
+		if share.Granter != "" {
+			granter, err := addressCodec.StringToBytes(share.Granter)
+			if err != nil {
+				return nil, err
+			}
+
+			if dfd.feegrantKeeper == nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+			} else if !bytes.Equal(granter, payer) {
+				err := dfd.feegrantKeeper.UseGrantedFees(ctx, granter, payer, share.Amount, sdkTx.GetMsgs())
+				if err != nil {
+					return nil, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", share.Granter, share.Payer)
+				}
+			}
+
+			deductFeesFrom = granter
+		}
+
+		deductFeesFromAcc := dfd.accountKeeper.GetAccount(ctx, deductFeesFrom)
+		if deductFeesFromAcc == nil {
+			return nil, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+		}
+
+		if err := DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, share.Amount); err != nil {
+			return nil, err
+		}
+
+		deducted = append(deducted, DeductedFee{Payer: deductFeesFrom, Amount: share.Amount})
+		events = append(events, sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, share.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductFeesFrom.String()),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return deducted, nil
+}
+
+// getFeeShares returns the fee shares extension option of the transaction, or
+// nil when it is not set.
+func getFeeShares(tx sdk.Tx) (*types.ExtensionOptionFeeShares, error) {
+	hasExtOptsTx, ok := tx.(HasExtensionOptionsTx)
+	if !ok {
+		return nil, nil
+	}
+
+	var feeShares *types.ExtensionOptionFeeShares
+	for _, opt := range hasExtOptsTx.GetExtensionOptions() {
+		shares, ok := opt.GetCachedValue().(*types.ExtensionOptionFeeShares)
+		if !ok {
+			continue
+		}
+		if feeShares != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("fee shares can only be set once")
+		}
+		feeShares = shares
+	}
+
+	return feeShares, nil
 }
 
 // DeductFees deducts fees from the given account and sends them to the
@@ -8,12 +8,14 @@ import (
 
 	"cosmossdk.io/math"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -139,9 +141,10 @@ func TestDeductFees(t *testing.T) {
 	require.NotNil(t, err, "Tx did not error when fee payer had insufficient funds")
 
 	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
-	_, err = antehandler(s.ctx, tx, false)
+	newCtx, err := antehandler(s.ctx, tx, false)
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
+	require.Equal(t, []ante.DeductedFee{{Payer: accs[0].acc.GetAddress(), Amount: feeAmount}}, ante.GetDeductedFees(newCtx))
 }
 
 func TestDeductFees_WithFeeRecipientModule(t *testing.T) {
@@ -196,3 +199,115 @@ func TestDeductFees_WithFeeRecipientModule(t *testing.T) {
 		})
 	}
 }
+
+func TestDeductFeeShares(t *testing.T) {
+	feeAmount := testdata.NewTestFeeAmount()
+	share1 := sdk.NewCoins(sdk.NewInt64Coin("atom", 60))
+	share2 := sdk.NewCoins(sdk.NewInt64Coin("atom", 90))
+
+	testCases := []struct {
+		name     string
+		malleate func(s *AnteTestSuite, accs []TestAccount) []authtypes.FeeShare
+		expErr   string
+	}{
+		{
+			name: "each payer pays its share",
+			malleate: func(s *AnteTestSuite, accs []TestAccount) []authtypes.FeeShare {
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, share1).Return(nil)
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[1].acc.GetAddress(), authtypes.FeeCollectorName, share2).Return(nil)
+				return []authtypes.FeeShare{
+					authtypes.NewFeeShare(accs[0].acc.GetAddress().String(), share1, ""),
+					authtypes.NewFeeShare(accs[1].acc.GetAddress().String(), share2, ""),
+				}
+			},
+		},
+		{
+			name: "granter pays the share of a payer",
+			malleate: func(s *AnteTestSuite, accs []TestAccount) []authtypes.FeeShare {
+				s.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), accs[2].acc.GetAddress(), accs[1].acc.GetAddress(), share2, gomock.Any()).Return(nil)
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, share1).Return(nil)
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[2].acc.GetAddress(), authtypes.FeeCollectorName, share2).Return(nil)
+				return []authtypes.FeeShare{
+					authtypes.NewFeeShare(accs[0].acc.GetAddress().String(), share1, ""),
+					authtypes.NewFeeShare(accs[1].acc.GetAddress().String(), share2, accs[2].acc.GetAddress().String()),
+				}
+			},
+		},
+		{
+			name: "shares do not sum up to the fee",
+			malleate: func(_ *AnteTestSuite, accs []TestAccount) []authtypes.FeeShare {
+				return []authtypes.FeeShare{
+					authtypes.NewFeeShare(accs[0].acc.GetAddress().String(), share1, ""),
+					authtypes.NewFeeShare(accs[1].acc.GetAddress().String(), share1, ""),
+				}
+			},
+			expErr: "do not sum up to the fee",
+		},
+		{
+			name: "duplicate payer",
+			malleate: func(_ *AnteTestSuite, accs []TestAccount) []authtypes.FeeShare {
+				return []authtypes.FeeShare{
+					authtypes.NewFeeShare(accs[0].acc.GetAddress().String(), share1, ""),
+					authtypes.NewFeeShare(accs[0].acc.GetAddress().String(), share2, ""),
+				}
+			},
+			expErr: "duplicate fee share payer",
+		},
+		{
+			name: "fee granter set with the shares",
+			malleate: func(s *AnteTestSuite, accs []TestAccount) []authtypes.FeeShare {
+				s.txBuilder.SetFeeGranter(accs[2].acc.GetAddress())
+				return []authtypes.FeeShare{
+					authtypes.NewFeeShare(accs[0].acc.GetAddress().String(), share1, ""),
+					authtypes.NewFeeShare(accs[1].acc.GetAddress().String(), share2, ""),
+				}
+			},
+			expErr: "fee granter cannot be set with fee shares",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := SetupTestSuite(t, false)
+			s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+			accs := s.CreateTestAccounts(3)
+
+			require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+			s.txBuilder.SetFeeAmount(feeAmount)
+			s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			shares := tc.malleate(s, accs)
+			opt, err := codectypes.NewAnyWithValue(authtypes.NewExtensionOptionFeeShares(shares...))
+			require.NoError(t, err)
+			s.txBuilder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(opt)
+
+			// the payers of the shares sign the transaction
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+			for _, share := range shares {
+				if share.Payer == accs[1].acc.GetAddress().String() {
+					privs, accNums, accSeqs = append(privs, accs[1].priv), append(accNums, 1), append(accSeqs, 0)
+				}
+			}
+			tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+
+			signers, err := tx.GetSigners()
+			require.NoError(t, err)
+			require.Len(t, signers, len(privs))
+
+			antehandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil))
+			newCtx, err := antehandler(s.ctx, tx, false)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			deducted := ante.GetDeductedFees(newCtx)
+			require.Len(t, deducted, len(shares))
+			for i, share := range shares {
+				require.Equal(t, share.Amount, deducted[i].Amount)
+			}
+		})
+	}
+}
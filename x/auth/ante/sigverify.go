@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"slices"
 	"time"
 
 	"google.golang.org/protobuf/types/known/anypb"
@@ -12,10 +13,12 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
@@ -32,6 +35,7 @@ var (
 	key                = make([]byte, secp256k1.PubKeySize)
 	simSecp256k1Pubkey = &secp256k1.PubKey{Key: key}
 	simSecp256k1Sig    [64]byte
+	simBls12381Sig     [96]byte
 )
 
 func init() {
@@ -83,6 +87,12 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			return sdk.Context{}, err
 		}
 
+		// signers authenticated by their account authenticators are not
+		// verified against the account public key, which must not be set
+		if isAuthenticatedSigner(ctx, signers[i]) {
+			continue
+		}
+
 		// PublicKey was omitted from slice since it has already been set in context
 		if pk == nil {
 			if !simulate {
@@ -192,6 +202,12 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	}
 
 	for i, sig := range sigs {
+		// the gas of signers authenticated by their account authenticators
+		// was consumed by the AuthenticatorDecorator
+		if isAuthenticatedSigner(ctx, signers[i]) {
+			continue
+		}
+
 		signerAcc, err := GetSignerAcc(ctx, sgcd.ak, signers[i])
 		if err != nil {
 			return ctx, err
@@ -214,6 +230,15 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			Sequence: sig.Sequence,
 		}
 
+		// In simulate mode the empty signature of a BLS12-381 signer is not part
+		// of an aggregate signature, so the cost of a full verification is consumed.
+		if simulate && authsigning.IsAggregatedSignature(sig) {
+			sig.Data = &signing.SingleSignatureData{
+				SignMode:  sig.Data.(*signing.SingleSignatureData).SignMode,
+				Signature: simBls12381Sig[:],
+			}
+		}
+
 		err = sgcd.sigGasConsumer(ctx.GasMeter(), sig, params)
 		if err != nil {
 			return ctx, err
@@ -343,10 +368,18 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 	}
 
+	// the BLS12-381 signers are verified once all the signers are processed, as
+	// their signatures may be aggregated
+	var blsSigners []blsSigner
 	for i, sig := range sigs {
 		if sig.Sequence > 0 && isUnordered {
 			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sequence is not allowed for unordered transactions")
 		}
+		// signers authenticated by their account authenticators were verified
+		// by the AuthenticatorDecorator
+		if isAuthenticatedSigner(ctx, signers[i]) {
+			continue
+		}
 		acc, err := GetSignerAcc(ctx, svd.ak, signers[i])
 		if err != nil {
 			return ctx, err
@@ -395,6 +428,18 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 				return ctx, fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
 			}
 			txData := adaptableTx.GetSigningTxData()
+
+			if blsPubKey, ok := pubKey.(*bls12_381.PubKey); ok {
+				if data, ok := sig.Data.(*signing.SingleSignatureData); ok {
+					blsSigners = append(blsSigners, blsSigner{
+						AggregateSigner: authsigning.AggregateSigner{PubKey: blsPubKey, SignerData: signerData, SignMode: data.SignMode},
+						signature:       data.Signature,
+						txData:          txData,
+					})
+					continue
+				}
+			}
+
 			err = authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
 			if err != nil {
 				var errMsg string
@@ -410,9 +455,59 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 	}
 
+	if err := svd.verifyBLSSignatures(ctx, blsSigners); err != nil {
+		return ctx, err
+	}
+
 	return next(ctx, tx, simulate)
 }
 
+// blsSigner is a BLS12-381 signer whose signature verification is deferred by
+// the SigVerificationDecorator.
+type blsSigner struct {
+	authsigning.AggregateSigner
+
+	signature []byte
+	txData    txsigning.TxData
+}
+
+// verifyBLSSignatures verifies the signatures of the BLS12-381 signers. When the
+// signature of at least one of them is empty, the signature of the first one must
+// be the aggregate signature of all of them, and the signatures of the other ones
+// must be empty. It is then verified with a single pairing check.
+func (svd SigVerificationDecorator) verifyBLSSignatures(ctx sdk.Context, signers []blsSigner) error {
+	aggregated := slices.ContainsFunc(signers, func(signer blsSigner) bool { return len(signer.signature) == 0 })
+	if !aggregated {
+		for _, signer := range signers {
+			sigData := &signing.SingleSignatureData{SignMode: signer.SignMode, Signature: signer.signature}
+			err := authsigning.VerifySignature(ctx, signer.PubKey, signer.SignerData, sigData, svd.signModeHandler, signer.txData)
+			if err != nil {
+				errMsg := fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", signer.SignerData.AccountNumber, signer.SignerData.ChainID, err.Error())
+				return errorsmod.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+			}
+		}
+		return nil
+	}
+
+	if len(signers[0].signature) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "the first BLS12-381 signer must carry the aggregate signature")
+	}
+	aggSigners := make([]authsigning.AggregateSigner, len(signers))
+	for i, signer := range signers {
+		if i > 0 && len(signer.signature) != 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signature of BLS12-381 signer %s must be empty as it is aggregated", signer.SignerData.Address)
+		}
+		aggSigners[i] = signer.AggregateSigner
+	}
+
+	err := authsigning.VerifyAggregateSignature(ctx, aggSigners, signers[0].signature, svd.signModeHandler, signers[0].txData)
+	if err != nil {
+		errMsg := fmt.Sprintf("aggregate signature verification failed; please verify account numbers and chain-id (%s): (%s)", ctx.ChainID(), err.Error())
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+	}
+	return nil
+}
+
 // verifyUnorderedNonce verifies the unordered nonce of an unordered transaction.
 // This checks that:
 // 1. The unordered transaction's timeout timestamp is set.
@@ -583,6 +678,23 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *bls12_381.PubKey:
+		if authsigning.IsAggregatedSignature(sig) {
+			meter.ConsumeGas(params.SigVerifyCostBls12381Aggregated(), "ante verify: bls12_381 aggregated")
+			return nil
+		}
+		meter.ConsumeGas(params.SigVerifyCostBls12381OrDefault(), "ante verify: bls12_381")
+		return nil
+
+	case *webauthn.PubKey:
+		// on top of the secp256r1 verification, the assertion is decoded and
+		// hashed, so its size is charged as well.
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: webauthn")
+		if data, ok := sig.Data.(*signing.SingleSignatureData); ok {
+			meter.ConsumeGas(params.TxSizeCostPerByte*uint64(len(data.Signature)), "ante verify: webauthn assertion")
+		}
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -1,11 +1,13 @@
 package ante_test
 
 import (
+	"fmt"
 	"testing"
 
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 )
@@ -42,3 +44,47 @@ func BenchmarkSig(b *testing.B) {
 		}
 	})
 }
+
+// This benchmark compares the verification of the individual signatures of n
+// BLS12-381 signers with the verification of their aggregated signature, and is
+// used to assess the SigVerifyCostBls12381Aggregated value.
+func BenchmarkAggregateSig(b *testing.B) {
+	if !bls12_381.Enabled {
+		b.Skip("bls12_381 keys require the bls12381 build tag")
+	}
+	require := require.New(b)
+
+	for _, n := range []int{2, 4, 7} {
+		pubKeys := make([]*bls12_381.PubKey, n)
+		msgs := make([][]byte, n)
+		sigs := make([][]byte, n)
+		for i := range n {
+			sk, err := bls12_381.GenPrivKey()
+			require.NoError(err)
+			pubKeys[i] = sk.PubKey().(*bls12_381.PubKey)
+			msgs[i] = cmtcrypto.CRandBytes(1000)
+			sigs[i], err = sk.Sign(msgs[i])
+			require.NoError(err)
+		}
+		aggSig, err := bls12_381.AggregateSignatures(sigs)
+		require.NoError(err)
+
+		b.Run(fmt.Sprintf("individual/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ReportMetric(float64(n*len(sigs[0])), "sig-bytes")
+			for b.Loop() {
+				for i := range n {
+					require.True(pubKeys[i].VerifySignature(msgs[i], sigs[i]))
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("aggregate/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ReportMetric(float64(len(aggSig)), "sig-bytes")
+			for b.Loop() {
+				require.True(bls12_381.VerifyAggregateSignature(pubKeys, msgs, aggSig))
+			}
+		})
+	}
+}
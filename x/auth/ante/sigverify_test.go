@@ -9,15 +9,18 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
@@ -107,6 +110,10 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 
 	p := types.DefaultParams()
 	skR1, _ := secp256r1.GenPrivKey()
+	passkey, err := webauthn.NewPubKeyFromBytes(skR1.PubKey().Bytes())
+	require.NoError(t, err)
+	assertion := &signing.SingleSignatureData{Signature: make([]byte, 200)}
+	pkBls := &bls12_381.PubKey{Key: make([]byte, 96)}
 	pkSet1, sigSet1 := generatePubKeysAndSignatures(5, msg, false)
 	multisigKey1 := kmultisig.NewLegacyAminoPubKey(2, pkSet1)
 	multisignature1 := multisig.NewMultisig(len(pkSet1))
@@ -134,6 +141,9 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		{"PubKeyEd25519", args{storetypes.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, false},
 		{"PubKeySecp256k1", args{storetypes.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{storetypes.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeyBls12381", args{storetypes.NewInfiniteGasMeter(), &signing.SingleSignatureData{Signature: make([]byte, 96)}, pkBls, params}, p.SigVerifyCostBls12381, false},
+		{"PubKeyBls12381 aggregated", args{storetypes.NewInfiniteGasMeter(), &signing.SingleSignatureData{}, pkBls, params}, p.SigVerifyCostBls12381Aggregated(), false},
+		{"PubKeyWebAuthn", args{storetypes.NewInfiniteGasMeter(), assertion, passkey, params}, p.SigVerifyCostSecp256r1() + 200*p.TxSizeCostPerByte, false},
 		{"Multisig", args{storetypes.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{storetypes.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -452,3 +462,126 @@ func TestIncrementSequenceDecorator(t *testing.T) {
 		})
 	}
 }
+
+func TestSigVerificationBLSAggregate(t *testing.T) {
+	if !bls12_381.Enabled {
+		t.Skip("bls12_381 keys require the bls12381 build tag")
+	}
+
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// make block height non-zero to ensure account numbers part of signBytes
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	var (
+		privs   []cryptotypes.PrivKey
+		msgs    []sdk.Msg
+		accNums []uint64
+	)
+	for i := range 3 {
+		var priv cryptotypes.PrivKey
+		if i == 1 {
+			priv = secp256k1.GenPrivKey()
+		} else {
+			blsPriv, err := bls12_381.GenPrivKey()
+			require.NoError(t, err)
+			priv = &blsPriv
+		}
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		require.NoError(t, acc.SetAccountNumber(uint64(i)+1000))
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+		privs = append(privs, priv)
+		msgs = append(msgs, testdata.NewTestMsg(addr))
+		accNums = append(accNums, acc.GetAccountNumber())
+	}
+
+	params := types.DefaultParams()
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	sgcd := ante.NewSigGasConsumeDecorator(suite.accountKeeper, ante.DefaultSigVerificationGasConsumer)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, sgcd, svd)
+
+	testCases := []struct {
+		name      string
+		malleate  func(sigs []signing.SignatureV2) []signing.SignatureV2
+		shouldErr bool
+	}{
+		{
+			name:     "individual signatures",
+			malleate: func(sigs []signing.SignatureV2) []signing.SignatureV2 { return sigs },
+		},
+		{
+			name: "aggregate signature",
+			malleate: func(sigs []signing.SignatureV2) []signing.SignatureV2 {
+				sigs, err := authsign.AggregateSignatures(sigs)
+				require.NoError(t, err)
+				return sigs
+			},
+		},
+		{
+			name: "aggregate signature missing a signer",
+			malleate: func(sigs []signing.SignatureV2) []signing.SignatureV2 {
+				sigs[2].Data.(*signing.SingleSignatureData).Signature = nil
+				return sigs
+			},
+			shouldErr: true,
+		},
+		{
+			name: "aggregated signature not empty",
+			malleate: func(sigs []signing.SignatureV2) []signing.SignatureV2 {
+				aggregated, err := authsign.AggregateSignatures(sigs)
+				require.NoError(t, err)
+				aggregated[2] = sigs[2]
+				return aggregated
+			},
+			shouldErr: true,
+		},
+		{
+			name: "aggregate signature carried by the last signer",
+			malleate: func(sigs []signing.SignatureV2) []signing.SignatureV2 {
+				aggregated, err := authsign.AggregateSignatures(sigs)
+				require.NoError(t, err)
+				aggregated[0].Data, aggregated[2].Data = aggregated[2].Data, aggregated[0].Data
+				return aggregated
+			},
+			shouldErr: true,
+		},
+	}
+
+	gasConsumed := make(map[string]uint64)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, []uint64{0, 0, 0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+
+			sigs, err := tx.GetSignaturesV2()
+			require.NoError(t, err)
+			require.NoError(t, suite.txBuilder.SetSignatures(tc.malleate(sigs)...))
+			tx = suite.txBuilder.GetTx()
+
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			_, err = antehandler(ctx, tx, false)
+			if tc.shouldErr {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				return
+			}
+			require.NoError(t, err)
+			gasConsumed[tc.name] = ctx.GasMeter().GasConsumed()
+		})
+	}
+
+	// the aggregated signature is charged less than an individual one
+	require.Equal(t,
+		params.SigVerifyCostBls12381-params.SigVerifyCostBls12381Aggregated(),
+		gasConsumed["individual signatures"]-gasConsumed["aggregate signature"],
+	)
+}
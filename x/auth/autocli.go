@@ -5,8 +5,10 @@ import (
 
 	authv1beta1 "cosmossdk.io/api/cosmos/auth/v1beta1"
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	_ "cosmossdk.io/api/cosmos/crypto/bls12_381" // register so that it shows up in protoregistry.GlobalTypes
 	_ "cosmossdk.io/api/cosmos/crypto/secp256k1" // register so that it shows up in protoregistry.GlobalTypes
 	_ "cosmossdk.io/api/cosmos/crypto/secp256r1" // register so that it shows up in protoregistry.GlobalTypes
+	_ "cosmossdk.io/api/cosmos/crypto/webauthn"  // register so that it shows up in protoregistry.GlobalTypes
 
 	"github.com/cosmos/cosmos-sdk/version"
 )
@@ -74,6 +76,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current auth parameters",
 				},
+				{
+					RpcMethod:      "AccountAuthenticators",
+					Use:            "authenticators [address]",
+					Short:          "Query the authenticators registered on an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -87,6 +95,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "AddAuthenticator",
+					Use:            "add-authenticator [authenticator]",
+					Short:          "Register an authenticator on the sender account",
+					Example:        fmt.Sprintf(`%s tx auth add-authenticator '{"@type":"/cosmos.auth.v1beta1.SignatureAuthenticator","pub_key":{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A..."}}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "authenticator"}},
+				},
+				{
+					RpcMethod:      "RemoveAuthenticator",
+					Use:            "remove-authenticator [id]",
+					Short:          "Remove an authenticator from the sender account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 			},
 		},
 	}
@@ -0,0 +1,341 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// signingSessionStatus is the status of a multisig signing session.
+type signingSessionStatus struct {
+	Address       string                 `json:"address"`
+	ChainID       string                 `json:"chain_id"`
+	AccountNumber uint64                 `json:"account_number,string"`
+	Sequence      uint64                 `json:"sequence,string"`
+	Threshold     uint32                 `json:"threshold"`
+	Signatures    int                    `json:"signatures"`
+	Complete      bool                   `json:"complete"`
+	Members       []signingSessionMember `json:"members"`
+}
+
+// signingSessionMember is a member of the multisig of a signing session.
+type signingSessionMember struct {
+	Address string `json:"address"`
+	Signed  bool   `json:"signed"`
+}
+
+// GetMultisigSessionCommand returns the multisig signing session commands.
+func GetMultisigSessionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig-session",
+		Short: "Coordinate the signatures of a multisig transaction in a signing session file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Coordinate the signatures of a multisig transaction in a signing session file.
+
+A signing session holds the unsigned transaction of a multisig account, the account number and
+sequence it is signed for, the multisig threshold and the signatures collected so far. The
+session file is passed from member to member, each signing into it, and finalized once the
+threshold of signatures is reached.
+
+Example:
+$ %[1]s tx multisig-session create tx.json k1k2k3 session.json
+$ %[1]s tx multisig-session sign session.json --from k1
+$ %[1]s tx multisig-session sign session.json --from k2
+$ %[1]s tx multisig-session status session.json
+$ %[1]s tx multisig-session finalize session.json
+
+The current multisig implementation defaults to amino-json sign mode.
+Only the amino-json and textual sign modes are supported.
+`, version.AppName),
+		),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		getMultisigSessionCreateCommand(),
+		getMultisigSessionSignCommand(),
+		getMultisigSessionStatusCommand(),
+		getMultisigSessionFinalizeCommand(),
+	)
+
+	return cmd
+}
+
+func getMultisigSessionCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [file] [name] [session-file]",
+		Short: "Create a signing session of a transaction generated offline for the multisig key [name]",
+		Long: `Create a signing session of the unsigned transaction read from [file] for the multisig
+key [name] and write it to [session-file].
+
+The account number and sequence of the multisig account are queried from the node, unless the
+--offline flag is set, in which case they must be set with the --account-number and --sequence
+flags.
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if txFactory.SignMode() == signingtypes.SignMode_SIGN_MODE_UNSPECIFIED {
+				txFactory = txFactory.WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			}
+
+			if _, err := os.Stat(args[2]); err == nil {
+				return fmt.Errorf("signing session %s already exists", args[2])
+			}
+
+			unsignedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			k, err := getMultisigRecord(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+			pubKey, err := k.GetPubKey()
+			if err != nil {
+				return err
+			}
+			multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+			if !ok {
+				return fmt.Errorf("%s is not a multisig key", args[1])
+			}
+
+			accNum, seq := txFactory.AccountNumber(), txFactory.Sequence()
+			if !clientCtx.Offline {
+				accNum, seq, err = clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, sdk.AccAddress(multisigPub.Address()))
+				if err != nil {
+					return err
+				}
+			}
+
+			session, err := authclient.NewSigningSession(unsignedTx, multisigPub, txFactory.ChainID(), accNum, seq, txFactory.SignMode())
+			if err != nil {
+				return err
+			}
+
+			if err := session.Write(clientCtx, args[2]); err != nil {
+				return err
+			}
+
+			return printSigningSessionStatus(clientCtx, session)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.Flags().MarkHidden(flags.FlagOutput)
+
+	return cmd
+}
+
+func getMultisigSessionSignCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [session-file]",
+		Short: "Sign a multisig signing session with the --from key",
+		Long: `Sign the transaction of the signing session read from [session-file] with the --from key,
+which must be a member of the multisig, and add the signature to the session file.
+
+Unless the --offline flag is set, the command fails when the account number or the sequence of
+the multisig account drifted from the ones the session is signed for.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			session, err := readSigningSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := session.Sign(clientCtx, txFactory, clientCtx.FromName); err != nil {
+				return err
+			}
+
+			if err := session.Write(clientCtx, args[0]); err != nil {
+				return err
+			}
+
+			return printSigningSessionStatus(clientCtx, session)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	_ = cmd.Flags().MarkHidden(flags.FlagOutput)
+
+	return cmd
+}
+
+func getMultisigSessionStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [session-file]",
+		Short: "Show the signatures collected by a multisig signing session",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := authclient.ReadSigningSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			return printSigningSessionStatus(clientCtx, session)
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagOutput, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+func getMultisigSessionFinalizeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize [session-file]",
+		Short: "Assemble the multisig signature of a signing session and broadcast the transaction",
+		Long: `Assemble the multisig signature from the signatures collected by the signing session read
+from [session-file] and broadcast the signed transaction. The threshold of signatures must be
+reached.
+
+Unless the --offline flag is set, the command fails when the account number or the sequence of
+the multisig account drifted from the ones the session is signed for. With the --generate-only
+or --offline flag, the signed transaction is printed instead of broadcast.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readSigningSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			signedTx, err := session.SignedTx(clientCtx.GetCmdContextWithFallback(), clientCtx.TxConfig)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.GenerateOnly || clientCtx.Offline {
+				json, err := clientCtx.TxConfig.TxJSONEncoder()(signedTx)
+				if err != nil {
+					return err
+				}
+
+				closeFunc, err := setOutputFile(cmd)
+				if err != nil {
+					return err
+				}
+				defer closeFunc()
+
+				cmd.Printf("%s\n", json)
+				return nil
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(signedTx)
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readSigningSession reads a signing session and, unless offline, checks that
+// the account number and sequence of the multisig account did not drift.
+func readSigningSession(clientCtx client.Context, filename string) (*authclient.SigningSession, error) {
+	session, err := authclient.ReadSigningSession(clientCtx, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if clientCtx.ChainID != "" && clientCtx.ChainID != session.ChainID {
+		return nil, fmt.Errorf("signing session is for chain %s, not %s", session.ChainID, clientCtx.ChainID)
+	}
+
+	if clientCtx.Offline {
+		return session, nil
+	}
+
+	accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, sdk.AccAddress(session.PubKey.Address()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := session.CheckAccount(accNum, seq); err != nil {
+		return nil, errors.Join(err, errors.New("create a new signing session"))
+	}
+
+	return session, nil
+}
+
+func printSigningSessionStatus(clientCtx client.Context, session *authclient.SigningSession) error {
+	status := signingSessionStatus{
+		Address:       sdk.AccAddress(session.PubKey.Address()).String(),
+		ChainID:       session.ChainID,
+		AccountNumber: session.AccountNumber,
+		Sequence:      session.Sequence,
+		Threshold:     session.PubKey.Threshold,
+		Signatures:    len(session.Signatures),
+		Complete:      session.IsComplete(),
+	}
+	for _, pk := range session.PubKey.GetPubKeys() {
+		status.Members = append(status.Members, signingSessionMember{
+			Address: sdk.AccAddress(pk.Address()).String(),
+			Signed:  session.HasSigned(pk),
+		})
+	}
+
+	bz, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintRaw(bz)
+}
@@ -1,16 +1,25 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 )
 
+// FlagSuggestFees is the flag printing the fees suggested by the x/feemarket
+// module for the simulated gas.
+const FlagSuggestFees = "suggest-fees"
+
 // GetSimulateCmd returns a command that simulates whether a transaction will be
 // successful.
 func GetSimulateCmd() *cobra.Command {
@@ -63,6 +72,10 @@ empty; they will be auto-populated by dummy data for simulation purpose.
 
 The --from flag is mandatory, as the signer account's correct sequence number is
 necessary for simulation.
+
+With --suggest-fees, the fees covering the base fee of the next block for the
+simulated gas, multiplied by --gas-adjustment, are printed too. This requires
+the x/feemarket module.
 `),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -86,16 +99,31 @@ necessary for simulation.
 				return err
 			}
 
-			simRes, _, err := tx.CalculateGas(clientCtx, txf, stdTx.GetMsgs()...)
+			simRes, adjusted, err := tx.CalculateGas(clientCtx, txf, stdTx.GetMsgs()...)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(simRes)
+			if err := clientCtx.PrintProto(simRes); err != nil {
+				return err
+			}
+
+			if suggest, _ := cmd.Flags().GetBool(FlagSuggestFees); !suggest {
+				return nil
+			}
+
+			res, err := feemarkettypes.NewQueryClient(clientCtx).SuggestGasPrice(cmd.Context(), &feemarkettypes.QuerySuggestGasPriceRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to query the suggested gas price: %w", err)
+			}
+
+			fee := res.GasPrice.Amount.MulInt(math.NewIntFromUint64(adjusted)).Ceil().RoundInt()
+			return clientCtx.PrintString(fmt.Sprintf("gas: %d\nsuggested fees: %s\n", adjusted, sdk.NewCoin(res.GasPrice.Denom, fee)))
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagSuggestFees, false, "Print the fees suggested by the fee market for the simulated gas")
 
 	return cmd
 }
@@ -0,0 +1,367 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	txsigning "github.com/cosmos/cosmos-sdk/x/tx/signing"
+)
+
+// SigningSession is a multisig signing session: the unsigned transaction of a
+// multisig account together with the account number and sequence it is signed
+// for and the signatures collected from the multisig members so far. Members
+// sign into the session file one after the other, and the session is finalized
+// once the threshold of signatures is reached.
+type SigningSession struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+	SignMode      signing.SignMode
+	PubKey        *kmultisig.LegacyAminoPubKey
+	Tx            sdk.Tx
+	Signatures    []signing.SignatureV2
+}
+
+// signingSessionJSON is the JSON file format of a SigningSession.
+type signingSessionJSON struct {
+	ChainID       string          `json:"chain_id"`
+	Address       string          `json:"address"`
+	AccountNumber uint64          `json:"account_number,string"`
+	Sequence      uint64          `json:"sequence,string"`
+	SignMode      string          `json:"sign_mode"`
+	Threshold     uint32          `json:"threshold"`
+	PubKey        json.RawMessage `json:"pub_key"`
+	Tx            json.RawMessage `json:"tx"`
+	Signatures    json.RawMessage `json:"signatures"`
+}
+
+// NewSigningSession creates a signing session of the unsigned transaction for
+// the given multisig public key. The transaction must be signed by the
+// multisig account.
+func NewSigningSession(tx sdk.Tx, pubKey *kmultisig.LegacyAminoPubKey, chainID string, accNum, seq uint64, signMode signing.SignMode) (*SigningSession, error) {
+	if chainID == "" {
+		return nil, errors.New("set the chain id with either the --chain-id flag or config file")
+	}
+	if err := validateSessionSignMode(signMode); err != nil {
+		return nil, err
+	}
+
+	signers, err := tx.(authsigning.Tx).GetSigners()
+	if err != nil {
+		return nil, err
+	}
+	if !isTxSigner(pubKey.Address(), signers) {
+		return nil, fmt.Errorf("multisig %s is not a signer of the transaction", sdk.AccAddress(pubKey.Address()))
+	}
+
+	return &SigningSession{
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      seq,
+		SignMode:      signMode,
+		PubKey:        pubKey,
+		Tx:            tx,
+	}, nil
+}
+
+// validateSessionSignMode returns an error if the members of a multisig
+// cannot sign with the given sign mode. The signatures of a multisig signer
+// cover every signer of the transaction only in the amino-json and textual
+// sign modes.
+func validateSessionSignMode(signMode signing.SignMode) error {
+	switch signMode {
+	case signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signing.SignMode_SIGN_MODE_TEXTUAL:
+		return nil
+	default:
+		return fmt.Errorf("sign mode %s is not supported by multisig signing sessions, use %s or %s", signMode, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signing.SignMode_SIGN_MODE_TEXTUAL)
+	}
+}
+
+// ReadSigningSession reads a signing session from the given file.
+func ReadSigningSession(clientCtx client.Context, filename string) (*SigningSession, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var file signingSessionJSON
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return nil, fmt.Errorf("invalid signing session %s: %w", filename, err)
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(file.PubKey, &pubKey); err != nil {
+		return nil, fmt.Errorf("invalid signing session public key: %w", err)
+	}
+	multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, fmt.Errorf("signing session public key is not a multisig public key: %T", pubKey)
+	}
+	if file.Threshold != multisigPub.Threshold {
+		return nil, fmt.Errorf("signing session threshold %d does not match the public key threshold %d", file.Threshold, multisigPub.Threshold)
+	}
+
+	signMode, ok := signing.SignMode_value[file.SignMode]
+	if !ok {
+		return nil, fmt.Errorf("invalid signing session sign mode %s", file.SignMode)
+	}
+	if err := validateSessionSignMode(signing.SignMode(signMode)); err != nil {
+		return nil, err
+	}
+
+	tx, err := clientCtx.TxConfig.TxJSONDecoder()(file.Tx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing session transaction: %w", err)
+	}
+
+	session := &SigningSession{
+		ChainID:       file.ChainID,
+		AccountNumber: file.AccountNumber,
+		Sequence:      file.Sequence,
+		SignMode:      signing.SignMode(signMode),
+		PubKey:        multisigPub,
+		Tx:            tx,
+	}
+
+	if len(file.Signatures) > 0 {
+		sigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(file.Signatures)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signing session signatures: %w", err)
+		}
+		for _, sig := range sigs {
+			if err := session.addSignature(sig); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return session, nil
+}
+
+// Write writes the signing session to the given file.
+func (s *SigningSession) Write(clientCtx client.Context, filename string) error {
+	pubKey, err := clientCtx.Codec.MarshalInterfaceJSON(s.PubKey)
+	if err != nil {
+		return err
+	}
+
+	tx, err := clientCtx.TxConfig.TxJSONEncoder()(s.Tx)
+	if err != nil {
+		return err
+	}
+
+	var sigs json.RawMessage
+	if len(s.Signatures) > 0 {
+		if sigs, err = clientCtx.TxConfig.MarshalSignatureJSON(s.Signatures); err != nil {
+			return err
+		}
+	}
+
+	bz, err := json.MarshalIndent(signingSessionJSON{
+		ChainID:       s.ChainID,
+		Address:       sdk.AccAddress(s.PubKey.Address()).String(),
+		AccountNumber: s.AccountNumber,
+		Sequence:      s.Sequence,
+		SignMode:      s.SignMode.String(),
+		Threshold:     s.PubKey.Threshold,
+		PubKey:        pubKey,
+		Tx:            tx,
+		Signatures:    sigs,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(bz, '\n'), 0o600)
+}
+
+// CheckAccount returns an error when the account number or the sequence of the
+// multisig account drifted from the ones the session is signed for, in which
+// case the collected signatures are no longer valid.
+func (s *SigningSession) CheckAccount(accNum, seq uint64) error {
+	if accNum != s.AccountNumber {
+		return fmt.Errorf("account number drift: session signed for account number %d, account has number %d", s.AccountNumber, accNum)
+	}
+	if seq != s.Sequence {
+		return fmt.Errorf("sequence drift: session signed for sequence %d, account is at sequence %d", s.Sequence, seq)
+	}
+
+	return nil
+}
+
+// AddSignature verifies the signature of a multisig member and adds it to the
+// session.
+func (s *SigningSession) AddSignature(ctx context.Context, txCfg client.TxConfig, sig signing.SignatureV2) error {
+	if err := s.VerifySignature(ctx, txCfg, sig); err != nil {
+		return err
+	}
+
+	return s.addSignature(sig)
+}
+
+func (s *SigningSession) addSignature(sig signing.SignatureV2) error {
+	if !s.IsMember(sig.PubKey) {
+		return fmt.Errorf("%s is not a member of multisig %s", sdk.AccAddress(sig.PubKey.Address()), sdk.AccAddress(s.PubKey.Address()))
+	}
+	if s.HasSigned(sig.PubKey) {
+		return fmt.Errorf("%s already signed", sdk.AccAddress(sig.PubKey.Address()))
+	}
+	if sig.Sequence != s.Sequence {
+		return fmt.Errorf("signature of %s is for sequence %d, expected %d", sdk.AccAddress(sig.PubKey.Address()), sig.Sequence, s.Sequence)
+	}
+
+	s.Signatures = append(s.Signatures, sig)
+	return nil
+}
+
+// IsMember returns true if the public key is one of the multisig public keys.
+func (s *SigningSession) IsMember(pubKey cryptotypes.PubKey) bool {
+	for _, pk := range s.PubKey.GetPubKeys() {
+		if pk.Equals(pubKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasSigned returns true if the session has a signature of the public key.
+func (s *SigningSession) HasSigned(pubKey cryptotypes.PubKey) bool {
+	for _, sig := range s.Signatures {
+		if sig.PubKey.Equals(pubKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsComplete returns true if the threshold of signatures is reached.
+func (s *SigningSession) IsComplete() bool {
+	return len(s.Signatures) >= int(s.PubKey.Threshold)
+}
+
+// Sign signs the session transaction with the key of a multisig member and
+// adds the signature to the session.
+func (s *SigningSession) Sign(clientCtx client.Context, txFactory tx.Factory, name string) error {
+	k, err := txFactory.Keybase().Key(name)
+	if err != nil {
+		return err
+	}
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if !s.IsMember(pubKey) {
+		return fmt.Errorf("key %s is not a member of multisig %s", name, sdk.AccAddress(s.PubKey.Address()))
+	}
+	if s.HasSigned(pubKey) {
+		return fmt.Errorf("key %s already signed", name)
+	}
+
+	// sign a copy of the transaction, the session one stays unsigned
+	bz, err := clientCtx.TxConfig.TxJSONEncoder()(s.Tx)
+	if err != nil {
+		return err
+	}
+	unsignedTx, err := clientCtx.TxConfig.TxJSONDecoder()(bz)
+	if err != nil {
+		return err
+	}
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(unsignedTx)
+	if err != nil {
+		return err
+	}
+
+	txFactory = txFactory.
+		WithChainID(s.ChainID).
+		WithAccountNumber(s.AccountNumber).
+		WithSequence(s.Sequence).
+		WithSignMode(s.SignMode)
+	if err := tx.Sign(clientCtx.GetCmdContextWithFallback(), txFactory, name, txBuilder, true); err != nil {
+		return err
+	}
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	return s.AddSignature(clientCtx.GetCmdContextWithFallback(), clientCtx.TxConfig, sigs[0])
+}
+
+// VerifySignature verifies the signature of a multisig member against the
+// session transaction.
+func (s *SigningSession) VerifySignature(ctx context.Context, txCfg client.TxConfig, sig signing.SignatureV2) error {
+	anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+	if err != nil {
+		return err
+	}
+
+	adaptableTx, ok := s.Tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", s.Tx)
+	}
+
+	signerData := txsigning.SignerData{
+		ChainID:       s.ChainID,
+		AccountNumber: s.AccountNumber,
+		Sequence:      s.Sequence,
+		Address:       sdk.AccAddress(sig.PubKey.Address()).String(),
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}
+	if err := authsigning.VerifySignature(ctx, sig.PubKey, signerData, sig.Data, txCfg.SignModeHandler(), adaptableTx.GetSigningTxData()); err != nil {
+		return fmt.Errorf("couldn't verify signature of %s: %w", sdk.AccAddress(sig.PubKey.Address()), err)
+	}
+
+	return nil
+}
+
+// SignedTx returns the transaction signed with the multisig signature of the
+// collected signatures, which are verified again. It returns an error if the
+// threshold is not reached.
+func (s *SigningSession) SignedTx(ctx context.Context, txCfg client.TxConfig) (sdk.Tx, error) {
+	if !s.IsComplete() {
+		return nil, fmt.Errorf("signing session has %d signatures out of the %d required", len(s.Signatures), s.PubKey.Threshold)
+	}
+
+	multisigSig := multisig.NewMultisig(len(s.PubKey.PubKeys))
+	for _, sig := range s.Signatures {
+		if err := s.VerifySignature(ctx, txCfg, sig); err != nil {
+			return nil, err
+		}
+		if err := multisig.AddSignatureV2(multisigSig, sig, s.PubKey.GetPubKeys()); err != nil {
+			return nil, err
+		}
+	}
+
+	txBuilder, err := txCfg.WrapTxBuilder(s.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   s.PubKey,
+		Data:     multisigSig,
+		Sequence: s.Sequence,
+	}); err != nil {
+		return nil, err
+	}
+
+	return txBuilder.GetTx(), nil
+}
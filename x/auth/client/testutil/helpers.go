@@ -93,3 +93,7 @@ func TxMultiSignBatchExec(clientCtx client.Context, filename, from, sigFile1, si
 
 	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetMultiSignBatchCmd(), args)
 }
+
+func TxMultisigSessionExec(clientCtx client.Context, args ...string) (testutil.BufferWriter, error) {
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetMultisigSessionCommand(), args)
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AddAuthenticator validates and registers an authenticator on the account at
+// the given address and returns its id.
+func (ak AccountKeeper) AddAuthenticator(ctx context.Context, addr sdk.AccAddress, authenticator types.Authenticator) (uint64, error) {
+	if err := types.ValidateAuthenticator(authenticator); err != nil {
+		return 0, err
+	}
+
+	authenticators, err := ak.GetAuthenticators(ctx, addr)
+	if err != nil {
+		return 0, err
+	}
+	if len(authenticators) >= types.MaxAuthenticatorsPerAccount {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account cannot have more than %d authenticators", types.MaxAuthenticatorsPerAccount)
+	}
+
+	id, err := ak.AuthenticatorID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	accAuthenticator, err := types.NewAccountAuthenticator(id, authenticator)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, ak.Authenticators.Set(ctx, collections.Join(addr, id), accAuthenticator)
+}
+
+// RemoveAuthenticator removes the authenticator with the given id from the
+// account at the given address.
+func (ak AccountKeeper) RemoveAuthenticator(ctx context.Context, addr sdk.AccAddress, id uint64) error {
+	key := collections.Join(addr, id)
+	has, err := ak.Authenticators.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "authenticator %d not found", id)
+	}
+
+	return ak.Authenticators.Remove(ctx, key)
+}
+
+// SetAuthenticator updates an authenticator registered on the account at the
+// given address.
+func (ak AccountKeeper) SetAuthenticator(ctx context.Context, addr sdk.AccAddress, accAuthenticator types.AccountAuthenticator) error {
+	key := collections.Join(addr, accAuthenticator.Id)
+	has, err := ak.Authenticators.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "authenticator %d not found", accAuthenticator.Id)
+	}
+
+	return ak.Authenticators.Set(ctx, key, accAuthenticator)
+}
+
+// GetAuthenticators returns the authenticators registered on the account at the
+// given address, ordered by id.
+func (ak AccountKeeper) GetAuthenticators(ctx context.Context, addr sdk.AccAddress) ([]types.AccountAuthenticator, error) {
+	iter, err := ak.Authenticators.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr))
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// GetAllAuthenticators returns the authenticators of all the accounts.
+func (ak AccountKeeper) GetAllAuthenticators(ctx context.Context) ([]types.AccountAuthenticators, error) {
+	var genAuthenticators []types.AccountAuthenticators
+	err := ak.Authenticators.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], accAuthenticator types.AccountAuthenticator) (bool, error) {
+		addr, err := ak.addressCodec.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		if n := len(genAuthenticators); n == 0 || genAuthenticators[n-1].Address != addr {
+			genAuthenticators = append(genAuthenticators, types.AccountAuthenticators{Address: addr})
+		}
+		last := &genAuthenticators[len(genAuthenticators)-1]
+		last.Authenticators = append(last.Authenticators, accAuthenticator)
+
+		return false, nil
+	})
+
+	return genAuthenticators, err
+}
+
+// initGenesisAuthenticators sets the genesis authenticators and the sequence of
+// authenticator ids after the highest genesis id.
+func (ak AccountKeeper) initGenesisAuthenticators(ctx context.Context, genAuthenticators []types.AccountAuthenticators) error {
+	var nextID uint64
+	for _, accAuthenticators := range genAuthenticators {
+		addr, err := ak.addressCodec.StringToBytes(accAuthenticators.Address)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authenticators address %s: %s", accAuthenticators.Address, err)
+		}
+
+		for _, accAuthenticator := range accAuthenticators.Authenticators {
+			if err := ak.Authenticators.Set(ctx, collections.Join(sdk.AccAddress(addr), accAuthenticator.Id), accAuthenticator); err != nil {
+				return err
+			}
+			nextID = max(nextID, accAuthenticator.Id+1)
+		}
+	}
+
+	current, err := ak.AuthenticatorID.Peek(ctx)
+	if err != nil {
+		return err
+	}
+
+	return ak.AuthenticatorID.Set(ctx, max(current, nextID))
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *KeeperTestSuite) TestAddRemoveAuthenticator() {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, addr))
+
+	sigAuthenticator, err := types.NewSignatureAuthenticator(secp256k1.GenPrivKey().PubKey())
+	s.Require().NoError(err)
+	anySigAuthenticator, err := codectypes.NewAnyWithValue(sigAuthenticator)
+	s.Require().NoError(err)
+
+	// restriction authenticators alone cannot authenticate anyone
+	anyFilter, err := codectypes.NewAnyWithValue(types.NewMessageFilterAuthenticator(sdk.MsgTypeURL(&types.MsgAddAuthenticator{})))
+	s.Require().NoError(err)
+	_, err = s.msgServer.AddAuthenticator(s.ctx, &types.MsgAddAuthenticator{Sender: addr.String(), Authenticator: anyFilter})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the account must exist
+	unknown := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	_, err = s.msgServer.AddAuthenticator(s.ctx, &types.MsgAddAuthenticator{Sender: unknown.String(), Authenticator: anySigAuthenticator})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnknownAddress)
+
+	res, err := s.msgServer.AddAuthenticator(s.ctx, &types.MsgAddAuthenticator{Sender: addr.String(), Authenticator: anySigAuthenticator})
+	s.Require().NoError(err)
+
+	queryRes, err := s.queryClient.AccountAuthenticators(s.ctx, &types.QueryAccountAuthenticatorsRequest{Address: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.Authenticators, 1)
+	s.Require().Equal(res.Id, queryRes.Authenticators[0].Id)
+	authenticator, err := queryRes.Authenticators[0].GetAuthenticator()
+	s.Require().NoError(err)
+	s.Require().Equal(sigAuthenticator.PubKey.Value, authenticator.(*types.SignatureAuthenticator).PubKey.Value)
+
+	// authenticators can only be removed by their account
+	_, err = s.msgServer.RemoveAuthenticator(s.ctx, &types.MsgRemoveAuthenticator{Sender: unknown.String(), Id: res.Id})
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	_, err = s.msgServer.RemoveAuthenticator(s.ctx, &types.MsgRemoveAuthenticator{Sender: addr.String(), Id: res.Id})
+	s.Require().NoError(err)
+
+	authenticators, err := s.accountKeeper.GetAuthenticators(s.ctx, addr)
+	s.Require().NoError(err)
+	s.Require().Empty(authenticators)
+}
+
+func (s *KeeperTestSuite) TestAuthenticatorsLimit() {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	for range types.MaxAuthenticatorsPerAccount {
+		sigAuthenticator, err := types.NewSignatureAuthenticator(secp256k1.GenPrivKey().PubKey())
+		s.Require().NoError(err)
+		_, err = s.accountKeeper.AddAuthenticator(s.ctx, addr, sigAuthenticator)
+		s.Require().NoError(err)
+	}
+
+	sigAuthenticator, err := types.NewSignatureAuthenticator(secp256k1.GenPrivKey().PubKey())
+	s.Require().NoError(err)
+	_, err = s.accountKeeper.AddAuthenticator(s.ctx, addr, sigAuthenticator)
+	s.Require().ErrorContains(err, "cannot have more than")
+}
+
+func (s *KeeperTestSuite) TestAuthenticatorsGenesis() {
+	s.Require().NoError(s.accountKeeper.Params.Set(s.ctx, types.DefaultParams()))
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	sigAuthenticator, err := types.NewSignatureAuthenticator(secp256k1.GenPrivKey().PubKey())
+	s.Require().NoError(err)
+	session, err := types.NewThresholdAuthenticator(2, sigAuthenticator, types.NewSpendLimitAuthenticator(sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	s.Require().NoError(err)
+
+	_, err = s.accountKeeper.AddAuthenticator(s.ctx, addr, sigAuthenticator)
+	s.Require().NoError(err)
+	_, err = s.accountKeeper.AddAuthenticator(s.ctx, addr, session)
+	s.Require().NoError(err)
+
+	exported := s.accountKeeper.ExportGenesis(s.ctx)
+	s.Require().Len(exported.Authenticators, 1)
+	s.Require().Len(exported.Authenticators[0].Authenticators, 2)
+	s.Require().NoError(types.ValidateGenesis(*exported))
+
+	s.SetupTest()
+	s.accountKeeper.InitGenesis(s.ctx, *exported)
+
+	authenticators, err := s.accountKeeper.GetAuthenticators(s.ctx, addr)
+	s.Require().NoError(err)
+	s.Require().Equal(exported.Authenticators[0].Authenticators, authenticators)
+
+	// new authenticators get ids after the genesis ones
+	id, err := s.accountKeeper.AddAuthenticator(s.ctx, addr, sigAuthenticator)
+	s.Require().NoError(err)
+	s.Require().Equal(authenticators[1].Id+1, id)
+}
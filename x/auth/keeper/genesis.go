@@ -25,6 +25,10 @@ func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		ak.SetAccount(ctx, acc)
 	}
 
+	if err := ak.initGenesisAuthenticators(ctx, data.Authenticators); err != nil {
+		panic(err)
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 }
 
@@ -39,5 +43,12 @@ func (ak AccountKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genAuthenticators, err := ak.GetAllAuthenticators(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(params, genAccounts)
+	genState.Authenticators = genAuthenticators
+	return genState
 }
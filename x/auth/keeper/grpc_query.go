@@ -245,3 +245,22 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 		},
 	}, nil
 }
+
+// AccountAuthenticators returns the authenticators registered on an account.
+func (s queryServer) AccountAuthenticators(ctx context.Context, req *types.QueryAccountAuthenticatorsRequest) (*types.QueryAccountAuthenticatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	authenticators, err := s.k.GetAuthenticators(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountAuthenticatorsResponse{Authenticators: authenticators}, nil
+}
@@ -108,6 +108,9 @@ type AccountKeeper struct {
 	AccountNumber   collections.Sequence
 	Accounts        *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
 	UnorderedNonces collections.KeySet[collections.Pair[int64, []byte]]
+	// Authenticators key: account address + authenticator id | value: AccountAuthenticator
+	Authenticators  collections.Map[collections.Pair[sdk.AccAddress, uint64], types.AccountAuthenticator]
+	AuthenticatorID collections.Sequence
 }
 
 type InitOption func(*AccountKeeper)
@@ -152,6 +155,8 @@ func NewAccountKeeper(
 		AccountNumber:   collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"), //nolint:staticcheck // kept in place for the migration
 		Accounts:        collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
 		UnorderedNonces: collections.NewKeySet(sb, types.UnorderedNoncesKey, "unordered_nonces", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		Authenticators:  collections.NewMap(sb, types.AuthenticatorsKeyPrefix, "authenticators", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.AccountAuthenticator](cdc)),
+		AuthenticatorID: collections.NewSequence(sb, types.AuthenticatorIDKey, "authenticator_id"),
 	}
 	schema, err := sb.Build()
 	if err != nil {
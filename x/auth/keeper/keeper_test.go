@@ -105,6 +105,7 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			TxSizeCostPerByte:      types.DefaultTxSizeCostPerByte + 1,
 			SigVerifyCostED25519:   types.DefaultSigVerifyCostED25519 + 1,
 			SigVerifyCostSecp256k1: types.DefaultSigVerifyCostSecp256k1 + 1,
+			SigVerifyCostBls12381:  types.DefaultSigVerifyCostBls12381 + 1,
 		},
 	}
 
@@ -117,6 +118,7 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(genState.Params.TxSizeCostPerByte, params.TxSizeCostPerByte, "TxSizeCostPerByte")
 	suite.Require().Equal(genState.Params.SigVerifyCostED25519, params.SigVerifyCostED25519, "SigVerifyCostED25519")
 	suite.Require().Equal(genState.Params.SigVerifyCostSecp256k1, params.SigVerifyCostSecp256k1, "SigVerifyCostSecp256k1")
+	suite.Require().Equal(genState.Params.SigVerifyCostBls12381, params.SigVerifyCostBls12381, "SigVerifyCostBls12381")
 
 	suite.SetupTest() // reset
 	ctx = suite.ctx
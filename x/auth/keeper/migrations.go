@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	v6 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v6"
+	v7 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v7"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -25,3 +26,10 @@ func NewMigrator(keeper AccountKeeper, queryServer grpc.Server, ss exported.Subs
 func (m Migrator) Migrate5to6(ctx sdk.Context) error {
 	return v6.Migrate(ctx, m.keeper.storeService, m.keeper.AccountNumber)
 }
+
+// Migrate6to7 migrates the x/auth module state from the consensus version 6 to
+// version 7. Specifically, it sets the default BLS12-381 signature verification
+// cost.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.Migrate(ctx, m.keeper.Params)
+}
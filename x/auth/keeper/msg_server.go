@@ -3,7 +3,10 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -36,3 +39,43 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) AddAuthenticator(goCtx context.Context, msg *types.MsgAddAuthenticator) (*types.MsgAddAuthenticatorResponse, error) {
+	sender, err := ms.ak.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if msg.Authenticator == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "authenticator cannot be empty")
+	}
+
+	authenticator, ok := msg.Authenticator.GetCachedValue().(types.Authenticator)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", (types.Authenticator)(nil), msg.Authenticator.GetCachedValue())
+	}
+
+	if !ms.ak.HasAccount(goCtx, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Sender)
+	}
+
+	id, err := ms.ak.AddAuthenticator(goCtx, sender, authenticator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddAuthenticatorResponse{Id: id}, nil
+}
+
+func (ms msgServer) RemoveAuthenticator(goCtx context.Context, msg *types.MsgRemoveAuthenticator) (*types.MsgRemoveAuthenticatorResponse, error) {
+	sender, err := ms.ak.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if err := ms.ak.RemoveAuthenticator(goCtx, sender, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAuthenticatorResponse{}, nil
+}
@@ -32,6 +32,7 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 					TxSizeCostPerByte:      5,
 					SigVerifyCostED25519:   694,
 					SigVerifyCostSecp256k1: 511,
+					SigVerifyCostBls12381:  8500,
 				},
 			},
 			expectErr: true,
@@ -47,6 +48,7 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 					TxSizeCostPerByte:      5,
 					SigVerifyCostED25519:   694,
 					SigVerifyCostSecp256k1: 511,
+					SigVerifyCostBls12381:  8500,
 				},
 			},
 			expectErr: true,
@@ -62,6 +64,7 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 					TxSizeCostPerByte:      0,
 					SigVerifyCostED25519:   694,
 					SigVerifyCostSecp256k1: 511,
+					SigVerifyCostBls12381:  8500,
 				},
 			},
 			expectErr: true,
@@ -77,6 +80,7 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 					TxSizeCostPerByte:      5,
 					SigVerifyCostED25519:   0,
 					SigVerifyCostSecp256k1: 511,
+					SigVerifyCostBls12381:  8500,
 				},
 			},
 			expectErr: true,
@@ -92,11 +96,26 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 					TxSizeCostPerByte:      5,
 					SigVerifyCostED25519:   694,
 					SigVerifyCostSecp256k1: 0,
+					SigVerifyCostBls12381:  8500,
 				},
 			},
 			expectErr: true,
 			expErrMsg: "invalid SECP256k1 signature verification cost",
 		},
+		{
+			name: "unset sig verify cost BLS12-381 defaults",
+			req: &types.MsgUpdateParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params: types.Params{
+					MaxMemoCharacters:      140,
+					TxSigLimit:             9,
+					TxSizeCostPerByte:      5,
+					SigVerifyCostED25519:   694,
+					SigVerifyCostSecp256k1: 511,
+				},
+			},
+			expectErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -122,6 +141,7 @@ func (s *KeeperTestSuite) TestUpdateParamsAuthority() {
 		TxSizeCostPerByte:      5,
 		SigVerifyCostED25519:   694,
 		SigVerifyCostSecp256k1: 511,
+		SigVerifyCostBls12381:  8500,
 	}
 
 	s.Run("fallback to keeper authority", func() {
@@ -0,0 +1,24 @@
+package v7
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Migrate sets the BLS12-381 signature verification cost to its default value,
+// as it did not exist before.
+func Migrate(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if p.SigVerifyCostBls12381 == 0 {
+		p.SigVerifyCostBls12381 = types.DefaultSigVerifyCostBls12381
+	}
+
+	return params.Set(ctx, p)
+}
@@ -0,0 +1,38 @@
+package v7
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMigrate(t *testing.T) {
+	kv, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(kv)
+	params := collections.NewItem(sb, collections.NewPrefix(0), "params", codec.CollValue[types.Params](codec.NewProtoCodec(nil)))
+
+	oldParams := types.DefaultParams()
+	oldParams.SigVerifyCostBls12381 = 0
+	require.NoError(t, params.Set(ctx, oldParams))
+
+	require.NoError(t, Migrate(ctx, params))
+
+	got, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultSigVerifyCostBls12381, got.SigVerifyCostBls12381)
+	require.Equal(t, oldParams.SigVerifyCostSecp256k1, got.SigVerifyCostSecp256k1)
+
+	// a cost set by governance is kept
+	got.SigVerifyCostBls12381 = 1
+	require.NoError(t, params.Set(ctx, got))
+	require.NoError(t, Migrate(ctx, params))
+	got, err = params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), got.SigVerifyCostBls12381)
+}
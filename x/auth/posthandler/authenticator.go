@@ -0,0 +1,105 @@
+package posthandler
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AuthenticatorKeeper defines the contract needed to update the authenticators
+// tracking the coins spent by the transactions they authenticated.
+type AuthenticatorKeeper interface {
+	GetAuthenticators(ctx context.Context, addr sdk.AccAddress) ([]types.AccountAuthenticator, error)
+	SetAuthenticator(ctx context.Context, addr sdk.AccAddress, authenticator types.AccountAuthenticator) error
+}
+
+// SpendLimitDecorator charges the coins spent by the signers authenticated by
+// a spend limited authenticator to that authenticator. The coins spent are the
+// decrease of the signer balances between its authentication and the end of
+// the message execution, so fees are not included. A transaction spending more
+// than the remaining limit fails, which reverts its messages.
+type SpendLimitDecorator struct {
+	authKeeper AuthenticatorKeeper
+	bankKeeper ante.BalanceKeeper
+}
+
+func NewSpendLimitDecorator(authKeeper AuthenticatorKeeper, bankKeeper ante.BalanceKeeper) SpendLimitDecorator {
+	return SpendLimitDecorator{
+		authKeeper: authKeeper,
+		bankKeeper: bankKeeper,
+	}
+}
+
+func (sld SpendLimitDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	for _, signer := range ante.GetAuthenticatedSigners(ctx) {
+		if signer.Balances == nil {
+			continue
+		}
+
+		if err := sld.trackSpend(ctx, signer); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// trackSpend charges the coins spent by the signer to its authenticator.
+func (sld SpendLimitDecorator) trackSpend(ctx sdk.Context, signer ante.AuthenticatedSigner) error {
+	balances := sld.bankKeeper.GetAllBalances(ctx, signer.Address)
+
+	var spent sdk.Coins
+	for _, coin := range signer.Balances {
+		if diff := coin.Amount.Sub(balances.AmountOf(coin.Denom)); diff.IsPositive() {
+			spent = spent.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	if spent.IsZero() {
+		return nil
+	}
+
+	accAuthenticators, err := sld.authKeeper.GetAuthenticators(ctx, signer.Address)
+	if err != nil {
+		return err
+	}
+
+	for _, accAuthenticator := range accAuthenticators {
+		if accAuthenticator.Id != signer.AuthenticatorID {
+			continue
+		}
+
+		authenticator, err := accAuthenticator.GetAuthenticator()
+		if err != nil {
+			return err
+		}
+
+		tracker, ok := authenticator.(types.SpendTracker)
+		if !ok {
+			return nil
+		}
+
+		updated, err := tracker.TrackSpend(spent)
+		if err != nil {
+			return err
+		}
+
+		accAuthenticator, err = types.NewAccountAuthenticator(accAuthenticator.Id, updated)
+		if err != nil {
+			return err
+		}
+
+		return sld.authKeeper.SetAuthenticator(ctx, signer.Address, accAuthenticator)
+	}
+
+	// the authenticator was removed by the transaction itself
+	return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authenticator %d removed while tracking its spend limit", signer.AuthenticatorID)
+}
@@ -0,0 +1,108 @@
+package posthandler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type balanceKeeper struct {
+	balances *sdk.Coins
+}
+
+func (bk balanceKeeper) GetAllBalances(context.Context, sdk.AccAddress) sdk.Coins {
+	return *bk.balances
+}
+
+func TestSpendLimitDecorator(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeight(1)
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	ak := keeper.NewAccountKeeper(
+		encCfg.Codec, runtime.NewKVStoreService(key), types.ProtoBaseAccount, nil, authcodec.NewBech32Codec("cosmos"),
+		sdk.Bech32MainPrefix, types.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, ak.Params.Set(ctx, types.DefaultParams()))
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, addr))
+	acc := ak.GetAccount(ctx, addr)
+
+	sessionPriv := secp256k1.GenPrivKey()
+	sigAuthenticator, err := types.NewSignatureAuthenticator(sessionPriv.PubKey())
+	require.NoError(t, err)
+	session, err := types.NewThresholdAuthenticator(2, sigAuthenticator, types.NewSpendLimitAuthenticator(sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	require.NoError(t, err)
+	_, err = ak.AddAuthenticator(ctx, addr, session)
+	require.NoError(t, err)
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	bk := balanceKeeper{balances: &balances}
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewAuthenticatorDecorator(ak, ak, bk, encCfg.TxConfig.SignModeHandler(), nil))
+	postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{AuthenticatorKeeper: ak, BankKeeper: bk})
+	require.NoError(t, err)
+
+	// spend executes a transaction signed by the session key spending the
+	// given amount of stake
+	spend := func(amount int64) error {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+			PubKey: sessionPriv.PubKey(),
+			Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		}))
+		signerData := authsigning.SignerData{
+			Address:       addr.String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			PubKey:        sessionPriv.PubKey(),
+		}
+		sig, err := clienttx.SignWithPrivKey(ctx, signing.SignMode_SIGN_MODE_DIRECT, signerData, txBuilder, sessionPriv, encCfg.TxConfig, 0)
+		require.NoError(t, err)
+		require.NoError(t, txBuilder.SetSignatures(sig))
+		tx := txBuilder.GetTx()
+
+		newCtx, err := anteHandler(ctx, tx, false)
+		if err != nil {
+			return err
+		}
+
+		before := balances
+		balances = balances.Sub(sdk.NewInt64Coin("stake", amount))
+		if _, err = postHandler(newCtx, tx, false, true); err != nil {
+			// the failed transaction is reverted
+			balances = before
+		}
+		return err
+	}
+
+	require.NoError(t, spend(6))
+	require.ErrorIs(t, spend(5), sdkerrors.ErrUnauthorized)
+	require.NoError(t, spend(4))
+
+	// the exhausted limit no longer authenticates the session key
+	require.ErrorContains(t, spend(0), "exhausted")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), balances)
+}
@@ -1,15 +1,53 @@
 package posthandler
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	// AuthenticatorKeeper and BankKeeper enable the tracking of the coins spent
+	// by the signers authenticated by a spend limited authenticator. Both must
+	// be set when the ante handler uses account authenticators.
+	AuthenticatorKeeper AuthenticatorKeeper
+	BankKeeper          ante.BalanceKeeper
+
+	// GasRefund enables the refund of a fraction of the fees paid for the unused
+	// gas. The BankKeeper must then implement RefundBankKeeper.
+	GasRefund *GasRefundOptions
+}
 
-// NewPostHandler returns an empty PostHandler chain.
-func NewPostHandler(_ HandlerOptions) (sdk.PostHandler, error) {
+// NewPostHandler returns a PostHandler chain tracking the spend limits of the
+// account authenticators and refunding the fees paid for the unused gas when
+// enabled, and an empty chain otherwise.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
 	postDecorators := []sdk.PostDecorator{}
 
+	if options.AuthenticatorKeeper != nil {
+		if options.BankKeeper == nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required to track the authenticators spend limits")
+		}
+
+		postDecorators = append(postDecorators, NewSpendLimitDecorator(options.AuthenticatorKeeper, options.BankKeeper))
+	}
+
+	if options.GasRefund != nil {
+		if err := options.GasRefund.Validate(); err != nil {
+			return nil, err
+		}
+
+		refundKeeper, ok := options.BankKeeper.(RefundBankKeeper)
+		if !ok {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper must implement SendCoinsFromModuleToAccount to refund the unused gas")
+		}
+
+		// the refund must be last to account for the gas consumed by the other decorators
+		postDecorators = append(postDecorators, NewGasRefundDecorator(refundKeeper, *options.GasRefund))
+	}
+
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
@@ -0,0 +1,127 @@
+package posthandler
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// RefundBankKeeper defines the contract needed to refund the fees paid for the
+// unused gas from the fee recipient module.
+type RefundBankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// GasRefundOptions configure the refund of the fees paid for the gas left
+// unused by a transaction.
+type GasRefundOptions struct {
+	// RefundRatio is the fraction, in [0, 1], of the fees paid for the unused
+	// gas returned to the fee payers. Keeping it below 1 keeps a cost on the
+	// block gas reserved by an overestimated gas limit.
+	RefundRatio math.LegacyDec
+
+	// MaxRefundableGas caps the unused gas refunded per transaction, so that
+	// reserving most of the block gas remains expensive. Zero disables the cap.
+	MaxRefundableGas uint64
+}
+
+// Validate checks the refund ratio is within [0, 1].
+func (o GasRefundOptions) Validate() error {
+	if o.RefundRatio.IsNil() || o.RefundRatio.IsNegative() || o.RefundRatio.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "gas refund ratio must be within [0, 1]: %s", o.RefundRatio)
+	}
+	return nil
+}
+
+// GasRefundDecorator refunds a fraction of the fees paid for the gas left
+// unused by a successful transaction. The fees are refunded from the
+// FeeRecipientModule to the accounts they were deducted from, which are the fee
+// granters when they are set, in proportion of the fee each of them paid. The
+// allowances of the fee grants are not restored.
+//
+// The refund is computed from the gas consumed when the decorator runs, so it
+// should be the last post decorator of the chain. Refunding does not consume
+// gas, so that a transaction consuming all of its gas does not run out of gas
+// while being refunded.
+type GasRefundDecorator struct {
+	bankKeeper RefundBankKeeper
+	options    GasRefundOptions
+}
+
+func NewGasRefundDecorator(bankKeeper RefundBankKeeper, options GasRefundOptions) GasRefundDecorator {
+	return GasRefundDecorator{
+		bankKeeper: bankKeeper,
+		options:    options,
+	}
+}
+
+func (grd GasRefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// the fees are not refunded on failure as the post handler state changes
+	// are reverted along with the messages ones
+	if !success || grd.options.RefundRatio.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the gas limit of the transaction rather than the gas meter one, which is
+	// infinite in simulation and at genesis
+	gasLimit := feeTx.GetGas()
+	gasUsed := ctx.GasMeter().GasConsumed()
+	if gasLimit == 0 || gasUsed >= gasLimit {
+		return next(ctx, tx, simulate, success)
+	}
+
+	refundableGas := gasLimit - gasUsed
+	if grd.options.MaxRefundableGas > 0 && refundableGas > grd.options.MaxRefundableGas {
+		refundableGas = grd.options.MaxRefundableGas
+	}
+
+	// fraction of the fees refunded
+	fraction := grd.options.RefundRatio.
+		MulInt(math.NewIntFromUint64(refundableGas)).
+		QuoInt(math.NewIntFromUint64(gasLimit))
+
+	refundCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	events := sdk.Events{}
+	for _, fee := range ante.GetDeductedFees(ctx) {
+		refund := RefundedFee(fee.Amount, fraction)
+		if refund.IsZero() {
+			continue
+		}
+
+		if err := grd.bankKeeper.SendCoinsFromModuleToAccount(refundCtx, ante.FeeRecipientModule, fee.Payer, refund); err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to refund %s to %s", refund, fee.Payer)
+		}
+
+		events = append(events, sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFeeRefund, refund.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, fee.Payer.String()),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return next(ctx, tx, simulate, success)
+}
+
+// RefundedFee returns the fraction of the fee refunded, rounded down.
+func RefundedFee(fee sdk.Coins, fraction math.LegacyDec) sdk.Coins {
+	refund := sdk.NewCoins()
+	for _, coin := range fee {
+		amount := fraction.MulInt(coin.Amount).TruncateInt()
+		if amount.IsPositive() {
+			refund = refund.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return refund
+}
@@ -0,0 +1,47 @@
+package posthandler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+)
+
+func TestRefundedFee(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 3))
+
+	testCases := []struct {
+		name     string
+		fraction math.LegacyDec
+		expected sdk.Coins
+	}{
+		{"no refund", math.LegacyZeroDec(), sdk.NewCoins()},
+		{"full refund", math.LegacyOneDec(), fee},
+		{"rounded down", math.LegacyNewDecWithPrec(25, 2), sdk.NewCoins(sdk.NewInt64Coin("atom", 37))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, posthandler.RefundedFee(fee, tc.fraction))
+		})
+	}
+}
+
+func TestNewPostHandlerGasRefund(t *testing.T) {
+	balances := sdk.NewCoins()
+
+	_, err := posthandler.NewPostHandler(posthandler.HandlerOptions{
+		GasRefund: &posthandler.GasRefundOptions{RefundRatio: math.LegacyNewDecWithPrec(11, 1)},
+	})
+	require.ErrorContains(t, err, "gas refund ratio must be within [0, 1]")
+
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{
+		BankKeeper: balanceKeeper{balances: &balances},
+		GasRefund:  &posthandler.GasRefundOptions{RefundRatio: math.LegacyNewDecWithPrec(5, 1)},
+	})
+	require.ErrorContains(t, err, "bank keeper must implement SendCoinsFromModuleToAccount")
+}
@@ -0,0 +1,111 @@
+package signing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	txsigning "github.com/cosmos/cosmos-sdk/x/tx/signing"
+)
+
+// AggregateSigner is a BLS12-381 signer of a transaction whose signature is
+// part of the aggregate signature of the transaction.
+type AggregateSigner struct {
+	PubKey     *bls12_381.PubKey
+	SignerData txsigning.SignerData
+	SignMode   signing.SignMode
+}
+
+// IsAggregatedSignature returns true if the signature of a BLS12-381 signer is
+// aggregated in the signature of another signer of the transaction, in which
+// case it is empty.
+func IsAggregatedSignature(sig signing.SignatureV2) bool {
+	if _, ok := sig.PubKey.(*bls12_381.PubKey); !ok {
+		return false
+	}
+	data, ok := sig.Data.(*signing.SingleSignatureData)
+	return ok && len(data.Signature) == 0
+}
+
+// AggregateSignatures replaces the signatures of the BLS12-381 signers by their
+// aggregate signature: the first BLS12-381 signer carries the aggregate signature
+// and the signatures of the other ones are emptied. The other signatures are
+// left as is. It is a no-op when there are less than two BLS12-381 signers.
+func AggregateSignatures(sigs []signing.SignatureV2) ([]signing.SignatureV2, error) {
+	var (
+		indexes []int
+		blsSigs [][]byte
+	)
+	for i, sig := range sigs {
+		if _, ok := sig.PubKey.(*bls12_381.PubKey); !ok {
+			continue
+		}
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok {
+			continue
+		}
+		if len(data.Signature) == 0 {
+			return nil, fmt.Errorf("signature %d is empty", i)
+		}
+		indexes = append(indexes, i)
+		blsSigs = append(blsSigs, data.Signature)
+	}
+
+	if len(indexes) < 2 {
+		return sigs, nil
+	}
+
+	aggSig, err := bls12_381.AggregateSignatures(blsSigs)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregated := make([]signing.SignatureV2, len(sigs))
+	copy(aggregated, sigs)
+	for j, i := range indexes {
+		data := *aggregated[i].Data.(*signing.SingleSignatureData)
+		data.Signature = nil
+		if j == 0 {
+			data.Signature = aggSig
+		}
+		aggregated[i].Data = &data
+	}
+
+	return aggregated, nil
+}
+
+// VerifyAggregateSignature verifies the aggregate signature of the given
+// BLS12-381 signers with a single pairing check.
+func VerifyAggregateSignature(
+	ctx context.Context,
+	signers []AggregateSigner,
+	sig []byte,
+	handler *txsigning.HandlerMap,
+	txData txsigning.TxData,
+) error {
+	if len(signers) == 0 {
+		return errors.New("no aggregate signer")
+	}
+
+	pubKeys := make([]*bls12_381.PubKey, len(signers))
+	msgs := make([][]byte, len(signers))
+	for i, signer := range signers {
+		signMode, err := internalSignModeToAPI(signer.SignMode)
+		if err != nil {
+			return err
+		}
+		signBytes, err := handler.GetSignBytes(ctx, signMode, signer.SignerData, txData)
+		if err != nil {
+			return err
+		}
+		pubKeys[i] = signer.PubKey
+		msgs[i] = signBytes
+	}
+
+	if !bls12_381.VerifyAggregateSignature(pubKeys, msgs, sig) {
+		return errors.New("unable to verify aggregate signature")
+	}
+	return nil
+}
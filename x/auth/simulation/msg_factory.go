@@ -16,6 +16,7 @@ func MsgUpdateParamsFactory() simsx.SimMsgFactoryFn[*types.MsgUpdateParams] {
 		params.TxSizeCostPerByte = r.Uint64InRange(1, 1000)
 		params.SigVerifyCostED25519 = r.Uint64InRange(1, 1000)
 		params.SigVerifyCostSecp256k1 = r.Uint64InRange(1, 1000)
+		params.SigVerifyCostBls12381 = r.Uint64InRange(1, 10000)
 
 		return nil, &types.MsgUpdateParams{
 			Authority: testData.ModuleAccountAddress(reporter, "gov"),
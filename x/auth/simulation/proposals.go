@@ -41,6 +41,7 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 	params.TxSizeCostPerByte = uint64(simtypes.RandIntBetween(r, 1, 1000))
 	params.SigVerifyCostED25519 = uint64(simtypes.RandIntBetween(r, 1, 1000))
 	params.SigVerifyCostSecp256k1 = uint64(simtypes.RandIntBetween(r, 1, 1000))
+	params.SigVerifyCostBls12381 = uint64(simtypes.RandIntBetween(r, 1, 10000))
 
 	return &types.MsgUpdateParams{
 		Authority: authority.String(),
@@ -0,0 +1,469 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxAuthenticatorsPerAccount is the maximum number of authenticators an
+	// account can register.
+	MaxAuthenticatorsPerAccount = 16
+
+	// MaxAuthenticatorDepth is the maximum nesting depth of threshold
+	// authenticators.
+	MaxAuthenticatorDepth = 4
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = AccountAuthenticator{}
+	_ codectypes.UnpackInterfacesMessage = AccountAuthenticators{}
+	_ codectypes.UnpackInterfacesMessage = SignatureAuthenticator{}
+	_ codectypes.UnpackInterfacesMessage = ThresholdAuthenticator{}
+
+	_ Authenticator = &SignatureAuthenticator{}
+	_ Authenticator = &ThresholdAuthenticator{}
+	_ Authenticator = &MessageFilterAuthenticator{}
+	_ Authenticator = &SpendLimitAuthenticator{}
+	_ Authenticator = &TimeWindowAuthenticator{}
+
+	_ SpendTracker = &ThresholdAuthenticator{}
+	_ SpendTracker = &SpendLimitAuthenticator{}
+)
+
+// Authenticator defines an account authenticator. Authenticators registered on
+// an account replace the account public key for the verification of the
+// signatures of that account.
+type Authenticator interface {
+	proto.Message
+
+	// ValidateBasic performs a stateless validation of the authenticator.
+	ValidateBasic() error
+
+	// Authenticate returns an error if the authenticator rejects the request.
+	Authenticate(ctx context.Context, req AuthenticationRequest) error
+}
+
+// SpendTracker is implemented by authenticators that track the coins spent by
+// the transactions they authenticated.
+type SpendTracker interface {
+	Authenticator
+
+	// TracksSpend returns true if the authenticator must be notified of the
+	// coins spent by the transactions it authenticates.
+	TracksSpend() bool
+
+	// TrackSpend returns the authenticator updated with the coins spent by a
+	// transaction it authenticated, or an error if they exceed its limits.
+	TrackSpend(spent sdk.Coins) (Authenticator, error)
+}
+
+// AuthenticationRequest is the request an authenticator has to accept for a
+// signer of a transaction.
+type AuthenticationRequest struct {
+	// Account is the address of the signer.
+	Account sdk.AccAddress
+
+	// Msgs are the messages of the transaction.
+	Msgs []sdk.Msg
+
+	// VerifySignature verifies the signature of the signer against the given
+	// public key.
+	VerifySignature func(pubKey cryptotypes.PubKey) error
+}
+
+// NewAccountAuthenticator creates a new AccountAuthenticator instance.
+func NewAccountAuthenticator(id uint64, authenticator Authenticator) (AccountAuthenticator, error) {
+	anyAuthenticator, err := codectypes.NewAnyWithValue(authenticator)
+	if err != nil {
+		return AccountAuthenticator{}, err
+	}
+
+	return AccountAuthenticator{Id: id, Authenticator: anyAuthenticator}, nil
+}
+
+// GetAuthenticator returns the unpacked authenticator.
+func (a AccountAuthenticator) GetAuthenticator() (Authenticator, error) {
+	return unpackAuthenticator(a.Authenticator)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a AccountAuthenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var authenticator Authenticator
+	return unpacker.UnpackAny(a.Authenticator, &authenticator)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a AccountAuthenticators) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, authenticator := range a.Authenticators {
+		if err := authenticator.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateAuthenticator validates an authenticator to be registered on an
+// account. Besides its stateless validation, the authenticator must verify a
+// signature whatever the path through which it passes, so that restriction
+// authenticators cannot be used on their own to authenticate anyone.
+func ValidateAuthenticator(authenticator Authenticator) error {
+	if err := authenticator.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !verifiesSignature(authenticator) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "authenticator must verify a signature")
+	}
+
+	return nil
+}
+
+// verifiesSignature returns true if the authenticator cannot pass without
+// verifying a signature.
+func verifiesSignature(authenticator Authenticator) bool {
+	switch a := authenticator.(type) {
+	case *SignatureAuthenticator:
+		return true
+	case *ThresholdAuthenticator:
+		// the threshold must not be reachable through sub-authenticators
+		// that do not verify a signature
+		unverified := 0
+		for _, anyAuthenticator := range a.Authenticators {
+			sub, err := unpackAuthenticator(anyAuthenticator)
+			if err != nil || !verifiesSignature(sub) {
+				unverified++
+			}
+		}
+		return unverified < int(a.Threshold)
+	default:
+		return false
+	}
+}
+
+// unpackAuthenticator returns the authenticator cached in the given Any.
+func unpackAuthenticator(anyAuthenticator *codectypes.Any) (Authenticator, error) {
+	if anyAuthenticator == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidType, "authenticator cannot be empty")
+	}
+
+	authenticator, ok := anyAuthenticator.GetCachedValue().(Authenticator)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", (Authenticator)(nil), anyAuthenticator.GetCachedValue())
+	}
+
+	return authenticator, nil
+}
+
+// NewSignatureAuthenticator creates a new SignatureAuthenticator instance.
+func NewSignatureAuthenticator(pubKey cryptotypes.PubKey) (*SignatureAuthenticator, error) {
+	anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignatureAuthenticator{PubKey: anyPubKey}, nil
+}
+
+// GetPubKey returns the unpacked public key of the authenticator.
+func (a SignatureAuthenticator) GetPubKey() (cryptotypes.PubKey, error) {
+	if a.PubKey == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "public key cannot be empty")
+	}
+
+	pubKey, ok := a.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "expected %T, got %T", (cryptotypes.PubKey)(nil), a.PubKey.GetCachedValue())
+	}
+
+	return pubKey, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a SignatureAuthenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(a.PubKey, &pubKey)
+}
+
+// ValidateBasic implements Authenticator.
+func (a *SignatureAuthenticator) ValidateBasic() error {
+	_, err := a.GetPubKey()
+	return err
+}
+
+// Authenticate implements Authenticator.
+func (a *SignatureAuthenticator) Authenticate(_ context.Context, req AuthenticationRequest) error {
+	pubKey, err := a.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	return req.VerifySignature(pubKey)
+}
+
+// NewThresholdAuthenticator creates a new ThresholdAuthenticator instance.
+func NewThresholdAuthenticator(threshold uint32, authenticators ...Authenticator) (*ThresholdAuthenticator, error) {
+	anyAuthenticators := make([]*codectypes.Any, len(authenticators))
+	for i, authenticator := range authenticators {
+		anyAuthenticator, err := codectypes.NewAnyWithValue(authenticator)
+		if err != nil {
+			return nil, err
+		}
+		anyAuthenticators[i] = anyAuthenticator
+	}
+
+	return &ThresholdAuthenticator{Threshold: threshold, Authenticators: anyAuthenticators}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a ThresholdAuthenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, anyAuthenticator := range a.Authenticators {
+		var authenticator Authenticator
+		if err := unpacker.UnpackAny(anyAuthenticator, &authenticator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateBasic implements Authenticator.
+func (a *ThresholdAuthenticator) ValidateBasic() error {
+	return a.validate(1)
+}
+
+func (a *ThresholdAuthenticator) validate(depth int) error {
+	if depth > MaxAuthenticatorDepth {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "threshold authenticators cannot be nested more than %d times", MaxAuthenticatorDepth)
+	}
+
+	if len(a.Authenticators) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "threshold authenticator must have sub-authenticators")
+	}
+
+	if a.Threshold == 0 || int(a.Threshold) > len(a.Authenticators) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "threshold must be between 1 and %d, got %d", len(a.Authenticators), a.Threshold)
+	}
+
+	for i, anyAuthenticator := range a.Authenticators {
+		sub, err := unpackAuthenticator(anyAuthenticator)
+		if err != nil {
+			return err
+		}
+
+		if threshold, ok := sub.(*ThresholdAuthenticator); ok {
+			err = threshold.validate(depth + 1)
+		} else {
+			err = sub.ValidateBasic()
+		}
+		if err != nil {
+			return errorsmod.Wrapf(err, "invalid sub-authenticator %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Authenticate implements Authenticator.
+func (a *ThresholdAuthenticator) Authenticate(ctx context.Context, req AuthenticationRequest) error {
+	var (
+		passed int
+		errs   []error
+	)
+	for i, anyAuthenticator := range a.Authenticators {
+		sub, err := unpackAuthenticator(anyAuthenticator)
+		if err != nil {
+			return err
+		}
+
+		if err := sub.Authenticate(ctx, req); err != nil {
+			errs = append(errs, fmt.Errorf("sub-authenticator %d: %w", i, err))
+			continue
+		}
+
+		passed++
+		if passed >= int(a.Threshold) {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%d of %d sub-authenticators passed: %s", passed, a.Threshold, errors.Join(errs...))
+}
+
+// TracksSpend implements SpendTracker.
+func (a *ThresholdAuthenticator) TracksSpend() bool {
+	for _, anyAuthenticator := range a.Authenticators {
+		sub, err := unpackAuthenticator(anyAuthenticator)
+		if err != nil {
+			continue
+		}
+		if tracker, ok := sub.(SpendTracker); ok && tracker.TracksSpend() {
+			return true
+		}
+	}
+	return false
+}
+
+// TrackSpend implements SpendTracker. The spent coins are tracked by all the
+// sub-authenticators tracking spending, whether or not they passed.
+func (a *ThresholdAuthenticator) TrackSpend(spent sdk.Coins) (Authenticator, error) {
+	updated := &ThresholdAuthenticator{
+		Threshold:      a.Threshold,
+		Authenticators: make([]*codectypes.Any, len(a.Authenticators)),
+	}
+	for i, anyAuthenticator := range a.Authenticators {
+		updated.Authenticators[i] = anyAuthenticator
+
+		sub, err := unpackAuthenticator(anyAuthenticator)
+		if err != nil {
+			return nil, err
+		}
+
+		tracker, ok := sub.(SpendTracker)
+		if !ok || !tracker.TracksSpend() {
+			continue
+		}
+
+		sub, err = tracker.TrackSpend(spent)
+		if err != nil {
+			return nil, err
+		}
+
+		if updated.Authenticators[i], err = codectypes.NewAnyWithValue(sub); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+// NewMessageFilterAuthenticator creates a new MessageFilterAuthenticator instance.
+func NewMessageFilterAuthenticator(msgTypeURLs ...string) *MessageFilterAuthenticator {
+	return &MessageFilterAuthenticator{MsgTypeUrls: msgTypeURLs}
+}
+
+// ValidateBasic implements Authenticator.
+func (a *MessageFilterAuthenticator) ValidateBasic() error {
+	if len(a.MsgTypeUrls) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message filter authenticator must allow at least one message type")
+	}
+
+	seen := make(map[string]struct{}, len(a.MsgTypeUrls))
+	for _, typeURL := range a.MsgTypeUrls {
+		if typeURL == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message type URL cannot be empty")
+		}
+		if _, ok := seen[typeURL]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate message type URL %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+
+	return nil
+}
+
+// Authenticate implements Authenticator.
+func (a *MessageFilterAuthenticator) Authenticate(_ context.Context, req AuthenticationRequest) error {
+	for _, msg := range req.Msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		allowed := false
+		for _, allowedTypeURL := range a.MsgTypeUrls {
+			if typeURL == allowedTypeURL {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "message type %s is not allowed", typeURL)
+		}
+	}
+
+	return nil
+}
+
+// NewSpendLimitAuthenticator creates a new SpendLimitAuthenticator instance.
+func NewSpendLimitAuthenticator(limit sdk.Coins) *SpendLimitAuthenticator {
+	return &SpendLimitAuthenticator{Limit: limit}
+}
+
+// ValidateBasic implements Authenticator.
+func (a *SpendLimitAuthenticator) ValidateBasic() error {
+	if a.Limit.Empty() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "spend limit cannot be empty")
+	}
+	if err := a.Limit.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if err := a.Spent.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return nil
+}
+
+// Authenticate implements Authenticator.
+func (a *SpendLimitAuthenticator) Authenticate(_ context.Context, _ AuthenticationRequest) error {
+	if a.Spent.IsAnyGTE(a.Limit) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "spend limit %s exhausted", a.Limit)
+	}
+
+	return nil
+}
+
+// TracksSpend implements SpendTracker.
+func (a *SpendLimitAuthenticator) TracksSpend() bool {
+	return true
+}
+
+// TrackSpend implements SpendTracker.
+func (a *SpendLimitAuthenticator) TrackSpend(spent sdk.Coins) (Authenticator, error) {
+	total := a.Spent.Add(spent...)
+	if !total.IsAllLTE(a.Limit) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "spending %s exceeds the spend limit %s, already spent %s", spent, a.Limit, a.Spent)
+	}
+
+	return &SpendLimitAuthenticator{Limit: a.Limit, Spent: total}, nil
+}
+
+// ValidateBasic implements Authenticator.
+func (a *TimeWindowAuthenticator) ValidateBasic() error {
+	if a.NotBefore.IsZero() && a.NotAfter.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "time window authenticator must have at least one bound")
+	}
+
+	if !a.NotBefore.IsZero() && !a.NotAfter.IsZero() && !a.NotBefore.Before(a.NotAfter) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "not before %s must be before not after %s", a.NotBefore, a.NotAfter)
+	}
+
+	return nil
+}
+
+// Authenticate implements Authenticator.
+func (a *TimeWindowAuthenticator) Authenticate(ctx context.Context, _ AuthenticationRequest) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	if !a.NotBefore.IsZero() && blockTime.Before(a.NotBefore) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authenticator is not valid before %s", a.NotBefore)
+	}
+
+	if !a.NotAfter.IsZero() && !blockTime.Before(a.NotAfter) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authenticator expired at %s", a.NotAfter)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgAddAuthenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var authenticator Authenticator
+	return unpacker.UnpackAny(msg.Authenticator, &authenticator)
+}
@@ -0,0 +1,1681 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/auth/v1beta1/authenticator.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountAuthenticator is an authenticator registered on an account. When an
+// account has authenticators, the ante handler dispatches the verification of
+// its signatures to them instead of the account public key.
+type AccountAuthenticator struct {
+	// id is the unique identifier of the authenticator.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// authenticator is the authenticator configuration.
+	Authenticator *any.Any `protobuf:"bytes,2,opt,name=authenticator,proto3" json:"authenticator,omitempty"`
+}
+
+func (m *AccountAuthenticator) Reset()         { *m = AccountAuthenticator{} }
+func (m *AccountAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*AccountAuthenticator) ProtoMessage()    {}
+func (*AccountAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b556032ae2fb178, []int{0}
+}
+func (m *AccountAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAuthenticator.Merge(m, src)
+}
+func (m *AccountAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAuthenticator proto.InternalMessageInfo
+
+// AccountAuthenticators defines the authenticators registered on an account.
+type AccountAuthenticators struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// authenticators are the authenticators of the account.
+	Authenticators []AccountAuthenticator `protobuf:"bytes,2,rep,name=authenticators,proto3" json:"authenticators"`
+}
+
+func (m *AccountAuthenticators) Reset()         { *m = AccountAuthenticators{} }
+func (m *AccountAuthenticators) String() string { return proto.CompactTextString(m) }
+func (*AccountAuthenticators) ProtoMessage()    {}
+func (*AccountAuthenticators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b556032ae2fb178, []int{1}
+}
+func (m *AccountAuthenticators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAuthenticators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAuthenticators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAuthenticators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAuthenticators.Merge(m, src)
+}
+func (m *AccountAuthenticators) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAuthenticators) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAuthenticators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAuthenticators proto.InternalMessageInfo
+
+func (m *AccountAuthenticators) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountAuthenticators) GetAuthenticators() []AccountAuthenticator {
+	if m != nil {
+		return m.Authenticators
+	}
+	return nil
+}
+
+// SignatureAuthenticator authenticates signatures made by the given public key.
+type SignatureAuthenticator struct {
+	// pub_key is the public key the signatures must be verified against.
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *SignatureAuthenticator) Reset()         { *m = SignatureAuthenticator{} }
+func (m *SignatureAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*SignatureAuthenticator) ProtoMessage()    {}
+func (*SignatureAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b556032ae2fb178, []int{2}
+}
+func (m *SignatureAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignatureAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignatureAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignatureAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureAuthenticator.Merge(m, src)
+}
+func (m *SignatureAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignatureAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureAuthenticator proto.InternalMessageInfo
+
+// ThresholdAuthenticator authenticates a signature when at least threshold of
+// its sub-authenticators do. A threshold equal to the number of
+// sub-authenticators requires all of them to pass.
+type ThresholdAuthenticator struct {
+	// threshold is the number of sub-authenticators that must pass.
+	Threshold uint32 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// authenticators are the sub-authenticators.
+	Authenticators []*any.Any `protobuf:"bytes,2,rep,name=authenticators,proto3" json:"authenticators,omitempty"`
+}
+
+func (m *ThresholdAuthenticator) Reset()         { *m = ThresholdAuthenticator{} }
+func (m *ThresholdAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*ThresholdAuthenticator) ProtoMessage()    {}
+func (*ThresholdAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b556032ae2fb178, []int{3}
+}
+func (m *ThresholdAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThresholdAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThresholdAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdAuthenticator.Merge(m, src)
+}
+func (m *ThresholdAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThresholdAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdAuthenticator proto.InternalMessageInfo
+
+func (m *ThresholdAuthenticator) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ThresholdAuthenticator) GetAuthenticators() []*any.Any {
+	if m != nil {
+		return m.Authenticators
+	}
+	return nil
+}
+
+// MessageFilterAuthenticator passes only for transactions whose messages all
+// have one of the allowed type URLs. It does not verify any signature and is
+// meant to be combined with a SignatureAuthenticator.
+type MessageFilterAuthenticator struct {
+	// msg_type_urls are the allowed message type URLs.
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (m *MessageFilterAuthenticator) Reset()         { *m = MessageFilterAuthenticator{} }
+func (m *MessageFilterAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*MessageFilterAuthenticator) ProtoMessage()    {}
+func (*MessageFilterAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b556032ae2fb178, []int{4}
+}
+func (m *MessageFilterAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageFilterAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageFilterAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageFilterAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageFilterAuthenticator.Merge(m, src)
+}
+func (m *MessageFilterAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageFilterAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageFilterAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageFilterAuthenticator proto.InternalMessageInfo
+
+func (m *MessageFilterAuthenticator) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+// SpendLimitAuthenticator passes as long as the coins spent by the account
+// through the transactions it authenticated, fees excluded, stay within the
+// limit. It does not verify any signature and is meant to be combined with a
+// SignatureAuthenticator.
+type SpendLimitAuthenticator struct {
+	// limit is the maximum amount of coins that can be spent.
+	Limit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=limit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"limit"`
+	// spent is the amount of coins already spent.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *SpendLimitAuthenticator) Reset()         { *m = SpendLimitAuthenticator{} }
+func (m *SpendLimitAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*SpendLimitAuthenticator) ProtoMessage()    {}
+func (*SpendLimitAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b556032ae2fb178, []int{5}
+}
+func (m *SpendLimitAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendLimitAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendLimitAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendLimitAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendLimitAuthenticator.Merge(m, src)
+}
+func (m *SpendLimitAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendLimitAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendLimitAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendLimitAuthenticator proto.InternalMessageInfo
+
+func (m *SpendLimitAuthenticator) GetLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *SpendLimitAuthenticator) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+// TimeWindowAuthenticator passes only for transactions included in a block
+// whose time is within [not_before, not_after). A zero bound is open. It does
+// not verify any signature and is meant to be combined with a
+// SignatureAuthenticator.
+type TimeWindowAuthenticator struct {
+	// not_before is the time from which the authenticator passes.
+	NotBefore time.Time `protobuf:"bytes,1,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before"`
+	// not_after is the time from which the authenticator no longer passes.
+	NotAfter time.Time `protobuf:"bytes,2,opt,name=not_after,json=notAfter,proto3,stdtime" json:"not_after"`
+}
+
+func (m *TimeWindowAuthenticator) Reset()         { *m = TimeWindowAuthenticator{} }
+func (m *TimeWindowAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*TimeWindowAuthenticator) ProtoMessage()    {}
+func (*TimeWindowAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b556032ae2fb178, []int{6}
+}
+func (m *TimeWindowAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeWindowAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeWindowAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeWindowAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeWindowAuthenticator.Merge(m, src)
+}
+func (m *TimeWindowAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeWindowAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeWindowAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeWindowAuthenticator proto.InternalMessageInfo
+
+func (m *TimeWindowAuthenticator) GetNotBefore() time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return time.Time{}
+}
+
+func (m *TimeWindowAuthenticator) GetNotAfter() time.Time {
+	if m != nil {
+		return m.NotAfter
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*AccountAuthenticator)(nil), "cosmos.auth.v1beta1.AccountAuthenticator")
+	proto.RegisterType((*AccountAuthenticators)(nil), "cosmos.auth.v1beta1.AccountAuthenticators")
+	proto.RegisterType((*SignatureAuthenticator)(nil), "cosmos.auth.v1beta1.SignatureAuthenticator")
+	proto.RegisterType((*ThresholdAuthenticator)(nil), "cosmos.auth.v1beta1.ThresholdAuthenticator")
+	proto.RegisterType((*MessageFilterAuthenticator)(nil), "cosmos.auth.v1beta1.MessageFilterAuthenticator")
+	proto.RegisterType((*SpendLimitAuthenticator)(nil), "cosmos.auth.v1beta1.SpendLimitAuthenticator")
+	proto.RegisterType((*TimeWindowAuthenticator)(nil), "cosmos.auth.v1beta1.TimeWindowAuthenticator")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/auth/v1beta1/authenticator.proto", fileDescriptor_2b556032ae2fb178)
+}
+
+var fileDescriptor_2b556032ae2fb178 = []byte{
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0x94, 0xef, 0x17, 0xe8, 0x54, 0x30, 0x96, 0x0a, 0xa5, 0x31, 0x6d, 0xd9, 0x84, 0x58,
+	0x48, 0xba, 0x2b, 0x55, 0x2f, 0xbd, 0xb5, 0x24, 0x78, 0x50, 0x13, 0xb3, 0xd4, 0x98, 0x18, 0xe3,
+	0x66, 0x7f, 0x0c, 0xdb, 0x09, 0xdd, 0x99, 0xcd, 0xce, 0xac, 0xb8, 0x57, 0x4f, 0xc6, 0x13, 0x67,
+	0x4f, 0xc6, 0x78, 0x30, 0x9e, 0x38, 0x70, 0xf6, 0x66, 0x42, 0x38, 0x11, 0x4e, 0x9e, 0xc4, 0xc0,
+	0x81, 0x8b, 0xff, 0x81, 0x17, 0xb3, 0xbf, 0xa0, 0x5b, 0x17, 0x42, 0x02, 0xf1, 0xd2, 0x76, 0xe6,
+	0xcd, 0x9b, 0xcf, 0x8f, 0x79, 0xef, 0x15, 0xde, 0xd6, 0x29, 0xb3, 0x28, 0x93, 0x54, 0x97, 0xf7,
+	0xa4, 0x57, 0x4b, 0x1a, 0xe2, 0xea, 0x52, 0xb0, 0x40, 0x84, 0x63, 0x5d, 0xe5, 0xd4, 0x11, 0x6d,
+	0x87, 0x72, 0x5a, 0x98, 0x0a, 0x0f, 0x8a, 0x7e, 0x4c, 0x8c, 0x0e, 0x96, 0x8b, 0x26, 0x35, 0x69,
+	0x10, 0x97, 0xfc, 0x5f, 0xe1, 0xd1, 0xf2, 0xac, 0x49, 0xa9, 0xd9, 0x47, 0x52, 0xb0, 0xd2, 0xdc,
+	0x35, 0x49, 0x25, 0x5e, 0x14, 0xaa, 0x0e, 0x87, 0x38, 0xb6, 0x10, 0xe3, 0xaa, 0x65, 0xc7, 0xb9,
+	0x21, 0x8c, 0x12, 0x5e, 0x1a, 0x61, 0x86, 0xa1, 0x4a, 0x44, 0x55, 0x53, 0x19, 0x3a, 0xa1, 0xaa,
+	0x53, 0x4c, 0xa2, 0xf8, 0x0d, 0xd5, 0xc2, 0x84, 0x4a, 0xc1, 0x67, 0xb8, 0x25, 0x7c, 0x04, 0xb0,
+	0xd8, 0xd6, 0x75, 0xea, 0x12, 0xde, 0x1e, 0xd4, 0x54, 0x98, 0x84, 0x59, 0x6c, 0x94, 0x40, 0x0d,
+	0xd4, 0xff, 0x93, 0xb3, 0xd8, 0x28, 0xa8, 0x70, 0x22, 0x21, 0xba, 0x94, 0xad, 0x81, 0x7a, 0xbe,
+	0x59, 0x14, 0x43, 0xbe, 0x62, 0xcc, 0x57, 0x6c, 0x13, 0xaf, 0x33, 0xbf, 0xbb, 0xdd, 0x98, 0x4b,
+	0xb1, 0x43, 0x4c, 0x60, 0xc8, 0xc9, 0x1b, 0x5b, 0x33, 0x6f, 0x3f, 0x54, 0x33, 0xfb, 0xdb, 0x8d,
+	0xeb, 0x61, 0x66, 0x83, 0x19, 0xeb, 0xb5, 0x3b, 0xe2, 0xfd, 0x7b, 0xc2, 0x57, 0x00, 0x6f, 0xa6,
+	0x91, 0x64, 0x85, 0x26, 0x1c, 0x53, 0x0d, 0xc3, 0x41, 0x8c, 0x05, 0x54, 0x73, 0x9d, 0xd2, 0xfe,
+	0x76, 0xa3, 0x18, 0x21, 0xb7, 0xc3, 0xc8, 0x2a, 0x77, 0x30, 0x31, 0xe5, 0xf8, 0x60, 0xe1, 0x05,
+	0x9c, 0x4c, 0xe0, 0xb2, 0x52, 0xb6, 0x36, 0x52, 0xcf, 0x37, 0x17, 0xc4, 0x54, 0xc6, 0x29, 0xb8,
+	0x9d, 0xdc, 0xce, 0x8f, 0x6a, 0xe6, 0xf3, 0xf1, 0xd6, 0x22, 0x90, 0x87, 0xee, 0x6a, 0x4d, 0xa5,
+	0x09, 0xf8, 0x06, 0xe0, 0xf4, 0x2a, 0x36, 0x89, 0xca, 0x5d, 0x07, 0x25, 0x7d, 0x7e, 0x00, 0xc7,
+	0x6c, 0x57, 0x53, 0xd6, 0x91, 0x57, 0x02, 0xe7, 0x38, 0x5a, 0xda, 0x3d, 0xd5, 0xa5, 0x3b, 0x9e,
+	0xcd, 0xa9, 0xf8, 0xc4, 0xd5, 0x1e, 0x22, 0x4f, 0x1e, 0xb5, 0x83, 0xef, 0x96, 0xe6, 0xbb, 0x77,
+	0x21, 0xdf, 0x53, 0x18, 0xbe, 0x3b, 0xde, 0x5a, 0x9c, 0x3b, 0xdd, 0x93, 0xd2, 0xc9, 0x0a, 0xbf,
+	0x01, 0x9c, 0xee, 0xf6, 0x1c, 0xc4, 0x7a, 0xb4, 0x6f, 0x24, 0x75, 0xdc, 0x82, 0x39, 0x1e, 0x47,
+	0x02, 0x25, 0x13, 0xf2, 0xe9, 0x46, 0x41, 0x3f, 0xc3, 0xf3, 0x4b, 0x95, 0xcf, 0xb0, 0xf5, 0x2f,
+	0xaf, 0x4a, 0x7d, 0xba, 0x44, 0xe1, 0x13, 0x80, 0xe5, 0xc7, 0x88, 0x31, 0xd5, 0x44, 0x2b, 0xb8,
+	0xcf, 0x91, 0x93, 0x74, 0x40, 0x80, 0x13, 0x16, 0x33, 0x15, 0xee, 0xd9, 0x48, 0x71, 0x9d, 0xbe,
+	0x5f, 0x91, 0x23, 0xf5, 0x9c, 0x9c, 0xb7, 0x98, 0xd9, 0xf5, 0x6c, 0xf4, 0xd4, 0xe9, 0xb3, 0x96,
+	0x76, 0x19, 0x8a, 0xf3, 0x03, 0x14, 0xcf, 0xe6, 0x21, 0xfc, 0xca, 0xc2, 0x99, 0x55, 0x1b, 0x11,
+	0xe3, 0x11, 0xb6, 0xf0, 0x50, 0x57, 0x6f, 0xc0, 0xff, 0xfb, 0xfe, 0x6e, 0xc0, 0x2d, 0xdf, 0x9c,
+	0x8d, 0x4b, 0xde, 0x9f, 0x18, 0x27, 0x5c, 0x96, 0x29, 0x26, 0x9d, 0x15, 0xbf, 0xc4, 0xbf, 0x1c,
+	0x54, 0xeb, 0x26, 0xe6, 0x3d, 0x57, 0x13, 0x75, 0x6a, 0x45, 0xc3, 0x46, 0x1a, 0xa0, 0xe1, 0x8b,
+	0x65, 0x41, 0x02, 0x7b, 0x7f, 0xbc, 0xb5, 0x78, 0xad, 0x8f, 0x4c, 0x55, 0xf7, 0x14, 0x7f, 0xe6,
+	0xb0, 0xb0, 0x3f, 0x42, 0x3c, 0x1f, 0x98, 0xd9, 0x88, 0xf0, 0x52, 0xf6, 0x9f, 0x01, 0x07, 0x78,
+	0x2d, 0xe5, 0x32, 0x8e, 0x0b, 0x83, 0x2d, 0x91, 0x6e, 0xa9, 0xf0, 0x26, 0x0b, 0x67, 0xba, 0xd8,
+	0x42, 0xcf, 0x30, 0x31, 0xe8, 0x46, 0xd2, 0xee, 0x65, 0x08, 0x09, 0xe5, 0x8a, 0x86, 0xd6, 0xa8,
+	0x83, 0xa2, 0xfe, 0x2e, 0xff, 0x55, 0xf2, 0xdd, 0x78, 0xc2, 0x77, 0xc6, 0x7d, 0xed, 0x9b, 0x07,
+	0x55, 0x20, 0xe7, 0x08, 0xe5, 0x9d, 0x20, 0xad, 0xd0, 0x86, 0xfe, 0x42, 0x51, 0xd7, 0x38, 0x8a,
+	0xa7, 0xee, 0xc5, 0xee, 0x18, 0x27, 0x94, 0xb7, 0xfd, 0xac, 0xab, 0x33, 0xe1, 0x0c, 0xa1, 0x9d,
+	0xe5, 0x9d, 0xc3, 0x0a, 0xd8, 0x3b, 0xac, 0x80, 0x9f, 0x87, 0x15, 0xb0, 0x79, 0x54, 0xc9, 0xec,
+	0x1d, 0x55, 0x32, 0xdf, 0x8f, 0x2a, 0x99, 0xe7, 0x0b, 0xe7, 0x3e, 0xe3, 0xeb, 0xf0, 0x6f, 0x35,
+	0x78, 0x4d, 0x6d, 0x34, 0x50, 0x73, 0xf7, 0xcf, 0x00, 0xe5, 0xd6, 0xce, 0x2e, 0x72, 0x07, 0x00,
+	0x00,
+}
+
+func (m *AccountAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Authenticator != nil {
+		{
+			size, err := m.Authenticator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthenticator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuthenticator(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountAuthenticators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAuthenticators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAuthenticators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for iNdEx := len(m.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthenticator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignatureAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignatureAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignatureAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthenticator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThresholdAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for iNdEx := len(m.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthenticator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintAuthenticator(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageFilterAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageFilterAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageFilterAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendLimitAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendLimitAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendLimitAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthenticator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Limit) > 0 {
+		for iNdEx := len(m.Limit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthenticator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeWindowAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeWindowAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeWindowAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NotAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NotAfter):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuthenticator(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NotBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NotBefore):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuthenticator(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthenticator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthenticator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuthenticator(uint64(m.Id))
+	}
+	if m.Authenticator != nil {
+		l = m.Authenticator.Size()
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountAuthenticators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	if len(m.Authenticators) > 0 {
+		for _, e := range m.Authenticators {
+			l = e.Size()
+			n += 1 + l + sovAuthenticator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignatureAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	return n
+}
+
+func (m *ThresholdAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovAuthenticator(uint64(m.Threshold))
+	}
+	if len(m.Authenticators) > 0 {
+		for _, e := range m.Authenticators {
+			l = e.Size()
+			n += 1 + l + sovAuthenticator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageFilterAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovAuthenticator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpendLimitAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Limit) > 0 {
+		for _, e := range m.Limit {
+			l = e.Size()
+			n += 1 + l + sovAuthenticator(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovAuthenticator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TimeWindowAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NotBefore)
+	n += 1 + l + sovAuthenticator(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NotAfter)
+	n += 1 + l + sovAuthenticator(uint64(l))
+	return n
+}
+
+func sovAuthenticator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthenticator(x uint64) (n int) {
+	return sovAuthenticator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccountAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authenticator == nil {
+				m.Authenticator = &any.Any{}
+			}
+			if err := m.Authenticator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountAuthenticators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountAuthenticators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountAuthenticators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticators = append(m.Authenticators, AccountAuthenticator{})
+			if err := m.Authenticators[len(m.Authenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignatureAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticators = append(m.Authenticators, &any.Any{})
+			if err := m.Authenticators[len(m.Authenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageFilterAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageFilterAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageFilterAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendLimitAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendLimitAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendLimitAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limit = append(m.Limit, types.Coin{})
+			if err := m.Limit[len(m.Limit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeWindowAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeWindowAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeWindowAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NotAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthenticator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthenticator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthenticator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthenticator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthenticator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthenticator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthenticator = fmt.Errorf("proto: unexpected end of group")
+)
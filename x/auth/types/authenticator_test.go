@@ -0,0 +1,148 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestValidateAuthenticator(t *testing.T) {
+	sigAuthenticator, err := types.NewSignatureAuthenticator(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	filter := types.NewMessageFilterAuthenticator("/testpb.TestMsg")
+	window := &types.TimeWindowAuthenticator{NotAfter: time.Unix(100, 0)}
+
+	newThreshold := func(threshold uint32, authenticators ...types.Authenticator) types.Authenticator {
+		authenticator, err := types.NewThresholdAuthenticator(threshold, authenticators...)
+		require.NoError(t, err)
+		return authenticator
+	}
+
+	var deep types.Authenticator = sigAuthenticator
+	for range types.MaxAuthenticatorDepth + 1 {
+		deep = newThreshold(1, deep)
+	}
+
+	testCases := []struct {
+		name          string
+		authenticator types.Authenticator
+		expErr        string
+	}{
+		{"signature", sigAuthenticator, ""},
+		{"empty public key", &types.SignatureAuthenticator{}, "public key cannot be empty"},
+		{"session key", newThreshold(3, sigAuthenticator, filter, window), ""},
+		{"restriction only", filter, "must verify a signature"},
+		{"threshold reachable without signature", newThreshold(2, sigAuthenticator, filter, window), "must verify a signature"},
+		{"zero threshold", newThreshold(0, sigAuthenticator), "threshold must be between"},
+		{"threshold too high", newThreshold(2, sigAuthenticator), "threshold must be between"},
+		{"no sub-authenticators", newThreshold(1), "must have sub-authenticators"},
+		{"too deep", deep, "cannot be nested"},
+		{"empty message filter", newThreshold(2, sigAuthenticator, types.NewMessageFilterAuthenticator()), "at least one message type"},
+		{"duplicate message filter", newThreshold(2, sigAuthenticator, types.NewMessageFilterAuthenticator("/a", "/a")), "duplicate message type"},
+		{"empty spend limit", newThreshold(2, sigAuthenticator, types.NewSpendLimitAuthenticator(nil)), "spend limit cannot be empty"},
+		{"unbounded time window", newThreshold(2, sigAuthenticator, &types.TimeWindowAuthenticator{}), "at least one bound"},
+		{"inverted time window", newThreshold(2, sigAuthenticator, &types.TimeWindowAuthenticator{NotBefore: time.Unix(2, 0), NotAfter: time.Unix(1, 0)}), "must be before"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateAuthenticator(tc.authenticator)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	sigAuthenticator, err := types.NewSignatureAuthenticator(priv.PubKey())
+	require.NoError(t, err)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr)
+	session, err := types.NewThresholdAuthenticator(3,
+		sigAuthenticator,
+		types.NewMessageFilterAuthenticator(sdk.MsgTypeURL(msg)),
+		&types.TimeWindowAuthenticator{NotBefore: time.Unix(10, 0), NotAfter: time.Unix(20, 0)},
+	)
+	require.NoError(t, err)
+
+	signedBy := func(pubKey cryptotypes.PubKey) func(cryptotypes.PubKey) error {
+		return func(expected cryptotypes.PubKey) error {
+			if !expected.Equals(pubKey) {
+				return errors.New("invalid signature")
+			}
+			return nil
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		blockTime int64
+		msgs      []sdk.Msg
+		signer    cryptotypes.PubKey
+		expErr    string
+	}{
+		{"valid", 15, []sdk.Msg{msg}, priv.PubKey(), ""},
+		{"wrong key", 15, []sdk.Msg{msg}, secp256k1.GenPrivKey().PubKey(), "invalid signature"},
+		{"message not allowed", 15, []sdk.Msg{msg, &types.MsgAddAuthenticator{}}, priv.PubKey(), "is not allowed"},
+		{"not yet valid", 5, []sdk.Msg{msg}, priv.PubKey(), "not valid before"},
+		{"expired", 20, []sdk.Msg{msg}, priv.PubKey(), "expired"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockTime(time.Unix(tc.blockTime, 0))
+			err := session.Authenticate(ctx, types.AuthenticationRequest{
+				Account:         addr,
+				Msgs:            tc.msgs,
+				VerifySignature: signedBy(tc.signer),
+			})
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestTrackSpend(t *testing.T) {
+	sigAuthenticator, err := types.NewSignatureAuthenticator(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	session, err := types.NewThresholdAuthenticator(2, sigAuthenticator, types.NewSpendLimitAuthenticator(sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	require.NoError(t, err)
+	require.True(t, session.TracksSpend())
+
+	updated, err := session.TrackSpend(sdk.NewCoins(sdk.NewInt64Coin("stake", 6)))
+	require.NoError(t, err)
+
+	// other denominations than the limited ones cannot be spent
+	_, err = updated.(types.SpendTracker).TrackSpend(sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = updated.(types.SpendTracker).TrackSpend(sdk.NewCoins(sdk.NewInt64Coin("stake", 5)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	updated, err = updated.(types.SpendTracker).TrackSpend(sdk.NewCoins(sdk.NewInt64Coin("stake", 4)))
+	require.NoError(t, err)
+
+	// the exhausted limit no longer authenticates
+	err = updated.Authenticate(sdk.Context{}, types.AuthenticationRequest{
+		VerifySignature: func(cryptotypes.PubKey) error { return nil },
+	})
+	require.ErrorContains(t, err, "exhausted")
+}
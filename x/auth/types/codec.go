@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
@@ -19,8 +20,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ModuleAccount{}, "cosmos-sdk/ModuleAccount", nil)
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/auth/Params", nil)
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential", nil)
+	cdc.RegisterInterface((*Authenticator)(nil), nil)
+	cdc.RegisterConcrete(&SignatureAuthenticator{}, "cosmos-sdk/SignatureAuthenticator", nil)
+	cdc.RegisterConcrete(&ThresholdAuthenticator{}, "cosmos-sdk/ThresholdAuthenticator", nil)
+	cdc.RegisterConcrete(&MessageFilterAuthenticator{}, "cosmos-sdk/MessageFilterAuthenticator", nil)
+	cdc.RegisterConcrete(&SpendLimitAuthenticator{}, "cosmos-sdk/SpendLimitAuthenticator", nil)
+	cdc.RegisterConcrete(&TimeWindowAuthenticator{}, "cosmos-sdk/TimeWindowAuthenticator", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgAddAuthenticator{}, "cosmos-sdk/MsgAddAuthenticator")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveAuthenticator{}, "cosmos-sdk/MsgRemoveAuthenticator")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -55,7 +64,24 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ModuleCredential{},
 	)
 
+	registry.RegisterInterface(
+		"cosmos.auth.v1beta1.Authenticator",
+		(*Authenticator)(nil),
+		&SignatureAuthenticator{},
+		&ThresholdAuthenticator{},
+		&MessageFilterAuthenticator{},
+		&SpendLimitAuthenticator{},
+		&TimeWindowAuthenticator{},
+	)
+
+	registry.RegisterImplementations(
+		(*tx.TxExtensionOptionI)(nil),
+		&ExtensionOptionFeeShares{},
+	)
+
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgAddAuthenticator{},
+		&MsgRemoveAuthenticator{},
 	)
 }
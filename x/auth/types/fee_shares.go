@@ -0,0 +1,61 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewExtensionOptionFeeShares creates a new ExtensionOptionFeeShares instance.
+func NewExtensionOptionFeeShares(shares ...FeeShare) *ExtensionOptionFeeShares {
+	return &ExtensionOptionFeeShares{Shares: shares}
+}
+
+// NewFeeShare creates a new FeeShare instance. The granter is optional.
+func NewFeeShare(payer string, amount sdk.Coins, granter string) FeeShare {
+	return FeeShare{
+		Payer:   payer,
+		Amount:  amount,
+		Granter: granter,
+	}
+}
+
+// Validate checks the payers and granters are valid addresses, that each payer
+// covers a single positive share and that the shares sum up to the fee.
+func (e ExtensionOptionFeeShares) Validate(addressCodec address.Codec, fee sdk.Coins) error {
+	if len(e.Shares) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee shares cannot be empty")
+	}
+
+	total := sdk.NewCoins()
+	payers := make(map[string]bool, len(e.Shares))
+	for _, share := range e.Shares {
+		payer, err := addressCodec.StringToBytes(share.Payer)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fee share payer %s: %s", share.Payer, err)
+		}
+		if payers[string(payer)] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate fee share payer %s", share.Payer)
+		}
+		payers[string(payer)] = true
+
+		if share.Granter != "" {
+			if _, err := addressCodec.StringToBytes(share.Granter); err != nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fee share granter %s: %s", share.Granter, err)
+			}
+		}
+
+		if !share.Amount.IsValid() || share.Amount.IsZero() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid fee share of %s: %s", share.Payer, share.Amount)
+		}
+		total = total.Add(share.Amount...)
+	}
+
+	if !total.Equal(fee) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "fee shares %s do not sum up to the fee %s", total, fee)
+	}
+
+	return nil
+}
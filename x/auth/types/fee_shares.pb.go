@@ -0,0 +1,642 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/auth/v1beta1/fee_shares.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExtensionOptionFeeShares is a tx extension option splitting the fee of the
+// transaction between several payers. The payers are signers of the
+// transaction, and the shares must sum up to the fee.
+type ExtensionOptionFeeShares struct {
+	// shares are the shares of the fee, one per payer.
+	Shares []FeeShare `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares"`
+}
+
+func (m *ExtensionOptionFeeShares) Reset()         { *m = ExtensionOptionFeeShares{} }
+func (m *ExtensionOptionFeeShares) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionFeeShares) ProtoMessage()    {}
+func (*ExtensionOptionFeeShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e2e554bb3767cf, []int{0}
+}
+func (m *ExtensionOptionFeeShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionFeeShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionFeeShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionFeeShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionFeeShares.Merge(m, src)
+}
+func (m *ExtensionOptionFeeShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionFeeShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionFeeShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionFeeShares proto.InternalMessageInfo
+
+func (m *ExtensionOptionFeeShares) GetShares() []FeeShare {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// FeeShare is the share of the fee of a transaction covered by a payer.
+type FeeShare struct {
+	// payer is the address of the account covering the share. It must sign the
+	// transaction.
+	Payer string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	// amount is the share of the fee.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// granter is the optional address of a fee granter paying the share on
+	// behalf of the payer with a fee grant.
+	Granter string `protobuf:"bytes,3,opt,name=granter,proto3" json:"granter,omitempty"`
+}
+
+func (m *FeeShare) Reset()         { *m = FeeShare{} }
+func (m *FeeShare) String() string { return proto.CompactTextString(m) }
+func (*FeeShare) ProtoMessage()    {}
+func (*FeeShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e2e554bb3767cf, []int{1}
+}
+func (m *FeeShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeShare.Merge(m, src)
+}
+func (m *FeeShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeShare proto.InternalMessageInfo
+
+func (m *FeeShare) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *FeeShare) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *FeeShare) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ExtensionOptionFeeShares)(nil), "cosmos.auth.v1beta1.ExtensionOptionFeeShares")
+	proto.RegisterType((*FeeShare)(nil), "cosmos.auth.v1beta1.FeeShare")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/auth/v1beta1/fee_shares.proto", fileDescriptor_10e2e554bb3767cf)
+}
+
+var fileDescriptor_10e2e554bb3767cf = []byte{
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x3f, 0x6f, 0xda, 0x40,
+	0x18, 0xc6, 0x6d, 0x50, 0x69, 0x71, 0x2b, 0x55, 0x75, 0x91, 0x6a, 0x90, 0x38, 0x10, 0xea, 0x40,
+	0x91, 0xb8, 0x2b, 0xb4, 0x5d, 0x98, 0x5a, 0xa3, 0xb2, 0x56, 0x82, 0xad, 0x0b, 0x3a, 0x9b, 0xab,
+	0xb1, 0x5a, 0xdf, 0x59, 0xbe, 0x03, 0x61, 0xa9, 0x53, 0xa6, 0x8c, 0x99, 0xf3, 0x09, 0xa2, 0x4c,
+	0x0c, 0x7c, 0x08, 0x46, 0xc4, 0x94, 0x29, 0x89, 0x60, 0xe0, 0x6b, 0x44, 0xf6, 0x9d, 0xc9, 0x92,
+	0x3f, 0x8b, 0x6d, 0xf9, 0xf9, 0x3d, 0x7a, 0x7f, 0xf7, 0xda, 0xc6, 0x47, 0x97, 0xf1, 0x80, 0x71,
+	0x84, 0x67, 0x62, 0x8a, 0xe6, 0x1d, 0x87, 0x08, 0xdc, 0x41, 0x7f, 0x08, 0x19, 0xf3, 0x29, 0x8e,
+	0x08, 0x87, 0x61, 0xc4, 0x04, 0x33, 0xdf, 0x4b, 0x0a, 0x26, 0x14, 0x54, 0x54, 0xa5, 0xe4, 0x31,
+	0x8f, 0xa5, 0x39, 0x4a, 0x9e, 0x24, 0x5a, 0x29, 0x4b, 0x74, 0x2c, 0x03, 0xd5, 0x93, 0x11, 0x50,
+	0xb3, 0x1c, 0xcc, 0xc9, 0x71, 0x96, 0xcb, 0x7c, 0xaa, 0xf2, 0x0f, 0x2a, 0x0f, 0xb8, 0x87, 0xe6,
+	0x9d, 0xe4, 0xa6, 0x82, 0x77, 0x38, 0xf0, 0x29, 0x43, 0xe9, 0x55, 0xbe, 0x6a, 0xfc, 0x37, 0xac,
+	0x9f, 0x0b, 0x41, 0x28, 0xf7, 0x19, 0xfd, 0x15, 0x0a, 0x9f, 0xd1, 0x01, 0x21, 0xa3, 0xd4, 0xd9,
+	0xfc, 0x6e, 0x14, 0xa4, 0xbd, 0xa5, 0xd7, 0xf3, 0xcd, 0xd7, 0xdd, 0x2a, 0x7c, 0x40, 0x1f, 0x66,
+	0xbc, 0x5d, 0x5c, 0x5f, 0xd7, 0xb4, 0x8b, 0xc3, 0xb2, 0xa5, 0x0f, 0x55, 0xaf, 0x07, 0xb6, 0xab,
+	0xf6, 0x5b, 0x59, 0x6a, 0xf3, 0xc9, 0xdf, 0xfa, 0x67, 0xf8, 0xed, 0xeb, 0xc9, 0x61, 0xd9, 0x52,
+	0x79, 0xe3, 0x34, 0x67, 0xbc, 0xca, 0xfa, 0x26, 0x34, 0x5e, 0x84, 0x38, 0x26, 0x91, 0xa5, 0xd7,
+	0xf5, 0x66, 0xd1, 0xb6, 0xb6, 0xab, 0x76, 0x49, 0x0d, 0xfc, 0x31, 0x99, 0x44, 0x84, 0xf3, 0x91,
+	0x88, 0x7c, 0xea, 0x0d, 0x25, 0x66, 0xc6, 0x46, 0x01, 0x07, 0x6c, 0x46, 0x85, 0x95, 0x4b, 0xf5,
+	0xca, 0x99, 0x5e, 0xb2, 0x97, 0xa3, 0x5e, 0x9f, 0xf9, 0xd4, 0x1e, 0x24, 0x6a, 0x97, 0x37, 0xb5,
+	0xa6, 0xe7, 0x8b, 0xe9, 0xcc, 0x81, 0x2e, 0x0b, 0xd4, 0x4a, 0xd1, 0xbd, 0x1d, 0x12, 0x71, 0x48,
+	0x78, 0x5a, 0xe0, 0xe7, 0x87, 0x65, 0xeb, 0xcd, 0x3f, 0xe2, 0x61, 0x37, 0x1e, 0x27, 0x9b, 0xe5,
+	0xea, 0x5c, 0x72, 0xa0, 0xd9, 0x35, 0x5e, 0x7a, 0x11, 0xa6, 0x82, 0x44, 0x56, 0xfe, 0x19, 0xd9,
+	0x0c, 0xec, 0x55, 0x1f, 0xd9, 0x85, 0x3c, 0x8d, 0xdd, 0x5f, 0xef, 0x80, 0xbe, 0xd9, 0x01, 0xfd,
+	0x76, 0x07, 0xf4, 0xb3, 0x3d, 0xd0, 0x36, 0x7b, 0xa0, 0x5d, 0xed, 0x81, 0xf6, 0xfb, 0xd3, 0x93,
+	0xd2, 0x0b, 0xf9, 0xcb, 0xa5, 0xee, 0x4e, 0x21, 0xfd, 0xa8, 0x5f, 0xee, 0x06, 0x00, 0x73, 0x48,
+	0x0b, 0x85, 0x8e, 0x02, 0x00, 0x00,
+}
+
+func (m *ExtensionOptionFeeShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionFeeShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionFeeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeShares(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeeShares(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeShares(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintFeeShares(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeShares(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeShares(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExtensionOptionFeeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovFeeShares(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovFeeShares(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovFeeShares(uint64(l))
+		}
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeeShares(uint64(l))
+	}
+	return n
+}
+
+func sovFeeShares(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeShares(x uint64) (n int) {
+	return sovFeeShares(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExtensionOptionFeeShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeShares
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionFeeShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionFeeShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeShares
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeShares
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeShares
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, FeeShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeShares(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeShares
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeShares
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeShares
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeShares
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeShares
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeShares
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeShares
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeShares
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeShares
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeShares
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeShares
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeShares(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeShares
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeShares(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeShares
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeShares
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeShares
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeShares
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeShares
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeShares
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeShares        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeShares          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeShares = fmt.Errorf("proto: unexpected end of group")
+)
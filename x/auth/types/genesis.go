@@ -40,6 +40,11 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, authenticators := range g.Authenticators {
+		if err := authenticators.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -72,7 +77,44 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	return validateGenAuthenticators(data.Authenticators)
+}
+
+// validateGenAuthenticators validates the genesis account authenticators.
+func validateGenAuthenticators(genAuthenticators []AccountAuthenticators) error {
+	addrs := make(map[string]struct{}, len(genAuthenticators))
+	ids := make(map[uint64]struct{})
+	for _, accAuthenticators := range genAuthenticators {
+		if _, ok := addrs[accAuthenticators.Address]; ok {
+			return fmt.Errorf("duplicate authenticators for account %s", accAuthenticators.Address)
+		}
+		addrs[accAuthenticators.Address] = struct{}{}
+
+		if len(accAuthenticators.Authenticators) > MaxAuthenticatorsPerAccount {
+			return fmt.Errorf("account %s has more than %d authenticators", accAuthenticators.Address, MaxAuthenticatorsPerAccount)
+		}
+
+		for _, accAuthenticator := range accAuthenticators.Authenticators {
+			if _, ok := ids[accAuthenticator.Id]; ok {
+				return fmt.Errorf("duplicate authenticator id %d", accAuthenticator.Id)
+			}
+			ids[accAuthenticator.Id] = struct{}{}
+
+			authenticator, err := accAuthenticator.GetAuthenticator()
+			if err != nil {
+				return err
+			}
+			if err := ValidateAuthenticator(authenticator); err != nil {
+				return fmt.Errorf("invalid authenticator %d of account %s: %w", accAuthenticator.Id, accAuthenticators.Address, err)
+			}
+		}
+	}
+
+	return nil
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -29,6 +29,12 @@ var (
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
 
+	// AuthenticatorsKeyPrefix prefix for the account authenticators store.
+	AuthenticatorsKeyPrefix = collections.NewPrefix(3)
+
+	// AuthenticatorIDKey is the key of the sequence of authenticator ids.
+	AuthenticatorIDKey = collections.NewPrefix(4)
+
 	// UnorderedNoncesKey prefix for the unordered sequence storage.
 	UnorderedNoncesKey = collections.NewPrefix(90)
 
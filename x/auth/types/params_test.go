@@ -35,6 +35,8 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"unset BLS12-381 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), nil},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -49,3 +51,14 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_SigVerifyCostBls12381OrDefault(t *testing.T) {
+	params := types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+		types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)
+	require.Equal(t, types.DefaultSigVerifyCostBls12381, params.SigVerifyCostBls12381OrDefault())
+	require.Equal(t, types.DefaultSigVerifyCostBls12381/3, params.SigVerifyCostBls12381Aggregated())
+
+	params = params.WithSigVerifyCostBls12381(300)
+	require.Equal(t, uint64(300), params.SigVerifyCostBls12381OrDefault())
+	require.Equal(t, uint64(100), params.SigVerifyCostBls12381Aggregated())
+}
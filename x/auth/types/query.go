@@ -11,3 +11,13 @@ func (m *QueryAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 }
 
 var _ codectypes.UnpackInterfacesMessage = &QueryAccountResponse{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryAccountAuthenticatorsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, authenticator := range m.Authenticators {
+		if err := authenticator.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
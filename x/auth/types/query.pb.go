@@ -932,6 +932,98 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QueryAccountAuthenticatorsRequest is the Query/AccountAuthenticators request type.
+type QueryAccountAuthenticatorsRequest struct {
+	// address is the account address string.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountAuthenticatorsRequest) Reset()         { *m = QueryAccountAuthenticatorsRequest{} }
+func (m *QueryAccountAuthenticatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountAuthenticatorsRequest) ProtoMessage()    {}
+func (*QueryAccountAuthenticatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{20}
+}
+func (m *QueryAccountAuthenticatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountAuthenticatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountAuthenticatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountAuthenticatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountAuthenticatorsRequest.Merge(m, src)
+}
+func (m *QueryAccountAuthenticatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountAuthenticatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountAuthenticatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountAuthenticatorsRequest proto.InternalMessageInfo
+
+func (m *QueryAccountAuthenticatorsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAccountAuthenticatorsResponse is the Query/AccountAuthenticators response type.
+type QueryAccountAuthenticatorsResponse struct {
+	// authenticators are the authenticators registered on the account.
+	Authenticators []AccountAuthenticator `protobuf:"bytes,1,rep,name=authenticators,proto3" json:"authenticators"`
+}
+
+func (m *QueryAccountAuthenticatorsResponse) Reset()         { *m = QueryAccountAuthenticatorsResponse{} }
+func (m *QueryAccountAuthenticatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountAuthenticatorsResponse) ProtoMessage()    {}
+func (*QueryAccountAuthenticatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QueryAccountAuthenticatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountAuthenticatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountAuthenticatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountAuthenticatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountAuthenticatorsResponse.Merge(m, src)
+}
+func (m *QueryAccountAuthenticatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountAuthenticatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountAuthenticatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountAuthenticatorsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountAuthenticatorsResponse) GetAuthenticators() []AccountAuthenticator {
+	if m != nil {
+		return m.Authenticators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -953,84 +1045,92 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryAccountAuthenticatorsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountAuthenticatorsRequest")
+	proto.RegisterType((*QueryAccountAuthenticatorsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAuthenticatorsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1145 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xa6, 0xa1, 0x49, 0x5f, 0x3e, 0x2a, 0xc6, 0xae, 0x08, 0x9b, 0xd4, 0xb6, 0xb6, 0x90,
-	0x2f, 0xea, 0xdd, 0xc6, 0x49, 0x13, 0x88, 0xc4, 0x21, 0x4b, 0xd5, 0x2a, 0x42, 0x20, 0xb3, 0xad,
-	0xa0, 0xf4, 0x62, 0xad, 0xbd, 0x6b, 0x67, 0x45, 0xbd, 0xeb, 0x7a, 0xd7, 0xa8, 0x26, 0xb2, 0x90,
-	0x90, 0x90, 0x7a, 0xe0, 0x80, 0x04, 0x37, 0x2e, 0xf9, 0x13, 0x38, 0x58, 0x42, 0x48, 0xdc, 0xe0,
-	0x50, 0x7c, 0xaa, 0xc2, 0x05, 0x71, 0x40, 0x28, 0x41, 0x82, 0xbf, 0x80, 0x33, 0xf2, 0xcc, 0xdb,
-	0x0f, 0xc7, 0x63, 0x7b, 0xd3, 0xde, 0xec, 0x9d, 0xf7, 0x7e, 0x1f, 0x6f, 0xde, 0xcc, 0x1b, 0xc8,
-	0x94, 0x1d, 0xb7, 0xe6, 0xb8, 0x8a, 0xde, 0xf4, 0x0e, 0x94, 0x4f, 0x37, 0x4a, 0xa6, 0xa7, 0x6f,
-	0x28, 0x8f, 0x9a, 0x66, 0xa3, 0x25, 0xd7, 0x1b, 0x8e, 0xe7, 0x90, 0x24, 0x0b, 0x90, 0x7b, 0x01,
-	0x32, 0x06, 0x88, 0xeb, 0x98, 0x55, 0xd2, 0x5d, 0x93, 0x45, 0x07, 0xb9, 0x75, 0xbd, 0x6a, 0xd9,
-	0xba, 0x67, 0x39, 0x36, 0x03, 0x10, 0x53, 0x55, 0xa7, 0xea, 0xd0, 0x9f, 0x4a, 0xef, 0x17, 0x7e,
-	0x7d, 0xb5, 0xea, 0x38, 0xd5, 0x87, 0xa6, 0x42, 0xff, 0x95, 0x9a, 0x15, 0x45, 0xb7, 0x91, 0x51,
-	0x5c, 0xc2, 0x25, 0xbd, 0x6e, 0x29, 0xba, 0x6d, 0x3b, 0x1e, 0x45, 0x73, 0x71, 0x35, 0xcd, 0x13,
-	0x4c, 0xc5, 0x21, 0x30, 0x5b, 0x2f, 0x32, 0x46, 0x14, 0xcf, 0x96, 0x16, 0x31, 0xd5, 0x17, 0x1c,
-	0xf5, 0x29, 0xb9, 0x90, 0xfa, 0xa0, 0xf7, 0x77, 0xaf, 0x5c, 0x76, 0x9a, 0xb6, 0xe7, 0x6a, 0xe6,
-	0xa3, 0xa6, 0xe9, 0x7a, 0xe4, 0x36, 0x40, 0x68, 0x69, 0x41, 0xc8, 0x0a, 0xab, 0x33, 0xf9, 0x65,
-	0x19, 0x71, 0x7b, 0xfe, 0x65, 0x86, 0x82, 0x52, 0xe4, 0x82, 0x5e, 0x35, 0x31, 0x57, 0x8b, 0x64,
-	0xee, 0x26, 0x8f, 0x3b, 0xb9, 0xcb, 0x2c, 0x2d, 0xe7, 0x1a, 0x9f, 0x64, 0x6f, 0xc8, 0x5b, 0x9b,
-	0xd2, 0xaf, 0x02, 0x5c, 0x39, 0xc3, 0xea, 0xd6, 0x1d, 0xdb, 0x35, 0x89, 0x06, 0xd3, 0x3a, 0x7e,
-	0x5b, 0x10, 0xb2, 0x17, 0x56, 0x67, 0xf2, 0x29, 0x99, 0xd5, 0x45, 0xf6, 0x4b, 0x26, 0xef, 0xd9,
-	0x2d, 0x35, 0xdb, 0xed, 0xe4, 0x96, 0x38, 0x5b, 0x24, 0x23, 0xe2, 0xbe, 0x16, 0xe0, 0x90, 0x3b,
-	0x7d, 0x56, 0x26, 0xa8, 0x95, 0x95, 0xb1, 0x56, 0x98, 0xa0, 0xf1, 0x5e, 0xee, 0x42, 0x32, 0x6a,
-	0xc5, 0xaf, 0x5f, 0x1e, 0xa6, 0x74, 0xc3, 0x68, 0x98, 0xae, 0x4b, 0x8b, 0x77, 0x49, 0x5d, 0x38,
-	0xee, 0xe4, 0x52, 0x48, 0xba, 0xc7, 0x56, 0xee, 0x7a, 0x0d, 0xcb, 0xae, 0x6a, 0x7e, 0xe0, 0xee,
-	0xf4, 0x93, 0xa3, 0x4c, 0xe2, 0xdf, 0xa3, 0x4c, 0x42, 0x3a, 0xe8, 0xdf, 0x95, 0xa0, 0x3c, 0x05,
-	0x98, 0x42, 0x5b, 0xb8, 0x25, 0xcf, 0x5b, 0x1d, 0x1f, 0x46, 0x4a, 0x01, 0xa1, 0x4c, 0x05, 0xbd,
-	0xa1, 0xd7, 0xfc, 0xdd, 0x97, 0x0a, 0x68, 0xca, 0xff, 0x8a, 0xf4, 0x6f, 0xc1, 0xc5, 0x3a, 0xfd,
-	0x82, 0xec, 0x8b, 0x32, 0x8f, 0x84, 0x25, 0xa9, 0x93, 0x4f, 0xff, 0xcc, 0x24, 0x34, 0x4c, 0x90,
-	0x36, 0x40, 0xa4, 0x88, 0xef, 0x39, 0x46, 0xf3, 0xa1, 0x79, 0xa6, 0xdb, 0x78, 0x95, 0xdd, 0x96,
-	0xbe, 0x12, 0x60, 0x91, 0x9b, 0x83, 0x6a, 0xee, 0xc7, 0xec, 0x95, 0xe5, 0x6e, 0x27, 0x27, 0xf1,
-	0x84, 0xf6, 0xe1, 0x46, 0x3a, 0x86, 0x2f, 0xe7, 0x26, 0x64, 0x06, 0xd5, 0xa8, 0xad, 0xf7, 0xf5,
-	0x9a, 0xdf, 0xf8, 0x84, 0xc0, 0xa4, 0xad, 0xd7, 0x4c, 0xb6, 0xe3, 0x1a, 0xfd, 0x2d, 0x7d, 0x06,
-	0xd9, 0xe1, 0x69, 0xe8, 0xe4, 0xc3, 0x78, 0xdb, 0x1a, 0xd7, 0x48, 0xb0, 0xb9, 0xeb, 0x90, 0x54,
-	0xcd, 0xf2, 0xc1, 0x66, 0xbe, 0xd0, 0x30, 0x2b, 0xd6, 0xe3, 0x91, 0xd5, 0x2e, 0x40, 0xaa, 0x3f,
-	0x16, 0xb5, 0x5d, 0x83, 0xb9, 0x12, 0xfd, 0x5e, 0xac, 0xd3, 0x05, 0x34, 0x37, 0x5b, 0x8a, 0x04,
-	0xf3, 0x11, 0x3f, 0x82, 0x45, 0x6c, 0x74, 0xb5, 0xe5, 0x99, 0xee, 0x3d, 0x07, 0xfb, 0x1d, 0x8b,
-	0x75, 0x0d, 0xe6, 0xb0, 0xf1, 0x8b, 0xa5, 0xde, 0x3a, 0x05, 0x9e, 0xd5, 0x66, 0xf5, 0x48, 0x0e,
-	0x1f, 0xf8, 0x01, 0x2c, 0xf1, 0x81, 0x51, 0xf2, 0xeb, 0x30, 0xef, 0x23, 0xbb, 0x74, 0x05, 0x35,
-	0xfb, 0x7c, 0x2c, 0x9c, 0x8f, 0xfd, 0x71, 0x20, 0x9a, 0x45, 0xdd, 0x73, 0x28, 0x87, 0x2f, 0xfa,
-	0x45, 0xa0, 0xef, 0x07, 0xb2, 0xcf, 0x40, 0x87, 0x95, 0x7e, 0xce, 0x82, 0x7c, 0x0e, 0xe9, 0xe8,
-	0x75, 0x11, 0x14, 0x67, 0xff, 0x56, 0xd8, 0x99, 0x13, 0x96, 0x41, 0x01, 0x2f, 0xa8, 0x13, 0x0b,
-	0x82, 0x36, 0x61, 0x19, 0x24, 0x0f, 0x80, 0x8d, 0x52, 0xb4, 0x0c, 0x7a, 0x2f, 0x4e, 0xaa, 0xc9,
-	0x3f, 0x06, 0xe0, 0x77, 0xb4, 0x4b, 0x18, 0xb6, 0x6f, 0xec, 0x5e, 0x39, 0xee, 0xe4, 0x5e, 0x3e,
-	0x43, 0x2f, 0xe7, 0xa5, 0x43, 0x3c, 0x1b, 0x3c, 0x01, 0xe8, 0x6e, 0x0f, 0x2e, 0xfb, 0x6c, 0x71,
-	0x2f, 0xc6, 0x79, 0xbd, 0x0f, 0x6e, 0x18, 0x79, 0x09, 0x5e, 0x89, 0x92, 0xef, 0xdb, 0x15, 0xe7,
-	0x45, 0x6e, 0x61, 0x4e, 0x85, 0x77, 0x24, 0x13, 0x16, 0x06, 0x39, 0xd0, 0xd9, 0x16, 0x4c, 0x5a,
-	0x76, 0xc5, 0xc1, 0xa3, 0x9b, 0xe5, 0xde, 0x89, 0xaa, 0xee, 0xfa, 0xe7, 0x53, 0xa3, 0xd1, 0x5c,
-	0x9a, 0xfc, 0x7f, 0x73, 0xf0, 0x12, 0xe5, 0x21, 0x47, 0x02, 0x4c, 0xfb, 0x37, 0x1e, 0x59, 0xe3,
-	0x62, 0xf2, 0xe6, 0xb6, 0xb8, 0x1e, 0x27, 0x94, 0x09, 0x97, 0xde, 0xee, 0x0e, 0xce, 0xb3, 0x27,
-	0xff, 0x7c, 0xbf, 0x2e, 0x7c, 0xf1, 0xdb, 0xdf, 0xdf, 0x4c, 0x64, 0xc8, 0x55, 0x85, 0xfb, 0xe8,
-	0xf0, 0x55, 0x7d, 0x2b, 0xc0, 0x14, 0x62, 0x92, 0xd5, 0xb1, 0xb4, 0xbe, 0xc0, 0xb5, 0x18, 0x91,
-	0xa8, 0x6f, 0x2b, 0x14, 0xb3, 0x46, 0x56, 0x46, 0x8a, 0x51, 0x0e, 0x71, 0xfb, 0xda, 0xa4, 0x2b,
-	0x00, 0x19, 0xec, 0x43, 0xb2, 0x39, 0x96, 0x77, 0xf0, 0xd8, 0x88, 0x5b, 0xe7, 0x4b, 0x42, 0xdd,
-	0xfb, 0x5d, 0x5e, 0x9f, 0xc6, 0x30, 0x13, 0x9c, 0xfe, 0xa2, 0x65, 0x28, 0x87, 0x96, 0xd1, 0x26,
-	0x5f, 0x0a, 0x70, 0x91, 0xcd, 0x53, 0xb2, 0x32, 0x5c, 0x4b, 0xdf, 0xf0, 0x16, 0x57, 0xc7, 0x07,
-	0xa2, 0xd0, 0xd5, 0x50, 0xd3, 0x55, 0xb2, 0xc8, 0xd5, 0xc4, 0xc6, 0x37, 0xf9, 0x51, 0x80, 0xf9,
-	0xfe, 0x31, 0x4c, 0x94, 0xe1, 0x34, 0xdc, 0x21, 0x2f, 0xde, 0x88, 0x9f, 0x80, 0xfa, 0x6e, 0x0d,
-	0x36, 0xe8, 0x76, 0x28, 0x79, 0x99, 0xbc, 0xc6, 0x95, 0x5c, 0xa3, 0x60, 0xc5, 0xa0, 0x4f, 0x7f,
-	0x12, 0x20, 0xc9, 0x99, 0xbe, 0x64, 0x2b, 0xa6, 0x9e, 0xbe, 0x19, 0x2f, 0xde, 0x3c, 0x67, 0x16,
-	0x5a, 0x79, 0x33, 0xd4, 0x9d, 0x23, 0x6f, 0xc4, 0xd1, 0xad, 0x1c, 0xf6, 0xde, 0x0f, 0x6d, 0xf2,
-	0x9d, 0x00, 0xb3, 0xd1, 0xc9, 0x3c, 0xe4, 0xac, 0x71, 0x06, 0xfd, 0x90, 0xb3, 0xc6, 0x1b, 0xf3,
-	0xd2, 0x36, 0xa7, 0xd4, 0x23, 0x1b, 0x83, 0xcd, 0x7f, 0xf2, 0xb3, 0x00, 0x29, 0xde, 0x30, 0x26,
-	0xfc, 0xdd, 0x1e, 0xf1, 0x20, 0x10, 0x37, 0xce, 0x91, 0x81, 0xaa, 0x6f, 0x0f, 0x53, 0x3d, 0xac,
-	0xc6, 0x4c, 0x75, 0x70, 0x5b, 0xb0, 0x41, 0xdb, 0x26, 0xbf, 0x84, 0x2e, 0xfa, 0x66, 0xf3, 0x68,
-	0x17, 0xbc, 0x17, 0xc2, 0x68, 0x17, 0xdc, 0xc1, 0x2f, 0xdd, 0x19, 0xe6, 0x42, 0x26, 0xd7, 0x63,
-	0xb9, 0x60, 0x4f, 0x91, 0x36, 0xf9, 0x41, 0x80, 0x99, 0xc8, 0x84, 0x22, 0xd7, 0xc7, 0x5e, 0x5f,
-	0x91, 0x61, 0x29, 0xe6, 0x62, 0x46, 0xa3, 0xea, 0x77, 0x07, 0x55, 0xef, 0x8c, 0x6f, 0xf2, 0xe0,
-	0xc5, 0x61, 0x57, 0x9c, 0xf0, 0xd2, 0x56, 0xdf, 0x79, 0x7a, 0x92, 0x16, 0x9e, 0x9d, 0xa4, 0x85,
-	0xbf, 0x4e, 0xd2, 0xc2, 0xd7, 0xa7, 0xe9, 0xc4, 0xb3, 0xd3, 0x74, 0xe2, 0xf7, 0xd3, 0x74, 0xe2,
-	0xc1, 0x5a, 0xd5, 0xf2, 0x0e, 0x9a, 0x25, 0xb9, 0xec, 0xd4, 0x7c, 0xc0, 0x90, 0x54, 0x79, 0xcc,
-	0xd0, 0xbd, 0x56, 0xdd, 0x74, 0x4b, 0x17, 0xe9, 0x6b, 0x79, 0xf3, 0xff, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x68, 0xf1, 0x1c, 0xea, 0xdd, 0x0f, 0x00, 0x00,
+	// 1233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xa4, 0x21, 0x49, 0x5f, 0xd2, 0x44, 0x8c, 0x1d, 0x11, 0x36, 0xa9, 0x6d, 0xb6, 0x90,
+	0x5f, 0xd4, 0xbb, 0x8d, 0xe3, 0x24, 0x10, 0x89, 0x43, 0x4c, 0xd5, 0x2a, 0x42, 0x45, 0x66, 0x5b,
+	0xd1, 0xd2, 0x8b, 0xb5, 0xb6, 0x37, 0xce, 0x8a, 0x78, 0xd7, 0xf5, 0xae, 0x51, 0x43, 0x14, 0x21,
+	0x21, 0x21, 0xf5, 0xc0, 0x01, 0x01, 0x37, 0x2e, 0xf9, 0x13, 0x38, 0x44, 0x42, 0x48, 0xdc, 0xe0,
+	0x50, 0x7c, 0x8a, 0xc2, 0x01, 0xc4, 0x01, 0xa1, 0x04, 0x09, 0xfe, 0x0c, 0xe4, 0x99, 0xb7, 0xf6,
+	0x6e, 0x3c, 0xb6, 0xd7, 0x6d, 0x6f, 0xeb, 0x9d, 0xf7, 0xbe, 0xf7, 0x7d, 0x6f, 0xde, 0xce, 0x7c,
+	0x09, 0x24, 0x8a, 0xb6, 0x53, 0xb1, 0x1d, 0x55, 0xaf, 0xbb, 0xbb, 0xea, 0x27, 0x2b, 0x05, 0xc3,
+	0xd5, 0x57, 0xd4, 0x47, 0x75, 0xa3, 0xb6, 0xaf, 0x54, 0x6b, 0xb6, 0x6b, 0xd3, 0x28, 0x0f, 0x50,
+	0x9a, 0x01, 0x0a, 0x06, 0x48, 0xcb, 0x98, 0x55, 0xd0, 0x1d, 0x83, 0x47, 0xb7, 0x72, 0xab, 0x7a,
+	0xd9, 0xb4, 0x74, 0xd7, 0xb4, 0x2d, 0x0e, 0x20, 0xc5, 0xca, 0x76, 0xd9, 0x66, 0x8f, 0x6a, 0xf3,
+	0x09, 0xdf, 0xbe, 0x5a, 0xb6, 0xed, 0xf2, 0x9e, 0xa1, 0xb2, 0x5f, 0x85, 0xfa, 0x8e, 0xaa, 0x5b,
+	0x58, 0x51, 0x9a, 0xc3, 0x25, 0xbd, 0x6a, 0xaa, 0xba, 0x65, 0xd9, 0x2e, 0x43, 0x73, 0x70, 0x35,
+	0x2e, 0x22, 0xcc, 0xc8, 0xf1, 0xf5, 0x85, 0x6e, 0xeb, 0x86, 0xe5, 0x9a, 0x45, 0xdd, 0xb5, 0x6b,
+	0x1e, 0x03, 0x1e, 0x98, 0xe7, 0xd4, 0x50, 0x25, 0x5f, 0x9a, 0x45, 0x0c, 0x4f, 0x99, 0xbf, 0x21,
+	0xb2, 0x03, 0xb1, 0x0f, 0x9a, 0x3f, 0xb7, 0x8a, 0x45, 0xbb, 0x6e, 0xb9, 0x8e, 0x66, 0x3c, 0xaa,
+	0x1b, 0x8e, 0x4b, 0x6f, 0x01, 0xb4, 0xb5, 0xcf, 0x90, 0x24, 0x59, 0x1c, 0x4f, 0xcf, 0x2b, 0x88,
+	0xdb, 0x6c, 0x94, 0xc2, 0x51, 0x90, 0x93, 0x92, 0xd3, 0xcb, 0x06, 0xe6, 0x6a, 0xbe, 0xcc, 0xcd,
+	0xe8, 0xe9, 0x71, 0x6a, 0x8a, 0xa7, 0xa5, 0x9c, 0xd2, 0xc7, 0xc9, 0x1b, 0x4a, 0x66, 0x55, 0xfe,
+	0x95, 0xc0, 0xf4, 0x85, 0xaa, 0x4e, 0xd5, 0xb6, 0x1c, 0x83, 0x6a, 0x30, 0xa6, 0xe3, 0xbb, 0x19,
+	0x92, 0xbc, 0xb4, 0x38, 0x9e, 0x8e, 0x29, 0xbc, 0x81, 0x8a, 0xd7, 0x5b, 0x65, 0xcb, 0xda, 0xcf,
+	0x26, 0x1b, 0xc7, 0xa9, 0x39, 0xc1, 0x5e, 0x2a, 0x88, 0xb8, 0xad, 0xb5, 0x70, 0xe8, 0xed, 0x80,
+	0x94, 0x21, 0x26, 0x65, 0xa1, 0xaf, 0x14, 0x4e, 0xa8, 0xbf, 0x96, 0xbb, 0x10, 0xf5, 0x4b, 0xf1,
+	0xfa, 0x97, 0x86, 0x51, 0xbd, 0x54, 0xaa, 0x19, 0x8e, 0xc3, 0x9a, 0x77, 0x39, 0x3b, 0x73, 0x7a,
+	0x9c, 0x8a, 0x61, 0xd1, 0x2d, 0xbe, 0x72, 0xd7, 0xad, 0x99, 0x56, 0x59, 0xf3, 0x02, 0x37, 0xc7,
+	0x9e, 0x1c, 0x25, 0x22, 0xff, 0x1d, 0x25, 0x22, 0xf2, 0x6e, 0x70, 0x57, 0x5a, 0xed, 0xc9, 0xc1,
+	0x28, 0xca, 0xc2, 0x2d, 0x79, 0xd6, 0xee, 0x78, 0x30, 0x72, 0x0c, 0x28, 0xab, 0x94, 0xd3, 0x6b,
+	0x7a, 0xc5, 0xdb, 0x7d, 0x39, 0x07, 0xd1, 0xc0, 0x5b, 0x2c, 0xff, 0x36, 0x8c, 0x54, 0xd9, 0x1b,
+	0xac, 0x3e, 0xab, 0x88, 0x8a, 0xf0, 0xa4, 0xec, 0xf0, 0xd3, 0xbf, 0x12, 0x11, 0x0d, 0x13, 0xe4,
+	0x15, 0x90, 0x18, 0xe2, 0x1d, 0xbb, 0x54, 0xdf, 0x33, 0x2e, 0x4c, 0x9b, 0xa8, 0xb3, 0xeb, 0xf2,
+	0x97, 0x04, 0x66, 0x85, 0x39, 0xc8, 0xe6, 0x41, 0xc8, 0x59, 0x99, 0x6f, 0x1c, 0xa7, 0x64, 0x11,
+	0xd1, 0x00, 0xae, 0x6f, 0x62, 0xc4, 0x74, 0xd6, 0x20, 0xd1, 0xc9, 0x26, 0xbb, 0xff, 0xbe, 0x5e,
+	0xf1, 0x06, 0x9f, 0x52, 0x18, 0xb6, 0xf4, 0x8a, 0xc1, 0x77, 0x5c, 0x63, 0xcf, 0xf2, 0xa7, 0x90,
+	0xec, 0x9e, 0x86, 0x4a, 0x3e, 0x0c, 0xb7, 0xad, 0x61, 0x85, 0xb4, 0x36, 0x77, 0x19, 0xa2, 0x59,
+	0xa3, 0xb8, 0xbb, 0x9a, 0xce, 0xd5, 0x8c, 0x1d, 0xf3, 0x71, 0xcf, 0x6e, 0xe7, 0x20, 0x16, 0x8c,
+	0x45, 0x6e, 0xd7, 0xe0, 0x4a, 0x81, 0xbd, 0xcf, 0x57, 0xd9, 0x02, 0x8a, 0x9b, 0x28, 0xf8, 0x82,
+	0xc5, 0x88, 0xf7, 0x61, 0x16, 0x07, 0x3d, 0xbb, 0xef, 0x1a, 0xce, 0x3d, 0x1b, 0xe7, 0x1d, 0x9b,
+	0x75, 0x0d, 0xae, 0xe0, 0xe0, 0xe7, 0x0b, 0xcd, 0x75, 0x06, 0x3c, 0xa1, 0x4d, 0xe8, 0xbe, 0x1c,
+	0x31, 0xf0, 0x43, 0x98, 0x13, 0x03, 0x23, 0xe5, 0x37, 0x60, 0xd2, 0x43, 0x76, 0xd8, 0x0a, 0x72,
+	0xf6, 0xea, 0xf1, 0x70, 0x31, 0xf6, 0x47, 0x2d, 0xd2, 0x3c, 0xea, 0x9e, 0xcd, 0x6a, 0x78, 0xa4,
+	0x9f, 0x07, 0xfa, 0x41, 0x8b, 0xf6, 0x05, 0xe8, 0x76, 0xa7, 0x9f, 0xb1, 0x21, 0x9f, 0x41, 0xdc,
+	0x7f, 0x5c, 0xb4, 0x9a, 0xb3, 0x7d, 0xb3, 0x3d, 0x99, 0x43, 0x66, 0x89, 0x01, 0x5e, 0xca, 0x0e,
+	0xcd, 0x10, 0x6d, 0xc8, 0x2c, 0xd1, 0x34, 0x00, 0x0e, 0x4a, 0xde, 0x2c, 0xb1, 0x73, 0x71, 0x38,
+	0x1b, 0xfd, 0xb3, 0x03, 0x7e, 0x43, 0xbb, 0x8c, 0x61, 0xdb, 0xa5, 0xcd, 0xe9, 0xd3, 0xe3, 0xd4,
+	0xcb, 0x17, 0xca, 0x2b, 0x69, 0xf9, 0x00, 0x12, 0x5d, 0x09, 0xa0, 0xba, 0x2d, 0x98, 0xf2, 0xaa,
+	0x85, 0x3d, 0x18, 0x27, 0xf5, 0x00, 0x5c, 0xb7, 0xe2, 0x05, 0x78, 0xc5, 0x5f, 0x7c, 0xdb, 0xda,
+	0xb1, 0x9f, 0xe7, 0x14, 0x16, 0x74, 0x78, 0x43, 0x36, 0x60, 0xa6, 0xb3, 0x06, 0x2a, 0xcb, 0xc0,
+	0xb0, 0x69, 0xed, 0xd8, 0xf8, 0xe9, 0x26, 0x85, 0x67, 0x62, 0x56, 0x77, 0xbc, 0xef, 0x53, 0x63,
+	0xd1, 0xe2, 0x32, 0x7b, 0xf0, 0x5a, 0xa0, 0x8f, 0xfe, 0x8b, 0xde, 0x79, 0xb1, 0xa2, 0xd6, 0x32,
+	0xf2, 0xd7, 0x04, 0xe4, 0x5e, 0xe5, 0x50, 0xdf, 0x7d, 0x98, 0x0c, 0x38, 0x0e, 0xef, 0xb4, 0x5d,
+	0x52, 0x7a, 0x5c, 0x31, 0x01, 0x2c, 0xbc, 0x0b, 0x2e, 0xc0, 0x08, 0x49, 0xa5, 0x4f, 0xa6, 0xe0,
+	0x25, 0x46, 0x8a, 0x1e, 0x11, 0x18, 0xdb, 0xf2, 0x2e, 0x71, 0x71, 0x31, 0x91, 0x75, 0x91, 0x96,
+	0xc3, 0x84, 0x72, 0x6d, 0xf2, 0x3b, 0x8d, 0xce, 0x2b, 0xfd, 0xc9, 0xbf, 0xdf, 0x2f, 0x93, 0xcf,
+	0x7f, 0xfb, 0xe7, 0x9b, 0xa1, 0x04, 0xbd, 0xaa, 0x0a, 0x0d, 0x98, 0xc7, 0xea, 0x5b, 0x02, 0xa3,
+	0x88, 0x49, 0x17, 0xfb, 0x96, 0xf5, 0x08, 0x2e, 0x85, 0x88, 0x44, 0x7e, 0x99, 0x36, 0x99, 0x25,
+	0xba, 0xd0, 0x93, 0x8c, 0x7a, 0x80, 0x9b, 0x7d, 0x48, 0x1b, 0x04, 0x68, 0xe7, 0xa7, 0x48, 0x57,
+	0xfb, 0xd6, 0xed, 0x3c, 0x39, 0xa4, 0xcc, 0x60, 0x49, 0xc8, 0x7b, 0xbb, 0x21, 0xfa, 0x54, 0x43,
+	0x88, 0x69, 0x1d, 0x80, 0x79, 0xb3, 0xa4, 0x1e, 0x98, 0xa5, 0x43, 0xfa, 0x05, 0x81, 0x11, 0x6e,
+	0x29, 0xe8, 0x42, 0x77, 0x2e, 0x01, 0xff, 0x22, 0x2d, 0xf6, 0x0f, 0x44, 0xa2, 0x8b, 0x6d, 0x4e,
+	0x57, 0xe9, 0xac, 0x90, 0x13, 0x77, 0x30, 0xf4, 0x47, 0x02, 0x93, 0x41, 0x27, 0x42, 0xd5, 0xee,
+	0x65, 0x84, 0x3e, 0x47, 0xba, 0x11, 0x3e, 0x01, 0xf9, 0xdd, 0xec, 0x1c, 0xd0, 0xf5, 0x36, 0xe5,
+	0x79, 0xfa, 0xba, 0x90, 0x72, 0x85, 0x81, 0xe5, 0x5b, 0x73, 0xfa, 0x13, 0x81, 0xa8, 0xc0, 0x80,
+	0xd0, 0x4c, 0x48, 0x3e, 0x01, 0x9b, 0x23, 0xad, 0x0d, 0x98, 0x85, 0x52, 0xde, 0x6a, 0xf3, 0x4e,
+	0xd1, 0x37, 0xc3, 0xf0, 0x56, 0x0f, 0x9a, 0x16, 0xea, 0x90, 0x7e, 0x47, 0x60, 0xc2, 0x6f, 0x4e,
+	0xba, 0x7c, 0x6b, 0x02, 0xaf, 0x23, 0x2d, 0x85, 0x88, 0x44, 0x7e, 0xeb, 0x82, 0x56, 0xf7, 0x1c,
+	0x0c, 0x6e, 0x81, 0xe8, 0xcf, 0x04, 0x62, 0x22, 0x3f, 0x42, 0xc5, 0xbb, 0xdd, 0xc3, 0x13, 0x49,
+	0x2b, 0x03, 0x64, 0x20, 0xeb, 0x5b, 0xdd, 0x58, 0x77, 0xeb, 0x31, 0x67, 0xad, 0x1e, 0x04, 0xbc,
+	0xc6, 0x21, 0xfd, 0xa5, 0xad, 0x22, 0x60, 0x4f, 0x7a, 0xab, 0x10, 0x99, 0x24, 0x69, 0x65, 0x80,
+	0x0c, 0x54, 0x71, 0xbb, 0x9b, 0x0a, 0x85, 0x5e, 0x0f, 0xa5, 0x82, 0xbb, 0xb1, 0x43, 0xfa, 0x03,
+	0x81, 0x71, 0xdf, 0x25, 0x4d, 0xaf, 0xf7, 0x3d, 0xbe, 0x7c, 0x7e, 0x41, 0x4a, 0x85, 0x8c, 0x46,
+	0xd6, 0xef, 0x75, 0xb2, 0xde, 0xe8, 0x3f, 0xe4, 0x2d, 0xd3, 0x65, 0xed, 0xd8, 0xbe, 0x43, 0xfb,
+	0x77, 0x02, 0xd3, 0xc2, 0x8b, 0x98, 0xae, 0xf7, 0x3f, 0x82, 0x45, 0x46, 0x41, 0xda, 0x18, 0x38,
+	0x0f, 0x75, 0xdd, 0x69, 0x74, 0x5e, 0xcc, 0x6d, 0x5d, 0x2a, 0x4d, 0xa9, 0x7d, 0xff, 0x2d, 0xe1,
+	0xbb, 0x8e, 0xb2, 0xef, 0x3e, 0x3d, 0x8b, 0x93, 0x93, 0xb3, 0x38, 0xf9, 0xfb, 0x2c, 0x4e, 0xbe,
+	0x3a, 0x8f, 0x47, 0x4e, 0xce, 0xe3, 0x91, 0x3f, 0xce, 0xe3, 0x91, 0x87, 0x4b, 0x65, 0xd3, 0xdd,
+	0xad, 0x17, 0x94, 0xa2, 0x5d, 0xf1, 0x20, 0xdb, 0x65, 0xd5, 0xc7, 0x1c, 0xdf, 0xdd, 0xaf, 0x1a,
+	0x4e, 0x61, 0x84, 0xfd, 0x29, 0xb4, 0xfa, 0xff, 0x00, 0x35, 0x84, 0xfa, 0x5f, 0xe3, 0x11, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1068,6 +1168,8 @@ type QueryClient interface {
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// AccountAuthenticators returns the authenticators registered on an account.
+	AccountAuthenticators(ctx context.Context, in *QueryAccountAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAccountAuthenticatorsResponse, error)
 }
 
 type queryClient struct {
@@ -1168,6 +1270,15 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) AccountAuthenticators(ctx context.Context, in *QueryAccountAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAccountAuthenticatorsResponse, error) {
+	out := new(QueryAccountAuthenticatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AccountAuthenticators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
@@ -1193,6 +1304,8 @@ type QueryServer interface {
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// AccountAuthenticators returns the authenticators registered on an account.
+	AccountAuthenticators(context.Context, *QueryAccountAuthenticatorsRequest) (*QueryAccountAuthenticatorsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1229,6 +1342,9 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) AccountAuthenticators(ctx context.Context, req *QueryAccountAuthenticatorsRequest) (*QueryAccountAuthenticatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountAuthenticators not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1414,6 +1530,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountAuthenticators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountAuthenticatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountAuthenticators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/AccountAuthenticators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountAuthenticators(ctx, req.(*QueryAccountAuthenticatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
@@ -1459,6 +1593,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "AccountAuthenticators",
+			Handler:    _Query_AccountAuthenticators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -2095,6 +2233,73 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAccountAuthenticatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountAuthenticatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountAuthenticatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountAuthenticatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountAuthenticatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountAuthenticatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for iNdEx := len(m.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2362,6 +2567,34 @@ func (m *QueryAccountInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAccountAuthenticatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountAuthenticatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for _, e := range m.Authenticators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3979,6 +4212,172 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAccountAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountAuthenticatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountAuthenticatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountAuthenticatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountAuthenticatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticators = append(m.Authenticators, AccountAuthenticator{})
+			if err := m.Authenticators[len(m.Authenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
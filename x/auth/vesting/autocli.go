@@ -40,6 +40,25 @@ These tokens may be used for staking but are non-transferable. Staking rewards w
 						{ProtoField: "amount", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "Clawback",
+					Use:       "clawback [address]",
+					Short:     "Claw back the unvested tokens of a clawback vesting account.",
+					Long: `Claw back the unvested tokens of a clawback vesting account. The sender must be the funder of the account.
+The clawed back tokens are sent to the funder, unless a destination is given with the '--dest-address' flag.`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "UpdateVestingFunder",
+					Use:       "update-vesting-funder [address] [new_funder_address]",
+					Short:     "Transfer the funder role of a clawback vesting account.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+						{ProtoField: "new_funder_address"},
+					},
+				},
 			},
 			EnhanceCustomCommand: false, // use custom commands only until v0.51
 		},
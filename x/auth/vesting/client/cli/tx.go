@@ -21,6 +21,9 @@ import (
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagLockup  = "lockup"
+	FlagVesting = "vesting"
+	FlagDest    = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -37,6 +40,9 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 		NewMsgCreateVestingAccountCmd(ac),
 		NewMsgCreatePermanentLockedAccountCmd(ac),
 		NewMsgCreatePeriodicVestingAccountCmd(ac),
+		NewMsgCreateClawbackVestingAccountCmd(ac),
+		NewMsgClawbackCmd(ac),
+		NewMsgUpdateVestingFunderCmd(ac),
 	)
 
 	return txCmd
@@ -175,36 +181,180 @@ func NewMsgCreatePeriodicVestingAccountCmd(ac address.Codec) *cobra.Command {
 				return err
 			}
 
-			contents, err := os.ReadFile(args[1])
+			vestingData, periods, err := readVestingData(args[1])
 			if err != nil {
 				return err
 			}
 
-			var vestingData VestingData
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readVestingData reads the vesting data JSON file at path and returns it with
+// its parsed periods.
+func readVestingData(path string) (VestingData, []types.Period, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return VestingData{}, nil, err
+	}
+
+	var vestingData VestingData
+
+	err = json.Unmarshal(contents, &vestingData)
+	if err != nil {
+		return VestingData{}, nil, err
+	}
+
+	var periods []types.Period
+
+	for i, p := range vestingData.Periods {
+
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return VestingData{}, nil, err
+		}
+
+		if p.Length < 0 {
+			return VestingData{}, nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+
+		period := types.Period{Length: p.Length, Amount: amount}
+		periods = append(periods, period)
+	}
+
+	return vestingData, periods, nil
+}
 
-			err = json.Unmarshal(contents, &vestingData)
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [start_time]",
+		Short: "Create a new vesting account whose unvested tokens can be clawed back by the sender.",
+		Long: `Create a new clawback vesting account funded by the sender, who becomes the funder
+of the account. The account has a lockup and a vesting schedule, given as periods JSON files
+(in the format of create-periodic-vesting-account) through the '--lockup' and '--vesting' flags.
+The start_time of the files is ignored in favor of the start_time argument, given as a UNIX
+epoch timestamp. Coins are spendable once they are both vested and unlocked. If one of the
+schedules is omitted, coins are vested (or unlocked) at start_time.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			var periods []types.Period
+			toAddr, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
 
-			for i, p := range vestingData.Periods {
+			startTime, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if lockupFile == "" && vestingFile == "" {
+				return errors.New("at least one of --lockup and --vesting must be provided")
+			}
 
-				amount, err := sdk.ParseCoinsNormalized(p.Coins)
-				if err != nil {
+			var lockupPeriods, vestingPeriods []types.Period
+			if lockupFile != "" {
+				if _, lockupPeriods, err = readVestingData(lockupFile); err != nil {
+					return err
+				}
+			}
+			if vestingFile != "" {
+				if _, vestingPeriods, err = readVestingData(vestingFile); err != nil {
 					return err
 				}
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, lockupPeriods, vestingPeriods)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "Path to the lockup periods JSON file")
+	cmd.Flags().String(FlagVesting, "", "Path to the vesting periods JSON file")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
 
-				if p.Length < 0 {
-					return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested tokens of a clawback vesting account.",
+		Long: `Claw back the unvested tokens of a clawback vesting account. The sender must be the
+funder of the account. Delegated unvested tokens are undelegated, and their unbonding is
+transferred to the destination. The clawed back tokens are sent to the funder, unless a
+destination is given with the '--dest' flag.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			var destAddr sdk.AccAddress
+			if dest, _ := cmd.Flags().GetString(FlagDest); dest != "" {
+				if destAddr, err = ac.StringToBytes(dest); err != nil {
+					return err
 				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, destAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address receiving the clawed back tokens (defaults to the funder)")
+	flags.AddTxFlagsToCmd(cmd)
 
-				period := types.Period{Length: p.Length, Amount: amount}
-				periods = append(periods, period)
+	return cmd
+}
+
+// NewMsgUpdateVestingFunderCmd returns a CLI command handler for creating a
+// MsgUpdateVestingFunder transaction.
+func NewMsgUpdateVestingFunderCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-vesting-funder [address] [new_funder]",
+		Short: "Transfer the funder role of a clawback vesting account.",
+		Long:  `Transfer the funder role of a clawback vesting account. The sender must be the current funder of the account.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
 			}
 
-			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			addr, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			newFunderAddr, err := ac.StringToBytes(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateVestingFunder(clientCtx.GetFromAddress(), newFunderAddr, addr)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
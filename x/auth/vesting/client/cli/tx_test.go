@@ -273,3 +273,187 @@ func (s *CLITestSuite) TestNewMsgCreatePeriodicVestingAccountCmd() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestNewMsgCreateClawbackVestingAccountCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", sdkmath.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, accounts[0].Address),
+	}
+
+	testCases := []struct {
+		name      string
+		to        sdk.AccAddress
+		startTime string
+		extraArgs []string
+		expectErr bool
+	}{
+		{
+			"valid transaction",
+			accounts[0].Address,
+			"4070908800",
+			append([]string{fmt.Sprintf("--%s=./test.json", cli.FlagVesting)}, extraArgs...),
+			false,
+		},
+		{
+			"valid transaction with lockup",
+			accounts[0].Address,
+			"4070908800",
+			append([]string{fmt.Sprintf("--%s=./test.json", cli.FlagLockup), fmt.Sprintf("--%s=./test.json", cli.FlagVesting)}, extraArgs...),
+			false,
+		},
+		{
+			"missing schedules",
+			accounts[0].Address,
+			"4070908800",
+			extraArgs,
+			true,
+		},
+		{
+			"invalid start time",
+			accounts[0].Address,
+			"-4070908800.1",
+			append([]string{fmt.Sprintf("--%s=./test.json", cli.FlagVesting)}, extraArgs...),
+			true,
+		},
+		{
+			"invalid to Address",
+			sdk.AccAddress{},
+			"4070908800",
+			append([]string{fmt.Sprintf("--%s=./test.json", cli.FlagVesting)}, extraArgs...),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd := cli.NewMsgCreateClawbackVestingAccountCmd(address.NewBech32Codec("cosmos"))
+			cmd.SetOut(io.Discard)
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.to.String(), tc.startTime}, tc.extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestNewMsgClawbackCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", sdkmath.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, accounts[0].Address),
+	}
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"valid transaction",
+			append([]string{accounts[1].Address.String()}, extraArgs...),
+			false,
+		},
+		{
+			"valid transaction with destination",
+			append([]string{accounts[1].Address.String(), fmt.Sprintf("--%s=%s", cli.FlagDest, accounts[0].Address)}, extraArgs...),
+			false,
+		},
+		{
+			"invalid destination",
+			append([]string{accounts[1].Address.String(), fmt.Sprintf("--%s=invalid", cli.FlagDest)}, extraArgs...),
+			true,
+		},
+		{
+			"invalid address",
+			append([]string{"invalid"}, extraArgs...),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd := cli.NewMsgClawbackCmd(address.NewBech32Codec("cosmos"))
+			cmd.SetOut(io.Discard)
+			cmd.SetContext(ctx)
+			cmd.SetArgs(tc.args)
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestNewMsgUpdateVestingFunderCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", sdkmath.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, accounts[0].Address),
+	}
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"valid transaction",
+			append([]string{accounts[1].Address.String(), accounts[0].Address.String()}, extraArgs...),
+			false,
+		},
+		{
+			"invalid new funder",
+			append([]string{accounts[1].Address.String(), "invalid"}, extraArgs...),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd := cli.NewMsgUpdateVestingFunderCmd(address.NewBech32Codec("cosmos"))
+			cmd.SetOut(io.Discard)
+			cmd.SetContext(ctx)
+			cmd.SetArgs(tc.args)
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
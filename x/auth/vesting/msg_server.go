@@ -1,11 +1,14 @@
 package vesting
 
 import (
+	"bytes"
 	"context"
+	stdmath "math"
 
 	"github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,12 +21,14 @@ import (
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	stakingKeeper types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper. The
+// StakingKeeper may be nil, in which case delegated coins cannot be clawed back.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, stakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -226,6 +231,338 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
 }
 
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	from, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
+	}
+
+	to, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	if len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "lockup and vesting periods cannot both be empty")
+	}
+
+	lockupCoins, err := validatePeriods("lockup", msg.LockupPeriods)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingCoins, err := validatePeriods("vesting", msg.VestingPeriods)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCoins := vestingCoins
+	if len(msg.VestingPeriods) == 0 {
+		totalCoins = lockupCoins
+	} else if len(msg.LockupPeriods) > 0 && !lockupCoins.Equal(vestingCoins) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "lockup periods total %s does not match vesting periods total %s", lockupCoins, vestingCoins)
+	}
+
+	if s.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if acc := s.GetAccount(ctx, to); acc != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	if err := s.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	// the funder is stored in its canonical form, so that it can be compared
+	funder, err := s.AccountKeeper.AddressCodec().BytesToString(from)
+	if err != nil {
+		return nil, err
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = s.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount, err := types.NewClawbackVestingAccount(baseAccount, funder, msg.StartTime, msg.LockupPeriods, msg.VestingPeriods)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	s.SetAccount(ctx, vestingAccount)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account") //nolint:staticcheck // TODO: switch to OpenTelemetry
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels( //nolint:staticcheck // TODO: switch to OpenTelemetry
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)}, //nolint:staticcheck // TODO: switch to OpenTelemetry
+				)
+			}
+		}
+	}()
+
+	if err = s.SendCoins(ctx, from, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	funder, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FunderAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+
+	addr, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	destAddress := msg.DestAddress
+	if destAddress == "" {
+		destAddress = msg.FunderAddress
+	}
+	dest, err := s.AccountKeeper.AddressCodec().StringToBytes(destAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid destination address: %s", err)
+	}
+
+	if s.BlockedAddr(dest) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", destAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	vestingAccount, err := s.getClawbackVestingAccount(ctx, addr, funder)
+	if err != nil {
+		return nil, err
+	}
+
+	unvested := vestingAccount.ComputeClawback(ctx.BlockTime())
+	if unvested.IsZero() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s has no unvested coins", msg.Address)
+	}
+
+	// the schedules must be updated before transferring coins, so that the
+	// unvested coins are no longer locked
+	s.SetAccount(ctx, vestingAccount)
+
+	// first, claw back from the spendable balance of the account
+	clawedBack := unvested.Min(s.SpendableCoins(ctx, addr))
+	if !clawedBack.IsZero() {
+		if err := s.SendCoins(ctx, addr, dest, clawedBack); err != nil {
+			return nil, err
+		}
+	}
+
+	// then, claw back the remaining bond denom coins from the unbonding
+	// delegations and the delegations of the account
+	if remaining := unvested.Sub(clawedBack...); !remaining.IsZero() && s.stakingKeeper != nil {
+		transferred, err := s.clawbackStaking(ctx, addr, dest, remaining)
+		if err != nil {
+			return nil, err
+		}
+
+		if !transferred.IsZero() {
+			// the transferred unbonding delegations will be returned to the
+			// destination, so the vesting account must track the undelegation
+			vestingAccount.TrackUndelegation(transferred)
+			s.SetAccount(ctx, vestingAccount)
+			clawedBack = clawedBack.Add(transferred...)
+		}
+	}
+
+	// the unvested coins have been removed from the schedules, so any coins
+	// that could not be recovered would otherwise become free coins of the
+	// account
+	if unrecovered := unvested.Sub(clawedBack...); !unrecovered.IsZero() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "cannot claw back %s of the unvested coins of account %s", unrecovered, msg.Address)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyDestination, destAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, clawedBack.String()),
+		),
+	)
+
+	return &types.MsgClawbackResponse{Amount: clawedBack}, nil
+}
+
+// clawbackStaking transfers up to amount bond denom coins to dest, first from
+// the unbonding delegations of addr and then by undelegating from its
+// delegations. The coins are transferred as unbonding delegations, which
+// complete at their original completion time. It returns the transferred coins.
+func (s msgServer) clawbackStaking(ctx sdk.Context, addr, dest sdk.AccAddress, amount sdk.Coins) (sdk.Coins, error) {
+	bondDenom, err := s.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	want := amount.AmountOf(bondDenom)
+	if !want.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+
+	transferred := math.ZeroInt()
+
+	unbondings, err := s.stakingKeeper.GetUnbondingDelegations(ctx, addr, stdmath.MaxUint16)
+	if err != nil {
+		return nil, err
+	}
+	for _, ubd := range unbondings {
+		if transferred.GTE(want) {
+			break
+		}
+
+		valAddr, err := s.stakingKeeper.ValidatorAddressCodec().StringToBytes(ubd.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		amt, err := s.stakingKeeper.TransferUnbonding(ctx, addr, dest, valAddr, want.Sub(transferred))
+		if err != nil {
+			return nil, err
+		}
+		transferred = transferred.Add(amt)
+	}
+
+	delegations, err := s.stakingKeeper.GetDelegatorDelegations(ctx, addr, stdmath.MaxUint16)
+	if err != nil {
+		return nil, err
+	}
+	for _, delegation := range delegations {
+		if transferred.GTE(want) {
+			break
+		}
+
+		valAddr, err := s.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		validator, err := s.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		unbondAmt := math.MinInt(validator.TokensFromShares(delegation.Shares).TruncateInt(), want.Sub(transferred))
+		if !unbondAmt.IsPositive() {
+			continue
+		}
+
+		shares, err := s.stakingKeeper.ValidateUnbondAmount(ctx, addr, valAddr, unbondAmt)
+		if err != nil {
+			return nil, err
+		}
+
+		_, returnAmount, err := s.stakingKeeper.Undelegate(ctx, addr, valAddr, shares)
+		if err != nil {
+			return nil, err
+		}
+
+		amt, err := s.stakingKeeper.TransferUnbonding(ctx, addr, dest, valAddr, returnAmount)
+		if err != nil {
+			return nil, err
+		}
+		transferred = transferred.Add(amt)
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(bondDenom, transferred)), nil
+}
+
+func (s msgServer) UpdateVestingFunder(goCtx context.Context, msg *types.MsgUpdateVestingFunder) (*types.MsgUpdateVestingFunderResponse, error) {
+	funder, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FunderAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+
+	newFunderBz, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.NewFunderAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new funder address: %s", err)
+	}
+	newFunder, err := s.AccountKeeper.AddressCodec().BytesToString(newFunderBz)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	vestingAccount, err := s.getClawbackVestingAccount(ctx, addr, funder)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingAccount.FunderAddress = newFunder
+	s.SetAccount(ctx, vestingAccount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateVestingFunder,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyNewFunder, newFunder),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+		),
+	)
+
+	return &types.MsgUpdateVestingFunderResponse{}, nil
+}
+
+// getClawbackVestingAccount returns the clawback vesting account at addr,
+// checking that funder is its funder.
+func (s msgServer) getClawbackVestingAccount(ctx context.Context, addr, funder sdk.AccAddress) (*types.ClawbackVestingAccount, error) {
+	acc := s.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	vestingAccount, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", addr)
+	}
+
+	// funders are compared by address bytes, not by their bech32 form
+	accFunder, err := s.AccountKeeper.AddressCodec().StringToBytes(vestingAccount.FunderAddress)
+	if err != nil || !bytes.Equal(accFunder, funder) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of account %s", funder, addr)
+	}
+
+	return vestingAccount, nil
+}
+
+// validatePeriods checks the given periods and returns the sum of their coins.
+func validatePeriods(name string, periods []types.Period) (sdk.Coins, error) {
+	var totalCoins sdk.Coins
+	for i, period := range periods {
+		if period.Length < 0 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %s period length of %d in period %d, length must not be negative", name, period.Length, i)
+		}
+
+		if err := validateAmount(period.Amount); err != nil {
+			return nil, err
+		}
+
+		totalCoins = totalCoins.Add(period.Amount...)
+	}
+
+	return totalCoins, nil
+}
+
 func validateAmount(amount sdk.Coins) error {
 	if !amount.IsValid() {
 		return sdkerrors.ErrInvalidCoins.Wrap(amount.String())
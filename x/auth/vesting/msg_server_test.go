@@ -1,6 +1,7 @@
 package vesting_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -22,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	vestingtestutil "github.com/cosmos/cosmos-sdk/x/auth/vesting/testutil"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -39,6 +42,7 @@ type VestingTestSuite struct {
 	ctx           sdk.Context
 	accountKeeper authkeeper.AccountKeeper
 	bankKeeper    *vestingtestutil.MockBankKeeper
+	stakingKeeper *vestingtestutil.MockStakingKeeper
 	msgServer     vestingtypes.MsgServer
 }
 
@@ -53,6 +57,7 @@ func (s *VestingTestSuite) SetupTest() {
 
 	ctrl := gomock.NewController(s.T())
 	s.bankKeeper = vestingtestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = vestingtestutil.NewMockStakingKeeper(ctrl)
 	s.accountKeeper = authkeeper.NewAccountKeeper(
 		encCfg.Codec,
 		storeService,
@@ -65,7 +70,7 @@ func (s *VestingTestSuite) SetupTest() {
 
 	vestingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
-	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper)
+	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper, s.stakingKeeper)
 }
 
 func (s *VestingTestSuite) TestCreateVestingAccount() {
@@ -474,6 +479,241 @@ func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
 	}
 }
 
+func (s *VestingTestSuite) TestCreateClawbackVestingAccount() {
+	periods := []vestingtypes.Period{
+		{Length: 10, Amount: sdk.NewCoins(periodCoin)},
+		{Length: 20, Amount: sdk.NewCoins(fooCoin)},
+	}
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgCreateClawbackVestingAccount
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "empty from address",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount([]byte{}, to1Addr, time.Now().Unix(), nil, periods),
+			expErr:    true,
+			expErrMsg: "invalid 'from' address",
+		},
+		{
+			name:      "invalid start time",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, 0, nil, periods),
+			expErr:    true,
+			expErrMsg: "invalid start time",
+		},
+		{
+			name:      "empty schedules",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), nil, nil),
+			expErr:    true,
+			expErrMsg: "lockup and vesting periods cannot both be empty",
+		},
+		{
+			name: "negative period length",
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), nil, []vestingtypes.Period{
+				{Length: -1, Amount: sdk.NewCoins(periodCoin)},
+			}),
+			expErr:    true,
+			expErrMsg: "invalid vesting period length",
+		},
+		{
+			name: "mismatched schedules",
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), []vestingtypes.Period{
+				{Length: 10, Amount: sdk.NewCoins(periodCoin)},
+			}, periods),
+			expErr:    true,
+			expErrMsg: "does not match vesting periods total",
+		},
+		{
+			name: "create for blocked address",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), nil, periods),
+			expErr:    true,
+			expErrMsg: "not allowed to receive funds",
+		},
+		{
+			name: "create a valid clawback vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin.Add(fooCoin)).Return(nil)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, sdk.NewCoins(periodCoin.Add(fooCoin))).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), []vestingtypes.Period{
+				{Length: 30, Amount: sdk.NewCoins(periodCoin.Add(fooCoin))},
+			}, periods),
+			expErr: false,
+		},
+		{
+			name: "create for existing account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), nil, periods),
+			expErr:    true,
+			expErrMsg: "already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := s.msgServer.CreateClawbackVestingAccount(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(fromAddr.String(), acc.FunderAddress)
+	s.Require().Equal(sdk.NewCoins(periodCoin.Add(fooCoin)), acc.OriginalVesting)
+}
+
+// setupClawbackAccount stores a clawback vesting account at to1Addr funded by
+// fromAddr, vesting 20foo after 10s and 100foo after 30s.
+func (s *VestingTestSuite) setupClawbackAccount() *vestingtypes.ClawbackVestingAccount {
+	baseAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr).(*authtypes.BaseAccount)
+	acc, err := vestingtypes.NewClawbackVestingAccount(baseAcc, fromAddr.String(), s.ctx.BlockTime().Unix(), nil, []vestingtypes.Period{
+		{Length: 10, Amount: sdk.NewCoins(periodCoin)},
+		{Length: 20, Amount: sdk.NewCoins(fooCoin)},
+	})
+	s.Require().NoError(err)
+	s.accountKeeper.SetAccount(s.ctx, acc)
+	return acc
+}
+
+func (s *VestingTestSuite) TestClawback() {
+	s.setupClawbackAccount()
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(15 * time.Second))
+
+	s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+	_, err := s.msgServer.Clawback(ctx, vestingtypes.NewMsgClawback(to2Addr, to1Addr, nil))
+	s.Require().ErrorContains(err, "is not the funder")
+
+	s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+	_, err = s.msgServer.Clawback(ctx, vestingtypes.NewMsgClawback(fromAddr, to2Addr, nil))
+	s.Require().ErrorContains(err, "does not exist")
+
+	s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(true)
+	_, err = s.msgServer.Clawback(ctx, vestingtypes.NewMsgClawback(fromAddr, to1Addr, to3Addr))
+	s.Require().ErrorContains(err, "not allowed to receive funds")
+
+	// 40foo are spendable, the remaining 60foo cannot be recovered from staking
+	cacheCtx, _ := ctx.CacheContext()
+	s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+	s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), to1Addr).Return(sdk.NewCoins(sdk.NewInt64Coin("foo", 40)))
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to1Addr, fromAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 40))).Return(nil)
+	s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("foo", nil)
+	s.stakingKeeper.EXPECT().GetUnbondingDelegations(gomock.Any(), to1Addr, gomock.Any()).Return(nil, nil)
+	s.stakingKeeper.EXPECT().GetDelegatorDelegations(gomock.Any(), to1Addr, gomock.Any()).Return(nil, nil)
+
+	_, err = s.msgServer.Clawback(cacheCtx, vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	s.Require().ErrorContains(err, "cannot claw back 60foo")
+
+	// all 100foo unvested coins are spendable
+	s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+	s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), to1Addr).Return(sdk.NewCoins(fooCoin))
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to1Addr, fromAddr, sdk.NewCoins(fooCoin)).Return(nil)
+
+	res, err := s.msgServer.Clawback(ctx, vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(fooCoin), res.Amount)
+
+	acc := s.accountKeeper.GetAccount(ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().Equal(sdk.NewCoins(periodCoin), acc.OriginalVesting)
+	s.Require().Equal(acc.StartTime+10, acc.EndTime)
+	s.Require().True(acc.GetUnvestedCoins(ctx.BlockTime()).IsZero())
+
+	// nothing left to claw back
+	s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+	_, err = s.msgServer.Clawback(ctx, vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil))
+	s.Require().ErrorContains(err, "has no unvested coins")
+}
+
+func (s *VestingTestSuite) TestClawbackDelegated() {
+	acc := s.setupClawbackAccount()
+	acc.TrackDelegation(s.ctx.BlockTime(), sdk.NewCoins(periodCoin.Add(fooCoin)), sdk.NewCoins(fooCoin))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	valAddr := sdk.ValAddress(to3Addr)
+	valAddrStr := valAddr.String()
+	s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(authcodec.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	// all 120foo are unvested: 20foo are spendable, 30foo are unbonding and 70foo
+	// are delegated
+	s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+	s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), to1Addr).Return(sdk.NewCoins(periodCoin))
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to1Addr, fromAddr, sdk.NewCoins(periodCoin)).Return(nil)
+	s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("foo", nil)
+	s.stakingKeeper.EXPECT().GetUnbondingDelegations(gomock.Any(), to1Addr, gomock.Any()).Return([]stakingtypes.UnbondingDelegation{
+		{DelegatorAddress: to1Addr.String(), ValidatorAddress: valAddrStr},
+	}, nil)
+	s.stakingKeeper.EXPECT().TransferUnbonding(gomock.Any(), to1Addr, fromAddr, valAddr, math.NewInt(100)).Return(math.NewInt(30), nil)
+	s.stakingKeeper.EXPECT().GetDelegatorDelegations(gomock.Any(), to1Addr, gomock.Any()).Return([]stakingtypes.Delegation{
+		stakingtypes.NewDelegation(to1Addr.String(), valAddrStr, math.LegacyNewDec(100)),
+	}, nil)
+	s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(stakingtypes.Validator{
+		OperatorAddress: valAddrStr,
+		Tokens:          math.NewInt(100),
+		DelegatorShares: math.LegacyNewDec(100),
+	}, nil)
+	s.stakingKeeper.EXPECT().ValidateUnbondAmount(gomock.Any(), to1Addr, valAddr, math.NewInt(70)).Return(math.LegacyNewDec(70), nil)
+	s.stakingKeeper.EXPECT().Undelegate(gomock.Any(), to1Addr, valAddr, math.LegacyNewDec(70)).Return(time.Time{}, math.NewInt(70), nil)
+	s.stakingKeeper.EXPECT().TransferUnbonding(gomock.Any(), to1Addr, fromAddr, valAddr, math.NewInt(70)).Return(math.NewInt(70), nil)
+
+	res, err := s.msgServer.Clawback(s.ctx, vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(periodCoin.Add(fooCoin)), res.Amount)
+
+	acc = s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().True(acc.OriginalVesting.IsZero())
+	s.Require().True(acc.DelegatedVesting.IsZero())
+	s.Require().True(acc.DelegatedFree.IsZero())
+	s.Require().NoError(acc.Validate())
+}
+
+func (s *VestingTestSuite) TestUpdateVestingFunder() {
+	s.setupClawbackAccount()
+
+	_, err := s.msgServer.UpdateVestingFunder(s.ctx, vestingtypes.NewMsgUpdateVestingFunder(to2Addr, to3Addr, to1Addr))
+	s.Require().ErrorContains(err, "is not the funder")
+
+	_, err = s.msgServer.UpdateVestingFunder(s.ctx, vestingtypes.NewMsgUpdateVestingFunder(fromAddr, to3Addr, to2Addr))
+	s.Require().ErrorContains(err, "does not exist")
+
+	_, err = s.msgServer.UpdateVestingFunder(s.ctx, vestingtypes.NewMsgUpdateVestingFunder(fromAddr, to3Addr, to1Addr))
+	s.Require().NoError(err)
+
+	acc := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().Equal(to3Addr.String(), acc.FunderAddress)
+
+	// the previous funder can no longer claw back
+	s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+	_, err = s.msgServer.Clawback(s.ctx, vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil))
+	s.Require().ErrorContains(err, "is not the funder")
+
+	// funders are stored canonical and compared by address bytes
+	msg := vestingtypes.NewMsgUpdateVestingFunder(to3Addr, fromAddr, to1Addr)
+	msg.FunderAddress = strings.ToUpper(msg.FunderAddress)
+	msg.NewFunderAddress = strings.ToUpper(msg.NewFunderAddress)
+	_, err = s.msgServer.UpdateVestingFunder(s.ctx, msg)
+	s.Require().NoError(err)
+
+	acc = s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().Equal(fromAddr.String(), acc.FunderAddress)
+}
+
 func TestVestingTestSuite(t *testing.T) {
 	suite.Run(t, new(VestingTestSuite))
 }
@@ -0,0 +1,12 @@
+package types
+
+// vesting module event types
+const (
+	EventTypeClawback            = "clawback"
+	EventTypeUpdateVestingFunder = "update_vesting_funder"
+
+	AttributeKeyFunder      = "funder"
+	AttributeKeyNewFunder   = "new_funder"
+	AttributeKeyAccount     = "account"
+	AttributeKeyDestination = "destination"
+)
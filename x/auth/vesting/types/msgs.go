@@ -8,6 +8,9 @@ var (
 	_ sdk.Msg = &MsgCreateVestingAccount{}
 	_ sdk.Msg = &MsgCreatePermanentLockedAccount{}
 	_ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
+	_ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg = &MsgClawback{}
+	_ sdk.Msg = &MsgUpdateVestingFunder{}
 )
 
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
@@ -39,3 +42,38 @@ func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTi
 		VestingPeriods: periods,
 	}
 }
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods []Period) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback. The destination
+// address is optional and defaults to the funder address.
+func NewMsgClawback(funderAddr, addr, destAddr sdk.AccAddress) *MsgClawback {
+	var dest string
+	if destAddr != nil {
+		dest = destAddr.String()
+	}
+
+	return &MsgClawback{
+		FunderAddress: funderAddr.String(),
+		Address:       addr.String(),
+		DestAddress:   dest,
+	}
+}
+
+// NewMsgUpdateVestingFunder returns a reference to a new MsgUpdateVestingFunder.
+func NewMsgUpdateVestingFunder(funderAddr, newFunderAddr, addr sdk.AccAddress) *MsgUpdateVestingFunder {
+	return &MsgUpdateVestingFunder{
+		FunderAddress:    funderAddr.String(),
+		NewFunderAddress: newFunderAddr.String(),
+		Address:          addr.String(),
+	}
+}
@@ -50,3 +50,66 @@ func (p Periods) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
 		%s`, strings.Join(periodsListString, ", ")))
 }
+
+// VestedCoins returns the sum of the coins of all periods that ended at the
+// given block time, for periods starting at startTime.
+func (p Periods) VestedCoins(startTime int64, blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	// track the start time of the next period
+	currentPeriodStartTime := startTime
+	for _, period := range p {
+		if blockTime.Unix()-currentPeriodStartTime < period.Length {
+			break
+		}
+
+		vestedCoins = vestedCoins.Add(period.Amount...)
+		currentPeriodStartTime += period.Length
+	}
+
+	return vestedCoins
+}
+
+// Truncate returns the periods that ended at the given block time, for periods
+// starting at startTime.
+func (p Periods) Truncate(startTime int64, blockTime time.Time) Periods {
+	var (
+		truncated              Periods
+		currentPeriodStartTime = startTime
+	)
+	for _, period := range p {
+		if blockTime.Unix()-currentPeriodStartTime < period.Length {
+			break
+		}
+
+		truncated = append(truncated, period)
+		currentPeriodStartTime += period.Length
+	}
+
+	return truncated
+}
+
+// Cap returns the periods with their amounts reduced so that their total does
+// not exceed limit. Coins are kept in the earliest periods. The length of a
+// period whose amount is reduced to zero is added to the next period, so that
+// the end time of the following periods is preserved.
+func (p Periods) Cap(limit sdk.Coins) Periods {
+	var (
+		capped        Periods
+		pendingLength int64
+		remaining     = limit
+	)
+	for _, period := range p {
+		amount := period.Amount.Min(remaining)
+		pendingLength += period.Length
+		if amount.IsZero() {
+			continue
+		}
+
+		capped = append(capped, Period{Length: pendingLength, Amount: amount})
+		remaining = remaining.Sub(amount...)
+		pendingLength = 0
+	}
+
+	return capped
+}
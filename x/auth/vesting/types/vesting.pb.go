@@ -288,6 +288,55 @@ func (m *PermanentLockedAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PermanentLockedAccount proto.InternalMessageInfo
 
+// ClawbackVestingAccount implements the VestingAccount interface. It has
+// separate lockup and vesting schedules: coins can only be spent once they are
+// both vested and unlocked. Unvested coins can be reclaimed by the funder of the
+// account through MsgClawback.
+type ClawbackVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	// funder_address is the address allowed to claw back unvested coins.
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// start_time is the time at which both schedules start, as unix timestamp (in seconds).
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// lockup_periods defines the schedule at which coins are unlocked.
+	LockupPeriods []Period `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3" json:"lockup_periods"`
+	// vesting_periods defines the schedule at which coins are vested.
+	VestingPeriods []Period `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+}
+
+func (m *ClawbackVestingAccount) Reset()         { *m = ClawbackVestingAccount{} }
+func (m *ClawbackVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*ClawbackVestingAccount) ProtoMessage()    {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{6}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "cosmos.vesting.v1beta1.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "cosmos.vesting.v1beta1.ContinuousVestingAccount")
@@ -295,6 +344,7 @@ func init() {
 	proto.RegisterType((*Period)(nil), "cosmos.vesting.v1beta1.Period")
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.PeriodicVestingAccount")
 	proto.RegisterType((*PermanentLockedAccount)(nil), "cosmos.vesting.v1beta1.PermanentLockedAccount")
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.ClawbackVestingAccount")
 }
 
 func init() {
@@ -302,47 +352,53 @@ func init() {
 }
 
 var fileDescriptor_89e80273ca606d6e = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0x34, 0x35, 0xda, 0x69, 0xed, 0xc7, 0x52, 0x4b, 0x5a, 0xe8, 0x66, 0x5d, 0x45, 0x62,
-	0xb0, 0x1b, 0xdb, 0x7a, 0x2a, 0x78, 0x30, 0x95, 0x82, 0xe8, 0x41, 0x82, 0x78, 0xf0, 0xb2, 0xcc,
-	0xee, 0x8e, 0xdb, 0xa1, 0xd9, 0x99, 0xb2, 0x33, 0x29, 0xe6, 0x1f, 0x14, 0x11, 0xf1, 0x6c, 0x2f,
-	0x9e, 0x44, 0x3c, 0xe5, 0xe0, 0xcd, 0x3f, 0x50, 0x10, 0x21, 0x78, 0xf2, 0x54, 0x25, 0x39, 0xe4,
-	0x6f, 0xc8, 0xce, 0xcc, 0x6e, 0x62, 0x93, 0x7a, 0x0c, 0x5e, 0x92, 0x9d, 0x99, 0xf7, 0x7d, 0x9f,
-	0x8f, 0x3c, 0x9b, 0x81, 0x37, 0x7d, 0xc6, 0x23, 0xc6, 0xab, 0x47, 0x98, 0x0b, 0x42, 0xc3, 0xea,
-	0xd1, 0xa6, 0x87, 0x05, 0xda, 0x4c, 0xd7, 0xce, 0x61, 0xcc, 0x04, 0x33, 0x56, 0x54, 0x95, 0x93,
-	0xee, 0xea, 0xaa, 0xb5, 0x25, 0x14, 0x11, 0xca, 0xaa, 0xf2, 0x53, 0x95, 0xae, 0x2d, 0x87, 0x2c,
-	0x64, 0xf2, 0xb1, 0x9a, 0x3c, 0xe9, 0x5d, 0x53, 0xc3, 0x78, 0x88, 0xe3, 0x0c, 0xc3, 0x67, 0x84,
-	0xea, 0xf3, 0x55, 0x75, 0xee, 0xaa, 0x46, 0x8d, 0xf6, 0x77, 0x2b, 0x6a, 0x8a, 0xfd, 0xac, 0x35,
-	0x59, 0xa8, 0x73, 0xfb, 0xfb, 0x34, 0x34, 0x6a, 0x88, 0xe3, 0xe7, 0x8a, 0xdb, 0x03, 0xdf, 0x67,
-	0x4d, 0x2a, 0x8c, 0x47, 0x70, 0x2e, 0x01, 0x73, 0x91, 0x5a, 0x17, 0x81, 0x05, 0xca, 0xb3, 0x5b,
-	0x96, 0xa3, 0x67, 0xcb, 0x01, 0x7a, 0x9a, 0x93, 0xb4, 0xeb, 0xbe, 0xda, 0x74, 0xe7, 0xac, 0x04,
-	0xea, 0xb3, 0xde, 0x60, 0xcb, 0x78, 0x03, 0xe0, 0x22, 0x8b, 0x49, 0x48, 0x28, 0x6a, 0xb8, 0xda,
-	0x82, 0xe2, 0x94, 0x95, 0x2f, 0xcf, 0x6e, 0xad, 0xa6, 0xf3, 0x92, 0xfa, 0x6c, 0xde, 0x2e, 0x23,
-	0xb4, 0xb6, 0x77, 0x7a, 0x56, 0xca, 0x7d, 0xfe, 0x55, 0x2a, 0x87, 0x44, 0xec, 0x37, 0x3d, 0xc7,
-	0x67, 0x91, 0x16, 0xa6, 0xbf, 0x36, 0x78, 0x70, 0x50, 0x15, 0xad, 0x43, 0xcc, 0x65, 0x03, 0x7f,
-	0xdf, 0x6f, 0x57, 0xe6, 0x1a, 0x38, 0x44, 0x7e, 0xcb, 0x4d, 0xac, 0xe1, 0x9f, 0xfa, 0xed, 0x0a,
-	0xa8, 0x2f, 0xa4, 0xd0, 0x5a, 0xa0, 0x71, 0x0c, 0xe0, 0x7c, 0x80, 0x93, 0x42, 0x81, 0x03, 0xf7,
-	0x65, 0x8c, 0x71, 0x31, 0x3f, 0x29, 0x32, 0x57, 0x33, 0xe0, 0xbd, 0x18, 0x63, 0xe3, 0x2d, 0x80,
-	0x4b, 0x03, 0x2a, 0xa9, 0x35, 0xd3, 0x93, 0x62, 0xb3, 0x98, 0x61, 0xa7, 0xde, 0xac, 0xc2, 0x2b,
-	0x98, 0x06, 0xae, 0x20, 0x11, 0x2e, 0x5e, 0xb2, 0x40, 0x39, 0x5f, 0xbf, 0x8c, 0x69, 0xf0, 0x8c,
-	0x44, 0x78, 0xe7, 0xd6, 0xf1, 0x87, 0x52, 0xee, 0x75, 0xbf, 0x5d, 0x59, 0x1f, 0xc2, 0x18, 0x0d,
-	0x8e, 0xfd, 0x0d, 0xc0, 0xe2, 0x2e, 0xa3, 0x82, 0xd0, 0x26, 0x6b, 0xf2, 0x73, 0xa9, 0xf2, 0xe0,
-	0xb2, 0x4c, 0x95, 0x96, 0x7a, 0x2e, 0x5d, 0x15, 0x67, 0xfc, 0x7b, 0xe2, 0x8c, 0xc2, 0xe8, 0x9c,
-	0x19, 0xde, 0x68, 0x72, 0xd7, 0x21, 0xe4, 0x02, 0xc5, 0x42, 0xa9, 0x98, 0x92, 0x2a, 0x66, 0xe4,
-	0x8e, 0xd4, 0x71, 0x27, 0xd5, 0x71, 0x63, 0x48, 0xc7, 0x45, 0x84, 0xed, 0x8f, 0x00, 0x5e, 0x7b,
-	0x88, 0x1b, 0xa8, 0x95, 0x79, 0x34, 0x41, 0x29, 0x3b, 0xb7, 0x53, 0xae, 0xd6, 0x10, 0xd7, 0xb1,
-	0x74, 0xec, 0x13, 0x00, 0x0b, 0x4f, 0x71, 0x4c, 0x58, 0x60, 0xac, 0xc0, 0x42, 0x03, 0xd3, 0x50,
-	0xec, 0x4b, 0x2e, 0xf9, 0xba, 0x5e, 0x19, 0x2d, 0x58, 0x40, 0x91, 0xe4, 0x38, 0xb1, 0x97, 0x4f,
-	0x03, 0xda, 0x27, 0x53, 0x70, 0x45, 0xb1, 0x23, 0xfe, 0x7f, 0x17, 0x09, 0xa3, 0x0e, 0x17, 0x52,
-	0xf4, 0x43, 0x49, 0x92, 0xeb, 0x7f, 0x04, 0xf3, 0x22, 0x74, 0xa5, 0xa5, 0x36, 0x93, 0xd8, 0xa4,
-	0x94, 0xce, 0xeb, 0x12, 0x75, 0xc2, 0x77, 0x2a, 0xe9, 0x4f, 0x77, 0x7d, 0xc8, 0xb0, 0xf1, 0x16,
-	0xd8, 0x5f, 0x81, 0x74, 0x27, 0x42, 0x14, 0x53, 0xf1, 0x84, 0xf9, 0x07, 0x38, 0x98, 0x64, 0xca,
-	0xee, 0x27, 0x54, 0x7f, 0x7c, 0xd9, 0x58, 0x18, 0x30, 0xb5, 0xee, 0x3a, 0xf7, 0xb6, 0xc7, 0xb0,
-	0x1f, 0x43, 0xb1, 0xf6, 0xf8, 0xb4, 0x6b, 0x82, 0x4e, 0xd7, 0x04, 0xbf, 0xbb, 0x26, 0x78, 0xd7,
-	0x33, 0x73, 0x9d, 0x9e, 0x99, 0xfb, 0xd9, 0x33, 0x73, 0x2f, 0x36, 0xff, 0x99, 0x9e, 0x57, 0xfa,
-	0x4a, 0xd2, 0x37, 0xa7, 0x0c, 0x93, 0x57, 0x90, 0x97, 0xd2, 0xf6, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xfd, 0x50, 0x2f, 0xf1, 0x58, 0x07, 0x00, 0x00,
+	// 732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6b, 0x13, 0x4d,
+	0x18, 0xce, 0x34, 0x69, 0xbe, 0xaf, 0xd3, 0x36, 0x6d, 0x97, 0x7e, 0x21, 0x2d, 0x74, 0x93, 0x2f,
+	0xdf, 0x87, 0xc4, 0x60, 0x37, 0xf6, 0x87, 0x97, 0x82, 0x48, 0x53, 0x29, 0x88, 0x1e, 0x4a, 0x14,
+	0x0f, 0x5e, 0x96, 0xd9, 0xdd, 0xe9, 0x76, 0x49, 0x76, 0x26, 0xec, 0xcc, 0x56, 0xf3, 0x1f, 0x14,
+	0x11, 0xf1, 0x6c, 0x2f, 0x9e, 0x44, 0x3c, 0xf5, 0xd0, 0x9b, 0x27, 0x6f, 0x05, 0x11, 0x4a, 0x4f,
+	0x9e, 0xaa, 0xb4, 0x87, 0xfe, 0x1b, 0xb2, 0x33, 0xb3, 0x69, 0x9a, 0x6e, 0x05, 0x11, 0xa2, 0x97,
+	0x64, 0x67, 0xde, 0x77, 0xde, 0xe7, 0x79, 0xde, 0xf7, 0xd9, 0x65, 0xe0, 0xff, 0x36, 0x65, 0x3e,
+	0x65, 0xb5, 0x6d, 0xcc, 0xb8, 0x47, 0xdc, 0xda, 0xf6, 0x82, 0x85, 0x39, 0x5a, 0x88, 0xd7, 0x46,
+	0x3b, 0xa0, 0x9c, 0x6a, 0x79, 0x99, 0x65, 0xc4, 0xbb, 0x2a, 0x6b, 0x76, 0x0a, 0xf9, 0x1e, 0xa1,
+	0x35, 0xf1, 0x2b, 0x53, 0x67, 0xa7, 0x5d, 0xea, 0x52, 0xf1, 0x58, 0x8b, 0x9e, 0xd4, 0xae, 0xae,
+	0x60, 0x2c, 0xc4, 0x70, 0x17, 0xc3, 0xa6, 0x1e, 0x51, 0xf1, 0x19, 0x19, 0x37, 0xe5, 0x41, 0x85,
+	0x76, 0xf1, 0x28, 0x0a, 0xf9, 0x56, 0xf7, 0x68, 0xb4, 0x90, 0xf1, 0xf2, 0xe7, 0x0c, 0xd4, 0xea,
+	0x88, 0xe1, 0xc7, 0x92, 0xdb, 0xaa, 0x6d, 0xd3, 0x90, 0x70, 0xed, 0x1e, 0x1c, 0x8b, 0xc0, 0x4c,
+	0x24, 0xd7, 0x05, 0x50, 0x02, 0x95, 0xd1, 0xc5, 0x92, 0xa1, 0x6a, 0x8b, 0x02, 0xaa, 0x9a, 0x11,
+	0x1d, 0x57, 0xe7, 0xea, 0x99, 0xc3, 0xe3, 0x22, 0x68, 0x8c, 0x5a, 0xe7, 0x5b, 0xda, 0x0b, 0x00,
+	0x27, 0x69, 0xe0, 0xb9, 0x1e, 0x41, 0x2d, 0x53, 0xb5, 0xa0, 0x30, 0x54, 0x4a, 0x57, 0x46, 0x17,
+	0x67, 0xe2, 0x7a, 0x51, 0x7e, 0xb7, 0xde, 0x1a, 0xf5, 0x48, 0x7d, 0xfd, 0xe0, 0xb8, 0x98, 0x7a,
+	0xff, 0xb5, 0x58, 0x71, 0x3d, 0xbe, 0x15, 0x5a, 0x86, 0x4d, 0x7d, 0x25, 0x4c, 0xfd, 0xcd, 0x33,
+	0xa7, 0x59, 0xe3, 0x9d, 0x36, 0x66, 0xe2, 0x00, 0x7b, 0x7d, 0xb6, 0x57, 0x1d, 0x6b, 0x61, 0x17,
+	0xd9, 0x1d, 0x33, 0x6a, 0x0d, 0x7b, 0x77, 0xb6, 0x57, 0x05, 0x8d, 0x89, 0x18, 0x5a, 0x09, 0xd4,
+	0x76, 0x00, 0xcc, 0x39, 0x38, 0x4a, 0xe4, 0xd8, 0x31, 0x37, 0x03, 0x8c, 0x0b, 0xe9, 0x41, 0x91,
+	0x19, 0xef, 0x02, 0xaf, 0x07, 0x18, 0x6b, 0x2f, 0x01, 0x9c, 0x3a, 0xa7, 0x12, 0xb7, 0x26, 0x33,
+	0x28, 0x36, 0x93, 0x5d, 0xec, 0xb8, 0x37, 0x33, 0xf0, 0x6f, 0x4c, 0x1c, 0x93, 0x7b, 0x3e, 0x2e,
+	0x0c, 0x97, 0x40, 0x25, 0xdd, 0xf8, 0x0b, 0x13, 0xe7, 0x91, 0xe7, 0xe3, 0x95, 0x6b, 0x3b, 0x6f,
+	0x8a, 0xa9, 0xe7, 0x67, 0x7b, 0xd5, 0xb9, 0x1e, 0x8c, 0xcb, 0xc6, 0x29, 0x7f, 0x02, 0xb0, 0xb0,
+	0x46, 0x09, 0xf7, 0x48, 0x48, 0x43, 0xd6, 0xe7, 0x2a, 0x0b, 0x4e, 0x0b, 0x57, 0x29, 0xa9, 0x7d,
+	0xee, 0xaa, 0x1a, 0xc9, 0xef, 0x89, 0x71, 0x19, 0x46, 0xf9, 0x4c, 0xb3, 0x2e, 0x3b, 0x77, 0x0e,
+	0x42, 0xc6, 0x51, 0xc0, 0xa5, 0x8a, 0x21, 0xa1, 0x62, 0x44, 0xec, 0x08, 0x1d, 0x37, 0x62, 0x1d,
+	0xff, 0xf5, 0xe8, 0xb8, 0x8a, 0x70, 0xf9, 0x2d, 0x80, 0xff, 0xdc, 0xc5, 0x2d, 0xd4, 0xc1, 0xce,
+	0xc5, 0xc8, 0x20, 0xa4, 0xac, 0x5c, 0x8f, 0xb9, 0x96, 0x7a, 0xb8, 0x26, 0xd2, 0x29, 0xef, 0x02,
+	0x98, 0xdd, 0xc0, 0x81, 0x47, 0x1d, 0x2d, 0x0f, 0xb3, 0x2d, 0x4c, 0x5c, 0xbe, 0x25, 0xb8, 0xa4,
+	0x1b, 0x6a, 0xa5, 0x75, 0x60, 0x16, 0xf9, 0x82, 0xe3, 0xc0, 0x5e, 0x3e, 0x05, 0x58, 0xde, 0x1d,
+	0x82, 0x79, 0xc9, 0xce, 0xb3, 0xff, 0x38, 0x4b, 0x68, 0x0d, 0x38, 0x11, 0xa3, 0xb7, 0x05, 0x49,
+	0xa6, 0xbe, 0x08, 0xfa, 0x55, 0xe8, 0x52, 0x4b, 0x7d, 0x24, 0x6a, 0x93, 0x54, 0x9a, 0x53, 0x29,
+	0x32, 0xc2, 0x56, 0xaa, 0xf1, 0xe8, 0xfe, 0xed, 0x69, 0x58, 0x72, 0x0b, 0xca, 0x1f, 0x80, 0xe8,
+	0x8e, 0x8f, 0x08, 0x26, 0xfc, 0x01, 0xb5, 0x9b, 0xd8, 0x19, 0xa4, 0xcb, 0x6e, 0x47, 0x54, 0x8f,
+	0xf6, 0xe7, 0x27, 0xce, 0x99, 0x96, 0x6e, 0x1a, 0xcb, 0x4b, 0x09, 0xec, 0x13, 0x28, 0x96, 0x3f,
+	0xa6, 0x61, 0x7e, 0xad, 0x85, 0x9e, 0x5a, 0xc8, 0x6e, 0xfe, 0x86, 0xd9, 0xde, 0x81, 0xb9, 0xcd,
+	0x90, 0x38, 0x38, 0x30, 0x91, 0xe3, 0x04, 0x98, 0x31, 0x31, 0xdf, 0x91, 0x7a, 0xe1, 0x68, 0x7f,
+	0x7e, 0x5a, 0x01, 0xac, 0xca, 0xc8, 0x43, 0x1e, 0x78, 0xc4, 0x6d, 0x8c, 0xcb, 0x7c, 0xb5, 0xd9,
+	0x67, 0x8e, 0x74, 0xbf, 0x39, 0x36, 0x60, 0xae, 0x45, 0xed, 0x66, 0xd8, 0xee, 0x7a, 0x23, 0xf3,
+	0xb3, 0xde, 0x18, 0x97, 0x05, 0x64, 0x80, 0x25, 0xd9, 0x6d, 0xf8, 0x57, 0xed, 0x96, 0x3c, 0xc3,
+	0x5b, 0xcb, 0xfd, 0x33, 0x4c, 0x1e, 0x54, 0xfd, 0xfe, 0xc1, 0x89, 0x0e, 0x0e, 0x4f, 0x74, 0xf0,
+	0xed, 0x44, 0x07, 0xaf, 0x4e, 0xf5, 0xd4, 0xe1, 0xa9, 0x9e, 0xfa, 0x72, 0xaa, 0xa7, 0x9e, 0x2c,
+	0xfc, 0xf0, 0x0b, 0xf0, 0x4c, 0x5d, 0x2b, 0xd4, 0xed, 0x47, 0x7c, 0x10, 0xac, 0xac, 0xb8, 0x58,
+	0x2c, 0x7d, 0x1f, 0x00, 0x12, 0x12, 0x7b, 0xad, 0x1c, 0x09, 0x00, 0x00,
 }
 
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -633,6 +689,81 @@ func (m *PermanentLockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -760,6 +891,38 @@ func (m *PermanentLockedAccount) Size() (n int) {
 	return n
 }
 
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovVesting(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1492,6 +1655,211 @@ func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVesting(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -518,3 +518,195 @@ func (plva PermanentLockedAccount) Validate() error {
 
 	return plva.BaseVestingAccount.Validate()
 }
+
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount. An empty
+// lockup (or vesting) schedule unlocks (or vests) all coins at the start time.
+func NewClawbackVestingAccount(
+	baseAcc *authtypes.BaseAccount, funder string, startTime int64, lockupPeriods, vestingPeriods Periods,
+) (*ClawbackVestingAccount, error) {
+	originalVesting := vestingPeriods.TotalAmount()
+	if len(vestingPeriods) == 0 {
+		originalVesting = lockupPeriods.TotalAmount()
+		vestingPeriods = Periods{{Length: 0, Amount: originalVesting}}
+	}
+	if len(lockupPeriods) == 0 {
+		lockupPeriods = Periods{{Length: 0, Amount: originalVesting}}
+	}
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:      baseAcc,
+		OriginalVesting:  originalVesting,
+		DelegatedFree:    sdk.NewCoins(),
+		DelegatedVesting: sdk.NewCoins(),
+		EndTime:          startTime + max(lockupPeriods.TotalLength(), vestingPeriods.TotalLength()),
+	}
+
+	clawbackVestingAccount := &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder,
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+
+	return clawbackVestingAccount, clawbackVestingAccount.Validate()
+}
+
+// GetVestedCoins returns the total number of coins vested by the vesting
+// schedule, regardless of the lockup schedule. If no coins are vested, nil is
+// returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return Periods(cva.VestingPeriods).VestedCoins(cva.StartTime, blockTime)
+}
+
+// GetUnlockedCoins returns the total number of coins unlocked by the lockup
+// schedule, regardless of the vesting schedule. If no coins are unlocked, nil
+// is returned.
+func (cva ClawbackVestingAccount) GetUnlockedCoins(blockTime time.Time) sdk.Coins {
+	return Periods(cva.LockupPeriods).VestedCoins(cva.StartTime, blockTime)
+}
+
+// GetUnvestedCoins returns the total number of coins not yet vested by the
+// vesting schedule. These are the coins that can be clawed back.
+func (cva ClawbackVestingAccount) GetUnvestedCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...)
+}
+
+// GetVestingCoins returns the total number of coins that are either unvested or
+// still locked. If no coins are vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime).Min(cva.GetUnlockedCoins(blockTime))...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting and lockup start for a clawback
+// vesting account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetFunderAddress returns the address allowed to claw back unvested coins.
+func (cva ClawbackVestingAccount) GetFunderAddress() string {
+	return cva.FunderAddress
+}
+
+// GetLockupPeriods returns the lockup periods of the clawback vesting account.
+func (cva ClawbackVestingAccount) GetLockupPeriods() Periods {
+	return cva.LockupPeriods
+}
+
+// GetVestingPeriods returns the vesting periods of the clawback vesting account.
+func (cva ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return cva.VestingPeriods
+}
+
+// ComputeClawback removes the unvested coins from the account schedules and
+// returns them. The vesting schedule is truncated to the periods vested at the
+// given block time, and the lockup schedule is capped to the remaining original
+// vesting amount. Delegated vesting coins exceeding the remaining vesting coins
+// are accounted as delegated free, since they no longer belong to a schedule.
+//
+// The caller is responsible for transferring the returned coins out of the
+// account, and for tracking any undelegation made to do so.
+func (cva *ClawbackVestingAccount) ComputeClawback(blockTime time.Time) sdk.Coins {
+	unvested := cva.GetUnvestedCoins(blockTime)
+	if unvested.IsZero() {
+		return unvested
+	}
+
+	cva.VestingPeriods = Periods(cva.VestingPeriods).Truncate(cva.StartTime, blockTime)
+	cva.OriginalVesting = Periods(cva.VestingPeriods).TotalAmount()
+	cva.LockupPeriods = Periods(cva.LockupPeriods).Cap(cva.OriginalVesting)
+	cva.EndTime = cva.StartTime + max(Periods(cva.LockupPeriods).TotalLength(), Periods(cva.VestingPeriods).TotalLength())
+
+	vesting := cva.GetVestingCoins(blockTime)
+	excess := cva.DelegatedVesting.Sub(cva.DelegatedVesting.Min(vesting)...)
+	if !excess.IsZero() {
+		cva.DelegatedVesting = cva.DelegatedVesting.Sub(excess...)
+		cva.DelegatedFree = cva.DelegatedFree.Add(excess...)
+	}
+
+	return unvested
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if cva.FunderAddress == "" {
+		return errors.New("funder address cannot be empty")
+	}
+	if cva.GetStartTime() > cva.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+
+	lockupEnd, err := validatePeriods("lockup", cva.StartTime, cva.LockupPeriods)
+	if err != nil {
+		return err
+	}
+	vestingEnd, err := validatePeriods("vesting", cva.StartTime, cva.VestingPeriods)
+	if err != nil {
+		return err
+	}
+	if max(lockupEnd, vestingEnd) != cva.EndTime {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+
+	lockupTotal := Periods(cva.LockupPeriods).TotalAmount()
+	vestingTotal := Periods(cva.VestingPeriods).TotalAmount()
+	if !lockupTotal.Equal(vestingTotal) {
+		return fmt.Errorf("lockup periods total (%v) does not match vesting periods total (%v)", lockupTotal, vestingTotal)
+	}
+	if !vestingTotal.Equal(cva.OriginalVesting) {
+		return fmt.Errorf("original vesting coins (%v) does not match the sum of all coins in vesting periods (%v)", cva.OriginalVesting, vestingTotal)
+	}
+
+	// a fully clawed back account has no original vesting coins left
+	if cva.OriginalVesting.IsZero() {
+		if !cva.DelegatedVesting.IsZero() {
+			return errors.New("delegated vesting amount cannot be greater than original vesting amount")
+		}
+		return cva.BaseAccount.Validate()
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+// validatePeriods checks that the periods starting at startTime are well formed
+// and returns their end time.
+func validatePeriods(name string, startTime int64, periods Periods) (int64, error) {
+	endTime := startTime
+	for i, p := range periods {
+		if p.Length < 0 {
+			return 0, fmt.Errorf("%s period #%d has a negative length: %d", name, i, p.Length)
+		}
+		endTime += p.Length
+
+		if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+			return 0, fmt.Errorf("%s period #%d has invalid coins: %s", name, i, p.Amount.String())
+		}
+	}
+	if endTime < startTime {
+		return 0, fmt.Errorf("cumulative %s endTime overflowed, and/or is less than startTime", name)
+	}
+
+	return endTime, nil
+}
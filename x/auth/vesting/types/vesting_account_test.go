@@ -883,6 +883,164 @@ func TestGenesisAccountValidate(t *testing.T) {
 	}
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+
+	// vests half after 12h and the rest after 24h, unlocks everything after 18h
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(18 * 60 * 60), Amount: origCoins},
+	}
+
+	cva, err := types.NewClawbackVestingAccount(bacc, funder.String(), now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), cva.EndTime)
+	require.Equal(t, origCoins, cva.OriginalVesting)
+
+	// require no coins vested or unlocked at the beginning of the schedules
+	require.Nil(t, cva.GetVestedCoins(now))
+	require.Nil(t, cva.GetUnlockedCoins(now))
+	require.Equal(t, origCoins, cva.GetVestingCoins(now))
+	require.Equal(t, origCoins, cva.LockedCoins(now))
+
+	// require half of the coins vested but still locked after 12h
+	require.Equal(t, vestingPeriods[0].Amount, cva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, origCoins, cva.GetVestingCoins(now.Add(12*time.Hour)))
+	require.Equal(t, vestingPeriods[1].Amount, cva.GetUnvestedCoins(now.Add(12*time.Hour)))
+
+	// require the vested coins to be spendable once unlocked
+	require.Equal(t, origCoins, cva.GetUnlockedCoins(now.Add(18*time.Hour)))
+	require.Equal(t, vestingPeriods[1].Amount, cva.GetVestingCoins(now.Add(18*time.Hour)))
+	require.Equal(t, vestingPeriods[1].Amount, cva.LockedCoins(now.Add(18*time.Hour)))
+
+	// require all coins vested and unlocked at the end of the schedules
+	require.Equal(t, origCoins, cva.GetVestedCoins(now.Add(24*time.Hour)))
+	require.True(t, cva.GetVestingCoins(now.Add(24*time.Hour)).IsZero())
+	require.True(t, cva.LockedCoins(now.Add(24*time.Hour)).IsZero())
+
+	// require an empty lockup schedule to unlock all coins at the start time
+	cva, err = types.NewClawbackVestingAccount(bacc, funder.String(), now.Unix(), nil, vestingPeriods)
+	require.NoError(t, err)
+	require.Equal(t, origCoins, cva.GetUnlockedCoins(now))
+	require.Equal(t, vestingPeriods[1].Amount, cva.LockedCoins(now.Add(12*time.Hour)))
+}
+
+func TestTrackDelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(18 * 60 * 60), Amount: origCoins},
+	}
+
+	// require vested but locked coins to be delegated as vesting
+	cva, err := types.NewClawbackVestingAccount(bacc, funder.String(), now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, err)
+	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, cva.DelegatedVesting)
+	require.True(t, cva.DelegatedFree.IsZero())
+
+	// require vested and unlocked coins to be delegated as free
+	cva, err = types.NewClawbackVestingAccount(bacc, funder.String(), now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, err)
+	cva.TrackDelegation(now.Add(18*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedFree)
+
+	// require undelegation to release delegated free coins first
+	cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedVesting)
+	require.True(t, cva.DelegatedFree.IsZero())
+}
+
+func TestComputeClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(24 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}},
+	}
+
+	cva, err := types.NewClawbackVestingAccount(bacc, funder.String(), now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, err)
+
+	// delegate all coins while they are vesting
+	cva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, cva.DelegatedVesting)
+
+	// claw back after the first vesting period
+	clawback := cva.ComputeClawback(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, clawback)
+	require.Equal(t, vestingPeriods[0].Amount, cva.OriginalVesting)
+	require.Equal(t, vestingPeriods[:1], types.Periods(cva.VestingPeriods))
+	require.Equal(t, types.Periods{
+		lockupPeriods[0],
+		{Length: int64(24 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}, types.Periods(cva.LockupPeriods))
+	require.Equal(t, now.Add(30*time.Hour).Unix(), cva.EndTime)
+
+	// require the delegated vesting coins exceeding the remaining vesting coins
+	// to be delegated free
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)}, cva.DelegatedFree)
+	require.NoError(t, cva.Validate())
+
+	// require nothing to claw back once all remaining coins are vested
+	require.True(t, cva.ComputeClawback(now.Add(12*time.Hour)).IsZero())
+
+	// require everything to be clawed back before the start time
+	cva, err = types.NewClawbackVestingAccount(bacc, funder.String(), now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, err)
+	require.Equal(t, origCoins, cva.ComputeClawback(now.Add(-time.Hour)))
+	require.True(t, cva.OriginalVesting.IsZero())
+	require.Empty(t, cva.VestingPeriods)
+	require.Empty(t, cva.LockupPeriods)
+	require.True(t, cva.LockedCoins(now).IsZero())
+	require.NoError(t, cva.Validate())
+}
+
+func TestValidateClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	periods := types.Periods{types.Period{Length: 100, Amount: origCoins}}
+
+	_, err := types.NewClawbackVestingAccount(bacc, "", now.Unix(), periods, periods)
+	require.ErrorContains(t, err, "funder address cannot be empty")
+
+	_, err = types.NewClawbackVestingAccount(bacc, funder.String(), now.Unix(), types.Periods{
+		types.Period{Length: 100, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}, periods)
+	require.ErrorContains(t, err, "does not match vesting periods total")
+
+	_, err = types.NewClawbackVestingAccount(bacc, funder.String(), now.Unix(), nil, types.Periods{
+		types.Period{Length: -1, Amount: origCoins},
+	})
+	require.ErrorContains(t, err, "negative length")
+
+	cva, err := types.NewClawbackVestingAccount(bacc, funder.String(), now.Unix(), periods, periods)
+	require.NoError(t, err)
+	cva.EndTime = now.Unix() + 50
+	require.ErrorContains(t, cva.Validate(), "vesting end time does not match")
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
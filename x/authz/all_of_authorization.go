@@ -0,0 +1,141 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// gasCostPerAuthorization is the gas consumed for each authorization of an
+// AllOfAuthorization checked on execution.
+const gasCostPerAuthorization = uint64(10)
+
+var (
+	_ Authorization                    = &AllOfAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &AllOfAuthorization{}
+)
+
+// NewAllOfAuthorization creates a new AllOfAuthorization object.
+func NewAllOfAuthorization(authorizations ...Authorization) (*AllOfAuthorization, error) {
+	anys := make([]*cdctypes.Any, len(authorizations))
+	for i, authorization := range authorizations {
+		any, err := cdctypes.NewAnyWithValue(authorization)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return &AllOfAuthorization{Authorizations: anys}, nil
+}
+
+// GetAuthorizations returns the cached authorizations of the AllOfAuthorization.
+func (a AllOfAuthorization) GetAuthorizations() ([]Authorization, error) {
+	authorizations := make([]Authorization, len(a.Authorizations))
+	for i, any := range a.Authorizations {
+		if any == nil {
+			return nil, sdkerrors.ErrInvalidType.Wrap("authorization is nil")
+		}
+		authorization, ok := any.GetCachedValue().(Authorization)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Authorization)(nil), any.GetCachedValue())
+		}
+		authorizations[i] = authorization
+	}
+
+	return authorizations, nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a AllOfAuthorization) MsgTypeURL() string {
+	authorizations, err := a.GetAuthorizations()
+	if err != nil || len(authorizations) == 0 {
+		return ""
+	}
+
+	return authorizations[0].MsgTypeURL()
+}
+
+// Accept implements Authorization.Accept. The message is accepted when all the
+// authorizations accept it, in which case each of them is updated. The grant is
+// deleted as soon as one of them is exhausted.
+func (a AllOfAuthorization) Accept(ctx context.Context, msg sdk.Msg) (AcceptResponse, error) {
+	authorizations, err := a.GetAuthorizations()
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	updated := make([]Authorization, len(authorizations))
+	exhausted := false
+	for i, authorization := range authorizations {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerAuthorization, "all of authorization")
+
+		resp, err := authorization.Accept(ctx, msg)
+		if err != nil {
+			return AcceptResponse{}, err
+		}
+		if !resp.Accept {
+			return AcceptResponse{Delete: resp.Delete}, nil
+		}
+
+		exhausted = exhausted || resp.Delete
+		updated[i] = authorization
+		if resp.Updated != nil {
+			updated[i] = resp.Updated
+		}
+	}
+
+	if exhausted {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	allOf, err := NewAllOfAuthorization(updated...)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	return AcceptResponse{Accept: true, Updated: allOf}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a AllOfAuthorization) ValidateBasic() error {
+	authorizations, err := a.GetAuthorizations()
+	if err != nil {
+		return err
+	}
+	if len(authorizations) == 0 {
+		return errors.New("authorizations cannot be empty")
+	}
+
+	msgTypeURL := authorizations[0].MsgTypeURL()
+	for i, authorization := range authorizations {
+		if _, ok := authorization.(*AllOfAuthorization); ok {
+			return fmt.Errorf("authorization %d: all of authorizations cannot be nested", i)
+		}
+		if authorization.MsgTypeURL() != msgTypeURL {
+			return fmt.Errorf("authorization %d: msg type %s differs from %s", i, authorization.MsgTypeURL(), msgTypeURL)
+		}
+		if err := authorization.ValidateBasic(); err != nil {
+			return fmt.Errorf("authorization %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a AllOfAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, any := range a.Authorizations {
+		var authorization Authorization
+		if err := unpacker.UnpackAny(any, &authorization); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
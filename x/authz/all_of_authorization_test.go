@@ -0,0 +1,87 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestAllOfAuthorization(t *testing.T) {
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(authz.ModuleName), storetypes.NewTransientStoreKey("transient_test")).Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	send := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))
+
+	a, err := authz.NewAllOfAuthorization(
+		authz.NewMaxExecutionsAuthorization(msgTypeURL, 3),
+		banktypes.NewPeriodicSendAuthorization(time.Hour, coins),
+	)
+	require.NoError(t, err)
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, msgTypeURL, a.MsgTypeURL())
+
+	t.Log("verify all the authorizations are updated and gas is consumed")
+	gasBefore := ctx.GasMeter().GasConsumed()
+	resp, err := a.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Greater(t, ctx.GasMeter().GasConsumed(), gasBefore)
+
+	updated, err := resp.Updated.(*authz.AllOfAuthorization).GetAuthorizations()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), updated[0].(*authz.MaxExecutionsAuthorization).RemainingExecutions)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), updated[1].(*banktypes.PeriodicSendAuthorization).PeriodCanSpend)
+
+	t.Log("verify the message is rejected when one of the authorizations rejects it")
+	resp, err = resp.Updated.Accept(ctx, send)
+	require.NoError(t, err)
+	_, err = resp.Updated.Accept(ctx, send)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	t.Log("verify the grant is deleted when one of the authorizations is exhausted")
+	a, err = authz.NewAllOfAuthorization(
+		authz.NewMaxExecutionsAuthorization(msgTypeURL, 1),
+		banktypes.NewPeriodicSendAuthorization(time.Hour, coins),
+	)
+	require.NoError(t, err)
+	resp, err = a.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+}
+
+func TestAllOfAuthorizationValidateBasic(t *testing.T) {
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+
+	a, err := authz.NewAllOfAuthorization()
+	require.NoError(t, err)
+	require.Error(t, a.ValidateBasic())
+
+	a, err = authz.NewAllOfAuthorization(
+		authz.NewMaxExecutionsAuthorization(msgTypeURL, 1),
+		authz.NewGenericAuthorization("/cosmos.bank.v1beta1.MsgMultiSend"),
+	)
+	require.NoError(t, err)
+	require.ErrorContains(t, a.ValidateBasic(), "differs")
+
+	nested, err := authz.NewAllOfAuthorization(authz.NewMaxExecutionsAuthorization(msgTypeURL, 1))
+	require.NoError(t, err)
+	a, err = authz.NewAllOfAuthorization(nested)
+	require.NoError(t, err)
+	require.ErrorContains(t, a.ValidateBasic(), "nested")
+
+	a, err = authz.NewAllOfAuthorization(authz.NewMaxExecutionsAuthorization(msgTypeURL, 0))
+	require.NoError(t, err)
+	require.Error(t, a.ValidateBasic())
+}
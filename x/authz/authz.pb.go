@@ -30,6 +30,49 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FilterOperator is the comparison of a FieldFilter.
+type FilterOperator int32
+
+const (
+	// FILTER_OPERATOR_UNSPECIFIED is an invalid operator.
+	FilterOperatorUnspecified FilterOperator = 0
+	// FILTER_OPERATOR_EQUAL requires the field to be equal to the single value.
+	FilterOperatorEqual FilterOperator = 1
+	// FILTER_OPERATOR_IN requires the field to be equal to one of the values,
+	// e.g. to allow a list of addresses.
+	FilterOperatorIn FilterOperator = 2
+	// FILTER_OPERATOR_LTE requires the integer field to be lower than or equal
+	// to the single value.
+	FilterOperatorLTE FilterOperator = 3
+	// FILTER_OPERATOR_GTE requires the integer field to be greater than or
+	// equal to the single value.
+	FilterOperatorGTE FilterOperator = 4
+)
+
+var FilterOperator_name = map[int32]string{
+	0: "FILTER_OPERATOR_UNSPECIFIED",
+	1: "FILTER_OPERATOR_EQUAL",
+	2: "FILTER_OPERATOR_IN",
+	3: "FILTER_OPERATOR_LTE",
+	4: "FILTER_OPERATOR_GTE",
+}
+
+var FilterOperator_value = map[string]int32{
+	"FILTER_OPERATOR_UNSPECIFIED": 0,
+	"FILTER_OPERATOR_EQUAL":       1,
+	"FILTER_OPERATOR_IN":          2,
+	"FILTER_OPERATOR_LTE":         3,
+	"FILTER_OPERATOR_GTE":         4,
+}
+
+func (x FilterOperator) String() string {
+	return proto.EnumName(FilterOperator_name, int32(x))
+}
+
+func (FilterOperator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{0}
+}
+
 // GenericAuthorization gives the grantee unrestricted permissions to execute
 // the provided method on behalf of the granter's account.
 type GenericAuthorization struct {
@@ -70,6 +113,181 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// MaxExecutionsAuthorization gives the grantee permissions to execute the
+// provided method on behalf of the granter's account a limited number of times.
+// The grant is deleted after its last execution.
+type MaxExecutionsAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// remaining_executions is the number of executions left.
+	RemainingExecutions uint64 `protobuf:"varint,2,opt,name=remaining_executions,json=remainingExecutions,proto3" json:"remaining_executions,omitempty"`
+}
+
+func (m *MaxExecutionsAuthorization) Reset()         { *m = MaxExecutionsAuthorization{} }
+func (m *MaxExecutionsAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MaxExecutionsAuthorization) ProtoMessage()    {}
+func (*MaxExecutionsAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *MaxExecutionsAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxExecutionsAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxExecutionsAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaxExecutionsAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxExecutionsAuthorization.Merge(m, src)
+}
+func (m *MaxExecutionsAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxExecutionsAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxExecutionsAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxExecutionsAuthorization proto.InternalMessageInfo
+
+// AllOfAuthorization gives the grantee permissions to execute a method on
+// behalf of the granter's account when all of its authorizations accept it.
+// Each authorization is updated on execution, and the grant is deleted as soon
+// as one of them is exhausted.
+type AllOfAuthorization struct {
+	// authorizations are the authorizations of the same method that must all
+	// accept the execution.
+	Authorizations []*any.Any `protobuf:"bytes,1,rep,name=authorizations,proto3" json:"authorizations,omitempty"`
+}
+
+func (m *AllOfAuthorization) Reset()         { *m = AllOfAuthorization{} }
+func (m *AllOfAuthorization) String() string { return proto.CompactTextString(m) }
+func (*AllOfAuthorization) ProtoMessage()    {}
+func (*AllOfAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *AllOfAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllOfAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllOfAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllOfAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllOfAuthorization.Merge(m, src)
+}
+func (m *AllOfAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllOfAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllOfAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllOfAuthorization proto.InternalMessageInfo
+
+// FilteredAuthorization gives the grantee permissions to execute the provided
+// method on behalf of the granter's account when the fields of the message
+// match all of its filters.
+type FilteredAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// filters are the constraints the fields of the message must all satisfy.
+	Filters []FieldFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters"`
+}
+
+func (m *FilteredAuthorization) Reset()         { *m = FilteredAuthorization{} }
+func (m *FilteredAuthorization) String() string { return proto.CompactTextString(m) }
+func (*FilteredAuthorization) ProtoMessage()    {}
+func (*FilteredAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{3}
+}
+func (m *FilteredAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FilteredAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FilteredAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FilteredAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilteredAuthorization.Merge(m, src)
+}
+func (m *FilteredAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *FilteredAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilteredAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilteredAuthorization proto.InternalMessageInfo
+
+// FieldFilter constrains a field of a message.
+type FieldFilter struct {
+	// path is the dot separated path of the field in the message, e.g.
+	// "validator_address" or "amount.denom". When the path traverses repeated
+	// fields, all their elements must satisfy the filter.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// operator is the comparison of the field with the values.
+	Operator FilterOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=cosmos.authz.v1beta1.FilterOperator" json:"operator,omitempty"`
+	// values are the values the field is compared with. Enum values are
+	// identified by their name.
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *FieldFilter) Reset()         { *m = FieldFilter{} }
+func (m *FieldFilter) String() string { return proto.CompactTextString(m) }
+func (*FieldFilter) ProtoMessage()    {}
+func (*FieldFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{4}
+}
+func (m *FieldFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldFilter.Merge(m, src)
+}
+func (m *FieldFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldFilter proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -84,7 +302,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{5}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +344,7 @@ func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{6}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +383,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{7}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +413,12 @@ func (m *GrantQueueItem) XXX_DiscardUnknown() {
 var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("cosmos.authz.v1beta1.FilterOperator", FilterOperator_name, FilterOperator_value)
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*MaxExecutionsAuthorization)(nil), "cosmos.authz.v1beta1.MaxExecutionsAuthorization")
+	proto.RegisterType((*AllOfAuthorization)(nil), "cosmos.authz.v1beta1.AllOfAuthorization")
+	proto.RegisterType((*FilteredAuthorization)(nil), "cosmos.authz.v1beta1.FilteredAuthorization")
+	proto.RegisterType((*FieldFilter)(nil), "cosmos.authz.v1beta1.FieldFilter")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -204,35 +427,58 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0xeb, 0xf1, 0xe7, 0x7c, 0x3a, 0x04, 0x51, 0x86, 0xd2, 0x21, 0xa9, 0x22, 0x84,
-	0x4e, 0x48, 0x8d, 0x75, 0x07, 0x13, 0x13, 0x8d, 0x90, 0x4e, 0xb0, 0x11, 0x8e, 0x85, 0xa5, 0x72,
-	0x5a, 0xe3, 0x5a, 0xd4, 0x71, 0x64, 0x3b, 0xe8, 0x72, 0x1f, 0x81, 0xe9, 0x3e, 0x03, 0x9f, 0x00,
-	0xa4, 0x7e, 0x88, 0x8a, 0xa9, 0x62, 0x62, 0xe2, 0x4f, 0x3b, 0xf0, 0x35, 0x50, 0xed, 0x44, 0x34,
-	0xb4, 0x12, 0x1d, 0x58, 0x22, 0xdb, 0xef, 0xf3, 0xbc, 0xef, 0x93, 0x5f, 0x62, 0xd8, 0x1d, 0x0a,
-	0xc5, 0x85, 0x42, 0xb8, 0xd0, 0xe3, 0x4b, 0xf4, 0xee, 0x24, 0x25, 0x1a, 0x9f, 0xd8, 0x5d, 0x94,
-	0x4b, 0xa1, 0x85, 0xeb, 0x59, 0x45, 0x64, 0xcf, 0x2a, 0x45, 0xe7, 0x0e, 0xe6, 0x2c, 0x13, 0xc8,
-	0x3c, 0xad, 0xb0, 0x73, 0xd7, 0x0a, 0x07, 0x66, 0x87, 0x2a, 0x97, 0x2d, 0x05, 0x54, 0x08, 0x3a,
-	0x21, 0xc8, 0xec, 0xd2, 0xe2, 0x0d, 0xd2, 0x8c, 0x13, 0xa5, 0x31, 0xcf, 0x2b, 0x81, 0x47, 0x05,
-	0x15, 0xd6, 0xb8, 0x5a, 0xd5, 0x1d, 0xff, 0xb6, 0xe1, 0xac, 0xb4, 0xa5, 0x50, 0x43, 0xef, 0x8c,
-	0x64, 0x44, 0xb2, 0x61, 0xbf, 0xd0, 0x63, 0x21, 0xd9, 0x25, 0xd6, 0x4c, 0x64, 0xee, 0x6d, 0xd8,
-	0xe2, 0x8a, 0xb6, 0x41, 0x17, 0x1c, 0x1f, 0x24, 0xab, 0xe5, 0xe3, 0xe7, 0x9f, 0xa7, 0xbd, 0x70,
-	0xdb, 0x3b, 0x44, 0x0d, 0xe7, 0xfb, 0x5f, 0x1f, 0x1f, 0x04, 0x56, 0xd6, 0x53, 0xa3, 0xb7, 0x68,
-	0x5b, 0xf7, 0xf0, 0x13, 0x80, 0xd7, 0xce, 0x24, 0xce, 0xb4, 0x9b, 0xc2, 0x23, 0xbc, 0x5e, 0x32,
-	0x13, 0x0f, 0x4f, 0xbd, 0xc8, 0x46, 0x8e, 0xea, 0xc8, 0x51, 0x3f, 0x2b, 0xe3, 0xfb, 0xbb, 0x45,
-	0x48, 0x9a, 0x2d, 0xdd, 0xa7, 0x10, 0x92, 0x8b, 0x9c, 0x49, 0x3b, 0x60, 0xcf, 0x0c, 0xe8, 0x6c,
-	0x0c, 0x38, 0xaf, 0x51, 0xc6, 0x37, 0x67, 0xdf, 0x02, 0x70, 0xf5, 0x3d, 0x00, 0xc9, 0x9a, 0x2f,
-	0xfc, 0xb0, 0x07, 0x5d, 0x93, 0xb9, 0x09, 0xea, 0x14, 0xde, 0xa0, 0xab, 0x53, 0x22, 0x2d, 0xac,
-	0xb8, 0xfd, 0x65, 0xda, 0xab, 0xbf, 0x75, 0x7f, 0x34, 0x92, 0x44, 0xa9, 0x97, 0x5a, 0xb2, 0x8c,
-	0x26, 0xb5, 0xf0, 0x8f, 0x87, 0x98, 0x34, 0x3b, 0x78, 0xc8, 0x26, 0xa8, 0xd6, 0xff, 0x07, 0xf5,
-	0xa4, 0x01, 0x6a, 0xff, 0x9f, 0xa0, 0xf6, 0x37, 0x20, 0x3d, 0x82, 0xb7, 0x0c, 0xa3, 0x17, 0x05,
-	0x29, 0xc8, 0x33, 0x4d, 0xb8, 0x1b, 0xc2, 0x23, 0xae, 0xe8, 0x40, 0x97, 0x39, 0x19, 0x14, 0x72,
-	0xa2, 0xda, 0xa0, 0xdb, 0x3a, 0x3e, 0x48, 0x0e, 0xb9, 0xa2, 0xe7, 0x65, 0x4e, 0x5e, 0xc9, 0x89,
-	0x8a, 0xe3, 0xd9, 0x4f, 0xdf, 0x99, 0x2d, 0x7c, 0x30, 0x5f, 0xf8, 0xe0, 0xc7, 0xc2, 0x07, 0x57,
-	0x4b, 0xdf, 0x99, 0x2f, 0x7d, 0xe7, 0xeb, 0xd2, 0x77, 0x5e, 0xdf, 0xa3, 0x4c, 0x8f, 0x8b, 0x34,
-	0x1a, 0x0a, 0x5e, 0xdd, 0x06, 0xb4, 0xf6, 0x7f, 0x5d, 0xd8, 0x4b, 0x96, 0x5e, 0x37, 0xf9, 0x1e,
-	0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x50, 0x89, 0xaf, 0x02, 0x89, 0x03, 0x00, 0x00,
+	// 804 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6f, 0xe2, 0x56,
+	0x14, 0xe5, 0x01, 0x9d, 0x19, 0x5e, 0x34, 0x94, 0x79, 0x90, 0x96, 0x71, 0x35, 0xc6, 0xb5, 0xa6,
+	0x15, 0x8a, 0x8a, 0xdd, 0xd0, 0xe9, 0x86, 0x45, 0x35, 0xd0, 0x31, 0x88, 0x8a, 0x86, 0xc4, 0x31,
+	0x9b, 0x56, 0x2a, 0x32, 0xf0, 0x30, 0x56, 0xfd, 0x55, 0xfb, 0x39, 0x82, 0xfc, 0x82, 0x8a, 0x55,
+	0x96, 0xdd, 0xb0, 0x69, 0x37, 0x5d, 0xa6, 0x52, 0x7e, 0x43, 0x85, 0xba, 0x4a, 0xb3, 0x69, 0x57,
+	0x69, 0x9b, 0x2c, 0xf2, 0x37, 0x2a, 0xdb, 0x90, 0x60, 0x20, 0x4a, 0xa4, 0xcc, 0xc6, 0x7a, 0x1f,
+	0xe7, 0xdc, 0x77, 0xce, 0xbd, 0x57, 0xd7, 0x90, 0xe9, 0x9a, 0x8e, 0x6e, 0x3a, 0xbc, 0xec, 0x92,
+	0xc1, 0x21, 0x7f, 0xb0, 0xdd, 0xc1, 0x44, 0xde, 0x0e, 0x76, 0x9c, 0x65, 0x9b, 0xc4, 0x44, 0x99,
+	0x00, 0xc1, 0x05, 0x67, 0x33, 0x04, 0xf5, 0x4c, 0xd6, 0x55, 0xc3, 0xe4, 0xfd, 0x6f, 0x00, 0xa4,
+	0x9e, 0x07, 0xc0, 0xb6, 0xbf, 0xe3, 0x67, 0xac, 0xe0, 0x2a, 0xa7, 0x98, 0xa6, 0xa2, 0x61, 0xde,
+	0xdf, 0x75, 0xdc, 0x3e, 0x4f, 0x54, 0x1d, 0x3b, 0x44, 0xd6, 0xad, 0x19, 0x20, 0xa3, 0x98, 0x8a,
+	0x19, 0x10, 0xbd, 0xd5, 0x3c, 0xe2, 0x32, 0x4d, 0x36, 0x46, 0xc1, 0x15, 0x4b, 0x60, 0xa6, 0x86,
+	0x0d, 0x6c, 0xab, 0xdd, 0xb2, 0x4b, 0x06, 0xa6, 0xad, 0x1e, 0xca, 0x44, 0x35, 0x0d, 0x94, 0x82,
+	0x31, 0xdd, 0x51, 0xb2, 0x80, 0x01, 0xf9, 0x84, 0xe8, 0x2d, 0x4b, 0x5f, 0xfd, 0x71, 0x52, 0x60,
+	0xd7, 0x79, 0xe0, 0x42, 0xcc, 0xf1, 0xd5, 0xf1, 0x56, 0x2e, 0x80, 0x15, 0x9c, 0xde, 0xf7, 0xfc,
+	0xba, 0xe8, 0xec, 0xef, 0x00, 0x52, 0x5f, 0xcb, 0x43, 0x61, 0x88, 0xbb, 0xae, 0x77, 0xe0, 0xdc,
+	0xf1, 0x38, 0xda, 0x86, 0x19, 0x1b, 0xeb, 0xb2, 0x6a, 0xa8, 0x86, 0xd2, 0xc6, 0xd7, 0xb4, 0x6c,
+	0x94, 0x01, 0xf9, 0xb8, 0x98, 0xbe, 0xbe, 0xbb, 0x89, 0x58, 0xea, 0xde, 0x4f, 0xef, 0xd9, 0x49,
+	0xe1, 0xdd, 0x1b, 0xb9, 0xcc, 0xa7, 0xdc, 0xe7, 0xaf, 0x3c, 0x0b, 0x1f, 0x2d, 0x58, 0xb8, 0x5d,
+	0x29, 0xfb, 0x17, 0x80, 0xa8, 0xac, 0x69, 0xcd, 0x7e, 0xd8, 0x40, 0x0f, 0x26, 0xe5, 0xc5, 0x03,
+	0x27, 0x0b, 0x98, 0x58, 0x7e, 0xa3, 0x98, 0xe1, 0x82, 0x4a, 0x70, 0xf3, 0x4a, 0x70, 0x65, 0x63,
+	0x54, 0xf9, 0xf8, 0x7e, 0x4a, 0xc5, 0xa5, 0x98, 0xa5, 0x6f, 0x1f, 0xe4, 0xf0, 0xc5, 0x82, 0xc3,
+	0x55, 0x0b, 0xec, 0x9f, 0x00, 0x6e, 0x56, 0x55, 0x8d, 0x60, 0x1b, 0xf7, 0xee, 0xaa, 0x4e, 0x15,
+	0x3e, 0xee, 0xfb, 0x50, 0xaf, 0x20, 0x9e, 0xcf, 0x0f, 0xb9, 0xb5, 0xb2, 0xaa, 0x2a, 0xd6, 0x7a,
+	0x41, 0xd0, 0x4a, 0x62, 0x7a, 0x9e, 0x8b, 0xfc, 0x7a, 0x75, 0xbc, 0x05, 0xc4, 0x39, 0xb9, 0xf4,
+	0xdd, 0x83, 0x0c, 0x31, 0x0b, 0x86, 0xd6, 0x2a, 0x67, 0x8f, 0x00, 0xdc, 0x58, 0xd0, 0x80, 0x10,
+	0x8c, 0x5b, 0x32, 0x19, 0xcc, 0xac, 0xf8, 0x6b, 0xf4, 0x1a, 0x3e, 0x31, 0x2d, 0x6c, 0xcb, 0xc4,
+	0xb4, 0xfd, 0xee, 0x4a, 0x16, 0x5f, 0xde, 0x66, 0xc6, 0x8b, 0xd1, 0x9c, 0x61, 0xc5, 0x6b, 0x16,
+	0x7a, 0x0f, 0x3e, 0x3a, 0x90, 0x35, 0x17, 0x3b, 0xd9, 0x18, 0x13, 0xcb, 0x27, 0xc4, 0xd9, 0xae,
+	0x94, 0x5e, 0xa3, 0x9b, 0xfd, 0x0d, 0xc0, 0x77, 0x6a, 0xb6, 0x6c, 0x10, 0xd4, 0x81, 0x4f, 0x43,
+	0xf5, 0xf5, 0x55, 0x3d, 0xb4, 0x65, 0xc2, 0x21, 0xd1, 0x1b, 0x08, 0xf1, 0xd0, 0x52, 0xed, 0xe0,
+	0x81, 0xa8, 0xff, 0x00, 0xb5, 0xf2, 0x80, 0x34, 0x1f, 0x2a, 0x95, 0x27, 0xd3, 0xf3, 0x1c, 0x38,
+	0xfa, 0x27, 0x07, 0xc4, 0x05, 0x1e, 0xfb, 0x73, 0x14, 0x22, 0x5f, 0x73, 0xb8, 0x2f, 0x8a, 0xf0,
+	0xb1, 0xe2, 0x9d, 0x62, 0x3b, 0x48, 0x68, 0x25, 0x7b, 0x76, 0x52, 0x98, 0x4f, 0xbd, 0x72, 0xaf,
+	0x67, 0x63, 0xc7, 0xd9, 0x27, 0xb6, 0x6a, 0x28, 0xe2, 0x1c, 0x78, 0xc3, 0xc1, 0xd9, 0xe8, 0xfd,
+	0x38, 0x78, 0x35, 0x51, 0xb1, 0xb7, 0x9f, 0xa8, 0xd7, 0xa1, 0x44, 0xc5, 0xef, 0x4c, 0x54, 0x7c,
+	0x25, 0x49, 0xaf, 0x60, 0xd2, 0xcf, 0xd1, 0x9e, 0x8b, 0x5d, 0x5c, 0x27, 0x58, 0x47, 0x2c, 0x7c,
+	0xaa, 0x3b, 0x4a, 0x9b, 0x8c, 0x2c, 0xdc, 0x76, 0x6d, 0x2d, 0x98, 0x09, 0x09, 0x71, 0x43, 0x77,
+	0x14, 0x69, 0x64, 0xe1, 0x96, 0xad, 0x39, 0x5b, 0x3f, 0x45, 0x61, 0x32, 0xdc, 0x58, 0xe8, 0x0b,
+	0xf8, 0x41, 0xb5, 0xde, 0x90, 0x04, 0xb1, 0xdd, 0xdc, 0x15, 0xc4, 0xb2, 0xd4, 0x14, 0xdb, 0xad,
+	0x9d, 0xfd, 0x5d, 0xe1, 0xcb, 0x7a, 0xb5, 0x2e, 0xbc, 0x49, 0x45, 0xa8, 0x17, 0xe3, 0x09, 0xf3,
+	0x3c, 0x4c, 0x6a, 0x19, 0x8e, 0x85, 0xbb, 0x6a, 0x5f, 0xc5, 0x3d, 0x54, 0x84, 0x9b, 0xcb, 0x7c,
+	0x61, 0xaf, 0x55, 0x6e, 0xa4, 0x00, 0xf5, 0xfe, 0x78, 0xc2, 0xa4, 0xc3, 0x4c, 0xe1, 0x07, 0x57,
+	0xd6, 0xd0, 0x27, 0x10, 0x2d, 0x73, 0xea, 0x3b, 0xa9, 0x28, 0x95, 0x19, 0x4f, 0x98, 0x54, 0x98,
+	0x50, 0x37, 0x10, 0x07, 0xd3, 0xcb, 0xe8, 0x86, 0x24, 0xa4, 0x62, 0xd4, 0xe6, 0x78, 0xc2, 0x3c,
+	0x0b, 0xc3, 0x1b, 0x92, 0xb0, 0x0e, 0x5f, 0x93, 0x84, 0x54, 0x7c, 0x1d, 0xbe, 0x26, 0x09, 0x54,
+	0xfc, 0xc7, 0x5f, 0xe8, 0x48, 0xa5, 0x32, 0xfd, 0x8f, 0x8e, 0x4c, 0x2f, 0x68, 0x70, 0x7a, 0x41,
+	0x83, 0x7f, 0x2f, 0x68, 0x70, 0x74, 0x49, 0x47, 0x4e, 0x2f, 0xe9, 0xc8, 0xdf, 0x97, 0x74, 0xe4,
+	0x9b, 0x97, 0x8a, 0x4a, 0x06, 0x6e, 0x87, 0xeb, 0x9a, 0xfa, 0xec, 0x97, 0xc9, 0x2f, 0x8c, 0x83,
+	0x61, 0xf0, 0x27, 0xee, 0x3c, 0xf2, 0x4b, 0xf7, 0xd9, 0xff, 0x03, 0x00, 0xfd, 0xf0, 0x07, 0xed,
+	0xae, 0x07, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -265,6 +511,166 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaxExecutionsAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxExecutionsAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxExecutionsAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingExecutions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.RemainingExecutions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllOfAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllOfAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllOfAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FilteredAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FilteredAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FilteredAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Operator != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -425,66 +831,138 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
-func (m *Grant) Size() (n int) {
+func (m *MaxExecutionsAuthorization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Authorization != nil {
-		l = m.Authorization.Size()
+	l = len(m.Msg)
+	if l > 0 {
 		n += 1 + l + sovAuthz(uint64(l))
 	}
-	if m.Expiration != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
-		n += 1 + l + sovAuthz(uint64(l))
+	if m.RemainingExecutions != 0 {
+		n += 1 + sovAuthz(uint64(m.RemainingExecutions))
 	}
 	return n
 }
 
-func (m *GrantAuthorization) Size() (n int) {
+func (m *AllOfAuthorization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovAuthz(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovAuthz(uint64(l))
-	}
-	if m.Authorization != nil {
-		l = m.Authorization.Size()
-		n += 1 + l + sovAuthz(uint64(l))
-	}
-	if m.Expiration != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
-		n += 1 + l + sovAuthz(uint64(l))
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *GrantQueueItem) Size() (n int) {
+func (m *FilteredAuthorization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.MsgTypeUrls) > 0 {
-		for _, s := range m.MsgTypeUrls {
-			l = len(s)
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
 	return n
 }
 
-func sovAuthz(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *FieldFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Operator != 0 {
+		n += 1 + sovAuthz(uint64(m.Operator))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantQueueItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozAuthz(x uint64) (n int) {
 	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -570,6 +1048,440 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MaxExecutionsAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxExecutionsAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxExecutionsAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingExecutions", wireType)
+			}
+			m.RemainingExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllOfAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllOfAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllOfAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, &any.Any{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FilteredAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilteredAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilteredAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, FieldFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= FilterOperator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -29,6 +29,7 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagFilter            = "filter"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -57,7 +58,7 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 // NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
 func NewCmdGrantAuthorization(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"filtered\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
@@ -65,7 +66,12 @@ func NewCmdGrantAuthorization(ac address.Codec) *cobra.Command {
 Examples:
  $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. filtered --msg-type=/cosmos.gov.v1.MsgVote --filter=proposal_id:gte:10 --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. filtered --msg-type=/cosmos.staking.v1beta1.MsgDelegate --filter=validator_address:in:cosmosvaloper1x..,cosmosvaloper1y.. --from=cosmos1sk..
+
+The filters of a filtered authorization are in the <path>:<operator>:<values> format, where the
+operator is one of eq, in, lte and gte and the values are separated by ",".
+	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -128,6 +134,24 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+
+			case "filtered":
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				rawFilters, err := cmd.Flags().GetStringArray(FlagFilter)
+				if err != nil {
+					return err
+				}
+
+				filters, err := parseFieldFilters(rawFilters)
+				if err != nil {
+					return err
+				}
+
+				authorization = authz.NewFilteredAuthorization(msgType, filters...)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -207,7 +231,8 @@ Examples:
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization")
+	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization or FilteredAuthorization")
+	cmd.Flags().StringArray(FlagFilter, []string{}, "Filter of a FilteredAuthorization in the <path>:<operator>:<values> format, can be repeated")
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
@@ -216,6 +241,34 @@ Examples:
 	return cmd
 }
 
+// filterOperators are the operators of the --filter flag.
+var filterOperators = map[string]authz.FilterOperator{
+	"eq":  authz.FilterOperatorEqual,
+	"in":  authz.FilterOperatorIn,
+	"lte": authz.FilterOperatorLTE,
+	"gte": authz.FilterOperatorGTE,
+}
+
+// parseFieldFilters parses filters in the <path>:<operator>:<values> format.
+func parseFieldFilters(rawFilters []string) ([]authz.FieldFilter, error) {
+	filters := make([]authz.FieldFilter, len(rawFilters))
+	for i, rawFilter := range rawFilters {
+		parts := strings.SplitN(rawFilter, ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid filter %s, expected <path>:<operator>:<values>", rawFilter)
+		}
+
+		operator, ok := filterOperators[parts[1]]
+		if !ok {
+			return nil, fmt.Errorf("invalid filter operator %s, expected one of eq, in, lte and gte", parts[1])
+		}
+
+		filters[i] = authz.NewFieldFilter(parts[0], operator, strings.Split(parts[2], ",")...)
+	}
+
+	return filters, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
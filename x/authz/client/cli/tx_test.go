@@ -420,6 +420,37 @@ func (s *CLITestSuite) TestCLITxGrantAuthorization() {
 			false,
 			"",
 		},
+		{
+			"Invalid filter operator",
+			[]string{
+				grantee.String(),
+				"filtered",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgVote),
+				fmt.Sprintf("--%s=proposal_id:gt:10", cli.FlagFilter),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+			},
+			true,
+			"invalid filter operator gt",
+		},
+		{
+			"Valid tx filtered authorization",
+			[]string{
+				grantee.String(),
+				"filtered",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgVote),
+				fmt.Sprintf("--%s=proposal_id:gte:10", cli.FlagFilter),
+				fmt.Sprintf("--%s=option:in:VOTE_OPTION_YES,VOTE_OPTION_ABSTAIN", cli.FlagFilter),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			},
+			false,
+			"",
+		},
 		{
 			"fail when granter = grantee",
 			[]string{
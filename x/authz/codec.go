@@ -17,6 +17,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+	cdc.RegisterConcrete(&MaxExecutionsAuthorization{}, "cosmos-sdk/MaxExecutionsAuthorization", nil)
+	cdc.RegisterConcrete(&AllOfAuthorization{}, "cosmos-sdk/AllOfAuthorization", nil)
+	cdc.RegisterConcrete(&FilteredAuthorization{}, "cosmos-sdk/FilteredAuthorization", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -31,6 +34,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		"cosmos.authz.v1beta1.Authorization",
 		(*Authorization)(nil),
 		&GenericAuthorization{},
+		&MaxExecutionsAuthorization{},
+		&AllOfAuthorization{},
+		&FilteredAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, MsgServiceDesc())
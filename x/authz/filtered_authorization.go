@@ -0,0 +1,315 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// gasCostPerFilterComparison is the gas consumed for each comparison of a
+// message field with a filter value.
+const gasCostPerFilterComparison = uint64(10)
+
+// gasCostPerFilteredByte is the gas consumed for each byte of the message
+// whose fields are filtered.
+const gasCostPerFilteredByte = uint64(1)
+
+// msgV2Key is the context key of the protoreflect message of the dispatched
+// message.
+type msgV2Key struct{}
+
+// ContextWithMsgV2 returns a context carrying the protoreflect message of the
+// dispatched message, as decoded by the x/tx signing context of the app. The
+// keeper sets it before the authorizations accept the message.
+func ContextWithMsgV2(ctx sdk.Context, msgV2 proto.Message) sdk.Context {
+	return ctx.WithValue(msgV2Key{}, msgV2)
+}
+
+var _ Authorization = &FilteredAuthorization{}
+
+// NewFilteredAuthorization creates a new FilteredAuthorization object.
+func NewFilteredAuthorization(msgTypeURL string, filters ...FieldFilter) *FilteredAuthorization {
+	return &FilteredAuthorization{
+		Msg:     msgTypeURL,
+		Filters: filters,
+	}
+}
+
+// NewFieldFilter creates a new FieldFilter object.
+func NewFieldFilter(path string, operator FilterOperator, values ...string) FieldFilter {
+	return FieldFilter{
+		Path:     path,
+		Operator: operator,
+		Values:   values,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a FilteredAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. The fields of the message selected
+// by the filters are read from its protoreflect message set in the context with
+// ContextWithMsgV2, and compared with the filter values.
+func (a FilteredAuthorization) Accept(ctx context.Context, msg sdk.Msg) (AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decoded, ok := sdkCtx.Value(msgV2Key{}).(proto.Message)
+	if !ok || decoded == nil {
+		return AcceptResponse{}, sdkerrors.ErrLogic.Wrapf("no decoded message for %s in the context", a.Msg)
+	}
+	if name := string(decoded.ProtoReflect().Descriptor().FullName()); name != gogoproto.MessageName(msg) {
+		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("decoded message %s does not match %s", name, a.Msg)
+	}
+
+	sdkCtx.GasMeter().ConsumeGas(gasCostPerFilteredByte*uint64(proto.Size(decoded)), "filtered authorization")
+
+	for _, filter := range a.Filters {
+		fields, err := resolveFieldPath(decoded.ProtoReflect().Descriptor(), filter.Path)
+		if err != nil {
+			return AcceptResponse{}, err
+		}
+
+		values := fieldValues(decoded.ProtoReflect(), fields, nil)
+		if len(values) == 0 {
+			return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("field %s is not set", filter.Path)
+		}
+
+		leaf := fields[len(fields)-1]
+		for _, value := range values {
+			ok, err := filter.match(sdkCtx, leaf, value)
+			if err != nil {
+				return AcceptResponse{}, err
+			}
+			if !ok {
+				return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("field %s does not satisfy the %s filter", filter.Path, filter.Operator)
+			}
+		}
+	}
+
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic. The filters are
+// checked against the descriptor of the message, which must be registered.
+func (a FilteredAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return errors.New("msg type cannot be empty")
+	}
+
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(a.Msg, "/")))
+	if err != nil {
+		return fmt.Errorf("unknown msg type %s: %w", a.Msg, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return fmt.Errorf("%s is not a message", a.Msg)
+	}
+
+	if len(a.Filters) == 0 {
+		return errors.New("filters cannot be empty")
+	}
+
+	for i, filter := range a.Filters {
+		if err := filter.validate(msgDesc); err != nil {
+			return fmt.Errorf("filter %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validate checks the path of the filter resolves to a comparable field of the
+// message and its values are valid for the field and the operator.
+func (f FieldFilter) validate(msgDesc protoreflect.MessageDescriptor) error {
+	fields, err := resolveFieldPath(msgDesc, f.Path)
+	if err != nil {
+		return err
+	}
+	leaf := fields[len(fields)-1]
+
+	switch f.Operator {
+	case FilterOperatorEqual:
+		if len(f.Values) != 1 {
+			return fmt.Errorf("%s requires a single value", f.Operator)
+		}
+	case FilterOperatorIn:
+		if len(f.Values) == 0 {
+			return fmt.Errorf("%s requires at least one value", f.Operator)
+		}
+	case FilterOperatorLTE, FilterOperatorGTE:
+		if len(f.Values) != 1 {
+			return fmt.Errorf("%s requires a single value", f.Operator)
+		}
+		if !isIntegerField(leaf) && leaf.Kind() != protoreflect.StringKind {
+			return fmt.Errorf("%s requires an integer field, %s is %s", f.Operator, f.Path, leaf.Kind())
+		}
+		if _, ok := new(big.Int).SetString(f.Values[0], 10); !ok {
+			return fmt.Errorf("%s requires an integer value, got %s", f.Operator, f.Values[0])
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid operator %s", f.Operator)
+	}
+
+	for _, value := range f.Values {
+		switch {
+		case isIntegerField(leaf):
+			i, ok := new(big.Int).SetString(value, 10)
+			if !ok || i.String() != value {
+				return fmt.Errorf("field %s requires integer values, got %s", f.Path, value)
+			}
+		case leaf.Kind() == protoreflect.BoolKind:
+			if value != "true" && value != "false" {
+				return fmt.Errorf("field %s requires true or false, got %s", f.Path, value)
+			}
+		case leaf.Kind() == protoreflect.EnumKind:
+			if leaf.Enum().Values().ByName(protoreflect.Name(value)) == nil {
+				return fmt.Errorf("field %s requires %s values, got %s", f.Path, leaf.Enum().FullName(), value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// match compares the value of a field with the filter values, consuming gas
+// for each comparison.
+func (f FieldFilter) match(ctx sdk.Context, field protoreflect.FieldDescriptor, value protoreflect.Value) (bool, error) {
+	switch f.Operator {
+	case FilterOperatorEqual, FilterOperatorIn:
+		s := formatFieldValue(field, value)
+		for _, v := range f.Values {
+			ctx.GasMeter().ConsumeGas(gasCostPerFilterComparison, "filtered authorization")
+			if v == s {
+				return true, nil
+			}
+		}
+		return false, nil
+
+	case FilterOperatorLTE, FilterOperatorGTE:
+		ctx.GasMeter().ConsumeGas(gasCostPerFilterComparison, "filtered authorization")
+		i, ok := new(big.Int).SetString(formatFieldValue(field, value), 10)
+		if !ok {
+			return false, sdkerrors.ErrUnauthorized.Wrapf("field %s is not an integer", f.Path)
+		}
+		bound, ok := new(big.Int).SetString(f.Values[0], 10)
+		if !ok {
+			return false, fmt.Errorf("invalid %s value %s", f.Operator, f.Values[0])
+		}
+		if f.Operator == FilterOperatorLTE {
+			return i.Cmp(bound) <= 0, nil
+		}
+		return i.Cmp(bound) >= 0, nil
+
+	default:
+		return false, fmt.Errorf("invalid operator %s", f.Operator)
+	}
+}
+
+// resolveFieldPath returns the descriptors of the fields of the dot separated
+// path. All the fields but the last one must be messages, and the last one a
+// string, bool, enum or integer field.
+func resolveFieldPath(msgDesc protoreflect.MessageDescriptor, path string) ([]protoreflect.FieldDescriptor, error) {
+	if path == "" {
+		return nil, errors.New("field path cannot be empty")
+	}
+
+	names := strings.Split(path, ".")
+	fields := make([]protoreflect.FieldDescriptor, len(names))
+	for i, name := range names {
+		if msgDesc == nil {
+			return nil, fmt.Errorf("field path %s: %s is not a message", path, names[i-1])
+		}
+
+		field := msgDesc.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil, fmt.Errorf("field path %s: %s has no field %s", path, msgDesc.FullName(), name)
+		}
+		if field.IsMap() {
+			return nil, fmt.Errorf("field path %s: map field %s cannot be filtered", path, name)
+		}
+
+		fields[i] = field
+		msgDesc = field.Message()
+	}
+
+	leaf := fields[len(fields)-1]
+	switch {
+	case leaf.Kind() == protoreflect.StringKind, leaf.Kind() == protoreflect.BoolKind, leaf.Kind() == protoreflect.EnumKind, isIntegerField(leaf):
+		return fields, nil
+	default:
+		return nil, fmt.Errorf("field path %s: %s field %s cannot be filtered", path, leaf.Kind(), leaf.Name())
+	}
+}
+
+// fieldValues appends the values of the fields of the path to values. All the
+// elements of the repeated fields are traversed.
+func fieldValues(msg protoreflect.Message, fields []protoreflect.FieldDescriptor, values []protoreflect.Value) []protoreflect.Value {
+	field, last := fields[0], len(fields) == 1
+
+	var elems []protoreflect.Value
+	if field.IsList() {
+		list := msg.Get(field).List()
+		for i := 0; i < list.Len(); i++ {
+			elems = append(elems, list.Get(i))
+		}
+	} else {
+		elems = append(elems, msg.Get(field))
+	}
+
+	if last {
+		return append(values, elems...)
+	}
+
+	for _, elem := range elems {
+		values = fieldValues(elem.Message(), fields[1:], values)
+	}
+
+	return values
+}
+
+func formatFieldValue(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return value.String()
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(value.Bool())
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return strconv.FormatInt(int64(value.Enum()), 10)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(value.Int(), 10)
+	default:
+		return strconv.FormatUint(value.Uint(), 10)
+	}
+}
+
+func isIntegerField(field protoreflect.FieldDescriptor) bool {
+	switch field.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return true
+	default:
+		return false
+	}
+}
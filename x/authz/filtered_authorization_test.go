@@ -0,0 +1,129 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// acceptFiltered calls Accept with the protoreflect message of msg in the
+// context, as the keeper does.
+func acceptFiltered(t *testing.T, ctx sdk.Context, a *authz.FilteredAuthorization, msg sdk.Msg) (authz.AcceptResponse, error) {
+	t.Helper()
+
+	bz, err := gogoproto.Marshal(msg)
+	require.NoError(t, err)
+	msgV2, err := anyutil.Unpack(&anypb.Any{TypeUrl: sdk.MsgTypeURL(msg), Value: bz}, gogoproto.HybridResolver, nil)
+	require.NoError(t, err)
+
+	return a.Accept(authz.ContextWithMsgV2(ctx, msgV2), msg)
+}
+
+func TestFilteredAuthorization(t *testing.T) {
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(authz.ModuleName), storetypes.NewTransientStoreKey("transient_test")).Ctx
+	valX, valY, valZ := "cosmosvaloper1x", "cosmosvaloper1y", "cosmosvaloper1z"
+
+	t.Log("verify a delegation is only accepted to the allowed validators")
+	a := authz.NewFilteredAuthorization(sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		authz.NewFieldFilter("validator_address", authz.FilterOperatorIn, valX, valY),
+		authz.NewFieldFilter("amount.amount", authz.FilterOperatorLTE, "1000"),
+	)
+	require.NoError(t, a.ValidateBasic())
+
+	resp, err := acceptFiltered(t, ctx, a, &stakingtypes.MsgDelegate{ValidatorAddress: valY, Amount: sdk.NewInt64Coin("stake", 1000)})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	_, err = acceptFiltered(t, ctx, a, &stakingtypes.MsgDelegate{ValidatorAddress: valZ, Amount: sdk.NewInt64Coin("stake", 1000)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = acceptFiltered(t, ctx, a, &stakingtypes.MsgDelegate{ValidatorAddress: valX, Amount: sdk.NewInt64Coin("stake", 1001)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	t.Log("verify a vote is only accepted on recent proposals with the allowed options")
+	a = authz.NewFilteredAuthorization(sdk.MsgTypeURL(&govv1.MsgVote{}),
+		authz.NewFieldFilter("proposal_id", authz.FilterOperatorGTE, "10"),
+		authz.NewFieldFilter("option", authz.FilterOperatorEqual, govv1.OptionYes.String()),
+	)
+	require.NoError(t, a.ValidateBasic())
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	_, err = acceptFiltered(t, ctx, a, &govv1.MsgVote{ProposalId: 10, Option: govv1.OptionYes})
+	require.NoError(t, err)
+	require.Greater(t, ctx.GasMeter().GasConsumed(), gasBefore)
+
+	_, err = acceptFiltered(t, ctx, a, &govv1.MsgVote{ProposalId: 9, Option: govv1.OptionYes})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = acceptFiltered(t, ctx, a, &govv1.MsgVote{ProposalId: 10, Option: govv1.OptionNo})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	t.Log("verify all the elements of repeated fields must satisfy the filter")
+	a = authz.NewFilteredAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		authz.NewFieldFilter("amount.denom", authz.FilterOperatorEqual, "stake"),
+	)
+	require.NoError(t, a.ValidateBasic())
+
+	_, err = acceptFiltered(t, ctx, a, &banktypes.MsgSend{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))})
+	require.NoError(t, err)
+	_, err = acceptFiltered(t, ctx, a, &banktypes.MsgSend{Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("stake", 1))})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = acceptFiltered(t, ctx, a, &banktypes.MsgSend{})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = acceptFiltered(t, ctx, a, &govv1.MsgVote{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	t.Log("verify the message decoded by the app is required")
+	_, err = a.Accept(ctx, &banktypes.MsgSend{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))})
+	require.ErrorIs(t, err, sdkerrors.ErrLogic)
+}
+
+func TestFilteredAuthorizationValidateBasic(t *testing.T) {
+	msgVote := sdk.MsgTypeURL(&govv1.MsgVote{})
+	msgSend := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name   string
+		a      *authz.FilteredAuthorization
+		expErr string
+	}{
+		{"empty msg type", authz.NewFilteredAuthorization("", authz.NewFieldFilter("voter", authz.FilterOperatorEqual, "x")), "msg type cannot be empty"},
+		{"unknown msg type", authz.NewFilteredAuthorization("/cosmos.unknown.MsgUnknown", authz.NewFieldFilter("voter", authz.FilterOperatorEqual, "x")), "unknown msg type"},
+		{"no filters", authz.NewFilteredAuthorization(msgVote), "filters cannot be empty"},
+		{"unknown field", authz.NewFilteredAuthorization(msgVote, authz.NewFieldFilter("proposal", authz.FilterOperatorEqual, "1")), "has no field proposal"},
+		{"message field", authz.NewFilteredAuthorization(msgSend, authz.NewFieldFilter("amount", authz.FilterOperatorEqual, "1stake")), "cannot be filtered"},
+		{"scalar traversed", authz.NewFilteredAuthorization(msgVote, authz.NewFieldFilter("voter.address", authz.FilterOperatorEqual, "x")), "voter is not a message"},
+		{"unspecified operator", authz.NewFilteredAuthorization(msgVote, authz.NewFieldFilter("voter", authz.FilterOperatorUnspecified, "x")), "invalid operator"},
+		{"equal with several values", authz.NewFilteredAuthorization(msgVote, authz.NewFieldFilter("voter", authz.FilterOperatorEqual, "x", "y")), "requires a single value"},
+		{"in without values", authz.NewFilteredAuthorization(msgVote, authz.NewFieldFilter("voter", authz.FilterOperatorIn)), "requires at least one value"},
+		{"lte on enum", authz.NewFilteredAuthorization(msgVote, authz.NewFieldFilter("option", authz.FilterOperatorLTE, "1")), "requires an integer field"},
+		{"gte with non integer", authz.NewFilteredAuthorization(msgVote, authz.NewFieldFilter("proposal_id", authz.FilterOperatorGTE, "ten")), "requires an integer value"},
+		{"non integer value", authz.NewFilteredAuthorization(msgVote, authz.NewFieldFilter("proposal_id", authz.FilterOperatorEqual, "01")), "requires integer values"},
+		{"unknown enum value", authz.NewFilteredAuthorization(msgVote, authz.NewFieldFilter("option", authz.FilterOperatorIn, "VOTE_OPTION_MAYBE")), "requires cosmos.gov.v1.VoteOption values"},
+		{"valid", authz.NewFilteredAuthorization(msgSend, authz.NewFieldFilter("to_address", authz.FilterOperatorIn, "x", "y"), authz.NewFieldFilter("amount.amount", authz.FilterOperatorLTE, "100")), ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.a.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
@@ -108,7 +108,7 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 	now := sdkCtx.BlockTime()
 
 	for i, msg := range msgs {
-		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+		signers, msgV2, err := k.cdc.GetMsgV1Signers(msg)
 		if err != nil {
 			return nil, err
 		}
@@ -139,7 +139,7 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 				return nil, err
 			}
 
-			resp, err := authorization.Accept(sdkCtx, msg)
+			resp, err := authorization.Accept(authz.ContextWithMsgV2(sdkCtx, msgV2), msg)
 			if err != nil {
 				return nil, err
 			}
@@ -171,6 +171,9 @@ func (s *TestSuite) TestDispatchAction() {
 	granteeAddr := addrs[1]
 	recipientAddr := addrs[2]
 	a := banktypes.NewSendAuthorization(coins100, nil)
+	filtered := authz.NewFilteredAuthorization(bankSendAuthMsgType,
+		authz.NewFieldFilter("to_address", authz.FilterOperatorIn, recipientAddr.String()),
+	)
 
 	testCases := []struct {
 		name      string
@@ -285,6 +288,44 @@ func (s *TestSuite) TestDispatchAction() {
 				require.Len(authzs, 0)
 			},
 		},
+		{
+			"expect error filtered authorization rejects the recipient",
+			authz.NewMsgExec(granteeAddr, []sdk.Msg{
+				&banktypes.MsgSend{
+					Amount:      coins10,
+					FromAddress: granterAddr.String(),
+					ToAddress:   granteeAddr.String(),
+				},
+			}),
+			true,
+			"field to_address does not satisfy the FILTER_OPERATOR_IN filter",
+			func() sdk.Context {
+				e := now.AddDate(0, 1, 0)
+				err := s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, filtered, &e)
+				require.NoError(err)
+				return s.ctx
+			},
+			func() {},
+		},
+		{
+			"valid test filtered authorization accepts the recipient",
+			authz.NewMsgExec(granteeAddr, []sdk.Msg{
+				&banktypes.MsgSend{
+					Amount:      coins10,
+					FromAddress: granterAddr.String(),
+					ToAddress:   recipientAddr.String(),
+				},
+			}),
+			false,
+			"",
+			func() sdk.Context {
+				e := now.AddDate(0, 1, 0)
+				err := s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, filtered, &e)
+				require.NoError(err)
+				return s.ctx
+			},
+			func() {},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,43 @@
+package authz
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Authorization = &MaxExecutionsAuthorization{}
+
+// NewMaxExecutionsAuthorization creates a new MaxExecutionsAuthorization object.
+func NewMaxExecutionsAuthorization(msgTypeURL string, maxExecutions uint64) *MaxExecutionsAuthorization {
+	return &MaxExecutionsAuthorization{
+		Msg:                 msgTypeURL,
+		RemainingExecutions: maxExecutions,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MaxExecutionsAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+func (a MaxExecutionsAuthorization) Accept(ctx context.Context, msg sdk.Msg) (AcceptResponse, error) {
+	if a.RemainingExecutions <= 1 {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return AcceptResponse{Accept: true, Updated: &MaxExecutionsAuthorization{Msg: a.Msg, RemainingExecutions: a.RemainingExecutions - 1}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MaxExecutionsAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return errors.New("msg type cannot be empty")
+	}
+	if a.RemainingExecutions == 0 {
+		return errors.New("remaining executions must be positive")
+	}
+	return nil
+}
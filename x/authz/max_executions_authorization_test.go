@@ -0,0 +1,34 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMaxExecutionsAuthorization(t *testing.T) {
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	require.Error(t, authz.NewMaxExecutionsAuthorization("", 1).ValidateBasic())
+	require.Error(t, authz.NewMaxExecutionsAuthorization(msgTypeURL, 0).ValidateBasic())
+
+	a := authz.NewMaxExecutionsAuthorization(msgTypeURL, 2)
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, msgTypeURL, a.MsgTypeURL())
+
+	t.Log("verify the remaining executions are decremented")
+	resp, err := a.Accept(nil, &banktypes.MsgSend{})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, authz.NewMaxExecutionsAuthorization(msgTypeURL, 1), resp.Updated)
+
+	t.Log("verify the authorization is deleted after the last execution")
+	resp, err = resp.Updated.Accept(nil, &banktypes.MsgSend{})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+}
@@ -82,6 +82,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query for send enabled entries",
 					Long: strings.TrimSpace(`Query for send enabled entries that have been specifically set.
 			
+To look up one or more specific denoms, supply them as arguments to this command.
+To look up all denoms, do not provide any arguments.`,
+					),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms", Varargs: true}},
+				},
+				{
+					RpcMethod: "SendPolicies",
+					Use:       "send-policies [denom1 ...]",
+					Short:     "Query for denom send policies",
+					Long: strings.TrimSpace(`Query for the send policies that have been set on denoms.
+
 To look up one or more specific denoms, supply them as arguments to this command.
 To look up all denoms, do not provide any arguments.`,
 					),
@@ -122,6 +133,17 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "SetSendPolicies",
+					Use:            "set-send-policies-proposal [send_policies]",
+					Short:          "Submit a proposal to set/update/remove denom send policies",
+					Example:        fmt.Sprintf(`%s tx bank set-send-policies-proposal '{"denom":"stake","address_list_mode":"ADDRESS_LIST_MODE_DENYLIST","addresses":["cosmos1..."]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "send_policies", Varargs: true}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"remove_denoms": {Name: "remove-denoms", Usage: "Denoms whose send policy should be removed"},
+					},
+					GovProposal: true,
+				},
 			},
 		},
 	}
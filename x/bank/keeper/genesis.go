@@ -54,6 +54,12 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, policy := range genState.SendPolicies {
+		if err := k.SetSendPolicy(ctx, policy); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -70,5 +76,6 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+	rv.SendPolicies = k.GetAllSendPolicies(ctx)
 	return rv
 }
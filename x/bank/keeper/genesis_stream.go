@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// InitGenesisStream initializes the bank module's state from a genesis source,
+// reading the balances one account at a time.
+func (k BaseKeeper) InitGenesisStream(ctx context.Context, cdc codec.JSONCodec, source appmodule.GenesisSource) error {
+	var params types.Params
+	if _, err := module.ReadGenesisField(cdc, source, types.GenesisFieldParams, &params); err != nil {
+		return err
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	err := module.ReadGenesisArray(cdc, source, types.GenesisFieldSendEnabled, func() *types.SendEnabled { return &types.SendEnabled{} }, func(se *types.SendEnabled) error {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	totalSupplyMap := sdk.NewMapCoins(sdk.Coins{})
+	err = module.ReadGenesisArray(cdc, source, types.GenesisFieldBalances, func() *types.Balance { return &types.Balance{} }, func(balance *types.Balance) error {
+		addr, err := k.ak.AddressCodec().StringToBytes(balance.Address)
+		if err != nil {
+			return err
+		}
+
+		for _, coin := range balance.Coins {
+			if err := k.Balances.Set(ctx, collections.Join(sdk.AccAddress(addr), coin.Denom), coin.Amount); err != nil {
+				return err
+			}
+		}
+
+		totalSupplyMap.Add(balance.Coins...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	totalSupply := totalSupplyMap.ToCoins()
+
+	var supply sdk.Coins
+	err = module.ReadGenesisArray(cdc, source, types.GenesisFieldSupply, func() *sdk.Coin { return &sdk.Coin{} }, func(coin *sdk.Coin) error {
+		supply = append(supply, *coin)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if !supply.Empty() && !supply.Equal(totalSupply) {
+		return fmt.Errorf("genesis supply is incorrect, expected %v, got %v", supply, totalSupply)
+	}
+
+	for _, coin := range totalSupply {
+		k.setSupply(ctx, coin)
+	}
+
+	err = module.ReadGenesisArray(cdc, source, types.GenesisFieldDenomMetadata, func() *types.Metadata { return &types.Metadata{} }, func(meta *types.Metadata) error {
+		k.SetDenomMetaData(ctx, *meta)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return module.ReadGenesisArray(cdc, source, types.GenesisFieldSendPolicies, func() *types.SendPolicy { return &types.SendPolicy{} }, func(policy *types.SendPolicy) error {
+		return k.SetSendPolicy(ctx, *policy)
+	})
+}
+
+// ExportGenesisStream writes the bank module's state to a genesis target,
+// writing the balances one account at a time.
+func (k BaseKeeper) ExportGenesisStream(ctx context.Context, cdc codec.JSONCodec, target appmodule.GenesisTarget) error {
+	params := k.GetParams(ctx)
+	if err := module.WriteGenesisField(cdc, target, types.GenesisFieldParams, &params); err != nil {
+		return err
+	}
+
+	err := module.WriteGenesisArray(cdc, target, types.GenesisFieldBalances, func(emit func(proto.Message) error) error {
+		// the balances are grouped by account as they are ordered by address
+		var balance *types.Balance
+		err := k.Balances.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], amount math.Int) (bool, error) {
+			addr, err := k.ak.AddressCodec().BytesToString(key.K1())
+			if err != nil {
+				return true, err
+			}
+
+			if balance != nil && balance.Address != addr {
+				if err := emit(balance); err != nil {
+					return true, err
+				}
+				balance = nil
+			}
+			if balance == nil {
+				balance = &types.Balance{Address: addr}
+			}
+
+			balance.Coins = append(balance.Coins, sdk.NewCoin(key.K2(), amount))
+			return false, nil
+		})
+		if err != nil || balance == nil {
+			return err
+		}
+		return emit(balance)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = module.WriteGenesisArray(cdc, target, types.GenesisFieldSupply, func(emit func(proto.Message) error) error {
+		return k.Supply.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+			coin := sdk.NewCoin(denom, amount)
+			return false, emit(&coin)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	err = module.WriteGenesisArray(cdc, target, types.GenesisFieldDenomMetadata, func(emit func(proto.Message) error) error {
+		return k.BaseViewKeeper.DenomMetadata.Walk(ctx, nil, func(_ string, metadata types.Metadata) (bool, error) {
+			return false, emit(&metadata)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	err = module.WriteGenesisArray(cdc, target, types.GenesisFieldSendEnabled, func(emit func(proto.Message) error) error {
+		return k.BaseViewKeeper.SendEnabled.Walk(ctx, nil, func(denom string, enabled bool) (bool, error) {
+			return false, emit(types.NewSendEnabled(denom, enabled))
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return module.WriteGenesisArray(cdc, target, types.GenesisFieldSendPolicies, func(emit func(proto.Message) error) error {
+		return k.BaseViewKeeper.SendPolicies.Walk(ctx, nil, func(_ string, policy types.SendPolicy) (bool, error) {
+			return false, emit(&policy)
+		})
+	})
+}
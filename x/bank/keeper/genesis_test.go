@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
 	"math"
 
 	sdkmath "cosmossdk.io/math"
@@ -130,3 +133,65 @@ func (suite *KeeperTestSuite) TestTotalSupply() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGenesisStream() {
+	defaultGenesis := types.DefaultGenesisState()
+	balances := []types.Balance{
+		{Coins: sdk.NewCoins(sdk.NewCoin("foocoin", sdkmath.NewInt(1))), Address: "cosmos1f9xjhxm0plzrh9cskf4qee4pc2xwp0n0556gh0"},
+		{Coins: sdk.NewCoins(sdk.NewCoin("barcoin", sdkmath.NewInt(1))), Address: "cosmos1t5u0jfg3ljsjrh2m9e47d4ny2hea7eehxrzdgd"},
+		{Coins: sdk.NewCoins(sdk.NewCoin("foocoin", sdkmath.NewInt(10)), sdk.NewCoin("barcoin", sdkmath.NewInt(20))), Address: "cosmos1m3h30wlvsf8llruxtpukdvsy0km2kum8g38c8q"},
+	}
+	genesis := types.NewGenesisState(defaultGenesis.Params, balances, nil, suite.getTestMetadata()[:1], []types.SendEnabled{{Denom: "foocoin", Enabled: false}})
+	suite.bankKeeper.InitGenesis(suite.ctx, genesis)
+	expected := suite.bankKeeper.ExportGenesis(suite.ctx)
+
+	// the streamed state has the format of the genesis state
+	streamed := genesisFields{}
+	suite.Require().NoError(suite.bankKeeper.ExportGenesisStream(suite.ctx, suite.encCfg.Codec, streamed.target))
+	bz, err := json.Marshal(streamed)
+	suite.Require().NoError(err)
+	var exported types.GenesisState
+	suite.Require().NoError(suite.encCfg.Codec.UnmarshalJSON(bz, &exported))
+	suite.Require().Equal(suite.encCfg.Codec.MustMarshalJSON(expected), suite.encCfg.Codec.MustMarshalJSON(&exported))
+
+	suite.Require().NoError(types.ValidateGenesisStream(suite.encCfg.Codec, streamed.source))
+
+	suite.SetupTest()
+	suite.Require().NoError(suite.bankKeeper.InitGenesisStream(suite.ctx, suite.encCfg.Codec, streamed.source))
+	suite.Require().Equal(expected, suite.bankKeeper.ExportGenesis(suite.ctx))
+
+	// the supply must match the balances
+	streamed[types.GenesisFieldSupply] = json.RawMessage(`[{"denom":"foocoin","amount":"1"}]`)
+	suite.Require().ErrorContains(types.ValidateGenesisStream(suite.encCfg.Codec, streamed.source), "genesis supply is incorrect")
+
+	// balances must not be duplicated
+	streamed[types.GenesisFieldSupply] = json.RawMessage(`[]`)
+	streamed[types.GenesisFieldBalances] = json.RawMessage(`[{"address":"cosmos1f9xjhxm0plzrh9cskf4qee4pc2xwp0n0556gh0","coins":[]},{"address":"cosmos1f9xjhxm0plzrh9cskf4qee4pc2xwp0n0556gh0","coins":[]}]`)
+	suite.Require().ErrorContains(types.ValidateGenesisStream(suite.encCfg.Codec, streamed.source), "duplicate balance")
+}
+
+// genesisFields is an in-memory genesis source and target.
+type genesisFields map[string]json.RawMessage
+
+func (g genesisFields) source(field string) (io.ReadCloser, error) {
+	bz, ok := g[field]
+	if !ok {
+		return nil, nil
+	}
+	return io.NopCloser(bytes.NewReader(bz)), nil
+}
+
+func (g genesisFields) target(field string) (io.WriteCloser, error) {
+	return &genesisFieldWriter{fields: g, field: field}, nil
+}
+
+type genesisFieldWriter struct {
+	bytes.Buffer
+	fields genesisFields
+	field  string
+}
+
+func (w *genesisFieldWriter) Close() error {
+	w.fields[w.field] = w.Bytes()
+	return nil
+}
@@ -301,6 +301,36 @@ func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabl
 	return resp, nil
 }
 
+// SendPolicies implements the Query/SendPolicies gRPC method
+func (k BaseKeeper) SendPolicies(ctx context.Context, req *types.QuerySendPoliciesRequest) (*types.QuerySendPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	resp := &types.QuerySendPoliciesResponse{}
+	if len(req.Denoms) > 0 {
+		for _, denom := range req.Denoms {
+			if policy, ok := k.GetSendPolicy(ctx, denom); ok {
+				resp.SendPolicies = append(resp.SendPolicies, policy)
+			}
+		}
+	} else {
+		results, pageResp, err := query.CollectionPaginate(
+			ctx,
+			k.BaseViewKeeper.SendPolicies,
+			req.Pagination, func(_ string, value types.SendPolicy) (types.SendPolicy, error) {
+				return value, nil
+			},
+		)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.SendPolicies = results
+		resp.Pagination = pageResp
+	}
+
+	return resp, nil
+}
+
 // DenomOwnersByQuery is identical to DenomOwner query, but receives denom values via query string.
 func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDenomOwnersByQueryRequest) (*types.QueryDenomOwnersByQueryResponse, error) {
 	if req == nil {
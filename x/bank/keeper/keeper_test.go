@@ -215,6 +215,14 @@ func (suite *KeeperTestSuite) mockSendCoins(ctx context.Context, sender sdk.Acco
 	suite.authKeeper.EXPECT().HasAccount(ctx, receiver).Return(true)
 }
 
+func (suite *KeeperTestSuite) mockModulePermissions(moduleAccs ...*authtypes.ModuleAccount) {
+	perms := make(map[string]authtypes.PermissionsForAddress, len(moduleAccs))
+	for _, acc := range moduleAccs {
+		perms[acc.Name] = authtypes.NewPermissionsForAddress(acc.Name, acc.Permissions)
+	}
+	suite.authKeeper.EXPECT().GetModulePermissions().Return(perms).AnyTimes()
+}
+
 func (suite *KeeperTestSuite) mockFundAccount(receiver sdk.AccAddress) {
 	suite.mockMintCoins(mintAcc)
 	suite.mockSendCoinsFromModuleToAccount(mintAcc, receiver)
@@ -183,3 +183,45 @@ func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEn
 
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
+
+// SetSendPolicies implements the MsgServer.SetSendPolicies method.
+func (k msgServer) SetSendPolicies(goCtx context.Context, msg *types.MsgSetSendPolicies) (*types.MsgSetSendPoliciesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, k.GetAuthority(), msg.Authority); err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for _, policy := range msg.SendPolicies {
+		if seen[policy.Denom] {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate send policies found for %q", policy.Denom)
+		}
+
+		seen[policy.Denom] = true
+
+		if err := policy.Validate(); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid send policy: %s", err)
+		}
+	}
+
+	for _, denom := range msg.RemoveDenoms {
+		if seen[denom] {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("denom %q is both set and removed", denom)
+		}
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid RemoveDenoms denom %q: %s", denom, err)
+		}
+	}
+
+	for _, policy := range msg.SendPolicies {
+		if err := k.SetSendPolicy(ctx, policy); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid send policy: %s", err)
+		}
+	}
+	if len(msg.RemoveDenoms) > 0 {
+		k.DeleteSendPolicies(ctx, msg.RemoveDenoms...)
+	}
+
+	return &types.MsgSetSendPoliciesResponse{}, nil
+}
@@ -44,6 +44,11 @@ type SendKeeper interface {
 	IsSendEnabledCoin(ctx context.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 
+	GetSendPolicy(ctx context.Context, denom string) (types.SendPolicy, bool)
+	SetSendPolicy(ctx context.Context, policy types.SendPolicy) error
+	DeleteSendPolicies(ctx context.Context, denoms ...string)
+	GetAllSendPolicies(ctx context.Context) []types.SendPolicy
+
 	BlockedAddr(addr sdk.AccAddress) bool
 	GetBlockedAddresses() map[string]bool
 
@@ -85,7 +90,7 @@ func NewBaseSendKeeper(
 		panic(fmt.Errorf("invalid bank authority address: %w", err))
 	}
 
-	return BaseSendKeeper{
+	k := BaseSendKeeper{
 		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeService, ak, logger),
 		cdc:             cdc,
 		ak:              ak,
@@ -95,6 +100,9 @@ func NewBaseSendKeeper(
 		logger:          logger,
 		sendRestriction: newSendRestriction(),
 	}
+	k.sendRestriction.policy = k.SendPolicyRestrictionFn
+
+	return k
 }
 
 // AppendSendRestriction adds the provided SendRestrictionFn to run after previously provided restrictions.
@@ -519,6 +527,10 @@ func (k BaseSendKeeper) getSendEnabledOrDefault(ctx context.Context, denom strin
 // It exists so that the SendRestrictionFn can be updated in the SendKeeper without needing to have a pointer receiver.
 type sendRestriction struct {
 	fn types.SendRestrictionFn
+
+	// policy is the built-in restriction enforcing the denom send policies. It
+	// runs after fn, on the final recipient, and is not affected by clear.
+	policy types.SendRestrictionFn
 }
 
 // newSendRestriction creates a new sendRestriction with nil send restriction.
@@ -547,8 +559,17 @@ var _ types.SendRestrictionFn = (*sendRestriction)(nil).apply
 
 // apply applies the send restriction if there is one. If not, it's a no-op.
 func (r *sendRestriction) apply(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
-	if r == nil || r.fn == nil {
+	if r == nil {
+		return toAddr, nil
+	}
+	if r.fn != nil {
+		var err error
+		if toAddr, err = r.fn(ctx, fromAddr, toAddr, amt); err != nil {
+			return toAddr, err
+		}
+	}
+	if r.policy == nil {
 		return toAddr, nil
 	}
-	return r.fn(ctx, fromAddr, toAddr, amt)
+	return r.policy(ctx, fromAddr, toAddr, amt)
 }
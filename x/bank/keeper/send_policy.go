@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetSendPolicy returns the send policy of a denom and whether it exists.
+func (k BaseSendKeeper) GetSendPolicy(ctx context.Context, denom string) (types.SendPolicy, bool) {
+	policy, err := k.SendPolicies.Get(ctx, denom)
+	if err != nil {
+		return types.SendPolicy{}, false
+	}
+
+	return policy, true
+}
+
+// SetSendPolicy sets the send policy of a denom, replacing the existing one.
+// The addresses of the policy are validated and stored in their canonical
+// form, so that they can be compared with the encoded sender and receiver.
+func (k BaseSendKeeper) SetSendPolicy(ctx context.Context, policy types.SendPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	var err error
+	if policy.Addresses, err = k.canonicalAddresses(policy.Addresses); err != nil {
+		return fmt.Errorf("invalid addresses for denom %s: %w", policy.Denom, err)
+	}
+	if policy.ReceiverAllowlist, err = k.canonicalAddresses(policy.ReceiverAllowlist); err != nil {
+		return fmt.Errorf("invalid receiver allowlist for denom %s: %w", policy.Denom, err)
+	}
+
+	has, err := k.SendPolicies.Has(ctx, policy.Denom)
+	if err != nil {
+		return err
+	}
+	if !has {
+		if err := k.addSendPolicyCount(ctx, 1); err != nil {
+			return err
+		}
+	}
+
+	return k.SendPolicies.Set(ctx, policy.Denom, policy)
+}
+
+// DeleteSendPolicies removes the send policies of the given denoms.
+func (k BaseSendKeeper) DeleteSendPolicies(ctx context.Context, denoms ...string) {
+	for _, denom := range denoms {
+		has, err := k.SendPolicies.Has(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+		if !has {
+			continue
+		}
+
+		if err := k.SendPolicies.Remove(ctx, denom); err != nil {
+			panic(err)
+		}
+		if err := k.BlockTransferTallies.Remove(ctx, denom); err != nil {
+			panic(err)
+		}
+		if err := k.addSendPolicyCount(ctx, -1); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetAllSendPolicies returns all the send policies that are stored.
+func (k BaseSendKeeper) GetAllSendPolicies(ctx context.Context) []types.SendPolicy {
+	var rv []types.SendPolicy
+	err := k.SendPolicies.Walk(ctx, nil, func(_ string, policy types.SendPolicy) (bool, error) {
+		rv = append(rv, policy)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return rv
+}
+
+// SendPolicyRestrictionFn is the built-in SendRestrictionFn enforcing the send
+// policies of the denoms being sent. It always runs after the send
+// restrictions registered with the keeper, and only applies to transfers
+// between accounts that are not module accounts.
+func (k BaseSendKeeper) SendPolicyRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if !k.hasSendPolicies(ctx) {
+		return toAddr, nil
+	}
+
+	// transfers from and to module accounts, such as minting, fee deduction
+	// and reward distribution, are not subject to the policies
+	if k.isModuleAccount(fromAddr) || k.isModuleAccount(toAddr) {
+		return toAddr, nil
+	}
+
+	var fromStr, toStr string
+	for _, coin := range amt {
+		policy, found := k.GetSendPolicy(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+
+		if fromStr == "" {
+			var err error
+			if fromStr, err = k.ak.AddressCodec().BytesToString(fromAddr); err != nil {
+				return nil, err
+			}
+			if toStr, err = k.ak.AddressCodec().BytesToString(toAddr); err != nil {
+				return nil, err
+			}
+		}
+
+		if !policy.IsSenderAllowed(fromStr) {
+			return nil, errorsmod.Wrapf(types.ErrSendPolicyViolation, "%s is not allowed to send %s", fromStr, coin.Denom)
+		}
+
+		if !policy.IsReceiverAllowed(toStr) {
+			return nil, errorsmod.Wrapf(types.ErrSendPolicyViolation, "%s is not allowed to receive %s", toStr, coin.Denom)
+		}
+
+		if policy.MaxTransferAmount.IsPositive() && coin.Amount.GT(policy.MaxTransferAmount) {
+			return nil, errorsmod.Wrapf(types.ErrSendPolicyViolation, "%s exceeds the max transfer amount of %s%s", coin, policy.MaxTransferAmount, coin.Denom)
+		}
+
+		if policy.BlockTransferCap.IsPositive() {
+			if err := k.addBlockTransfer(ctx, coin, policy.BlockTransferCap); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return toAddr, nil
+}
+
+// addBlockTransfer adds coin to the amount of its denom transferred in the
+// current block, returning an error if the total exceeds blockCap.
+func (k BaseSendKeeper) addBlockTransfer(ctx context.Context, coin sdk.Coin, blockCap math.Int) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	tally, err := k.BlockTransferTallies.Get(ctx, coin.Denom)
+	if errors.Is(err, collections.ErrNotFound) || (err == nil && tally.Height != height) {
+		tally = types.BlockTransferTally{Height: height, Amount: math.ZeroInt()}
+	} else if err != nil {
+		return err
+	}
+
+	tally.Amount = tally.Amount.Add(coin.Amount)
+	if tally.Amount.GT(blockCap) {
+		return errorsmod.Wrapf(types.ErrSendPolicyViolation, "transfers of %s exceed the block transfer cap of %s", coin.Denom, blockCap)
+	}
+
+	return k.BlockTransferTallies.Set(ctx, coin.Denom, tally)
+}
+
+// hasSendPolicies reports whether any send policy is stored. The count is read
+// without consuming gas, so that sends on chains without policies cost the same
+// as before send policies were introduced.
+func (k BaseSendKeeper) hasSendPolicies(ctx context.Context) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	count, err := k.SendPolicyCount.Get(sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	return err == nil && count > 0
+}
+
+// addSendPolicyCount adds delta to the number of stored send policies.
+func (k BaseSendKeeper) addSendPolicyCount(ctx context.Context, delta int64) error {
+	count, err := k.SendPolicyCount.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	return k.SendPolicyCount.Set(ctx, uint64(int64(count)+delta))
+}
+
+// isModuleAccount reports whether addr is the address of a module account
+// registered with the account keeper.
+func (k BaseSendKeeper) isModuleAccount(addr sdk.AccAddress) bool {
+	for _, perms := range k.ak.GetModulePermissions() {
+		if perms.GetAddress().Equals(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// canonicalAddresses decodes the addresses with the address codec and returns
+// them re-encoded, rejecting addresses that are duplicates once re-encoded.
+func (k BaseSendKeeper) canonicalAddresses(addrs []string) ([]string, error) {
+	if len(addrs) == 0 {
+		return addrs, nil
+	}
+
+	canonical := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		bz, err := k.ak.AddressCodec().StringToBytes(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		str, err := k.ak.AddressCodec().BytesToString(bz)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.Contains(canonical, str) {
+			return nil, fmt.Errorf("duplicate address %s", str)
+		}
+		canonical = append(canonical, str)
+	}
+
+	return canonical, nil
+}
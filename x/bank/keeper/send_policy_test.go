@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestSendPolicyRestrictionFn() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithBlockHeight(10)
+	suite.ctx = ctx
+	require := suite.Require()
+	k := suite.bankKeeper
+
+	suite.mockModulePermissions(mintAcc)
+	suite.mockFundAccount(accAddrs[3])
+	require.NoError(banktestutil.FundAccount(ctx, k, accAddrs[3], sdk.NewCoins(newFooCoin(10))))
+
+	allow := banktypes.NewSendPolicy(fooDenom, banktypes.ADDRESS_LIST_MODE_ALLOWLIST, []string{accAddrs[0].String()}, []string{accAddrs[1].String(), accAddrs[2].String()})
+	allow.MaxTransferAmount = math.NewInt(100)
+	allow.BlockTransferCap = math.NewInt(150)
+	deny := banktypes.NewSendPolicy(barDenom, banktypes.ADDRESS_LIST_MODE_DENYLIST, []string{accAddrs[3].String()}, nil)
+	require.NoError(k.SetSendPolicy(ctx, allow))
+	require.NoError(k.SetSendPolicy(ctx, deny))
+
+	testCases := []struct {
+		name   string
+		from   sdk.AccAddress
+		to     sdk.AccAddress
+		amt    sdk.Coins
+		expErr string
+	}{
+		{"denom without policy", accAddrs[3], accAddrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), ""},
+		{"allowed sender and receiver", accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(100)), ""},
+		{"sender not allowlisted", accAddrs[1], accAddrs[2], sdk.NewCoins(newFooCoin(1)), "is not allowed to send foo"},
+		{"receiver not allowlisted", accAddrs[0], accAddrs[3], sdk.NewCoins(newFooCoin(1)), "is not allowed to receive foo"},
+		{"max transfer amount exceeded", accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(101)), "exceeds the max transfer amount"},
+		{"block transfer cap exceeded", accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(51)), "exceed the block transfer cap"},
+		{"within block transfer cap", accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(50)), ""},
+		{"denylisted sender", accAddrs[3], accAddrs[0], sdk.NewCoins(newBarCoin(1)), "is not allowed to send bar"},
+		{"denylisted receiver", accAddrs[0], accAddrs[3], sdk.NewCoins(newBarCoin(1)), "is not allowed to receive bar"},
+		{"not denylisted", accAddrs[1], accAddrs[0], sdk.NewCoins(newBarCoin(1000)), ""},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			to, err := k.SendPolicyRestrictionFn(ctx, tc.from, tc.to, tc.amt)
+			if tc.expErr != "" {
+				require.ErrorIs(err, banktypes.ErrSendPolicyViolation)
+				require.ErrorContains(err, tc.expErr)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tc.to, to)
+		})
+	}
+
+	// the block transfer cap resets in the next block
+	_, err := k.SendPolicyRestrictionFn(ctx.WithBlockHeight(11), accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(100)))
+	require.NoError(err)
+
+	// the policies still apply once the custom send restrictions are cleared
+	k.ClearSendRestriction()
+	acc3 := authtypes.NewBaseAccountWithAddress(accAddrs[3])
+	err = k.SendCoins(ctx, accAddrs[3], accAddrs[1], sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrSendPolicyViolation)
+
+	k.DeleteSendPolicies(ctx, fooDenom)
+	suite.mockSendCoins(ctx, acc3, accAddrs[1])
+	require.NoError(k.SendCoins(ctx, accAddrs[3], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+}
+
+func (suite *KeeperTestSuite) TestSendPolicyModuleAccounts() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	k := suite.bankKeeper
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
+	suite.mockModulePermissions(mintAcc, feeCollectorAcc)
+
+	// only accAddrs[0] may send foo, only to accAddrs[1], and at most 10foo per block
+	policy := banktypes.NewSendPolicy(fooDenom, banktypes.ADDRESS_LIST_MODE_ALLOWLIST, []string{accAddrs[0].String()}, []string{accAddrs[1].String()})
+	policy.BlockTransferCap = math.NewInt(10)
+	require.NoError(k.SetSendPolicy(ctx, policy))
+
+	// minting to an account is not restricted
+	suite.mockFundAccount(accAddrs[2])
+	require.NoError(banktestutil.FundAccount(ctx, k, accAddrs[2], sdk.NewCoins(newFooCoin(100))))
+
+	// neither is deducting fees, which does not count towards the block transfer cap
+	acc2 := authtypes.NewBaseAccountWithAddress(accAddrs[2])
+	suite.mockSendCoinsFromAccountToModule(acc2, feeCollectorAcc)
+	require.NoError(k.SendCoinsFromAccountToModule(ctx, accAddrs[2], authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(50))))
+
+	tally, err := k.BlockTransferTallies.Get(ctx, fooDenom)
+	require.ErrorIs(err, collections.ErrNotFound, "unexpected tally %s", tally.Amount)
+
+	// transfers between accounts are still restricted
+	_, err = k.SendPolicyRestrictionFn(ctx, accAddrs[2], accAddrs[1], sdk.NewCoins(newFooCoin(1)))
+	require.ErrorContains(err, "is not allowed to send foo")
+}
+
+func (suite *KeeperTestSuite) TestSendPolicyCanonicalAddresses() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	k := suite.bankKeeper
+	suite.mockModulePermissions(mintAcc)
+
+	upper := strings.ToUpper(accAddrs[0].String())
+	policy := banktypes.NewSendPolicy(fooDenom, banktypes.ADDRESS_LIST_MODE_DENYLIST, []string{upper}, nil)
+	require.NoError(policy.Validate())
+	require.NoError(k.SetSendPolicy(ctx, policy))
+
+	stored, found := k.GetSendPolicy(ctx, fooDenom)
+	require.True(found)
+	require.Equal([]string{accAddrs[0].String()}, stored.Addresses)
+
+	_, err := k.SendPolicyRestrictionFn(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(1)))
+	require.ErrorContains(err, "is not allowed to send foo")
+
+	// addresses that are duplicates once canonicalized are rejected
+	policy.Addresses = []string{upper, accAddrs[0].String()}
+	require.ErrorContains(k.SetSendPolicy(ctx, policy), "duplicate address")
+
+	// addresses of another prefix are rejected
+	other, err := sdk.Bech32ifyAddressBytes("osmo", accAddrs[0])
+	require.NoError(err)
+	policy.Addresses = []string{other}
+	require.Error(k.SetSendPolicy(ctx, policy))
+}
+
+func (suite *KeeperTestSuite) TestSendPolicyNoGasWithoutPolicies() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	require := suite.Require()
+	k := suite.bankKeeper
+
+	_, err := k.SendPolicyRestrictionFn(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(1)))
+	require.NoError(err)
+	require.Zero(ctx.GasMeter().GasConsumed())
+
+	suite.mockModulePermissions()
+	require.NoError(k.SetSendPolicy(ctx, banktypes.NewSendPolicy(barDenom, banktypes.ADDRESS_LIST_MODE_UNSPECIFIED, nil, nil)))
+	k.DeleteSendPolicies(ctx, barDenom)
+	require.Empty(k.GetAllSendPolicies(ctx))
+
+	gas := ctx.GasMeter().GasConsumed()
+	_, err = k.SendPolicyRestrictionFn(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(1)))
+	require.NoError(err)
+	require.Equal(gas, ctx.GasMeter().GasConsumed())
+}
+
+func (suite *KeeperTestSuite) TestMsgSetSendPolicies() {
+	require := suite.Require()
+	authority := govAcc.GetAddress().String()
+	policy := banktypes.NewSendPolicy(fooDenom, banktypes.ADDRESS_LIST_MODE_DENYLIST, []string{accAddrs[0].String()}, nil)
+
+	testCases := []struct {
+		name   string
+		req    *banktypes.MsgSetSendPolicies
+		expErr string
+	}{
+		{
+			name:   "invalid authority",
+			req:    banktypes.NewMsgSetSendPolicies(accAddrs[0].String(), []banktypes.SendPolicy{policy}, nil),
+			expErr: "invalid authority",
+		},
+		{
+			name:   "duplicate denoms",
+			req:    banktypes.NewMsgSetSendPolicies(authority, []banktypes.SendPolicy{policy, policy}, nil),
+			expErr: "duplicate send policies",
+		},
+		{
+			name:   "invalid policy",
+			req:    banktypes.NewMsgSetSendPolicies(authority, []banktypes.SendPolicy{banktypes.NewSendPolicy(fooDenom, banktypes.ADDRESS_LIST_MODE_UNSPECIFIED, []string{accAddrs[0].String()}, nil)}, nil),
+			expErr: "invalid send policy",
+		},
+		{
+			name:   "set and removed",
+			req:    banktypes.NewMsgSetSendPolicies(authority, []banktypes.SendPolicy{policy}, []string{fooDenom}),
+			expErr: "both set and removed",
+		},
+		{
+			name: "all good",
+			req:  banktypes.NewMsgSetSendPolicies(authority, []banktypes.SendPolicy{policy}, []string{barDenom}),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SetSendPolicies(suite.ctx, tc.req)
+			if tc.expErr != "" {
+				require.ErrorContains(err, tc.expErr)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+
+	res, err := suite.queryClient.SendPolicies(suite.ctx, &banktypes.QuerySendPoliciesRequest{})
+	require.NoError(err)
+	require.Equal([]banktypes.SendPolicy{policy}, res.SendPolicies)
+
+	res, err = suite.queryClient.SendPolicies(suite.ctx, &banktypes.QuerySendPoliciesRequest{Denoms: []string{barDenom, fooDenom}})
+	require.NoError(err)
+	require.Equal([]banktypes.SendPolicy{policy}, res.SendPolicies)
+
+	_, err = suite.msgServer.SetSendPolicies(suite.ctx, banktypes.NewMsgSetSendPolicies(authority, nil, []string{fooDenom}))
+	require.NoError(err)
+	require.Empty(suite.bankKeeper.GetAllSendPolicies(suite.ctx))
+}
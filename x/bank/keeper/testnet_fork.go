@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// TestnetFork overrides the bank params and the account balances of a testnet
+// fork. The supply follows the balance changes.
+func (k BaseKeeper) TestnetFork(ctx context.Context, cdc codec.JSONCodec, spec *module.TestnetForkSpec) error {
+	params := k.GetParams(ctx)
+	if err := spec.ApplyParamsOverride(cdc, types.ModuleName, &params); err != nil {
+		return err
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	for _, acc := range spec.Accounts {
+		addr, err := k.ak.AddressCodec().StringToBytes(acc.Address)
+		if err != nil {
+			return fmt.Errorf("invalid account address %s: %w", acc.Address, err)
+		}
+
+		balance, err := sdk.ParseCoinsNormalized(acc.Balance)
+		if err != nil {
+			return fmt.Errorf("invalid balance of account %s: %w", acc.Address, err)
+		}
+
+		if err := k.SetTestnetBalance(ctx, addr, balance); err != nil {
+			return fmt.Errorf("account %s: %w", acc.Address, err)
+		}
+	}
+
+	return nil
+}
+
+// SetTestnetBalance sets the balance of the account for each denom of balance,
+// creating the account if needed, and updates the supply accordingly.
+func (k BaseKeeper) SetTestnetBalance(ctx context.Context, addr sdk.AccAddress, balance sdk.Coins) error {
+	if !k.ak.HasAccount(ctx, addr) {
+		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, addr))
+	}
+
+	for _, coin := range balance {
+		supply := k.GetSupply(ctx, coin.Denom)
+		supply.Amount = supply.Amount.Add(coin.Amount).Sub(k.GetBalance(ctx, addr, coin.Denom).Amount)
+
+		if err := k.UncheckedSetBalance(ctx, addr, coin); err != nil {
+			return err
+		}
+		k.setSupply(ctx, supply)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestTestnetFork() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	spec := &module.TestnetForkSpec{
+		Accounts: []module.TestnetForkAccount{
+			{Address: accAddrs[0].String(), Balance: "30foo"},
+			{Address: accAddrs[1].String(), Balance: "1000foo,20bar"},
+		},
+		Params: map[string]json.RawMessage{
+			banktypes.ModuleName: json.RawMessage(`{"default_send_enabled": false}`),
+		},
+	}
+
+	suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[0]).Return(true)
+	suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[1]).Return(false)
+	suite.authKeeper.EXPECT().NewAccountWithAddress(ctx, accAddrs[1]).Return(authtypes.NewBaseAccountWithAddress(accAddrs[1]))
+	suite.authKeeper.EXPECT().SetAccount(ctx, gomock.Any())
+	require.NoError(suite.bankKeeper.TestnetFork(ctx, suite.encCfg.Codec, spec))
+
+	// only the listed denoms are replaced
+	require.Equal(sdk.NewCoins(newFooCoin(30), newBarCoin(50)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(1000), newBarCoin(20)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+
+	// the supply follows the balances
+	require.Equal(newFooCoin(1030), suite.bankKeeper.GetSupply(ctx, fooDenom))
+	require.Equal(newBarCoin(70), suite.bankKeeper.GetSupply(ctx, barDenom))
+
+	require.False(suite.bankKeeper.GetParams(ctx).DefaultSendEnabled)
+
+	spec.Accounts = nil
+	spec.Params[banktypes.ModuleName] = json.RawMessage(`{"send_enabled_by_default": false}`)
+	require.ErrorContains(suite.bankKeeper.TestnetFork(ctx, suite.encCfg.Codec, spec), "unknown parameter send_enabled_by_default")
+}
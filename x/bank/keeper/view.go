@@ -67,21 +67,31 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	SendPolicies  collections.Map[string, types.SendPolicy]
+	// BlockTransferTallies tracks the amounts transferred in the current block
+	// of the denoms with a block transfer cap.
+	BlockTransferTallies collections.Map[string, types.BlockTransferTally]
+	// SendPolicyCount is the number of stored send policies, used to skip the
+	// policy lookups when there are none.
+	SendPolicyCount collections.Item[uint64]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
 func NewBaseViewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, ak types.AccountKeeper, logger log.Logger) BaseViewKeeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := BaseViewKeeper{
-		cdc:           cdc,
-		storeService:  storeService,
-		ak:            ak,
-		logger:        logger,
-		Supply:        collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
-		DenomMetadata: collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
-		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
-		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		cdc:                  cdc,
+		storeService:         storeService,
+		ak:                   ak,
+		logger:               logger,
+		Supply:               collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		DenomMetadata:        collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
+		SendEnabled:          collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
+		Balances:             collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
+		Params:               collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		SendPolicies:         collections.NewMap(sb, types.SendPoliciesPrefix, "send_policies", collections.StringKey, codec.CollValue[types.SendPolicy](cdc)),
+		BlockTransferTallies: collections.NewMap(sb, types.BlockTransferTallyPrefix, "block_transfer_tallies", collections.StringKey, codec.CollValue[types.BlockTransferTally](cdc)),
+		SendPolicyCount:      collections.NewItem(sb, types.SendPolicyCountKey, "send_policy_count", collections.Uint64Value),
 	}
 
 	schema, err := sb.Build()
@@ -80,6 +80,7 @@ func TestMigrateJSON(t *testing.T) {
 		"send_enabled": []
 	},
 	"send_enabled": [],
+	"send_policies": [],
 	"supply": [
 		{
 			"amount": "20",
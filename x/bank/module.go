@@ -42,6 +42,8 @@ var (
 	_ module.AppModuleBasic      = AppModule{}
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.HasGenesis          = AppModule{}
+	_ module.HasStreamingGenesis = AppModule{}
+	_ module.HasTestnetFork      = AppModule{}
 	_ module.HasServices         = AppModule{}
 
 	_ appmodule.AppModule     = AppModule{}
@@ -78,6 +80,12 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 	return data.Validate()
 }
 
+// ValidateGenesisStream performs genesis state validation for the bank module,
+// reading the balances one account at a time.
+func (AppModuleBasic) ValidateGenesisStream(cdc codec.JSONCodec, _ client.TxEncodingConfig, source appmodule.GenesisSource) error {
+	return types.ValidateGenesisStream(cdc, source)
+}
+
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the bank module.
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
 	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
@@ -163,6 +171,23 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// InitGenesisStream performs genesis initialization for the bank module from a
+// genesis source, reading the balances one account at a time.
+func (am AppModule) InitGenesisStream(ctx context.Context, cdc codec.JSONCodec, source appmodule.GenesisSource) error {
+	return am.keeper.InitGenesisStream(ctx, cdc, source)
+}
+
+// ExportGenesisStream exports the bank module's state to a genesis target,
+// writing the balances one account at a time.
+func (am AppModule) ExportGenesisStream(ctx context.Context, cdc codec.JSONCodec, target appmodule.GenesisTarget) error {
+	return am.keeper.ExportGenesisStream(ctx, cdc, target)
+}
+
+// TestnetFork overrides the bank params and the account balances of a testnet fork.
+func (am AppModule) TestnetFork(ctx context.Context, spec *module.TestnetForkSpec) error {
+	return am.keeper.TestnetFork(ctx, am.cdc, spec)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
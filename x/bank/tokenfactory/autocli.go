@@ -0,0 +1,94 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	tokenfactoryv1beta1 "cosmossdk.io/api/cosmos/tokenfactory/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: tokenfactoryv1beta1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current tokenfactory parameters",
+				},
+				{
+					RpcMethod:      "DenomAuthorityMetadata",
+					Use:            "denom-authority-metadata [denom]",
+					Short:          "Query the admin and hook of a factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsFromCreator",
+					Use:            "denoms-from-creator [creator]",
+					Short:          "Query all the factory denoms created by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: tokenfactoryv1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreateDenom",
+					Use:            "create-denom [subdenom]",
+					Short:          "Create a new factory/{sender}/{subdenom} denom, paying the denom creation fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subdenom"}},
+				},
+				{
+					RpcMethod:      "Mint",
+					Use:            "mint [amount]",
+					Short:          "Mint a factory denom to the sender or to --mint-to-address. Must be the denom admin.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "Burn",
+					Use:            "burn [amount]",
+					Short:          "Burn a factory denom from the sender or from --burn-from-address. Must be the denom admin.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ChangeAdmin",
+					Use:            "change-admin [denom] [new_admin]",
+					Short:          "Change the admin of a factory denom. Must be the denom admin.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "new_admin"}},
+				},
+				{
+					RpcMethod:      "SetDenomMetadata",
+					Use:            "set-denom-metadata [metadata]",
+					Short:          "Set the bank metadata of a factory denom. Must be the denom admin.",
+					Example:        fmt.Sprintf(`%s tx tokenfactory set-denom-metadata '{"base":"factory/cosmos1.../foo","display":"foo","name":"Foo","symbol":"FOO","denom_units":[{"denom":"factory/cosmos1.../foo","exponent":0}]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+				},
+				{
+					RpcMethod:      "SetDenomHook",
+					Use:            "set-denom-hook [denom] [hook]",
+					Short:          "Attach a registered denom hook to a factory denom, or detach it with an empty hook. Must be the denom admin.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "hook"}},
+				},
+				{
+					RpcMethod:      "ForceTransfer",
+					Use:            "force-transfer [amount] [from_address] [to_address]",
+					Short:          "Move a factory denom between two accounts. Must be the denom admin and force transfers must be enabled.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}, {ProtoField: "transfer_from_address"}, {ProtoField: "transfer_to_address"}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update tokenfactory module params. Note: the entire params must be provided.",
+					Example:        fmt.Sprintf(`%s tx tokenfactory update-params-proposal '{ "denom_creation_fee": [{"denom":"stake","amount":"1000000"}], "enable_force_transfer": false }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package tokenfactory
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	modulev1 "cosmossdk.io/api/cosmos/tokenfactory/module/v1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ depinject.OnePerModuleType = AppModule{}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am AppModule) IsOnePerModuleType() {}
+
+func init() {
+	appconfig.RegisterModule(&modulev1.Module{},
+		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetDenomHooks),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config       *modulev1.Module
+	Cdc          codec.Codec
+	StoreService store.KVStoreService
+
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	TokenFactoryKeeper keeper.Keeper
+	Module             appmodule.AppModule
+	SendRestriction    banktypes.SendRestrictionFn
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	feeCollectorName := in.Config.FeeCollectorName
+	if feeCollectorName == "" {
+		feeCollectorName = authtypes.FeeCollectorName
+	}
+
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	k := keeper.NewKeeper(in.Cdc, in.StoreService, in.AccountKeeper, in.BankKeeper, feeCollectorName, authority.String())
+	m := NewAppModule(k)
+
+	return ModuleOutputs{TokenFactoryKeeper: k, Module: m, SendRestriction: k.SendRestrictionFn}
+}
+
+// InvokeSetDenomHooks registers the denom hooks provided by other modules.
+func InvokeSetDenomHooks(keeper keeper.Keeper, hooks map[string]types.DenomHooksWrapper) error {
+	if hooks == nil {
+		return nil
+	}
+
+	for _, modName := range slices.Sorted(maps.Keys(hooks)) {
+		for _, name := range slices.Sorted(maps.Keys(hooks[modName].Hooks)) {
+			if keeper.HasDenomHook(name) {
+				return fmt.Errorf("denom hook %s provided by module %s is already registered", name, modName)
+			}
+			keeper.RegisterDenomHook(name, hooks[modName].Hooks[name])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CreateDenom creates the factory/{creator}/{subdenom} denom, charging the
+// denom creation fee to the creator, and returns the new denom.
+func (k Keeper) CreateDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	creatorStr, err := k.accountKeeper.AddressCodec().BytesToString(creator)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.GetTokenDenom(creatorStr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if has, err := k.DenomAuthority.Has(ctx, denom); err != nil {
+		return "", err
+	} else if has {
+		return "", errorsmod.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found || k.bankKeeper.HasSupply(ctx, denom) {
+		return "", errorsmod.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !params.DenomCreationFee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, k.feeCollectorName, params.DenomCreationFee); err != nil {
+			return "", err
+		}
+	}
+
+	if err := k.createDenomAfterValidation(ctx, creatorStr, denom, types.DenomAuthorityMetadata{Admin: creatorStr}); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// createDenomAfterValidation stores a denom which passed validation and
+// registers its default bank metadata.
+func (k Keeper) createDenomAfterValidation(ctx context.Context, creator, denom string, metadata types.DenomAuthorityMetadata) error {
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+			Base:       denom,
+			Display:    denom,
+			Name:       denom,
+			Symbol:     denom,
+		})
+	}
+
+	if err := k.DenomAuthority.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return k.CreatorDenoms.Set(ctx, collections.Join(creator, denom))
+}
+
+// checkAdmin returns an error unless sender is the admin of the factory denom.
+func (k Keeper) checkAdmin(ctx context.Context, sender, denom string) error {
+	metadata, err := k.DenomAuthority.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "denom %s was not created by the token factory", denom)
+	} else if err != nil {
+		return err
+	}
+
+	// admins are compared by address bytes, not by their bech32 form
+	if metadata.Admin == "" {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", sender, denom)
+	}
+	admin, err := k.accountKeeper.AddressCodec().StringToBytes(metadata.Admin)
+	if err != nil {
+		return err
+	}
+	senderBz, err := k.accountKeeper.AddressCodec().StringToBytes(sender)
+	if err != nil || !bytes.Equal(admin, senderBz) {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", sender, denom)
+	}
+
+	return nil
+}
+
+// SendRestrictionFn is a bank send restriction running the send restriction of
+// the hook attached to each factory denom being sent. It must be registered
+// with the bank keeper for denom hooks to apply to transfers.
+func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	for _, coin := range amt {
+		if !types.IsFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		hook, ok, err := k.getDenomHook(ctx, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if !ok || hook.SendRestriction == nil {
+			continue
+		}
+
+		toAddr, err = hook.SendRestriction(ctx, fromAddr, toAddr, sdk.NewCoins(coin))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return toAddr, nil
+}
+
+// getDenomHook returns the hook attached to a factory denom, if any.
+func (k Keeper) getDenomHook(ctx context.Context, denom string) (types.DenomHook, bool, error) {
+	name, err := k.DenomHooks.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DenomHook{}, false, nil
+	} else if err != nil {
+		return types.DenomHook{}, false, err
+	}
+
+	hook, ok := k.hooks[name]
+	return hook, ok, nil
+}
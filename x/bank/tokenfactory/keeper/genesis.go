@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/types"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a given genesis
+// state. Denom creation fees are not charged for genesis denoms.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, genDenom := range data.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(genDenom.Denom)
+		if err != nil {
+			return err
+		}
+
+		if _, err := k.accountKeeper.AddressCodec().StringToBytes(creator); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidGenesis, "invalid creator of %s: %s", genDenom.Denom, err)
+		}
+
+		if admin := genDenom.AuthorityMetadata.Admin; admin != "" {
+			if _, err := k.accountKeeper.AddressCodec().StringToBytes(admin); err != nil {
+				return errorsmod.Wrapf(types.ErrInvalidGenesis, "invalid admin of %s: %s", genDenom.Denom, err)
+			}
+		}
+
+		if genDenom.Hook != "" && !k.HasDenomHook(genDenom.Hook) {
+			return errorsmod.Wrapf(types.ErrUnknownHook, "hook %s of %s", genDenom.Hook, genDenom.Denom)
+		}
+
+		if err := k.createDenomAfterValidation(ctx, creator, genDenom.Denom, genDenom.AuthorityMetadata); err != nil {
+			return err
+		}
+
+		if genDenom.Hook != "" {
+			if err := k.DenomHooks.Set(ctx, genDenom.Denom, genDenom.Hook); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the tokenfactory module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := []types.GenesisDenom{}
+	err = k.DenomAuthority.Walk(ctx, nil, func(denom string, metadata types.DenomAuthorityMetadata) (bool, error) {
+		hook, err := k.DenomHooks.Get(ctx, denom)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return true, err
+		}
+
+		denoms = append(denoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+			Hook:              hook,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, denoms), nil
+}
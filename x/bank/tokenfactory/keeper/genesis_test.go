@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *KeeperTestSuite) TestGenesis() {
+	denomA := "factory/" + creatorAddr.String() + "/a"
+	denomB := "factory/" + otherAddr.String() + "/b"
+	s.keeper.RegisterDenomHook("noop", types.DenomHook{})
+	genState := types.NewGenesisState(
+		types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), true),
+		[]types.GenesisDenom{
+			{Denom: denomA, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creatorAddr.String()}, Hook: "noop"},
+			{Denom: denomB, AuthorityMetadata: types.DenomAuthorityMetadata{}},
+		},
+	)
+	s.Require().NoError(genState.Validate())
+
+	s.bankKeeper.EXPECT().GetDenomMetaData(gomock.Any(), denomA).Return(banktypes.Metadata{}, false)
+	s.bankKeeper.EXPECT().GetDenomMetaData(gomock.Any(), denomB).Return(banktypes.Metadata{Base: denomB}, true)
+	s.bankKeeper.EXPECT().SetDenomMetaData(gomock.Any(), gomock.Any())
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, genState))
+
+	res, err := s.queryServer.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: otherAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denomB}, res.Denoms)
+
+	metadata, err := s.queryServer.DenomAuthorityMetadata(s.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: denomA})
+	s.Require().NoError(err)
+	s.Require().Equal(creatorAddr.String(), metadata.AuthorityMetadata.Admin)
+	s.Require().Equal("noop", metadata.Hook)
+
+	_, err = s.queryServer.DenomAuthorityMetadata(s.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: "stake"})
+	s.Require().Error(err)
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(genState.Params, exported.Params)
+	s.Require().ElementsMatch(genState.FactoryDenoms, exported.FactoryDenoms)
+}
+
+func (s *KeeperTestSuite) TestInitGenesisInvalid() {
+	otherPrefixCreator, err := sdk.Bech32ifyAddressBytes("osmo", creatorAddr)
+	s.Require().NoError(err)
+	denom := "factory/" + creatorAddr.String() + "/a"
+
+	testCases := []struct {
+		name   string
+		denom  types.GenesisDenom
+		expErr error
+	}{
+		{
+			name:   "creator not encoded with the address codec",
+			denom:  types.GenesisDenom{Denom: "factory/" + otherPrefixCreator + "/a"},
+			expErr: types.ErrInvalidGenesis,
+		},
+		{
+			name:   "invalid admin",
+			denom:  types.GenesisDenom{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: otherPrefixCreator}},
+			expErr: types.ErrInvalidGenesis,
+		},
+		{
+			name:   "unknown hook",
+			denom:  types.GenesisDenom{Denom: denom, Hook: "unknown"},
+			expErr: types.ErrUnknownHook,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			genState := types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{tc.denom})
+			s.Require().NoError(genState.Validate())
+
+			err := s.keeper.InitGenesis(s.ctx, genState)
+			s.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/types"
+)
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	cdc              codec.BinaryCodec
+	storeService     storetypes.KVStoreService
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	// hooks are the denom hooks registered by the application, by name.
+	hooks map[string]types.DenomHook
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// DenomAuthority maps a factory denom to its authority metadata.
+	DenomAuthority collections.Map[string, types.DenomAuthorityMetadata]
+	// CreatorDenoms indexes the factory denoms by creator.
+	CreatorDenoms collections.KeySet[collections.Pair[string, string]]
+	// DenomHooks maps a factory denom to the name of its hook.
+	DenomHooks collections.Map[string, string]
+}
+
+// NewKeeper creates a new tokenfactory Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	feeCollectorName string,
+	authority string,
+) Keeper {
+	// ensure tokenfactory module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:              cdc,
+		storeService:     storeService,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+		hooks:            make(map[string]types.DenomHook),
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		DenomAuthority:   collections.NewMap(sb, types.DenomAuthorityKey, "denom_authority", collections.StringKey, codec.CollValue[types.DenomAuthorityMetadata](cdc)),
+		CreatorDenoms:    collections.NewKeySet(sb, types.CreatorDenomsKey, "creator_denoms", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		DenomHooks:       collections.NewMap(sb, types.DenomHooksKey, "denom_hooks", collections.StringKey, collections.StringValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/tokenfactory module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// RegisterDenomHook registers a denom hook under the given name so that denom
+// admins can attach it to their denoms. It must be called during app
+// initialization and panics if the name is already taken.
+func (k Keeper) RegisterDenomHook(name string, hook types.DenomHook) {
+	if name == "" {
+		panic("denom hook name cannot be empty")
+	}
+	if _, ok := k.hooks[name]; ok {
+		panic(fmt.Sprintf("denom hook %s already registered", name))
+	}
+	k.hooks[name] = hook
+}
+
+// HasDenomHook reports whether a denom hook is registered under the given name.
+func (k Keeper) HasDenomHook(name string) bool {
+	_, ok := k.hooks[name]
+	return ok
+}
+
+// GetAuthorityMetadata returns the authority metadata of a factory denom.
+func (k Keeper) GetAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	return k.DenomAuthority.Get(ctx, denom)
+}
+
+// GetDenomsFromCreator returns the factory denoms created by the given account.
+func (k Keeper) GetDenomsFromCreator(ctx context.Context, creator string) ([]string, error) {
+	var denoms []string
+	rng := collections.NewPrefixedPairRange[string, string](creator)
+	err := k.CreatorDenoms.Walk(ctx, rng, func(key collections.Pair[string, string]) (bool, error) {
+		denoms = append(denoms, key.K2())
+		return false, nil
+	})
+	return denoms, err
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/keeper"
+	tftestutil "github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	creatorAddr = sdk.AccAddress("creator_____________")
+	otherAddr   = sdk.AccAddress("other_______________")
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	bankKeeper  *tftestutil.MockBankKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(tokenfactory.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := tftestutil.NewMockAccountKeeper(ctrl)
+	s.bankKeeper = tftestutil.NewMockBankKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName))
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	s.keeper = keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		s.bankKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, types.DefaultParams()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+}
+
+// createDenom creates the factory/{creator}/{subdenom} denom free of charge.
+func (s *KeeperTestSuite) createDenom(subdenom string) string {
+	s.bankKeeper.EXPECT().GetDenomMetaData(gomock.Any(), gomock.Any()).Return(banktypes.Metadata{}, false).Times(2)
+	s.bankKeeper.EXPECT().HasSupply(gomock.Any(), gomock.Any()).Return(false)
+	s.bankKeeper.EXPECT().SetDenomMetaData(gomock.Any(), gomock.Any())
+
+	res, err := s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(creatorAddr.String(), subdenom))
+	s.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+func (s *KeeperTestSuite) TestSendRestrictionFn() {
+	denom := s.createDenom("foo")
+	s.keeper.RegisterDenomHook("redirect", types.DenomHook{
+		SendRestriction: func(_ context.Context, _, _ sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+			if amt.AmountOf(denom).GT(math.NewInt(100)) {
+				return nil, errors.New("too much")
+			}
+			return otherAddr, nil
+		},
+	})
+	s.Require().Panics(func() { s.keeper.RegisterDenomHook("redirect", types.DenomHook{}) })
+
+	toAddr := sdk.AccAddress("to__________________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10), sdk.NewInt64Coin("stake", 1000))
+
+	// without a hook the restriction is a no-op
+	newTo, err := s.keeper.SendRestrictionFn(s.ctx, creatorAddr, toAddr, coins)
+	s.Require().NoError(err)
+	s.Require().Equal(toAddr, newTo)
+
+	_, err = s.msgServer.SetDenomHook(s.ctx, &types.MsgSetDenomHook{Sender: creatorAddr.String(), Denom: denom, Hook: "redirect"})
+	s.Require().NoError(err)
+
+	newTo, err = s.keeper.SendRestrictionFn(s.ctx, creatorAddr, toAddr, coins)
+	s.Require().NoError(err)
+	s.Require().Equal(otherAddr, newTo)
+
+	// non factory denoms are never restricted
+	newTo, err = s.keeper.SendRestrictionFn(s.ctx, creatorAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+	s.Require().NoError(err)
+	s.Require().Equal(toAddr, newTo)
+
+	_, err = s.keeper.SendRestrictionFn(s.ctx, creatorAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)))
+	s.Require().ErrorContains(err, "too much")
+}
@@ -0,0 +1,336 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/tokenfactory MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// CreateDenom creates a new factory denom administered by the sender.
+func (ms msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	sender, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	denom, err := ms.Keeper.CreateDenom(goCtx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+// Mint mints a factory denom to the sender or to the given address.
+func (ms msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	if _, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.Sender); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+
+	mintTo := msg.MintToAddress
+	if mintTo == "" {
+		mintTo = msg.Sender
+	}
+	mintToAddr, err := ms.accountKeeper.AddressCodec().StringToBytes(mintTo)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid mint to address: %s", err)
+	}
+
+	if err := ms.checkAdmin(goCtx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins(msg.Amount)
+	hook, ok, err := ms.getDenomHook(goCtx, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if ok && hook.MintingRestriction != nil {
+		if err := hook.MintingRestriction(goCtx, coins); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ms.bankKeeper.MintCoins(goCtx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := ms.bankKeeper.SendCoinsFromModuleToAccount(goCtx, types.ModuleName, mintToAddr, coins); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyMintToAddress, mintTo),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn burns a factory denom from the sender or, when force transfers are
+// enabled, from the given address.
+func (ms msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	if _, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.Sender); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+
+	burnFrom := msg.BurnFromAddress
+	if burnFrom == "" {
+		burnFrom = msg.Sender
+	}
+	burnFromAddr, err := ms.accountKeeper.AddressCodec().StringToBytes(burnFrom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid burn from address: %s", err)
+	}
+
+	if err := ms.checkAdmin(goCtx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	if burnFrom != msg.Sender {
+		if err := ms.checkForceTransfer(goCtx, burnFromAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	coins := sdk.NewCoins(msg.Amount)
+	if err := ms.bankKeeper.SendCoinsFromAccountToModule(goCtx, burnFromAddr, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := ms.bankKeeper.BurnCoins(goCtx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeKeyBurnFromAddress, burnFrom),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ChangeAdmin changes the admin of a factory denom.
+func (ms msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	if _, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.Sender); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	// the admin is stored in its canonical form, an empty admin renounces it
+	var newAdmin string
+	if msg.NewAdmin != "" {
+		newAdminBz, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.NewAdmin)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+		if newAdmin, err = ms.accountKeeper.AddressCodec().BytesToString(newAdminBz); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ms.checkAdmin(goCtx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := ms.DenomAuthority.Set(goCtx, msg.Denom, types.DenomAuthorityMetadata{Admin: newAdmin}); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdmin),
+		),
+	)
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// SetDenomMetadata sets the bank metadata of a factory denom.
+func (ms msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	if _, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.Sender); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ms.checkAdmin(goCtx, msg.Sender, msg.Metadata.Base); err != nil {
+		return nil, err
+	}
+
+	ms.bankKeeper.SetDenomMetaData(goCtx, msg.Metadata)
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Metadata.Base),
+		),
+	)
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+// SetDenomHook attaches a registered denom hook to a factory denom, or
+// detaches the current hook when the hook name is empty.
+func (ms msgServer) SetDenomHook(goCtx context.Context, msg *types.MsgSetDenomHook) (*types.MsgSetDenomHookResponse, error) {
+	if _, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.Sender); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := ms.checkAdmin(goCtx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if msg.Hook == "" {
+		if err := ms.DenomHooks.Remove(goCtx, msg.Denom); err != nil {
+			return nil, err
+		}
+	} else {
+		if !ms.HasDenomHook(msg.Hook) {
+			return nil, errorsmod.Wrapf(types.ErrUnknownHook, "hook %s", msg.Hook)
+		}
+		if err := ms.DenomHooks.Set(goCtx, msg.Denom, msg.Hook); err != nil {
+			return nil, err
+		}
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomHook,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyHook, msg.Hook),
+		),
+	)
+
+	return &types.MsgSetDenomHookResponse{}, nil
+}
+
+// ForceTransfer moves a factory denom between two accounts on behalf of its
+// admin.
+func (ms msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	if _, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.Sender); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+
+	fromAddr, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.TransferFromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid transfer from address: %s", err)
+	}
+
+	toAddr, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.TransferToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid transfer to address: %s", err)
+	}
+
+	if err := ms.checkAdmin(goCtx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := ms.checkForceTransfer(goCtx, fromAddr); err != nil {
+		return nil, err
+	}
+
+	if ms.bankKeeper.BlockedAddr(toAddr) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.TransferToAddress)
+	}
+
+	if err := ms.bankKeeper.SendCoins(goCtx, fromAddr, toAddr, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForceTransfer,
+			sdk.NewAttribute(types.AttributeKeyTransferFrom, msg.TransferFromAddress),
+			sdk.NewAttribute(types.AttributeKeyTransferTo, msg.TransferToAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgForceTransferResponse{}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, ms.authority, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// checkForceTransfer returns an error unless the admin is allowed to move
+// coins out of the given account.
+func (ms msgServer) checkForceTransfer(ctx context.Context, from sdk.AccAddress) error {
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.EnableForceTransfer {
+		return types.ErrForceTransferDisabled
+	}
+
+	if ms.bankKeeper.BlockedAddr(from) {
+		return errorsmod.Wrapf(types.ErrBlockedAccount, "%s", from)
+	}
+
+	return nil
+}
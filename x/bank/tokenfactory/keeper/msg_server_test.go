@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *KeeperTestSuite) TestCreateDenom() {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, types.NewParams(fee, false)))
+
+	// invalid subdenom
+	_, err := s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(creatorAddr.String(), "!!"))
+	s.Require().Error(err)
+
+	// fee cannot be paid
+	s.bankKeeper.EXPECT().GetDenomMetaData(gomock.Any(), gomock.Any()).Return(banktypes.Metadata{}, false)
+	s.bankKeeper.EXPECT().HasSupply(gomock.Any(), gomock.Any()).Return(false)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), creatorAddr, authtypes.FeeCollectorName, fee).Return(errors.New("insufficient funds"))
+	_, err = s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(creatorAddr.String(), "foo"))
+	s.Require().ErrorContains(err, "insufficient funds")
+
+	s.bankKeeper.EXPECT().GetDenomMetaData(gomock.Any(), gomock.Any()).Return(banktypes.Metadata{}, false).Times(2)
+	s.bankKeeper.EXPECT().HasSupply(gomock.Any(), gomock.Any()).Return(false)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), creatorAddr, authtypes.FeeCollectorName, fee).Return(nil)
+	s.bankKeeper.EXPECT().SetDenomMetaData(gomock.Any(), gomock.Any())
+	res, err := s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(creatorAddr.String(), "foo"))
+	s.Require().NoError(err)
+	s.Require().Equal("factory/"+creatorAddr.String()+"/foo", res.NewTokenDenom)
+
+	metadata, err := s.keeper.GetAuthorityMetadata(s.ctx, res.NewTokenDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(creatorAddr.String(), metadata.Admin)
+
+	// denoms cannot be created twice
+	_, err = s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(creatorAddr.String(), "foo"))
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+}
+
+func (s *KeeperTestSuite) TestMintBurn() {
+	denom := s.createDenom("foo")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+
+	// only the admin can mint
+	_, err := s.msgServer.Mint(s.ctx, types.NewMsgMint(otherAddr.String(), coins[0], ""))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.Mint(s.ctx, types.NewMsgMint(creatorAddr.String(), sdk.NewInt64Coin("stake", 100), ""))
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, coins).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, otherAddr, coins).Return(nil)
+	_, err = s.msgServer.Mint(s.ctx, types.NewMsgMint(creatorAddr.String(), coins[0], otherAddr.String()))
+	s.Require().NoError(err)
+
+	// minting restrictions of the denom hook apply
+	s.keeper.RegisterDenomHook("capped", types.DenomHook{
+		MintingRestriction: func(_ context.Context, _ sdk.Coins) error { return errors.New("capped") },
+	})
+	_, err = s.msgServer.SetDenomHook(s.ctx, &types.MsgSetDenomHook{Sender: creatorAddr.String(), Denom: denom, Hook: "capped"})
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(s.ctx, types.NewMsgMint(creatorAddr.String(), coins[0], ""))
+	s.Require().ErrorContains(err, "capped")
+
+	// burning from another account requires force transfers
+	_, err = s.msgServer.Burn(s.ctx, types.NewMsgBurn(creatorAddr.String(), coins[0], otherAddr.String()))
+	s.Require().ErrorIs(err, types.ErrForceTransferDisabled)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), creatorAddr, types.ModuleName, coins).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, coins).Return(nil)
+	_, err = s.msgServer.Burn(s.ctx, types.NewMsgBurn(creatorAddr.String(), coins[0], ""))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, types.NewParams(nil, true)))
+	s.bankKeeper.EXPECT().BlockedAddr(otherAddr).Return(false)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), otherAddr, types.ModuleName, coins).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, coins).Return(nil)
+	_, err = s.msgServer.Burn(s.ctx, types.NewMsgBurn(creatorAddr.String(), coins[0], otherAddr.String()))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestChangeAdmin() {
+	denom := s.createDenom("foo")
+
+	_, err := s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(otherAddr.String(), denom, otherAddr.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(creatorAddr.String(), denom, "invalid"))
+	s.Require().Error(err)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(creatorAddr.String(), denom, otherAddr.String()))
+	s.Require().NoError(err)
+
+	metadata, err := s.keeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(otherAddr.String(), metadata.Admin)
+
+	// admins are stored canonical and compared by address bytes
+	_, err = s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(strings.ToUpper(otherAddr.String()), denom, strings.ToUpper(otherAddr.String())))
+	s.Require().NoError(err)
+	metadata, err = s.keeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(otherAddr.String(), metadata.Admin)
+
+	// renouncing the admin leaves the denom without admin
+	_, err = s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(otherAddr.String(), denom, ""))
+	s.Require().NoError(err)
+	_, err = s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(otherAddr.String(), denom, otherAddr.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestSetDenomMetadata() {
+	denom := s.createDenom("foo")
+	metadata := banktypes.Metadata{
+		Description: "foo token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "foo", Exponent: 6},
+		},
+		Base:    denom,
+		Display: "foo",
+		Name:    "Foo",
+		Symbol:  "FOO",
+	}
+
+	_, err := s.msgServer.SetDenomMetadata(s.ctx, &types.MsgSetDenomMetadata{Sender: otherAddr.String(), Metadata: metadata})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.bankKeeper.EXPECT().SetDenomMetaData(gomock.Any(), metadata)
+	_, err = s.msgServer.SetDenomMetadata(s.ctx, &types.MsgSetDenomMetadata{Sender: creatorAddr.String(), Metadata: metadata})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestSetDenomHook() {
+	denom := s.createDenom("foo")
+
+	_, err := s.msgServer.SetDenomHook(s.ctx, &types.MsgSetDenomHook{Sender: creatorAddr.String(), Denom: denom, Hook: "unknown"})
+	s.Require().ErrorIs(err, types.ErrUnknownHook)
+
+	s.keeper.RegisterDenomHook("noop", types.DenomHook{})
+	_, err = s.msgServer.SetDenomHook(s.ctx, &types.MsgSetDenomHook{Sender: otherAddr.String(), Denom: denom, Hook: "noop"})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.SetDenomHook(s.ctx, &types.MsgSetDenomHook{Sender: creatorAddr.String(), Denom: denom, Hook: "noop"})
+	s.Require().NoError(err)
+	hook, err := s.keeper.DenomHooks.Get(s.ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal("noop", hook)
+
+	_, err = s.msgServer.SetDenomHook(s.ctx, &types.MsgSetDenomHook{Sender: creatorAddr.String(), Denom: denom})
+	s.Require().NoError(err)
+	has, err := s.keeper.DenomHooks.Has(s.ctx, denom)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestForceTransfer() {
+	denom := s.createDenom("foo")
+	coin := sdk.NewInt64Coin(denom, 10)
+	toAddr := sdk.AccAddress("to__________________")
+
+	_, err := s.msgServer.ForceTransfer(s.ctx, types.NewMsgForceTransfer(creatorAddr.String(), coin, otherAddr.String(), toAddr.String()))
+	s.Require().ErrorIs(err, types.ErrForceTransferDisabled)
+
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, types.NewParams(nil, true)))
+
+	_, err = s.msgServer.ForceTransfer(s.ctx, types.NewMsgForceTransfer(otherAddr.String(), coin, otherAddr.String(), toAddr.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	moduleAddr := authtypes.NewModuleAddress("distribution")
+	s.bankKeeper.EXPECT().BlockedAddr(moduleAddr).Return(true)
+	_, err = s.msgServer.ForceTransfer(s.ctx, types.NewMsgForceTransfer(creatorAddr.String(), coin, moduleAddr.String(), toAddr.String()))
+	s.Require().ErrorIs(err, types.ErrBlockedAccount)
+
+	s.bankKeeper.EXPECT().BlockedAddr(otherAddr).Return(false)
+	s.bankKeeper.EXPECT().BlockedAddr(toAddr).Return(false)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), otherAddr, toAddr, sdk.NewCoins(coin)).Return(nil)
+	_, err = s.msgServer.ForceTransfer(s.ctx, types.NewMsgForceTransfer(creatorAddr.String(), coin, otherAddr.String(), toAddr.String()))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), true)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: creatorAddr.String(), Params: params})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.keeper.GetAuthority(), Params: types.Params{DenomCreationFee: sdk.Coins{sdk.NewInt64Coin("stake", 0)}}})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.keeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+	// MaxCreatorLength is the maximum length of a creator address.
+	MaxCreatorLength = 75
+)
+
+// GetTokenDenom returns the factory denom for the given creator and subdenom,
+// in the form factory/{creator}/{subdenom}.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidSubdenom, "subdenom too long, max length is %d", MaxSubdenomLength)
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", errorsmod.Wrapf(ErrInvalidCreator, "creator too long, max length is %d", MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") || creator == "" {
+		return "", errorsmod.Wrapf(ErrInvalidCreator, "invalid creator %q", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	return denom, sdk.ValidateDenom(denom)
+}
+
+// DeconstructDenom splits a factory denom into its creator and subdenom. The
+// subdenom may itself contain slashes. The creator address is not decoded, as
+// that requires the chain's address codec.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", err
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "not enough parts in denom %s", denom)
+	}
+	if parts[0] != ModuleDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "denom prefix is incorrect, is %s, should be %s", parts[0], ModuleDenomPrefix)
+	}
+
+	creator, subdenom = parts[1], parts[2]
+	if creator == "" {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "empty creator in denom %s", denom)
+	}
+	if len(subdenom) > MaxSubdenomLength {
+		return "", "", errorsmod.Wrapf(ErrInvalidSubdenom, "subdenom too long, max length is %d", MaxSubdenomLength)
+	}
+
+	return creator, subdenom, nil
+}
+
+// IsFactoryDenom reports whether the denom has the factory prefix.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, ModuleDenomPrefix+"/")
+}
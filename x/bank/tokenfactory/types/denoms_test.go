@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/tokenfactory/types"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	denom, err := types.GetTokenDenom(creator, "foo")
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+creator+"/foo", denom)
+
+	_, err = types.GetTokenDenom(creator, strings.Repeat("a", types.MaxSubdenomLength+1))
+	require.ErrorIs(t, err, types.ErrInvalidSubdenom)
+
+	_, err = types.GetTokenDenom("a/b", "foo")
+	require.ErrorIs(t, err, types.ErrInvalidCreator)
+
+	_, err = types.GetTokenDenom(creator, "!!")
+	require.Error(t, err)
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	testCases := []struct {
+		name     string
+		denom    string
+		subdenom string
+		expErr   bool
+	}{
+		{"valid", "factory/" + creator + "/foo", "foo", false},
+		{"subdenom with slashes", "factory/" + creator + "/foo/bar", "foo/bar", false},
+		{"empty subdenom", "factory/" + creator + "/", "", false},
+		{"wrong prefix", "ibc/" + creator + "/foo", "", true},
+		{"not enough parts", "factory/" + creator, "", true},
+		{"empty creator", "factory//foo", "", true},
+		{"invalid denom", "factory", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, subdenom, err := types.DeconstructDenom(tc.denom)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, creator, c)
+			require.Equal(t, tc.subdenom, subdenom)
+		})
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+	denom := "factory/" + creator + "/foo"
+
+	require.NoError(t, types.DefaultGenesisState().Validate())
+
+	gs := types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+		{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator}},
+	})
+	require.NoError(t, gs.Validate())
+
+	gs.FactoryDenoms = append(gs.FactoryDenoms, gs.FactoryDenoms[0])
+	require.ErrorIs(t, gs.Validate(), types.ErrInvalidGenesis)
+
+	gs.FactoryDenoms = []types.GenesisDenom{{Denom: "stake"}}
+	require.Error(t, gs.Validate())
+
+	gs = types.NewGenesisState(types.Params{DenomCreationFee: sdk.Coins{sdk.NewInt64Coin("stake", 0)}}, nil)
+	require.Error(t, gs.Validate())
+}
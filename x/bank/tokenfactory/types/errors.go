@@ -0,0 +1,16 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrDenomExists           = errors.Register(ModuleName, 2, "denom already exists")
+	ErrInvalidDenom          = errors.Register(ModuleName, 3, "invalid denom")
+	ErrInvalidSubdenom       = errors.Register(ModuleName, 4, "invalid subdenom")
+	ErrInvalidCreator        = errors.Register(ModuleName, 5, "invalid creator")
+	ErrUnauthorized          = errors.Register(ModuleName, 6, "unauthorized account")
+	ErrForceTransferDisabled = errors.Register(ModuleName, 7, "force transfers are disabled")
+	ErrUnknownHook           = errors.Register(ModuleName, 8, "unknown denom hook")
+	ErrBlockedAccount        = errors.Register(ModuleName, 9, "cannot burn or transfer from a blocked account")
+	ErrInvalidGenesis        = errors.Register(ModuleName, 10, "invalid genesis state")
+)
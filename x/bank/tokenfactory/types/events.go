@@ -0,0 +1,22 @@
+package types
+
+// tokenfactory module event types
+const (
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeMint             = "tf_mint"
+	EventTypeBurn             = "tf_burn"
+	EventTypeChangeAdmin      = "change_admin"
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+	EventTypeSetDenomHook     = "set_denom_hook"
+	EventTypeForceTransfer    = "force_transfer"
+
+	AttributeKeyCreator         = "creator"
+	AttributeKeyDenom           = "denom"
+	AttributeKeyNewAdmin        = "new_admin"
+	AttributeKeyMintToAddress   = "mint_to_address"
+	AttributeKeyBurnFromAddress = "burn_from_address"
+	AttributeKeyTransferFrom    = "transfer_from_address"
+	AttributeKeyTransferTo      = "transfer_to_address"
+	AttributeKeyHook            = "hook"
+	AttributeKeyAmount          = "amount"
+)
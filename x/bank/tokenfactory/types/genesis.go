@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, denoms []GenesisDenom) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		FactoryDenoms: denoms,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []GenesisDenom{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure. Creator and admin addresses, as well as denom hooks, are validated
+// by the keeper in InitGenesis.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.FactoryDenoms))
+	for _, denom := range gs.FactoryDenoms {
+		if _, ok := seen[denom.Denom]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate denom %s", denom.Denom)
+		}
+		seen[denom.Denom] = struct{}{}
+
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DenomHook groups the restrictions an application can attach to factory
+// denoms. Denom admins opt into a hook by name through MsgSetDenomHook. Each
+// restriction only sees the coins of the denoms the hook is attached to.
+type DenomHook struct {
+	// SendRestriction is invoked on every transfer of the denom, including
+	// mints and burns moved through the module account.
+	SendRestriction banktypes.SendRestrictionFn
+	// MintingRestriction is invoked before minting the denom.
+	MintingRestriction banktypes.MintingRestrictionFn
+}
+
+// DenomHooksWrapper is a wrapper for the named denom hooks provided by a
+// module through depinject. It implements depinject.OnePerModuleType. Hook
+// names must be unique across modules.
+type DenomHooksWrapper struct {
+	Hooks map[string]DenomHook
+}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (DenomHooksWrapper) IsOnePerModuleType() {}
@@ -0,0 +1,25 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// ModuleDenomPrefix is the prefix of every denom created by the module.
+	ModuleDenomPrefix = "factory"
+)
+
+var (
+	// ParamsKey is the key of the module params.
+	ParamsKey = collections.NewPrefix(0)
+	// DenomAuthorityKey is the prefix of the authority metadata of each factory denom.
+	DenomAuthorityKey = collections.NewPrefix(1)
+	// CreatorDenomsKey is the prefix of the (creator, denom) index.
+	CreatorDenomsKey = collections.NewPrefix(2)
+	// DenomHooksKey is the prefix of the hook attached to each factory denom.
+	DenomHooksKey = collections.NewPrefix(3)
+)
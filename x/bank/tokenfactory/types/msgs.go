@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+	_ sdk.Msg = &MsgSetDenomHook{}
+	_ sdk.Msg = &MsgForceTransfer{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgCreateDenom creates a message to create a new factory denom.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{Sender: sender, Subdenom: subdenom}
+}
+
+// NewMsgMint creates a message to mint a factory denom to the given address.
+func NewMsgMint(sender string, amount sdk.Coin, mintTo string) *MsgMint {
+	return &MsgMint{Sender: sender, Amount: amount, MintToAddress: mintTo}
+}
+
+// NewMsgBurn creates a message to burn a factory denom from the given address.
+func NewMsgBurn(sender string, amount sdk.Coin, burnFrom string) *MsgBurn {
+	return &MsgBurn{Sender: sender, Amount: amount, BurnFromAddress: burnFrom}
+}
+
+// NewMsgChangeAdmin creates a message to change the admin of a factory denom.
+func NewMsgChangeAdmin(sender, denom, newAdmin string) *MsgChangeAdmin {
+	return &MsgChangeAdmin{Sender: sender, Denom: denom, NewAdmin: newAdmin}
+}
+
+// NewMsgForceTransfer creates a message to move a factory denom between two
+// accounts on behalf of its admin.
+func NewMsgForceTransfer(sender string, amount sdk.Coin, from, to string) *MsgForceTransfer {
+	return &MsgForceTransfer{Sender: sender, Amount: amount, TransferFromAddress: from, TransferToAddress: to}
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(denomCreationFee sdk.Coins, enableForceTransfer bool) Params {
+	return Params{
+		DenomCreationFee:    denomCreationFee,
+		EnableForceTransfer: enableForceTransfer,
+	}
+}
+
+// DefaultParams returns the default tokenfactory params: creating a denom is
+// free and force transfers are disabled.
+func DefaultParams() Params {
+	return NewParams(sdk.NewCoins(), false)
+}
+
+// Validate validates the params.
+func (p Params) Validate() error {
+	return p.DenomCreationFee.Validate()
+}
@@ -11,15 +11,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -83,38 +88,130 @@ func (m *SendAuthorization) GetAllowList() []string {
 	return nil
 }
 
+// PeriodicSendAuthorization allows the grantee to spend up to
+// period_spend_limit coins from the granter's account in each period. The
+// coins that can be spent are topped up to period_spend_limit when the period
+// resets.
+type PeriodicSendAuthorization struct {
+	// period specifies the time duration in which period_spend_limit coins can
+	// be spent before that allowance is reset.
+	Period time.Duration `protobuf:"bytes,1,opt,name=period,proto3,stdduration" json:"period"`
+	// period_spend_limit specifies the maximum number of coins that can be spent
+	// in the period.
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit"`
+	// period_can_spend is the number of coins left to be spent before the
+	// period_reset time.
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend"`
+	// period_reset is the time at which this period resets and a new one
+	// begins, it is calculated from the start time of the first transaction
+	// after the last period ended.
+	PeriodReset time.Time `protobuf:"bytes,4,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *PeriodicSendAuthorization) Reset()         { *m = PeriodicSendAuthorization{} }
+func (m *PeriodicSendAuthorization) String() string { return proto.CompactTextString(m) }
+func (*PeriodicSendAuthorization) ProtoMessage()    {}
+func (*PeriodicSendAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4d2a37888ea779f, []int{1}
+}
+func (m *PeriodicSendAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicSendAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicSendAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicSendAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicSendAuthorization.Merge(m, src)
+}
+func (m *PeriodicSendAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicSendAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicSendAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicSendAuthorization proto.InternalMessageInfo
+
+func (m *PeriodicSendAuthorization) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodicSendAuthorization) GetPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *PeriodicSendAuthorization) GetPeriodCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *PeriodicSendAuthorization) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*SendAuthorization)(nil), "cosmos.bank.v1beta1.SendAuthorization")
+	proto.RegisterType((*PeriodicSendAuthorization)(nil), "cosmos.bank.v1beta1.PeriodicSendAuthorization")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/authz.proto", fileDescriptor_a4d2a37888ea779f) }
 
 var fileDescriptor_a4d2a37888ea779f = []byte{
-	// 372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0xcc, 0xcb, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10,
-	0x75, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95, 0x84, 0xe8,
-	0x8e, 0x87, 0x48, 0x40, 0x8d, 0x82, 0x48, 0xc9, 0xc1, 0x6d, 0x2e, 0x4e, 0x85, 0xdb, 0x9c, 0x9c,
-	0x9f, 0x99, 0x07, 0x91, 0x57, 0x3a, 0xc0, 0xc4, 0x25, 0x18, 0x9c, 0x9a, 0x97, 0xe2, 0x58, 0x5a,
-	0x92, 0x91, 0x5f, 0x94, 0x59, 0x95, 0x58, 0x92, 0x99, 0x9f, 0x27, 0xd4, 0xc4, 0xc8, 0xc5, 0x5d,
-	0x5c, 0x90, 0x9a, 0x97, 0x12, 0x9f, 0x93, 0x99, 0x9b, 0x59, 0x22, 0xc1, 0xa8, 0xc0, 0xac, 0xc1,
-	0x6d, 0x24, 0xa9, 0x07, 0x77, 0x65, 0x71, 0x2a, 0xcc, 0x95, 0x7a, 0xce, 0xf9, 0x99, 0x79, 0x4e,
-	0x6e, 0x27, 0xee, 0xc9, 0x33, 0xac, 0xba, 0x2f, 0xaf, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4,
-	0x97, 0x9c, 0x9f, 0x0b, 0x75, 0x07, 0x94, 0xd2, 0x2d, 0x4e, 0xc9, 0xd6, 0x2f, 0xa9, 0x2c, 0x48,
-	0x2d, 0x06, 0x6b, 0x28, 0x9e, 0xf5, 0x7c, 0x83, 0x16, 0x4f, 0x4e, 0x6a, 0x7a, 0x62, 0x72, 0x65,
-	0x3c, 0xc8, 0x39, 0xc5, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0xe2, 0x02, 0xdb, 0xea, 0x03, 0xb2,
-	0x54, 0xc8, 0x8b, 0x8b, 0x2b, 0x31, 0x27, 0x27, 0xbf, 0x3c, 0x3e, 0x27, 0xb3, 0xb8, 0x44, 0x82,
-	0x49, 0x81, 0x59, 0x83, 0xd3, 0x49, 0xfb, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x2b, 0x1c, 0x53, 0x52,
-	0x8a, 0x52, 0x8b, 0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x6f, 0x6d, 0xd1, 0xe5, 0x47, 0xd8,
-	0xa4, 0x60, 0xa0, 0x67, 0x62, 0x1e, 0xc4, 0x09, 0xd6, 0xee, 0x93, 0x59, 0x5c, 0x62, 0x15, 0x75,
-	0x6a, 0x8b, 0xae, 0x12, 0x54, 0x1f, 0x24, 0xdc, 0x61, 0xce, 0x47, 0xf1, 0xf8, 0x25, 0x0c, 0x53,
-	0x8c, 0xbb, 0x9e, 0x6f, 0xd0, 0x92, 0x41, 0xf2, 0x03, 0x46, 0x60, 0x39, 0x39, 0x9f, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x26, 0xde, 0xd0, 0xa8, 0x80, 0x24, 0x07, 0x70,
-	0xa0, 0x24, 0xb1, 0x81, 0xa3, 0xc3, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xca, 0xc6, 0x66, 0xe8,
-	0x2a, 0x02, 0x00, 0x00,
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xcd, 0x25, 0x55, 0xa5, 0x5e, 0xca, 0x8f, 0x9a, 0x0e, 0x49, 0x84, 0xec, 0x28, 0x62, 0x08,
+	0x41, 0xb1, 0x29, 0x2d, 0x42, 0xea, 0x44, 0x13, 0xc4, 0x80, 0x32, 0xa0, 0x84, 0xa9, 0x8b, 0x75,
+	0xb6, 0x0f, 0xe7, 0x54, 0xdb, 0x67, 0xf9, 0xce, 0x40, 0x3b, 0x32, 0xc2, 0x40, 0x47, 0xc4, 0x5f,
+	0x80, 0x58, 0xc8, 0x90, 0x3f, 0x80, 0xb1, 0x62, 0xaa, 0x3a, 0x21, 0x06, 0x8a, 0x92, 0x21, 0xff,
+	0x06, 0xf2, 0xdd, 0x39, 0x0d, 0x44, 0x30, 0x30, 0x74, 0x49, 0x2e, 0xf7, 0x7d, 0xef, 0xde, 0xf7,
+	0xde, 0xfb, 0x14, 0x68, 0xb8, 0x94, 0x85, 0x94, 0x59, 0x0e, 0x8a, 0x0e, 0xac, 0x17, 0x5b, 0x0e,
+	0xe6, 0x68, 0xcb, 0x42, 0x29, 0x1f, 0x1e, 0x99, 0x71, 0x42, 0x39, 0xd5, 0x6e, 0xc8, 0x06, 0x33,
+	0x6b, 0x30, 0x55, 0x43, 0x6d, 0x03, 0x85, 0x24, 0xa2, 0x96, 0xf8, 0x94, 0x7d, 0xb5, 0x4d, 0x9f,
+	0xfa, 0x54, 0x1c, 0xad, 0xec, 0xa4, 0x6e, 0xab, 0x12, 0x6d, 0xcb, 0x82, 0x7a, 0x4a, 0x96, 0xf4,
+	0x39, 0x33, 0xc3, 0x73, 0x66, 0x97, 0x92, 0x28, 0xaf, 0xfb, 0x94, 0xfa, 0x01, 0xb6, 0xc4, 0x2f,
+	0x27, 0x7d, 0x6e, 0x79, 0x69, 0x82, 0x38, 0xa1, 0x79, 0xdd, 0xf8, 0xb3, 0xce, 0x49, 0x88, 0x19,
+	0x47, 0x61, 0x2c, 0x1b, 0x1a, 0x5f, 0x8a, 0x70, 0x63, 0x80, 0x23, 0x6f, 0x2f, 0xe5, 0x43, 0x9a,
+	0x90, 0x23, 0x01, 0xd6, 0x5e, 0x03, 0x58, 0x66, 0x31, 0x8e, 0x3c, 0x3b, 0x20, 0x21, 0xe1, 0x15,
+	0x50, 0x2f, 0x35, 0xcb, 0xf7, 0xaa, 0xe6, 0x5c, 0x26, 0xc3, 0xb9, 0x4c, 0xb3, 0x4b, 0x49, 0xd4,
+	0x79, 0x7c, 0xf2, 0xc3, 0x28, 0x7c, 0x3a, 0x37, 0x9a, 0x3e, 0xe1, 0xc3, 0xd4, 0x31, 0x5d, 0x1a,
+	0x2a, 0x21, 0xea, 0xab, 0xcd, 0xbc, 0x03, 0x8b, 0x1f, 0xc6, 0x98, 0x09, 0x00, 0xfb, 0x30, 0x1b,
+	0xb5, 0xd6, 0x03, 0xec, 0x23, 0xf7, 0xd0, 0xce, 0xf4, 0xb0, 0x8f, 0xb3, 0x51, 0x0b, 0xf4, 0xa1,
+	0x60, 0xed, 0x65, 0xa4, 0xda, 0x13, 0x08, 0x51, 0x10, 0xd0, 0x97, 0x76, 0x40, 0x18, 0xaf, 0x14,
+	0xeb, 0xa5, 0xe6, 0x5a, 0xe7, 0xce, 0xd9, 0xb8, 0xbd, 0xa9, 0xa6, 0xd8, 0xf3, 0xbc, 0x04, 0x33,
+	0x36, 0xe0, 0x09, 0x89, 0xfc, 0xef, 0xe3, 0xf6, 0xb5, 0x0b, 0xa6, 0xfa, 0x5d, 0x73, 0xe7, 0x41,
+	0x7f, 0x4d, 0xc0, 0x7b, 0x84, 0xf1, 0xdd, 0xfd, 0xaf, 0xe3, 0x76, 0x43, 0xe1, 0x64, 0x70, 0xf9,
+	0xf8, 0xbf, 0x09, 0x3f, 0x5b, 0x7a, 0x65, 0xfb, 0xcd, 0x6c, 0xd4, 0xba, 0xb9, 0xa0, 0x61, 0xc9,
+	0xac, 0xc6, 0xe7, 0x15, 0x58, 0x7d, 0x8a, 0x13, 0x42, 0x3d, 0xe2, 0x2e, 0x5b, 0xf9, 0x10, 0xae,
+	0xc6, 0xa2, 0x58, 0x01, 0x75, 0x20, 0x4c, 0x94, 0x91, 0x98, 0x79, 0x24, 0xe6, 0x23, 0x15, 0x59,
+	0xe7, 0x4a, 0x66, 0xe2, 0xfb, 0x73, 0x03, 0x48, 0x2f, 0x14, 0x4e, 0x7b, 0x07, 0xa0, 0x26, 0x8f,
+	0xf6, 0x62, 0x26, 0xc5, 0xcb, 0xca, 0xe4, 0xba, 0x24, 0x1f, 0x5c, 0x24, 0xf3, 0x16, 0x40, 0x75,
+	0x69, 0xbb, 0x28, 0x92, 0x53, 0x55, 0x4a, 0x97, 0x35, 0xcf, 0x55, 0x49, 0xdd, 0x45, 0x91, 0x18,
+	0x49, 0xeb, 0xc1, 0x75, 0x35, 0x4c, 0x82, 0x19, 0xe6, 0x95, 0x15, 0xe1, 0x73, 0x6d, 0xc9, 0xe7,
+	0x67, 0xf9, 0xea, 0x4b, 0xa3, 0x8f, 0xe7, 0x46, 0x97, 0x25, 0xbc, 0x9f, 0xa1, 0x77, 0x9d, 0xff,
+	0xdc, 0x94, 0xfb, 0x3b, 0xd9, 0xa6, 0xdc, 0x5a, 0x50, 0xf2, 0xd7, 0x9d, 0xe8, 0x74, 0x4f, 0x26,
+	0x3a, 0x38, 0x9d, 0xe8, 0xe0, 0xe7, 0x44, 0x07, 0xc7, 0x53, 0xbd, 0x70, 0x3a, 0xd5, 0x0b, 0xdf,
+	0xa6, 0x7a, 0x61, 0xff, 0xf6, 0x3f, 0xbd, 0x79, 0x25, 0xff, 0x81, 0x84, 0x45, 0xce, 0xaa, 0x10,
+	0xb6, 0xfd, 0x6b, 0x00, 0x4c, 0x57, 0x15, 0x87, 0x9d, 0x04, 0x00, 0x00,
 }
 
 func (m *SendAuthorization) Marshal() (dAtA []byte, err error) {
@@ -163,6 +260,73 @@ func (m *SendAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PeriodicSendAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicSendAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicSendAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthz(v)
 	base := offset
@@ -195,6 +359,31 @@ func (m *SendAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *PeriodicSendAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
 func sovAuthz(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -317,6 +506,190 @@ func (m *SendAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PeriodicSendAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicSendAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicSendAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuthz(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -28,6 +29,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AddressListMode defines how the addresses of a SendPolicy are interpreted.
+type AddressListMode int32
+
+const (
+	// ADDRESS_LIST_MODE_UNSPECIFIED means the addresses are ignored.
+	ADDRESS_LIST_MODE_UNSPECIFIED AddressListMode = 0
+	// ADDRESS_LIST_MODE_ALLOWLIST means only the listed addresses can send the denom.
+	ADDRESS_LIST_MODE_ALLOWLIST AddressListMode = 1
+	// ADDRESS_LIST_MODE_DENYLIST means the listed addresses can neither send nor
+	// receive the denom.
+	ADDRESS_LIST_MODE_DENYLIST AddressListMode = 2
+)
+
+var AddressListMode_name = map[int32]string{
+	0: "ADDRESS_LIST_MODE_UNSPECIFIED",
+	1: "ADDRESS_LIST_MODE_ALLOWLIST",
+	2: "ADDRESS_LIST_MODE_DENYLIST",
+}
+
+var AddressListMode_value = map[string]int32{
+	"ADDRESS_LIST_MODE_UNSPECIFIED": 0,
+	"ADDRESS_LIST_MODE_ALLOWLIST":   1,
+	"ADDRESS_LIST_MODE_DENYLIST":    2,
+}
+
+func (x AddressListMode) String() string {
+	return proto.EnumName(AddressListMode_name, int32(x))
+}
+
+func (AddressListMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{0}
+}
+
 // Params defines the parameters for the bank module.
 type Params struct {
 	// Deprecated: Use of SendEnabled in params is deprecated.
@@ -141,6 +175,138 @@ func (m *SendEnabled) GetEnabled() bool {
 	return false
 }
 
+// SendPolicy defines the governance-managed transfer restrictions of a denom.
+// The restrictions apply to every transfer of the denom, including transfers
+// from and to module accounts.
+type SendPolicy struct {
+	// denom is the denom the policy applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address_list_mode defines how addresses is interpreted.
+	AddressListMode AddressListMode `protobuf:"varint,2,opt,name=address_list_mode,json=addressListMode,proto3,enum=cosmos.bank.v1beta1.AddressListMode" json:"address_list_mode,omitempty"`
+	// addresses is the allowlist or denylist of the policy, depending on
+	// address_list_mode.
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// receiver_allowlist restricts the receivers of the denom to the listed
+	// addresses. An empty list allows any receiver.
+	ReceiverAllowlist []string `protobuf:"bytes,4,rep,name=receiver_allowlist,json=receiverAllowlist,proto3" json:"receiver_allowlist,omitempty"`
+	// max_transfer_amount is the maximum amount of the denom in a single transfer.
+	// Zero means no limit.
+	MaxTransferAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_transfer_amount,json=maxTransferAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_transfer_amount"`
+	// block_transfer_cap is the maximum amount of the denom transferred in a
+	// single block. Zero means no limit.
+	BlockTransferCap cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=block_transfer_cap,json=blockTransferCap,proto3,customtype=cosmossdk.io/math.Int" json:"block_transfer_cap"`
+}
+
+func (m *SendPolicy) Reset()         { *m = SendPolicy{} }
+func (m *SendPolicy) String() string { return proto.CompactTextString(m) }
+func (*SendPolicy) ProtoMessage()    {}
+func (*SendPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{2}
+}
+func (m *SendPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendPolicy.Merge(m, src)
+}
+func (m *SendPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendPolicy proto.InternalMessageInfo
+
+func (m *SendPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SendPolicy) GetAddressListMode() AddressListMode {
+	if m != nil {
+		return m.AddressListMode
+	}
+	return ADDRESS_LIST_MODE_UNSPECIFIED
+}
+
+func (m *SendPolicy) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *SendPolicy) GetReceiverAllowlist() []string {
+	if m != nil {
+		return m.ReceiverAllowlist
+	}
+	return nil
+}
+
+// BlockTransferTally tracks the amount of a denom transferred in a block, to
+// enforce SendPolicy.block_transfer_cap.
+type BlockTransferTally struct {
+	// height is the block height of the tally.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// amount is the amount transferred at height.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *BlockTransferTally) Reset()         { *m = BlockTransferTally{} }
+func (m *BlockTransferTally) String() string { return proto.CompactTextString(m) }
+func (*BlockTransferTally) ProtoMessage()    {}
+func (*BlockTransferTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{3}
+}
+func (m *BlockTransferTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockTransferTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockTransferTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockTransferTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTransferTally.Merge(m, src)
+}
+func (m *BlockTransferTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockTransferTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTransferTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTransferTally proto.InternalMessageInfo
+
+func (m *BlockTransferTally) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // Input models transaction input.
 type Input struct {
 	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -151,7 +317,7 @@ func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{2}
+	return fileDescriptor_dd052eee12edf988, []int{4}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +356,7 @@ func (m *Output) Reset()         { *m = Output{} }
 func (m *Output) String() string { return proto.CompactTextString(m) }
 func (*Output) ProtoMessage()    {}
 func (*Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{3}
+	return fileDescriptor_dd052eee12edf988, []int{5}
 }
 func (m *Output) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +398,7 @@ func (m *Supply) Reset()         { *m = Supply{} }
 func (m *Supply) String() string { return proto.CompactTextString(m) }
 func (*Supply) ProtoMessage()    {}
 func (*Supply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{4}
+	return fileDescriptor_dd052eee12edf988, []int{6}
 }
 func (m *Supply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +446,7 @@ func (m *DenomUnit) Reset()         { *m = DenomUnit{} }
 func (m *DenomUnit) String() string { return proto.CompactTextString(m) }
 func (*DenomUnit) ProtoMessage()    {}
 func (*DenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{5}
+	return fileDescriptor_dd052eee12edf988, []int{7}
 }
 func (m *DenomUnit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +523,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{6}
+	return fileDescriptor_dd052eee12edf988, []int{8}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,8 +609,11 @@ func (m *Metadata) GetURIHash() string {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.bank.v1beta1.AddressListMode", AddressListMode_name, AddressListMode_value)
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
+	proto.RegisterType((*SendPolicy)(nil), "cosmos.bank.v1beta1.SendPolicy")
+	proto.RegisterType((*BlockTransferTally)(nil), "cosmos.bank.v1beta1.BlockTransferTally")
 	proto.RegisterType((*Input)(nil), "cosmos.bank.v1beta1.Input")
 	proto.RegisterType((*Output)(nil), "cosmos.bank.v1beta1.Output")
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
@@ -455,52 +624,70 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0x13, 0x49,
-	0x14, 0xf6, 0xf8, 0x77, 0xc6, 0x39, 0x9d, 0x6e, 0x62, 0xe9, 0x26, 0x3e, 0xdd, 0xda, 0x72, 0x73,
-	0x8e, 0xef, 0x6c, 0xe7, 0xc7, 0x09, 0x09, 0x37, 0x08, 0x87, 0x5f, 0x2e, 0x10, 0x68, 0xa3, 0x08,
-	0x89, 0xc6, 0x1a, 0x7b, 0x07, 0x7b, 0x95, 0xdd, 0x99, 0xd5, 0xce, 0x6c, 0x88, 0x5b, 0x2a, 0x44,
-	0x45, 0x4d, 0x15, 0x51, 0x21, 0x44, 0xe1, 0x22, 0x3d, 0x6d, 0x94, 0x2a, 0xa2, 0x42, 0x29, 0x02,
-	0x72, 0x0a, 0xe7, 0xcf, 0x40, 0x3b, 0xbb, 0x6b, 0x3b, 0xc2, 0xa1, 0x44, 0xa2, 0xb1, 0xe7, 0xbd,
-	0xef, 0x7b, 0xef, 0x7d, 0xf3, 0xf6, 0xdb, 0x85, 0x5a, 0x8f, 0x0b, 0x9b, 0x8b, 0x46, 0x97, 0xb0,
-	0xbd, 0xc6, 0xfe, 0x46, 0x97, 0x4a, 0xb2, 0xa1, 0x82, 0xba, 0xe3, 0x72, 0xc9, 0xd1, 0x4a, 0x80,
-	0xd7, 0x55, 0x2a, 0xc4, 0x0b, 0xf9, 0x3e, 0xef, 0x73, 0x85, 0x37, 0xfc, 0x53, 0x40, 0x2d, 0xac,
-	0x06, 0xd4, 0x4e, 0x00, 0x84, 0x75, 0x01, 0x34, 0x9b, 0x22, 0xe8, 0x74, 0x4a, 0x8f, 0x9b, 0x2c,
-	0xc4, 0xff, 0x0c, 0x71, 0x5b, 0xf4, 0x1b, 0xfb, 0x1b, 0xfe, 0x5f, 0x08, 0xfc, 0x41, 0x6c, 0x93,
-	0xf1, 0x86, 0xfa, 0x0d, 0x52, 0xe5, 0xb7, 0x00, 0xa6, 0x1f, 0x13, 0x97, 0xd8, 0x02, 0xdd, 0x87,
-	0xcb, 0x82, 0x32, 0xa3, 0x43, 0x19, 0xe9, 0x5a, 0xd4, 0xc0, 0xa0, 0x94, 0xa8, 0xe4, 0x36, 0x4b,
-	0xf5, 0x05, 0x9a, 0xeb, 0x3b, 0x94, 0x19, 0x77, 0x03, 0x5e, 0x2b, 0x8e, 0x81, 0x9e, 0x13, 0xb3,
-	0x04, 0x5a, 0x87, 0x79, 0x83, 0x3e, 0x23, 0x9e, 0x25, 0x3b, 0x57, 0x1a, 0xc6, 0x4b, 0xa0, 0x92,
-	0xd5, 0x51, 0x88, 0xcd, 0xb5, 0x68, 0xfe, 0xfd, 0x6a, 0x32, 0xaa, 0xe2, 0x60, 0x50, 0x4d, 0x18,
-	0x7b, 0x8d, 0x83, 0x60, 0x85, 0x81, 0xb2, 0xf2, 0x36, 0xcc, 0xcd, 0xb1, 0x51, 0x1e, 0xa6, 0x0c,
-	0xca, 0xb8, 0x8d, 0x41, 0x09, 0x54, 0x96, 0xf4, 0x20, 0x40, 0x18, 0x66, 0xae, 0x0e, 0x8a, 0xc2,
-	0x66, 0xf2, 0xf2, 0xb0, 0x08, 0xca, 0x27, 0x00, 0xa6, 0xda, 0xcc, 0xf1, 0x24, 0xda, 0x84, 0x19,
-	0x62, 0x18, 0x2e, 0x15, 0x22, 0xe8, 0xd0, 0xc2, 0x9f, 0x8e, 0x6a, 0xf9, 0xf0, 0x9a, 0xb7, 0x03,
-	0x64, 0x47, 0xba, 0x26, 0xeb, 0xeb, 0x11, 0x11, 0x3d, 0x87, 0x29, 0x7f, 0xc3, 0x02, 0xc7, 0xd5,
-	0x56, 0x56, 0x67, 0x5b, 0x11, 0x74, 0xba, 0x95, 0x6d, 0x6e, 0xb2, 0xd6, 0xbd, 0xe3, 0xf3, 0x62,
-	0xec, 0xfd, 0x97, 0x62, 0xa5, 0x6f, 0xca, 0x81, 0xd7, 0xad, 0xf7, 0xb8, 0x1d, 0x3e, 0xbe, 0xc6,
-	0xdc, 0x05, 0xe5, 0xd0, 0xa1, 0x42, 0x15, 0x88, 0x37, 0x93, 0x51, 0x75, 0xd9, 0xa2, 0x7d, 0xd2,
-	0x1b, 0x76, 0xd4, 0x8c, 0x77, 0x93, 0x51, 0x15, 0xe8, 0xc1, 0xbc, 0x66, 0xfe, 0xe5, 0x61, 0x31,
-	0x76, 0x79, 0x58, 0x8c, 0xbd, 0x98, 0x8c, 0xaa, 0x91, 0x9c, 0xf2, 0x47, 0x00, 0xd3, 0x8f, 0x3c,
-	0xf9, 0xcb, 0xdd, 0x26, 0x1b, 0xdd, 0xa6, 0xfc, 0x01, 0xc0, 0xf4, 0x8e, 0xe7, 0x38, 0xd6, 0xd0,
-	0x57, 0x23, 0xb9, 0x24, 0x56, 0xe8, 0xb8, 0x9f, 0xa1, 0x46, 0xcd, 0x6b, 0xae, 0x85, 0x6a, 0xc0,
-	0xc9, 0x51, 0xed, 0xaf, 0x85, 0x36, 0x57, 0x02, 0xdb, 0x18, 0x94, 0x9f, 0xc0, 0xa5, 0x3b, 0xbe,
-	0xcd, 0x76, 0x99, 0x29, 0xaf, 0x31, 0x60, 0x01, 0x66, 0xe9, 0x81, 0xc3, 0x19, 0x65, 0x52, 0x39,
-	0xf0, 0x37, 0x7d, 0x1a, 0xfb, 0xe6, 0x24, 0x96, 0x49, 0x04, 0x15, 0x38, 0x51, 0x4a, 0x54, 0x96,
-	0xf4, 0x28, 0x2c, 0x9f, 0xc5, 0x61, 0xf6, 0x21, 0x95, 0xc4, 0x20, 0x92, 0xa0, 0x12, 0xcc, 0x19,
-	0x54, 0xf4, 0x5c, 0xd3, 0x91, 0x26, 0x67, 0x61, 0xfb, 0xf9, 0x14, 0xba, 0xe5, 0x33, 0x18, 0xb7,
-	0x3b, 0x1e, 0x33, 0x65, 0xf4, 0xfc, 0xb4, 0x85, 0xef, 0xe8, 0x54, 0xaf, 0x0e, 0x8d, 0xe8, 0x28,
-	0x10, 0x82, 0x49, 0x7f, 0xaf, 0x38, 0xa1, 0x7a, 0xab, 0xb3, 0xaf, 0xce, 0x30, 0x85, 0x63, 0x91,
-	0x21, 0x4e, 0xaa, 0x74, 0x14, 0xa2, 0x7f, 0x60, 0x92, 0x11, 0x9b, 0xe2, 0x94, 0x72, 0xd6, 0xca,
-	0xd9, 0x51, 0xed, 0xf7, 0xd9, 0xa2, 0x4b, 0xeb, 0xf5, 0xff, 0xb7, 0x74, 0x45, 0x40, 0xff, 0xc2,
-	0xb4, 0x18, 0xda, 0x5d, 0x6e, 0xe1, 0xf4, 0xf5, 0xd4, 0x90, 0x82, 0xfe, 0x83, 0x09, 0xcf, 0x35,
-	0x71, 0x46, 0x31, 0x0b, 0xe3, 0xf3, 0x62, 0x62, 0x57, 0x6f, 0x7f, 0x5f, 0x70, 0x43, 0xf7, 0x69,
-	0xe8, 0x26, 0xcc, 0x7a, 0xae, 0xd9, 0x19, 0x10, 0x31, 0xc0, 0x59, 0x55, 0xa2, 0x8d, 0xcf, 0x8b,
-	0x99, 0x5d, 0xbd, 0xfd, 0x80, 0x88, 0xc1, 0xa2, 0xb2, 0x8c, 0xe7, 0x9a, 0x3e, 0xd6, 0xda, 0x3e,
-	0x1e, 0x6b, 0xe0, 0x74, 0xac, 0x81, 0xaf, 0x63, 0x0d, 0xbc, 0xbe, 0xd0, 0x62, 0xa7, 0x17, 0x5a,
-	0xec, 0xf3, 0x85, 0x16, 0x7b, 0xba, 0xf6, 0x43, 0x07, 0x85, 0x9f, 0x1f, 0x65, 0xa4, 0x6e, 0x5a,
-	0x7d, 0x29, 0xb7, 0xbe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x05, 0x03, 0x28, 0xdd, 0x05, 0x00,
-	0x00,
+	// 1002 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x4f, 0x1b, 0xc7,
+	0x17, 0xf7, 0xda, 0xc6, 0x98, 0x21, 0xdf, 0x2f, 0x30, 0xd0, 0x66, 0xe3, 0x28, 0x6b, 0xd7, 0xaa,
+	0x54, 0x42, 0x8a, 0x0d, 0x24, 0x8d, 0x54, 0x5f, 0x2a, 0xfc, 0x23, 0xa9, 0x25, 0x08, 0x68, 0x0d,
+	0x8a, 0xda, 0x43, 0xb7, 0x63, 0xef, 0xc4, 0x1e, 0xb1, 0x3b, 0xb3, 0xda, 0x99, 0x25, 0x58, 0xbd,
+	0xf5, 0x50, 0x45, 0x3d, 0xf5, 0xdc, 0x13, 0x6a, 0x2f, 0x55, 0xd5, 0x03, 0x07, 0xee, 0xbd, 0x46,
+	0x39, 0x21, 0x4e, 0x15, 0x07, 0x5a, 0xc1, 0x81, 0xfc, 0x19, 0xd5, 0xce, 0xce, 0x62, 0x08, 0x26,
+	0x55, 0x7b, 0xa8, 0xd4, 0x8b, 0x3d, 0xef, 0xbd, 0xcf, 0xfb, 0xbc, 0xcf, 0xcc, 0xbc, 0x79, 0x36,
+	0x30, 0x3a, 0x8c, 0xbb, 0x8c, 0x97, 0xdb, 0x88, 0x6e, 0x95, 0xb7, 0x17, 0xdb, 0x58, 0xa0, 0x45,
+	0x69, 0x94, 0x3c, 0x9f, 0x09, 0x06, 0xa7, 0xa3, 0x78, 0x49, 0xba, 0x54, 0x3c, 0x37, 0xd3, 0x65,
+	0x5d, 0x26, 0xe3, 0xe5, 0x70, 0x15, 0x41, 0x73, 0xb7, 0x22, 0xa8, 0x15, 0x05, 0x54, 0x5e, 0x14,
+	0x1a, 0x54, 0xe1, 0xf8, 0xbc, 0x4a, 0x87, 0x11, 0xaa, 0xe2, 0x37, 0x55, 0xdc, 0xe5, 0xdd, 0xf2,
+	0xf6, 0x62, 0xf8, 0xa5, 0x02, 0x53, 0xc8, 0x25, 0x94, 0x95, 0xe5, 0x67, 0xe4, 0x2a, 0xfe, 0xa0,
+	0x81, 0xcc, 0x3a, 0xf2, 0x91, 0xcb, 0xe1, 0x63, 0x70, 0x83, 0x63, 0x6a, 0x5b, 0x98, 0xa2, 0xb6,
+	0x83, 0x6d, 0x5d, 0x2b, 0xa4, 0x66, 0xc7, 0x97, 0x0a, 0xa5, 0x21, 0x9a, 0x4b, 0x2d, 0x4c, 0xed,
+	0x46, 0x84, 0xab, 0x26, 0x75, 0xcd, 0x1c, 0xe7, 0x03, 0x07, 0x5c, 0x00, 0x33, 0x36, 0x7e, 0x86,
+	0x02, 0x47, 0x58, 0x97, 0x08, 0x93, 0x05, 0x6d, 0x36, 0x6b, 0x42, 0x15, 0xbb, 0x40, 0x51, 0xb9,
+	0xf3, 0xed, 0xd9, 0xde, 0x9c, 0x1e, 0x15, 0x9a, 0xe7, 0xf6, 0x56, 0x79, 0x27, 0x3a, 0xc2, 0x48,
+	0x59, 0xb1, 0x06, 0xc6, 0x2f, 0xa0, 0xe1, 0x0c, 0x18, 0xb1, 0x31, 0x65, 0xae, 0xae, 0x15, 0xb4,
+	0xd9, 0x31, 0x33, 0x32, 0xa0, 0x0e, 0x46, 0x2f, 0x17, 0x8a, 0xcd, 0x4a, 0xfa, 0xf5, 0x6e, 0x5e,
+	0x2b, 0x1e, 0xa4, 0x00, 0x08, 0x59, 0xd6, 0x99, 0x43, 0x3a, 0xfd, 0x6b, 0x48, 0xd6, 0xc1, 0x14,
+	0xb2, 0x6d, 0x1f, 0x73, 0x6e, 0x39, 0x84, 0x0b, 0xcb, 0x65, 0x36, 0x96, 0x74, 0xff, 0x5f, 0x7a,
+	0x7f, 0xe8, 0x41, 0x2c, 0x47, 0xe8, 0x15, 0xc2, 0xc5, 0x2a, 0xb3, 0xb1, 0x39, 0x81, 0x2e, 0x3b,
+	0xe0, 0x43, 0x30, 0xa6, 0x5c, 0x98, 0xeb, 0xa9, 0x42, 0x6a, 0x76, 0xac, 0xaa, 0x1f, 0xee, 0xcf,
+	0xcf, 0x28, 0x32, 0x95, 0xdf, 0x12, 0x3e, 0xa1, 0x5d, 0x73, 0x00, 0x85, 0x8f, 0x01, 0xf4, 0x71,
+	0x07, 0x93, 0x6d, 0xec, 0x5b, 0xc8, 0x71, 0xd8, 0xf3, 0x50, 0x8f, 0x9e, 0xfe, 0x0b, 0x82, 0xa9,
+	0x38, 0x67, 0x39, 0x4e, 0x81, 0x5f, 0x82, 0x69, 0x17, 0xed, 0x58, 0xc2, 0x47, 0x94, 0x3f, 0x0b,
+	0xc9, 0x5c, 0x16, 0x50, 0xa1, 0x8f, 0x84, 0xdb, 0xae, 0x2e, 0xbc, 0x3c, 0xce, 0x27, 0x8e, 0x8e,
+	0xf3, 0xef, 0x44, 0x6c, 0xdc, 0xde, 0x2a, 0x11, 0x56, 0x76, 0x91, 0xe8, 0x95, 0x9a, 0x54, 0x1c,
+	0xee, 0xcf, 0x03, 0x55, 0xa6, 0x49, 0xc5, 0x4f, 0x67, 0x7b, 0x73, 0x9a, 0x39, 0xe5, 0xa2, 0x9d,
+	0x0d, 0xc5, 0xb5, 0x2c, 0xa9, 0xe0, 0x17, 0x00, 0xb6, 0x1d, 0xd6, 0xd9, 0x1a, 0xd4, 0xe8, 0x20,
+	0x4f, 0xcf, 0xfc, 0xc3, 0x02, 0x93, 0x92, 0x2b, 0x2e, 0x51, 0x43, 0x5e, 0xe5, 0x66, 0x78, 0x7f,
+	0x87, 0xfb, 0xf3, 0x13, 0x83, 0x06, 0x29, 0x2c, 0x94, 0x3e, 0x7a, 0x50, 0xfc, 0x46, 0x03, 0xb0,
+	0x7a, 0x11, 0xbd, 0x81, 0x1c, 0xa7, 0x0f, 0xdf, 0x05, 0x99, 0x1e, 0x26, 0xdd, 0x9e, 0x90, 0x77,
+	0x9b, 0x32, 0x95, 0x05, 0x6b, 0x20, 0xa3, 0x36, 0x9f, 0x94, 0xda, 0xee, 0xfd, 0x0d, 0x6d, 0xa6,
+	0x4a, 0xad, 0x4c, 0x0f, 0x13, 0xf2, 0x4a, 0x03, 0x23, 0x4d, 0xea, 0x05, 0x02, 0x2e, 0x81, 0x51,
+	0x75, 0x87, 0x51, 0x63, 0xbd, 0xe5, 0xae, 0x62, 0x20, 0x7c, 0x0e, 0x46, 0xc2, 0xd7, 0xcb, 0xf5,
+	0xa4, 0x7c, 0x71, 0xb7, 0x06, 0x8d, 0xc6, 0xf1, 0x79, 0xa3, 0xd5, 0x18, 0xa1, 0xd5, 0x47, 0xa1,
+	0xe2, 0x9f, 0x7f, 0xcf, 0xcf, 0x76, 0x89, 0xe8, 0x05, 0xed, 0x52, 0x87, 0xb9, 0x6a, 0x34, 0x94,
+	0x2f, 0x3c, 0x1e, 0xd1, 0xf7, 0x30, 0x97, 0x09, 0xfc, 0xfb, 0xb3, 0xbd, 0xb9, 0x1b, 0x0e, 0xee,
+	0xa2, 0x4e, 0xdf, 0x92, 0x35, 0xa2, 0x33, 0x8e, 0xea, 0x55, 0x66, 0x5e, 0xec, 0xe6, 0x13, 0xaf,
+	0x77, 0xf3, 0x89, 0xaf, 0xcf, 0xf6, 0xe6, 0x62, 0x39, 0xc5, 0x5f, 0x35, 0x90, 0x59, 0x0b, 0xc4,
+	0x7f, 0x6e, 0x37, 0xd9, 0x78, 0x37, 0xc5, 0x5f, 0x34, 0x90, 0x69, 0x05, 0x9e, 0xe7, 0xf4, 0x43,
+	0x35, 0x82, 0x09, 0xe4, 0xe8, 0xda, 0xbf, 0xa6, 0x46, 0xd6, 0xab, 0xdc, 0x55, 0x6a, 0xb4, 0x57,
+	0xfb, 0xf3, 0xb7, 0x87, 0x8e, 0x50, 0x29, 0xb0, 0xa9, 0x6b, 0xc5, 0xa7, 0x60, 0xac, 0x1e, 0x4e,
+	0x9f, 0x4d, 0x4a, 0xc4, 0x35, 0x73, 0x29, 0x07, 0xb2, 0x78, 0xc7, 0x63, 0x14, 0xab, 0xe6, 0xfd,
+	0x9f, 0x79, 0x6e, 0x87, 0x83, 0x0f, 0x39, 0x04, 0x9d, 0xcf, 0x17, 0x33, 0x36, 0x8b, 0x47, 0x49,
+	0x90, 0x5d, 0xc5, 0x02, 0xd9, 0x48, 0x20, 0x58, 0x00, 0xe3, 0x36, 0xe6, 0x1d, 0x9f, 0x78, 0x82,
+	0x30, 0xaa, 0xe8, 0x2f, 0xba, 0xe0, 0x27, 0x21, 0x82, 0x32, 0xd7, 0x0a, 0x28, 0x11, 0xf1, 0xfd,
+	0x19, 0x43, 0xc7, 0xde, 0xb9, 0x5e, 0x13, 0xd8, 0xf1, 0x92, 0x43, 0x08, 0xd2, 0xe1, 0xb9, 0xea,
+	0x29, 0xc9, 0x2d, 0xd7, 0xa1, 0x3a, 0x9b, 0x70, 0xcf, 0x41, 0x7d, 0x3d, 0x2d, 0xdd, 0xb1, 0x09,
+	0x3f, 0x00, 0x69, 0x8a, 0x5c, 0xac, 0x26, 0xd1, 0xf4, 0xd1, 0x9b, 0xef, 0xea, 0xc1, 0x7d, 0x53,
+	0x02, 0xe0, 0x3d, 0x90, 0xe1, 0x7d, 0xb7, 0xcd, 0x1c, 0x3d, 0x73, 0x3d, 0x54, 0x41, 0xe0, 0x87,
+	0x20, 0x15, 0xf8, 0x44, 0x1f, 0x95, 0xc8, 0xdc, 0xc9, 0x71, 0x3e, 0xb5, 0x69, 0x36, 0xaf, 0x26,
+	0x3c, 0x34, 0x43, 0x18, 0xfc, 0x18, 0x64, 0x03, 0x9f, 0x58, 0x3d, 0xc4, 0x7b, 0x7a, 0x56, 0xa6,
+	0x18, 0x27, 0xc7, 0xf9, 0xd1, 0x4d, 0xb3, 0xf9, 0x29, 0xe2, 0xbd, 0x61, 0x69, 0xa3, 0x81, 0x4f,
+	0xc2, 0xd8, 0xdc, 0x57, 0x60, 0xe2, 0x8d, 0xe1, 0x0f, 0xdf, 0x03, 0x77, 0x96, 0xeb, 0x75, 0xb3,
+	0xd1, 0x6a, 0x59, 0x2b, 0xcd, 0xd6, 0x86, 0xb5, 0xba, 0x56, 0x6f, 0x58, 0x9b, 0x4f, 0x5a, 0xeb,
+	0x8d, 0x5a, 0xf3, 0x51, 0xb3, 0x51, 0x9f, 0x4c, 0xc0, 0x3c, 0xb8, 0x7d, 0x15, 0xb2, 0xbc, 0xb2,
+	0xb2, 0xf6, 0x34, 0x34, 0x27, 0x35, 0x68, 0x80, 0xdc, 0x55, 0x40, 0xbd, 0xf1, 0xe4, 0x33, 0x19,
+	0x4f, 0xe6, 0xd2, 0x2f, 0x7e, 0x34, 0x12, 0xd5, 0xda, 0xcb, 0x13, 0x43, 0x3b, 0x38, 0x31, 0xb4,
+	0x3f, 0x4e, 0x0c, 0xed, 0xbb, 0x53, 0x23, 0x71, 0x70, 0x6a, 0x24, 0x7e, 0x3b, 0x35, 0x12, 0x9f,
+	0xdf, 0x7d, 0x6b, 0xfb, 0xaa, 0xdf, 0x55, 0xd9, 0xc5, 0xed, 0x8c, 0xfc, 0x0b, 0x70, 0xff, 0xcf,
+	0x01, 0x00, 0xa3, 0x06, 0xb8, 0xac, 0xb6, 0x08, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -530,6 +717,55 @@ func (this *SendEnabled) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SendPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendPolicy)
+	if !ok {
+		that2, ok := that.(SendPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.AddressListMode != that1.AddressListMode {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	if len(this.ReceiverAllowlist) != len(that1.ReceiverAllowlist) {
+		return false
+	}
+	for i := range this.ReceiverAllowlist {
+		if this.ReceiverAllowlist[i] != that1.ReceiverAllowlist[i] {
+			return false
+		}
+	}
+	if !this.MaxTransferAmount.Equal(that1.MaxTransferAmount) {
+		return false
+	}
+	if !this.BlockTransferCap.Equal(that1.BlockTransferCap) {
+		return false
+	}
+	return true
+}
 func (this *Supply) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -646,6 +882,117 @@ func (m *SendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SendPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BlockTransferCap.Size()
+		i -= size
+		if _, err := m.BlockTransferCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxTransferAmount.Size()
+		i -= size
+		if _, err := m.MaxTransferAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ReceiverAllowlist) > 0 {
+		for iNdEx := len(m.ReceiverAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiverAllowlist[iNdEx])
+			copy(dAtA[i:], m.ReceiverAllowlist[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.ReceiverAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AddressListMode != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.AddressListMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTransferTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTransferTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTransferTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Input) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -946,6 +1293,52 @@ func (m *SendEnabled) Size() (n int) {
 	return n
 }
 
+func (m *SendPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.AddressListMode != 0 {
+		n += 1 + sovBank(uint64(m.AddressListMode))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if len(m.ReceiverAllowlist) > 0 {
+		for _, s := range m.ReceiverAllowlist {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = m.MaxTransferAmount.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = m.BlockTransferCap.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *BlockTransferTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBank(uint64(m.Height))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
 func (m *Input) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1276,6 +1669,342 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SendPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressListMode", wireType)
+			}
+			m.AddressListMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressListMode |= AddressListMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverAllowlist = append(m.ReceiverAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransferAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTransferCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockTransferCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockTransferTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockTransferTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockTransferTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Input) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
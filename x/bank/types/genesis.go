@@ -36,6 +36,17 @@ func (gs GenesisState) Validate() error {
 		seenSendEnabled[p.Denom] = true
 	}
 
+	seenSendPolicies := make(map[string]bool)
+	for _, p := range gs.SendPolicies {
+		if seenSendPolicies[p.Denom] {
+			return fmt.Errorf("duplicate send policy found: '%s'", p.Denom)
+		}
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		seenSendPolicies[p.Denom] = true
+	}
+
 	for _, balance := range gs.Balances {
 		if seenBalances[balance.Address] {
 			return fmt.Errorf("duplicate balance for address %s", balance.Address)
@@ -40,6 +40,8 @@ type GenesisState struct {
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
 	// send_enabled defines the denoms where send is enabled or disabled.
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// send_policies defines the governance-managed send policies of the denoms.
+	SendPolicies []SendPolicy `protobuf:"bytes,6,rep,name=send_policies,json=sendPolicies,proto3" json:"send_policies"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +112,13 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetSendPolicies() []SendPolicy {
+	if m != nil {
+		return m.SendPolicies
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -160,37 +169,39 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xbf, 0x6e, 0xd3, 0x50,
-	0x14, 0xc6, 0x6d, 0x42, 0xd3, 0xf6, 0x26, 0x80, 0x30, 0x1d, 0xdc, 0x52, 0x9c, 0xd0, 0x29, 0x54,
-	0x8a, 0x4d, 0x03, 0x12, 0x12, 0x03, 0x12, 0x8e, 0x80, 0x09, 0x81, 0x9a, 0x8d, 0x25, 0xba, 0xb6,
-	0x8f, 0x8c, 0xd5, 0xf8, 0x5e, 0x2b, 0xe7, 0x16, 0xc8, 0x1b, 0x30, 0x32, 0x33, 0x75, 0x44, 0x4c,
-	0x1d, 0xfa, 0x00, 0x88, 0xa9, 0x63, 0xc5, 0x84, 0x18, 0x00, 0x25, 0x43, 0x79, 0x0c, 0xe4, 0x7b,
-	0x6e, 0xfe, 0x48, 0x44, 0x8c, 0x5d, 0x6c, 0xcb, 0xdf, 0x77, 0x7e, 0xdf, 0x39, 0xe7, 0xda, 0xec,
-	0x76, 0x2c, 0x31, 0x97, 0x18, 0x44, 0x5c, 0x1c, 0x04, 0x6f, 0xf6, 0x22, 0x50, 0x7c, 0x2f, 0x48,
-	0x41, 0x00, 0x66, 0xe8, 0x17, 0x43, 0xa9, 0xa4, 0x73, 0x83, 0x2c, 0x7e, 0x69, 0xf1, 0x8d, 0x65,
-	0x6b, 0x23, 0x95, 0xa9, 0xd4, 0x7a, 0x50, 0x3e, 0x91, 0x75, 0xcb, 0x9b, 0xd1, 0x10, 0x66, 0xb4,
-	0x58, 0x66, 0xe2, 0x1f, 0x7d, 0x21, 0x4d, 0x73, 0x49, 0xdf, 0x24, 0xbd, 0x4f, 0x60, 0x93, 0x4b,
-	0xd2, 0x75, 0x9e, 0x67, 0x42, 0x06, 0xfa, 0x4a, 0xaf, 0x76, 0xbe, 0x56, 0x58, 0xfd, 0x19, 0xb5,
-	0xda, 0x53, 0x5c, 0x81, 0xf3, 0x88, 0x55, 0x0b, 0x3e, 0xe4, 0x39, 0xba, 0x76, 0xd3, 0x6e, 0xd5,
-	0x3a, 0x37, 0xfd, 0x25, 0xad, 0xfb, 0x2f, 0xb5, 0x25, 0x5c, 0x3f, 0xfd, 0xd9, 0xb0, 0x3e, 0x9d,
-	0x1f, 0xef, 0xda, 0xfb, 0xa6, 0xca, 0xe9, 0xb2, 0xb5, 0x88, 0x0f, 0xb8, 0x88, 0x01, 0xdd, 0x4b,
-	0xcd, 0x4a, 0xab, 0xd6, 0xd9, 0x5e, 0x4a, 0x08, 0xc9, 0xb4, 0x88, 0x98, 0x15, 0x3a, 0x23, 0x56,
-	0xc5, 0xc3, 0xa2, 0x18, 0x8c, 0xdc, 0x8a, 0x46, 0x6c, 0xce, 0x11, 0x08, 0x33, 0x44, 0x57, 0x66,
-	0x22, 0x7c, 0x5a, 0xd6, 0x7f, 0xfe, 0xd5, 0x68, 0xa5, 0x99, 0x7a, 0x7d, 0x18, 0xf9, 0xb1, 0xcc,
-	0xcd, 0xd0, 0xe6, 0xd6, 0xc6, 0xe4, 0x20, 0x50, 0xa3, 0x02, 0x50, 0x17, 0xe0, 0xc7, 0xf3, 0xe3,
-	0xdd, 0xfa, 0x00, 0x52, 0x1e, 0x8f, 0xfa, 0xe5, 0x5a, 0xd1, 0xf4, 0x4f, 0x81, 0xce, 0x0b, 0x76,
-	0x35, 0x01, 0x21, 0xf3, 0x7e, 0x0e, 0x8a, 0x27, 0x5c, 0x71, 0xf7, 0xb2, 0x6e, 0xe1, 0xd6, 0xd2,
-	0x29, 0x9e, 0x1b, 0xd3, 0xe2, 0x18, 0x57, 0x74, 0xfd, 0x54, 0x71, 0x38, 0xab, 0x23, 0x88, 0xa4,
-	0x0f, 0x82, 0x47, 0x03, 0x48, 0xdc, 0x15, 0x8d, 0x6b, 0x2e, 0xc5, 0xf5, 0x40, 0x24, 0x4f, 0xc8,
-	0x17, 0x6e, 0x97, 0xc4, 0x1f, 0x27, 0xed, 0x6b, 0xf3, 0x31, 0x9a, 0x77, 0xfd, 0xfb, 0x0f, 0x28,
-	0xa4, 0x86, 0x73, 0xeb, 0xce, 0x17, 0x9b, 0xad, 0x9a, 0x7d, 0x3a, 0x1d, 0xb6, 0xca, 0x93, 0x64,
-	0x08, 0x48, 0x07, 0xb8, 0x1e, 0xba, 0xdf, 0x4e, 0xda, 0x1b, 0x26, 0xec, 0x31, 0x29, 0x3d, 0x35,
-	0xcc, 0x44, 0xba, 0x3f, 0x35, 0x3a, 0x6f, 0xd9, 0x8a, 0xde, 0x84, 0x39, 0xb0, 0x0b, 0xd8, 0x36,
-	0xe5, 0x3d, 0x5c, 0x7b, 0x7f, 0xd4, 0xb0, 0xfe, 0x1c, 0x35, 0xac, 0xb0, 0x7b, 0x3a, 0xf6, 0xec,
-	0xb3, 0xb1, 0x67, 0xff, 0x1e, 0x7b, 0xf6, 0x87, 0x89, 0x67, 0x9d, 0x4d, 0x3c, 0xeb, 0xfb, 0xc4,
-	0xb3, 0x5e, 0xdd, 0xf9, 0x6f, 0xd4, 0x3b, 0xfa, 0x0f, 0x74, 0x62, 0x54, 0xd5, 0xdf, 0xf4, 0xbd,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x58, 0x75, 0x60, 0x91, 0x03, 0x00, 0x00,
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x3d, 0x6f, 0xd3, 0x5e,
+	0x14, 0xc6, 0xed, 0xbe, 0xa4, 0xed, 0x4d, 0xfa, 0xff, 0x0b, 0xd3, 0xc1, 0x2d, 0xc5, 0x0e, 0x9d,
+	0x42, 0xa5, 0xd8, 0x34, 0x14, 0x21, 0x31, 0x20, 0xe1, 0x08, 0x98, 0x10, 0x55, 0xb3, 0xb1, 0x44,
+	0xd7, 0xf6, 0x91, 0xb1, 0x6a, 0xdf, 0x6b, 0xe5, 0xdc, 0x02, 0xfe, 0x06, 0x8c, 0xcc, 0x4c, 0x9d,
+	0x10, 0x62, 0xea, 0xd0, 0x0f, 0xc0, 0xd8, 0xb1, 0x62, 0x42, 0x0c, 0x80, 0x92, 0xa1, 0x7c, 0x0c,
+	0xe4, 0x7b, 0x6f, 0x5e, 0x24, 0x52, 0x46, 0x16, 0xdb, 0xf2, 0xf3, 0x9c, 0xe7, 0x77, 0xce, 0x7d,
+	0x21, 0xb7, 0x22, 0x8e, 0x39, 0x47, 0x3f, 0xa4, 0xec, 0xc8, 0x7f, 0xb5, 0x17, 0x82, 0xa0, 0x7b,
+	0x7e, 0x02, 0x0c, 0x30, 0x45, 0xaf, 0x18, 0x70, 0xc1, 0xad, 0xeb, 0xca, 0xe2, 0x55, 0x16, 0x4f,
+	0x5b, 0xb6, 0x36, 0x12, 0x9e, 0x70, 0xa9, 0xfb, 0xd5, 0x97, 0xb2, 0x6e, 0x39, 0x93, 0x34, 0x84,
+	0x49, 0x5a, 0xc4, 0x53, 0xf6, 0x87, 0x3e, 0x43, 0x93, 0xb9, 0x4a, 0xdf, 0x54, 0x7a, 0x5f, 0x05,
+	0x6b, 0xae, 0x92, 0xae, 0xd1, 0x3c, 0x65, 0xdc, 0x97, 0x4f, 0xf5, 0x6b, 0xe7, 0xc3, 0x12, 0x69,
+	0x3c, 0x55, 0xad, 0xf6, 0x04, 0x15, 0x60, 0x3d, 0x24, 0xb5, 0x82, 0x0e, 0x68, 0x8e, 0xb6, 0xd9,
+	0x34, 0x5b, 0xf5, 0xce, 0x0d, 0x6f, 0x4e, 0xeb, 0xde, 0x81, 0xb4, 0x04, 0x6b, 0xe7, 0xdf, 0x5d,
+	0xe3, 0xe3, 0xe5, 0xe9, 0xae, 0x79, 0xa8, 0xab, 0xac, 0x2e, 0x59, 0x0d, 0x69, 0x46, 0x59, 0x04,
+	0x68, 0x2f, 0x34, 0x17, 0x5b, 0xf5, 0xce, 0xf6, 0xdc, 0x84, 0x40, 0x99, 0x66, 0x23, 0x26, 0x85,
+	0x56, 0x49, 0x6a, 0x78, 0x5c, 0x14, 0x59, 0x69, 0x2f, 0xca, 0x88, 0xcd, 0x69, 0x04, 0xc2, 0x24,
+	0xa2, 0xcb, 0x53, 0x16, 0x3c, 0xa9, 0xea, 0x3f, 0xfd, 0x70, 0x5b, 0x49, 0x2a, 0x5e, 0x1e, 0x87,
+	0x5e, 0xc4, 0x73, 0x3d, 0xb4, 0x7e, 0xb5, 0x31, 0x3e, 0xf2, 0x45, 0x59, 0x00, 0xca, 0x02, 0x7c,
+	0x7f, 0x79, 0xba, 0xdb, 0xc8, 0x20, 0xa1, 0x51, 0xd9, 0xaf, 0x96, 0x15, 0x75, 0xff, 0x0a, 0x68,
+	0x3d, 0x27, 0xff, 0xc5, 0xc0, 0x78, 0xde, 0xcf, 0x41, 0xd0, 0x98, 0x0a, 0x6a, 0x2f, 0xc9, 0x16,
+	0x6e, 0xce, 0x9d, 0xe2, 0x99, 0x36, 0xcd, 0x8e, 0xb1, 0x2e, 0xeb, 0xc7, 0x8a, 0x45, 0x49, 0x03,
+	0x81, 0xc5, 0x7d, 0x60, 0x34, 0xcc, 0x20, 0xb6, 0x97, 0x65, 0x5c, 0x73, 0x6e, 0x5c, 0x0f, 0x58,
+	0xfc, 0x58, 0xf9, 0x82, 0xed, 0x2a, 0xf1, 0xdb, 0x59, 0xfb, 0xff, 0xe9, 0x18, 0xcd, 0x3b, 0xde,
+	0xfe, 0x7d, 0x05, 0xa9, 0xe3, 0xd4, 0x6a, 0x85, 0x64, 0x5d, 0x22, 0x0a, 0x9e, 0xa5, 0x51, 0x0a,
+	0x68, 0xd7, 0x24, 0xc3, 0xbd, 0x92, 0x71, 0x50, 0x19, 0xcb, 0x2b, 0x10, 0xf7, 0xf6, 0x15, 0xa2,
+	0x81, 0x63, 0x67, 0x0a, 0xb8, 0xf3, 0xd9, 0x24, 0x2b, 0x7a, 0xcf, 0xac, 0x0e, 0x59, 0xa1, 0x71,
+	0x3c, 0x00, 0x54, 0x87, 0x64, 0x2d, 0xb0, 0xbf, 0x9c, 0xb5, 0x37, 0x34, 0xec, 0x91, 0x52, 0x7a,
+	0x62, 0x90, 0xb2, 0xe4, 0x70, 0x6c, 0xb4, 0x5e, 0x93, 0x65, 0xb9, 0xda, 0xf6, 0xc2, 0xbf, 0xda,
+	0x51, 0xc5, 0x7b, 0xb0, 0xfa, 0xf6, 0xc4, 0x35, 0x7e, 0x9d, 0xb8, 0x46, 0xd0, 0x3d, 0x1f, 0x3a,
+	0xe6, 0xc5, 0xd0, 0x31, 0x7f, 0x0e, 0x1d, 0xf3, 0xdd, 0xc8, 0x31, 0x2e, 0x46, 0x8e, 0xf1, 0x75,
+	0xe4, 0x18, 0x2f, 0x6e, 0xff, 0x15, 0xf5, 0x46, 0xdd, 0x35, 0x49, 0x0c, 0x6b, 0xf2, 0xde, 0xdc,
+	0xfd, 0x3d, 0x00, 0x90, 0xf1, 0x00, 0x74, 0xf5, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +224,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SendPolicies) > 0 {
+		for iNdEx := len(m.SendPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -369,6 +394,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SendPolicies) > 0 {
+		for _, e := range m.SendPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,6 +626,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendPolicies = append(m.SendPolicies, SendPolicy{})
+			if err := m.SendPolicies[len(m.SendPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
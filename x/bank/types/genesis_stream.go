@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Fields of the bank module genesis state, as named in its JSON encoding. They
+// are the fields of the genesis source and target when streaming the genesis.
+const (
+	GenesisFieldParams        = "params"
+	GenesisFieldBalances      = "balances"
+	GenesisFieldSupply        = "supply"
+	GenesisFieldDenomMetadata = "denom_metadata"
+	GenesisFieldSendEnabled   = "send_enabled"
+	GenesisFieldSendPolicies  = "send_policies"
+)
+
+// ValidateGenesisStream validates the bank module's genesis state read from a
+// genesis source, reading the balances one account at a time.
+func ValidateGenesisStream(cdc codec.JSONCodec, source appmodule.GenesisSource) error {
+	// the state without the balances and supply is validated as a whole
+	var gs GenesisState
+	if _, err := module.ReadGenesisField(cdc, source, GenesisFieldParams, &gs.Params); err != nil {
+		return err
+	}
+
+	err := module.ReadGenesisArray(cdc, source, GenesisFieldSendEnabled, func() *SendEnabled { return &SendEnabled{} }, func(se *SendEnabled) error {
+		gs.SendEnabled = append(gs.SendEnabled, *se)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = module.ReadGenesisArray(cdc, source, GenesisFieldDenomMetadata, func() *Metadata { return &Metadata{} }, func(meta *Metadata) error {
+		gs.DenomMetadata = append(gs.DenomMetadata, *meta)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = module.ReadGenesisArray(cdc, source, GenesisFieldSendPolicies, func() *SendPolicy { return &SendPolicy{} }, func(policy *SendPolicy) error {
+		gs.SendPolicies = append(gs.SendPolicies, *policy)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := gs.Validate(); err != nil {
+		return err
+	}
+
+	seenBalances := make(map[string]bool)
+	totalSupply := sdk.Coins{}
+	err = module.ReadGenesisArray(cdc, source, GenesisFieldBalances, func() *Balance { return &Balance{} }, func(balance *Balance) error {
+		if seenBalances[balance.Address] {
+			return fmt.Errorf("duplicate balance for address %s", balance.Address)
+		}
+		if err := balance.Validate(); err != nil {
+			return err
+		}
+		seenBalances[balance.Address] = true
+
+		totalSupply = totalSupply.Add(balance.Coins...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var supply sdk.Coins
+	err = module.ReadGenesisArray(cdc, source, GenesisFieldSupply, func() *sdk.Coin { return &sdk.Coin{} }, func(coin *sdk.Coin) error {
+		supply = append(supply, *coin)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !supply.Empty() {
+		// NOTE: this errors if supply for any given coin is zero
+		if err := supply.Validate(); err != nil {
+			return err
+		}
+
+		if !supply.Equal(totalSupply) {
+			return fmt.Errorf("genesis supply is incorrect, expected %v, got %v", supply, totalSupply)
+		}
+	}
+
+	return nil
+}
@@ -35,6 +35,14 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// SendPoliciesPrefix is the prefix for the send policies of the denoms.
+	SendPoliciesPrefix = collections.NewPrefix(6)
+	// BlockTransferTallyPrefix is the prefix for the amounts of the denoms
+	// transferred in the current block.
+	BlockTransferTallyPrefix = collections.NewPrefix(7)
+	// SendPolicyCountKey is the key for the number of stored send policies.
+	SendPolicyCountKey = collections.NewPrefix(8)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
@@ -8,6 +8,7 @@ var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetSendPolicies{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -28,3 +29,13 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgSetSendPolicies constructs a message to set or remove the send policies
+// of one or more denoms.
+func NewMsgSetSendPolicies(authority string, sendPolicies []SendPolicy, removeDenoms []string) *MsgSetSendPolicies {
+	return &MsgSetSendPolicies{
+		Authority:    authority,
+		SendPolicies: sendPolicies,
+		RemoveDenoms: removeDenoms,
+	}
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	context "context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &PeriodicSendAuthorization{}
+
+// NewPeriodicSendAuthorization creates a new PeriodicSendAuthorization object.
+// The first period starts with the first send.
+func NewPeriodicSendAuthorization(period time.Duration, periodSpendLimit sdk.Coins) *PeriodicSendAuthorization {
+	return &PeriodicSendAuthorization{
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PeriodicSendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept. The authorization is never deleted,
+// the spend limit being restored at the start of each period.
+func (a PeriodicSendAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for range mSend.Amount {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "periodic send authorization")
+	}
+
+	a.tryResetPeriod(sdkCtx.HeaderInfo().Time)
+
+	canSpend, isNegative := a.PeriodCanSpend.SafeSub(mSend.Amount...)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than period spend limit")
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &PeriodicSendAuthorization{
+		Period:           a.Period,
+		PeriodSpendLimit: a.PeriodSpendLimit,
+		PeriodCanSpend:   canSpend,
+		PeriodReset:      a.PeriodReset,
+	}}, nil
+}
+
+// tryResetPeriod restores PeriodCanSpend to PeriodSpendLimit once PeriodReset
+// is reached. The next reset is one period after the previous one, or one
+// period after blockTime when more than a period elapsed without any send.
+func (a *PeriodicSendAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodCanSpend = a.PeriodSpendLimit
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PeriodicSendAuthorization) ValidateBasic() error {
+	if a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+	if !a.PeriodSpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spend limit is invalid: %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodSpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spend limit must be positive")
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period can spend is invalid: %s", a.PeriodCanSpend)
+	}
+	if !a.PeriodCanSpend.DenomsSubsetOf(a.PeriodSpendLimit) {
+		return sdkerrors.ErrInvalidCoins.Wrap("period can spend denoms must be a subset of the period spend limit")
+	}
+	if !a.PeriodCanSpend.IsAllLTE(a.PeriodSpendLimit) {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period can spend %s exceeds the period spend limit %s", a.PeriodCanSpend, a.PeriodSpendLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestPeriodicSendAuthorization(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(types.StoreKey), storetypes.NewTransientStoreKey("transient_test")).Ctx.WithHeaderInfo(header.Info{Time: now})
+	authorization := types.NewPeriodicSendAuthorization(time.Hour, coins1000)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("the first send starts the period")
+	resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated := resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Equal(t, coins500, updated.PeriodCanSpend)
+	require.Equal(t, now.Add(time.Hour), updated.PeriodReset)
+
+	t.Log("exhausting the period does not delete the authorization")
+	resp, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated = resp.Updated.(*types.PeriodicSendAuthorization)
+	require.True(t, updated.PeriodCanSpend.IsZero())
+
+	_, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	t.Log("the spend limit is restored in the next period")
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(90 * time.Minute)})
+	resp, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	updated = resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Equal(t, coins500, updated.PeriodCanSpend)
+	require.Equal(t, now.Add(2*time.Hour), updated.PeriodReset)
+
+	t.Log("a period without sends resets from the block time")
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(5 * time.Hour)})
+	resp, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins1000))
+	require.NoError(t, err)
+	updated = resp.Updated.(*types.PeriodicSendAuthorization)
+	require.True(t, updated.PeriodCanSpend.IsZero())
+	require.Equal(t, now.Add(6*time.Hour), updated.PeriodReset)
+
+	_, err = authorization.Accept(ctx, &types.MsgMultiSend{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
+
+func TestPeriodicSendAuthorizationValidateBasic(t *testing.T) {
+	require.Error(t, types.NewPeriodicSendAuthorization(0, coins1000).ValidateBasic())
+	require.Error(t, types.NewPeriodicSendAuthorization(time.Hour, sdk.NewCoins()).ValidateBasic())
+
+	authorization := types.NewPeriodicSendAuthorization(time.Hour, coins1000)
+	authorization.PeriodCanSpend = sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	require.Error(t, authorization.ValidateBasic())
+
+	authorization.PeriodCanSpend = coins1000.Add(coins1000...)
+	require.ErrorContains(t, authorization.ValidateBasic(), "exceeds the period spend limit")
+
+	authorization.PeriodCanSpend = coins500
+	require.NoError(t, authorization.ValidateBasic())
+}
@@ -1361,6 +1361,117 @@ func (m *QuerySendEnabledResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySendPoliciesRequest defines the RPC request for looking up send policies.
+type QuerySendPoliciesRequest struct {
+	// denoms is the specific denoms you want look up. Leave empty to get all entries.
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// pagination defines an optional pagination for the request. This field is
+	// only read if the denoms field is empty.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySendPoliciesRequest) Reset()         { *m = QuerySendPoliciesRequest{} }
+func (m *QuerySendPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySendPoliciesRequest) ProtoMessage()    {}
+func (*QuerySendPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{27}
+}
+func (m *QuerySendPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySendPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySendPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendPoliciesRequest.Merge(m, src)
+}
+func (m *QuerySendPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySendPoliciesRequest proto.InternalMessageInfo
+
+func (m *QuerySendPoliciesRequest) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *QuerySendPoliciesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySendPoliciesResponse defines the RPC response of a SendPolicies query.
+type QuerySendPoliciesResponse struct {
+	SendPolicies []SendPolicy `protobuf:"bytes,1,rep,name=send_policies,json=sendPolicies,proto3" json:"send_policies"`
+	// pagination defines the pagination in the response. This field is only
+	// populated if the denoms field in the request is empty.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySendPoliciesResponse) Reset()         { *m = QuerySendPoliciesResponse{} }
+func (m *QuerySendPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySendPoliciesResponse) ProtoMessage()    {}
+func (*QuerySendPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{28}
+}
+func (m *QuerySendPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySendPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySendPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendPoliciesResponse.Merge(m, src)
+}
+func (m *QuerySendPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySendPoliciesResponse proto.InternalMessageInfo
+
+func (m *QuerySendPoliciesResponse) GetSendPolicies() []SendPolicy {
+	if m != nil {
+		return m.SendPolicies
+	}
+	return nil
+}
+
+func (m *QuerySendPoliciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -1389,102 +1500,109 @@ func init() {
 	proto.RegisterType((*QueryDenomOwnersByQueryResponse)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersByQueryResponse")
 	proto.RegisterType((*QuerySendEnabledRequest)(nil), "cosmos.bank.v1beta1.QuerySendEnabledRequest")
 	proto.RegisterType((*QuerySendEnabledResponse)(nil), "cosmos.bank.v1beta1.QuerySendEnabledResponse")
+	proto.RegisterType((*QuerySendPoliciesRequest)(nil), "cosmos.bank.v1beta1.QuerySendPoliciesRequest")
+	proto.RegisterType((*QuerySendPoliciesResponse)(nil), "cosmos.bank.v1beta1.QuerySendPoliciesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 1431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4f, 0x88, 0x1b, 0x55,
-	0x18, 0xcf, 0x6b, 0x69, 0xba, 0xfb, 0x65, 0xab, 0xf4, 0xed, 0xd6, 0x6e, 0x67, 0x6d, 0xb2, 0x4e,
-	0x4b, 0x37, 0x0d, 0xbb, 0x33, 0x9b, 0x64, 0xed, 0x6a, 0xdc, 0x16, 0x36, 0xad, 0xeb, 0x41, 0xa5,
-	0x6d, 0xd6, 0x5e, 0xaa, 0x10, 0x26, 0xc9, 0x18, 0x43, 0x93, 0x99, 0x34, 0x33, 0xdb, 0x1a, 0x4a,
-	0x41, 0x04, 0xa1, 0x07, 0x11, 0xc1, 0xf6, 0x22, 0x08, 0x3d, 0x89, 0x08, 0x96, 0x0a, 0x3d, 0xf4,
-	0xa0, 0x47, 0xa1, 0x08, 0x62, 0x59, 0x2f, 0xb2, 0x82, 0xca, 0xae, 0x50, 0xf1, 0xea, 0x59, 0x90,
-	0xbc, 0x3f, 0x99, 0x99, 0xcc, 0xcb, 0x64, 0xf6, 0x8f, 0xa5, 0x78, 0x59, 0x92, 0x37, 0xef, 0x7b,
-	0xdf, 0xef, 0xf7, 0x7b, 0xdf, 0x7c, 0xef, 0xf7, 0xb2, 0x90, 0x28, 0x9b, 0x56, 0xc3, 0xb4, 0xd4,
-	0x92, 0x66, 0x5c, 0x52, 0xaf, 0xa4, 0x4b, 0xba, 0xad, 0xa5, 0xd5, 0xcb, 0x2b, 0x7a, 0xab, 0xad,
-	0x34, 0x5b, 0xa6, 0x6d, 0xe2, 0x51, 0x3a, 0x41, 0xe9, 0x4c, 0x50, 0xd8, 0x04, 0x29, 0xd5, 0x8d,
-	0xb2, 0x74, 0x3a, 0xbb, 0x1b, 0xdb, 0xd4, 0xaa, 0x35, 0x43, 0xb3, 0x6b, 0xa6, 0x41, 0x17, 0x90,
-	0xc6, 0xaa, 0x66, 0xd5, 0x24, 0x1f, 0xd5, 0xce, 0x27, 0x36, 0xfa, 0x6c, 0xd5, 0x34, 0xab, 0x75,
-	0x5d, 0xd5, 0x9a, 0x35, 0x55, 0x33, 0x0c, 0xd3, 0x26, 0x21, 0x16, 0x7b, 0x1a, 0x77, 0xaf, 0xcf,
-	0x57, 0x2e, 0x9b, 0x35, 0xc3, 0xf7, 0xdc, 0x85, 0x9a, 0x20, 0xa4, 0xcf, 0x0f, 0xd1, 0xe7, 0x45,
-	0x9a, 0x96, 0x31, 0xa0, 0x8f, 0x26, 0x58, 0x28, 0x47, 0xed, 0x26, 0x2b, 0xed, 0xd7, 0x1a, 0x35,
-	0xc3, 0x54, 0xc9, 0x5f, 0x3a, 0x24, 0xd7, 0x60, 0xf4, 0x7c, 0x67, 0x46, 0x5e, 0xab, 0x6b, 0x46,
-	0x59, 0x2f, 0xe8, 0x97, 0x57, 0x74, 0xcb, 0xc6, 0x19, 0xd8, 0xab, 0x55, 0x2a, 0x2d, 0xdd, 0xb2,
-	0xc6, 0xd1, 0x24, 0x4a, 0x0e, 0xe7, 0xc7, 0x57, 0xef, 0xcd, 0x8c, 0xb1, 0x4c, 0x8b, 0xf4, 0xc9,
-	0xb2, 0xdd, 0xaa, 0x19, 0xd5, 0x02, 0x9f, 0x88, 0xc7, 0x60, 0x4f, 0x45, 0x37, 0xcc, 0xc6, 0xf8,
-	0xae, 0x4e, 0x44, 0x81, 0x7e, 0xc9, 0x0d, 0xdd, 0xb8, 0x9d, 0x88, 0xfc, 0x79, 0x3b, 0x11, 0x91,
-	0x5f, 0x85, 0x31, 0x6f, 0x2a, 0xab, 0x69, 0x1a, 0x96, 0x8e, 0xb3, 0xb0, 0xb7, 0x44, 0x87, 0x48,
-	0xae, 0x58, 0xe6, 0x90, 0xd2, 0xdd, 0x14, 0x4b, 0xe7, 0x9b, 0xa2, 0x9c, 0x36, 0x6b, 0x46, 0x81,
-	0xcf, 0x94, 0x7f, 0x41, 0x70, 0x90, 0xac, 0xb6, 0x58, 0xaf, 0xb3, 0x05, 0xad, 0xed, 0x80, 0x5f,
-	0x02, 0x70, 0xb6, 0x96, 0x30, 0x88, 0x65, 0x8e, 0x79, 0x70, 0x50, 0x21, 0x39, 0x9a, 0x73, 0x5a,
-	0x95, 0x8b, 0x55, 0x70, 0x45, 0xe2, 0x1c, 0xec, 0x6b, 0xe9, 0x96, 0x59, 0xbf, 0xa2, 0x17, 0xa9,
-	0x18, 0xbb, 0x27, 0x51, 0x72, 0x28, 0x7f, 0x60, 0xed, 0xde, 0xcc, 0x7e, 0xba, 0xda, 0x8c, 0x55,
-	0xb9, 0x34, 0x39, 0xab, 0x3c, 0x3f, 0xab, 0xcc, 0x16, 0x46, 0xd8, 0xdc, 0x33, 0x3d, 0x52, 0xad,
-	0x23, 0x18, 0xf7, 0xb3, 0x63, 0x7a, 0x5d, 0x87, 0x21, 0xa6, 0x42, 0x87, 0xdf, 0xee, 0x40, 0xc1,
-	0xf2, 0x4b, 0x0f, 0x7e, 0x4d, 0x44, 0xbe, 0xfc, 0x2d, 0x91, 0xac, 0xd6, 0xec, 0x77, 0x56, 0x4a,
-	0x4a, 0xd9, 0x6c, 0xb0, 0x82, 0x51, 0x1d, 0x38, 0xaa, 0xdd, 0x6e, 0xea, 0x16, 0x09, 0xb0, 0x3e,
-	0x7d, 0x74, 0x37, 0x35, 0x52, 0xd7, 0xab, 0x5a, 0xb9, 0x5d, 0xec, 0x94, 0xa4, 0xf5, 0xc5, 0xa3,
-	0xbb, 0x29, 0x54, 0xe8, 0xa6, 0xc4, 0xaf, 0x08, 0x94, 0x9a, 0x1a, 0xa8, 0x14, 0xc5, 0xee, 0x96,
-	0x4a, 0xbe, 0x8f, 0xe0, 0x30, 0x21, 0xb9, 0xdc, 0xd4, 0x8d, 0x8a, 0x56, 0xaa, 0xeb, 0x4f, 0xd0,
-	0x46, 0xe6, 0x26, 0xf8, 0x66, 0xac, 0xde, 0x9b, 0x79, 0xda, 0xb3, 0x73, 0x73, 0x27, 0xe4, 0x7f,
-	0x10, 0xc4, 0xfb, 0x41, 0xff, 0x7f, 0xed, 0x52, 0x6e, 0x54, 0xc4, 0xff, 0x43, 0x04, 0x47, 0x84,
-	0xfc, 0xf3, 0x6d, 0x52, 0xca, 0x3b, 0xdf, 0x46, 0x02, 0xb6, 0x63, 0x5e, 0x6e, 0xc2, 0xd1, 0x60,
-	0x34, 0xdb, 0xe8, 0x34, 0x22, 0x01, 0xe6, 0xe5, 0xf7, 0x78, 0xfb, 0x79, 0xc3, 0xb4, 0xb5, 0xfa,
-	0xf2, 0x4a, 0xb3, 0x59, 0x6f, 0x73, 0xd2, 0x6f, 0x7a, 0xa4, 0x47, 0x9b, 0xa9, 0xc0, 0xfc, 0xe8,
-	0x9a, 0x2f, 0x59, 0xd6, 0xb3, 0x1d, 0x4e, 0x8f, 0xf8, 0x9b, 0xf7, 0x08, 0x0f, 0x04, 0xc6, 0xb4,
-	0x0d, 0x51, 0x8b, 0x8c, 0x3c, 0xbe, 0xda, 0x63, 0x09, 0xf1, 0x5b, 0xdb, 0xa8, 0xbc, 0x81, 0xfc,
-	0xe5, 0x69, 0x76, 0x88, 0x50, 0xbe, 0x67, 0xdf, 0xe6, 0xa2, 0x77, 0xab, 0x06, 0xb9, 0xaa, 0x46,
-	0xbe, 0x00, 0x07, 0x7a, 0x66, 0x33, 0x7d, 0x16, 0x20, 0xaa, 0x35, 0xcc, 0x15, 0xc3, 0x1e, 0x58,
-	0x08, 0xf9, 0xe1, 0x8e, 0x3e, 0x8c, 0x22, 0x8d, 0x91, 0xc7, 0x00, 0x93, 0x65, 0xcf, 0x69, 0x2d,
-	0xad, 0xc1, 0xbb, 0x95, 0x7c, 0x81, 0x1d, 0xa5, 0x7c, 0x94, 0xa5, 0x3a, 0x05, 0xd1, 0x26, 0x19,
-	0x61, 0xa9, 0x26, 0x14, 0x81, 0xe5, 0x50, 0x68, 0x90, 0x27, 0x19, 0x8d, 0x92, 0x2b, 0x20, 0x91,
-	0x65, 0x49, 0x29, 0x5b, 0xaf, 0xeb, 0xb6, 0x56, 0xd1, 0x6c, 0x8d, 0xf3, 0x5e, 0xda, 0x7a, 0xb1,
-	0x79, 0x74, 0xbd, 0x83, 0x60, 0x42, 0x98, 0x86, 0xb1, 0x58, 0x82, 0xe1, 0x06, 0x1b, 0xe3, 0xfd,
-	0xec, 0xb0, 0x90, 0x08, 0x8f, 0x74, 0x53, 0x71, 0x42, 0x77, 0xee, 0xf4, 0x48, 0xc3, 0x21, 0x07,
-	0x6f, 0xaf, 0x2a, 0xe2, 0x6a, 0x28, 0xb9, 0x95, 0xf4, 0x31, 0x3c, 0x03, 0x43, 0x1c, 0x26, 0xd3,
-	0x31, 0x3c, 0xc1, 0x6e, 0xa4, 0x7c, 0x0a, 0x8e, 0xf9, 0x73, 0xe4, 0xdb, 0xb4, 0x0a, 0x69, 0xa7,
-	0x0b, 0xc4, 0x68, 0xc2, 0xd4, 0xc0, 0xf8, 0x1d, 0x05, 0x7c, 0x95, 0x35, 0x32, 0x92, 0xf0, 0xec,
-	0x55, 0x43, 0x6f, 0x59, 0x81, 0x08, 0x77, 0xea, 0x80, 0x95, 0x6f, 0x21, 0x00, 0x27, 0xe9, 0x96,
-	0x8e, 0x8a, 0x53, 0x4e, 0x3f, 0xdf, 0xb5, 0x89, 0xd7, 0x38, 0xa8, 0xb5, 0x9f, 0x90, 0xbf, 0xe1,
-	0x7d, 0xd5, 0xa3, 0x08, 0xd3, 0x3c, 0x0f, 0x23, 0x44, 0x85, 0xa2, 0x49, 0xc6, 0xd9, 0x9b, 0x90,
-	0x10, 0xea, 0xee, 0xc4, 0x17, 0x62, 0x15, 0x67, 0xad, 0xff, 0xf8, 0x68, 0xbe, 0xc5, 0xad, 0x89,
-	0x0b, 0x3e, 0xab, 0x9f, 0xc7, 0xb2, 0xaf, 0xb9, 0x03, 0xab, 0x02, 0xab, 0x9b, 0x95, 0xbf, 0x43,
-	0x90, 0xe8, 0x8b, 0xeb, 0x49, 0x54, 0xb7, 0x0f, 0x8f, 0x8f, 0xf8, 0xc9, 0xbf, 0xac, 0x1b, 0x95,
-	0x97, 0x8d, 0x8e, 0xdb, 0xa8, 0x70, 0x61, 0x9f, 0x81, 0x28, 0x81, 0x42, 0x91, 0x0f, 0x17, 0xd8,
-	0xb7, 0x1e, 0x69, 0xcb, 0x5b, 0x96, 0x56, 0x68, 0x45, 0xbe, 0xe5, 0xf5, 0xea, 0x01, 0xc4, 0x14,
-	0x3d, 0x0d, 0x23, 0x96, 0x6e, 0x54, 0x8a, 0x3a, 0x1d, 0x67, 0x8a, 0x4e, 0x0a, 0x15, 0x75, 0xc7,
-	0xc7, 0x2c, 0xe7, 0x4b, 0x8f, 0xa4, 0xe5, 0x1d, 0x2d, 0xd8, 0xf9, 0xcc, 0xd7, 0x18, 0xf6, 0x10,
-	0xfc, 0xf8, 0x33, 0x04, 0x7b, 0x99, 0x73, 0xc3, 0x49, 0x21, 0x44, 0xc1, 0x55, 0x55, 0x3a, 0x1e,
-	0x62, 0x26, 0xc5, 0x22, 0x9f, 0xbc, 0xd1, 0x79, 0xff, 0xdf, 0xff, 0xe9, 0x8f, 0x4f, 0x76, 0x65,
-	0xf0, 0xac, 0x2a, 0xbe, 0x65, 0x53, 0x03, 0xad, 0x5e, 0x63, 0x4d, 0xe6, 0xba, 0x5a, 0x6a, 0xd3,
-	0xab, 0x1c, 0xbe, 0x8d, 0x20, 0xe6, 0xba, 0x90, 0xe1, 0xe9, 0xfe, 0x99, 0xfd, 0xb7, 0x52, 0x69,
-	0x26, 0xe4, 0x6c, 0x86, 0x75, 0xce, 0xc1, 0x7a, 0x1c, 0x4f, 0x85, 0xc4, 0x8a, 0x7f, 0x44, 0xb0,
-	0xdf, 0x77, 0x27, 0xc1, 0x99, 0xfe, 0xa9, 0xfb, 0xdd, 0xbd, 0xa4, 0xec, 0xa6, 0x62, 0x18, 0xe8,
-	0xf3, 0xdf, 0xfb, 0x3b, 0x92, 0xc3, 0x23, 0x8b, 0xd3, 0x42, 0x1e, 0x16, 0x5f, 0xaf, 0x28, 0x60,
-	0xf4, 0x17, 0x82, 0x83, 0x7d, 0x7c, 0x3d, 0x7e, 0x21, 0x3c, 0x46, 0xef, 0xc5, 0x44, 0x7a, 0x71,
-	0x0b, 0x91, 0x8c, 0xe3, 0x45, 0x3f, 0xc7, 0x79, 0x87, 0xe3, 0x02, 0xce, 0x6d, 0x9a, 0xa3, 0x53,
-	0x61, 0x37, 0x11, 0xc4, 0x5c, 0x76, 0x3e, 0xa8, 0xc2, 0xfc, 0x17, 0x8f, 0xa0, 0x0a, 0x13, 0xdc,
-	0x11, 0xe4, 0xa4, 0x83, 0xfa, 0x30, 0x9e, 0x10, 0xa3, 0xa6, 0x30, 0x6e, 0x22, 0x18, 0xe2, 0x16,
-	0x1a, 0x07, 0xbc, 0x6f, 0x3d, 0xa6, 0x5c, 0x4a, 0x85, 0x99, 0xca, 0xd0, 0xa4, 0x1d, 0x34, 0xc7,
-	0xf0, 0xd1, 0x00, 0x34, 0x8e, 0x5a, 0x1f, 0x20, 0x88, 0x52, 0xdf, 0x8c, 0xa7, 0xfa, 0x67, 0xf2,
-	0x98, 0x74, 0x29, 0x39, 0x78, 0x62, 0x78, 0x79, 0xa8, 0x43, 0xc7, 0x9f, 0x23, 0x78, 0xca, 0x6b,
-	0x9b, 0xb1, 0xda, 0x3f, 0x8d, 0xd0, 0xc7, 0x4b, 0xb3, 0xe1, 0x03, 0xc2, 0x0b, 0x46, 0x8f, 0x9f,
-	0x22, 0xf7, 0x7a, 0xf8, 0x0e, 0x82, 0x7d, 0x1e, 0x63, 0x89, 0x95, 0x01, 0x69, 0x7b, 0x61, 0xaa,
-	0xa1, 0xe7, 0x33, 0x94, 0x0b, 0x0e, 0xca, 0x34, 0x56, 0xc3, 0xa0, 0x54, 0xaf, 0x91, 0x81, 0x93,
-	0xa9, 0xd4, 0x75, 0xbc, 0x86, 0x40, 0xea, 0xef, 0x84, 0xf1, 0x4b, 0x21, 0xd1, 0x88, 0xfc, 0xb7,
-	0xb4, 0xb0, 0xb5, 0x60, 0xc6, 0x6b, 0xd1, 0xe1, 0x75, 0x02, 0xcf, 0x85, 0xe1, 0x55, 0x2c, 0xb5,
-	0x8b, 0xe4, 0x9c, 0x2c, 0x5a, 0x14, 0xfd, 0x7d, 0x04, 0x31, 0x97, 0x19, 0x0a, 0x7a, 0xd9, 0xfd,
-	0xe6, 0x3c, 0xe8, 0x65, 0x17, 0x18, 0x57, 0xf9, 0xb5, 0xc0, 0xce, 0xac, 0xe0, 0xe9, 0xfe, 0x14,
-	0x98, 0x1b, 0x73, 0xef, 0xcb, 0x0f, 0x08, 0xb0, 0xdf, 0xc7, 0xe1, 0x6c, 0x28, 0x4c, 0x5e, 0x37,
-	0x2a, 0xcd, 0x6d, 0x2e, 0xc8, 0xc5, 0xc7, 0xef, 0xce, 0x1c, 0x46, 0xd3, 0x38, 0x35, 0x90, 0x51,
-	0x77, 0x47, 0xf0, 0x57, 0x08, 0x62, 0x2e, 0xfb, 0x13, 0xb4, 0x15, 0x7e, 0xdb, 0x17, 0xb4, 0x15,
-	0x02, 0x4f, 0x26, 0x2f, 0x06, 0x1e, 0x20, 0x47, 0xf0, 0x73, 0xe2, 0xe6, 0xe7, 0xb2, 0x71, 0xf9,
-	0xd3, 0x0f, 0xd6, 0xe3, 0xe8, 0xe1, 0x7a, 0x1c, 0xfd, 0xbe, 0x1e, 0x47, 0x1f, 0x6f, 0xc4, 0x23,
-	0x0f, 0x37, 0xe2, 0x91, 0x9f, 0x37, 0xe2, 0x91, 0x8b, 0xc7, 0x03, 0x7f, 0xc5, 0x79, 0x97, 0xae,
-	0x49, 0x7e, 0xcc, 0x29, 0x45, 0xc9, 0x7f, 0x00, 0xb2, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x4b,
-	0xc9, 0xde, 0xc5, 0x24, 0x19, 0x00, 0x00,
+	// 1511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4f, 0x68, 0x1c, 0x55,
+	0x18, 0xcf, 0x6b, 0x69, 0x9a, 0x7c, 0x9b, 0x2a, 0x7d, 0x49, 0x6d, 0x32, 0xb1, 0xbb, 0x71, 0x5a,
+	0x9a, 0x74, 0xc9, 0xce, 0x24, 0xd9, 0xb4, 0xd1, 0x98, 0x16, 0xba, 0xad, 0xe9, 0x41, 0xa5, 0xed,
+	0xc6, 0x5e, 0xaa, 0xb0, 0xcc, 0xee, 0x8e, 0xeb, 0xd2, 0xdd, 0x99, 0xed, 0xbe, 0x4d, 0xeb, 0x52,
+	0x0a, 0x22, 0x08, 0x3d, 0x88, 0x0a, 0xb6, 0x17, 0x41, 0xe8, 0x49, 0x44, 0xb0, 0x54, 0xe8, 0xa1,
+	0x07, 0x3d, 0x0a, 0xa5, 0x20, 0x96, 0x7a, 0x91, 0x0a, 0x2a, 0xa9, 0x52, 0xf1, 0xea, 0x59, 0x90,
+	0x7d, 0x7f, 0x76, 0x66, 0x76, 0xde, 0xcc, 0x4e, 0x92, 0xb5, 0x16, 0x2f, 0x25, 0xfb, 0xe6, 0xfb,
+	0xde, 0xf7, 0xfb, 0xfd, 0xde, 0x37, 0xdf, 0xfb, 0xbe, 0x29, 0x24, 0x0a, 0x36, 0xa9, 0xda, 0x44,
+	0xcf, 0x1b, 0xd6, 0x39, 0xfd, 0xc2, 0x6c, 0xde, 0x6c, 0x18, 0xb3, 0xfa, 0xf9, 0x55, 0xb3, 0xde,
+	0xd4, 0x6a, 0x75, 0xbb, 0x61, 0xe3, 0x61, 0x66, 0xa0, 0xb5, 0x0c, 0x34, 0x6e, 0xa0, 0x24, 0xdb,
+	0x5e, 0xc4, 0x64, 0xd6, 0x6d, 0xdf, 0x9a, 0x51, 0x2a, 0x5b, 0x46, 0xa3, 0x6c, 0x5b, 0x6c, 0x03,
+	0x65, 0xa4, 0x64, 0x97, 0x6c, 0xfa, 0xa7, 0xde, 0xfa, 0x8b, 0xaf, 0x3e, 0x5b, 0xb2, 0xed, 0x52,
+	0xc5, 0xd4, 0x8d, 0x5a, 0x59, 0x37, 0x2c, 0xcb, 0x6e, 0x50, 0x17, 0xc2, 0x9f, 0xc6, 0xdd, 0xfb,
+	0x8b, 0x9d, 0x0b, 0x76, 0xd9, 0xf2, 0x3d, 0x77, 0xa1, 0xa6, 0x08, 0xd9, 0xf3, 0x31, 0xf6, 0x3c,
+	0xc7, 0xc2, 0x72, 0x06, 0xec, 0xd1, 0x38, 0x77, 0x15, 0xa8, 0xdd, 0x64, 0x95, 0x9d, 0x46, 0xb5,
+	0x6c, 0xd9, 0x3a, 0xfd, 0x97, 0x2d, 0xa9, 0x65, 0x18, 0x3e, 0xdd, 0xb2, 0xc8, 0x18, 0x15, 0xc3,
+	0x2a, 0x98, 0x59, 0xf3, 0xfc, 0xaa, 0x49, 0x1a, 0x78, 0x0e, 0xb6, 0x1b, 0xc5, 0x62, 0xdd, 0x24,
+	0x64, 0x14, 0x4d, 0xa0, 0xa9, 0xc1, 0xcc, 0xe8, 0xfd, 0x5b, 0xa9, 0x11, 0x1e, 0xe9, 0x28, 0x7b,
+	0xb2, 0xd2, 0xa8, 0x97, 0xad, 0x52, 0x56, 0x18, 0xe2, 0x11, 0xd8, 0x56, 0x34, 0x2d, 0xbb, 0x3a,
+	0xba, 0xa5, 0xe5, 0x91, 0x65, 0x3f, 0x16, 0x07, 0xae, 0x5c, 0x4f, 0xf4, 0xfd, 0x71, 0x3d, 0xd1,
+	0xa7, 0xbe, 0x0c, 0x23, 0xde, 0x50, 0xa4, 0x66, 0x5b, 0xc4, 0xc4, 0x69, 0xd8, 0x9e, 0x67, 0x4b,
+	0x34, 0x56, 0x6c, 0x6e, 0x4c, 0x6b, 0x1f, 0x0a, 0x31, 0xc5, 0xa1, 0x68, 0xc7, 0xec, 0xb2, 0x95,
+	0x15, 0x96, 0xea, 0x4f, 0x08, 0x76, 0xd3, 0xdd, 0x8e, 0x56, 0x2a, 0x7c, 0x43, 0xb2, 0x19, 0xf0,
+	0xcb, 0x00, 0xce, 0xd1, 0x52, 0x06, 0xb1, 0xb9, 0xfd, 0x1e, 0x1c, 0x4c, 0x48, 0x81, 0xe6, 0x94,
+	0x51, 0x12, 0x62, 0x65, 0x5d, 0x9e, 0x78, 0x11, 0x76, 0xd4, 0x4d, 0x62, 0x57, 0x2e, 0x98, 0x39,
+	0x26, 0xc6, 0xd6, 0x09, 0x34, 0x35, 0x90, 0xd9, 0xf5, 0xe0, 0x56, 0x6a, 0x27, 0xdb, 0x2d, 0x45,
+	0x8a, 0xe7, 0x26, 0x66, 0xb4, 0x83, 0x33, 0xda, 0x4c, 0x76, 0x88, 0xdb, 0x1e, 0xef, 0x90, 0x6a,
+	0x0d, 0xc1, 0xa8, 0x9f, 0x1d, 0xd7, 0xeb, 0x32, 0x0c, 0x70, 0x15, 0x5a, 0xfc, 0xb6, 0x86, 0x0a,
+	0x96, 0x59, 0xbe, 0xf3, 0x73, 0xa2, 0xef, 0x8b, 0x5f, 0x12, 0x53, 0xa5, 0x72, 0xe3, 0xad, 0xd5,
+	0xbc, 0x56, 0xb0, 0xab, 0x3c, 0x61, 0x74, 0x07, 0x8e, 0xde, 0x68, 0xd6, 0x4c, 0x42, 0x1d, 0xc8,
+	0x27, 0x8f, 0x6e, 0x26, 0x87, 0x2a, 0x66, 0xc9, 0x28, 0x34, 0x73, 0xad, 0x94, 0x24, 0x9f, 0x3f,
+	0xba, 0x99, 0x44, 0xd9, 0x76, 0x48, 0x7c, 0x42, 0xa2, 0xd4, 0x64, 0x57, 0xa5, 0x18, 0x76, 0xb7,
+	0x54, 0xea, 0x6d, 0x04, 0x7b, 0x28, 0xc9, 0x95, 0x9a, 0x69, 0x15, 0x8d, 0x7c, 0xc5, 0x7c, 0x82,
+	0x0e, 0x72, 0x71, 0x5c, 0x1c, 0xc6, 0xfd, 0x5b, 0xa9, 0xa7, 0x3d, 0x27, 0x37, 0x7f, 0x48, 0xfd,
+	0x1b, 0x41, 0x3c, 0x08, 0xfa, 0xff, 0xeb, 0x94, 0x16, 0x87, 0x65, 0xfc, 0xdf, 0x47, 0xb0, 0x57,
+	0xca, 0x3f, 0xd3, 0xa4, 0xa9, 0xdc, 0xfb, 0x32, 0x12, 0x72, 0x1c, 0x0b, 0x6a, 0x0d, 0xf6, 0x85,
+	0xa3, 0xd9, 0x44, 0xa5, 0x91, 0x09, 0xb0, 0xa0, 0xbe, 0x23, 0xca, 0xcf, 0x6b, 0x76, 0xc3, 0xa8,
+	0xac, 0xac, 0xd6, 0x6a, 0x95, 0xa6, 0x20, 0xfd, 0xba, 0x47, 0x7a, 0xb4, 0x9e, 0x0c, 0xcc, 0x0c,
+	0x3f, 0xf0, 0x05, 0x4b, 0x7b, 0x8e, 0xc3, 0xa9, 0x11, 0x7f, 0x89, 0x1a, 0xe1, 0x81, 0xc0, 0x99,
+	0x36, 0xa1, 0x9f, 0xd0, 0x95, 0xc7, 0x97, 0x7b, 0x3c, 0x20, 0x7e, 0x63, 0x13, 0x99, 0xd7, 0x95,
+	0xbf, 0x3a, 0xcd, 0x2f, 0x11, 0xc6, 0xf7, 0xe4, 0x9b, 0x42, 0xf4, 0x76, 0xd6, 0x20, 0x57, 0xd6,
+	0xa8, 0x67, 0x60, 0x57, 0x87, 0x35, 0xd7, 0x67, 0x09, 0xfa, 0x8d, 0xaa, 0xbd, 0x6a, 0x35, 0xba,
+	0x26, 0x42, 0x66, 0xb0, 0xa5, 0x0f, 0xa7, 0xc8, 0x7c, 0xd4, 0x11, 0xc0, 0x74, 0xdb, 0x53, 0x46,
+	0xdd, 0xa8, 0x8a, 0x6a, 0xa5, 0x9e, 0x81, 0x61, 0xcf, 0x2a, 0x0f, 0x75, 0x04, 0xfa, 0x6b, 0x74,
+	0x85, 0x87, 0x1a, 0xd7, 0x24, 0x2d, 0x87, 0xc6, 0x9c, 0x3c, 0xc1, 0x98, 0x97, 0x5a, 0x04, 0x85,
+	0x6e, 0x4b, 0x53, 0x99, 0xbc, 0x6a, 0x36, 0x8c, 0xa2, 0xd1, 0x30, 0x04, 0xef, 0xe5, 0x8d, 0x27,
+	0x9b, 0x47, 0xd7, 0x1b, 0x08, 0xc6, 0xa5, 0x61, 0x38, 0x8b, 0x65, 0x18, 0xac, 0xf2, 0x35, 0x51,
+	0xcf, 0xf6, 0x48, 0x89, 0x08, 0x4f, 0x37, 0x15, 0xc7, 0xb5, 0x77, 0xb7, 0xc7, 0x2c, 0x8c, 0x39,
+	0x78, 0x3b, 0x55, 0x91, 0x67, 0x43, 0x1e, 0x14, 0x99, 0x0b, 0x67, 0x78, 0x1c, 0x06, 0x04, 0x4c,
+	0xae, 0x63, 0x74, 0x82, 0x6d, 0x4f, 0xf5, 0x08, 0xec, 0xf7, 0xc7, 0xc8, 0x34, 0x59, 0x16, 0xb2,
+	0x4a, 0x17, 0x8a, 0xd1, 0x86, 0xc9, 0xae, 0xfe, 0x3d, 0x05, 0x7c, 0x11, 0x76, 0x3b, 0x01, 0x4f,
+	0x5e, 0xb4, 0xcc, 0x3a, 0x09, 0x45, 0xd8, 0xab, 0x0b, 0x56, 0xbd, 0x86, 0x00, 0x9c, 0xa0, 0x1b,
+	0xba, 0x2a, 0x8e, 0x38, 0xf5, 0x7c, 0xcb, 0x3a, 0x5e, 0xe3, 0xb0, 0xd2, 0x7e, 0x48, 0xfd, 0x5a,
+	0xd4, 0x55, 0x8f, 0x22, 0x5c, 0xf3, 0x0c, 0x0c, 0x51, 0x15, 0x72, 0x36, 0x5d, 0xe7, 0x6f, 0x42,
+	0x42, 0xaa, 0xbb, 0xe3, 0x9f, 0x8d, 0x15, 0x9d, 0xbd, 0xfe, 0xe5, 0xab, 0xf9, 0x9a, 0x68, 0x4d,
+	0x5c, 0xf0, 0x79, 0xfe, 0x3c, 0x96, 0x73, 0x5d, 0xdc, 0x75, 0x5f, 0xd2, 0xea, 0xa6, 0xd5, 0x6f,
+	0x11, 0x24, 0x02, 0x71, 0x3d, 0x89, 0xea, 0x06, 0xf0, 0xf8, 0x40, 0xdc, 0xfc, 0x2b, 0xa6, 0x55,
+	0x7c, 0xc9, 0x6a, 0x75, 0x1b, 0x45, 0x21, 0xec, 0x33, 0xd0, 0x4f, 0xa1, 0x30, 0xe4, 0x83, 0x59,
+	0xfe, 0xab, 0x43, 0xda, 0xc2, 0x86, 0xa5, 0x95, 0xb6, 0x22, 0xdf, 0x88, 0x7c, 0xf5, 0x00, 0xe2,
+	0x8a, 0x1e, 0x83, 0x21, 0x62, 0x5a, 0xc5, 0x9c, 0xc9, 0xd6, 0xb9, 0xa2, 0x13, 0x52, 0x45, 0xdd,
+	0xfe, 0x31, 0xe2, 0xfc, 0xc0, 0x27, 0x24, 0xf0, 0x7b, 0x95, 0xb0, 0x0b, 0xea, 0x87, 0x6e, 0xfc,
+	0xa7, 0xec, 0x4a, 0xb9, 0x50, 0x36, 0xc9, 0x7f, 0xa7, 0xe8, 0xc1, 0x79, 0xf5, 0x2e, 0x82, 0x31,
+	0x09, 0x22, 0x2e, 0xe9, 0x49, 0xd8, 0x41, 0x25, 0xad, 0xf1, 0x07, 0xa1, 0x59, 0xda, 0xde, 0xa1,
+	0xe9, 0x2e, 0x40, 0x43, 0x44, 0x2c, 0x97, 0x7d, 0xad, 0x7a, 0x4f, 0xe5, 0x3d, 0x38, 0x3f, 0xf7,
+	0xfb, 0x30, 0x6c, 0xa3, 0x64, 0xf0, 0xa7, 0x08, 0xb6, 0xf3, 0xc6, 0x18, 0x4f, 0x49, 0xd1, 0x4a,
+	0xbe, 0x04, 0x28, 0x07, 0x22, 0x58, 0x32, 0x2c, 0xea, 0xe1, 0x2b, 0x2d, 0x76, 0xef, 0xfe, 0xf0,
+	0xdb, 0xc7, 0x5b, 0xe6, 0xf0, 0x8c, 0x2e, 0xff, 0x88, 0x41, 0x5d, 0x88, 0x7e, 0x89, 0xd7, 0xf0,
+	0xcb, 0x7a, 0xbe, 0xc9, 0x26, 0x65, 0x7c, 0x1d, 0x41, 0xcc, 0x35, 0xef, 0xe2, 0xe9, 0xe0, 0xc8,
+	0xfe, 0xa1, 0x5f, 0x49, 0x45, 0xb4, 0xe6, 0x58, 0xe7, 0x1d, 0xac, 0x07, 0xf0, 0x64, 0x44, 0xac,
+	0xf8, 0x7b, 0x04, 0x3b, 0x7d, 0x23, 0x1f, 0x9e, 0x0b, 0x0e, 0x1d, 0x34, 0xda, 0x2a, 0xe9, 0x75,
+	0xf9, 0x70, 0xd0, 0xa7, 0xef, 0xfa, 0x0b, 0xbe, 0xc3, 0x23, 0x8d, 0x67, 0xa5, 0x3c, 0x88, 0xd8,
+	0x2f, 0x27, 0x61, 0xf4, 0x27, 0x82, 0xdd, 0x01, 0x63, 0x13, 0x7e, 0x3e, 0x3a, 0x46, 0xef, 0xdc,
+	0xa7, 0xbc, 0xb0, 0x01, 0x4f, 0xce, 0xf1, 0xac, 0x9f, 0xe3, 0x82, 0xc3, 0x71, 0x09, 0x2f, 0xae,
+	0x9b, 0xa3, 0x93, 0x61, 0x57, 0x11, 0xc4, 0x5c, 0xd3, 0x52, 0x58, 0x86, 0xf9, 0xe7, 0x3a, 0x25,
+	0x15, 0xd1, 0x9a, 0x13, 0x99, 0x72, 0x50, 0xef, 0xc1, 0xe3, 0x72, 0xd4, 0x0c, 0xc6, 0x55, 0x04,
+	0x03, 0x62, 0x42, 0xc1, 0x21, 0xef, 0x5b, 0xc7, 0xcc, 0xa3, 0x24, 0xa3, 0x98, 0x72, 0x34, 0xb3,
+	0x0e, 0x9a, 0xfd, 0x78, 0x5f, 0x08, 0x1a, 0x47, 0xad, 0xf7, 0x10, 0xf4, 0xb3, 0xb1, 0x04, 0x4f,
+	0x06, 0x47, 0xf2, 0xcc, 0x40, 0xca, 0x54, 0x77, 0xc3, 0xe8, 0xf2, 0xb0, 0x01, 0x08, 0x7f, 0x86,
+	0xe0, 0x29, 0xef, 0x54, 0x82, 0xf5, 0xe0, 0x30, 0xd2, 0x31, 0x49, 0x99, 0x89, 0xee, 0x10, 0x5d,
+	0x30, 0x76, 0x17, 0xe5, 0x44, 0x2b, 0x8d, 0x6f, 0x20, 0xd8, 0xe1, 0xe9, 0xdb, 0xb1, 0xd6, 0x25,
+	0x6c, 0x27, 0x4c, 0x3d, 0xb2, 0x3d, 0x47, 0xb9, 0xe4, 0xa0, 0x9c, 0xc5, 0x7a, 0x14, 0x94, 0xfa,
+	0x25, 0xba, 0x70, 0x38, 0x99, 0xbc, 0x8c, 0x1f, 0x20, 0x50, 0x82, 0x07, 0x0d, 0xfc, 0x62, 0x44,
+	0x34, 0xb2, 0xf1, 0x46, 0x59, 0xda, 0x98, 0x33, 0xe7, 0x75, 0xd4, 0xe1, 0x75, 0x08, 0xcf, 0x47,
+	0xe1, 0x95, 0xcb, 0x37, 0x73, 0xf4, 0x9e, 0xcc, 0x11, 0x86, 0xfe, 0x36, 0x82, 0x98, 0xab, 0xd7,
+	0x0c, 0x7b, 0xd9, 0xfd, 0xb3, 0x8f, 0x92, 0x8a, 0x68, 0xcd, 0xf1, 0xbe, 0x12, 0x5a, 0x99, 0x35,
+	0x3c, 0x1d, 0x4c, 0x81, 0x37, 0xbb, 0xee, 0x73, 0xf9, 0x0e, 0x01, 0xf6, 0xb7, 0xc9, 0x38, 0x1d,
+	0x09, 0x93, 0xb7, 0xd9, 0x57, 0xe6, 0xd7, 0xe7, 0xe4, 0xe2, 0xe3, 0x6f, 0x7e, 0x1d, 0x46, 0xd3,
+	0x38, 0xd9, 0x95, 0x51, 0xfb, 0x44, 0xf0, 0x97, 0x08, 0x62, 0xae, 0xee, 0x32, 0xec, 0x28, 0xfc,
+	0x5d, 0xb5, 0x92, 0x8a, 0x68, 0x2d, 0x52, 0x27, 0xf4, 0x02, 0xd9, 0x8b, 0x9f, 0x93, 0x17, 0x3f,
+	0x57, 0x97, 0x8c, 0xbf, 0x42, 0x30, 0xe4, 0xee, 0xfd, 0x70, 0x17, 0x08, 0x1d, 0x5d, 0xab, 0xa2,
+	0x45, 0x35, 0xe7, 0x90, 0x33, 0x77, 0xfd, 0x8d, 0x9b, 0x03, 0x79, 0x1f, 0x56, 0x83, 0x21, 0x8b,
+	0x2e, 0x34, 0x73, 0xec, 0xce, 0x5a, 0x1c, 0xdd, 0x5b, 0x8b, 0xa3, 0x5f, 0xd7, 0xe2, 0xe8, 0xa3,
+	0x87, 0xf1, 0xbe, 0x7b, 0x0f, 0xe3, 0x7d, 0x3f, 0x3e, 0x8c, 0xf7, 0x9d, 0x3d, 0x10, 0xfa, 0x61,
+	0xef, 0x6d, 0xb6, 0x29, 0xfd, 0xbe, 0x97, 0xef, 0xa7, 0xff, 0x29, 0x94, 0xfe, 0x67, 0x00, 0xd0,
+	0x2c, 0x02, 0x96, 0x37, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1552,6 +1670,10 @@ type QueryClient interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// SendPolicies queries for the send policies of denoms.
+	//
+	// Only denominations with a send policy are returned.
+	SendPolicies(ctx context.Context, in *QuerySendPoliciesRequest, opts ...grpc.CallOption) (*QuerySendPoliciesResponse, error)
 }
 
 type queryClient struct {
@@ -1679,6 +1801,15 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) SendPolicies(ctx context.Context, in *QuerySendPoliciesRequest, opts ...grpc.CallOption) (*QuerySendPoliciesResponse, error) {
+	out := new(QuerySendPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/SendPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -1734,6 +1865,10 @@ type QueryServer interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// SendPolicies queries for the send policies of denoms.
+	//
+	// Only denominations with a send policy are returned.
+	SendPolicies(context.Context, *QuerySendPoliciesRequest) (*QuerySendPoliciesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1779,6 +1914,9 @@ func (*UnimplementedQueryServer) DenomOwnersByQuery(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) SendEnabled(ctx context.Context, req *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (*UnimplementedQueryServer) SendPolicies(ctx context.Context, req *QuerySendPoliciesRequest) (*QuerySendPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPolicies not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2018,6 +2156,24 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SendPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySendPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/SendPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendPolicies(ctx, req.(*QuerySendPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
@@ -2075,6 +2231,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "SendPolicies",
+			Handler:    _Query_SendPolicies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -3148,6 +3308,103 @@ func (m *QuerySendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySendPoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySendPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySendPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySendPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySendPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySendPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.SendPolicies) > 0 {
+		for iNdEx := len(m.SendPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3575,6 +3832,44 @@ func (m *QuerySendEnabledResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySendPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySendPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendPolicies) > 0 {
+		for _, e := range m.SendPolicies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6346,6 +6641,244 @@ func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySendPoliciesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySendPoliciesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySendPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySendPoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySendPoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySendPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendPolicies = append(m.SendPolicies, SendPolicy{})
+			if err := m.SendPolicies[len(m.SendPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
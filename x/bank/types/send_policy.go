@@ -0,0 +1,95 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSendPolicy creates a new SendPolicy without transfer limits.
+func NewSendPolicy(denom string, mode AddressListMode, addresses, receiverAllowlist []string) SendPolicy {
+	return SendPolicy{
+		Denom:             denom,
+		AddressListMode:   mode,
+		Addresses:         addresses,
+		ReceiverAllowlist: receiverAllowlist,
+		MaxTransferAmount: math.ZeroInt(),
+		BlockTransferCap:  math.ZeroInt(),
+	}
+}
+
+// Validate gets any errors with this SendPolicy. The addresses are only checked
+// to be non-empty and unique, the keeper validates them with the address codec.
+func (p SendPolicy) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	if _, ok := AddressListMode_name[int32(p.AddressListMode)]; !ok {
+		return fmt.Errorf("invalid address list mode %d for denom %s", p.AddressListMode, p.Denom)
+	}
+
+	if p.AddressListMode == ADDRESS_LIST_MODE_UNSPECIFIED && len(p.Addresses) > 0 {
+		return fmt.Errorf("addresses set without an address list mode for denom %s", p.Denom)
+	}
+
+	if err := validateAddressList(p.Addresses); err != nil {
+		return fmt.Errorf("invalid addresses for denom %s: %w", p.Denom, err)
+	}
+
+	if err := validateAddressList(p.ReceiverAllowlist); err != nil {
+		return fmt.Errorf("invalid receiver allowlist for denom %s: %w", p.Denom, err)
+	}
+
+	if p.MaxTransferAmount.IsNil() || p.MaxTransferAmount.IsNegative() {
+		return fmt.Errorf("max transfer amount must be non-negative for denom %s: %s", p.Denom, p.MaxTransferAmount)
+	}
+
+	if p.BlockTransferCap.IsNil() || p.BlockTransferCap.IsNegative() {
+		return fmt.Errorf("block transfer cap must be non-negative for denom %s: %s", p.Denom, p.BlockTransferCap)
+	}
+
+	return nil
+}
+
+// IsSenderAllowed reports whether the address list of the policy allows addr
+// to send the denom.
+func (p SendPolicy) IsSenderAllowed(addr string) bool {
+	switch p.AddressListMode {
+	case ADDRESS_LIST_MODE_ALLOWLIST:
+		return slices.Contains(p.Addresses, addr)
+	case ADDRESS_LIST_MODE_DENYLIST:
+		return !slices.Contains(p.Addresses, addr)
+	default:
+		return true
+	}
+}
+
+// IsReceiverAllowed reports whether the policy allows addr to receive the denom.
+func (p SendPolicy) IsReceiverAllowed(addr string) bool {
+	if p.AddressListMode == ADDRESS_LIST_MODE_DENYLIST && slices.Contains(p.Addresses, addr) {
+		return false
+	}
+
+	return len(p.ReceiverAllowlist) == 0 || slices.Contains(p.ReceiverAllowlist, addr)
+}
+
+func validateAddressList(addrs []string) error {
+	seen := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		if strings.TrimSpace(addr) == "" {
+			return errors.New("empty address")
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSendPolicyValidate(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	testCases := []struct {
+		name   string
+		policy func() types.SendPolicy
+		expErr string
+	}{
+		{"valid allowlist", func() types.SendPolicy {
+			return types.NewSendPolicy("foo", types.ADDRESS_LIST_MODE_ALLOWLIST, []string{addr1}, []string{addr2})
+		}, ""},
+		{"valid limits only", func() types.SendPolicy {
+			p := types.NewSendPolicy("foo", types.ADDRESS_LIST_MODE_UNSPECIFIED, nil, nil)
+			p.MaxTransferAmount = math.NewInt(10)
+			p.BlockTransferCap = math.NewInt(100)
+			return p
+		}, ""},
+		{"invalid denom", func() types.SendPolicy {
+			return types.NewSendPolicy("1", types.ADDRESS_LIST_MODE_UNSPECIFIED, nil, nil)
+		}, "invalid denom"},
+		{"invalid mode", func() types.SendPolicy {
+			return types.NewSendPolicy("foo", types.AddressListMode(3), nil, nil)
+		}, "invalid address list mode"},
+		{"addresses without mode", func() types.SendPolicy {
+			return types.NewSendPolicy("foo", types.ADDRESS_LIST_MODE_UNSPECIFIED, []string{addr1}, nil)
+		}, "without an address list mode"},
+		{"empty address", func() types.SendPolicy {
+			return types.NewSendPolicy("foo", types.ADDRESS_LIST_MODE_DENYLIST, []string{""}, nil)
+		}, "invalid addresses"},
+		{"duplicate receiver", func() types.SendPolicy {
+			return types.NewSendPolicy("foo", types.ADDRESS_LIST_MODE_UNSPECIFIED, nil, []string{addr2, addr2})
+		}, "duplicate address"},
+		{"negative max transfer amount", func() types.SendPolicy {
+			p := types.NewSendPolicy("foo", types.ADDRESS_LIST_MODE_UNSPECIFIED, nil, nil)
+			p.MaxTransferAmount = math.NewInt(-1)
+			return p
+		}, "max transfer amount"},
+		{"nil block transfer cap", func() types.SendPolicy {
+			p := types.NewSendPolicy("foo", types.ADDRESS_LIST_MODE_UNSPECIFIED, nil, nil)
+			p.BlockTransferCap = math.Int{}
+			return p
+		}, "block transfer cap"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy().Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSendPolicyAddressLists(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	allow := types.NewSendPolicy("foo", types.ADDRESS_LIST_MODE_ALLOWLIST, []string{addr1}, nil)
+	require.True(t, allow.IsSenderAllowed(addr1))
+	require.False(t, allow.IsSenderAllowed(addr2))
+	require.True(t, allow.IsReceiverAllowed(addr2))
+
+	deny := types.NewSendPolicy("foo", types.ADDRESS_LIST_MODE_DENYLIST, []string{addr1}, []string{addr1, addr2})
+	require.False(t, deny.IsSenderAllowed(addr1))
+	require.True(t, deny.IsSenderAllowed(addr2))
+	require.False(t, deny.IsReceiverAllowed(addr1))
+	require.True(t, deny.IsReceiverAllowed(addr2))
+
+	receivers := types.NewSendPolicy("foo", types.ADDRESS_LIST_MODE_UNSPECIFIED, nil, []string{addr1})
+	require.True(t, receivers.IsSenderAllowed(addr2))
+	require.False(t, receivers.IsReceiverAllowed(addr2))
+}
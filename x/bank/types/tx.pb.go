@@ -405,6 +405,111 @@ func (m *MsgSetSendEnabledResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetSendEnabledResponse proto.InternalMessageInfo
 
+// MsgSetSendPolicies is the Msg/SetSendPolicies request type.
+//
+// Existing send policies of denoms that are not included in this message are
+// left unchanged.
+type MsgSetSendPolicies struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// send_policies is the list of policies to add or replace.
+	SendPolicies []SendPolicy `protobuf:"bytes,2,rep,name=send_policies,json=sendPolicies,proto3" json:"send_policies"`
+	// remove_denoms is the list of denoms whose send policy is removed. Denoms
+	// without a send policy are ignored.
+	RemoveDenoms []string `protobuf:"bytes,3,rep,name=remove_denoms,json=removeDenoms,proto3" json:"remove_denoms,omitempty"`
+}
+
+func (m *MsgSetSendPolicies) Reset()         { *m = MsgSetSendPolicies{} }
+func (m *MsgSetSendPolicies) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSendPolicies) ProtoMessage()    {}
+func (*MsgSetSendPolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{8}
+}
+func (m *MsgSetSendPolicies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSendPolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSendPolicies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSendPolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSendPolicies.Merge(m, src)
+}
+func (m *MsgSetSendPolicies) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSendPolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSendPolicies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSendPolicies proto.InternalMessageInfo
+
+func (m *MsgSetSendPolicies) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetSendPolicies) GetSendPolicies() []SendPolicy {
+	if m != nil {
+		return m.SendPolicies
+	}
+	return nil
+}
+
+func (m *MsgSetSendPolicies) GetRemoveDenoms() []string {
+	if m != nil {
+		return m.RemoveDenoms
+	}
+	return nil
+}
+
+// MsgSetSendPoliciesResponse defines the Msg/SetSendPolicies response type.
+type MsgSetSendPoliciesResponse struct {
+}
+
+func (m *MsgSetSendPoliciesResponse) Reset()         { *m = MsgSetSendPoliciesResponse{} }
+func (m *MsgSetSendPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSendPoliciesResponse) ProtoMessage()    {}
+func (*MsgSetSendPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{9}
+}
+func (m *MsgSetSendPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSendPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSendPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSendPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSendPoliciesResponse.Merge(m, src)
+}
+func (m *MsgSetSendPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSendPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSendPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSendPoliciesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
@@ -414,58 +519,66 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.bank.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgSetSendEnabled)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabled")
 	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
+	proto.RegisterType((*MsgSetSendPolicies)(nil), "cosmos.bank.v1beta1.MsgSetSendPolicies")
+	proto.RegisterType((*MsgSetSendPoliciesResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendPoliciesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 730 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0x13, 0x5f,
-	0x14, 0xed, 0xd0, 0xdf, 0xaf, 0xa4, 0x8f, 0x2a, 0x61, 0x20, 0x02, 0x23, 0x99, 0x96, 0x89, 0x21,
-	0x80, 0x32, 0x03, 0x68, 0x24, 0xa9, 0xd1, 0x68, 0x51, 0x8c, 0x26, 0x8d, 0xa6, 0xc4, 0x85, 0x6e,
-	0x9a, 0xd7, 0xce, 0x63, 0x98, 0xd0, 0x99, 0xd7, 0xcc, 0x7b, 0x83, 0x74, 0x61, 0x62, 0x5c, 0x19,
-	0x57, 0xae, 0x5d, 0xb1, 0x34, 0xea, 0x82, 0x05, 0x4b, 0x3f, 0x00, 0x71, 0x45, 0x58, 0xb9, 0x52,
-	0x03, 0x0b, 0xfc, 0x10, 0x2e, 0xcc, 0xfb, 0xd3, 0xe9, 0x50, 0xda, 0x42, 0xdc, 0x30, 0xc3, 0xbb,
-	0xe7, 0x9c, 0x3b, 0xe7, 0xcc, 0xbd, 0x53, 0x30, 0x51, 0xc5, 0xc4, 0xc3, 0xc4, 0xaa, 0x40, 0x7f,
-	0xc3, 0xda, 0x5c, 0xa8, 0x20, 0x0a, 0x17, 0x2c, 0xba, 0x65, 0xd6, 0x03, 0x4c, 0xb1, 0x3a, 0x2c,
-	0xaa, 0x26, 0xab, 0x9a, 0xb2, 0xaa, 0x8d, 0x38, 0xd8, 0xc1, 0xbc, 0x6e, 0xb1, 0x3b, 0x01, 0xd5,
-	0xf4, 0x48, 0x88, 0xa0, 0x48, 0xa8, 0x8a, 0x5d, 0xff, 0x54, 0x3d, 0xd6, 0x88, 0xeb, 0x8a, 0xfa,
-	0xb8, 0xa8, 0x97, 0x85, 0xb0, 0xec, 0x2b, 0x4a, 0xa3, 0x92, 0xea, 0x11, 0xc7, 0xda, 0x5c, 0x60,
-	0x17, 0x59, 0x18, 0x82, 0x9e, 0xeb, 0x63, 0x8b, 0xff, 0x15, 0x47, 0xc6, 0x97, 0x3e, 0xd0, 0x5f,
-	0x24, 0xce, 0x2a, 0xf2, 0x6d, 0xf5, 0x16, 0xc8, 0xac, 0x05, 0xd8, 0x2b, 0x43, 0xdb, 0x0e, 0x10,
-	0x21, 0x63, 0x4a, 0x4e, 0x99, 0x4e, 0x17, 0xc6, 0x0e, 0x76, 0xe7, 0x46, 0xa4, 0xfe, 0x3d, 0x51,
-	0x59, 0xa5, 0x81, 0xeb, 0x3b, 0xa5, 0x01, 0x86, 0x96, 0x47, 0xea, 0x12, 0x00, 0x14, 0x47, 0xd4,
-	0xbe, 0x33, 0xa8, 0x69, 0x8a, 0x9b, 0xc4, 0x06, 0x48, 0x41, 0x0f, 0x87, 0x3e, 0x1d, 0x4b, 0xe6,
-	0x92, 0xd3, 0x03, 0x8b, 0xe3, 0x66, 0x14, 0x22, 0x41, 0xcd, 0x10, 0xcd, 0x65, 0xec, 0xfa, 0x85,
-	0x95, 0xbd, 0x1f, 0xd9, 0xc4, 0xa7, 0x9f, 0xd9, 0x69, 0xc7, 0xa5, 0xeb, 0x61, 0xc5, 0xac, 0x62,
-	0x4f, 0x3a, 0x97, 0x97, 0x39, 0x62, 0x6f, 0x58, 0xb4, 0x51, 0x47, 0x84, 0x13, 0xc8, 0x87, 0xe3,
-	0x9d, 0xd9, 0x4c, 0x0d, 0x39, 0xb0, 0xda, 0x28, 0xb3, 0x6c, 0xc9, 0xc7, 0xe3, 0x9d, 0x59, 0xa5,
-	0x24, 0x1b, 0xe6, 0xe7, 0xdf, 0x6e, 0x67, 0x13, 0xbf, 0xb7, 0xb3, 0x89, 0x37, 0x0c, 0x17, 0xf7,
-	0xfe, 0xee, 0x78, 0x67, 0x56, 0x8d, 0x69, 0xca, 0x88, 0x8c, 0x21, 0x30, 0x28, 0x6f, 0x4b, 0x88,
-	0xd4, 0xb1, 0x4f, 0x90, 0xf1, 0x55, 0x01, 0x99, 0x22, 0x71, 0x8a, 0x61, 0x8d, 0xba, 0x3c, 0xc6,
-	0xdb, 0x20, 0xe5, 0xfa, 0xf5, 0x90, 0xb2, 0x00, 0x99, 0x21, 0xcd, 0xec, 0x30, 0x15, 0xe6, 0x23,
-	0x06, 0x29, 0xa4, 0x99, 0x23, 0xf9, 0x50, 0x82, 0xa4, 0xde, 0x05, 0xfd, 0x38, 0xa4, 0x9c, 0xdf,
-	0xc7, 0xf9, 0x97, 0x3b, 0xf2, 0x9f, 0x70, 0x4c, 0x5c, 0xa0, 0x49, 0xcb, 0x5f, 0x6d, 0x5a, 0x92,
-	0x92, 0xcc, 0xcc, 0xe8, 0x49, 0x33, 0xd1, 0xd3, 0x1a, 0x97, 0xc0, 0x48, 0xfc, 0xff, 0xc8, 0xd6,
-	0x81, 0xc2, 0xad, 0x3e, 0xab, 0xdb, 0x90, 0xa2, 0xa7, 0x30, 0x80, 0x1e, 0x51, 0x6f, 0x82, 0x34,
-	0x0c, 0xe9, 0x3a, 0x0e, 0x5c, 0xda, 0x38, 0x73, 0x3a, 0x5a, 0x50, 0xf5, 0x0e, 0x48, 0xd5, 0xb9,
-	0x02, 0x9f, 0x8b, 0x6e, 0x8e, 0x44, 0x93, 0x13, 0x91, 0x08, 0x56, 0xfe, 0xe1, 0xc1, 0xee, 0xdc,
-	0x60, 0xcb, 0x40, 0x6e, 0xde, 0xbc, 0xb1, 0xc4, 0xfc, 0xb5, 0x5a, 0x30, 0x8b, 0x93, 0x31, 0x8b,
-	0x5b, 0x62, 0x6f, 0xda, 0x0c, 0x18, 0x26, 0x18, 0x6d, 0x3b, 0x6a, 0xfa, 0xcd, 0x0f, 0x77, 0xe8,
-	0x61, 0xfc, 0x51, 0xc0, 0x10, 0x7f, 0xdf, 0x94, 0x65, 0xf3, 0xc0, 0x87, 0x95, 0x1a, 0xb2, 0xff,
-	0x39, 0x86, 0x65, 0x90, 0x21, 0xc8, 0xb7, 0xcb, 0x48, 0xe8, 0xc8, 0xd7, 0x9b, 0xeb, 0x18, 0x46,
-	0xac, 0x5f, 0x69, 0x80, 0xc4, 0x9a, 0x4f, 0x81, 0xc1, 0x90, 0xa0, 0xb2, 0x8d, 0xd6, 0x60, 0x58,
-	0xa3, 0xe5, 0x35, 0x1c, 0xf0, 0xbd, 0x49, 0x97, 0x2e, 0x84, 0x04, 0xdd, 0x17, 0xa7, 0x2b, 0x38,
-	0xc8, 0x17, 0xce, 0x95, 0xd9, 0x44, 0xfb, 0x8c, 0xc7, 0x8d, 0x1a, 0xf3, 0x60, 0xfc, 0xd4, 0x61,
-	0xcf, 0xc0, 0x16, 0x3f, 0x27, 0x41, 0xb2, 0x48, 0x1c, 0xf5, 0x31, 0xf8, 0x8f, 0xef, 0xc2, 0x44,
-	0x47, 0x73, 0x72, 0x85, 0xb4, 0x2b, 0xbd, 0xaa, 0xcd, 0x46, 0xea, 0x73, 0x90, 0x6e, 0x2d, 0xd7,
-	0x64, 0x37, 0x4a, 0x04, 0xd1, 0x66, 0xce, 0x84, 0x44, 0xd2, 0x2f, 0x41, 0xe6, 0xc4, 0x80, 0x77,
-	0x7d, 0xa0, 0x38, 0x4a, 0xbb, 0x76, 0x1e, 0x54, 0xb4, 0x48, 0xc3, 0xdf, 0x4e, 0xe7, 0xa4, 0xbe,
-	0x02, 0x17, 0xdb, 0x86, 0x6a, 0xaa, 0x7b, 0x16, 0x71, 0x9c, 0x66, 0x9e, 0x0f, 0xd7, 0xb3, 0xbd,
-	0xf6, 0xff, 0x6b, 0xb6, 0x5f, 0x85, 0xe5, 0xbd, 0x43, 0x5d, 0xd9, 0x3f, 0xd4, 0x95, 0x5f, 0x87,
-	0xba, 0xf2, 0xfe, 0x48, 0x4f, 0xec, 0x1f, 0xe9, 0x89, 0xef, 0x47, 0x7a, 0xe2, 0xc5, 0x4c, 0xcf,
-	0x2f, 0xac, 0xdc, 0x2e, 0xfe, 0xa1, 0xad, 0xa4, 0xf8, 0x0f, 0xc9, 0xf5, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x2f, 0xd9, 0x25, 0x8a, 0x1a, 0x07, 0x00, 0x00,
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x4f, 0xe3, 0x46,
+	0x14, 0x8e, 0x03, 0x0d, 0xca, 0x10, 0x1a, 0x61, 0x50, 0x01, 0x97, 0x26, 0xc1, 0xad, 0x28, 0xd0,
+	0x62, 0x13, 0x1a, 0x8a, 0x94, 0xaa, 0x55, 0x9b, 0x50, 0xaa, 0x56, 0x8a, 0x40, 0x41, 0x3d, 0xb4,
+	0x97, 0xc8, 0x89, 0x07, 0x63, 0x11, 0x7b, 0x22, 0xcf, 0x98, 0x92, 0x43, 0x25, 0xb4, 0x97, 0x5d,
+	0xed, 0x69, 0xcf, 0x7b, 0xe2, 0xb8, 0x5a, 0xad, 0x56, 0x1c, 0x38, 0xee, 0x1f, 0x80, 0xf6, 0x84,
+	0x38, 0xed, 0x69, 0x77, 0x05, 0x07, 0xf6, 0x8f, 0xd8, 0xc3, 0x6a, 0xc6, 0x63, 0xc7, 0xe4, 0xb7,
+	0xb8, 0x60, 0xf3, 0xde, 0xfb, 0xbe, 0x37, 0xdf, 0xe7, 0xe7, 0xe7, 0x80, 0xf9, 0x1a, 0xc2, 0x16,
+	0xc2, 0x6a, 0x55, 0xb3, 0x0f, 0xd5, 0xa3, 0x6c, 0x15, 0x12, 0x2d, 0xab, 0x92, 0x63, 0xa5, 0xe1,
+	0x20, 0x82, 0xc4, 0x29, 0x2f, 0xab, 0xd0, 0xac, 0xc2, 0xb3, 0xd2, 0xb4, 0x81, 0x0c, 0xc4, 0xf2,
+	0x2a, 0xbd, 0xf3, 0x4a, 0xa5, 0x54, 0x40, 0x84, 0x61, 0x40, 0x54, 0x43, 0xa6, 0xdd, 0x91, 0x0f,
+	0x35, 0x62, 0xbc, 0x5e, 0x7e, 0xce, 0xcb, 0x57, 0x3c, 0x62, 0xde, 0xd7, 0x4b, 0xcd, 0x70, 0xa8,
+	0x85, 0x0d, 0xf5, 0x28, 0x4b, 0x2f, 0x3c, 0x31, 0xa9, 0x59, 0xa6, 0x8d, 0x54, 0xf6, 0xd7, 0x0b,
+	0xc9, 0x2f, 0xa2, 0x60, 0xac, 0x84, 0x8d, 0x3d, 0x68, 0xeb, 0xe2, 0x4f, 0x20, 0xb1, 0xef, 0x20,
+	0xab, 0xa2, 0xe9, 0xba, 0x03, 0x31, 0x9e, 0x15, 0x32, 0xc2, 0x52, 0xbc, 0x30, 0x7b, 0x75, 0xbe,
+	0x3a, 0xcd, 0xf9, 0x7f, 0xf3, 0x32, 0x7b, 0xc4, 0x31, 0x6d, 0xa3, 0x3c, 0x4e, 0xab, 0x79, 0x48,
+	0xdc, 0x04, 0x80, 0xa0, 0x00, 0x1a, 0x1d, 0x00, 0x8d, 0x13, 0xe4, 0x03, 0x9b, 0x20, 0xa6, 0x59,
+	0xc8, 0xb5, 0xc9, 0xec, 0x48, 0x66, 0x64, 0x69, 0x7c, 0x7d, 0x4e, 0x09, 0x4c, 0xc4, 0xd0, 0x37,
+	0x51, 0x29, 0x22, 0xd3, 0x2e, 0x6c, 0x5f, 0xbc, 0x4d, 0x47, 0x9e, 0xbf, 0x4b, 0x2f, 0x19, 0x26,
+	0x39, 0x70, 0xab, 0x4a, 0x0d, 0x59, 0x5c, 0x39, 0xbf, 0xac, 0x62, 0xfd, 0x50, 0x25, 0xcd, 0x06,
+	0xc4, 0x0c, 0x80, 0x9f, 0xde, 0x9e, 0xad, 0x24, 0xea, 0xd0, 0xd0, 0x6a, 0xcd, 0x0a, 0xf5, 0x16,
+	0x3f, 0xbb, 0x3d, 0x5b, 0x11, 0xca, 0xbc, 0x61, 0x7e, 0xed, 0xd1, 0x69, 0x3a, 0xf2, 0xe1, 0x34,
+	0x1d, 0x79, 0x40, 0xeb, 0xc2, 0xda, 0x1f, 0xdf, 0x9e, 0xad, 0x88, 0x21, 0x4e, 0x6e, 0x91, 0x3c,
+	0x09, 0x92, 0xfc, 0xb6, 0x0c, 0x71, 0x03, 0xd9, 0x18, 0xca, 0xaf, 0x04, 0x90, 0x28, 0x61, 0xa3,
+	0xe4, 0xd6, 0x89, 0xc9, 0x6c, 0xfc, 0x19, 0xc4, 0x4c, 0xbb, 0xe1, 0x12, 0x6a, 0x20, 0x15, 0x24,
+	0x29, 0x5d, 0xa6, 0x42, 0xf9, 0x93, 0x96, 0x14, 0xe2, 0x54, 0x11, 0x3f, 0x94, 0x07, 0x12, 0x7f,
+	0x05, 0x63, 0xc8, 0x25, 0x0c, 0x1f, 0x65, 0xf8, 0x2f, 0xbb, 0xe2, 0x77, 0x5c, 0xd2, 0x46, 0xe0,
+	0xc3, 0xf2, 0xdf, 0xf9, 0x92, 0x38, 0x25, 0x15, 0x33, 0x73, 0x57, 0x4c, 0x70, 0x5a, 0xf9, 0x0b,
+	0x30, 0x1d, 0xfe, 0x3f, 0x90, 0x75, 0x25, 0x30, 0xa9, 0x7f, 0x37, 0x74, 0x8d, 0xc0, 0x5d, 0xcd,
+	0xd1, 0x2c, 0x2c, 0xfe, 0x08, 0xe2, 0x9a, 0x4b, 0x0e, 0x90, 0x63, 0x92, 0xe6, 0xc0, 0xe9, 0x68,
+	0x95, 0x8a, 0xbf, 0x80, 0x58, 0x83, 0x31, 0xb0, 0xb9, 0xe8, 0xa5, 0xc8, 0x6b, 0x72, 0xc7, 0x12,
+	0x0f, 0x95, 0xff, 0xe3, 0xea, 0x7c, 0x35, 0xd9, 0x12, 0x90, 0x59, 0x53, 0x72, 0x9b, 0x54, 0x5f,
+	0xab, 0x05, 0x95, 0xb8, 0x10, 0x92, 0x78, 0xec, 0xbd, 0x37, 0x6d, 0x02, 0x64, 0x05, 0xcc, 0xb4,
+	0x85, 0x7c, 0xbd, 0xf9, 0xa9, 0x2e, 0x3d, 0xe4, 0x8f, 0x02, 0x98, 0x64, 0xcf, 0x9b, 0x50, 0x6f,
+	0x7e, 0xb7, 0xb5, 0x6a, 0x1d, 0xea, 0xf7, 0xb6, 0xa1, 0x08, 0x12, 0x18, 0xda, 0x7a, 0x05, 0x7a,
+	0x3c, 0xfc, 0xf1, 0x66, 0xba, 0x9a, 0x11, 0xea, 0x57, 0x1e, 0xc7, 0xa1, 0xe6, 0x8b, 0x20, 0xe9,
+	0x62, 0x58, 0xd1, 0xe1, 0xbe, 0xe6, 0xd6, 0x49, 0x65, 0x1f, 0x39, 0xec, 0xbd, 0x89, 0x97, 0x27,
+	0x5c, 0x0c, 0xb7, 0xbc, 0xe8, 0x36, 0x72, 0xf2, 0x85, 0xa1, 0x3c, 0x9b, 0x6f, 0x9f, 0xf1, 0xb0,
+	0x50, 0x79, 0x0d, 0xcc, 0x75, 0x04, 0xfb, 0x1b, 0xf6, 0x30, 0x0a, 0xc4, 0x16, 0x64, 0x17, 0xd5,
+	0xcd, 0x9a, 0x09, 0xef, 0x3f, 0x38, 0x3b, 0x60, 0x82, 0x39, 0xd6, 0xe0, 0x44, 0xdc, 0xb2, 0x74,
+	0x4f, 0xcb, 0x58, 0xc7, 0x66, 0x78, 0x86, 0x12, 0xd8, 0x0f, 0xd3, 0x83, 0x7c, 0x0d, 0x26, 0x1c,
+	0x68, 0xa1, 0x23, 0x6a, 0xa0, 0x8d, 0x2c, 0xcc, 0xbd, 0x4b, 0x78, 0xc1, 0x2d, 0x16, 0xcb, 0x17,
+	0x3b, 0x94, 0x6d, 0xe4, 0x3a, 0xad, 0xfb, 0xaa, 0xab, 0x75, 0x7e, 0x27, 0x39, 0x0b, 0xa4, 0xce,
+	0x68, 0x1f, 0xf3, 0x36, 0x72, 0xeb, 0x2f, 0x47, 0xc1, 0x48, 0x09, 0x1b, 0xe2, 0x5f, 0x60, 0x94,
+	0x2d, 0x92, 0xf9, 0xae, 0x32, 0xf9, 0xfe, 0x91, 0xbe, 0xe9, 0x97, 0xf5, 0x1b, 0x89, 0xff, 0x80,
+	0x78, 0x6b, 0x33, 0x2d, 0xf4, 0x82, 0x04, 0x25, 0xd2, 0xf2, 0xc0, 0x92, 0x80, 0xfa, 0x3f, 0x90,
+	0xb8, 0xb3, 0x1d, 0x7a, 0x1e, 0x28, 0x5c, 0x25, 0x7d, 0x3f, 0x4c, 0x55, 0xb0, 0x85, 0xa6, 0x5e,
+	0x77, 0x0e, 0x99, 0xf8, 0x3f, 0xf8, 0xbc, 0xed, 0x8d, 0x5c, 0xec, 0xed, 0x45, 0xb8, 0x4e, 0x52,
+	0x86, 0xab, 0xeb, 0xdf, 0xfe, 0x44, 0x00, 0xc9, 0xf6, 0x01, 0xff, 0x76, 0x00, 0xb1, 0x5f, 0x28,
+	0xa9, 0x43, 0x16, 0xf6, 0x39, 0xc2, 0x46, 0x4e, 0xfa, 0xec, 0x84, 0xce, 0x76, 0xa1, 0x78, 0x71,
+	0x9d, 0x12, 0x2e, 0xaf, 0x53, 0xc2, 0xfb, 0xeb, 0x94, 0xf0, 0xe4, 0x26, 0x15, 0xb9, 0xbc, 0x49,
+	0x45, 0xde, 0xdc, 0xa4, 0x22, 0xff, 0x2e, 0xf7, 0xfd, 0x42, 0xf2, 0xed, 0xc8, 0x3e, 0x94, 0xd5,
+	0x18, 0xfb, 0x21, 0xf0, 0xc3, 0xa7, 0x01, 0x00, 0x17, 0x11, 0x98, 0xab, 0xda, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -492,6 +605,10 @@ type MsgClient interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// SetSendPolicies is a governance operation for setting the send policies of
+	// any number of denoms. Only the policies to add or replace, and the denoms
+	// whose policy should be removed, need to be included.
+	SetSendPolicies(ctx context.Context, in *MsgSetSendPolicies, opts ...grpc.CallOption) (*MsgSetSendPoliciesResponse, error)
 }
 
 type msgClient struct {
@@ -538,6 +655,15 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) SetSendPolicies(ctx context.Context, in *MsgSetSendPolicies, opts ...grpc.CallOption) (*MsgSetSendPoliciesResponse, error) {
+	out := new(MsgSetSendPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetSendPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Send defines a method for sending coins from one account to another account.
@@ -552,6 +678,10 @@ type MsgServer interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SetSendPolicies is a governance operation for setting the send policies of
+	// any number of denoms. Only the policies to add or replace, and the denoms
+	// whose policy should be removed, need to be included.
+	SetSendPolicies(context.Context, *MsgSetSendPolicies) (*MsgSetSendPoliciesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -570,6 +700,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (*UnimplementedMsgServer) SetSendPolicies(ctx context.Context, req *MsgSetSendPolicies) (*MsgSetSendPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSendPolicies not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -647,6 +780,24 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetSendPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSendPolicies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSendPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetSendPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSendPolicies(ctx, req.(*MsgSetSendPolicies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Msg",
@@ -668,6 +819,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "SetSendPolicies",
+			Handler:    _Msg_SetSendPolicies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -960,6 +1115,82 @@ func (m *MsgSetSendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetSendPolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSendPolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSendPolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveDenoms) > 0 {
+		for iNdEx := len(m.RemoveDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveDenoms[iNdEx])
+			copy(dAtA[i:], m.RemoveDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SendPolicies) > 0 {
+		for iNdEx := len(m.SendPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSendPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSendPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSendPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1091,6 +1322,40 @@ func (m *MsgSetSendEnabledResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetSendPolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SendPolicies) > 0 {
+		for _, e := range m.SendPolicies {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveDenoms) > 0 {
+		for _, s := range m.RemoveDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetSendPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1826,6 +2091,204 @@ func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetSendPolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSendPolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSendPolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendPolicies = append(m.SendPolicies, SendPolicy{})
+			if err := m.SendPolicies[len(m.SendPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveDenoms = append(m.RemoveDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSendPoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSendPoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSendPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -144,6 +144,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "WithdrawTokenizeShareRecordReward",
+					Use:       "withdraw-tokenize-share-rewards",
+					Short:     "Withdraw the rewards of all tokenize share records owned by the sender",
+					Example:   fmt.Sprintf("$ %s tx distribution withdraw-tokenize-share-rewards --from mykey", version.AppName),
+				},
 				{
 					RpcMethod: "DepositValidatorRewardsPool",
 					Use:       "fund-validator-rewards-pool [validator-addr] [amount]",
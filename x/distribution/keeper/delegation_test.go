@@ -507,6 +507,74 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	// create validator with no commission, fully delegated to by a tokenize share record
+	valAddr := sdk.ValAddress(valConsAddr0)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+
+	owner := sdk.AccAddress("owner_______________")
+	record := stakingtypes.TokenizeShareRecord{
+		Id:            1,
+		Owner:         owner.String(),
+		ModuleAccount: stakingtypes.GetTokenizeShareModuleAccountName(1),
+		Validator:     valAddr.String(),
+	}
+	recordAddr := record.GetModuleAddress()
+
+	del := stakingtypes.NewDelegation(recordAddr.String(), valAddr.String(), val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), recordAddr, valAddr).Return(del, nil).AnyTimes()
+	stakingKeeper.EXPECT().GetTokenizeShareRecordsByOwner(gomock.Any(), owner).Return([]stakingtypes.TokenizeShareRecord{record}, nil)
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, recordAddr, valAddr))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}))
+
+	// rewards are paid to the record account and then sent to the owner,
+	// without the balance of the record account
+	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial)}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, recordAddr, expRewards)
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Times(0)
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), recordAddr, owner, expRewards)
+
+	res, err := keeper.NewMsgServerImpl(distrKeeper).WithdrawTokenizeShareRecordReward(ctx, disttypes.NewMsgWithdrawTokenizeShareRecordReward(owner.String()))
+	require.NoError(t, err)
+	require.Equal(t, expRewards, res.Amount)
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -13,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Keeper of the distribution store
@@ -230,6 +232,57 @@ func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.Val
 	return commission, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the delegation rewards accrued by
+// every tokenize share record owned by the given account and sends them to the
+// owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	records, err := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	totalRewards := sdk.NewCoins()
+	for _, record := range records {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		// the record account holds the delegation, so rewards are first paid
+		// out to it and then sent to the owner. Only the withdrawn rewards are
+		// sent, not other coins held by the record account.
+		recordAddr := record.GetModuleAddress()
+		rewards, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr)
+		if err != nil {
+			if errors.Is(err, stakingtypes.ErrNoDelegation) {
+				continue
+			}
+			return nil, err
+		}
+
+		if rewards.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalRewards.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, ownerAddr.String()),
+		),
+	)
+
+	return totalRewards, nil
+}
+
 // GetTotalRewards returns the total amount of fee distribution rewards held in the store
 func (k Keeper) GetTotalRewards(ctx context.Context) (totalRewards sdk.DecCoins) {
 	k.IterateValidatorOutstandingRewards(ctx,
@@ -2,12 +2,13 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress           = "set_withdraw_address"
+	EventTypeRewards                      = "rewards"
+	EventTypeCommission                   = "commission"
+	EventTypeWithdrawRewards              = "withdraw_rewards"
+	EventTypeWithdrawCommission           = "withdraw_commission"
+	EventTypeWithdrawTokenizeShareRewards = "withdraw_tokenize_share_rewards"
+	EventTypeProposerReward               = "proposer_reward"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
@@ -639,6 +639,95 @@ func (m *MsgDepositValidatorRewardsPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositValidatorRewardsPoolResponse proto.InternalMessageInfo
 
+// MsgWithdrawTokenizeShareRecordReward withdraws the rewards earned by all the
+// tokenize share records owned by an account.
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+// MsgWithdrawTokenizeShareRecordRewardResponse defines the
+// Msg/WithdrawTokenizeShareRecordReward response type.
+type MsgWithdrawTokenizeShareRecordRewardResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Reset() {
+	*m = MsgWithdrawTokenizeShareRecordRewardResponse{}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) ProtoMessage() {}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -654,6 +743,8 @@ func init() {
 	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpendResponse")
 	proto.RegisterType((*MsgDepositValidatorRewardsPool)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPool")
 	proto.RegisterType((*MsgDepositValidatorRewardsPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPoolResponse")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordReward")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordRewardResponse")
 }
 
 func init() {
@@ -661,69 +752,75 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 991 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0xb4, 0xa2, 0xa2, 0xb3, 0x2b, 0x75, 0xeb, 0x2d, 0x6a, 0xd7, 0xdd, 0x75, 0x8a, 0x17,
-	0x4a, 0x55, 0x51, 0xbb, 0x29, 0xed, 0x56, 0x04, 0x21, 0x44, 0xbb, 0x44, 0x42, 0xc2, 0xb0, 0x4a,
-	0x04, 0x08, 0x2e, 0x95, 0x13, 0x1b, 0x77, 0x44, 0xed, 0xb1, 0x3c, 0x93, 0x64, 0x73, 0x03, 0x04,
-	0x02, 0x21, 0x0e, 0x48, 0x9c, 0xe0, 0xc2, 0x1e, 0x57, 0x88, 0x43, 0x0e, 0x11, 0xf0, 0x13, 0x56,
-	0x9c, 0x56, 0x3d, 0x21, 0x0e, 0xb0, 0x4a, 0x0f, 0x41, 0xe2, 0x0f, 0x70, 0x42, 0xc8, 0xf6, 0xd8,
-	0xb1, 0x63, 0xc7, 0x4e, 0xca, 0x6a, 0xf7, 0xd2, 0x56, 0x33, 0xef, 0x7d, 0xf3, 0xbd, 0x6f, 0xde,
-	0x7c, 0xcf, 0x85, 0xcf, 0x34, 0x30, 0x31, 0x31, 0x91, 0x35, 0x44, 0xa8, 0x83, 0xea, 0x4d, 0x8a,
-	0xb0, 0x25, 0xb7, 0x4a, 0x75, 0x9d, 0xaa, 0x25, 0x99, 0xde, 0x96, 0x6c, 0x07, 0x53, 0xcc, 0xad,
-	0xfa, 0x51, 0x52, 0x34, 0x4a, 0x62, 0x51, 0xfc, 0x92, 0x81, 0x0d, 0xec, 0xc5, 0xc9, 0xee, 0x5f,
-	0x7e, 0x0a, 0x2f, 0x30, 0xe0, 0xba, 0x4a, 0xf4, 0x10, 0xb0, 0x81, 0x91, 0xc5, 0xf6, 0xaf, 0xf8,
-	0xfb, 0x47, 0x7e, 0x22, 0xc3, 0xf7, 0xb7, 0x96, 0x59, 0xaa, 0x49, 0x0c, 0xb9, 0x55, 0x72, 0x7f,
-	0xb1, 0x8d, 0x45, 0xd5, 0x44, 0x16, 0x96, 0xbd, 0x9f, 0x6c, 0x49, 0xca, 0xe2, 0x1f, 0xa3, 0xeb,
-	0xc5, 0x8b, 0x7f, 0x03, 0xf8, 0x94, 0x42, 0x8c, 0x9a, 0x4e, 0xdf, 0x45, 0xf4, 0x58, 0x73, 0xd4,
-	0xf6, 0xab, 0x9a, 0xe6, 0xe8, 0x84, 0x70, 0xaf, 0xc1, 0x45, 0x4d, 0x3f, 0xd1, 0x0d, 0x95, 0x62,
-	0xe7, 0x48, 0xf5, 0x17, 0x57, 0xc0, 0x1a, 0xd8, 0x98, 0x3f, 0x58, 0x39, 0xed, 0x6d, 0x2d, 0x31,
-	0x8a, 0x2c, 0xbc, 0x46, 0x1d, 0x64, 0x19, 0xd5, 0x4b, 0x61, 0x4a, 0x00, 0x73, 0x08, 0x2f, 0xb5,
-	0x19, 0x72, 0x88, 0x32, 0x93, 0x83, 0xb2, 0xd0, 0x8e, 0x73, 0x29, 0x57, 0xbe, 0xb8, 0x53, 0x2c,
-	0xfc, 0x75, 0xa7, 0x58, 0xf8, 0x64, 0xd0, 0xdd, 0x4c, 0xd2, 0xfa, 0x72, 0xd0, 0xdd, 0xbc, 0xee,
-	0x23, 0x6d, 0x11, 0xed, 0x43, 0x59, 0x21, 0x86, 0x82, 0x35, 0xf4, 0x41, 0x67, 0xa4, 0x26, 0xb1,
-	0x08, 0xaf, 0xa5, 0x16, 0x5b, 0xd5, 0x89, 0x8d, 0x2d, 0xa2, 0x8b, 0xff, 0x02, 0xc8, 0x2b, 0xc4,
-	0x08, 0xb6, 0x6f, 0x06, 0x27, 0x55, 0xf5, 0xb6, 0xea, 0x68, 0x0f, 0x4b, 0x93, 0x37, 0xe1, 0x62,
-	0x4b, 0x3d, 0x41, 0x5a, 0x0c, 0xc6, 0x17, 0xe5, 0xe9, 0xd3, 0xde, 0xd6, 0x35, 0x06, 0xf3, 0x4e,
-	0x10, 0x33, 0x82, 0xd7, 0x1a, 0x59, 0x2f, 0xbf, 0x9e, 0x2f, 0xcf, 0x7a, 0x5c, 0x9e, 0x91, 0x02,
-	0x11, 0xb6, 0xfc, 0x0a, 0xc5, 0x1e, 0x80, 0xe2, 0x78, 0x01, 0x02, 0x9d, 0xb8, 0x0e, 0x9c, 0x53,
-	0x4d, 0xdc, 0xb4, 0xe8, 0x0a, 0x58, 0x9b, 0xdd, 0xb8, 0xb0, 0x73, 0x85, 0xf5, 0x9d, 0xe4, 0xb6,
-	0x77, 0xf0, 0x12, 0xa4, 0x43, 0x8c, 0xac, 0x83, 0xca, 0xbd, 0x3f, 0x8a, 0x85, 0x1f, 0xfe, 0x2c,
-	0x6e, 0x18, 0x88, 0x1e, 0x37, 0xeb, 0x52, 0x03, 0x9b, 0xac, 0xbd, 0xe5, 0x08, 0x27, 0xda, 0xb1,
-	0x75, 0xe2, 0x25, 0x90, 0xef, 0x06, 0xdd, 0xcd, 0x8b, 0xee, 0xb1, 0x8d, 0xce, 0x91, 0xfb, 0x40,
-	0xc8, 0xdd, 0x41, 0x77, 0x13, 0x54, 0xd9, 0x81, 0xe5, 0xcb, 0xa7, 0xbd, 0xad, 0x85, 0x61, 0xe6,
-	0xda, 0xb6, 0xb4, 0x7b, 0x43, 0xfc, 0x05, 0x40, 0x21, 0x42, 0x3b, 0x54, 0xee, 0x10, 0x9b, 0x26,
-	0x22, 0x04, 0x61, 0x2b, 0x5d, 0x74, 0x70, 0x7e, 0xd1, 0xe3, 0x3d, 0x99, 0x80, 0x4e, 0xe9, 0xc9,
-	0x08, 0xbb, 0x21, 0x2f, 0xf1, 0x67, 0x00, 0xd7, 0xb3, 0xa9, 0x87, 0xaa, 0x7f, 0x05, 0x26, 0x97,
-	0xfd, 0xbd, 0x69, 0x65, 0xff, 0x3d, 0x29, 0x6b, 0xf6, 0x4d, 0x88, 0x9f, 0xcd, 0xc0, 0x25, 0x85,
-	0x18, 0x95, 0xa6, 0xa5, 0xb9, 0x64, 0x9b, 0x16, 0xa2, 0x9d, 0x5b, 0x18, 0x9f, 0x3c, 0xc6, 0xee,
-	0xe0, 0x6e, 0xc0, 0x79, 0x4d, 0xb7, 0x31, 0x41, 0x14, 0x3b, 0xb9, 0x3e, 0x33, 0x0c, 0x2d, 0x97,
-	0xa3, 0xb7, 0x39, 0x5c, 0x77, 0x6f, 0xb1, 0x18, 0xbf, 0xc5, 0x44, 0xb9, 0xa2, 0x00, 0xaf, 0xa6,
-	0xad, 0x87, 0xa6, 0xf2, 0x00, 0xc0, 0x05, 0x85, 0x18, 0x6f, 0xdb, 0x9a, 0x4a, 0xf5, 0x5b, 0xaa,
-	0xa3, 0x9a, 0xc4, 0xe5, 0xa9, 0x36, 0xe9, 0x31, 0x76, 0x10, 0xed, 0xe4, 0x3a, 0xc8, 0x30, 0x94,
-	0xab, 0xc0, 0x39, 0xdb, 0x43, 0xf0, 0x8a, 0xbb, 0xb0, 0x73, 0x5d, 0xca, 0x18, 0x45, 0x92, 0x7f,
-	0xd8, 0xc1, 0xbc, 0x2b, 0x32, 0xd3, 0xc9, 0xcf, 0x2e, 0x2b, 0xc9, 0x57, 0xb4, 0xef, 0x95, 0x1e,
-	0x1e, 0xe5, 0x96, 0xfe, 0x5c, 0xa4, 0xf4, 0xd8, 0x44, 0x19, 0x29, 0x47, 0x94, 0xe0, 0xf2, 0xc8,
-	0x52, 0x50, 0x7d, 0xda, 0x7b, 0xdd, 0x17, 0x7f, 0x9a, 0xf1, 0xc6, 0x4e, 0x4c, 0xaf, 0x9a, 0xad,
-	0x5b, 0xda, 0xb9, 0x85, 0xb9, 0x0a, 0xe7, 0x1d, 0xbd, 0x81, 0x6c, 0xa4, 0x5b, 0xd4, 0xbf, 0xf8,
-	0xea, 0x70, 0x21, 0xd2, 0x91, 0xb3, 0x8f, 0xda, 0xaf, 0xde, 0x98, 0x48, 0xe9, 0xf5, 0x51, 0xa5,
-	0xe5, 0x54, 0x79, 0xc4, 0x5d, 0x6f, 0x82, 0x25, 0x37, 0xb2, 0xe5, 0xfe, 0x67, 0xc6, 0xb3, 0xc7,
-	0x9b, 0x7e, 0x5b, 0x87, 0x16, 0xe3, 0x9b, 0x3a, 0xf1, 0xde, 0x6c, 0xec, 0xe1, 0x80, 0x89, 0x1f,
-	0xce, 0xc3, 0x9e, 0x65, 0x8f, 0xf3, 0xa6, 0x6a, 0x81, 0x07, 0x24, 0x24, 0xdc, 0xdb, 0x4e, 0xda,
-	0xc2, 0xb3, 0x69, 0x37, 0x36, 0x54, 0x98, 0x69, 0x2b, 0xbe, 0xec, 0xb9, 0x7b, 0x86, 0xf2, 0x19,
-	0x37, 0xb7, 0xb7, 0xbd, 0xf3, 0xe3, 0x93, 0x70, 0x56, 0x21, 0x06, 0xf7, 0x29, 0x80, 0x5c, 0xca,
-	0x47, 0xda, 0x4e, 0xe6, 0xf3, 0x4f, 0xfd, 0xd6, 0xe1, 0xcb, 0xd3, 0xe7, 0x84, 0x13, 0xe8, 0x1b,
-	0x00, 0x97, 0xc7, 0x7d, 0x1c, 0xed, 0xe7, 0xe1, 0x8e, 0x49, 0xe4, 0x5f, 0x39, 0x67, 0x62, 0xc8,
-	0xea, 0x7b, 0x00, 0x57, 0xb3, 0x46, 0xff, 0x4b, 0x93, 0x1e, 0x90, 0x92, 0xcc, 0x1f, 0xfe, 0x8f,
-	0xe4, 0x90, 0xe1, 0xc7, 0x00, 0x2e, 0x26, 0xe7, 0x64, 0x29, 0x0f, 0x3a, 0x91, 0xc2, 0xbf, 0x38,
-	0x75, 0x4a, 0xc8, 0xe1, 0x73, 0x00, 0x2f, 0xc6, 0x66, 0xd0, 0xf3, 0x79, 0x58, 0xd1, 0x68, 0x7e,
-	0x77, 0x9a, 0xe8, 0x70, 0xf6, 0x5d, 0xfe, 0x35, 0x69, 0x47, 0xdc, 0xb7, 0x00, 0x72, 0x29, 0xd6,
-	0x9f, 0xdb, 0xcc, 0xc9, 0x9c, 0xfc, 0x66, 0x1e, 0x6f, 0x95, 0xe9, 0xdc, 0x7a, 0x00, 0xae, 0x66,
-	0xf9, 0x64, 0x6e, 0x2f, 0x65, 0x24, 0xe7, 0xf7, 0xd2, 0x04, 0x3e, 0x91, 0x42, 0x7b, 0x6f, 0x9b,
-	0x7f, 0xe2, 0x23, 0xd7, 0xca, 0x0e, 0xde, 0xba, 0xdb, 0x17, 0xc0, 0xbd, 0xbe, 0x00, 0xee, 0xf7,
-	0x05, 0xf0, 0xa0, 0x2f, 0x80, 0xaf, 0xcf, 0x84, 0xc2, 0xfd, 0x33, 0xa1, 0xf0, 0xdb, 0x99, 0x50,
-	0x78, 0xbf, 0x94, 0x69, 0x94, 0xb7, 0xe3, 0x23, 0xde, 0xf3, 0xcd, 0xfa, 0x9c, 0xf7, 0x6f, 0xe2,
-	0x0b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x5d, 0xe8, 0xdd, 0x18, 0x0f, 0x00, 0x00,
+	// 1079 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x41, 0x6c, 0x1b, 0x45,
+	0x14, 0xf5, 0x24, 0x22, 0x92, 0xa7, 0x41, 0x69, 0xb6, 0x41, 0x49, 0x37, 0xcd, 0x3a, 0xdd, 0x86,
+	0x10, 0x45, 0xcd, 0x3a, 0x0e, 0x4e, 0x23, 0x8c, 0x2a, 0xd4, 0xb8, 0x44, 0xaa, 0x84, 0xa1, 0xb2,
+	0xa1, 0x08, 0x2e, 0xd1, 0xda, 0x3b, 0x6c, 0x46, 0x8d, 0x77, 0xac, 0x9d, 0x71, 0x5c, 0x73, 0x02,
+	0x04, 0x02, 0x21, 0x0e, 0x48, 0x9c, 0xe0, 0x42, 0x8f, 0x15, 0xa7, 0x1c, 0x2c, 0xe0, 0x02, 0x47,
+	0x54, 0x71, 0xaa, 0x72, 0x42, 0x1c, 0xa0, 0x4a, 0x0e, 0x41, 0xe2, 0x8c, 0xc4, 0x09, 0xa1, 0xdd,
+	0x9d, 0x5d, 0xef, 0x7a, 0xd7, 0xbb, 0x76, 0x52, 0x11, 0x2e, 0xb6, 0x35, 0xf3, 0xff, 0x9b, 0xf7,
+	0xdf, 0xfc, 0xf9, 0xff, 0xcb, 0x70, 0xa1, 0x46, 0x68, 0x9d, 0xd0, 0xac, 0x86, 0x29, 0x33, 0x71,
+	0xb5, 0xc9, 0x30, 0x31, 0xb2, 0x7b, 0xb9, 0x2a, 0x62, 0x6a, 0x2e, 0xcb, 0xee, 0x29, 0x0d, 0x93,
+	0x30, 0x22, 0xcc, 0x3a, 0x56, 0x8a, 0xdf, 0x4a, 0xe1, 0x56, 0xe2, 0x94, 0x4e, 0x74, 0x62, 0xdb,
+	0x65, 0xad, 0x5f, 0x8e, 0x8b, 0x28, 0x71, 0xe0, 0xaa, 0x4a, 0x91, 0x07, 0x58, 0x23, 0xd8, 0xe0,
+	0xfb, 0x17, 0x9d, 0xfd, 0x6d, 0xc7, 0x91, 0xe3, 0x3b, 0x5b, 0xd3, 0xdc, 0xb5, 0x4e, 0xf5, 0xec,
+	0x5e, 0xce, 0xfa, 0xe2, 0x1b, 0x93, 0x6a, 0x1d, 0x1b, 0x24, 0x6b, 0x7f, 0xf2, 0x25, 0x25, 0x8e,
+	0x7f, 0x80, 0xae, 0x6d, 0x2f, 0xff, 0x09, 0xe0, 0x33, 0x25, 0xaa, 0x57, 0x10, 0x7b, 0x13, 0xb3,
+	0x1d, 0xcd, 0x54, 0x5b, 0x37, 0x34, 0xcd, 0x44, 0x94, 0x0a, 0x2f, 0xc3, 0x49, 0x0d, 0xed, 0x22,
+	0x5d, 0x65, 0xc4, 0xdc, 0x56, 0x9d, 0xc5, 0x19, 0x30, 0x0f, 0x96, 0xd2, 0x9b, 0x33, 0x07, 0x9d,
+	0x95, 0x29, 0x4e, 0x91, 0x9b, 0x57, 0x98, 0x89, 0x0d, 0xbd, 0x7c, 0xde, 0x73, 0x71, 0x61, 0x8a,
+	0xf0, 0x7c, 0x8b, 0x23, 0x7b, 0x28, 0x23, 0x09, 0x28, 0x13, 0xad, 0x20, 0x97, 0xc2, 0xd6, 0x27,
+	0xf7, 0x33, 0xa9, 0x3f, 0xee, 0x67, 0x52, 0x1f, 0x1c, 0xef, 0x2f, 0x87, 0x69, 0x7d, 0x7a, 0xbc,
+	0xbf, 0x7c, 0xc5, 0x41, 0x5a, 0xa1, 0xda, 0xdd, 0x6c, 0x89, 0xea, 0x25, 0xa2, 0xe1, 0x77, 0xda,
+	0x3d, 0x31, 0xc9, 0x19, 0x38, 0x17, 0x19, 0x6c, 0x19, 0xd1, 0x06, 0x31, 0x28, 0x92, 0xff, 0x01,
+	0x50, 0x2c, 0x51, 0xdd, 0xdd, 0xbe, 0xe9, 0x9e, 0x54, 0x46, 0x2d, 0xd5, 0xd4, 0x9e, 0x94, 0x26,
+	0xaf, 0xc2, 0xc9, 0x3d, 0x75, 0x17, 0x6b, 0x01, 0x18, 0x47, 0x94, 0xcb, 0x07, 0x9d, 0x95, 0x39,
+	0x0e, 0x73, 0xc7, 0xb5, 0xe9, 0xc1, 0xdb, 0xeb, 0x59, 0x2f, 0xdc, 0x4a, 0x96, 0x67, 0x31, 0x28,
+	0x4f, 0x4f, 0x80, 0x98, 0x18, 0x4e, 0x84, 0x72, 0x07, 0x40, 0xb9, 0xbf, 0x00, 0xae, 0x4e, 0x42,
+	0x1b, 0x8e, 0xa9, 0x75, 0xd2, 0x34, 0xd8, 0x0c, 0x98, 0x1f, 0x5d, 0x3a, 0xb7, 0x76, 0x91, 0xe7,
+	0x9d, 0x62, 0xa5, 0xb7, 0xfb, 0x12, 0x94, 0x22, 0xc1, 0xc6, 0xe6, 0xd6, 0xc3, 0xdf, 0x32, 0xa9,
+	0x6f, 0x7e, 0xcf, 0x2c, 0xe9, 0x98, 0xed, 0x34, 0xab, 0x4a, 0x8d, 0xd4, 0x79, 0x7a, 0x67, 0x7d,
+	0x9c, 0x58, 0xbb, 0x81, 0xa8, 0xed, 0x40, 0xbf, 0x3a, 0xde, 0x5f, 0x1e, 0xb7, 0x8e, 0xad, 0xb5,
+	0xb7, 0xad, 0x07, 0x42, 0x1f, 0x1c, 0xef, 0x2f, 0x83, 0x32, 0x3f, 0xb0, 0x70, 0xe1, 0xa0, 0xb3,
+	0x32, 0xd1, 0xf5, 0x9c, 0x5f, 0x55, 0xf2, 0xd7, 0xe4, 0xef, 0x01, 0x94, 0x7c, 0xb4, 0x3d, 0xe5,
+	0x8a, 0xa4, 0x5e, 0xc7, 0x94, 0x62, 0x62, 0x44, 0x8b, 0x0e, 0x4e, 0x2e, 0x7a, 0x30, 0x27, 0x43,
+	0xd0, 0x11, 0x39, 0xe9, 0x63, 0xd7, 0xe5, 0x25, 0x7f, 0x07, 0xe0, 0x62, 0x3c, 0x75, 0x4f, 0xf5,
+	0xcf, 0xc0, 0xe0, 0xb2, 0xbf, 0x35, 0xac, 0xec, 0xbf, 0x86, 0x65, 0x8d, 0xbf, 0x09, 0xf9, 0xa3,
+	0x11, 0x38, 0x55, 0xa2, 0xfa, 0x56, 0xd3, 0xd0, 0x2c, 0xb2, 0x4d, 0x03, 0xb3, 0xf6, 0x6d, 0x42,
+	0x76, 0xcf, 0x30, 0x3b, 0x84, 0x6b, 0x30, 0xad, 0xa1, 0x06, 0xa1, 0x98, 0x11, 0x33, 0xb1, 0xce,
+	0x74, 0x4d, 0x0b, 0x05, 0xff, 0x6d, 0x76, 0xd7, 0xad, 0x5b, 0xcc, 0x04, 0x6f, 0x31, 0x14, 0xae,
+	0x2c, 0xc1, 0x4b, 0x51, 0xeb, 0x5e, 0x51, 0x79, 0x0c, 0xe0, 0x44, 0x89, 0xea, 0x6f, 0x34, 0x34,
+	0x95, 0xa1, 0xdb, 0xaa, 0xa9, 0xd6, 0xa9, 0xc5, 0x53, 0x6d, 0xb2, 0x1d, 0x62, 0x62, 0xd6, 0x4e,
+	0xac, 0x20, 0x5d, 0x53, 0x61, 0x0b, 0x8e, 0x35, 0x6c, 0x04, 0x3b, 0xb8, 0x73, 0x6b, 0x57, 0x94,
+	0x98, 0x56, 0xa4, 0x38, 0x87, 0x6d, 0xa6, 0x2d, 0x91, 0xb9, 0x4e, 0x8e, 0x77, 0xa1, 0x14, 0x7e,
+	0x45, 0x1b, 0x76, 0xe8, 0xde, 0x51, 0x56, 0xe8, 0xcf, 0xf9, 0x42, 0x0f, 0x74, 0x94, 0x9e, 0x70,
+	0x64, 0x05, 0x4e, 0xf7, 0x2c, 0xb9, 0xd1, 0x47, 0xbd, 0xd7, 0x0d, 0xf9, 0xdb, 0x11, 0xbb, 0xed,
+	0x04, 0xf4, 0xaa, 0x34, 0x90, 0xa1, 0x9d, 0x58, 0x98, 0x4b, 0x30, 0x6d, 0xa2, 0x1a, 0x6e, 0x60,
+	0x64, 0x30, 0xe7, 0xe2, 0xcb, 0xdd, 0x05, 0x5f, 0x46, 0x8e, 0xfe, 0xd7, 0xf5, 0xea, 0x95, 0x81,
+	0x94, 0x5e, 0xec, 0x55, 0x3a, 0x1b, 0x29, 0x8f, 0x9c, 0x87, 0x73, 0x91, 0x1b, 0xf1, 0x72, 0xff,
+	0x3d, 0x62, 0x97, 0xc7, 0x9b, 0x4e, 0x5a, 0x7b, 0x25, 0xc6, 0x29, 0xea, 0xd4, 0x7e, 0xb3, 0x81,
+	0x87, 0x03, 0x06, 0x7e, 0x38, 0x4f, 0xba, 0x97, 0x9d, 0xe5, 0x4d, 0x55, 0xdc, 0x1a, 0x10, 0x92,
+	0x70, 0x7d, 0x35, 0x5c, 0x16, 0x9e, 0x8d, 0xba, 0xb1, 0xae, 0xc2, 0x5c, 0x5b, 0xf9, 0x3a, 0x5c,
+	0x0c, 0xac, 0x87, 0x94, 0x8f, 0xb9, 0xb9, 0xf5, 0x55, 0xf9, 0x07, 0x00, 0x17, 0x7c, 0xdd, 0xe1,
+	0x75, 0x72, 0x17, 0x19, 0xf8, 0x5d, 0x54, 0xd9, 0x51, 0x4d, 0x54, 0x46, 0x35, 0x62, 0x6a, 0x0e,
+	0x94, 0x70, 0x1d, 0x3e, 0x4d, 0x5a, 0x06, 0x1a, 0x7c, 0x2c, 0x19, 0xb7, 0xcd, 0xdd, 0x6e, 0x76,
+	0xa7, 0x7f, 0xec, 0x79, 0x2b, 0xf6, 0x20, 0xb8, 0x15, 0xff, 0x42, 0x74, 0x73, 0x73, 0x19, 0xf2,
+	0x79, 0xe2, 0x47, 0x00, 0xaf, 0x0e, 0xc2, 0xff, 0xff, 0x39, 0x59, 0xac, 0xe7, 0xd7, 0xfe, 0x4a,
+	0xc3, 0xd1, 0x12, 0xd5, 0x85, 0x0f, 0x01, 0x14, 0x22, 0xa6, 0xe4, 0xb5, 0xd8, 0xfa, 0x1b, 0x39,
+	0x6c, 0x8a, 0x85, 0xe1, 0x7d, 0x3c, 0x79, 0xbe, 0x00, 0x70, 0xba, 0xdf, 0x74, 0xba, 0x91, 0x84,
+	0xdb, 0xc7, 0x51, 0x7c, 0xe9, 0x84, 0x8e, 0x1e, 0xab, 0xaf, 0x01, 0x9c, 0x8d, 0x9b, 0xbd, 0x5e,
+	0x1c, 0xf4, 0x80, 0x08, 0x67, 0xb1, 0x78, 0x0a, 0x67, 0x8f, 0xe1, 0xfb, 0x00, 0x4e, 0x86, 0x07,
+	0x95, 0x5c, 0x12, 0x74, 0xc8, 0x45, 0x7c, 0x61, 0x68, 0x17, 0x8f, 0xc3, 0xc7, 0x00, 0x8e, 0x07,
+	0x86, 0x80, 0xab, 0x49, 0x58, 0x7e, 0x6b, 0x31, 0x3f, 0x8c, 0xb5, 0x37, 0x7c, 0x5c, 0xf8, 0x39,
+	0xdc, 0x0f, 0x84, 0x2f, 0x01, 0x14, 0x22, 0x7a, 0x6f, 0x62, 0x32, 0x87, 0x7d, 0xc4, 0xc2, 0xf0,
+	0x3e, 0xf1, 0xdc, 0x3a, 0x00, 0xce, 0xc6, 0x35, 0xaa, 0xc4, 0x5c, 0x8a, 0x71, 0x16, 0x8b, 0xa7,
+	0x70, 0x8e, 0xa1, 0xbd, 0xbe, 0x2a, 0xfc, 0x04, 0xe0, 0xe5, 0xe4, 0x2a, 0x7d, 0x63, 0xd0, 0x5c,
+	0xee, 0x0b, 0x21, 0xde, 0x3a, 0x35, 0x44, 0x5c, 0x20, 0x79, 0xf1, 0xa9, 0xf7, 0xac, 0xa2, 0xb8,
+	0xf9, 0xda, 0x83, 0x43, 0x09, 0x3c, 0x3c, 0x94, 0xc0, 0xa3, 0x43, 0x09, 0x3c, 0x3e, 0x94, 0xc0,
+	0xe7, 0x47, 0x52, 0xea, 0xd1, 0x91, 0x94, 0xfa, 0xe5, 0x48, 0x4a, 0xbd, 0x9d, 0x8b, 0x2d, 0xb9,
+	0xf7, 0x82, 0xc3, 0xa2, 0x5d, 0x81, 0xab, 0x63, 0xf6, 0x1f, 0x0e, 0xcf, 0xff, 0x3b, 0x00, 0xfa,
+	0xf0, 0xb8, 0x59, 0x62, 0x11, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -951,6 +1048,35 @@ func (this *MsgDepositValidatorRewardsPoolResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgWithdrawTokenizeShareRecordRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -991,6 +1117,9 @@ type MsgClient interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// earned by all the tokenize share records owned by an account.
+	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 }
 
 type msgClient struct {
@@ -1064,6 +1193,15 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -1093,6 +1231,9 @@ type MsgServer interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// earned by all the tokenize share records owned by an account.
+	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1120,6 +1261,9 @@ func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) DepositValidatorRewardsPool(ctx context.Context, req *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, req *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokenizeShareRecordReward not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1251,6 +1395,24 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, req.(*MsgWithdrawTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
@@ -1284,6 +1446,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1768,6 +1934,73 @@ func (m *MsgDepositValidatorRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1981,6 +2214,34 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3242,6 +3503,172 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
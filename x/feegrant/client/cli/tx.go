@@ -19,11 +19,12 @@ import (
 
 // flags for feegrant module
 const (
-	FlagExpiration  = "expiration"
-	FlagPeriod      = "period"
-	FlagPeriodLimit = "period-limit"
-	FlagSpendLimit  = "spend-limit"
-	FlagAllowedMsgs = "allowed-messages"
+	FlagExpiration   = "expiration"
+	FlagPeriod       = "period"
+	FlagPeriodLimit  = "period-limit"
+	FlagSpendLimit   = "spend-limit"
+	FlagAllowedMsgs  = "allowed-messages"
+	FlagAllowedAddrs = "allowed-addresses"
 )
 
 // GetTxCmd returns the transaction commands for feegrant module
@@ -60,8 +61,10 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
-				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --allowed-messages "/cosmos.bank.v1beta1.MsgSend"
+	--allowed-addresses cosmos1contract...
+				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -161,11 +164,29 @@ Examples:
 				return err
 			}
 
+			allowedAddrs, err := cmd.Flags().GetStringSlice(FlagAllowedAddrs)
+			if err != nil {
+				return err
+			}
+
+			if len(allowedAddrs) > 0 && len(allowedMsgs) == 0 {
+				return fmt.Errorf("--%s requires --%s", FlagAllowedAddrs, FlagAllowedMsgs)
+			}
+
 			if len(allowedMsgs) > 0 {
-				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
+				allowedMsgAllowance, err := feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
 				if err != nil {
 					return err
 				}
+
+				for _, addr := range allowedAddrs {
+					if _, err := ac.StringToBytes(addr); err != nil {
+						return err
+					}
+				}
+				allowedMsgAllowance.AllowedAddresses = allowedAddrs
+
+				grant = allowedMsgAllowance
 			}
 
 			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
@@ -179,6 +200,7 @@ Examples:
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
+	cmd.Flags().StringSlice(FlagAllowedAddrs, []string{}, "Set of addresses the allowed messages must target, e.g. contract or module addresses, requires --allowed-messages")
 	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration (in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
@@ -184,6 +184,11 @@ type AllowedMsgAllowance struct {
 	Allowance *any.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
 	// allowed_messages are the messages for which the grantee has the access.
 	AllowedMessages []string `protobuf:"bytes,2,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+	// allowed_addresses optionally restricts the grant to the messages
+	// targeting one of the addresses, e.g. a contract or a module account. A
+	// message targets an address when one of its address fields, other than its
+	// signers, holds it.
+	AllowedAddresses []string `protobuf:"bytes,3,rep,name=allowed_addresses,json=allowedAddresses,proto3" json:"allowed_addresses,omitempty"`
 }
 
 func (m *AllowedMsgAllowance) Reset()         { *m = AllowedMsgAllowance{} }
@@ -295,48 +300,51 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 655 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0xde, 0x61, 0x01, 0xb3, 0xb3, 0x88, 0x50, 0x49, 0xec, 0x12, 0xd3, 0x25, 0x9b, 0x28, 0x0b,
-	0x09, 0x6d, 0xc0, 0x1b, 0x27, 0xb6, 0x18, 0x50, 0x03, 0x09, 0x29, 0x9e, 0x4c, 0x4c, 0x33, 0x6d,
-	0x87, 0x3a, 0x71, 0xdb, 0x69, 0x3a, 0x45, 0xd9, 0xab, 0x27, 0xa3, 0x07, 0x39, 0x1a, 0x4f, 0x1c,
-	0x8d, 0x27, 0x0e, 0xfc, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe, 0x03,
-	0xd3, 0x99, 0x69, 0xb7, 0xb0, 0xa2, 0x90, 0x18, 0x2e, 0xbb, 0x33, 0x6f, 0xde, 0xf7, 0xbd, 0xef,
-	0x7b, 0xef, 0x6d, 0x16, 0xde, 0x75, 0x29, 0x0b, 0x28, 0x33, 0x36, 0x30, 0xf6, 0x63, 0x14, 0x26,
-	0xc6, 0x8b, 0x59, 0x07, 0x27, 0x68, 0x36, 0x0f, 0xe8, 0x51, 0x4c, 0x13, 0xaa, 0xdc, 0x12, 0x79,
-	0x7a, 0x1e, 0x96, 0x79, 0xe3, 0x63, 0x3e, 0xf5, 0x29, 0xcf, 0x31, 0xd2, 0x93, 0x48, 0x1f, 0xaf,
-	0xf9, 0x94, 0xfa, 0x6d, 0x6c, 0xf0, 0x9b, 0xb3, 0xb9, 0x61, 0xa0, 0xb0, 0x93, 0x3d, 0x09, 0x26,
-	0x5b, 0x60, 0x24, 0xad, 0x78, 0xd2, 0xa4, 0x18, 0x07, 0x31, 0x9c, 0x0b, 0x71, 0x29, 0x09, 0xe5,
-	0xfb, 0x28, 0x0a, 0x48, 0x48, 0x0d, 0xfe, 0x29, 0x43, 0xf5, 0xb3, 0x85, 0x12, 0x12, 0x60, 0x96,
-	0xa0, 0x20, 0xca, 0x38, 0xcf, 0x26, 0x78, 0x9b, 0x31, 0x4a, 0x08, 0x95, 0x9c, 0x8d, 0x9d, 0x3e,
-	0x38, 0x6c, 0x22, 0x46, 0xdc, 0x56, 0xbb, 0x4d, 0x5f, 0xa2, 0xd0, 0xc5, 0xca, 0x2b, 0x00, 0xab,
-	0x2c, 0xc2, 0xa1, 0x67, 0xb7, 0x49, 0x40, 0x12, 0x15, 0x4c, 0x94, 0x9b, 0xd5, 0xb9, 0x9a, 0x2e,
-	0xb5, 0xa6, 0xea, 0x32, 0xfb, 0xfa, 0x22, 0x25, 0xa1, 0xb9, 0xb4, 0xff, 0xa3, 0x5e, 0xfa, 0x74,
-	0x58, 0x6f, 0xfa, 0x24, 0x79, 0xb6, 0xe9, 0xe8, 0x2e, 0x0d, 0xa4, 0x31, 0xf9, 0x35, 0xc3, 0xbc,
-	0xe7, 0x46, 0xd2, 0x89, 0x30, 0xe3, 0x00, 0xf6, 0xe1, 0x64, 0x77, 0x7a, 0xa8, 0x8d, 0x7d, 0xe4,
-	0x76, 0xec, 0xd4, 0x1f, 0xfb, 0x78, 0xb2, 0x3b, 0x0d, 0x2c, 0xc8, 0xab, 0xae, 0xa4, 0x45, 0x95,
-	0x05, 0x08, 0xf1, 0x56, 0x44, 0x84, 0x56, 0xb5, 0x6f, 0x02, 0x34, 0xab, 0x73, 0xe3, 0xba, 0x30,
-	0xa3, 0x67, 0x66, 0xf4, 0xc7, 0x99, 0x5b, 0xb3, 0x7f, 0xfb, 0xb0, 0x0e, 0xac, 0x02, 0x66, 0x7e,
-	0xf9, 0xf3, 0xde, 0xcc, 0x9d, 0x73, 0xc6, 0xa6, 0x2f, 0x61, 0x9c, 0x1b, 0x7e, 0xf8, 0xe6, 0x64,
-	0x77, 0xba, 0x56, 0x50, 0x7a, 0xba, 0x1f, 0x8d, 0xef, 0xfd, 0x70, 0x74, 0x0d, 0xc7, 0x84, 0x7a,
-	0xc5, 0x2e, 0x3d, 0x80, 0x03, 0x4e, 0x9a, 0xa7, 0x02, 0xae, 0x6d, 0x52, 0x3f, 0xaf, 0xd4, 0x69,
-	0x36, 0xb3, 0x92, 0x36, 0x4b, 0xf8, 0x15, 0x04, 0xca, 0x02, 0x1c, 0x8c, 0x38, 0xbd, 0xb4, 0x59,
-	0xeb, 0xb1, 0x79, 0x5f, 0xce, 0xcc, 0xbc, 0x9e, 0x82, 0xdf, 0x1f, 0xd6, 0x81, 0x20, 0x90, 0x38,
-	0xe5, 0x1d, 0x80, 0x8a, 0x38, 0xda, 0xc5, 0xc1, 0x95, 0xaf, 0x6a, 0x70, 0x23, 0xa2, 0xf8, 0x7a,
-	0x77, 0x7c, 0x6f, 0x01, 0x94, 0x41, 0xdb, 0x45, 0xa1, 0x50, 0xa5, 0xf6, 0x5f, 0x95, 0x9e, 0x61,
-	0x51, 0x7a, 0x11, 0x85, 0x5c, 0x92, 0xb2, 0x02, 0x87, 0xa4, 0x98, 0x18, 0x33, 0x9c, 0xa8, 0x03,
-	0xff, 0x5c, 0x27, 0xde, 0xe8, 0xed, 0xbc, 0xd1, 0x55, 0x01, 0xb7, 0x52, 0xf4, 0xfc, 0xa3, 0x4b,
-	0x2d, 0xd6, 0xed, 0x82, 0xf2, 0x9e, 0x2d, 0x6a, 0xfc, 0x02, 0xf0, 0x26, 0xbf, 0x61, 0x6f, 0x95,
-	0xf9, 0xdd, 0xed, 0x7a, 0x0a, 0x2b, 0x28, 0xbb, 0xc8, 0x0d, 0x1b, 0xeb, 0x91, 0xdb, 0x0a, 0x3b,
-	0xe6, 0xd4, 0x85, 0xc5, 0x58, 0x5d, 0x46, 0x65, 0x0a, 0x8e, 0x20, 0x51, 0xd5, 0x0e, 0x30, 0x63,
-	0xc8, 0xc7, 0x4c, 0xed, 0x9b, 0x28, 0x37, 0x2b, 0xd6, 0x0d, 0x19, 0x5f, 0x95, 0xe1, 0xf9, 0xb5,
-	0xd7, 0x3b, 0xf5, 0xd2, 0xa5, 0x1c, 0x6b, 0x05, 0xc7, 0x7f, 0xf0, 0xd6, 0xf8, 0x02, 0xe0, 0xc0,
-	0x72, 0x4a, 0xa1, 0xcc, 0xc1, 0x6b, 0x9c, 0x0b, 0xc7, 0xdc, 0x63, 0xc5, 0x54, 0xbf, 0xee, 0xcd,
-	0x8c, 0xc9, 0x42, 0x2d, 0xcf, 0x8b, 0x31, 0x63, 0xeb, 0x49, 0x4c, 0x42, 0xdf, 0xca, 0x12, 0xbb,
-	0x18, 0xcc, 0x7f, 0x2e, 0x17, 0xc0, 0x9c, 0xe9, 0x66, 0xf9, 0x7f, 0x77, 0xd3, 0x6c, 0xed, 0x1f,
-	0x69, 0xe0, 0xe0, 0x48, 0x03, 0x3f, 0x8f, 0x34, 0xb0, 0x7d, 0xac, 0x95, 0x0e, 0x8e, 0xb5, 0xd2,
-	0xb7, 0x63, 0xad, 0xf4, 0x64, 0xf2, 0xaf, 0x8b, 0xbc, 0x95, 0xff, 0xcb, 0x38, 0x83, 0x5c, 0xc6,
-	0xbd, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x31, 0x3d, 0x53, 0x70, 0x90, 0x06, 0x00, 0x00,
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0xb4, 0xc0, 0x37, 0x9d, 0xf2, 0xe5, 0xc7, 0x4a, 0xe2, 0x96, 0x98, 0x6d, 0xd3, 0x44,
+	0x29, 0x98, 0xee, 0x0a, 0xea, 0x85, 0x13, 0x5d, 0x0c, 0xa8, 0x81, 0x84, 0x2c, 0x1e, 0x8c, 0x89,
+	0x69, 0xa6, 0xbb, 0xc3, 0x3a, 0xb1, 0xbb, 0xd3, 0xec, 0x2c, 0x4a, 0xaf, 0x9e, 0x8c, 0x1e, 0xe4,
+	0x68, 0x3c, 0x71, 0x34, 0x9e, 0x38, 0xf0, 0x47, 0x10, 0x4d, 0x0c, 0xf1, 0xa4, 0x1e, 0xc4, 0xc0,
+	0x81, 0x7f, 0xc3, 0xec, 0xcc, 0xec, 0x76, 0x01, 0xab, 0x90, 0x18, 0x2e, 0xed, 0xcc, 0x9b, 0xf7,
+	0x3e, 0xef, 0xf3, 0xf9, 0xbc, 0xd7, 0x14, 0x5e, 0xb3, 0x29, 0xf3, 0x28, 0x33, 0xd6, 0x30, 0x76,
+	0x03, 0xe4, 0x87, 0xc6, 0xb3, 0xe9, 0x26, 0x0e, 0xd1, 0x74, 0x12, 0xd0, 0xdb, 0x01, 0x0d, 0xa9,
+	0x72, 0x59, 0xe4, 0xe9, 0x49, 0x58, 0xe6, 0x8d, 0x8f, 0xb9, 0xd4, 0xa5, 0x3c, 0xc7, 0x88, 0x4e,
+	0x22, 0x7d, 0xbc, 0xe8, 0x52, 0xea, 0xb6, 0xb0, 0xc1, 0x6f, 0xcd, 0xf5, 0x35, 0x03, 0xf9, 0x9d,
+	0xf8, 0x49, 0x20, 0x35, 0x44, 0x8d, 0x84, 0x15, 0x4f, 0x9a, 0x24, 0xd3, 0x44, 0x0c, 0x27, 0x44,
+	0x6c, 0x4a, 0x7c, 0xf9, 0x3e, 0x8a, 0x3c, 0xe2, 0x53, 0x83, 0x7f, 0xca, 0x50, 0xe9, 0x64, 0xa3,
+	0x90, 0x78, 0x98, 0x85, 0xc8, 0x6b, 0xc7, 0x98, 0x27, 0x13, 0x9c, 0xf5, 0x00, 0x85, 0x84, 0x4a,
+	0xcc, 0xca, 0x56, 0x16, 0x0e, 0x99, 0x88, 0x11, 0xbb, 0xde, 0x6a, 0xd1, 0xe7, 0xc8, 0xb7, 0xb1,
+	0xf2, 0x02, 0xc0, 0x02, 0x6b, 0x63, 0xdf, 0x69, 0xb4, 0x88, 0x47, 0x42, 0x15, 0x94, 0x73, 0xd5,
+	0xc2, 0x4c, 0x51, 0x97, 0x5c, 0x23, 0x76, 0xb1, 0x7c, 0x7d, 0x9e, 0x12, 0xdf, 0x5c, 0xd8, 0xfd,
+	0x51, 0xca, 0x7c, 0xd8, 0x2f, 0x55, 0x5d, 0x12, 0x3e, 0x59, 0x6f, 0xea, 0x36, 0xf5, 0xa4, 0x30,
+	0xf9, 0x55, 0x63, 0xce, 0x53, 0x23, 0xec, 0xb4, 0x31, 0xe3, 0x05, 0xec, 0xdd, 0xd1, 0xf6, 0xd4,
+	0x60, 0x0b, 0xbb, 0xc8, 0xee, 0x34, 0x22, 0x7d, 0xec, 0xfd, 0xd1, 0xf6, 0x14, 0xb0, 0x20, 0xef,
+	0xba, 0x14, 0x35, 0x55, 0xe6, 0x20, 0xc4, 0x1b, 0x6d, 0x22, 0xb8, 0xaa, 0xd9, 0x32, 0xa8, 0x16,
+	0x66, 0xc6, 0x75, 0x21, 0x46, 0x8f, 0xc5, 0xe8, 0x0f, 0x62, 0xb5, 0x66, 0xdf, 0xe6, 0x7e, 0x09,
+	0x58, 0xa9, 0x9a, 0xd9, 0xc5, 0x8f, 0x3b, 0xb5, 0xab, 0x3d, 0xc6, 0xa6, 0x2f, 0x60, 0x9c, 0x08,
+	0xbe, 0xf7, 0xea, 0x68, 0x7b, 0xaa, 0x98, 0x62, 0x7a, 0xdc, 0x8f, 0xca, 0xb7, 0x3e, 0x38, 0xba,
+	0x82, 0x03, 0x42, 0x9d, 0xb4, 0x4b, 0x77, 0x61, 0x7f, 0x33, 0xca, 0x53, 0x01, 0xe7, 0x36, 0xa1,
+	0xf7, 0x6a, 0x75, 0x1c, 0xcd, 0xcc, 0x47, 0x66, 0x09, 0xbd, 0x02, 0x40, 0x99, 0x83, 0x03, 0x6d,
+	0x0e, 0x2f, 0x65, 0x16, 0x4f, 0xc9, 0xbc, 0x23, 0x67, 0x66, 0xfe, 0x1f, 0x15, 0xbf, 0xdd, 0x2f,
+	0x01, 0x01, 0x20, 0xeb, 0x94, 0x37, 0x00, 0x2a, 0xe2, 0xd8, 0x48, 0x0f, 0x2e, 0x77, 0x51, 0x83,
+	0x1b, 0x11, 0xcd, 0x57, 0xbb, 0xe3, 0x7b, 0x0d, 0xa0, 0x0c, 0x36, 0x6c, 0xe4, 0x0b, 0x56, 0x6a,
+	0xdf, 0x45, 0xf1, 0x19, 0x12, 0xad, 0xe7, 0x91, 0xcf, 0x29, 0x29, 0x4b, 0x70, 0x50, 0x92, 0x09,
+	0x30, 0xc3, 0xa1, 0xda, 0xff, 0xd7, 0x75, 0xe2, 0x46, 0x6f, 0x26, 0x46, 0x17, 0x44, 0xb9, 0x15,
+	0x55, 0xcf, 0xde, 0x3f, 0xd7, 0x62, 0x5d, 0x49, 0x31, 0x3f, 0xb5, 0x45, 0x95, 0x4f, 0x59, 0x78,
+	0x89, 0xdf, 0xb0, 0xb3, 0xcc, 0xdc, 0xee, 0x76, 0x3d, 0x86, 0x79, 0x14, 0x5f, 0xe4, 0x86, 0x8d,
+	0x9d, 0xa2, 0x5b, 0xf7, 0x3b, 0xe6, 0xe4, 0x99, 0xc9, 0x58, 0x5d, 0x44, 0x65, 0x12, 0x8e, 0x20,
+	0xd1, 0xb5, 0xe1, 0x61, 0xc6, 0x90, 0x8b, 0x99, 0x9a, 0x2d, 0xe7, 0xaa, 0x79, 0x6b, 0x58, 0xc6,
+	0x97, 0x65, 0x58, 0x79, 0x08, 0x47, 0xe3, 0x54, 0xe4, 0x38, 0x01, 0x66, 0x0c, 0x33, 0xbe, 0x59,
+	0x79, 0xf3, 0xfa, 0x97, 0x9d, 0xda, 0x98, 0xec, 0x5d, 0x17, 0x6f, 0xab, 0x61, 0x40, 0x7c, 0xf7,
+	0xfb, 0x4e, 0x6d, 0xb8, 0x2b, 0xbb, 0x7c, 0x43, 0xbf, 0x7d, 0xcb, 0x8a, 0x1b, 0xd6, 0x63, 0x90,
+	0xd9, 0x95, 0x97, 0x5b, 0xa5, 0xcc, 0xb9, 0xbc, 0xd4, 0x52, 0x5e, 0xfe, 0xc6, 0xb5, 0xca, 0x67,
+	0x00, 0xfb, 0x17, 0x23, 0x08, 0x65, 0x06, 0xfe, 0xc7, 0xb1, 0x70, 0xc0, 0xdd, 0xcb, 0x9b, 0x6a,
+	0x2f, 0xae, 0x56, 0x9c, 0xd8, 0xad, 0xc1, 0x6a, 0xf6, 0x6c, 0x35, 0x27, 0xe6, 0x94, 0xfb, 0xd7,
+	0x73, 0x32, 0xeb, 0xbb, 0x07, 0x1a, 0xd8, 0x3b, 0xd0, 0xc0, 0xcf, 0x03, 0x0d, 0x6c, 0x1e, 0x6a,
+	0x99, 0xbd, 0x43, 0x2d, 0xf3, 0xf5, 0x50, 0xcb, 0x3c, 0x9a, 0xf8, 0xe3, 0x4f, 0x64, 0x23, 0xf9,
+	0xff, 0x6a, 0x0e, 0x70, 0x1a, 0x37, 0x7f, 0x0d, 0x00, 0x6b, 0xdf, 0x78, 0xe0, 0xea, 0x06, 0x00,
+	0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -483,6 +491,15 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedAddresses) > 0 {
+		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedAddresses[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AllowedMessages) > 0 {
 		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedMessages[iNdEx])
@@ -629,6 +646,12 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 			n += 1 + l + sovFeegrant(uint64(l))
 		}
 	}
+	if len(m.AllowedAddresses) > 0 {
+		for _, s := range m.AllowedAddresses {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1093,6 +1116,38 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAddresses = append(m.AllowedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
@@ -2,10 +2,18 @@ package feegrant
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"github.com/cosmos/cosmos-proto/anyutil"
 	"github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
 
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -17,6 +25,10 @@ import (
 // Tracking issues https://github.com/cosmos/cosmos-sdk/issues/9054, https://github.com/cosmos/cosmos-sdk/discussions/9072
 const (
 	gasCostPerIteration = uint64(10)
+
+	// maxAddressFieldDepth is the maximum depth of the nested messages searched
+	// for the allowed addresses.
+	maxAddressFieldDepth = 8
 )
 
 var (
@@ -74,6 +86,12 @@ func (a *AllowedMsgAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []
 		return false, errorsmod.Wrap(ErrMessageNotAllowed, "message does not exist in allowed messages")
 	}
 
+	if len(a.AllowedAddresses) > 0 {
+		if err := a.allMsgAddressesAllowed(sdk.UnwrapSDKContext(ctx), msgs); err != nil {
+			return false, err
+		}
+	}
+
 	allowance, err := a.GetAllowance()
 	if err != nil {
 		return false, err
@@ -111,6 +129,100 @@ func (a *AllowedMsgAllowance) allMsgTypesAllowed(ctx sdk.Context, msgs []sdk.Msg
 	return true
 }
 
+// allMsgAddressesAllowed checks that each message targets one of the allowed
+// addresses, i.e. that one of its address fields, other than its signers,
+// holds an allowed address.
+func (a *AllowedMsgAllowance) allMsgAddressesAllowed(ctx sdk.Context, msgs []sdk.Msg) error {
+	allowed := make(map[string]bool, len(a.AllowedAddresses))
+	for _, addr := range a.AllowedAddresses {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg address")
+		allowed[addr] = true
+	}
+
+	for _, msg := range msgs {
+		addrs, err := msgAddresses(msg)
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, addr := range addrs {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg address")
+			if allowed[addr] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errorsmod.Wrapf(ErrMessageNotAllowed, "message %s does not target an allowed address", sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return nil
+}
+
+// msgAddresses returns the addresses held by the address fields of the message
+// and of its nested messages, except its signer fields.
+func msgAddresses(msg sdk.Msg) ([]string, error) {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	msgV2, err := anyutil.Unpack(&anypb.Any{TypeUrl: sdk.MsgTypeURL(msg), Value: bz}, proto.HybridResolver, protoregistry.GlobalTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	reflectMsg := msgV2.ProtoReflect()
+	signers := map[protoreflect.Name]bool{}
+	for _, name := range protov2.GetExtension(reflectMsg.Descriptor().Options(), msgv1.E_Signer).([]string) {
+		signers[protoreflect.Name(name)] = true
+	}
+
+	return appendMsgAddresses(nil, reflectMsg, signers, 0), nil
+}
+
+func appendMsgAddresses(addrs []string, msg protoreflect.Message, skip map[protoreflect.Name]bool, depth int) []string {
+	if depth > maxAddressFieldDepth {
+		return addrs
+	}
+
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if skip[field.Name()] || field.IsMap() {
+			continue
+		}
+
+		switch field.Kind() {
+		case protoreflect.StringKind:
+			if protov2.GetExtension(field.Options(), cosmos_proto.E_Scalar).(string) != "cosmos.AddressString" {
+				continue
+			}
+			if field.IsList() {
+				list := msg.Get(field).List()
+				for j := 0; j < list.Len(); j++ {
+					addrs = append(addrs, list.Get(j).String())
+				}
+			} else {
+				addrs = append(addrs, msg.Get(field).String())
+			}
+
+		case protoreflect.MessageKind:
+			if field.IsList() {
+				list := msg.Get(field).List()
+				for j := 0; j < list.Len(); j++ {
+					addrs = appendMsgAddresses(addrs, list.Get(j).Message(), nil, depth+1)
+				}
+			} else if msg.Has(field) {
+				addrs = appendMsgAddresses(addrs, msg.Get(field).Message(), nil, depth+1)
+			}
+		}
+	}
+
+	return addrs
+}
+
 // ValidateBasic implements FeeAllowance and enforces basic sanity checks
 func (a *AllowedMsgAllowance) ValidateBasic() error {
 	if a.Allowance == nil {
@@ -120,6 +232,17 @@ func (a *AllowedMsgAllowance) ValidateBasic() error {
 		return errorsmod.Wrap(ErrNoMessages, "allowed messages shouldn't be empty")
 	}
 
+	seen := make(map[string]bool, len(a.AllowedAddresses))
+	for _, addr := range a.AllowedAddresses {
+		if strings.TrimSpace(addr) == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "allowed address cannot be empty")
+		}
+		if seen[addr] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowed address %s", addr)
+		}
+		seen[addr] = true
+	}
+
 	allowance, err := a.GetAllowance()
 	if err != nil {
 		return err
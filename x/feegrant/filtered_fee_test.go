@@ -188,3 +188,65 @@ func TestFilteredFeeValidAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestFilteredFeeAllowedAddresses(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(ocproto.Header{Time: time.Now()})
+
+	sender := sdk.AccAddress("sender______________").String()
+	contract := sdk.AccAddress("contract____________").String()
+	other := sdk.AccAddress("other_______________").String()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+
+	allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{}, []string{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+	})
+	require.NoError(t, err)
+	allowance.AllowedAddresses = []string{contract}
+	require.NoError(t, allowance.ValidateBasic())
+
+	cases := map[string]struct {
+		msgs   []sdk.Msg
+		accept bool
+	}{
+		"allowed recipient": {
+			msgs:   []sdk.Msg{&banktypes.MsgSend{FromAddress: sender, ToAddress: contract, Amount: coins}},
+			accept: true,
+		},
+		"other recipient": {
+			msgs: []sdk.Msg{&banktypes.MsgSend{FromAddress: sender, ToAddress: other, Amount: coins}},
+		},
+		"signer is not a target": {
+			msgs: []sdk.Msg{&banktypes.MsgSend{FromAddress: contract, ToAddress: other, Amount: coins}},
+		},
+		"allowed nested recipient": {
+			msgs: []sdk.Msg{&banktypes.MsgMultiSend{
+				Inputs:  []banktypes.Input{{Address: sender, Coins: coins}},
+				Outputs: []banktypes.Output{{Address: other, Coins: coins}, {Address: contract, Coins: coins}},
+			}},
+			accept: true,
+		},
+		"one message with other recipient": {
+			msgs: []sdk.Msg{
+				&banktypes.MsgSend{FromAddress: sender, ToAddress: contract, Amount: coins},
+				&banktypes.MsgSend{FromAddress: sender, ToAddress: other, Amount: coins},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := allowance.Accept(ctx, coins, tc.msgs)
+			if tc.accept {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+			}
+		})
+	}
+
+	allowance.AllowedAddresses = []string{contract, contract}
+	require.ErrorContains(t, allowance.ValidateBasic(), "duplicate allowed address")
+}
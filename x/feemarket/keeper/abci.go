@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker adjusts the base fee of the next block from the gas used by the
+// transactions of the block.
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.Enabled {
+		return nil
+	}
+
+	baseFee, err := k.BaseFee.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.BaseFee.Set(ctx, params.NextBaseFee(baseFee, ctx.BlockGasUsed()))
+}
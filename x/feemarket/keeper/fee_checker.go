@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// priorityPrecision is the number of priority units per unit of the fee denom
+// tipped per unit of gas, so that tips below one unit per gas still order the
+// transactions in the mempool.
+const priorityPrecision = 1_000_000
+
+var _ ante.TxFeeChecker = Keeper{}.CheckTxFee
+
+// CheckTxFee implements ante.TxFeeChecker. The fee paid in the fee denom must
+// cover the base fee for the gas limit of the transaction, in CheckTx and in
+// FinalizeBlock. In CheckTx the minimum gas price of the validator applies
+// too, if higher. The priority of the transaction is its tip, the gas price
+// paid above the base fee.
+//
+// When the base fee is disabled, and for genesis transactions, the fee is
+// checked by ante.CheckTxFeeWithValidatorMinGasPrices.
+func (k Keeper) CheckTxFee(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !params.Enabled || ctx.BlockHeight() == 0 {
+		return ante.CheckTxFeeWithValidatorMinGasPrices(ctx, tx)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	gas := feeTx.GetGas()
+	if gas == 0 {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+	gasDec := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gas))
+
+	baseFee, err := k.BaseFee.Get(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	minGasPrice := baseFee
+	if ctx.IsCheckTx() {
+		if local := ctx.MinGasPrices().AmountOf(params.FeeDenom); local.GT(minGasPrice) {
+			minGasPrice = local
+		}
+	}
+
+	fee := feeTx.GetFee()
+	paid := fee.AmountOf(params.FeeDenom)
+	required := minGasPrice.Mul(gasDec).Ceil().RoundInt()
+	if paid.LT(required) {
+		return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, sdk.NewCoin(params.FeeDenom, required))
+	}
+
+	tip := sdkmath.LegacyNewDecFromInt(paid).Quo(gasDec).Sub(baseFee)
+	return fee, tipPriority(tip), nil
+}
+
+// tipPriority returns the priority of a transaction tipping the given amount
+// per unit of gas.
+func tipPriority(tip sdkmath.LegacyDec) int64 {
+	if !tip.IsPositive() {
+		return 0
+	}
+
+	priority := tip.MulInt64(priorityPrecision).TruncateInt()
+	if !priority.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return priority.Int64()
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (s *KeeperTestSuite) feeTx(fee sdk.Coins, gas uint64) sdk.Tx {
+	builder := s.txConfig.NewTxBuilder()
+	s.Require().NoError(builder.SetMsgs(testdata.NewTestMsg(sdk.AccAddress("signer"))))
+	builder.SetFeeAmount(fee)
+	builder.SetGasLimit(gas)
+	return builder.GetTx()
+}
+
+func (s *KeeperTestSuite) TestCheckTxFee() {
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDec(3)))
+
+	testCases := []struct {
+		name        string
+		enabled     bool
+		checkTx     bool
+		fee         sdk.Coins
+		gas         uint64
+		expPriority int64
+		expErr      error
+	}{
+		{
+			name:        "disabled, fee checked against the minimum gas prices",
+			checkTx:     true,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 300)),
+			gas:         100,
+			expPriority: 3,
+		},
+		{
+			name:    "disabled, insufficient for the minimum gas prices",
+			checkTx: true,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("stake", 299)),
+			gas:     100,
+			expErr:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:    "base fee paid without tip",
+			enabled: true,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("stake", 200)),
+			gas:     100,
+		},
+		{
+			name:        "tip sets the priority",
+			enabled:     true,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 205)),
+			gas:         100,
+			expPriority: 50_000,
+		},
+		{
+			name:    "base fee not paid",
+			enabled: true,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("stake", 199)),
+			gas:     100,
+			expErr:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:    "base fee paid in another denom",
+			enabled: true,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			gas:     100,
+			expErr:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:    "minimum gas prices above the base fee apply in check tx",
+			enabled: true,
+			checkTx: true,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("stake", 299)),
+			gas:     100,
+			expErr:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:        "minimum gas prices paid in check tx",
+			enabled:     true,
+			checkTx:     true,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 300)),
+			gas:         100,
+			expPriority: 1_000_000,
+		},
+		{
+			name:        "priority capped",
+			enabled:     true,
+			fee:         sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewIntFromUint64(math.MaxUint64))),
+			gas:         1,
+			expPriority: math.MaxInt64,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			if tc.enabled {
+				s.enable(sdkmath.LegacyNewDec(2))
+			}
+
+			ctx := s.ctx.WithIsCheckTx(tc.checkTx).WithMinGasPrices(minGasPrices)
+			fee, priority, err := s.keeper.CheckTxFee(ctx, s.feeTx(tc.fee, tc.gas))
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.fee, fee)
+			s.Require().Equal(tc.expPriority, priority)
+		})
+	}
+}
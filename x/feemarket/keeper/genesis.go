@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// InitGenesis initializes the feemarket module's state from a given genesis
+// state. The base fee defaults to the min base fee.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	baseFee := data.BaseFee
+	if baseFee.IsNil() || baseFee.LT(data.Params.MinBaseFee) {
+		baseFee = data.Params.MinBaseFee
+	}
+
+	return k.BaseFee.Set(ctx, baseFee)
+}
+
+// ExportGenesis returns the feemarket module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := k.BaseFee.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, baseFee), nil
+}
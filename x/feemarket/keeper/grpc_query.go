@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/feemarket QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the feemarket module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// BaseFee returns the base fee of the current block.
+func (q queryServer) BaseFee(ctx context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	baseFee, err := q.k.GetBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBaseFeeResponse{BaseFee: baseFee}, nil
+}
+
+// SuggestGasPrice returns the highest base fee the next block can have, so
+// that a transaction paying it is not rejected if it misses the current block.
+func (q queryServer) SuggestGasPrice(ctx context.Context, _ *types.QuerySuggestGasPriceRequest) (*types.QuerySuggestGasPriceResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := q.k.GetBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySuggestGasPriceResponse{
+		GasPrice: sdk.NewDecCoinFromDec(baseFee.Denom, params.MaxNextBaseFee(baseFee.Amount)),
+	}, nil
+}
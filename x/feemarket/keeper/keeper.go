@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService storetypes.KVStoreService
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// BaseFee is the base fee per unit of gas of the current block.
+	BaseFee collections.Item[math.LegacyDec]
+}
+
+// NewKeeper creates a new feemarket Keeper instance.
+func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService, authority string) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		authority:    authority,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BaseFee:      collections.NewItem(sb, types.BaseFeeKey, "base_fee", sdk.LegacyDecValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetBaseFee returns the base fee per unit of gas the transactions must pay,
+// in the fee denom. It is zero when the base fee is disabled.
+func (k Keeper) GetBaseFee(ctx context.Context) (sdk.DecCoin, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	if !params.Enabled {
+		return sdk.NewDecCoinFromDec(params.FeeDenom, math.LegacyZeroDec()), nil
+	}
+
+	baseFee, err := k.BaseFee.Get(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	return sdk.NewDecCoinFromDec(params.FeeDenom, baseFee), nil
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	txConfig    client.TxConfig
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feemarket.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeight(1)
+	s.txConfig = encCfg.TxConfig
+
+	s.keeper = keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+}
+
+// enable enables the base fee with a 0.1stake floor and a 1000 gas target.
+func (s *KeeperTestSuite) enable(baseFee math.LegacyDec) types.Params {
+	params := types.NewParams(true, "stake", math.LegacyNewDecWithPrec(1, 1), 1000, math.LegacyNewDecWithPrec(125, 3))
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.keeper.BaseFee.Set(s.ctx, baseFee))
+	return params
+}
+
+func (s *KeeperTestSuite) baseFee() math.LegacyDec {
+	baseFee, err := s.keeper.BaseFee.Get(s.ctx)
+	s.Require().NoError(err)
+	return baseFee
+}
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	// the base fee does not change while disabled
+	baseFee := s.baseFee()
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockGasUsed(100_000_000)))
+	s.Require().Equal(baseFee, s.baseFee())
+
+	s.enable(math.LegacyNewDec(1))
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockGasUsed(2000)))
+	s.Require().Equal(math.LegacyNewDecWithPrec(1125, 3), s.baseFee())
+
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockGasUsed(1000)))
+	s.Require().Equal(math.LegacyNewDecWithPrec(1125, 3), s.baseFee())
+
+	for i := 0; i < 100; i++ {
+		s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockGasUsed(0)))
+	}
+	s.Require().Equal(math.LegacyNewDecWithPrec(1, 1), s.baseFee())
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	authority := s.keeper.GetAuthority()
+	params := types.NewParams(true, "stake", math.LegacyNewDec(2), 1000, math.LegacyNewDecWithPrec(125, 3))
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: sdk.AccAddress("other").String(), Params: params})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	invalid := params
+	invalid.MinBaseFee = math.LegacyZeroDec()
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Params: invalid})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	// the base fee is raised to the new floor
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDec(2), s.baseFee())
+}
+
+func (s *KeeperTestSuite) TestQueries() {
+	res, err := s.queryServer.BaseFee(s.ctx, &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().True(res.BaseFee.IsZero())
+
+	s.enable(math.LegacyNewDec(2))
+
+	res, err = s.queryServer.BaseFee(s.ctx, &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoin("stake", math.NewInt(2)), res.BaseFee)
+
+	suggestion, err := s.queryServer.SuggestGasPrice(s.ctx, &types.QuerySuggestGasPriceRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(225, 2)), suggestion.GasPrice)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	params := types.NewParams(true, "stake", math.LegacyNewDecWithPrec(1, 1), 1000, math.LegacyNewDecWithPrec(125, 3))
+	genState := types.NewGenesisState(params, math.LegacyNewDecWithPrec(3, 1))
+	s.Require().NoError(genState.Validate())
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, genState))
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(genState.Params, exported.Params)
+	s.Require().Equal(genState.BaseFee.String(), exported.BaseFee.String())
+
+	// the base fee defaults to the floor
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, types.NewGenesisState(params, math.LegacyDec{})))
+	s.Require().Equal(params.MinBaseFee, s.baseFee())
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the x/feemarket MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams updates the params. The base fee is raised to the new min base
+// fee if it is below it.
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, ms.authority, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	baseFee, err := ms.BaseFee.Get(goCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if baseFee.LT(msg.Params.MinBaseFee) {
+		if err := ms.BaseFee.Set(goCtx, msg.Params.MinBaseFee); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/feemarket module sentinel errors
+var (
+	ErrInvalidParams  = errors.Register(ModuleName, 2, "invalid params")
+	ErrInvalidGenesis = errors.Register(ModuleName, 3, "invalid genesis state")
+)
@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, baseFee math.LegacyDec) *GenesisState {
+	return &GenesisState{
+		Params:  params,
+		BaseFee: baseFee,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, where the base fee is
+// the min base fee.
+func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
+	return NewGenesisState(params, params.MinBaseFee)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if !gs.BaseFee.IsNil() && gs.BaseFee.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "base fee must be non-negative, got %s", gs.BaseFee)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the key of the module params.
+	ParamsKey = collections.NewPrefix(0)
+	// BaseFeeKey is the key of the current base fee.
+	BaseFeeKey = collections.NewPrefix(1)
+)
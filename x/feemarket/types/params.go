@@ -0,0 +1,83 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(enabled bool, feeDenom string, minBaseFee math.LegacyDec, targetBlockGas uint64, maxChangeRate math.LegacyDec) Params {
+	return Params{
+		Enabled:        enabled,
+		FeeDenom:       feeDenom,
+		MinBaseFee:     minBaseFee,
+		TargetBlockGas: targetBlockGas,
+		MaxChangeRate:  maxChangeRate,
+	}
+}
+
+// DefaultParams returns the default feemarket params. The base fee is
+// disabled, and once enabled it changes by at most 12.5% per block, as in
+// EIP-1559.
+func DefaultParams() Params {
+	return NewParams(
+		false,
+		sdk.DefaultBondDenom,
+		math.LegacyNewDecWithPrec(1, 3),
+		15_000_000,
+		math.LegacyNewDecWithPrec(125, 3),
+	)
+}
+
+// Validate validates the params.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid fee denom: %s", err)
+	}
+
+	if p.MinBaseFee.IsNil() || p.MinBaseFee.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidParams, "min base fee must be non-negative, got %s", p.MinBaseFee)
+	}
+	// a zero base fee could never increase
+	if p.Enabled && !p.MinBaseFee.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidParams, "min base fee must be positive when the base fee is enabled")
+	}
+
+	if p.TargetBlockGas == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "target block gas must be positive")
+	}
+
+	if p.MaxChangeRate.IsNil() || !p.MaxChangeRate.IsPositive() || p.MaxChangeRate.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidParams, "max change rate must be in (0, 1], got %s", p.MaxChangeRate)
+	}
+
+	return nil
+}
+
+// NextBaseFee returns the base fee of the next block given the base fee and
+// the gas used by the current block. The base fee changes proportionally to
+// the distance of the gas used from the target, by at most the max change
+// rate, and never goes below the min base fee.
+func (p Params) NextBaseFee(baseFee math.LegacyDec, gasUsed uint64) math.LegacyDec {
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.TargetBlockGas))
+	delta := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed)).Sub(target).Quo(target)
+
+	// blocks using more than twice the target are capped to the max change rate
+	if delta.GT(math.LegacyOneDec()) {
+		delta = math.LegacyOneDec()
+	}
+
+	next := baseFee.Add(baseFee.Mul(p.MaxChangeRate).Mul(delta))
+	if next.LT(p.MinBaseFee) {
+		return p.MinBaseFee
+	}
+
+	return next
+}
+
+// MaxNextBaseFee returns the highest base fee the next block can have.
+func (p Params) MaxNextBaseFee(baseFee math.LegacyDec) math.LegacyDec {
+	return baseFee.Add(baseFee.Mul(p.MaxChangeRate))
+}
@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params func(p *types.Params)
+		expErr string
+	}{
+		{
+			name:   "default",
+			params: func(*types.Params) {},
+		},
+		{
+			name:   "enabled",
+			params: func(p *types.Params) { p.Enabled = true },
+		},
+		{
+			name:   "invalid fee denom",
+			params: func(p *types.Params) { p.FeeDenom = "" },
+			expErr: "invalid fee denom",
+		},
+		{
+			name:   "negative min base fee",
+			params: func(p *types.Params) { p.MinBaseFee = math.LegacyNewDec(-1) },
+			expErr: "min base fee must be non-negative",
+		},
+		{
+			name: "zero min base fee when enabled",
+			params: func(p *types.Params) {
+				p.Enabled = true
+				p.MinBaseFee = math.LegacyZeroDec()
+			},
+			expErr: "min base fee must be positive",
+		},
+		{
+			name:   "zero target block gas",
+			params: func(p *types.Params) { p.TargetBlockGas = 0 },
+			expErr: "target block gas must be positive",
+		},
+		{
+			name:   "zero max change rate",
+			params: func(p *types.Params) { p.MaxChangeRate = math.LegacyZeroDec() },
+			expErr: "max change rate must be in (0, 1]",
+		},
+		{
+			name:   "max change rate above one",
+			params: func(p *types.Params) { p.MaxChangeRate = math.LegacyNewDecWithPrec(11, 1) },
+			expErr: "max change rate must be in (0, 1]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.params(&params)
+
+			err := params.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.NewParams(true, "stake", math.LegacyNewDecWithPrec(1, 1), 1000, math.LegacyNewDecWithPrec(125, 3))
+	baseFee := math.LegacyNewDec(1)
+
+	testCases := []struct {
+		name    string
+		baseFee math.LegacyDec
+		gasUsed uint64
+		exp     math.LegacyDec
+	}{
+		{"target", baseFee, 1000, baseFee},
+		{"twice the target", baseFee, 2000, math.LegacyNewDecWithPrec(1125, 3)},
+		{"above twice the target", baseFee, 10_000, math.LegacyNewDecWithPrec(1125, 3)},
+		{"one and a half the target", baseFee, 1500, math.LegacyMustNewDecFromStr("1.0625")},
+		{"half the target", baseFee, 500, math.LegacyMustNewDecFromStr("0.9375")},
+		{"empty block", baseFee, 0, math.LegacyNewDecWithPrec(875, 3)},
+		{"floor", math.LegacyNewDecWithPrec(105, 3), 0, params.MinBaseFee},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp.String(), params.NextBaseFee(tc.baseFee, tc.gasUsed).String())
+		})
+	}
+
+	require.Equal(t, math.LegacyNewDecWithPrec(1125, 3).String(), params.MaxNextBaseFee(baseFee).String())
+}
@@ -39,6 +39,8 @@ func CommandsWithCustomMigrationMap(txConfig client.TxConfig, moduleBasics modul
 		MigrateGenesisCmd(migrationMap),
 		CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, defaultNodeHome, gentxModule.GenTxValidator, txConfig.SigningContext().ValidatorAddressCodec()),
 		ValidateGenesisCmd(moduleBasics),
+		SplitGenesisCmd(),
+		JoinGenesisCmd(),
 		AddGenesisAccountCmd(defaultNodeHome, txConfig.SigningContext().AddressCodec()),
 		AddBulkGenesisAccountCmd(defaultNodeHome, txConfig.SigningContext().AddressCodec()),
 	)
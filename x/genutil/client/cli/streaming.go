@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// SplitGenesisCmd converts a genesis file into a streaming genesis directory.
+func SplitGenesisCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "split [genesis-file] [output-dir]",
+		Short: "Convert a genesis file into a streaming genesis directory",
+		Long: fmt.Sprintf(`Convert a genesis file into a streaming genesis directory.
+The directory holds a %s file whose app state references the %s directory, where the state
+of each module is stored as one file per field. Array fields are stored as NDJSON files.`, types.StreamingGenesisFile, types.StreamingAppStateDir),
+		Example: "split genesis.json genesis",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := types.SplitGenesisFile(args[0], args[1]); err != nil {
+				return fmt.Errorf("failed to split genesis file %s: %w", args[0], err)
+			}
+
+			cmd.Printf("Genesis file %s split into %s\n", args[0], args[1])
+			return nil
+		},
+	}
+}
+
+// JoinGenesisCmd converts a streaming genesis directory into a genesis file.
+func JoinGenesisCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "join [genesis-dir] [output-file]",
+		Short:   "Convert a streaming genesis directory into a genesis file",
+		Example: "join genesis genesis.json",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := types.JoinGenesisDir(args[0], args[1]); err != nil {
+				return fmt.Errorf("failed to join genesis directory %s: %w", args[0], err)
+			}
+
+			cmd.Printf("Genesis directory %s joined into %s\n", args[0], args[1])
+			return nil
+		},
+	}
+}
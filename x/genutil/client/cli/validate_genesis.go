@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -24,6 +26,8 @@ func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 		Aliases: []string{"validate-genesis"},
 		Args:    cobra.RangeArgs(0, 1),
 		Short:   "Validates the genesis file at the default location or at the location passed as an arg",
+		Long: `Validates the genesis file at the default location or at the location passed as an arg.
+The location can also be a streaming genesis directory, whose modules state is validated one module at a time.`,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			serverCtx := server.GetServerContextFromCmd(cmd)
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -37,6 +41,9 @@ func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 			} else {
 				genesis = args[0]
 			}
+			if info, err := os.Stat(genesis); err == nil && info.IsDir() {
+				genesis = filepath.Join(genesis, types.StreamingGenesisFile)
+			}
 
 			appGenesis, err := types.AppGenesisFromFile(genesis)
 			if err != nil {
@@ -47,6 +54,15 @@ func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 				return fmt.Errorf("make sure that you have correctly migrated all CometBFT consensus params. Refer the UPGRADING.md (%s): %w", chainUpgradeGuide, err)
 			}
 
+			if dir, ok := types.ResolveStreamingAppState(appGenesis.AppState, filepath.Dir(genesis)); ok {
+				if err = mbm.ValidateGenesisStream(cdc, clientCtx.TxConfig, types.NewStreamingGenesis(dir)); err != nil {
+					return validateGenesisError(genesis, err)
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "File at %s is a valid streaming genesis file\n", genesis)
+				return nil
+			}
+
 			var genState map[string]json.RawMessage
 			if err := json.Unmarshal(appGenesis.AppState, &genState); err != nil {
 				if strings.Contains(err.Error(), "unexpected end of JSON input") {
@@ -56,11 +72,7 @@ func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 			}
 
 			if err = mbm.ValidateGenesis(cdc, clientCtx.TxConfig, genState); err != nil {
-				errStr := fmt.Sprintf("error validating genesis file %s: %s", genesis, err.Error())
-				if errors.Is(err, io.EOF) {
-					errStr = fmt.Sprintf("%s: section is missing in the app_state", errStr)
-				}
-				return fmt.Errorf("%s", errStr)
+				return validateGenesisError(genesis, err)
 			}
 
 			fmt.Fprintf(cmd.OutOrStdout(), "File at %s is a valid genesis file\n", genesis)
@@ -69,6 +81,14 @@ func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 	}
 }
 
+func validateGenesisError(genesis string, err error) error {
+	errStr := fmt.Sprintf("error validating genesis file %s: %s", genesis, err.Error())
+	if errors.Is(err, io.EOF) {
+		errStr = fmt.Sprintf("%s: section is missing in the app_state", errStr)
+	}
+	return fmt.Errorf("%s", errStr)
+}
+
 func enrichUnmarshalError(err error) error {
 	var syntaxErr *json.SyntaxError
 	if errors.As(err, &syntaxErr) {
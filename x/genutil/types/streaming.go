@@ -0,0 +1,679 @@
+package types
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+const (
+	// StreamingGenesisFile is the name of the genesis file of a streaming
+	// genesis directory. Its app state references the app state directory.
+	StreamingGenesisFile = "genesis.json"
+
+	// StreamingAppStateDir is the name of the app state directory of a streaming
+	// genesis directory.
+	StreamingAppStateDir = "app_state"
+
+	// streamingGenesisKey is the key of the app state of a genesis file whose
+	// modules state is stored in an app state directory.
+	streamingGenesisKey = "streaming_genesis"
+
+	jsonExt   = ".json"
+	ndjsonExt = ".ndjson"
+)
+
+var (
+	_ module.GenesisReader = StreamingGenesis{}
+	_ module.GenesisWriter = StreamingGenesis{}
+)
+
+// streamingAppState is the app state of a genesis file referencing an app
+// state directory.
+type streamingAppState struct {
+	StreamingGenesis struct {
+		Dir string `json:"dir"`
+	} `json:"streaming_genesis"`
+}
+
+// NewStreamingAppState returns the app state of a genesis file referencing
+// the app state directory. A relative directory is relative to the directory
+// of the genesis file.
+func NewStreamingAppState(dir string) json.RawMessage {
+	var appState streamingAppState
+	appState.StreamingGenesis.Dir = dir
+
+	bz, err := json.Marshal(appState)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ResolveStreamingAppState returns the app state directory referenced by the app
+// state of a genesis file, resolving a relative directory against the
+// directory of the genesis file. It returns false when the app state does not
+// reference an app state directory.
+func ResolveStreamingAppState(appState json.RawMessage, genesisDir string) (string, bool) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &fields); err != nil || len(fields) != 1 || fields[streamingGenesisKey] == nil {
+		return "", false
+	}
+
+	var ref streamingAppState
+	if err := json.Unmarshal(appState, &ref); err != nil || ref.StreamingGenesis.Dir == "" {
+		return "", false
+	}
+
+	dir := ref.StreamingGenesis.Dir
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(genesisDir, dir)
+	}
+	return dir, true
+}
+
+// StreamingGenesis is the app state of a genesis stored in a directory, with a
+// sub-directory per module and a file per field of the module genesis state.
+// JSON array fields are stored as newline delimited JSON files (.ndjson) with
+// an element per line, and other fields as JSON files (.json). The state of
+// the modules is read and written one field, or one array element, at a time.
+type StreamingGenesis struct {
+	dir string
+}
+
+// NewStreamingGenesis returns the streaming genesis stored in the app state
+// directory.
+func NewStreamingGenesis(dir string) StreamingGenesis {
+	return StreamingGenesis{dir: dir}
+}
+
+// Modules returns the names of the modules with a state, in lexical order.
+func (sg StreamingGenesis) Modules() ([]string, error) {
+	entries, err := os.ReadDir(sg.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			modules = append(modules, entry.Name())
+		}
+	}
+	return modules, nil
+}
+
+// fields returns the fields of the module state in lexical order, along with
+// their file, or nil when there is no state for the module.
+func (sg StreamingGenesis) fields(moduleName string) ([]string, map[string]string, error) {
+	entries, err := os.ReadDir(filepath.Join(sg.dir, moduleName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	var fields []string
+	files := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		ext := filepath.Ext(name)
+		if entry.IsDir() || (ext != jsonExt && ext != ndjsonExt) {
+			continue
+		}
+
+		field := strings.TrimSuffix(name, ext)
+		if _, ok := files[field]; ok {
+			return nil, nil, fmt.Errorf("field %s of module %s is stored twice", field, moduleName)
+		}
+		fields = append(fields, field)
+		files[field] = filepath.Join(sg.dir, moduleName, name)
+	}
+	slices.Sort(fields)
+
+	return fields, files, nil
+}
+
+// ModuleSource implements module.GenesisReader.
+func (sg StreamingGenesis) ModuleSource(moduleName string) (appmodule.GenesisSource, error) {
+	fields, files, err := sg.fields(moduleName)
+	if err != nil || fields == nil && files == nil {
+		return nil, err
+	}
+
+	return func(field string) (io.ReadCloser, error) {
+		file, ok := files[field]
+		if !ok {
+			return nil, nil
+		}
+		return openField(file)
+	}, nil
+}
+
+// ModuleJSON implements module.GenesisReader.
+func (sg StreamingGenesis) ModuleJSON(moduleName string) (json.RawMessage, error) {
+	fields, files, err := sg.fields(moduleName)
+	if err != nil || fields == nil && files == nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := writeModule(&buf, fields, files); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ModuleTarget implements module.GenesisWriter.
+func (sg StreamingGenesis) ModuleTarget(moduleName string) (appmodule.GenesisTarget, error) {
+	if !isFileName(moduleName) {
+		return nil, fmt.Errorf("invalid genesis module name %q", moduleName)
+	}
+
+	dir := filepath.Join(sg.dir, moduleName)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return func(field string) (io.WriteCloser, error) {
+		if !isFileName(field) {
+			return nil, fmt.Errorf("invalid genesis field name %q", field)
+		}
+		return newFieldWriter(filepath.Join(dir, field)), nil
+	}, nil
+}
+
+// WriteModuleJSON implements module.GenesisWriter.
+func (sg StreamingGenesis) WriteModuleJSON(moduleName string, bz json.RawMessage) error {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	return writeFields(decoder, func() (appmodule.GenesisTarget, error) {
+		return sg.ModuleTarget(moduleName)
+	})
+}
+
+// SplitGenesisFile converts the genesis file into a streaming genesis
+// directory, reading the app state one field at a time.
+func SplitGenesisFile(genFile, dir string) error {
+	file, err := os.Open(filepath.Clean(genFile))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(bufio.NewReader(file))
+	decoder.UseNumber()
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+
+	sg := NewStreamingGenesis(filepath.Join(dir, StreamingAppStateDir))
+	if err := os.MkdirAll(sg.dir, 0o755); err != nil {
+		return err
+	}
+
+	metadata := map[string]json.RawMessage{}
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		if key != "app_state" {
+			var value json.RawMessage
+			if err := decoder.Decode(&value); err != nil {
+				return err
+			}
+			metadata[key.(string)] = value
+			continue
+		}
+
+		if err := expectDelim(decoder, '{'); err != nil {
+			return fmt.Errorf("app_state: %w", err)
+		}
+		for decoder.More() {
+			moduleName, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+
+			err = writeFields(decoder, func() (appmodule.GenesisTarget, error) {
+				return sg.ModuleTarget(moduleName.(string))
+			})
+			if err != nil {
+				return fmt.Errorf("module %s: %w", moduleName, err)
+			}
+		}
+		if _, err := decoder.Token(); err != nil {
+			return err
+		}
+	}
+
+	bz, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	var appGenesis AppGenesis
+	if err := json.Unmarshal(bz, &appGenesis); err != nil {
+		return fmt.Errorf("error unmarshalling AppGenesis: %w", err)
+	}
+	appGenesis.AppState = NewStreamingAppState(StreamingAppStateDir)
+
+	return appGenesis.SaveAs(filepath.Join(dir, StreamingGenesisFile))
+}
+
+// JoinGenesisDir converts the streaming genesis directory into a genesis file,
+// writing the app state one field at a time.
+func JoinGenesisDir(dir, genFile string) error {
+	appGenesis, err := AppGenesisFromFile(filepath.Join(dir, StreamingGenesisFile))
+	if err != nil {
+		return err
+	}
+
+	appStateDir, ok := ResolveStreamingAppState(appGenesis.AppState, dir)
+	if !ok {
+		return fmt.Errorf("%s is not a streaming genesis directory", dir)
+	}
+	sg := NewStreamingGenesis(appStateDir)
+
+	modules, err := sg.Modules()
+	if err != nil {
+		return err
+	}
+
+	// the app state is written last, in place of the closing brace
+	appGenesis.AppState = nil
+	bz, err := json.Marshal(appGenesis)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(filepath.Clean(genFile), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	if _, err := w.Write(bz[:len(bz)-1]); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, `,"app_state":{`); err != nil {
+		return err
+	}
+
+	for i, moduleName := range modules {
+		fields, files, err := sg.fields(moduleName)
+		if err != nil {
+			return err
+		}
+
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if err := writeKey(w, moduleName); err != nil {
+			return err
+		}
+		if err := writeModule(w, fields, files); err != nil {
+			return err
+		}
+	}
+
+	if _, err := io.WriteString(w, "}}"); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// writeModule writes the module state JSON object made of the fields files.
+func writeModule(w io.Writer, fields []string, files map[string]string) error {
+	if _, err := io.WriteString(w, "{"); err != nil {
+		return err
+	}
+
+	for i, field := range fields {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if err := writeKey(w, field); err != nil {
+			return err
+		}
+
+		r, err := openField(files[field])
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(w, r)
+		if closeErr := r.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "}")
+	return err
+}
+
+// writeFields writes each field of the JSON object read by the decoder to the
+// genesis target returned by newTarget. A null object has no state, so that no
+// target is created for it.
+func writeFields(decoder *json.Decoder, newTarget func() (appmodule.GenesisTarget, error)) error {
+	token, err := decoder.Token()
+	if err != nil || token == nil {
+		return err
+	}
+	if token != json.Delim('{') {
+		return fmt.Errorf("expected { got %v", token)
+	}
+
+	target, err := newTarget()
+	if err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		field, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		w, err := target(field.(string))
+		if err != nil {
+			return err
+		}
+		if err := copyJSON(decoder, token, w); err != nil {
+			_ = w.Close()
+			return err
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+	}
+
+	_, err = decoder.Token()
+	return err
+}
+
+// isFileName returns true if the module or field name can be used as a file
+// name in the app state directory.
+func isFileName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
+
+func writeKey(w io.Writer, key string) error {
+	bz, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(bz); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, ":")
+	return err
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %s got %v", delim, token)
+	}
+	return nil
+}
+
+// copyJSON writes the JSON value starting with the token to the writer, one
+// token at a time.
+func copyJSON(decoder *json.Decoder, token json.Token, w io.Writer) error {
+	delim, ok := token.(json.Delim)
+	if !ok {
+		bz, err := marshalToken(token)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(bz)
+		return err
+	}
+
+	if _, err := io.WriteString(w, delim.String()); err != nil {
+		return err
+	}
+
+	first := true
+	for decoder.More() {
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		next, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		if delim == '{' {
+			if err := writeKey(w, next.(string)); err != nil {
+				return err
+			}
+			if next, err = decoder.Token(); err != nil {
+				return err
+			}
+		}
+
+		if err := copyJSON(decoder, next, w); err != nil {
+			return err
+		}
+	}
+
+	end, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, end.(json.Delim).String())
+	return err
+}
+
+// marshalToken marshals a JSON scalar token without escaping HTML characters.
+func marshalToken(token json.Token) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(token); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// openField opens the file of a field, converting newline delimited JSON
+// files to a JSON array.
+func openField(file string) (io.ReadCloser, error) {
+	f, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		return nil, err
+	}
+
+	if filepath.Ext(file) != ndjsonExt {
+		return f, nil
+	}
+	return &ndjsonArrayReader{file: f, reader: bufio.NewReader(f)}, nil
+}
+
+// ndjsonArrayReader reads a newline delimited JSON file as a JSON array.
+type ndjsonArrayReader struct {
+	file    *os.File
+	reader  *bufio.Reader
+	pending []byte
+	started bool
+	first   bool
+	done    bool
+}
+
+func (r *ndjsonArrayReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.next(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// next loads the next chunk of the JSON array into pending.
+func (r *ndjsonArrayReader) next() error {
+	if !r.started {
+		r.started, r.first = true, true
+		r.pending = []byte("[")
+		return nil
+	}
+
+	line, err := r.reader.ReadBytes('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	if errors.Is(err, io.EOF) && len(bytes.TrimSpace(line)) == 0 {
+		r.done = true
+		r.pending = []byte("]")
+		return nil
+	}
+
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return nil
+	}
+	if !r.first {
+		line = append([]byte(","), line...)
+	}
+	r.first = false
+	r.pending = line
+	return nil
+}
+
+func (r *ndjsonArrayReader) Close() error {
+	return r.file.Close()
+}
+
+// fieldWriter writes a field to its file, converting JSON arrays to newline
+// delimited JSON as they are written.
+type fieldWriter struct {
+	pw   *io.PipeWriter
+	done chan error
+}
+
+func newFieldWriter(path string) *fieldWriter {
+	pr, pw := io.Pipe()
+	w := &fieldWriter{pw: pw, done: make(chan error, 1)}
+
+	go func() {
+		err := writeField(pr, path)
+		// unblock the writer when the field could not be written
+		_ = pr.CloseWithError(err)
+		w.done <- err
+	}()
+
+	return w
+}
+
+func (w *fieldWriter) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+func (w *fieldWriter) Close() error {
+	if err := w.pw.Close(); err != nil {
+		return err
+	}
+	return <-w.done
+}
+
+// writeField reads the JSON value of a field from the reader and writes it to
+// its file.
+func writeField(r io.Reader, path string) (err error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	ext := jsonExt
+	if token == json.Delim('[') {
+		ext = ndjsonExt
+	}
+	// a field previously stored with the other format is replaced
+	for _, other := range []string{jsonExt, ndjsonExt} {
+		if err := os.Remove(path + other); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	file, err := os.OpenFile(path+ext, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	w := bufio.NewWriter(file)
+	if ext == jsonExt {
+		if err := copyJSON(decoder, token, w); err != nil {
+			return err
+		}
+		return w.Flush()
+	}
+
+	for decoder.More() {
+		var elem json.RawMessage
+		if err := decoder.Decode(&elem); err != nil {
+			return err
+		}
+
+		var line bytes.Buffer
+		if err := json.Compact(&line, elem); err != nil {
+			return err
+		}
+		line.WriteByte('\n')
+		if _, err := w.Write(line.Bytes()); err != nil {
+			return err
+		}
+	}
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+	return w.Flush()
+}
@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestStreamingGenesisSplitJoin(t *testing.T) {
+	dir := t.TempDir()
+	genFile := filepath.Join("testdata", "app_genesis.json")
+	require.NoError(t, types.SplitGenesisFile(genFile, dir))
+
+	// array fields are stored one element per line
+	bz, err := os.ReadFile(filepath.Join(dir, types.StreamingAppStateDir, "auth", "accounts.ndjson"))
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "\n")
+	require.FileExists(t, filepath.Join(dir, types.StreamingAppStateDir, "auth", "params.json"))
+
+	// the genesis file references the app state directory
+	appGenesis, err := types.AppGenesisFromFile(filepath.Join(dir, types.StreamingGenesisFile))
+	require.NoError(t, err)
+	appStateDir, ok := types.ResolveStreamingAppState(appGenesis.AppState, dir)
+	require.True(t, ok)
+	require.Equal(t, filepath.Join(dir, types.StreamingAppStateDir), appStateDir)
+
+	joined := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, types.JoinGenesisDir(dir, joined))
+
+	expected, err := types.AppGenesisFromFile(genFile)
+	require.NoError(t, err)
+	actual, err := types.AppGenesisFromFile(joined)
+	require.NoError(t, err)
+	// modules with a null state have no state, and are not stored
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(expected.AppState, &appState))
+	delete(appState, "consensus")
+	delete(appState, "params")
+	bz, err = json.Marshal(appState)
+	require.NoError(t, err)
+	require.JSONEq(t, string(bz), string(actual.AppState))
+	expected.AppState, actual.AppState = nil, nil
+	require.Equal(t, expected, actual)
+}
+
+func TestStreamingGenesisReadWrite(t *testing.T) {
+	sg := types.NewStreamingGenesis(t.TempDir())
+
+	require.NoError(t, sg.WriteModuleJSON("legacy", json.RawMessage(`{"params":{"enabled":true},"items":[{"id":"1"},{"id":"2"}],"empty":[]}`)))
+
+	target, err := sg.ModuleTarget("streamed")
+	require.NoError(t, err)
+	w, err := target("items")
+	require.NoError(t, err)
+	_, err = io.WriteString(w, `[{"id": "1"},`)
+	require.NoError(t, err)
+	_, err = io.WriteString(w, `{"id": "2"}]`)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	_, err = sg.ModuleTarget("../escape")
+	require.Error(t, err)
+	_, err = target("../escape")
+	require.Error(t, err)
+
+	modules, err := sg.Modules()
+	require.NoError(t, err)
+	require.Equal(t, []string{"legacy", "streamed"}, modules)
+
+	bz, err := sg.ModuleJSON("legacy")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"empty":[],"items":[{"id":"1"},{"id":"2"}],"params":{"enabled":true}}`, string(bz))
+
+	source, err := sg.ModuleSource("streamed")
+	require.NoError(t, err)
+	r, err := source("items")
+	require.NoError(t, err)
+	bz, err = io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.JSONEq(t, `[{"id":"1"},{"id":"2"}]`, string(bz))
+
+	r, err = source("missing")
+	require.NoError(t, err)
+	require.Nil(t, r)
+
+	source, err = sg.ModuleSource("missing")
+	require.NoError(t, err)
+	require.Nil(t, source)
+}
+
+func TestResolveStreamingAppState(t *testing.T) {
+	dir, ok := types.ResolveStreamingAppState(types.NewStreamingAppState(types.StreamingAppStateDir), "config")
+	require.True(t, ok)
+	require.Equal(t, filepath.Join("config", types.StreamingAppStateDir), dir)
+
+	dir, ok = types.ResolveStreamingAppState(types.NewStreamingAppState("/genesis/app_state"), "config")
+	require.True(t, ok)
+	require.Equal(t, "/genesis/app_state", dir)
+
+	_, ok = types.ResolveStreamingAppState(json.RawMessage(`{"bank":{}}`), "config")
+	require.False(t, ok)
+	_, ok = types.ResolveStreamingAppState(json.RawMessage(`{"streaming_genesis":{"dir":"app_state"},"bank":{}}`), "config")
+	require.False(t, ok)
+}
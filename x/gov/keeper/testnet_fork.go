@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// TestnetFork overrides the gov params of a testnet fork, e.g. to shorten the
+// voting period of the proposals submitted to rehearse an upgrade.
+func (k Keeper) TestnetFork(ctx context.Context, cdc codec.JSONCodec, spec *module.TestnetForkSpec) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := spec.ApplyParamsOverride(cdc, types.ModuleName, &params); err != nil {
+		return err
+	}
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, params)
+}
@@ -14,9 +14,12 @@ import (
 	reflect "reflect"
 
 	address "cosmossdk.io/core/address"
+	appmodule "cosmossdk.io/core/appmodule"
 	math "cosmossdk.io/math"
+	codec "github.com/cosmos/cosmos-sdk/codec"
 	types "github.com/cosmos/cosmos-sdk/store/v2/types"
 	types0 "github.com/cosmos/cosmos-sdk/types"
+	module "github.com/cosmos/cosmos-sdk/types/module"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	keeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -293,6 +296,23 @@ func (mr *MockBankKeeperMockRecorder) DeleteSendEnabled(ctx any, denoms ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).DeleteSendEnabled), varargs...)
 }
 
+// DeleteSendPolicies mocks base method.
+func (m *MockBankKeeper) DeleteSendPolicies(ctx context.Context, denoms ...string) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range denoms {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "DeleteSendPolicies", varargs...)
+}
+
+// DeleteSendPolicies indicates an expected call of DeleteSendPolicies.
+func (mr *MockBankKeeperMockRecorder) DeleteSendPolicies(ctx any, denoms ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, denoms...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSendPolicies", reflect.TypeOf((*MockBankKeeper)(nil).DeleteSendPolicies), varargs...)
+}
+
 // DenomMetadata mocks base method.
 func (m *MockBankKeeper) DenomMetadata(arg0 context.Context, arg1 *types1.QueryDenomMetadataRequest) (*types1.QueryDenomMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -382,6 +402,20 @@ func (mr *MockBankKeeperMockRecorder) ExportGenesis(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportGenesis", reflect.TypeOf((*MockBankKeeper)(nil).ExportGenesis), arg0)
 }
 
+// ExportGenesisStream mocks base method.
+func (m *MockBankKeeper) ExportGenesisStream(arg0 context.Context, arg1 codec.JSONCodec, arg2 appmodule.GenesisTarget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportGenesisStream", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportGenesisStream indicates an expected call of ExportGenesisStream.
+func (mr *MockBankKeeperMockRecorder) ExportGenesisStream(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportGenesisStream", reflect.TypeOf((*MockBankKeeper)(nil).ExportGenesisStream), arg0, arg1, arg2)
+}
+
 // GetAccountsBalances mocks base method.
 func (m *MockBankKeeper) GetAccountsBalances(ctx context.Context) []types1.Balance {
 	m.ctrl.T.Helper()
@@ -438,6 +472,20 @@ func (mr *MockBankKeeperMockRecorder) GetAllSendEnabledEntries(ctx any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSendEnabledEntries", reflect.TypeOf((*MockBankKeeper)(nil).GetAllSendEnabledEntries), ctx)
 }
 
+// GetAllSendPolicies mocks base method.
+func (m *MockBankKeeper) GetAllSendPolicies(ctx context.Context) []types1.SendPolicy {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSendPolicies", ctx)
+	ret0, _ := ret[0].([]types1.SendPolicy)
+	return ret0
+}
+
+// GetAllSendPolicies indicates an expected call of GetAllSendPolicies.
+func (mr *MockBankKeeperMockRecorder) GetAllSendPolicies(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSendPolicies", reflect.TypeOf((*MockBankKeeper)(nil).GetAllSendPolicies), ctx)
+}
+
 // GetAuthority mocks base method.
 func (m *MockBankKeeper) GetAuthority() string {
 	m.ctrl.T.Helper()
@@ -540,6 +588,21 @@ func (mr *MockBankKeeperMockRecorder) GetSendEnabledEntry(ctx, denom any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSendEnabledEntry", reflect.TypeOf((*MockBankKeeper)(nil).GetSendEnabledEntry), ctx, denom)
 }
 
+// GetSendPolicy mocks base method.
+func (m *MockBankKeeper) GetSendPolicy(ctx context.Context, denom string) (types1.SendPolicy, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSendPolicy", ctx, denom)
+	ret0, _ := ret[0].(types1.SendPolicy)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSendPolicy indicates an expected call of GetSendPolicy.
+func (mr *MockBankKeeperMockRecorder) GetSendPolicy(ctx, denom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSendPolicy", reflect.TypeOf((*MockBankKeeper)(nil).GetSendPolicy), ctx, denom)
+}
+
 // GetSupply mocks base method.
 func (m *MockBankKeeper) GetSupply(ctx context.Context, denom string) types0.Coin {
 	m.ctrl.T.Helper()
@@ -608,6 +671,20 @@ func (mr *MockBankKeeperMockRecorder) InitGenesis(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitGenesis", reflect.TypeOf((*MockBankKeeper)(nil).InitGenesis), arg0, arg1)
 }
 
+// InitGenesisStream mocks base method.
+func (m *MockBankKeeper) InitGenesisStream(arg0 context.Context, arg1 codec.JSONCodec, arg2 appmodule.GenesisSource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitGenesisStream", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InitGenesisStream indicates an expected call of InitGenesisStream.
+func (mr *MockBankKeeperMockRecorder) InitGenesisStream(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitGenesisStream", reflect.TypeOf((*MockBankKeeper)(nil).InitGenesisStream), arg0, arg1, arg2)
+}
+
 // InputOutputCoins mocks base method.
 func (m *MockBankKeeper) InputOutputCoins(ctx context.Context, input types1.Input, outputs []types1.Output) error {
 	m.ctrl.T.Helper()
@@ -911,6 +988,21 @@ func (mr *MockBankKeeperMockRecorder) SendEnabled(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SendEnabled), arg0, arg1)
 }
 
+// SendPolicies mocks base method.
+func (m *MockBankKeeper) SendPolicies(arg0 context.Context, arg1 *types1.QuerySendPoliciesRequest) (*types1.QuerySendPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPolicies", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QuerySendPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendPolicies indicates an expected call of SendPolicies.
+func (mr *MockBankKeeperMockRecorder) SendPolicies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPolicies", reflect.TypeOf((*MockBankKeeper)(nil).SendPolicies), arg0, arg1)
+}
+
 // SetAllSendEnabled mocks base method.
 func (m *MockBankKeeper) SetAllSendEnabled(ctx context.Context, sendEnableds []*types1.SendEnabled) {
 	m.ctrl.T.Helper()
@@ -961,6 +1053,20 @@ func (mr *MockBankKeeperMockRecorder) SetSendEnabled(ctx, denom, value any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetSendEnabled), ctx, denom, value)
 }
 
+// SetSendPolicy mocks base method.
+func (m *MockBankKeeper) SetSendPolicy(ctx context.Context, policy types1.SendPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSendPolicy", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSendPolicy indicates an expected call of SetSendPolicy.
+func (mr *MockBankKeeperMockRecorder) SetSendPolicy(ctx, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSendPolicy", reflect.TypeOf((*MockBankKeeper)(nil).SetSendPolicy), ctx, policy)
+}
+
 // SpendableBalanceByDenom mocks base method.
 func (m *MockBankKeeper) SpendableBalanceByDenom(arg0 context.Context, arg1 *types1.QuerySpendableBalanceByDenomRequest) (*types1.QuerySpendableBalanceByDenomResponse, error) {
 	m.ctrl.T.Helper()
@@ -1034,6 +1140,20 @@ func (mr *MockBankKeeperMockRecorder) SupplyOf(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupplyOf", reflect.TypeOf((*MockBankKeeper)(nil).SupplyOf), arg0, arg1)
 }
 
+// TestnetFork mocks base method.
+func (m *MockBankKeeper) TestnetFork(arg0 context.Context, arg1 codec.JSONCodec, arg2 *module.TestnetForkSpec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestnetFork", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TestnetFork indicates an expected call of TestnetFork.
+func (mr *MockBankKeeperMockRecorder) TestnetFork(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestnetFork", reflect.TypeOf((*MockBankKeeper)(nil).TestnetFork), arg0, arg1, arg2)
+}
+
 // TotalSupply mocks base method.
 func (m *MockBankKeeper) TotalSupply(arg0 context.Context, arg1 *types1.QueryTotalSupplyRequest) (*types1.QueryTotalSupplyResponse, error) {
 	m.ctrl.T.Helper()
@@ -29,6 +29,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "annual-provisions",
 					Short:     "Query the current minting annual provisions value",
 				},
+				{
+					RpcMethod: "EpochMintParams",
+					Use:       "epoch-mint-params",
+					Short:     "Query the current epoch-driven minting parameters",
+				},
+				{
+					RpcMethod: "EpochProvisions",
+					Use:       "epoch-provisions",
+					Short:     "Query the current epoch-driven minting provisions value",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -43,6 +53,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "UpdateEpochMintParams",
+					Use:            "update-epoch-mint-params-proposal [params]",
+					Short:          "Submit a proposal to update mint module epoch minting params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update mint module epoch minting params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query mint epoch-mint-params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx mint update-epoch-mint-params-proposal '{ "epoch_identifier": "day", ... }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
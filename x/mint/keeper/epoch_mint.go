@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// EpochMintFn is the MintFn used with epoch-driven minting. It does not mint
+// anything in the begin blocker, as coins are minted at the end of each epoch
+// by the keeper's EpochHooks.
+func EpochMintFn(_ sdk.Context, _ *Keeper) error {
+	return nil
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks is a wrapper struct that implements the x/epochs hooks for
+// epoch-driven minting.
+type EpochHooks struct {
+	k Keeper
+}
+
+// EpochHooks returns the x/epochs hooks minting coins at the end of each epoch.
+// The hooks are a no-op unless the keeper was created with WithEpochMinting.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// AfterEpochEnd mints the epoch provisions at the end of the configured epoch.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	if !h.k.epochMinting {
+		return nil
+	}
+
+	return h.k.MintEpochProvisions(ctx, epochIdentifier, epochNumber)
+}
+
+// BeforeEpochStart is a no-op for epoch-driven minting.
+func (h EpochHooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// MintEpochProvisions mints the epoch provisions for the given epoch and
+// distributes them to the module accounts defined in the epoch mint params.
+// Nothing is minted if the epoch identifier does not match the params, or if
+// the epoch is before the minting start epoch.
+func (k Keeper) MintEpochProvisions(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	epochParams, err := k.EpochMintParams.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if epochIdentifier != epochParams.EpochIdentifier || epochNumber < epochParams.MintingStartEpoch {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	minter, err := k.EpochMinter.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// reduce the epoch provisions if a reduction period has elapsed
+	minter = minter.NextEpochMinter(epochParams, epochNumber)
+	if err := k.EpochMinter.Set(ctx, minter); err != nil {
+		return err
+	}
+
+	// calculate minted coins
+	mintedCoin := k.capToMaxSupply(ctx, params, minter.EpochProvision(params))
+
+	// mint coins, will skip zero coin automatically
+	mintedCoins := sdk.NewCoins(mintedCoin)
+	if err := k.MintCoins(ctx, mintedCoins); err != nil {
+		return err
+	}
+
+	if err := k.distributeEpochProvisions(ctx, epochParams, mintedCoin); err != nil {
+		return err
+	}
+
+	if mintedCoin.Amount.IsInt64() {
+		defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "epoch_minted_tokens") //nolint:staticcheck // TODO: switch to OpenTelemetry
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochMint,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, sdkmath.NewInt(epochNumber).String()),
+			sdk.NewAttribute(types.AttributeKeyEpochProvisions, minter.EpochProvisions.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// distributeEpochProvisions sends the minted coin to the module accounts
+// according to the distribution proportions. Any remainder left by rounding is
+// sent to the fee collector.
+func (k Keeper) distributeEpochProvisions(ctx context.Context, epochParams types.EpochMintParams, mintedCoin sdk.Coin) error {
+	if mintedCoin.IsZero() {
+		return nil
+	}
+
+	remaining := mintedCoin.Amount
+	for _, d := range epochParams.DistributionProportions {
+		amount := d.Proportion.MulInt(mintedCoin.Amount).TruncateInt()
+		if amount.IsZero() {
+			continue
+		}
+
+		// bank panics on unknown module accounts, which would not be
+		// recovered by the epoch hooks
+		if k.accountKeeper.GetModuleAddress(d.ModuleName) == nil {
+			return types.ErrUnknownModuleAccount.Wrapf("distribution recipient %s", d.ModuleName)
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, amount))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, d.ModuleName, coins); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(amount)
+	}
+
+	if remaining.IsPositive() {
+		return k.AddCollectedFees(ctx, sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, remaining)))
+	}
+
+	return nil
+}
+
+// validateEpochMintParams checks that the epoch mint params are valid and, when
+// epoch-driven minting is enabled, that every distribution recipient is a
+// registered module account. Chains minting per block may not register the
+// recipients of the default params.
+func (k Keeper) validateEpochMintParams(params types.EpochMintParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if !k.epochMinting {
+		return nil
+	}
+
+	for _, d := range params.DistributionProportions {
+		if k.accountKeeper.GetModuleAddress(d.ModuleName) == nil {
+			return types.ErrUnknownModuleAccount.Wrapf("distribution recipient %s", d.ModuleName)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	minttestutil "github.com/cosmos/cosmos-sdk/x/mint/testutil"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+const poolModuleName = "protocolpool"
+
+// EpochMintTestSuite defines the test suite for epoch-driven minting.
+type EpochMintTestSuite struct {
+	suite.Suite
+
+	mintKeeper    keeper.Keeper
+	ctx           sdk.Context
+	accountKeeper *minttestutil.MockAccountKeeper
+	bankKeeper    *minttestutil.MockBankKeeper
+}
+
+func TestEpochMintTestSuite(t *testing.T) {
+	suite.Run(t, new(EpochMintTestSuite))
+}
+
+func (s *EpochMintTestSuite) SetupTest() {
+	s.mintKeeper = s.newKeeper(keeper.WithEpochMinting())
+}
+
+func (s *EpochMintTestSuite) newKeeper(opts ...keeper.InitOption) keeper.Keeper {
+	encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	s.ctx = testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	ctrl := gomock.NewController(s.T())
+	s.accountKeeper = minttestutil.NewMockAccountKeeper(ctrl)
+	s.bankKeeper = minttestutil.NewMockBankKeeper(ctrl)
+	s.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{}).AnyTimes()
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		minttestutil.NewMockStakingKeeper(ctrl),
+		s.accountKeeper,
+		s.bankKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		opts...,
+	)
+
+	params := types.DefaultEpochMintParams()
+	params.GenesisEpochProvisions = math.LegacyNewDec(1000)
+	params.ReductionPeriodInEpochs = 2
+	params.MintingStartEpoch = 2
+	params.DistributionProportions = []types.MintDistribution{
+		{ModuleName: authtypes.FeeCollectorName, Proportion: math.LegacyNewDecWithPrec(667, 3)},
+		{ModuleName: poolModuleName, Proportion: math.LegacyNewDecWithPrec(333, 3)},
+	}
+
+	s.Require().NoError(k.Params.Set(s.ctx, types.DefaultParams()))
+	s.Require().NoError(k.Minter.Set(s.ctx, types.DefaultInitialMinter()))
+	s.Require().NoError(k.EpochMintParams.Set(s.ctx, params))
+	s.Require().NoError(k.EpochMinter.Set(s.ctx, types.InitialEpochMinter()))
+
+	return k
+}
+
+func (s *EpochMintTestSuite) expectRecipients() {
+	s.accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(authtypes.NewModuleAddress(authtypes.FeeCollectorName)).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress(poolModuleName).Return(authtypes.NewModuleAddress(poolModuleName)).AnyTimes()
+}
+
+func (s *EpochMintTestSuite) expectMint(amount, toFeeCollector, toPool int64) {
+	s.expectRecipients()
+	s.bankKeeper.EXPECT().GetSupply(s.ctx, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0))
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, toFeeCollector))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, poolModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, toPool))).Return(nil)
+}
+
+func (s *EpochMintTestSuite) TestEpochMintFnSkipsBlockMinting() {
+	// no bank keeper calls are expected
+	s.Require().NoError(s.mintKeeper.MintFn(s.ctx))
+}
+
+func (s *EpochMintTestSuite) TestAfterEpochEnd() {
+	hooks := s.mintKeeper.EpochHooks()
+
+	// other epoch identifiers and epochs before the start epoch are ignored
+	s.Require().NoError(hooks.AfterEpochEnd(s.ctx, "week", 2))
+	s.Require().NoError(hooks.AfterEpochEnd(s.ctx, "day", 1))
+
+	// first mint uses the genesis epoch provisions, split between the modules
+	s.expectMint(1000, 667, 333)
+	s.Require().NoError(hooks.AfterEpochEnd(s.ctx, "day", 2))
+
+	minter, err := s.mintKeeper.EpochMinter.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewEpochMinter(math.LegacyNewDec(1000), 2), minter)
+
+	s.expectMint(1000, 667, 333)
+	s.Require().NoError(hooks.AfterEpochEnd(s.ctx, "day", 3))
+
+	// provisions are halved after the reduction period, rounding remainder goes to the fee collector
+	s.bankKeeper.EXPECT().GetSupply(s.ctx, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0))
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 333))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, poolModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 166))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))).Return(nil)
+	s.Require().NoError(hooks.AfterEpochEnd(s.ctx, "day", 4))
+
+	minter, err = s.mintKeeper.EpochMinter.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewEpochMinter(math.LegacyNewDec(500), 4), minter)
+
+	events := s.ctx.EventManager().Events()
+	s.Require().True(slices.ContainsFunc(events, func(event sdk.Event) bool {
+		return event.Type == types.EventTypeEpochMint
+	}), "expected an epoch mint event to be emitted")
+}
+
+func (s *EpochMintTestSuite) TestAfterEpochEndMaxSupply() {
+	params := types.DefaultParams()
+	params.MaxSupply = math.NewInt(10_300)
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	s.expectRecipients()
+	s.bankKeeper.EXPECT().GetSupply(s.ctx, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000))
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, poolModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 99))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))).Return(nil)
+
+	s.Require().NoError(s.mintKeeper.EpochHooks().AfterEpochEnd(s.ctx, "day", 2))
+}
+
+func (s *EpochMintTestSuite) TestAfterEpochEndUnknownRecipient() {
+	s.accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	s.accountKeeper.EXPECT().GetModuleAddress(poolModuleName).Return(nil)
+	s.bankKeeper.EXPECT().GetSupply(s.ctx, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0))
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 667))).Return(nil)
+
+	err := s.mintKeeper.EpochHooks().AfterEpochEnd(s.ctx, "day", 2)
+	s.Require().ErrorIs(err, types.ErrUnknownModuleAccount)
+}
+
+func (s *EpochMintTestSuite) TestEpochMintingWithMintFnPanics() {
+	s.Require().Panics(func() {
+		s.newKeeper(keeper.WithEpochMinting(), keeper.WithMintFn(keeper.DefaultMintFn(types.DefaultInflationCalculationFn)))
+	})
+}
+
+func (s *EpochMintTestSuite) TestHooksDisabledWithoutEpochMinting() {
+	s.mintKeeper = s.newKeeper()
+
+	// no bank keeper calls are expected
+	s.Require().NoError(s.mintKeeper.EpochHooks().AfterEpochEnd(s.ctx, "day", 2))
+
+	minter, err := s.mintKeeper.EpochMinter.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().False(minter.IsStarted())
+}
+
+func (s *EpochMintTestSuite) TestUpdateEpochMintParams() {
+	msgServer := keeper.NewMsgServerImpl(s.mintKeeper)
+
+	params := types.DefaultEpochMintParams()
+	params.DistributionProportions = []types.MintDistribution{
+		{ModuleName: authtypes.FeeCollectorName, Proportion: math.LegacyNewDecWithPrec(5, 1)},
+		{ModuleName: poolModuleName, Proportion: math.LegacyNewDecWithPrec(5, 1)},
+	}
+
+	_, err := msgServer.UpdateEpochMintParams(s.ctx, &types.MsgUpdateEpochMintParams{
+		Authority: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+		Params:    params,
+	})
+	s.Require().Error(err)
+
+	invalid := params
+	invalid.ReductionPeriodInEpochs = 0
+	_, err = msgServer.UpdateEpochMintParams(s.ctx, &types.MsgUpdateEpochMintParams{
+		Authority: s.mintKeeper.GetAuthority(),
+		Params:    invalid,
+	})
+	s.Require().ErrorContains(err, "reduction period")
+
+	s.accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	s.accountKeeper.EXPECT().GetModuleAddress(poolModuleName).Return(nil)
+	_, err = msgServer.UpdateEpochMintParams(s.ctx, &types.MsgUpdateEpochMintParams{
+		Authority: s.mintKeeper.GetAuthority(),
+		Params:    params,
+	})
+	s.Require().ErrorIs(err, types.ErrUnknownModuleAccount)
+
+	s.accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	s.accountKeeper.EXPECT().GetModuleAddress(poolModuleName).Return(authtypes.NewModuleAddress(poolModuleName))
+	_, err = msgServer.UpdateEpochMintParams(s.ctx, &types.MsgUpdateEpochMintParams{
+		Authority: s.mintKeeper.GetAuthority(),
+		Params:    params,
+	})
+	s.Require().NoError(err)
+
+	stored, err := s.mintKeeper.EpochMintParams.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params, stored)
+}
+
+func (s *EpochMintTestSuite) TestEpochMintQueries() {
+	queryServer := keeper.NewQueryServerImpl(s.mintKeeper)
+
+	paramsRes, err := queryServer.EpochMintParams(s.ctx, &types.QueryEpochMintParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal("day", paramsRes.Params.EpochIdentifier)
+
+	s.Require().NoError(s.mintKeeper.EpochMinter.Set(s.ctx, types.NewEpochMinter(math.LegacyNewDec(42), 3)))
+	provisionsRes, err := queryServer.EpochProvisions(s.ctx, &types.QueryEpochProvisionsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDec(42), provisionsRes.EpochProvisions)
+}
@@ -15,6 +15,25 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *
 		panic(err)
 	}
 
+	epochMintParams := types.DefaultEpochMintParams()
+	if data.EpochMintParams != nil {
+		epochMintParams = *data.EpochMintParams
+	}
+	if err := keeper.validateEpochMintParams(epochMintParams); err != nil {
+		panic(err)
+	}
+	if err := keeper.EpochMintParams.Set(ctx, epochMintParams); err != nil {
+		panic(err)
+	}
+
+	epochMinter := types.InitialEpochMinter()
+	if data.EpochMinter != nil {
+		epochMinter = *data.EpochMinter
+	}
+	if err := keeper.EpochMinter.Set(ctx, epochMinter); err != nil {
+		panic(err)
+	}
+
 	ak.GetModuleAccount(ctx, types.ModuleName)
 }
 
@@ -30,5 +49,19 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(minter, params)
+	epochMintParams, err := keeper.EpochMintParams.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	epochMinter, err := keeper.EpochMinter.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(minter, params)
+	genState.EpochMintParams = &epochMintParams
+	genState.EpochMinter = &epochMinter
+
+	return genState
 }
@@ -54,7 +54,9 @@ func (s *GenesisTestSuite) SetupTest() {
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
 	s.accountKeeper = accountKeeper
 	accountKeeper.EXPECT().GetModuleAddress(minterAcc.Name).Return(minterAcc.GetAddress())
-	accountKeeper.EXPECT().GetModuleAccount(s.sdkCtx, minterAcc.Name).Return(minterAcc)
+	accountKeeper.EXPECT().GetModuleAccount(s.sdkCtx, minterAcc.Name).Return(minterAcc).AnyTimes()
+	accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(authtypes.NewModuleAddress(authtypes.FeeCollectorName)).AnyTimes()
+	accountKeeper.EXPECT().GetModuleAddress("unknown").Return(nil).AnyTimes()
 
 	s.keeper = keeper.NewKeeper(s.cdc, runtime.NewKVStoreService(key), stakingKeeper, accountKeeper, bankKeeper, "", "")
 }
@@ -89,3 +91,23 @@ func (s *GenesisTestSuite) TestImportExportGenesis() {
 	genesisState2 := s.keeper.ExportGenesis(s.sdkCtx)
 	s.Require().Equal(genesisState, genesisState2)
 }
+
+func (s *GenesisTestSuite) TestInitGenesisUnknownEpochRecipient() {
+	genesisState := types.DefaultGenesisState()
+	epochMintParams := types.DefaultEpochMintParams()
+	epochMintParams.DistributionProportions = []types.MintDistribution{
+		{ModuleName: "unknown", Proportion: math.LegacyOneDec()},
+	}
+	genesisState.EpochMintParams = &epochMintParams
+
+	// the recipients are only checked with epoch-driven minting
+	s.Require().NotPanics(func() {
+		s.keeper.InitGenesis(s.sdkCtx, s.accountKeeper, genesisState)
+	})
+
+	s.accountKeeper.(*minttestutil.MockAccountKeeper).EXPECT().GetModuleAddress(minterAcc.Name).Return(minterAcc.GetAddress())
+	epochKeeper := keeper.NewKeeper(s.cdc, runtime.NewKVStoreService(s.key), nil, s.accountKeeper, nil, "", "", keeper.WithEpochMinting())
+	s.Require().Panics(func() {
+		epochKeeper.InitGenesis(s.sdkCtx, s.accountKeeper, genesisState)
+	})
+}
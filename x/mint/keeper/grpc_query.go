@@ -45,3 +45,23 @@ func (q queryServer) AnnualProvisions(ctx context.Context, _ *types.QueryAnnualP
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// EpochMintParams returns the epoch-driven minting params of the mint module.
+func (q queryServer) EpochMintParams(ctx context.Context, _ *types.QueryEpochMintParamsRequest) (*types.QueryEpochMintParamsResponse, error) {
+	params, err := q.k.EpochMintParams.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochMintParamsResponse{Params: params}, nil
+}
+
+// EpochProvisions returns epochMinter.EpochProvisions of the mint module.
+func (q queryServer) EpochProvisions(ctx context.Context, _ *types.QueryEpochProvisionsRequest) (*types.QueryEpochProvisionsResponse, error) {
+	minter, err := q.k.EpochMinter.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochProvisionsResponse{EpochProvisions: minter.EpochProvisions}, nil
+}
@@ -19,6 +19,7 @@ type Keeper struct {
 	cdc              codec.BinaryCodec
 	storeService     storetypes.KVStoreService
 	stakingKeeper    types.StakingKeeper
+	accountKeeper    types.AccountKeeper
 	bankKeeper       types.BankKeeper
 	feeCollectorName string
 
@@ -30,8 +31,15 @@ type Keeper struct {
 	Params collections.Item[types.Params]
 	Minter collections.Item[types.Minter]
 
+	EpochMintParams collections.Item[types.EpochMintParams]
+	EpochMinter     collections.Item[types.EpochMinter]
+
 	// mintFn is a function that encompasses all minting logic run in the x/mint begin blocker.
 	mintFn MintFn
+	// customMintFn is set when mintFn was overridden with WithMintFn.
+	customMintFn bool
+	// epochMinting enables minting at the end of each epoch through the x/epochs hooks.
+	epochMinting bool
 }
 
 type InitOption func(*Keeper)
@@ -40,6 +48,17 @@ type InitOption func(*Keeper)
 func WithMintFn(mintFn MintFn) InitOption {
 	return func(k *Keeper) {
 		k.mintFn = mintFn
+		k.customMintFn = true
+	}
+}
+
+// WithEpochMinting enables epoch-driven minting. Block-based minting is
+// disabled and coins are instead minted at the end of each epoch, once the
+// keeper's EpochHooks are registered with the x/epochs keeper. It cannot be
+// combined with WithMintFn.
+func WithEpochMinting() InitOption {
+	return func(k *Keeper) {
+		k.epochMinting = true
 	}
 }
 
@@ -67,11 +86,14 @@ func NewKeeper(
 		cdc:              cdc,
 		storeService:     storeService,
 		stakingKeeper:    sk,
+		accountKeeper:    ak,
 		bankKeeper:       bk,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Minter:           collections.NewItem(sb, types.MinterKey, "minter", codec.CollValue[types.Minter](cdc)),
+		EpochMintParams:  collections.NewItem(sb, types.EpochMintParamsKey, "epoch_mint_params", codec.CollValue[types.EpochMintParams](cdc)),
+		EpochMinter:      collections.NewItem(sb, types.EpochMinterKey, "epoch_minter", codec.CollValue[types.EpochMinter](cdc)),
 		mintFn:           DefaultMintFn(types.DefaultInflationCalculationFn),
 	}
 
@@ -85,6 +107,15 @@ func NewKeeper(
 		opt(&k)
 	}
 
+	if k.epochMinting {
+		// a custom mint function would keep minting every block on top of
+		// the epoch provisions
+		if k.customMintFn {
+			panic("x/mint: WithEpochMinting cannot be combined with WithMintFn")
+		}
+		k.mintFn = EpochMintFn
+	}
+
 	return k
 }
 
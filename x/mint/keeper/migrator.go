@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/exported"
 	v3 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v3"
+	v4 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place state migrations.
@@ -25,3 +26,9 @@ func NewMigrator(k Keeper, ss exported.Subspace) Migrator {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.Migrate(ctx, m.keeper.storeService.OpenKVStore(ctx), m.keeper.cdc, m.keeper.Params)
 }
+
+// Migrate3to4 migrates the x/mint module state from the consensus version 3 to
+// version 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.Migrate(ctx, m.keeper.EpochMintParams, m.keeper.EpochMinter)
+}
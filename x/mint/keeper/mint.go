@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"context"
+
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -52,20 +54,7 @@ func DefaultMintFn(ic types.InflationCalculationFn) MintFn {
 		// calculate minted coins
 		mintedCoin := minter.BlockProvision(params)
 
-		maxSupply := params.MaxSupply
-		totalSupply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount // fetch total supply from the bank module
-
-		// if maxSupply is not infinite, and minted coins exceeds maxSupply, adjust minted coins to be the diff
-		if !maxSupply.IsZero() && totalSupply.Add(mintedCoin.Amount).GT(maxSupply) {
-			// calculate the difference between maxSupply and totalSupply
-			diff := maxSupply.Sub(totalSupply)
-			if diff.Sign() == -1 {
-				// mint nothing if total supply already exceeds max supply
-				diff = sdkmath.ZeroInt()
-			}
-			// mint the difference
-			mintedCoin.Amount = diff
-		}
+		mintedCoin = k.capToMaxSupply(ctx, params, mintedCoin)
 
 		// mint coins, will skip zero coin automatically
 		mintedCoins := sdk.NewCoins(mintedCoin)
@@ -96,3 +85,24 @@ func DefaultMintFn(ic types.InflationCalculationFn) MintFn {
 		return nil
 	}
 }
+
+// capToMaxSupply adjusts the coin to be minted so that the total supply of the
+// mint denom does not exceed the max supply param.
+func (k *Keeper) capToMaxSupply(ctx context.Context, params types.Params, mintedCoin sdk.Coin) sdk.Coin {
+	maxSupply := params.MaxSupply
+	totalSupply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount // fetch total supply from the bank module
+
+	// if maxSupply is not infinite, and minted coins exceeds maxSupply, adjust minted coins to be the diff
+	if !maxSupply.IsZero() && totalSupply.Add(mintedCoin.Amount).GT(maxSupply) {
+		// calculate the difference between maxSupply and totalSupply
+		diff := maxSupply.Sub(totalSupply)
+		if diff.Sign() == -1 {
+			// mint nothing if total supply already exceeds max supply
+			diff = sdkmath.ZeroInt()
+		}
+		// mint the difference
+		mintedCoin.Amount = diff
+	}
+
+	return mintedCoin
+}
@@ -39,3 +39,22 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateEpochMintParams updates the epoch-driven minting params.
+func (ms msgServer) UpdateEpochMintParams(goCtx context.Context, msg *types.MsgUpdateEpochMintParams) (*types.MsgUpdateEpochMintParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, ms.authority, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := ms.validateEpochMintParams(msg.Params); err != nil {
+		return nil, err
+	}
+
+	if err := ms.EpochMintParams.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEpochMintParamsResponse{}, nil
+}
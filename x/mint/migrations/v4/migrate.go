@@ -0,0 +1,22 @@
+package v4
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// Migrate migrates the x/mint module state from the consensus version 3 to
+// version 4. It sets the default epoch mint params and an initial epoch minter.
+func Migrate(
+	ctx sdk.Context,
+	epochMintParams collections.Item[types.EpochMintParams],
+	epochMinter collections.Item[types.EpochMinter],
+) error {
+	if err := epochMintParams.Set(ctx, types.DefaultEpochMintParams()); err != nil {
+		return err
+	}
+
+	return epochMinter.Set(ctx, types.InitialEpochMinter())
+}
@@ -0,0 +1,42 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	v4 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStoreService := runtime.NewKVStoreService(storeKey)
+
+	sb := collections.NewSchemaBuilder(kvStoreService)
+	epochMintParams := collections.NewItem(sb, types.EpochMintParamsKey, "epoch_mint_params", codec.CollValue[types.EpochMintParams](cdc))
+	epochMinter := collections.NewItem(sb, types.EpochMinterKey, "epoch_minter", codec.CollValue[types.EpochMinter](cdc))
+
+	require.NoError(t, v4.Migrate(ctx, epochMintParams, epochMinter))
+
+	params, err := epochMintParams.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultEpochMintParams(), params)
+
+	minter, err := epochMinter.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.InitialEpochMinter(), minter)
+}
@@ -11,7 +11,9 @@ import (
 // RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/mint/Params", nil)
+	cdc.RegisterConcrete(EpochMintParams{}, "cosmos-sdk/x/mint/EpochMintParams", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/mint/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateEpochMintParams{}, "cosmos-sdk/x/mint/MsgUpdateEpochParams")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -19,6 +21,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateEpochMintParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,165 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewEpochMintParams returns EpochMintParams instance with the given values.
+func NewEpochMintParams(
+	epochIdentifier string,
+	genesisEpochProvisions sdkmath.LegacyDec,
+	reductionPeriodInEpochs int64,
+	reductionFactor sdkmath.LegacyDec,
+	mintingStartEpoch int64,
+	distributionProportions []MintDistribution,
+) EpochMintParams {
+	return EpochMintParams{
+		EpochIdentifier:         epochIdentifier,
+		GenesisEpochProvisions:  genesisEpochProvisions,
+		ReductionPeriodInEpochs: reductionPeriodInEpochs,
+		ReductionFactor:         reductionFactor,
+		MintingStartEpoch:       mintingStartEpoch,
+		DistributionProportions: distributionProportions,
+	}
+}
+
+// DefaultEpochMintParams returns default x/mint epoch-driven minting parameters.
+// By default, provisions are halved every year of daily epochs and all minted
+// coins are sent to the fee collector.
+func DefaultEpochMintParams() EpochMintParams {
+	return EpochMintParams{
+		EpochIdentifier:         "day",
+		GenesisEpochProvisions:  sdkmath.LegacyNewDec(5_000_000),
+		ReductionPeriodInEpochs: 365,
+		ReductionFactor:         sdkmath.LegacyNewDecWithPrec(5, 1),
+		MintingStartEpoch:       1,
+		DistributionProportions: []MintDistribution{
+			{ModuleName: authtypes.FeeCollectorName, Proportion: sdkmath.LegacyOneDec()},
+		},
+	}
+}
+
+// Validate does the sanity check on the epoch mint params.
+func (p EpochMintParams) Validate() error {
+	if strings.TrimSpace(p.EpochIdentifier) == "" {
+		return errors.New("epoch identifier cannot be blank")
+	}
+	if p.GenesisEpochProvisions.IsNil() {
+		return fmt.Errorf("genesis epoch provisions cannot be nil: %s", p.GenesisEpochProvisions)
+	}
+	if p.GenesisEpochProvisions.IsNegative() {
+		return fmt.Errorf("genesis epoch provisions cannot be negative: %s", p.GenesisEpochProvisions)
+	}
+	if p.ReductionPeriodInEpochs <= 0 {
+		return fmt.Errorf("reduction period in epochs must be positive: %d", p.ReductionPeriodInEpochs)
+	}
+	if p.ReductionFactor.IsNil() {
+		return fmt.Errorf("reduction factor cannot be nil: %s", p.ReductionFactor)
+	}
+	if p.ReductionFactor.IsNegative() {
+		return fmt.Errorf("reduction factor cannot be negative: %s", p.ReductionFactor)
+	}
+	if p.ReductionFactor.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("reduction factor too large: %s", p.ReductionFactor)
+	}
+	if p.MintingStartEpoch < 0 {
+		return fmt.Errorf("minting start epoch cannot be negative: %d", p.MintingStartEpoch)
+	}
+
+	return validateDistributionProportions(p.DistributionProportions)
+}
+
+func validateDistributionProportions(proportions []MintDistribution) error {
+	if len(proportions) == 0 {
+		return errors.New("distribution proportions cannot be empty")
+	}
+
+	seen := make(map[string]struct{}, len(proportions))
+	total := sdkmath.LegacyZeroDec()
+	for _, d := range proportions {
+		if strings.TrimSpace(d.ModuleName) == "" {
+			return errors.New("distribution module name cannot be blank")
+		}
+		if _, ok := seen[d.ModuleName]; ok {
+			return fmt.Errorf("duplicate distribution module name: %s", d.ModuleName)
+		}
+		seen[d.ModuleName] = struct{}{}
+
+		if d.Proportion.IsNil() {
+			return fmt.Errorf("distribution proportion for %s cannot be nil", d.ModuleName)
+		}
+		if !d.Proportion.IsPositive() {
+			return fmt.Errorf("distribution proportion for %s must be positive: %s", d.ModuleName, d.Proportion)
+		}
+		total = total.Add(d.Proportion)
+	}
+
+	if !total.Equal(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("distribution proportions must sum to one, got: %s", total)
+	}
+
+	return nil
+}
+
+// NewEpochMinter returns a new EpochMinter object with the given epoch
+// provisions and last reduction epoch.
+func NewEpochMinter(epochProvisions sdkmath.LegacyDec, lastReductionEpoch int64) EpochMinter {
+	return EpochMinter{
+		EpochProvisions:    epochProvisions,
+		LastReductionEpoch: lastReductionEpoch,
+	}
+}
+
+// InitialEpochMinter returns an EpochMinter that has not minted yet. Minting
+// starts from the genesis epoch provisions of the params.
+func InitialEpochMinter() EpochMinter {
+	return NewEpochMinter(sdkmath.LegacyZeroDec(), 0)
+}
+
+// ValidateEpochMinter does a basic validation on the epoch minter.
+func ValidateEpochMinter(minter EpochMinter) error {
+	if minter.EpochProvisions.IsNil() {
+		return errors.New("epoch provisions cannot be nil")
+	}
+	if minter.EpochProvisions.IsNegative() {
+		return fmt.Errorf("epoch provisions should be positive, is %s", minter.EpochProvisions)
+	}
+	if minter.LastReductionEpoch < 0 {
+		return fmt.Errorf("last reduction epoch should be positive, is %d", minter.LastReductionEpoch)
+	}
+	return nil
+}
+
+// IsStarted returns true if the epoch minter has minted at least once.
+func (m EpochMinter) IsStarted() bool {
+	return m.LastReductionEpoch > 0
+}
+
+// NextEpochMinter returns the epoch minter to use for minting at the end of the
+// given epoch. Minting starts from the genesis epoch provisions, and the
+// provisions are multiplied by the reduction factor every
+// ReductionPeriodInEpochs epochs.
+func (m EpochMinter) NextEpochMinter(params EpochMintParams, epochNumber int64) EpochMinter {
+	if !m.IsStarted() {
+		return NewEpochMinter(params.GenesisEpochProvisions, epochNumber)
+	}
+
+	if epochNumber-m.LastReductionEpoch < params.ReductionPeriodInEpochs {
+		return m
+	}
+
+	return NewEpochMinter(m.EpochProvisions.Mul(params.ReductionFactor), epochNumber)
+}
+
+// EpochProvision returns the provisions for an epoch based on the epoch
+// provisions rate.
+func (m EpochMinter) EpochProvision(params Params) sdk.Coin {
+	return sdk.NewCoin(params.MintDenom, m.EpochProvisions.TruncateInt())
+}
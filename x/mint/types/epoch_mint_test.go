@@ -0,0 +1,146 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestDefaultEpochMintParams_ValidateOK(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, DefaultEpochMintParams().Validate())
+}
+
+func TestEpochMintParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	withParams := func(fn func(p *EpochMintParams)) EpochMintParams {
+		p := DefaultEpochMintParams()
+		fn(&p)
+		return p
+	}
+
+	tests := []struct {
+		name        string
+		params      EpochMintParams
+		errContains string
+	}{
+		{
+			name: "valid split distribution",
+			params: withParams(func(p *EpochMintParams) {
+				p.DistributionProportions = []MintDistribution{
+					{ModuleName: "fee_collector", Proportion: sdkmath.LegacyNewDecWithPrec(7, 1)},
+					{ModuleName: "protocolpool", Proportion: sdkmath.LegacyNewDecWithPrec(3, 1)},
+				}
+			}),
+		},
+		{
+			name:        "blank epoch identifier",
+			params:      withParams(func(p *EpochMintParams) { p.EpochIdentifier = " " }),
+			errContains: "epoch identifier cannot be blank",
+		},
+		{
+			name:        "negative genesis epoch provisions",
+			params:      withParams(func(p *EpochMintParams) { p.GenesisEpochProvisions = sdkmath.LegacyNewDec(-1) }),
+			errContains: "genesis epoch provisions cannot be negative",
+		},
+		{
+			name:        "zero reduction period",
+			params:      withParams(func(p *EpochMintParams) { p.ReductionPeriodInEpochs = 0 }),
+			errContains: "reduction period in epochs must be positive",
+		},
+		{
+			name:        "reduction factor above one",
+			params:      withParams(func(p *EpochMintParams) { p.ReductionFactor = sdkmath.LegacyNewDecWithPrec(11, 1) }),
+			errContains: "reduction factor too large",
+		},
+		{
+			name:        "negative minting start epoch",
+			params:      withParams(func(p *EpochMintParams) { p.MintingStartEpoch = -1 }),
+			errContains: "minting start epoch cannot be negative",
+		},
+		{
+			name:        "empty distribution",
+			params:      withParams(func(p *EpochMintParams) { p.DistributionProportions = nil }),
+			errContains: "distribution proportions cannot be empty",
+		},
+		{
+			name: "duplicate distribution module",
+			params: withParams(func(p *EpochMintParams) {
+				p.DistributionProportions = []MintDistribution{
+					{ModuleName: "fee_collector", Proportion: sdkmath.LegacyNewDecWithPrec(5, 1)},
+					{ModuleName: "fee_collector", Proportion: sdkmath.LegacyNewDecWithPrec(5, 1)},
+				}
+			}),
+			errContains: "duplicate distribution module name",
+		},
+		{
+			name: "zero distribution proportion",
+			params: withParams(func(p *EpochMintParams) {
+				p.DistributionProportions = []MintDistribution{
+					{ModuleName: "fee_collector", Proportion: sdkmath.LegacyOneDec()},
+					{ModuleName: "protocolpool", Proportion: sdkmath.LegacyZeroDec()},
+				}
+			}),
+			errContains: "must be positive",
+		},
+		{
+			name: "distribution not summing to one",
+			params: withParams(func(p *EpochMintParams) {
+				p.DistributionProportions = []MintDistribution{
+					{ModuleName: "fee_collector", Proportion: sdkmath.LegacyNewDecWithPrec(5, 1)},
+				}
+			}),
+			errContains: "must sum to one",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.params.Validate()
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errContains)
+		})
+	}
+}
+
+func TestNextEpochMinter(t *testing.T) {
+	t.Parallel()
+
+	params := DefaultEpochMintParams()
+	params.GenesisEpochProvisions = sdkmath.LegacyNewDec(1000)
+	params.ReductionPeriodInEpochs = 10
+	params.ReductionFactor = sdkmath.LegacyNewDecWithPrec(5, 1)
+
+	// minting starts from the genesis epoch provisions
+	minter := InitialEpochMinter().NextEpochMinter(params, 3)
+	require.Equal(t, NewEpochMinter(sdkmath.LegacyNewDec(1000), 3), minter)
+
+	// no reduction before the reduction period has elapsed
+	require.Equal(t, minter, minter.NextEpochMinter(params, 12))
+
+	// provisions are halved once the reduction period has elapsed
+	minter = minter.NextEpochMinter(params, 13)
+	require.Equal(t, NewEpochMinter(sdkmath.LegacyNewDec(500), 13), minter)
+
+	minter = minter.NextEpochMinter(params, 23)
+	require.Equal(t, NewEpochMinter(sdkmath.LegacyNewDec(250), 23), minter)
+
+	coin := minter.EpochProvision(DefaultParams())
+	require.Equal(t, DefaultParams().MintDenom, coin.Denom)
+	require.Equal(t, sdkmath.NewInt(250), coin.Amount)
+}
+
+func TestValidateEpochMinter(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateEpochMinter(InitialEpochMinter()))
+	require.Error(t, ValidateEpochMinter(NewEpochMinter(sdkmath.LegacyNewDec(-1), 1)))
+	require.Error(t, ValidateEpochMinter(NewEpochMinter(sdkmath.LegacyNewDec(1), -1)))
+}
@@ -0,0 +1,8 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/mint module sentinel errors
+var (
+	ErrUnknownModuleAccount = errors.Register(ModuleName, 2, "unknown module account")
+)
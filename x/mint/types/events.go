@@ -2,9 +2,12 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint      = ModuleName
+	EventTypeEpochMint = "epoch_mint"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyEpochNumber      = "epoch_number"
+	AttributeKeyEpochProvisions  = "epoch_provisions"
 )
@@ -28,9 +28,14 @@ func NewGenesisState(minter Minter, params Params) *GenesisState {
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
+	epochMintParams := DefaultEpochMintParams()
+	epochMinter := InitialEpochMinter()
+
 	return &GenesisState{
-		Minter: DefaultInitialMinter(),
-		Params: DefaultParams(),
+		Minter:          DefaultInitialMinter(),
+		Params:          DefaultParams(),
+		EpochMintParams: &epochMintParams,
+		EpochMinter:     &epochMinter,
 	}
 }
 
@@ -41,5 +46,17 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if data.EpochMintParams != nil {
+		if err := data.EpochMintParams.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if data.EpochMinter != nil {
+		if err := ValidateEpochMinter(*data.EpochMinter); err != nil {
+			return err
+		}
+	}
+
 	return ValidateMinter(data.Minter)
 }
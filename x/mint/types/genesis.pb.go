@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +31,12 @@ type GenesisState struct {
 	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// epoch_mint_params defines the parameters of epoch-driven minting. When
+	// omitted, the default epoch mint params are used.
+	EpochMintParams *EpochMintParams `protobuf:"bytes,3,opt,name=epoch_mint_params,json=epochMintParams,proto3" json:"epoch_mint_params,omitempty"`
+	// epoch_minter holds the epoch-driven minting state. When omitted, minting
+	// starts from the genesis epoch provisions.
+	EpochMinter *EpochMinter `protobuf:"bytes,4,opt,name=epoch_minter,json=epochMinter,proto3" json:"epoch_minter,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +86,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetEpochMintParams() *EpochMintParams {
+	if m != nil {
+		return m.EpochMintParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochMinter() *EpochMinter {
+	if m != nil {
+		return m.EpochMinter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.mint.v1beta1.GenesisState")
 }
@@ -86,22 +107,27 @@ func init() {
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/genesis.proto", fileDescriptor_0e215eb1d09cd648) }
 
 var fileDescriptor_0e215eb1d09cd648 = []byte{
-	// 234 bytes of a gzipped FileDescriptorProto
+	// 312 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
 	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
 	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
 	0x83, 0x58, 0x10, 0xa5, 0x52, 0x72, 0xd8, 0x4c, 0x03, 0xeb, 0x83, 0xc8, 0x0b, 0x26, 0xe6, 0x66,
-	0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0x52, 0x1f, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xbe, 0xe0,
-	0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0x36, 0x90, 0x8e, 0xd4, 0x22, 0x09, 0x46, 0x05, 0x46,
-	0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0xf6, 0xeb, 0xf9, 0x82, 0x95, 0x38, 0x71, 0x9e, 0xb8, 0x27,
-	0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xa8, 0x2e, 0x90, 0xfe, 0x82, 0xc4, 0xa2, 0xc4,
-	0xdc, 0x62, 0x09, 0x26, 0x3c, 0xfa, 0x03, 0xc0, 0x4a, 0x50, 0xf4, 0x43, 0x74, 0x39, 0x39, 0x9f,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x66, 0x7a, 0x66, 0x49, 0x46, 0x69,
-	0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0xa3, 0x10, 0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0x02,
-	0xe2, 0xeb, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xe7, 0x8c, 0x01, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xf3, 0xd5, 0x6f, 0x47, 0x5f, 0x01, 0x00, 0x00,
+	0xe6, 0xe5, 0xeb, 0x83, 0x49, 0xa8, 0x90, 0x24, 0x44, 0x4b, 0x3c, 0xc4, 0x2c, 0xa8, 0x55, 0x60,
+	0x8e, 0xd2, 0x79, 0x26, 0x2e, 0x1e, 0x77, 0x88, 0x53, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0xec,
+	0xb8, 0xd8, 0x40, 0x86, 0xa5, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61,
+	0x71, 0x9a, 0x9e, 0x2f, 0x58, 0x89, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0,
+	0x62, 0x0c, 0x82, 0xea, 0x02, 0xe9, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x96, 0x60, 0xc2, 0xa3,
+	0x3f, 0x00, 0xac, 0x04, 0x45, 0x3f, 0x44, 0x97, 0x50, 0x2a, 0x97, 0x60, 0x6a, 0x41, 0x7e, 0x72,
+	0x46, 0x3c, 0x48, 0x7d, 0x3c, 0xd4, 0x28, 0x66, 0xb0, 0x51, 0x2a, 0x58, 0x8d, 0x72, 0x05, 0xa9,
+	0x06, 0xb9, 0x07, 0x6a, 0xa6, 0xf0, 0xad, 0x2d, 0xba, 0xfc, 0x10, 0x85, 0xba, 0xc5, 0x29, 0xd9,
+	0x0a, 0x06, 0x7a, 0xa6, 0x26, 0x41, 0xfc, 0xa9, 0xa8, 0xaa, 0x84, 0x22, 0xb8, 0x78, 0x10, 0xd6,
+	0xa4, 0x16, 0x49, 0xb0, 0x80, 0x6d, 0x50, 0xc0, 0x6f, 0x43, 0x6a, 0x11, 0x76, 0xd3, 0xb9, 0x53,
+	0x91, 0x54, 0x38, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
+	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x66, 0x7a,
+	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x34, 0x12, 0xf4, 0x11, 0x26, 0xe9, 0x57,
+	0x40, 0x62, 0xb4, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x3b, 0xc6, 0x80, 0x01, 0x00,
+	0x46, 0xe6, 0x34, 0xf9, 0x3b, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +150,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EpochMinter != nil {
+		{
+			size, err := m.EpochMinter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EpochMintParams != nil {
+		{
+			size, err := m.EpochMintParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -168,6 +218,14 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.EpochMintParams != nil {
+		l = m.EpochMintParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EpochMinter != nil {
+		l = m.EpochMinter.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -272,6 +330,78 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochMintParams == nil {
+				m.EpochMintParams = &EpochMintParams{}
+			}
+			if err := m.EpochMintParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMinter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochMinter == nil {
+				m.EpochMinter = &EpochMinter{}
+			}
+			if err := m.EpochMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
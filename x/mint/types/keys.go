@@ -6,6 +6,10 @@ var (
 	// MinterKey is the key to use for the keeper store.
 	MinterKey = collections.NewPrefix(0)
 	ParamsKey = collections.NewPrefix(1)
+	// EpochMintParamsKey is the key for the epoch-driven minting params.
+	EpochMintParamsKey = collections.NewPrefix(2)
+	// EpochMinterKey is the key for the epoch-driven minting state.
+	EpochMinterKey = collections.NewPrefix(3)
 )
 
 const (
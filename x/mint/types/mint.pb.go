@@ -134,45 +134,249 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+// EpochMintParams defines the parameters for epoch-driven minting.
+//
+// They are only used by chains that configure the x/mint keeper with
+// EpochMintFn and register its epoch hooks with x/epochs. The mint denom and
+// max supply are shared with Params.
+type EpochMintParams struct {
+	// epoch_identifier is the x/epochs identifier whose end triggers minting.
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// genesis_epoch_provisions is the amount minted per epoch before any
+	// reduction is applied.
+	GenesisEpochProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=genesis_epoch_provisions,json=genesisEpochProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"genesis_epoch_provisions"`
+	// reduction_period_in_epochs is the number of epochs between two reductions
+	// of the epoch provisions.
+	ReductionPeriodInEpochs int64 `protobuf:"varint,3,opt,name=reduction_period_in_epochs,json=reductionPeriodInEpochs,proto3" json:"reduction_period_in_epochs,omitempty"`
+	// reduction_factor is the multiplier applied to the epoch provisions at
+	// every reduction. A value of 0.5 halves the provisions.
+	ReductionFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=reduction_factor,json=reductionFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reduction_factor"`
+	// minting_start_epoch is the first epoch number for which coins are minted.
+	MintingStartEpoch int64 `protobuf:"varint,5,opt,name=minting_start_epoch,json=mintingStartEpoch,proto3" json:"minting_start_epoch,omitempty"`
+	// distribution_proportions defines how minted coins are split between
+	// module accounts. The proportions must sum to one.
+	DistributionProportions []MintDistribution `protobuf:"bytes,6,rep,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
+}
+
+func (m *EpochMintParams) Reset()         { *m = EpochMintParams{} }
+func (m *EpochMintParams) String() string { return proto.CompactTextString(m) }
+func (*EpochMintParams) ProtoMessage()    {}
+func (*EpochMintParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *EpochMintParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochMintParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochMintParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochMintParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochMintParams.Merge(m, src)
+}
+func (m *EpochMintParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochMintParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochMintParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochMintParams proto.InternalMessageInfo
+
+func (m *EpochMintParams) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *EpochMintParams) GetReductionPeriodInEpochs() int64 {
+	if m != nil {
+		return m.ReductionPeriodInEpochs
+	}
+	return 0
+}
+
+func (m *EpochMintParams) GetMintingStartEpoch() int64 {
+	if m != nil {
+		return m.MintingStartEpoch
+	}
+	return 0
+}
+
+func (m *EpochMintParams) GetDistributionProportions() []MintDistribution {
+	if m != nil {
+		return m.DistributionProportions
+	}
+	return nil
+}
+
+// MintDistribution defines the share of the minted coins sent to a module
+// account.
+type MintDistribution struct {
+	// module_name is the name of the receiving module account.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// proportion is the fraction of the minted coins sent to the module account.
+	Proportion cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=proportion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"proportion"`
+}
+
+func (m *MintDistribution) Reset()         { *m = MintDistribution{} }
+func (m *MintDistribution) String() string { return proto.CompactTextString(m) }
+func (*MintDistribution) ProtoMessage()    {}
+func (*MintDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *MintDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintDistribution.Merge(m, src)
+}
+func (m *MintDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintDistribution proto.InternalMessageInfo
+
+func (m *MintDistribution) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// EpochMinter represents the epoch-driven minting state.
+type EpochMinter struct {
+	// epoch_provisions is the amount minted at the end of each epoch.
+	EpochProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=epoch_provisions,json=epochProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"epoch_provisions"`
+	// last_reduction_epoch is the epoch number at which the epoch provisions
+	// were last reduced, or at which minting started.
+	LastReductionEpoch int64 `protobuf:"varint,2,opt,name=last_reduction_epoch,json=lastReductionEpoch,proto3" json:"last_reduction_epoch,omitempty"`
+}
+
+func (m *EpochMinter) Reset()         { *m = EpochMinter{} }
+func (m *EpochMinter) String() string { return proto.CompactTextString(m) }
+func (*EpochMinter) ProtoMessage()    {}
+func (*EpochMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{4}
+}
+func (m *EpochMinter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochMinter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochMinter.Merge(m, src)
+}
+func (m *EpochMinter) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochMinter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochMinter proto.InternalMessageInfo
+
+func (m *EpochMinter) GetLastReductionEpoch() int64 {
+	if m != nil {
+		return m.LastReductionEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*EpochMintParams)(nil), "cosmos.mint.v1beta1.EpochMintParams")
+	proto.RegisterType((*MintDistribution)(nil), "cosmos.mint.v1beta1.MintDistribution")
+	proto.RegisterType((*EpochMinter)(nil), "cosmos.mint.v1beta1.EpochMinter")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6b, 0xd4, 0x40,
-	0x1c, 0xc5, 0x37, 0xba, 0xae, 0xec, 0x68, 0xd1, 0x4e, 0x2d, 0x8c, 0x95, 0xa6, 0xa5, 0x07, 0xa9,
-	0x85, 0x26, 0x2e, 0x82, 0x07, 0x8f, 0xdb, 0xbd, 0x14, 0x2c, 0x2e, 0xf1, 0x20, 0x2a, 0x38, 0xfc,
-	0x93, 0x8c, 0xd9, 0x71, 0x33, 0x33, 0x21, 0x33, 0x5b, 0xb2, 0x5f, 0xc1, 0x93, 0x1f, 0xc3, 0x63,
-	0x0f, 0x5e, 0xbc, 0x78, 0xee, 0xb1, 0x78, 0x12, 0x0f, 0x45, 0x76, 0x0f, 0xfd, 0x1a, 0x92, 0x99,
-	0xb0, 0x15, 0x7b, 0xd2, 0xf6, 0x12, 0x92, 0xf7, 0xfe, 0xf9, 0xbd, 0xc7, 0x30, 0x7f, 0xe4, 0x27,
-	0x4a, 0x0b, 0xa5, 0x43, 0xc1, 0xa5, 0x09, 0x0f, 0x7b, 0x31, 0x33, 0xd0, 0xb3, 0x1f, 0x41, 0x51,
-	0x2a, 0xa3, 0xf0, 0x8a, 0xf3, 0x03, 0x2b, 0x35, 0xfe, 0xda, 0xbd, 0x4c, 0x65, 0xca, 0xfa, 0x61,
-	0xfd, 0xe6, 0x46, 0xd7, 0xee, 0xbb, 0x51, 0xea, 0x8c, 0xe6, 0x3f, 0x67, 0x2d, 0x83, 0xe0, 0x52,
-	0x85, 0xf6, 0xe9, 0xa4, 0xad, 0xaf, 0x1e, 0xea, 0x1c, 0x70, 0x69, 0x58, 0x89, 0x5f, 0xa0, 0x2e,
-	0x97, 0xef, 0x73, 0x30, 0x5c, 0x49, 0xe2, 0x6d, 0x7a, 0xdb, 0xdd, 0x7e, 0xef, 0xf8, 0x74, 0xa3,
-	0xf5, 0xf3, 0x74, 0xe3, 0x81, 0xc3, 0xe8, 0x74, 0x1c, 0x70, 0x15, 0x0a, 0x30, 0xa3, 0xe0, 0x39,
-	0xcb, 0x20, 0x99, 0x0e, 0x58, 0xf2, 0xfd, 0xcb, 0x2e, 0x6a, 0x52, 0x06, 0x2c, 0x89, 0xce, 0x19,
-	0xf8, 0x1d, 0x5a, 0x06, 0x29, 0x27, 0x90, 0xd7, 0x5d, 0x0e, 0xb9, 0xe6, 0x4a, 0x6a, 0x72, 0xed,
-	0x7f, 0xc1, 0x77, 0x1d, 0x6b, 0xb8, 0x40, 0x6d, 0x7d, 0x6b, 0xa3, 0xce, 0x10, 0x4a, 0x10, 0x1a,
-	0xaf, 0x23, 0x54, 0x1f, 0x0d, 0x4d, 0x99, 0x54, 0xc2, 0x95, 0x8f, 0xba, 0xb5, 0x32, 0xa8, 0x05,
-	0xfc, 0x01, 0xad, 0x2e, 0x6a, 0xd1, 0x12, 0x0c, 0xa3, 0xc9, 0x08, 0x64, 0xc6, 0x9a, 0x36, 0x4f,
-	0xff, 0xb9, 0xcd, 0xe7, 0xb3, 0xa3, 0x1d, 0x2f, 0x5a, 0x59, 0x40, 0x23, 0x30, 0x6c, 0xcf, 0x22,
-	0xf1, 0x5b, 0xb4, 0x74, 0x9e, 0x25, 0xa0, 0x22, 0xd7, 0x2f, 0x95, 0x71, 0x7b, 0x01, 0x3b, 0x80,
-	0xea, 0x2f, 0x38, 0x97, 0xa4, 0x7d, 0x55, 0x70, 0x2e, 0xf1, 0x2b, 0x74, 0x2b, 0x53, 0x90, 0xd3,
-	0x58, 0xc9, 0x94, 0xa5, 0xe4, 0xc6, 0xa5, 0xd0, 0xa8, 0x46, 0xf5, 0x2d, 0x09, 0x3f, 0x44, 0x77,
-	0xe2, 0x5c, 0x25, 0x63, 0x4d, 0x0b, 0x56, 0xd2, 0x29, 0x83, 0x92, 0x74, 0x36, 0xbd, 0xed, 0x76,
-	0xb4, 0xe4, 0xe4, 0x21, 0x2b, 0x5f, 0x33, 0xa8, 0x6f, 0x20, 0x12, 0x50, 0x51, 0x3d, 0x29, 0x8a,
-	0x7c, 0x4a, 0x6e, 0xda, 0xfc, 0xc7, 0x4d, 0xfe, 0xea, 0xc5, 0xfc, 0x7d, 0x69, 0xfe, 0x48, 0xde,
-	0x97, 0xc6, 0x25, 0x77, 0x05, 0x54, 0x2f, 0x2d, 0xe2, 0xd9, 0xfa, 0xc7, 0xb3, 0xa3, 0x1d, 0xe2,
-	0x06, 0x76, 0x75, 0x3a, 0x0e, 0x2b, 0xb7, 0x61, 0xee, 0xd6, 0xf4, 0xf7, 0x8e, 0x67, 0xbe, 0x77,
-	0x32, 0xf3, 0xbd, 0x5f, 0x33, 0xdf, 0xfb, 0x34, 0xf7, 0x5b, 0x27, 0x73, 0xbf, 0xf5, 0x63, 0xee,
-	0xb7, 0xde, 0x3c, 0xca, 0xb8, 0x19, 0x4d, 0xe2, 0x20, 0x51, 0xa2, 0x59, 0xa1, 0xf0, 0x22, 0xc5,
-	0x4c, 0x0b, 0xa6, 0xe3, 0x8e, 0x5d, 0xa4, 0x27, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x40, 0x97,
-	0xc4, 0xef, 0xc3, 0x03, 0x00, 0x00,
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x4f, 0x1b, 0x47,
+	0x18, 0xc6, 0xbd, 0xd8, 0x75, 0xe5, 0x71, 0x91, 0xcd, 0x18, 0xca, 0x96, 0x0a, 0xe3, 0x5a, 0x6a,
+	0x05, 0x48, 0xac, 0xa1, 0xff, 0xa4, 0xd2, 0x9b, 0x71, 0x2b, 0x59, 0x2a, 0xad, 0xb5, 0x48, 0xad,
+	0x9a, 0x44, 0x59, 0x8d, 0x77, 0x87, 0xf5, 0xc4, 0xde, 0x99, 0xd5, 0xcc, 0x18, 0xd9, 0x5f, 0x21,
+	0xa7, 0x7c, 0x84, 0x9c, 0xa2, 0x1c, 0x51, 0xc4, 0x25, 0x97, 0x9c, 0x39, 0x22, 0x4e, 0x51, 0x0e,
+	0x28, 0x82, 0x03, 0x5f, 0x23, 0xda, 0x99, 0xcd, 0xae, 0x65, 0x73, 0x09, 0xce, 0x05, 0xe1, 0xf7,
+	0x9d, 0xf9, 0x3d, 0xcf, 0xcc, 0xfb, 0xec, 0x2e, 0xa8, 0xba, 0x4c, 0x04, 0x4c, 0x34, 0x02, 0x42,
+	0x65, 0xe3, 0x64, 0xaf, 0x8b, 0x25, 0xda, 0x53, 0x3f, 0xac, 0x90, 0x33, 0xc9, 0x60, 0x45, 0xf7,
+	0x2d, 0x55, 0x8a, 0xfb, 0x6b, 0xcb, 0x3e, 0xf3, 0x99, 0xea, 0x37, 0xa2, 0xff, 0xf4, 0xd2, 0xb5,
+	0x6f, 0xf4, 0x52, 0x47, 0x37, 0xe2, 0x7d, 0xba, 0xb5, 0x84, 0x02, 0x42, 0x59, 0x43, 0xfd, 0xd5,
+	0xa5, 0xfa, 0x6b, 0x03, 0xe4, 0x0f, 0x09, 0x95, 0x98, 0xc3, 0x7f, 0x40, 0x81, 0xd0, 0xe3, 0x01,
+	0x92, 0x84, 0x51, 0xd3, 0xa8, 0x19, 0x9b, 0x85, 0xe6, 0xde, 0xf9, 0xd5, 0x46, 0xe6, 0xdd, 0xd5,
+	0xc6, 0xb7, 0x1a, 0x23, 0xbc, 0xbe, 0x45, 0x58, 0x23, 0x40, 0xb2, 0x67, 0xfd, 0x85, 0x7d, 0xe4,
+	0x8e, 0x5b, 0xd8, 0xbd, 0x3c, 0xdb, 0x01, 0xb1, 0x4a, 0x0b, 0xbb, 0x76, 0xca, 0x80, 0x8f, 0xc1,
+	0x12, 0xa2, 0x74, 0x88, 0x06, 0x91, 0x97, 0x13, 0x22, 0x08, 0xa3, 0xc2, 0x5c, 0xb8, 0x2f, 0xb8,
+	0xac, 0x59, 0x9d, 0x04, 0x55, 0x7f, 0x93, 0x03, 0xf9, 0x0e, 0xe2, 0x28, 0x10, 0x70, 0x1d, 0x80,
+	0xe8, 0x6a, 0x1c, 0x0f, 0x53, 0x16, 0x68, 0xf3, 0x76, 0x21, 0xaa, 0xb4, 0xa2, 0x02, 0x7c, 0x02,
+	0x56, 0x12, 0x5b, 0x0e, 0x47, 0x12, 0x3b, 0x6e, 0x0f, 0x51, 0x1f, 0xc7, 0x6e, 0x7e, 0xfd, 0x64,
+	0x37, 0x2f, 0x6f, 0x4f, 0xb7, 0x0d, 0xbb, 0x92, 0x40, 0x6d, 0x24, 0xf1, 0x81, 0x42, 0xc2, 0x87,
+	0x60, 0x31, 0xd5, 0x0a, 0xd0, 0xc8, 0xcc, 0xce, 0xa5, 0xf1, 0x55, 0x02, 0x3b, 0x44, 0xa3, 0x29,
+	0x38, 0xa1, 0x66, 0xee, 0x73, 0xc1, 0x09, 0x85, 0xff, 0x81, 0xa2, 0xcf, 0xd0, 0xc0, 0xe9, 0x32,
+	0xea, 0x61, 0xcf, 0xfc, 0x62, 0x2e, 0x34, 0x88, 0x50, 0x4d, 0x45, 0x82, 0x3f, 0x80, 0x52, 0x77,
+	0xc0, 0xdc, 0xbe, 0x70, 0x42, 0xcc, 0x9d, 0x31, 0x46, 0xdc, 0xcc, 0xd7, 0x8c, 0xcd, 0x9c, 0xbd,
+	0xa8, 0xcb, 0x1d, 0xcc, 0xff, 0xc7, 0x28, 0x4a, 0x20, 0x08, 0xd0, 0xc8, 0x11, 0xc3, 0x30, 0x1c,
+	0x8c, 0xcd, 0x2f, 0x95, 0xfe, 0x6e, 0xac, 0xbf, 0x32, 0xab, 0xdf, 0xa6, 0x72, 0x42, 0xb9, 0x4d,
+	0xa5, 0x56, 0x2e, 0x04, 0x68, 0x74, 0xa4, 0x10, 0xfb, 0xeb, 0x4f, 0x6f, 0x4f, 0xb7, 0x4d, 0xbd,
+	0x60, 0x47, 0x78, 0xfd, 0xc6, 0x48, 0x3f, 0x61, 0x3a, 0x35, 0xf5, 0x17, 0x39, 0x50, 0xfa, 0x23,
+	0x64, 0x6e, 0x2f, 0x7a, 0x02, 0xe2, 0x24, 0x6d, 0x81, 0x32, 0x8e, 0x4a, 0x0e, 0xf1, 0x30, 0x95,
+	0xe4, 0x98, 0x60, 0x1e, 0xe7, 0xa9, 0xa4, 0xea, 0xed, 0xa4, 0x0c, 0x43, 0x60, 0xfa, 0x98, 0x62,
+	0x41, 0x84, 0xa3, 0xb7, 0xcc, 0xc4, 0xfc, 0xbe, 0x97, 0xf7, 0x75, 0xcc, 0x55, 0xe6, 0xd2, 0xc4,
+	0xc3, 0xdf, 0xc1, 0x1a, 0xc7, 0xde, 0xd0, 0x55, 0xe3, 0x0f, 0x31, 0x27, 0xcc, 0x73, 0x08, 0xd5,
+	0xea, 0x42, 0x05, 0x2d, 0x6b, 0xaf, 0x26, 0x2b, 0x3a, 0x6a, 0x41, 0x9b, 0x2a, 0x8a, 0x80, 0x08,
+	0x94, 0xd3, 0xcd, 0xc7, 0xc8, 0x95, 0x8c, 0xcf, 0x19, 0x9f, 0x52, 0xc2, 0xfb, 0x53, 0xe1, 0xa0,
+	0x05, 0x2a, 0xd1, 0xfd, 0x12, 0xea, 0x3b, 0x42, 0x22, 0x2e, 0xb5, 0x33, 0x95, 0xa4, 0xac, 0xbd,
+	0x14, 0xb7, 0x8e, 0xa2, 0x8e, 0xf2, 0x04, 0xfb, 0xc0, 0xf4, 0x88, 0x90, 0x9c, 0x74, 0x87, 0xfa,
+	0x48, 0x9c, 0x85, 0x8c, 0x4b, 0x75, 0x83, 0xf9, 0x5a, 0x76, 0xb3, 0xf8, 0xe3, 0xf7, 0xd6, 0x1d,
+	0x6f, 0x3e, 0x2b, 0x9a, 0x57, 0x6b, 0x62, 0x63, 0xb3, 0x10, 0x9d, 0x40, 0x9b, 0x5a, 0x9d, 0x24,
+	0x76, 0x52, 0xe0, 0xfe, 0x6f, 0x97, 0x67, 0x3b, 0xa5, 0x34, 0x0b, 0xb5, 0x5d, 0xeb, 0x97, 0x9f,
+	0xa3, 0x7c, 0x7c, 0x37, 0x9b, 0x8f, 0xa9, 0x50, 0xd4, 0x9f, 0x1b, 0xa0, 0x3c, 0xad, 0x09, 0x37,
+	0x40, 0x31, 0x60, 0xde, 0x70, 0x80, 0x1d, 0x8a, 0x02, 0x1c, 0x87, 0x04, 0xe8, 0xd2, 0xdf, 0x28,
+	0xc0, 0xf0, 0x5f, 0x00, 0xd2, 0x03, 0xcd, 0x99, 0x88, 0x09, 0xd2, 0x7e, 0xe5, 0x8e, 0x83, 0xd4,
+	0x5f, 0x19, 0xa0, 0x98, 0xd8, 0xc6, 0x1c, 0x3e, 0x02, 0xe5, 0x99, 0x50, 0xde, 0xfb, 0xa5, 0x5e,
+	0xc2, 0x53, 0x41, 0xdc, 0x05, 0xcb, 0x03, 0x24, 0xa4, 0x93, 0x06, 0x4a, 0x4f, 0x7a, 0x41, 0x4d,
+	0x1a, 0x46, 0x3d, 0xfb, 0x63, 0x4b, 0xb9, 0xba, 0xd3, 0x74, 0xf3, 0xe0, 0xfc, 0xba, 0x6a, 0x5c,
+	0x5c, 0x57, 0x8d, 0xf7, 0xd7, 0x55, 0xe3, 0xd9, 0x4d, 0x35, 0x73, 0x71, 0x53, 0xcd, 0xbc, 0xbd,
+	0xa9, 0x66, 0x1e, 0x6c, 0xf9, 0x44, 0xf6, 0x86, 0x5d, 0xcb, 0x65, 0x41, 0xfc, 0x0d, 0x6b, 0xcc,
+	0x8e, 0x49, 0x8e, 0x43, 0x2c, 0xba, 0x79, 0xf5, 0x25, 0xfb, 0xe9, 0xc3, 0x00, 0x1e, 0xc9, 0x90,
+	0xbc, 0x44, 0x07, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -303,6 +507,158 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochMintParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochMintParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochMintParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DistributionProportions) > 0 {
+		for iNdEx := len(m.DistributionProportions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionProportions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MintingStartEpoch != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MintingStartEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ReductionFactor.Size()
+		i -= size
+		if _, err := m.ReductionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ReductionPeriodInEpochs != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ReductionPeriodInEpochs))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.GenesisEpochProvisions.Size()
+		i -= size
+		if _, err := m.GenesisEpochProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Proportion.Size()
+		i -= size
+		if _, err := m.Proportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochMinter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastReductionEpoch != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.LastReductionEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.EpochProvisions.Size()
+		i -= size
+		if _, err := m.EpochProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -353,6 +709,64 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *EpochMintParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.GenesisEpochProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.ReductionPeriodInEpochs != 0 {
+		n += 1 + sovMint(uint64(m.ReductionPeriodInEpochs))
+	}
+	l = m.ReductionFactor.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.MintingStartEpoch != 0 {
+		n += 1 + sovMint(uint64(m.MintingStartEpoch))
+	}
+	if len(m.DistributionProportions) > 0 {
+		for _, e := range m.DistributionProportions {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Proportion.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *EpochMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.LastReductionEpoch != 0 {
+		n += 1 + sovMint(uint64(m.LastReductionEpoch))
+	}
+	return n
+}
+
 func sovMint(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -748,6 +1162,447 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EpochMintParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochMintParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochMintParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisEpochProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GenesisEpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionPeriodInEpochs", wireType)
+			}
+			m.ReductionPeriodInEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReductionPeriodInEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReductionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingStartEpoch", wireType)
+			}
+			m.MintingStartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintingStartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionProportions = append(m.DistributionProportions, MintDistribution{})
+			if err := m.DistributionProportions[len(m.DistributionProportions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochMinter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochMinter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReductionEpoch", wireType)
+			}
+			m.LastReductionEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReductionEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMint(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -270,6 +270,169 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryEpochMintParamsRequest is the request type for the Query/EpochMintParams
+// RPC method.
+type QueryEpochMintParamsRequest struct {
+}
+
+func (m *QueryEpochMintParamsRequest) Reset()         { *m = QueryEpochMintParamsRequest{} }
+func (m *QueryEpochMintParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochMintParamsRequest) ProtoMessage()    {}
+func (*QueryEpochMintParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryEpochMintParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochMintParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochMintParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochMintParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochMintParamsRequest.Merge(m, src)
+}
+func (m *QueryEpochMintParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochMintParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochMintParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochMintParamsRequest proto.InternalMessageInfo
+
+// QueryEpochMintParamsResponse is the response type for the
+// Query/EpochMintParams RPC method.
+type QueryEpochMintParamsResponse struct {
+	// params defines the epoch-driven minting parameters.
+	Params EpochMintParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryEpochMintParamsResponse) Reset()         { *m = QueryEpochMintParamsResponse{} }
+func (m *QueryEpochMintParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochMintParamsResponse) ProtoMessage()    {}
+func (*QueryEpochMintParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryEpochMintParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochMintParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochMintParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochMintParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochMintParamsResponse.Merge(m, src)
+}
+func (m *QueryEpochMintParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochMintParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochMintParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochMintParamsResponse proto.InternalMessageInfo
+
+func (m *QueryEpochMintParamsResponse) GetParams() EpochMintParams {
+	if m != nil {
+		return m.Params
+	}
+	return EpochMintParams{}
+}
+
+// QueryEpochProvisionsRequest is the request type for the Query/EpochProvisions
+// RPC method.
+type QueryEpochProvisionsRequest struct {
+}
+
+func (m *QueryEpochProvisionsRequest) Reset()         { *m = QueryEpochProvisionsRequest{} }
+func (m *QueryEpochProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochProvisionsRequest) ProtoMessage()    {}
+func (*QueryEpochProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{8}
+}
+func (m *QueryEpochProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochProvisionsRequest.Merge(m, src)
+}
+func (m *QueryEpochProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochProvisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochProvisionsRequest proto.InternalMessageInfo
+
+// QueryEpochProvisionsResponse is the response type for the
+// Query/EpochProvisions RPC method.
+type QueryEpochProvisionsResponse struct {
+	// epoch_provisions is the amount currently minted at the end of each epoch.
+	EpochProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=epoch_provisions,json=epochProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"epoch_provisions"`
+}
+
+func (m *QueryEpochProvisionsResponse) Reset()         { *m = QueryEpochProvisionsResponse{} }
+func (m *QueryEpochProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochProvisionsResponse) ProtoMessage()    {}
+func (*QueryEpochProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{9}
+}
+func (m *QueryEpochProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochProvisionsResponse.Merge(m, src)
+}
+func (m *QueryEpochProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochProvisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochProvisionsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -277,43 +440,56 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryEpochMintParamsRequest)(nil), "cosmos.mint.v1beta1.QueryEpochMintParamsRequest")
+	proto.RegisterType((*QueryEpochMintParamsResponse)(nil), "cosmos.mint.v1beta1.QueryEpochMintParamsResponse")
+	proto.RegisterType((*QueryEpochProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryEpochProvisionsRequest")
+	proto.RegisterType((*QueryEpochProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryEpochProvisionsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4d, 0x6b, 0x14, 0x31,
-	0x18, 0xc7, 0x37, 0x8a, 0x0b, 0x1b, 0x3d, 0xb4, 0x69, 0x7d, 0x9b, 0x6d, 0xb3, 0x65, 0x84, 0xba,
-	0x56, 0x9a, 0xb0, 0x2b, 0x78, 0x14, 0x5c, 0x7b, 0x11, 0x3c, 0xd4, 0xa2, 0x17, 0x2f, 0x92, 0x9d,
-	0xc6, 0x69, 0xe8, 0x4e, 0x32, 0xdd, 0x64, 0x8a, 0x7b, 0x13, 0xf1, 0xe8, 0x41, 0xf0, 0x4b, 0xe8,
-	0xcd, 0x83, 0x5f, 0x41, 0xe8, 0xb1, 0xe8, 0x45, 0x3c, 0x14, 0xd9, 0x15, 0xfc, 0x1a, 0x32, 0x49,
-	0xa6, 0xe2, 0x74, 0x06, 0x95, 0x5e, 0x76, 0x67, 0x9e, 0xb7, 0xff, 0x2f, 0xcf, 0x3f, 0x03, 0x3b,
-	0x91, 0xd2, 0x89, 0xd2, 0x34, 0x11, 0xd2, 0xd0, 0xfd, 0xde, 0x90, 0x1b, 0xd6, 0xa3, 0x7b, 0x19,
-	0x1f, 0x4f, 0x48, 0x3a, 0x56, 0x46, 0xa1, 0x05, 0x57, 0x40, 0xf2, 0x02, 0xe2, 0x0b, 0x82, 0xc5,
-	0x58, 0xc5, 0xca, 0xe6, 0x69, 0xfe, 0xe4, 0x4a, 0x83, 0xa5, 0x58, 0xa9, 0x78, 0xc4, 0x29, 0x4b,
-	0x05, 0x65, 0x52, 0x2a, 0xc3, 0x8c, 0x50, 0x52, 0xfb, 0x2c, 0xae, 0x52, 0xb2, 0x53, 0x5d, 0x7e,
-	0x9e, 0x25, 0x42, 0x2a, 0x6a, 0x7f, 0x7d, 0xe8, 0xaa, 0x6b, 0x79, 0xea, 0x94, 0x3c, 0x88, 0x7d,
-	0x09, 0x17, 0x21, 0x7a, 0x98, 0x53, 0x6e, 0xb2, 0x31, 0x4b, 0xf4, 0x16, 0xdf, 0xcb, 0xb8, 0x36,
-	0xe1, 0x63, 0xb8, 0xf0, 0x47, 0x54, 0xa7, 0x4a, 0x6a, 0x8e, 0xee, 0xc0, 0x66, 0x6a, 0x23, 0x57,
-	0xc0, 0x0a, 0xe8, 0x9e, 0xef, 0xb7, 0x49, 0xc5, 0xa1, 0x88, 0x6b, 0x1a, 0xb4, 0x0e, 0x8e, 0x3a,
-	0x8d, 0x77, 0x3f, 0x3f, 0xac, 0x81, 0x2d, 0xdf, 0x15, 0x5e, 0x86, 0x17, 0xed, 0xd8, 0xfb, 0xf2,
-	0xd9, 0xc8, 0x9e, 0xa9, 0xd0, 0x93, 0xf0, 0x52, 0x39, 0xe1, 0x25, 0x1f, 0xc1, 0x96, 0x28, 0x82,
-	0x56, 0xf5, 0xc2, 0xe0, 0x76, 0x3e, 0xf8, 0xdb, 0x51, 0xa7, 0xed, 0xc4, 0xf5, 0xf6, 0x2e, 0x11,
-	0x8a, 0x26, 0xcc, 0xec, 0x90, 0x07, 0x3c, 0x66, 0xd1, 0x64, 0x83, 0x47, 0x9f, 0x3f, 0xae, 0x43,
-	0xcf, 0xb6, 0xc1, 0x23, 0x47, 0xf1, 0x7b, 0x50, 0x88, 0xe1, 0x92, 0xd5, 0xbb, 0x2b, 0x65, 0xc6,
-	0x46, 0x9b, 0x63, 0xb5, 0x2f, 0x74, 0xbe, 0xe2, 0x82, 0xe7, 0x15, 0x80, 0xcb, 0x35, 0x05, 0x9e,
-	0x2b, 0x82, 0xf3, 0xcc, 0xe6, 0xf2, 0xa5, 0xfa, 0xe4, 0x29, 0xf9, 0xe6, 0x58, 0x49, 0xac, 0xff,
-	0xe9, 0x2c, 0x3c, 0x67, 0x31, 0xd0, 0x0b, 0x00, 0x9b, 0x6e, 0xaf, 0xe8, 0x7a, 0xe5, 0xd2, 0x4f,
-	0x9a, 0x18, 0x74, 0xff, 0x5e, 0xe8, 0x0e, 0x13, 0x5e, 0x7b, 0xf9, 0xe5, 0xc7, 0xdb, 0x33, 0xcb,
-	0xa8, 0x4d, 0xab, 0xee, 0x96, 0x33, 0x0f, 0xbd, 0x06, 0xb0, 0x75, 0xec, 0x0f, 0x5a, 0xab, 0x1f,
-	0x5e, 0x76, 0x37, 0xb8, 0xf9, 0x4f, 0xb5, 0x9e, 0x65, 0xd5, 0xb2, 0xac, 0x20, 0x5c, 0xc9, 0x72,
-	0x6c, 0x21, 0x7a, 0x0f, 0xe0, 0x5c, 0xd9, 0x1d, 0xd4, 0xab, 0x57, 0xaa, 0xb1, 0x3a, 0xe8, 0xff,
-	0x4f, 0x8b, 0x67, 0x24, 0x96, 0xb1, 0x8b, 0x56, 0x2b, 0x19, 0x4f, 0xdc, 0x8b, 0xc1, 0xbd, 0x83,
-	0x29, 0x06, 0x87, 0x53, 0x0c, 0xbe, 0x4f, 0x31, 0x78, 0x33, 0xc3, 0x8d, 0xc3, 0x19, 0x6e, 0x7c,
-	0x9d, 0xe1, 0xc6, 0x93, 0x1b, 0xb1, 0x30, 0x3b, 0xd9, 0x90, 0x44, 0x2a, 0x29, 0x66, 0xb9, 0xbf,
-	0x75, 0xbd, 0xbd, 0x4b, 0x9f, 0xbb, 0xc1, 0x66, 0x92, 0x72, 0x3d, 0x6c, 0xda, 0x0f, 0xf6, 0xd6,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0x1a, 0x74, 0xc5, 0x6a, 0x04, 0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x82, 0x81, 0x8c, 0x42, 0xd2, 0x49, 0xfd, 0xb5, 0x49, 0x37, 0x61, 0xd5, 0x36,
+	0x56, 0xb2, 0xdb, 0xc4, 0x1f, 0x07, 0x0f, 0x62, 0x63, 0x45, 0x04, 0x85, 0x5a, 0xf4, 0xe2, 0x25,
+	0x4c, 0xb6, 0xe3, 0x66, 0x69, 0x76, 0x67, 0x9b, 0xdd, 0x14, 0x03, 0x1e, 0x44, 0x3c, 0x7a, 0x10,
+	0x04, 0xff, 0x06, 0xbd, 0x79, 0xe8, 0x41, 0xc1, 0x3f, 0xa0, 0x78, 0x2a, 0xf5, 0x22, 0x1e, 0x8a,
+	0x24, 0x82, 0xff, 0x86, 0xec, 0xcc, 0x24, 0x31, 0x9b, 0xd9, 0xd6, 0x60, 0x2f, 0x21, 0x99, 0xf7,
+	0xbe, 0xef, 0xfb, 0x99, 0xf7, 0xe6, 0x11, 0x58, 0x30, 0xa9, 0xef, 0x50, 0xdf, 0x70, 0x6c, 0x37,
+	0x30, 0xb6, 0x2a, 0x0d, 0x12, 0xe0, 0x8a, 0xb1, 0xd9, 0x21, 0xed, 0xae, 0xee, 0xb5, 0x69, 0x40,
+	0x51, 0x96, 0x27, 0xe8, 0x61, 0x82, 0x2e, 0x12, 0x94, 0x59, 0x8b, 0x5a, 0x94, 0xc5, 0x8d, 0xf0,
+	0x1b, 0x4f, 0x55, 0xf2, 0x16, 0xa5, 0x56, 0x8b, 0x18, 0xd8, 0xb3, 0x0d, 0xec, 0xba, 0x34, 0xc0,
+	0x81, 0x4d, 0x5d, 0x5f, 0x44, 0x55, 0x99, 0x13, 0xab, 0xca, 0xe3, 0x33, 0xd8, 0xb1, 0x5d, 0x6a,
+	0xb0, 0x4f, 0x71, 0x74, 0x8e, 0x4b, 0xea, 0xdc, 0x49, 0x80, 0xb0, 0x1f, 0xda, 0x2c, 0x44, 0x0f,
+	0x43, 0xca, 0x55, 0xdc, 0xc6, 0x8e, 0xbf, 0x46, 0x36, 0x3b, 0xc4, 0x0f, 0xb4, 0xc7, 0x30, 0x3b,
+	0x76, 0xea, 0x7b, 0xd4, 0xf5, 0x09, 0xba, 0x09, 0x93, 0x1e, 0x3b, 0x39, 0x0b, 0x8a, 0xa0, 0x74,
+	0xa2, 0x9a, 0xd3, 0x25, 0x97, 0xd2, 0xb9, 0xa8, 0x96, 0xda, 0xd9, 0x2f, 0x24, 0xde, 0xff, 0xfe,
+	0xb8, 0x08, 0xd6, 0x84, 0x4a, 0x3b, 0x03, 0x4f, 0xb1, 0xb2, 0xf7, 0xdc, 0xa7, 0x2d, 0x76, 0xa7,
+	0x81, 0x9f, 0x0b, 0x4f, 0x47, 0x03, 0xc2, 0xf2, 0x11, 0x4c, 0xd9, 0x83, 0x43, 0xe6, 0x7a, 0xb2,
+	0x76, 0x3d, 0x2c, 0xfc, 0x63, 0xbf, 0x90, 0xe3, 0xe6, 0xfe, 0xfa, 0x86, 0x6e, 0x53, 0xc3, 0xc1,
+	0x41, 0x53, 0xbf, 0x4f, 0x2c, 0x6c, 0x76, 0x57, 0x88, 0xb9, 0xb7, 0x5d, 0x86, 0x82, 0x6d, 0x85,
+	0x98, 0x9c, 0x62, 0x54, 0x48, 0x53, 0x61, 0x9e, 0xf9, 0x2d, 0xbb, 0x6e, 0x07, 0xb7, 0x56, 0xdb,
+	0x74, 0xcb, 0xf6, 0xc3, 0x16, 0x0f, 0x78, 0x5e, 0x01, 0x38, 0x17, 0x93, 0x20, 0xb8, 0x4c, 0x38,
+	0x83, 0x59, 0xac, 0xee, 0x0d, 0x83, 0xff, 0xc9, 0x97, 0xc1, 0x11, 0x33, 0xad, 0x0a, 0x73, 0x8c,
+	0xe2, 0x8e, 0x47, 0xcd, 0xe6, 0x03, 0xdb, 0x0d, 0xc6, 0xa6, 0x74, 0x23, 0xbb, 0xb7, 0x5d, 0x4e,
+	0xf3, 0x2a, 0x65, 0x7f, 0x7d, 0xa3, 0xb8, 0xa4, 0x5f, 0xbb, 0xaa, 0x3d, 0x87, 0x79, 0xb9, 0x46,
+	0x80, 0xdf, 0x8d, 0xcc, 0xf0, 0x82, 0x74, 0x86, 0x11, 0xb5, 0x64, 0x98, 0x72, 0xf7, 0x31, 0xe2,
+	0x89, 0xbe, 0xca, 0x35, 0xef, 0x00, 0xcc, 0xcb, 0x45, 0x02, 0x19, 0xc3, 0x0c, 0x09, 0x43, 0x47,
+	0xd7, 0xea, 0x34, 0x19, 0xb7, 0x92, 0x82, 0x55, 0xbf, 0x24, 0xe1, 0x71, 0x06, 0x86, 0x5e, 0x00,
+	0x98, 0xe4, 0x9d, 0x40, 0x0b, 0xd2, 0x7e, 0x4d, 0xee, 0x90, 0x52, 0x3a, 0x3c, 0x91, 0xdf, 0x4f,
+	0x3b, 0xff, 0xf2, 0xdb, 0xaf, 0xb7, 0xc7, 0xe6, 0x50, 0xce, 0x90, 0xad, 0x36, 0x6f, 0x37, 0x7a,
+	0x0d, 0x60, 0x6a, 0xb8, 0x1e, 0x68, 0x31, 0xbe, 0x78, 0x74, 0xb9, 0x94, 0xcb, 0xff, 0x94, 0x2b,
+	0x58, 0xe6, 0x19, 0x4b, 0x11, 0xa9, 0x52, 0x96, 0xe1, 0x06, 0xa1, 0x0f, 0x00, 0x66, 0xa2, 0xcb,
+	0x81, 0x2a, 0xf1, 0x4e, 0x31, 0x9b, 0xa6, 0x54, 0xa7, 0x91, 0x08, 0x46, 0x9d, 0x31, 0x96, 0xd0,
+	0xbc, 0x94, 0x71, 0x62, 0x2d, 0xd1, 0x67, 0x00, 0xd3, 0x91, 0x07, 0x8d, 0x96, 0xe2, 0x7d, 0xe5,
+	0xdb, 0xa6, 0x54, 0xa6, 0x50, 0x08, 0xd0, 0xe5, 0xaf, 0x93, 0xaf, 0xea, 0x40, 0x76, 0xfe, 0xcc,
+	0xc3, 0xa3, 0xba, 0x18, 0xfb, 0xa7, 0x01, 0xfb, 0x5f, 0x6d, 0x3e, 0x8c, 0x7d, 0xb2, 0xcb, 0x95,
+	0x29, 0x14, 0x82, 0xfd, 0x56, 0x1c, 0xfb, 0x02, 0xba, 0x78, 0x00, 0xfb, 0xa8, 0xed, 0xb5, 0xdb,
+	0x3b, 0x3d, 0x15, 0xec, 0xf6, 0x54, 0xf0, 0xb3, 0xa7, 0x82, 0x37, 0x7d, 0x35, 0xb1, 0xdb, 0x57,
+	0x13, 0xdf, 0xfb, 0x6a, 0xe2, 0xc9, 0x25, 0xcb, 0x0e, 0x9a, 0x9d, 0x86, 0x6e, 0x52, 0x67, 0x50,
+	0x6a, 0xe4, 0x64, 0x3c, 0xe3, 0x75, 0x83, 0xae, 0x47, 0xfc, 0x46, 0x92, 0xfd, 0x4d, 0x5d, 0xf9,
+	0x33, 0x00, 0x50, 0xd7, 0xe3, 0xad, 0x60, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -334,6 +510,11 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// EpochMintParams returns the epoch-driven minting parameters.
+	EpochMintParams(ctx context.Context, in *QueryEpochMintParamsRequest, opts ...grpc.CallOption) (*QueryEpochMintParamsResponse, error)
+	// EpochProvisions returns the amount currently minted at the end of each
+	// epoch.
+	EpochProvisions(ctx context.Context, in *QueryEpochProvisionsRequest, opts ...grpc.CallOption) (*QueryEpochProvisionsResponse, error)
 }
 
 type queryClient struct {
@@ -371,6 +552,24 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) EpochMintParams(ctx context.Context, in *QueryEpochMintParamsRequest, opts ...grpc.CallOption) (*QueryEpochMintParamsResponse, error) {
+	out := new(QueryEpochMintParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/EpochMintParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochProvisions(ctx context.Context, in *QueryEpochProvisionsRequest, opts ...grpc.CallOption) (*QueryEpochProvisionsResponse, error) {
+	out := new(QueryEpochProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/EpochProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -379,6 +578,11 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// EpochMintParams returns the epoch-driven minting parameters.
+	EpochMintParams(context.Context, *QueryEpochMintParamsRequest) (*QueryEpochMintParamsResponse, error)
+	// EpochProvisions returns the amount currently minted at the end of each
+	// epoch.
+	EpochProvisions(context.Context, *QueryEpochProvisionsRequest) (*QueryEpochProvisionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -394,6 +598,12 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) EpochMintParams(ctx context.Context, req *QueryEpochMintParamsRequest) (*QueryEpochMintParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochMintParams not implemented")
+}
+func (*UnimplementedQueryServer) EpochProvisions(ctx context.Context, req *QueryEpochProvisionsRequest) (*QueryEpochProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochProvisions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -453,6 +663,42 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochMintParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochMintParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochMintParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/EpochMintParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochMintParams(ctx, req.(*QueryEpochMintParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/EpochProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochProvisions(ctx, req.(*QueryEpochProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
@@ -470,6 +716,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "EpochMintParams",
+			Handler:    _Query_EpochMintParams_Handler,
+		},
+		{
+			MethodName: "EpochProvisions",
+			Handler:    _Query_EpochProvisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -643,6 +897,118 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEpochMintParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochMintParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochMintParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochMintParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochMintParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochMintParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EpochProvisions.Size()
+		i -= size
+		if _, err := m.EpochProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -714,18 +1080,58 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryEpochMintParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryEpochMintParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *QueryEpochProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
@@ -1119,6 +1525,272 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEpochMintParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochMintParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochMintParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochMintParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochMintParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochMintParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -126,38 +126,141 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateEpochMintParams is the Msg/UpdateEpochMintParams request type.
+type MsgUpdateEpochMintParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/mint epoch-driven minting parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params EpochMintParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateEpochMintParams) Reset()         { *m = MsgUpdateEpochMintParams{} }
+func (m *MsgUpdateEpochMintParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEpochMintParams) ProtoMessage()    {}
+func (*MsgUpdateEpochMintParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d933a8bf5e188a, []int{2}
+}
+func (m *MsgUpdateEpochMintParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEpochMintParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEpochMintParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEpochMintParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEpochMintParams.Merge(m, src)
+}
+func (m *MsgUpdateEpochMintParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEpochMintParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEpochMintParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEpochMintParams proto.InternalMessageInfo
+
+func (m *MsgUpdateEpochMintParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateEpochMintParams) GetParams() EpochMintParams {
+	if m != nil {
+		return m.Params
+	}
+	return EpochMintParams{}
+}
+
+// MsgUpdateEpochMintParamsResponse defines the response structure for executing
+// a MsgUpdateEpochMintParams message.
+type MsgUpdateEpochMintParamsResponse struct {
+}
+
+func (m *MsgUpdateEpochMintParamsResponse) Reset()         { *m = MsgUpdateEpochMintParamsResponse{} }
+func (m *MsgUpdateEpochMintParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEpochMintParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateEpochMintParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d933a8bf5e188a, []int{3}
+}
+func (m *MsgUpdateEpochMintParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEpochMintParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEpochMintParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEpochMintParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEpochMintParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateEpochMintParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEpochMintParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEpochMintParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEpochMintParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.mint.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.mint.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateEpochMintParams)(nil), "cosmos.mint.v1beta1.MsgUpdateEpochMintParams")
+	proto.RegisterType((*MsgUpdateEpochMintParamsResponse)(nil), "cosmos.mint.v1beta1.MsgUpdateEpochMintParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/tx.proto", fileDescriptor_a0d933a8bf5e188a) }
 
 var fileDescriptor_a0d933a8bf5e188a = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xa0, 0xb2, 0x52, 0xe2, 0x30, 0x2d, 0xc5, 0xe9, 0xfa, 0x65, 0x86, 0x20, 0x0a, 0xa2, 0x5a, 0x4a,
-	0x30, 0x31, 0x37, 0x33, 0x2f, 0x5f, 0x1f, 0x4c, 0x42, 0x85, 0xe4, 0xb0, 0x19, 0x0f, 0x36, 0x0d,
-	0x22, 0x2f, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x50, 0x51, 0x49, 0x88, 0xae,
-	0x78, 0x88, 0x04, 0xd4, 0x0d, 0x60, 0x8e, 0xd2, 0x25, 0x46, 0x2e, 0x7e, 0xdf, 0xe2, 0xf4, 0xd0,
-	0x82, 0x94, 0xc4, 0x92, 0xd4, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x33, 0x2e, 0xce, 0xc4,
-	0xd2, 0x92, 0x8c, 0xfc, 0xa2, 0xcc, 0x92, 0x4a, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0x89,
-	0x4b, 0x5b, 0x74, 0x45, 0xa0, 0x1a, 0x1d, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x83, 0x4b, 0x8a,
-	0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xec, 0xb8, 0xd8, 0x0a, 0xc0, 0x26, 0x48, 0x30, 0x29,
-	0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0xf1, 0xae, 0x1e, 0xc4, 0x12, 0x27, 0xce, 0x13, 0xf7,
-	0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd5, 0x65, 0xe5, 0x7e, 0x69, 0x8b, 0x2e,
-	0x3f, 0x44, 0x8b, 0x6e, 0x71, 0x4a, 0xb6, 0x82, 0x81, 0x9e, 0x89, 0x79, 0xd3, 0xf3, 0x0d, 0x5a,
-	0x08, 0x2b, 0xba, 0x9e, 0x6f, 0xd0, 0x52, 0x44, 0xa8, 0xd0, 0xaf, 0x80, 0x04, 0x04, 0x9a, 0x07,
-	0x94, 0xf4, 0xb8, 0xc4, 0xd1, 0x84, 0x82, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0xad, 0x84,
-	0xb1, 0xd8, 0x61, 0xd4, 0xca, 0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x54, 0xce, 0xc5, 0x83, 0x12,
-	0x10, 0x2a, 0x58, 0x3d, 0x80, 0x66, 0xb4, 0x94, 0x0e, 0x31, 0xaa, 0x60, 0x0e, 0x50, 0x12, 0x3e,
-	0x85, 0xe9, 0x00, 0x29, 0xd6, 0x06, 0x50, 0x40, 0x38, 0x39, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x66, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e,
-	0x34, 0xfe, 0xf4, 0x31, 0x83, 0xa1, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xb1, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xd2, 0x76, 0x68, 0x8a, 0x02, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xce, 0xac, 0xb8, 0xd0, 0x51, 0x58, 0x4c, 0x57, 0x36, 0x46, 0x89, 0x31, 0x88, 0xac, 0xc5,
+	0xcc, 0xd8, 0x35, 0x71, 0x61, 0x0f, 0x82, 0x15, 0xe9, 0xc5, 0x82, 0x54, 0xbc, 0x78, 0x91, 0xb4,
+	0x09, 0x69, 0x90, 0x64, 0x42, 0x66, 0x5a, 0xdb, 0x9b, 0x78, 0xf4, 0x24, 0x1e, 0xfd, 0x05, 0x1e,
+	0x7b, 0xe8, 0x8f, 0x28, 0x9e, 0x4a, 0x4f, 0x9e, 0x44, 0xda, 0x43, 0xf1, 0x5f, 0x48, 0x32, 0x53,
+	0x8b, 0x49, 0x0c, 0xca, 0x5e, 0x92, 0xc9, 0x7b, 0xdf, 0xf7, 0xbe, 0xf7, 0xbe, 0x97, 0x81, 0x37,
+	0xfa, 0x84, 0x86, 0x84, 0xe2, 0x30, 0x88, 0x18, 0x1e, 0x35, 0x7b, 0x1e, 0x73, 0x9a, 0x98, 0x8d,
+	0x51, 0x9c, 0x10, 0x46, 0xe4, 0x3a, 0xcf, 0xa2, 0x34, 0x8b, 0x44, 0x56, 0x3d, 0xda, 0x52, 0xa8,
+	0x8f, 0x47, 0xcd, 0xf4, 0xc5, 0xd1, 0xea, 0x15, 0x27, 0x0c, 0x22, 0x82, 0xb3, 0xa7, 0x08, 0x69,
+	0x65, 0xe5, 0xb3, 0x6a, 0x3c, 0x7f, 0xe8, 0x13, 0x9f, 0x64, 0x47, 0x9c, 0x9e, 0x44, 0xf4, 0x1a,
+	0x67, 0xbd, 0xe6, 0x09, 0xd1, 0x43, 0xf6, 0x61, 0x2c, 0x01, 0x3c, 0xe8, 0x50, 0xff, 0x65, 0xec,
+	0x3a, 0xcc, 0x7b, 0xee, 0x24, 0x4e, 0x48, 0xe5, 0x87, 0xb0, 0xe6, 0x0c, 0xd9, 0x80, 0x24, 0x01,
+	0x9b, 0x28, 0x40, 0x07, 0xc7, 0xb5, 0x96, 0xb2, 0x9c, 0x99, 0x87, 0x82, 0xf8, 0xd8, 0x75, 0x13,
+	0x8f, 0xd2, 0x17, 0x2c, 0x09, 0x22, 0xbf, 0xbb, 0x83, 0xca, 0x8f, 0xe0, 0x7e, 0x9c, 0x55, 0x50,
+	0xf6, 0x74, 0x70, 0x7c, 0xe9, 0xe4, 0x3a, 0x2a, 0x19, 0x17, 0x71, 0x91, 0x56, 0x6d, 0xfe, 0xfd,
+	0xa6, 0xf4, 0x65, 0x33, 0x6d, 0x80, 0xae, 0x60, 0x9d, 0xb5, 0x97, 0x33, 0xf3, 0x80, 0x53, 0x4c,
+	0xea, 0xbe, 0xd1, 0xef, 0x23, 0xeb, 0xf4, 0xfd, 0x66, 0xda, 0xd8, 0x49, 0x7c, 0xd8, 0x4c, 0x1b,
+	0xb7, 0x76, 0x08, 0x3c, 0xe6, 0x46, 0xe4, 0x06, 0x30, 0x10, 0x3c, 0xca, 0x85, 0xba, 0x1e, 0x8d,
+	0x49, 0x44, 0xbd, 0xb3, 0x7a, 0x89, 0x86, 0xf1, 0x13, 0x40, 0xe5, 0x37, 0xe1, 0x69, 0x4c, 0xfa,
+	0x83, 0x4e, 0x10, 0xb1, 0x73, 0xba, 0xd1, 0xce, 0xb9, 0x71, 0xbb, 0xd4, 0x8d, 0x9c, 0x5a, 0x99,
+	0x2d, 0xcf, 0x0a, 0x2d, 0xdb, 0x56, 0xd1, 0x96, 0x3b, 0x15, 0xb6, 0x64, 0x22, 0xc2, 0x9b, 0x53,
+	0xa8, 0xff, 0x6d, 0xd4, 0x0a, 0x93, 0x6c, 0xeb, 0xe4, 0xf3, 0x1e, 0xbc, 0xd0, 0xa1, 0xbe, 0xfc,
+	0x16, 0x5e, 0xfe, 0xe3, 0x6f, 0x29, 0x9f, 0x2b, 0xe7, 0xbf, 0x7a, 0xef, 0x5f, 0x50, 0xdb, 0x06,
+	0x8c, 0xfa, 0xd7, 0xe2, 0x96, 0xe4, 0x4f, 0x00, 0x5e, 0x2d, 0x5f, 0x91, 0x59, 0x5d, 0x3c, 0x07,
+	0x57, 0xed, 0xff, 0x82, 0x57, 0x34, 0x65, 0x5b, 0xea, 0xc5, 0x77, 0xe9, 0xae, 0x5a, 0x4f, 0xe6,
+	0x2b, 0x0d, 0x2c, 0x56, 0x1a, 0xf8, 0xb1, 0xd2, 0xc0, 0xc7, 0xb5, 0x26, 0x2d, 0xd6, 0x9a, 0xf4,
+	0x6d, 0xad, 0x49, 0xaf, 0xee, 0xfa, 0x01, 0x1b, 0x0c, 0x7b, 0xa8, 0x4f, 0x42, 0x71, 0xf3, 0x70,
+	0x71, 0x53, 0x6c, 0x12, 0x7b, 0xb4, 0xb7, 0x9f, 0x5d, 0xc9, 0x07, 0xbf, 0x06, 0x00, 0xce, 0x08,
+	0x8a, 0x8f, 0x44, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -175,6 +278,10 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/mint module
 	// parameters. The authority is defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateEpochMintParams defines a governance operation for updating the
+	// x/mint epoch-driven minting parameters. The authority defaults to the x/gov
+	// module account.
+	UpdateEpochMintParams(ctx context.Context, in *MsgUpdateEpochMintParams, opts ...grpc.CallOption) (*MsgUpdateEpochMintParamsResponse, error)
 }
 
 type msgClient struct {
@@ -194,11 +301,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateEpochMintParams(ctx context.Context, in *MsgUpdateEpochMintParams, opts ...grpc.CallOption) (*MsgUpdateEpochMintParamsResponse, error) {
+	out := new(MsgUpdateEpochMintParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Msg/UpdateEpochMintParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/mint module
 	// parameters. The authority is defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateEpochMintParams defines a governance operation for updating the
+	// x/mint epoch-driven minting parameters. The authority defaults to the x/gov
+	// module account.
+	UpdateEpochMintParams(context.Context, *MsgUpdateEpochMintParams) (*MsgUpdateEpochMintParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -208,6 +328,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateEpochMintParams(ctx context.Context, req *MsgUpdateEpochMintParams) (*MsgUpdateEpochMintParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEpochMintParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -231,6 +354,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateEpochMintParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEpochMintParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEpochMintParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Msg/UpdateEpochMintParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEpochMintParams(ctx, req.(*MsgUpdateEpochMintParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Msg",
@@ -240,6 +381,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateEpochMintParams",
+			Handler:    _Msg_UpdateEpochMintParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/tx.proto",
@@ -308,6 +453,69 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateEpochMintParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEpochMintParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEpochMintParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEpochMintParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEpochMintParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEpochMintParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -343,6 +551,30 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateEpochMintParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateEpochMintParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -514,6 +746,171 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateEpochMintParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEpochMintParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEpochMintParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEpochMintParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEpochMintParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEpochMintParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -137,6 +137,38 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod: "TokenizeShareRecordById",
+					Use:       "tokenize-share-record-by-id [id]",
+					Short:     "Query a tokenize share record by its id",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-record-by-id 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "TokenizeShareRecordByDenom",
+					Use:       "tokenize-share-record-by-denom [denom]",
+					Short:     "Query a tokenize share record by its share token denom",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-record-by-denom cosmosvaloper.../1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "TokenizeShareRecordsOwned",
+					Use:       "tokenize-share-records-owned [owner]",
+					Short:     "Query the tokenize share records owned by an account",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-records-owned cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the total amount of liquid staked tokens",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -173,6 +205,21 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking cancel-unbond cosmosvaloper... 100stake 2 --from mykey`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "creation_height"}},
 				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-shares [validator-addr] [amount] [owner]",
+					Short:          "Tokenize delegation shares into transferable share tokens",
+					Long:           "Convert part of a delegation into share tokens. The owner controls the tokenize share record and receives its rewards.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-shares cosmosvaloper... 100stake cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "tokenized_share_owner"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens [amount]",
+					Short:          "Redeem share tokens for a delegation",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100cosmosvaloper.../1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
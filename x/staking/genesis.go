@@ -48,9 +48,35 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id == 0 || record.Id > lastID {
+			return fmt.Errorf("tokenize share record id %d is out of range, last id is %d", record.Id, lastID)
+		}
+
+		if record.ModuleAccount != types.GetTokenizeShareModuleAccountName(record.Id) {
+			return fmt.Errorf("invalid module account for tokenize share record %d: %s", record.Id, record.ModuleAccount)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -1157,6 +1157,71 @@ func (k Keeper) Undelegate(
 	return completionTime, returnAmount, nil
 }
 
+// TransferUnbonding moves up to wantAmt tokens from the unbonding entries of
+// fromAddr with the given validator to toAddr. The moved entries keep their
+// creation height and completion time. Entries that are on hold are skipped.
+// It returns the amount of tokens actually transferred.
+func (k Keeper) TransferUnbonding(
+	ctx context.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt math.Int,
+) (math.Int, error) {
+	transferred := math.ZeroInt()
+
+	ubd, err := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if err != nil {
+		if errors.Is(err, types.ErrNoUnbondingDelegation) {
+			return transferred, nil
+		}
+		return transferred, err
+	}
+
+	// walk the entries from the most recent one, so that the entries created by
+	// an undelegation in the same block are transferred first
+	for i := len(ubd.Entries) - 1; i >= 0 && transferred.LT(wantAmt); i-- {
+		entry := ubd.Entries[i]
+		if entry.OnHold() || !entry.Balance.IsPositive() {
+			continue
+		}
+
+		hasMaxEntries, err := k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr)
+		if err != nil {
+			return transferred, err
+		}
+		if hasMaxEntries {
+			return transferred, types.ErrMaxUnbondingDelegationEntries
+		}
+
+		amt := math.MinInt(entry.Balance, wantAmt.Sub(transferred))
+		if amt.Equal(entry.Balance) {
+			ubd.RemoveEntry(int64(i))
+			if err = k.DeleteUnbondingIndex(ctx, entry.UnbondingId); err != nil {
+				return transferred, err
+			}
+		} else {
+			entry.Balance = entry.Balance.Sub(amt)
+			entry.InitialBalance = math.MaxInt(entry.InitialBalance.Sub(amt), math.ZeroInt())
+			ubd.Entries[i] = entry
+		}
+
+		toUbd, err := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, amt)
+		if err != nil {
+			return transferred, err
+		}
+		if err = k.InsertUBDQueue(ctx, toUbd, entry.CompletionTime); err != nil {
+			return transferred, err
+		}
+
+		transferred = transferred.Add(amt)
+	}
+
+	if len(ubd.Entries) == 0 {
+		err = k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		err = k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return transferred, err
+}
+
 // CompleteUnbonding completes the unbonding of all mature entries in the
 // retrieved unbonding delegation object and returns the total unbonding balance
 // or an error upon failure.
@@ -1168,3 +1168,62 @@ func (s *KeeperTestSuite) TestSetUnbondingDelegationEntry() {
 	// unbondingID comes from a global counter -> gaps in unbondingIDs are OK as long as every unbondingID is unique
 	require.Equal(uint64(2), resUnbonding.Entries[1].UnbondingId)
 }
+
+func (s *KeeperTestSuite) TestTransferUnbonding() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	s.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	fromAddr, toAddr, valAddr := delAddrs[0], delAddrs[1], valAddrs[0]
+
+	// nothing is transferred without unbonding delegation
+	transferred, err := keeper.TransferUnbonding(ctx, fromAddr, toAddr, valAddr, math.NewInt(10))
+	require.NoError(err)
+	require.True(transferred.IsZero())
+
+	_, err = keeper.SetUnbondingDelegationEntry(ctx, fromAddr, valAddr, 1, time.Unix(100, 0).UTC(), math.NewInt(5))
+	require.NoError(err)
+	_, err = keeper.SetUnbondingDelegationEntry(ctx, fromAddr, valAddr, 2, time.Unix(200, 0).UTC(), math.NewInt(10))
+	require.NoError(err)
+
+	// the most recent entry is transferred first, then the older one is split
+	transferred, err = keeper.TransferUnbonding(ctx, fromAddr, toAddr, valAddr, math.NewInt(12))
+	require.NoError(err)
+	require.Equal(math.NewInt(12), transferred)
+
+	fromUbd, err := keeper.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	require.NoError(err)
+	require.Len(fromUbd.Entries, 1)
+	require.Equal(int64(1), fromUbd.Entries[0].CreationHeight)
+	require.Equal(math.NewInt(3), fromUbd.Entries[0].Balance)
+	require.Equal(math.NewInt(3), fromUbd.Entries[0].InitialBalance)
+
+	toUbd, err := keeper.GetUnbondingDelegation(ctx, toAddr, valAddr)
+	require.NoError(err)
+	require.Len(toUbd.Entries, 2)
+	require.Equal(int64(2), toUbd.Entries[0].CreationHeight)
+	require.Equal(time.Unix(200, 0).UTC(), toUbd.Entries[0].CompletionTime)
+	require.Equal(math.NewInt(10), toUbd.Entries[0].Balance)
+	require.Equal(int64(1), toUbd.Entries[1].CreationHeight)
+	require.Equal(math.NewInt(2), toUbd.Entries[1].Balance)
+
+	// the transferred entries are queued for completion
+	pairs, err := keeper.GetUBDQueueTimeSlice(ctx, time.Unix(200, 0).UTC())
+	require.NoError(err)
+	require.Contains(pairs, stakingtypes.DVPair{DelegatorAddress: toUbd.DelegatorAddress, ValidatorAddress: toUbd.ValidatorAddress})
+
+	// transferring more than available empties the unbonding delegation
+	transferred, err = keeper.TransferUnbonding(ctx, fromAddr, toAddr, valAddr, math.NewInt(100))
+	require.NoError(err)
+	require.Equal(math.NewInt(3), transferred)
+
+	_, err = keeper.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	require.ErrorIs(err, stakingtypes.ErrNoUnbondingDelegation)
+
+	toUbd, err = keeper.GetUnbondingDelegation(ctx, toAddr, valAddr)
+	require.NoError(err)
+	require.Len(toUbd.Entries, 2)
+	require.Equal(math.NewInt(5), toUbd.Entries[1].Balance)
+}
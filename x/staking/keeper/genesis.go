@@ -137,6 +137,20 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId); err != nil {
+		panic(err)
+	}
+
+	if err := k.initLiquidStakeTotals(ctx); err != nil {
+		panic(err)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -266,14 +280,26 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	tokenizeShareRecords, err := k.GetAllTokenizeShareRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	lastTokenizeShareRecordID, err := k.GetLastTokenizeShareRecordID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:               params,
-		LastTotalPower:       totalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           allValidators,
-		Delegations:          allDelegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    params,
+		LastTotalPower:            totalPower,
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                allValidators,
+		Delegations:               allDelegations,
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		TokenizeShareRecords:      tokenizeShareRecords,
+		LastTokenizeShareRecordId: lastTokenizeShareRecordID,
 	}
 }
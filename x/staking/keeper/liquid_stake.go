@@ -0,0 +1,434 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTotalLiquidStakedTokens returns the total amount of tokens held as
+// tokenized shares.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.TotalLiquidStakedTokensKey)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	if bz == nil {
+		return math.ZeroInt(), nil
+	}
+
+	ip := sdk.IntProto{}
+	if err := k.cdc.Unmarshal(bz, &ip); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return ip.Int, nil
+}
+
+// SetTotalLiquidStakedTokens sets the total amount of tokens held as
+// tokenized shares.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx context.Context, tokens math.Int) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&sdk.IntProto{Int: tokens})
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// GetValidatorLiquidShares returns the amount of a validator's delegator
+// shares held as tokenized shares.
+func (k Keeper) GetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	if bz == nil {
+		return math.LegacyZeroDec(), nil
+	}
+
+	dp := sdk.DecProto{}
+	if err := k.cdc.Unmarshal(bz, &dp); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	return dp.Dec, nil
+}
+
+// SetValidatorLiquidShares sets the amount of a validator's delegator shares
+// held as tokenized shares. Zero amounts are removed from the store.
+func (k Keeper) SetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if !shares.IsPositive() {
+		return store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+	}
+
+	bz, err := k.cdc.Marshal(&sdk.DecProto{Dec: shares})
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// safelyIncreaseLiquidStake records newly tokenized shares against the global
+// and validator liquid staking caps, failing if either cap would be exceeded.
+func (k Keeper) safelyIncreaseLiquidStake(ctx context.Context, validator types.Validator, shares math.LegacyDec, tokens math.Int) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	totalLiquid, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	totalBonded, err := k.TotalValidatorPower(ctx)
+	if err != nil {
+		return err
+	}
+
+	totalLiquid = totalLiquid.Add(tokens)
+	if params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) &&
+		math.LegacyNewDecFromInt(totalLiquid).GT(params.GlobalLiquidStakingCap.MulInt(totalBonded)) {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	validatorLiquid, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	validatorLiquid = validatorLiquid.Add(shares)
+	if params.ValidatorLiquidStakingCap.LT(math.LegacyOneDec()) &&
+		validatorLiquid.GT(params.ValidatorLiquidStakingCap.Mul(validator.DelegatorShares)) {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	if err := k.SetTotalLiquidStakedTokens(ctx, totalLiquid); err != nil {
+		return err
+	}
+
+	return k.SetValidatorLiquidShares(ctx, valAddr, validatorLiquid)
+}
+
+// decreaseLiquidStake removes redeemed shares from the global and validator
+// liquid staking totals. The totals never go below zero, since slashing may
+// have reduced the value of the shares since they were tokenized.
+func (k Keeper) decreaseLiquidStake(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec, tokens math.Int) error {
+	totalLiquid, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SetTotalLiquidStakedTokens(ctx, math.MaxInt(totalLiquid.Sub(tokens), math.ZeroInt())); err != nil {
+		return err
+	}
+
+	validatorLiquid, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.SetValidatorLiquidShares(ctx, valAddr, math.LegacyMaxDec(validatorLiquid.Sub(shares), math.LegacyZeroDec()))
+}
+
+// decreaseLiquidStakeOnSlash removes the liquid portion of slashed validator
+// tokens from the total liquid staked tokens.
+func (k Keeper) decreaseLiquidStakeOnSlash(ctx context.Context, validator types.Validator, slashedTokens math.Int) error {
+	if validator.DelegatorShares.IsZero() {
+		return nil
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	validatorLiquid, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil || validatorLiquid.IsZero() {
+		return err
+	}
+
+	liquidSlashed := validatorLiquid.Quo(validator.DelegatorShares).MulInt(slashedTokens).TruncateInt()
+	totalLiquid, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.SetTotalLiquidStakedTokens(ctx, math.MaxInt(totalLiquid.Sub(liquidSlashed), math.ZeroInt()))
+}
+
+// initLiquidStakeTotals computes the global and validator liquid staking
+// totals from the delegations held by the tokenize share record accounts.
+func (k Keeper) initLiquidStakeTotals(ctx context.Context) error {
+	records, err := k.GetAllTokenizeShareRecords(ctx)
+	if err != nil {
+		return err
+	}
+
+	totalLiquid := math.ZeroInt()
+	for _, record := range records {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+		if err != nil {
+			return err
+		}
+
+		delegation, err := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if errors.Is(err, types.ErrNoDelegation) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		validatorLiquid, err := k.GetValidatorLiquidShares(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SetValidatorLiquidShares(ctx, valAddr, validatorLiquid.Add(delegation.Shares)); err != nil {
+			return err
+		}
+
+		totalLiquid = totalLiquid.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+	}
+
+	return k.SetTotalLiquidStakedTokens(ctx, totalLiquid)
+}
+
+// TokenizeShares converts part of a delegation into share tokens. The shares
+// are moved to a new tokenize share record account and an equal amount of
+// share tokens is minted to the delegator. The record owner is entitled to the
+// rewards earned by the tokenized delegation.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner string,
+) (sdk.Coin, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// shares received through an in-progress redelegation must remain
+	// slashable by the source validator, so they cannot be moved elsewhere
+	hasRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if hasRedelegation {
+		return sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if !shares.TruncateInt().IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "too few shares to tokenize")
+	}
+
+	if err := k.safelyIncreaseLiquidStake(ctx, validator, shares, amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	record, err := k.addTokenizeShareRecord(ctx, validator.GetOperator(), owner)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the tokens go through the delegator account so that vesting accounts
+	// track the undelegation, and locked tokens cannot be tokenized
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, record.GetModuleAddress(), returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordShares, err := k.Delegate(ctx, record.GetModuleAddress(), returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the unbonded tokens are truncated, so when the exchange rate is not 1:1
+	// the record receives fewer shares than were unbonded. Share tokens are
+	// minted for the shares of the record, so that they can all be redeemed.
+	if !recordShares.TruncateInt().IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "too few shares to tokenize")
+	}
+	if lost := shares.Sub(recordShares); lost.IsPositive() {
+		if err := k.decreaseLiquidStake(ctx, valAddr, lost, amount.Sub(returnAmount)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), recordShares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens and delegates the underlying tokens
+// of the tokenize share record back to its validator on behalf of the
+// delegator. Once a record has no delegation left, its remaining rewards are
+// paid out to the record owner and the record is removed.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, shareToken.Denom)
+	if balance.Amount.LT(shareToken.Amount) {
+		return sdk.Coin{}, types.ErrNotEnoughBalance
+	}
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	delegation, err := k.GetDelegation(ctx, recordAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// redeeming all the share tokens redeems the full delegation, including
+	// any fractional shares lost when the share tokens were minted
+	shares := math.LegacyNewDecFromInt(shareToken.Amount)
+	if shareToken.Amount.Equal(delegation.Shares.TruncateInt()) {
+		shares = delegation.Shares
+	}
+
+	if shares.GT(delegation.Shares) {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid shares amount")
+	}
+
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+	if err := k.decreaseLiquidStake(ctx, valAddr, shares, tokens); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnCoin := sdk.NewCoin(bondDenom, returnAmount)
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, recordAddr, sdk.NewCoins(returnCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, recordAddr, delAddr, sdk.NewCoins(returnCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the record account only holds the rewards withdrawn while unbonding
+	// once its delegation is gone, so they are paid out to the record owner
+	if _, err := k.GetDelegation(ctx, recordAddr, valAddr); errors.Is(err, types.ErrNoDelegation) {
+		owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr); !rewards.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, rewards); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+	} else if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, err := k.Delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return returnCoin, nil
+}
@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupTokenizeShares creates an unbonded validator with a 1000 token
+// delegation from the returned delegator.
+func (s *KeeperTestSuite) setupTokenizeShares() (sdk.AccAddress, sdk.ValAddress) {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any(), gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)).AnyTimes()
+
+	comm := stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+	msg, err := stakingtypes.NewMsgCreateValidator(ValAddr.String(), ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	delAddr := sdk.AccAddress(PKs[1].Address())
+	_, err = keeper.Delegate(ctx, delAddr, math.NewInt(1000), stakingtypes.Unbonded, s.mustGetValidator(ValAddr), true)
+	require.NoError(err)
+
+	return delAddr, ValAddr
+}
+
+func (s *KeeperTestSuite) mustGetValidator(valAddr sdk.ValAddress) stakingtypes.Validator {
+	validator, err := s.stakingKeeper.GetValidator(s.ctx, valAddr)
+	s.Require().NoError(err)
+	return validator
+}
+
+func (s *KeeperTestSuite) TestTokenizeAndRedeemShares() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	delAddr, valAddr := s.setupTokenizeShares()
+	owner := sdk.AccAddress(PKs[2].Address())
+
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any()).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.ModuleName, delAddr, gomock.Any()).Times(1)
+
+	res, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(delAddr.String(), valAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 400), owner.String()))
+	require.NoError(err)
+
+	record, err := keeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(err)
+	require.Equal(owner.String(), record.Owner)
+	require.Equal(valAddr.String(), record.Validator)
+	require.Equal(sdk.NewInt64Coin(record.GetShareTokenDenom(), 400), res.Amount)
+
+	byDenom, err := keeper.GetTokenizeShareRecordByDenom(ctx, res.Amount.Denom)
+	require.NoError(err)
+	require.Equal(record, byDenom)
+
+	owned, err := keeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	require.NoError(err)
+	require.Equal([]stakingtypes.TokenizeShareRecord{record}, owned)
+
+	delegation, err := keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(600), delegation.Shares)
+
+	recordDelegation, err := keeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(400), recordDelegation.Shares)
+
+	totalLiquid, err := keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.Equal(math.NewInt(400), totalLiquid)
+
+	validatorLiquid, err := keeper.GetValidatorLiquidShares(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(400), validatorLiquid)
+
+	// redeeming all the share tokens removes the record
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), delAddr, res.Amount.Denom).Return(res.Amount)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.ModuleName, sdk.NewCoins(res.Amount))
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ModuleName, sdk.NewCoins(res.Amount))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), record.GetModuleAddress()).Return(sdk.NewCoins())
+
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(delAddr.String(), res.Amount))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400), redeemRes.Amount)
+
+	_, err = keeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	delegation, err = keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(1000), delegation.Shares)
+
+	totalLiquid, err = keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.True(totalLiquid.IsZero())
+
+	validatorLiquid, err = keeper.GetValidatorLiquidShares(ctx, valAddr)
+	require.NoError(err)
+	require.True(validatorLiquid.IsZero())
+}
+
+func (s *KeeperTestSuite) TestRedeemAllSharesOfSlashedValidator() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	delAddr, valAddr := s.setupTokenizeShares()
+	owner := sdk.AccAddress(PKs[2].Address())
+
+	// about half of the tokens of the validator were slashed, so the tokens
+	// unbonded from the delegator are truncated
+	validator := s.mustGetValidator(valAddr)
+	validator.Tokens = math.NewInt(514)
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any()).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.ModuleName, delAddr, gomock.Any()).Times(1)
+
+	res, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(delAddr.String(), valAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 64), owner.String()))
+	require.NoError(err)
+
+	// the share tokens are minted for the shares of the record
+	record, err := keeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(err)
+	recordDelegation, err := keeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.NoError(err)
+	require.Equal(recordDelegation.Shares.TruncateInt(), res.Amount.Amount)
+
+	// all of them can be redeemed
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), delAddr, res.Amount.Denom).Return(res.Amount)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.ModuleName, sdk.NewCoins(res.Amount))
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ModuleName, sdk.NewCoins(res.Amount))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), record.GetModuleAddress()).Return(sdk.NewCoins())
+
+	_, err = msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(delAddr.String(), res.Amount))
+	require.NoError(err)
+
+	_, err = keeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	validatorLiquid, err := keeper.GetValidatorLiquidShares(ctx, valAddr)
+	require.NoError(err)
+	require.True(validatorLiquid.IsZero())
+}
+
+func (s *KeeperTestSuite) TestTokenizeSharesCaps() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	delAddr, valAddr := s.setupTokenizeShares()
+
+	testCases := []struct {
+		name         string
+		globalCap    math.LegacyDec
+		validatorCap math.LegacyDec
+		expErr       error
+	}{
+		{
+			name:         "validator cap exceeded",
+			globalCap:    math.LegacyOneDec(),
+			validatorCap: math.LegacyNewDecWithPrec(1, 1),
+			expErr:       stakingtypes.ErrValidatorLiquidStakingCapExceeded,
+		},
+		{
+			name:         "global cap exceeded",
+			globalCap:    math.LegacyNewDecWithPrec(1, 1),
+			validatorCap: math.LegacyOneDec(),
+			expErr:       stakingtypes.ErrGlobalLiquidStakingCapExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			params, err := keeper.GetParams(ctx)
+			require.NoError(err)
+			params.GlobalLiquidStakingCap = tc.globalCap
+			params.ValidatorLiquidStakingCap = tc.validatorCap
+			require.NoError(keeper.SetParams(ctx, params))
+
+			_, err = msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(delAddr.String(), valAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 400), delAddr.String()))
+			require.ErrorIs(err, tc.expErr)
+		})
+	}
+}
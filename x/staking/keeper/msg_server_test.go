@@ -1071,12 +1071,14 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &stakingtypes.MsgUpdateParams{
 				Authority: keeper.GetAuthority(),
 				Params: stakingtypes.Params{
-					MinCommissionRate: stakingtypes.DefaultMinCommissionRate,
-					UnbondingTime:     stakingtypes.DefaultUnbondingTime,
-					MaxValidators:     stakingtypes.DefaultMaxValidators,
-					MaxEntries:        stakingtypes.DefaultMaxEntries,
-					HistoricalEntries: stakingtypes.DefaultHistoricalEntries,
-					BondDenom:         "ghosttoken",
+					MinCommissionRate:         stakingtypes.DefaultMinCommissionRate,
+					UnbondingTime:             stakingtypes.DefaultUnbondingTime,
+					MaxValidators:             stakingtypes.DefaultMaxValidators,
+					MaxEntries:                stakingtypes.DefaultMaxEntries,
+					HistoricalEntries:         stakingtypes.DefaultHistoricalEntries,
+					BondDenom:                 "ghosttoken",
+					GlobalLiquidStakingCap:    stakingtypes.DefaultGlobalLiquidStakingCap,
+					ValidatorLiquidStakingCap: stakingtypes.DefaultValidatorLiquidStakingCap,
 				},
 			},
 			expErr:    true,
@@ -1117,6 +1119,24 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			expErr:    true,
 			expErrMsg: "max entries must be positive",
 		},
+		{
+			name: "liquid staking cap greater than 100%",
+			input: &stakingtypes.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params: stakingtypes.Params{
+					MinCommissionRate:         stakingtypes.DefaultMinCommissionRate,
+					UnbondingTime:             stakingtypes.DefaultUnbondingTime,
+					MaxValidators:             stakingtypes.DefaultMaxValidators,
+					MaxEntries:                stakingtypes.DefaultMaxEntries,
+					HistoricalEntries:         stakingtypes.DefaultHistoricalEntries,
+					BondDenom:                 "denom",
+					GlobalLiquidStakingCap:    math.LegacyNewDec(2),
+					ValidatorLiquidStakingCap: stakingtypes.DefaultValidatorLiquidStakingCap,
+				},
+			},
+			expErr:    true,
+			expErrMsg: "global liquid staking cap cannot be greater than 100%",
+		},
 		{
 			name: "negative unbounding time",
 			input: &stakingtypes.MsgUpdateParams{
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TestnetFork overrides the staking params and replaces the validator set of a
+// testnet fork. The validators of the network begin unbonding and are jailed,
+// so that they do not bond again. The validators of the testnet are created
+// with a self-delegation of their power, spent from the balance of their
+// operator, and bonded right away.
+//
+// No validator update is returned to CometBFT, as its own validator set is
+// replaced along with the state.
+func (k Keeper) TestnetFork(ctx context.Context, cdc codec.JSONCodec, spec *module.TestnetForkSpec) error {
+	lastValidators, err := k.GetLastValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the validators of the network leave the validator set
+	for _, validator := range lastValidators {
+		if validator, err = k.bondedToUnbonding(ctx, validator); err != nil {
+			return err
+		}
+		if err := k.bondedTokensToNotBonded(ctx, validator.GetTokens()); err != nil {
+			return err
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+		if err != nil {
+			return err
+		}
+		if err := k.DeleteLastValidatorPower(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+
+	if err := k.jailPowerIndex(ctx); err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if err := spec.ApplyParamsOverride(cdc, types.ModuleName, &params); err != nil {
+		return err
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	if len(spec.Validators) > int(params.MaxValidators) {
+		return fmt.Errorf("%d validators exceed the maximum of %d validators", len(spec.Validators), params.MaxValidators)
+	}
+
+	msgServer := NewMsgServerImpl(&k)
+	totalPower := int64(0)
+	for _, val := range spec.Validators {
+		if err := k.createTestnetValidator(ctx, msgServer, params, val); err != nil {
+			return fmt.Errorf("validator %s: %w", val.Moniker, err)
+		}
+		totalPower += val.Power
+	}
+
+	return k.SetLastTotalPower(ctx, math.NewInt(totalPower))
+}
+
+// jailPowerIndex jails the validators of the power index, which are the ones
+// able to join the validator set.
+func (k Keeper) jailPowerIndex(ctx context.Context) error {
+	iterator, err := k.ValidatorsPowerStoreIterator(ctx)
+	if err != nil {
+		return err
+	}
+
+	var valAddrs []sdk.ValAddress
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, iterator.Value())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, valAddr := range valAddrs {
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		if err := k.jailValidator(ctx, validator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createTestnetValidator creates and bonds a validator of a testnet fork.
+func (k Keeper) createTestnetValidator(ctx context.Context, msgServer types.MsgServer, params types.Params, val module.TestnetForkValidator) error {
+	operator, err := k.authKeeper.AddressCodec().StringToBytes(val.Operator)
+	if err != nil {
+		return fmt.Errorf("invalid operator address %s: %w", val.Operator, err)
+	}
+	valAddr, err := k.validatorAddressCodec.BytesToString(operator)
+	if err != nil {
+		return err
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(val.ConsPubKey)
+	if err != nil {
+		return err
+	}
+
+	tokens := k.TokensFromConsensusPower(ctx, val.Power)
+	_, err = msgServer.CreateValidator(ctx, &types.MsgCreateValidator{
+		Description:       types.Description{Moniker: val.Moniker},
+		Commission:        types.NewCommissionRates(params.MinCommissionRate, math.LegacyOneDec(), math.LegacyNewDecWithPrec(1, 2)),
+		MinSelfDelegation: math.OneInt(),
+		ValidatorAddress:  valAddr,
+		Pubkey:            pkAny,
+		Value:             sdk.NewCoin(params.BondDenom, tokens),
+	})
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.GetValidator(ctx, operator)
+	if err != nil {
+		return err
+	}
+	if _, err := k.unbondedToBonded(ctx, validator); err != nil {
+		return err
+	}
+	if err := k.notBondedTokensToBonded(ctx, tokens); err != nil {
+		return err
+	}
+
+	return k.SetLastValidatorPower(ctx, operator, val.Power)
+}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the most recently created
+// tokenize share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx context.Context) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.LastTokenizeShareRecordIDKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// SetLastTokenizeShareRecordID sets the id of the most recently created
+// tokenize share record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx context.Context, id uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, id uint64) (types.TokenizeShareRecord, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	if bz == nil {
+		return types.TokenizeShareRecord{}, types.ErrTokenizeShareRecordNotExists.Wrapf("id %d", id)
+	}
+
+	var record types.TokenizeShareRecord
+	if err := k.cdc.Unmarshal(bz, &record); err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	return record, nil
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record whose share
+// tokens have the given denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx context.Context, denom string) (types.TokenizeShareRecord, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	if bz == nil {
+		return types.TokenizeShareRecord{}, types.ErrTokenizeShareRecordNotExists.Wrapf("denom %s", denom)
+	}
+
+	return k.GetTokenizeShareRecord(ctx, binary.BigEndian.Uint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by
+// the given account.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]types.TokenizeShareRecord, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := types.GetTokenizeShareRecordIDsByOwnerPrefix(owner)
+	iterator, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var records []types.TokenizeShareRecord
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := k.GetTokenizeShareRecord(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx context.Context) ([]types.TokenizeShareRecord, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.TokenizeShareRecordPrefix, storetypes.PrefixEndBytes(types.TokenizeShareRecordPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var records []types.TokenizeShareRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// SetTokenizeShareRecord stores a tokenize share record along with its owner
+// and denom indexes.
+func (k Keeper) SetTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	idBz := sdk.Uint64ToBigEndian(record.Id)
+	if err := store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), bz); err != nil {
+		return err
+	}
+
+	if err := store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, record.Id), idBz); err != nil {
+		return err
+	}
+
+	return store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), idBz)
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record and its indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx context.Context, id uint64) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetTokenizeShareRecordByIndexKey(id)); err != nil {
+		return err
+	}
+
+	if err := store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id)); err != nil {
+		return err
+	}
+
+	return store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+}
+
+// addTokenizeShareRecord creates a new tokenize share record for the given
+// validator and owner, using the next record id.
+func (k Keeper) addTokenizeShareRecord(ctx context.Context, validator, owner string) (types.TokenizeShareRecord, error) {
+	id, err := k.GetLastTokenizeShareRecordID(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	id++
+	if err := k.SetLastTokenizeShareRecordID(ctx, id); err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	record := types.TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner,
+		ModuleAccount: types.GetTokenizeShareModuleAccountName(id),
+		Validator:     validator,
+	}
+
+	return record, k.SetTokenizeShareRecord(ctx, record)
+}
@@ -1,6 +1,7 @@
 package v4
 
 import (
+	"reflect"
 	"slices"
 
 	sdkmath "cosmossdk.io/math"
@@ -32,8 +33,12 @@ func migrateParams(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCo
 	var legacyParams types.Params
 	legacySubspace.GetParamSet(ctx, &legacyParams)
 
-	if err := legacyParams.Validate(); err != nil {
-		return err
+	// only the legacy param set is validated, the liquid staking caps are
+	// not part of it and are set by the v6 migration
+	for _, pair := range legacyParams.ParamSetPairs() {
+		if err := pair.ValidatorFn(reflect.ValueOf(pair.Value).Elem().Interface()); err != nil {
+			return err
+		}
 	}
 
 	bz := cdc.MustMarshal(&legacyParams)
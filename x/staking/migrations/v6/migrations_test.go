@@ -0,0 +1,36 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// params as stored before v6, without the liquid staking caps
+	oldParams := types.DefaultParams()
+	oldParams.GlobalLiquidStakingCap = types.Params{}.GlobalLiquidStakingCap
+	oldParams.ValidatorLiquidStakingCap = types.Params{}.ValidatorLiquidStakingCap
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v6.MigrateStore(ctx, store, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, params.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, params.ValidatorLiquidStakingCap)
+	require.Equal(t, oldParams.UnbondingTime, params.UnbondingTime)
+	require.Equal(t, oldParams.BondDenom, params.BondDenom)
+}
@@ -48,4 +48,10 @@ var (
 	ErrInvalidSigner                   = errors.Register(ModuleName, 43, "expected authority account as only signer for proposal message")
 	ErrBadRedelegationSrc              = errors.Register(ModuleName, 44, "redelegation source validator not found")
 	ErrNoUnbondingType                 = errors.Register(ModuleName, 45, "unbonding type not found")
+
+	ErrTokenizeShareRecordNotExists      = errors.Register(ModuleName, 46, "tokenize share record does not exist")
+	ErrNotEnoughBalance                  = errors.Register(ModuleName, 47, "not enough balance for tokenize share redemption")
+	ErrRedelegationInProgress            = errors.Register(ModuleName, 48, "delegator is not allowed to tokenize shares from validator with a redelegation in progress")
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 49, "tokenization exceeds the global liquid staking cap")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 50, "tokenization exceeds the validator liquid staking cap")
 )
@@ -46,6 +46,10 @@ type GenesisState struct {
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	// exported defines a bool to identify whether the chain dealing with exported or initialized genesis.
 	Exported bool `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the most recently created tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -130,6 +134,20 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -181,40 +199,45 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0x86, 0x6d, 0x4a, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x09, 0x32, 0x19, 0x9c, 0x10, 0x75, 0x88,
-	0x0a, 0xb1, 0x69, 0xd8, 0xd8, 0x1a, 0x21, 0x50, 0xa5, 0x4a, 0x54, 0x2e, 0x65, 0xe8, 0x12, 0x5d,
-	0xea, 0x93, 0x73, 0x8a, 0x7d, 0x67, 0xf9, 0xbe, 0x94, 0xf2, 0x0f, 0x18, 0xd9, 0x58, 0x3b, 0x32,
-	0x32, 0xf4, 0x47, 0x74, 0xac, 0x3a, 0x21, 0x86, 0x0a, 0x25, 0x03, 0xfc, 0x0c, 0xe4, 0x3b, 0xc7,
-	0x18, 0xa5, 0x5e, 0x92, 0x38, 0xdf, 0xf3, 0x3e, 0xef, 0x27, 0xf9, 0x0e, 0x6d, 0x9d, 0x08, 0x19,
-	0x09, 0xe9, 0x4a, 0x20, 0x53, 0xc6, 0x03, 0xf7, 0x74, 0x67, 0x4c, 0x81, 0xec, 0xb8, 0x01, 0xe5,
-	0x54, 0x32, 0xe9, 0xc4, 0x89, 0x00, 0x81, 0x1f, 0x6b, 0xca, 0xc9, 0x28, 0x27, 0xa3, 0x5a, 0x8d,
-	0x40, 0x04, 0x42, 0x21, 0x6e, 0xfa, 0x4b, 0xd3, 0xad, 0x32, 0xe7, 0x32, 0xad, 0xa9, 0x27, 0x9a,
-	0x1a, 0xe9, 0x78, 0x56, 0xa0, 0x47, 0x0f, 0x49, 0xc4, 0xb8, 0x70, 0xd5, 0xa7, 0xfe, 0xab, 0xfb,
-	0xb5, 0x82, 0x36, 0xdf, 0xea, 0x9d, 0x0e, 0x81, 0x00, 0xc5, 0xbb, 0xa8, 0x1a, 0x93, 0x84, 0x44,
-	0xd2, 0x32, 0x3b, 0x66, 0xaf, 0x3e, 0xb0, 0x9d, 0xdb, 0x77, 0x74, 0x0e, 0x14, 0x35, 0xdc, 0xb8,
-	0xbc, 0x69, 0x1b, 0xdf, 0x7e, 0x7f, 0xdf, 0x36, 0xbd, 0x2c, 0x88, 0x8f, 0xd1, 0x83, 0x90, 0x48,
-	0x18, 0x81, 0x00, 0x12, 0x8e, 0x62, 0xf1, 0x91, 0x26, 0xd6, 0x9d, 0x8e, 0xd9, 0xdb, 0x1c, 0xbe,
-	0x48, 0xe1, 0x9f, 0x37, 0xed, 0xa6, 0x76, 0x4a, 0x7f, 0xea, 0x30, 0xe1, 0x46, 0x04, 0x26, 0xce,
-	0x1e, 0x87, 0xeb, 0x8b, 0x3e, 0xca, 0xca, 0xf6, 0x38, 0x68, 0xe7, 0xfd, 0xd4, 0xf4, 0x3e, 0x15,
-	0x1d, 0xa4, 0x1e, 0xcc, 0x50, 0x53, 0xb9, 0x4f, 0x49, 0xc8, 0x7c, 0x02, 0x22, 0xd1, 0x7e, 0x69,
-	0xad, 0x75, 0xd6, 0x7a, 0xf5, 0xc1, 0x76, 0xd9, 0xb6, 0xfb, 0x44, 0xc2, 0x87, 0x65, 0x46, 0xa9,
-	0x8a, 0x9b, 0x3f, 0x0a, 0x57, 0xc6, 0x12, 0xef, 0x23, 0x94, 0xb7, 0x48, 0xeb, 0xae, 0xf2, 0x3f,
-	0x2d, 0xf3, 0xe7, 0xe1, 0xa2, 0xb6, 0x90, 0xc7, 0xef, 0x50, 0xdd, 0xa7, 0x21, 0x0d, 0x08, 0x30,
-	0xc1, 0xa5, 0x55, 0x51, 0xba, 0x6e, 0x99, 0xee, 0x75, 0x8e, 0x16, 0x7d, 0x45, 0x03, 0x9e, 0xa2,
-	0xe6, 0x8c, 0x8f, 0x05, 0xf7, 0x19, 0x0f, 0x46, 0x45, 0x75, 0x55, 0xa9, 0x9f, 0x95, 0xa9, 0x8f,
-	0x96, 0xa1, 0xdb, 0x3b, 0x1a, 0xb3, 0xd5, 0xb9, 0xc4, 0x47, 0xe8, 0x5e, 0x42, 0x8b, 0x25, 0xeb,
-	0xaa, 0x64, 0xab, 0xac, 0xc4, 0x2b, 0xc0, 0x45, 0xfb, 0xff, 0x16, 0xdc, 0x42, 0x35, 0x7a, 0x16,
-	0x8b, 0x04, 0xa8, 0x6f, 0xd5, 0x3a, 0x66, 0xaf, 0xe6, 0xe5, 0xcf, 0xdd, 0x09, 0xc2, 0xab, 0x2f,
-	0x0d, 0x0f, 0xd0, 0x3a, 0xf1, 0xfd, 0x84, 0x4a, 0x7d, 0x3e, 0x37, 0x86, 0xd6, 0xf5, 0x45, 0xbf,
-	0x91, 0x6d, 0xb1, 0xab, 0x27, 0x87, 0x90, 0x30, 0x1e, 0x78, 0x4b, 0x10, 0x37, 0x50, 0xe5, 0xdf,
-	0x21, 0x5c, 0xf3, 0xf4, 0xc3, 0xab, 0xda, 0xe7, 0xf3, 0xb6, 0xf1, 0xe7, 0xbc, 0x6d, 0x0c, 0xdf,
-	0x5c, 0xce, 0x6d, 0xf3, 0x6a, 0x6e, 0x9b, 0xbf, 0xe6, 0xb6, 0xf9, 0x65, 0x61, 0x1b, 0x57, 0x0b,
-	0xdb, 0xf8, 0xb1, 0xb0, 0x8d, 0xe3, 0xe7, 0x01, 0x83, 0xc9, 0x6c, 0xec, 0x9c, 0x88, 0x28, 0xbb,
-	0x49, 0xd9, 0x57, 0x5f, 0xfa, 0x53, 0xf7, 0x2c, 0xbf, 0x89, 0xf0, 0x29, 0xa6, 0x72, 0x5c, 0x55,
-	0x57, 0xea, 0xe5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x57, 0x29, 0x7a, 0x5e, 0xfc, 0x03, 0x00,
-	0x00,
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0xed, 0x5f, 0xdb, 0x34, 0xbd, 0xf6, 0xc7, 0x9f, 0x6b, 0x52, 0xb9, 0x11, 0x38, 0x26,
+	0xea, 0x10, 0x15, 0x62, 0xb7, 0x01, 0x16, 0xb6, 0x5a, 0x08, 0x14, 0xa9, 0x12, 0x95, 0xd3, 0x32,
+	0x74, 0xb1, 0x2e, 0xb9, 0x93, 0x73, 0x4a, 0xe2, 0x8b, 0x7c, 0x97, 0x52, 0x58, 0x58, 0x19, 0x79,
+	0x09, 0x1d, 0x19, 0x19, 0xf2, 0x22, 0x3a, 0x56, 0x9d, 0x10, 0x43, 0x85, 0x92, 0x01, 0x36, 0xde,
+	0x02, 0xf2, 0x9d, 0x93, 0x1a, 0x25, 0x66, 0x89, 0x72, 0x7e, 0xbe, 0xdf, 0xcf, 0xf7, 0x79, 0xa4,
+	0xe7, 0x0e, 0xec, 0xb4, 0x19, 0xef, 0x33, 0xee, 0x70, 0x81, 0xba, 0x34, 0x0c, 0x9c, 0xb3, 0xfd,
+	0x16, 0x11, 0x68, 0xdf, 0x09, 0x48, 0x48, 0x38, 0xe5, 0xf6, 0x20, 0x62, 0x82, 0xc1, 0x2d, 0xa5,
+	0xb2, 0x13, 0x95, 0x9d, 0xa8, 0x4a, 0x85, 0x80, 0x05, 0x4c, 0x4a, 0x9c, 0xf8, 0x9f, 0x52, 0x97,
+	0xb2, 0x98, 0x53, 0xb7, 0x52, 0x6d, 0x2b, 0x95, 0xaf, 0xec, 0x49, 0x80, 0x2a, 0xdd, 0x47, 0x7d,
+	0x1a, 0x32, 0x47, 0xfe, 0xaa, 0x4f, 0x95, 0xdf, 0x39, 0xb0, 0xf1, 0x5a, 0xf5, 0xd4, 0x14, 0x48,
+	0x10, 0x78, 0x00, 0x72, 0x03, 0x14, 0xa1, 0x3e, 0x37, 0x74, 0x4b, 0xaf, 0xae, 0xd7, 0x4d, 0x7b,
+	0x71, 0x8f, 0xf6, 0x91, 0x54, 0xb9, 0x6b, 0x97, 0x37, 0x65, 0xed, 0xcb, 0xcf, 0xaf, 0xbb, 0xba,
+	0x97, 0x18, 0xe1, 0x29, 0xb8, 0xd7, 0x43, 0x5c, 0xf8, 0x82, 0x09, 0xd4, 0xf3, 0x07, 0xec, 0x1d,
+	0x89, 0x8c, 0xff, 0x2c, 0xbd, 0xba, 0xe1, 0xee, 0xc5, 0xe2, 0xef, 0x37, 0xe5, 0xa2, 0x62, 0x72,
+	0xdc, 0xb5, 0x29, 0x73, 0xfa, 0x48, 0x74, 0xec, 0x46, 0x28, 0xae, 0x47, 0x35, 0x90, 0x84, 0x35,
+	0x42, 0xa1, 0x98, 0x77, 0x62, 0xd2, 0x71, 0x0c, 0x3a, 0x8a, 0x39, 0x90, 0x82, 0xa2, 0x64, 0x9f,
+	0xa1, 0x1e, 0xc5, 0x48, 0xb0, 0x48, 0xf1, 0xb9, 0xb1, 0x64, 0x2d, 0x55, 0xd7, 0xeb, 0xbb, 0x59,
+	0xdd, 0x1e, 0x22, 0x2e, 0xde, 0x4e, 0x3d, 0x12, 0x95, 0xee, 0x7c, 0xb3, 0x37, 0x57, 0xe6, 0xf0,
+	0x10, 0x80, 0x59, 0x0a, 0x37, 0x96, 0x25, 0xff, 0x51, 0x16, 0x7f, 0x66, 0x4e, 0x63, 0x53, 0x7e,
+	0xf8, 0x06, 0xac, 0x63, 0xd2, 0x23, 0x01, 0x12, 0x94, 0x85, 0xdc, 0x58, 0x91, 0xb8, 0x4a, 0x16,
+	0xee, 0xe5, 0x4c, 0x9a, 0xe6, 0xa5, 0x09, 0xb0, 0x0b, 0x8a, 0xc3, 0xb0, 0xc5, 0x42, 0x4c, 0xc3,
+	0xc0, 0x4f, 0xa3, 0x73, 0x12, 0xfd, 0x38, 0x0b, 0x7d, 0x32, 0x35, 0x2d, 0xce, 0x28, 0x0c, 0xe7,
+	0xeb, 0x1c, 0x9e, 0x80, 0xff, 0x23, 0x92, 0x0e, 0x59, 0x95, 0x21, 0x3b, 0x59, 0x21, 0x1e, 0xc1,
+	0x0b, 0xe9, 0x7f, 0x53, 0x60, 0x09, 0xe4, 0xc9, 0xf9, 0x80, 0x45, 0x82, 0x60, 0x23, 0x6f, 0xe9,
+	0xd5, 0xbc, 0x37, 0x3b, 0xc3, 0x8f, 0x60, 0x4b, 0xb0, 0x2e, 0x09, 0xe9, 0x07, 0xe2, 0xf3, 0x0e,
+	0x8a, 0x88, 0x1f, 0x91, 0x36, 0x8b, 0x30, 0x37, 0xd6, 0xfe, 0x3d, 0xe0, 0x71, 0xe2, 0x6a, 0xc6,
+	0x26, 0x4f, 0x7a, 0xdc, 0x07, 0x72, 0xf1, 0x46, 0xb5, 0xbb, 0xca, 0x53, 0xe3, 0xb8, 0x6b, 0xed,
+	0xd9, 0xcf, 0x9f, 0x25, 0x33, 0x8b, 0x79, 0x4b, 0x3c, 0xf3, 0xc3, 0x64, 0x8d, 0x17, 0x74, 0xe1,
+	0x53, 0x6c, 0x00, 0x4b, 0xaf, 0x2e, 0xbb, 0x9b, 0x0b, 0xb0, 0xde, 0xb6, 0x5a, 0xdb, 0x39, 0x6a,
+	0x03, 0x57, 0x3a, 0x00, 0xce, 0x2f, 0x23, 0xac, 0x83, 0x55, 0x84, 0x71, 0x44, 0xb8, 0xba, 0x77,
+	0x6b, 0xae, 0x71, 0x3d, 0xaa, 0x15, 0x92, 0x09, 0x0f, 0x54, 0xa5, 0x29, 0x22, 0x1a, 0x06, 0xde,
+	0x54, 0x08, 0x0b, 0x60, 0xe5, 0xf6, 0x72, 0x2d, 0x79, 0xea, 0xf0, 0x22, 0xff, 0xe9, 0xa2, 0xac,
+	0xfd, 0xba, 0x28, 0x6b, 0xee, 0xab, 0xcb, 0xb1, 0xa9, 0x5f, 0x8d, 0x4d, 0xfd, 0xc7, 0xd8, 0xd4,
+	0x3f, 0x4f, 0x4c, 0xed, 0x6a, 0x62, 0x6a, 0xdf, 0x26, 0xa6, 0x76, 0xfa, 0x24, 0xa0, 0xa2, 0x33,
+	0x6c, 0xd9, 0x6d, 0xd6, 0x4f, 0x5e, 0x08, 0xe7, 0x76, 0x02, 0xe7, 0x7c, 0xf6, 0xc2, 0x88, 0xf7,
+	0x03, 0xc2, 0x5b, 0x39, 0xf9, 0x54, 0x3c, 0xfd, 0x33, 0x00, 0xd8, 0x1a, 0xfa, 0x83, 0xd4, 0x04,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -237,6 +260,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -429,6 +471,15 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
 	return n
 }
 
@@ -739,6 +790,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
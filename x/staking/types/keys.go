@@ -60,6 +60,13 @@ var (
 
 	// NOTE: keys in range 0x81–0x87 were previously used in liquid staking forks of the staking module.
 	// Module developers MUST NOT use these keys and MUST consider them "reserved".
+
+	TokenizeShareRecordPrefix          = []byte{0x91} // key for tokenize share records, by id
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x92} // prefix for the tokenize share record ids, by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x93} // prefix for the tokenize share record ids, by share denom
+	LastTokenizeShareRecordIDKey       = []byte{0x94} // key for the last tokenize share record id
+	TotalLiquidStakedTokensKey         = []byte{0x95} // key for the total liquid staked tokens
+	ValidatorLiquidSharesPrefix        = []byte{0x96} // prefix for the liquid shares of each validator
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -426,3 +433,31 @@ func GetHistoricalInfoKey(height int64) []byte {
 	binary.BigEndian.PutUint64(heightBytes, uint64(height))
 	return append(HistoricalInfoKey, heightBytes...)
 }
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record.
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the prefix of the tokenize
+// share record ids owned by an account.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the key of a tokenize share
+// record id in the owner index.
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key of a tokenize share record
+// id in the share denom index.
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the liquid shares of a validator.
+func GetValidatorLiquidSharesKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesPrefix, address.MustLengthPrefix(operatorAddr)...)
+}
@@ -31,18 +31,31 @@ const (
 	DefaultHistoricalEntries uint32 = 10000
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = math.LegacyZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = math.LegacyZeroDec()
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, i.e. no cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, i.e. no cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+)
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate math.LegacyDec) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	minCommissionRate, globalLiquidStakingCap, validatorLiquidStakingCap math.LegacyDec,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
 	}
 }
 
@@ -55,6 +68,8 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
 	)
 }
 
@@ -104,6 +119,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap("global", p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("validator", p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -203,3 +226,17 @@ func validateMinCommissionRate(i any) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(name string, v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s liquid staking cap cannot be nil", name)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s liquid staking cap cannot be negative: %s", name, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s liquid staking cap cannot be greater than 100%%: %s", name, v)
+	}
+
+	return nil
+}
@@ -930,6 +930,12 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded tokens
+	// that may be held as tokenized shares.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of a validator's
+	// delegator shares that may be held as tokenized shares.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1197,6 +1203,81 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents a delegation that has been converted into a
+// transferable share token. The delegation is held by a module account derived
+// from the record id, and the share tokens are redeemable by whoever holds them.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the account entitled to the rewards earned by the delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator the shares are delegated to.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 // ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
 // TODO: explore moving this to proto/cosmos/base to separate modules from tendermint dependence
 type ValidatorUpdates struct {
@@ -1207,7 +1288,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1266,6 +1347,7 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 }
 
@@ -1274,127 +1356,136 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1920 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6c, 0x63, 0x47,
-	0x19, 0xcf, 0x8b, 0x53, 0x27, 0xf9, 0x9c, 0xc4, 0xce, 0x6c, 0x76, 0xd7, 0xeb, 0xa5, 0xb1, 0xd7,
-	0x5d, 0x68, 0xba, 0x34, 0x76, 0x37, 0xa0, 0x3d, 0x04, 0x54, 0x14, 0xc7, 0xde, 0xae, 0x4b, 0x9b,
-	0x04, 0x3b, 0x09, 0x14, 0x01, 0x4f, 0xe3, 0xf7, 0x26, 0xce, 0x23, 0x7e, 0xf3, 0xcc, 0x9b, 0xf1,
-	0x12, 0xdf, 0x39, 0x54, 0x41, 0x48, 0x95, 0x90, 0x10, 0x12, 0x44, 0xac, 0xc4, 0xa5, 0xdc, 0x7a,
-	0x58, 0x71, 0xe7, 0x56, 0x90, 0x90, 0x56, 0x7b, 0x42, 0x95, 0x08, 0x68, 0xf7, 0xd0, 0x0a, 0x2e,
-	0x88, 0x13, 0x47, 0x34, 0x7f, 0xde, 0x1f, 0xc7, 0xc9, 0x66, 0xb3, 0xad, 0xd0, 0x8a, 0x5e, 0x9e,
-	0xde, 0xcc, 0x7c, 0xdf, 0x6f, 0xe6, 0xfb, 0x7d, 0x7f, 0xe6, 0x0f, 0x5c, 0xb7, 0x3c, 0xe6, 0x7a,
-	0xac, 0xcc, 0x38, 0xde, 0x73, 0x68, 0xbb, 0x7c, 0xf7, 0x66, 0x8b, 0x70, 0x7c, 0x33, 0x68, 0x97,
-	0xba, 0xbe, 0xc7, 0x3d, 0x74, 0x49, 0x49, 0x95, 0x82, 0x5e, 0x2d, 0x95, 0x9b, 0x6b, 0x7b, 0x6d,
-	0x4f, 0x8a, 0x94, 0xc5, 0x9f, 0x92, 0xce, 0x5d, 0x69, 0x7b, 0x5e, 0xbb, 0x43, 0xca, 0xb2, 0xd5,
-	0xea, 0xed, 0x94, 0x31, 0xed, 0xeb, 0xa1, 0xf9, 0xe3, 0x43, 0x76, 0xcf, 0xc7, 0xdc, 0xf1, 0xa8,
-	0x1e, 0xcf, 0x1f, 0x1f, 0xe7, 0x8e, 0x4b, 0x18, 0xc7, 0x6e, 0x37, 0xc0, 0x56, 0x2b, 0x31, 0xd5,
-	0xa4, 0x7a, 0x59, 0x1a, 0x5b, 0x9b, 0xd2, 0xc2, 0x8c, 0x84, 0x76, 0x58, 0x9e, 0x13, 0x60, 0xcf,
-	0x62, 0xd7, 0xa1, 0x5e, 0x59, 0x7e, 0x75, 0xd7, 0x17, 0x38, 0xa1, 0x36, 0xf1, 0x5d, 0x87, 0xf2,
-	0x32, 0xef, 0x77, 0x09, 0x53, 0x5f, 0x3d, 0x7a, 0x35, 0x36, 0x8a, 0x5b, 0x96, 0x13, 0x1f, 0x2c,
-	0xfe, 0xdc, 0x80, 0x99, 0x3b, 0x0e, 0xe3, 0x9e, 0xef, 0x58, 0xb8, 0x53, 0xa7, 0x3b, 0x1e, 0xfa,
-	0x1a, 0x24, 0x77, 0x09, 0xb6, 0x89, 0x9f, 0x35, 0x0a, 0xc6, 0x42, 0x6a, 0x29, 0x5b, 0x8a, 0x00,
-	0x4a, 0x4a, 0xf7, 0x8e, 0x1c, 0xaf, 0x4c, 0x7e, 0x78, 0x94, 0x1f, 0x79, 0xff, 0xe3, 0x0f, 0x6e,
-	0x18, 0x0d, 0xad, 0x82, 0xaa, 0x90, 0xbc, 0x8b, 0x3b, 0x8c, 0xf0, 0xec, 0x68, 0x21, 0xb1, 0x90,
-	0x5a, 0xba, 0x56, 0x3a, 0x99, 0xf3, 0xd2, 0x36, 0xee, 0x38, 0x36, 0xe6, 0xde, 0x20, 0x8a, 0xd2,
-	0x2d, 0xfe, 0x62, 0x14, 0xd2, 0xab, 0x9e, 0xeb, 0x3a, 0x8c, 0x39, 0x1e, 0x6d, 0x60, 0x4e, 0x18,
-	0x7a, 0x13, 0xc6, 0x7c, 0xcc, 0x89, 0x5c, 0xd4, 0x64, 0xe5, 0x96, 0x50, 0xfa, 0xe8, 0x28, 0x7f,
-	0x55, 0xc1, 0x33, 0x7b, 0xaf, 0xe4, 0x78, 0x65, 0x17, 0xf3, 0xdd, 0xd2, 0x5b, 0xa4, 0x8d, 0xad,
-	0x7e, 0x95, 0x58, 0x0f, 0xef, 0x2f, 0x82, 0x9e, 0xbd, 0x4a, 0x2c, 0x35, 0x83, 0xc4, 0x40, 0xdf,
-	0x82, 0x09, 0x17, 0xef, 0x9b, 0x12, 0x6f, 0xf4, 0x53, 0xe1, 0x8d, 0xbb, 0x78, 0x5f, 0xac, 0x0f,
-	0xfd, 0x00, 0xd2, 0x02, 0xd2, 0xda, 0xc5, 0xb4, 0x4d, 0x14, 0x72, 0xe2, 0x53, 0x21, 0x4f, 0xbb,
-	0x78, 0x7f, 0x55, 0xa2, 0x09, 0xfc, 0xe5, 0xb1, 0x4f, 0xee, 0xe5, 0x8d, 0xe2, 0x1f, 0x0c, 0x80,
-	0x88, 0x18, 0x84, 0x21, 0x63, 0x85, 0x2d, 0x39, 0x29, 0xd3, 0x4e, 0x7b, 0xf9, 0x34, 0xde, 0x8f,
-	0xd1, 0x5a, 0x99, 0x16, 0xcb, 0x7b, 0x70, 0x94, 0x37, 0xd4, 0xac, 0x69, 0x6b, 0x88, 0xf6, 0x54,
-	0xaf, 0x6b, 0x63, 0x4e, 0x4c, 0x11, 0xc3, 0x92, 0xad, 0xd4, 0x52, 0xae, 0xa4, 0x02, 0xbc, 0x14,
-	0x04, 0x78, 0x69, 0x33, 0x08, 0x70, 0x05, 0xf8, 0xde, 0xdf, 0x02, 0x40, 0x50, 0xda, 0x62, 0x5c,
-	0xdb, 0xf0, 0xbe, 0x01, 0xa9, 0x2a, 0x61, 0x96, 0xef, 0x74, 0x45, 0xca, 0xa0, 0x2c, 0x8c, 0xbb,
-	0x1e, 0x75, 0xf6, 0x74, 0xc0, 0x4d, 0x36, 0x82, 0x26, 0xca, 0xc1, 0x84, 0x63, 0x13, 0xca, 0x1d,
-	0xde, 0x57, 0x6e, 0x6a, 0x84, 0x6d, 0xa1, 0xf5, 0x63, 0xd2, 0x62, 0x4e, 0xc0, 0x73, 0x23, 0x68,
-	0xa2, 0x57, 0x20, 0xc3, 0x88, 0xd5, 0xf3, 0x1d, 0xde, 0x37, 0x2d, 0x8f, 0x72, 0x6c, 0xf1, 0xec,
-	0x98, 0x14, 0x49, 0x07, 0xfd, 0xab, 0xaa, 0x5b, 0x80, 0xd8, 0x84, 0x63, 0xa7, 0xc3, 0xb2, 0x2f,
-	0x28, 0x10, 0xdd, 0xd4, 0x4b, 0x3d, 0x1c, 0x87, 0xc9, 0x30, 0x50, 0xd1, 0x2a, 0x64, 0xbc, 0x2e,
-	0xf1, 0xc5, 0xbf, 0x89, 0x6d, 0xdb, 0x27, 0x8c, 0xe9, 0x68, 0xcc, 0x3e, 0xbc, 0xbf, 0x38, 0xa7,
-	0x09, 0x5f, 0x51, 0x23, 0x4d, 0xee, 0x3b, 0xb4, 0xdd, 0x48, 0x07, 0x1a, 0xba, 0x1b, 0xbd, 0x23,
-	0x5c, 0x46, 0x19, 0xa1, 0xac, 0xc7, 0xcc, 0x6e, 0xaf, 0xb5, 0x47, 0xfa, 0x9a, 0xd4, 0xb9, 0x21,
-	0x52, 0x57, 0x68, 0xbf, 0x92, 0xfd, 0x53, 0x04, 0x6d, 0xf9, 0xfd, 0x2e, 0xf7, 0x4a, 0x1b, 0xbd,
-	0xd6, 0x37, 0x49, 0x5f, 0xb8, 0x4a, 0xe3, 0x6c, 0x48, 0x18, 0x74, 0x09, 0x92, 0x3f, 0xc4, 0x4e,
-	0x87, 0xd8, 0x92, 0x91, 0x89, 0x86, 0x6e, 0xa1, 0x65, 0x48, 0x32, 0x8e, 0x79, 0x8f, 0x49, 0x1a,
-	0x66, 0x96, 0x8a, 0xa7, 0xc5, 0x46, 0xc5, 0xa3, 0x76, 0x53, 0x4a, 0x36, 0xb4, 0x06, 0x5a, 0x85,
-	0x24, 0xf7, 0xf6, 0x08, 0xd5, 0x04, 0x55, 0xbe, 0xac, 0xa3, 0xf9, 0xe2, 0x70, 0x34, 0xd7, 0x29,
-	0x8f, 0xc5, 0x71, 0x9d, 0xf2, 0x86, 0x56, 0x45, 0xdf, 0x83, 0x8c, 0x4d, 0x3a, 0xa4, 0x2d, 0x99,
-	0x63, 0xbb, 0xd8, 0x27, 0x2c, 0x9b, 0x94, 0x70, 0x37, 0xcf, 0x9d, 0x1c, 0x8d, 0x74, 0x08, 0xd5,
-	0x94, 0x48, 0x68, 0x03, 0x52, 0x76, 0x14, 0x4e, 0xd9, 0x71, 0x49, 0xe6, 0x4b, 0xa7, 0xd9, 0x18,
-	0x8b, 0xbc, 0x78, 0xe5, 0x89, 0x43, 0x88, 0x08, 0xea, 0xd1, 0x96, 0x47, 0x6d, 0x87, 0xb6, 0xcd,
-	0x5d, 0xe2, 0xb4, 0x77, 0x79, 0x76, 0xa2, 0x60, 0x2c, 0x24, 0x1a, 0xe9, 0xb0, 0xff, 0x8e, 0xec,
-	0x46, 0x1b, 0x30, 0x13, 0x89, 0xca, 0x0c, 0x99, 0x3c, 0x6f, 0x86, 0x4c, 0x87, 0x00, 0x42, 0x04,
-	0xbd, 0x0d, 0x10, 0xe5, 0x60, 0x16, 0x24, 0x5a, 0xf1, 0xec, 0x6c, 0x8e, 0x1b, 0x13, 0x03, 0x40,
-	0x14, 0x2e, 0xb8, 0x0e, 0x35, 0x19, 0xe9, 0xec, 0x98, 0x9a, 0x39, 0x81, 0x9b, 0x92, 0xf4, 0xbf,
-	0x7e, 0x0e, 0x6f, 0x7e, 0x74, 0x7f, 0x31, 0xad, 0x5a, 0x8b, 0xcc, 0xde, 0x2b, 0xbc, 0x56, 0xfa,
-	0xea, 0xad, 0xc6, 0xac, 0xeb, 0xd0, 0x26, 0xe9, 0xec, 0x54, 0x43, 0x60, 0xf4, 0x75, 0xb8, 0x1a,
-	0x11, 0xe2, 0x51, 0x73, 0xd7, 0xeb, 0xd8, 0xa6, 0x4f, 0x76, 0x4c, 0xcb, 0xeb, 0x51, 0x9e, 0x9d,
-	0x92, 0x34, 0x5e, 0x0e, 0x45, 0xd6, 0xe9, 0x1d, 0xaf, 0x63, 0x37, 0xc8, 0xce, 0xaa, 0x18, 0x46,
-	0x2f, 0x41, 0xc4, 0x86, 0xe9, 0xd8, 0x2c, 0x3b, 0x5d, 0x48, 0x2c, 0x8c, 0x35, 0xa6, 0xc2, 0xce,
-	0xba, 0xcd, 0x96, 0x27, 0xde, 0xbd, 0x97, 0x1f, 0xf9, 0xe4, 0x5e, 0x7e, 0xa4, 0x78, 0x1b, 0xa6,
-	0xb6, 0x71, 0x47, 0xa7, 0x16, 0x61, 0xe8, 0x16, 0x4c, 0xe2, 0xa0, 0x91, 0x35, 0x0a, 0x89, 0x27,
-	0xa6, 0x66, 0x24, 0x5a, 0xfc, 0x9d, 0x01, 0xc9, 0xea, 0xf6, 0x06, 0x76, 0x7c, 0x54, 0x83, 0xd9,
-	0x28, 0x56, 0x9f, 0x36, 0xcb, 0xa3, 0xf0, 0x0e, 0xd2, 0x7c, 0x0d, 0x66, 0xef, 0x06, 0x85, 0x23,
-	0x84, 0x51, 0x5b, 0xcd, 0xb5, 0x87, 0xf7, 0x17, 0x5f, 0xd4, 0x30, 0x61, 0x71, 0x39, 0x86, 0x77,
-	0xf7, 0x58, 0x7f, 0xcc, 0xe6, 0x37, 0x61, 0x5c, 0x2d, 0x95, 0xa1, 0x6f, 0xc0, 0x0b, 0x5d, 0xf1,
-	0x23, 0x4d, 0x4d, 0x2d, 0xcd, 0x9f, 0x1a, 0xf3, 0x52, 0x3e, 0x1e, 0x21, 0x4a, 0xaf, 0xf8, 0xd3,
-	0x51, 0x80, 0xea, 0xf6, 0xf6, 0xa6, 0xef, 0x74, 0x3b, 0x84, 0x7f, 0x56, 0xb6, 0x6f, 0xc1, 0xc5,
-	0xc8, 0x76, 0xe6, 0x5b, 0xe7, 0xb7, 0xff, 0x42, 0xa8, 0xdf, 0xf4, 0xad, 0x13, 0x61, 0x6d, 0xc6,
-	0x43, 0xd8, 0xc4, 0xf9, 0x61, 0xab, 0x8c, 0x0f, 0x33, 0xfb, 0x1d, 0x48, 0x45, 0x64, 0x30, 0x54,
-	0x87, 0x09, 0xae, 0xff, 0x35, 0xc1, 0xc5, 0xd3, 0x09, 0x0e, 0xd4, 0xe2, 0x24, 0x87, 0xea, 0xc5,
-	0xff, 0x18, 0x00, 0xb1, 0x1c, 0x79, 0x3e, 0x63, 0x0c, 0xd5, 0x21, 0xa9, 0x8b, 0x73, 0xe2, 0x59,
-	0x8b, 0xb3, 0x06, 0x88, 0x91, 0xfa, 0xb3, 0x51, 0xb8, 0xb0, 0x15, 0x64, 0xef, 0xf3, 0xcf, 0xc1,
-	0x16, 0x8c, 0x13, 0xca, 0x7d, 0x47, 0x92, 0x20, 0x7c, 0xfe, 0xda, 0x69, 0x3e, 0x3f, 0xc1, 0xa8,
-	0x1a, 0xe5, 0x7e, 0x3f, 0x1e, 0x01, 0x01, 0x56, 0x8c, 0x8f, 0x5f, 0x25, 0x20, 0x7b, 0x9a, 0x2a,
-	0x7a, 0x19, 0xd2, 0x96, 0x4f, 0x64, 0x47, 0xb0, 0xef, 0x18, 0xb2, 0x60, 0xce, 0x04, 0xdd, 0x7a,
-	0xdb, 0x69, 0x80, 0x38, 0xa8, 0x89, 0xe0, 0x12, 0xa2, 0xcf, 0x76, 0x32, 0x9b, 0x89, 0x10, 0xe4,
-	0xc6, 0xb3, 0x09, 0x69, 0x87, 0x3a, 0xdc, 0xc1, 0x1d, 0xb3, 0x85, 0x3b, 0x98, 0x5a, 0xc1, 0x09,
-	0xf6, 0x5c, 0x7b, 0xfe, 0x8c, 0xc6, 0xa8, 0x28, 0x08, 0x54, 0x83, 0xf1, 0x00, 0x6d, 0xec, 0xfc,
-	0x68, 0x81, 0x2e, 0xba, 0x06, 0x53, 0xf1, 0x8d, 0x41, 0x9e, 0x46, 0xc6, 0x1a, 0xa9, 0xd8, 0xbe,
-	0x70, 0xd6, 0xce, 0x93, 0x7c, 0xe2, 0xce, 0xa3, 0x0f, 0x7c, 0xbf, 0x49, 0xc0, 0x6c, 0x83, 0xd8,
-	0xff, 0xff, 0x6e, 0xd9, 0x00, 0x50, 0xa9, 0x2a, 0x2a, 0xa9, 0xf6, 0xcc, 0x33, 0xe4, 0xfb, 0xa4,
-	0x02, 0xa9, 0x32, 0xfe, 0xbf, 0xf2, 0xd0, 0x5f, 0x47, 0x61, 0x2a, 0xee, 0xa1, 0xcf, 0xe5, 0xa6,
-	0x85, 0xd6, 0xa2, 0x32, 0x35, 0x26, 0xcb, 0xd4, 0x2b, 0xa7, 0x95, 0xa9, 0xa1, 0x68, 0x3e, 0xa3,
-	0x3e, 0xfd, 0x3a, 0x01, 0xc9, 0x0d, 0xec, 0x63, 0x97, 0xa1, 0xf5, 0xa1, 0xb3, 0xad, 0xba, 0x5b,
-	0x5e, 0x19, 0x0a, 0xe6, 0xaa, 0x7e, 0xfe, 0x50, 0xb1, 0xfc, 0xcb, 0xd3, 0x8e, 0xb6, 0x5f, 0x84,
-	0x19, 0x71, 0x47, 0x0e, 0x0d, 0x52, 0xe4, 0x4e, 0xcb, 0xab, 0x6e, 0x68, 0x3d, 0x43, 0x79, 0x48,
-	0x09, 0xb1, 0xa8, 0x0e, 0x0b, 0x19, 0x70, 0xf1, 0x7e, 0x4d, 0xf5, 0xa0, 0x45, 0x40, 0xbb, 0xe1,
-	0x9b, 0x85, 0x19, 0x11, 0x21, 0xe4, 0x66, 0xa3, 0x91, 0x40, 0xfc, 0x45, 0x00, 0xb1, 0x0a, 0xd3,
-	0x26, 0xd4, 0x73, 0xf5, 0x45, 0x6f, 0x52, 0xf4, 0x54, 0x45, 0x07, 0xfa, 0x89, 0xa1, 0x8e, 0xc8,
-	0xc7, 0x6e, 0xd2, 0xfa, 0x86, 0xb2, 0xf9, 0x14, 0x49, 0xf1, 0xef, 0xa3, 0x7c, 0xae, 0x8f, 0xdd,
-	0xce, 0x72, 0xf1, 0x04, 0x9c, 0xe2, 0x49, 0x97, 0x7b, 0x71, 0x70, 0x1e, 0xbc, 0x89, 0x2f, 0x5f,
-	0x17, 0xe1, 0x7d, 0xf0, 0xf1, 0x07, 0x37, 0xae, 0x46, 0xa7, 0xec, 0xf2, 0x7e, 0xf8, 0xa2, 0xa5,
-	0x7c, 0x22, 0x4e, 0xaa, 0x28, 0xda, 0x35, 0x1a, 0x84, 0x75, 0xc5, 0x25, 0x50, 0x5c, 0x1a, 0x62,
-	0x87, 0x7b, 0xe3, 0xc9, 0x97, 0x86, 0x48, 0x7f, 0xe0, 0xd2, 0x10, 0xcb, 0xa9, 0xd7, 0xa3, 0xa2,
-	0x3d, 0xaa, 0x5d, 0xae, 0xb1, 0x5a, 0x98, 0x91, 0xd8, 0xed, 0xc3, 0x19, 0x80, 0x08, 0x94, 0x64,
-	0xaa, 0x8e, 0x14, 0xff, 0x6c, 0xc0, 0x95, 0xa1, 0xf0, 0x0b, 0x97, 0x6c, 0x01, 0xf2, 0x63, 0x83,
-	0xd2, 0x8d, 0x7d, 0xbd, 0xf4, 0x67, 0x8b, 0xe6, 0x59, 0x7f, 0xa8, 0x72, 0x7f, 0x36, 0xbb, 0x8f,
-	0x2e, 0x3d, 0x7f, 0x34, 0x60, 0x2e, 0xbe, 0x80, 0xd0, 0x94, 0x26, 0x4c, 0xc5, 0xa7, 0xd6, 0x46,
-	0x5c, 0x7f, 0x1a, 0x23, 0xe2, 0xeb, 0x1f, 0x00, 0x41, 0xdb, 0x51, 0x8a, 0xab, 0xa7, 0xb4, 0x9b,
-	0x4f, 0x4d, 0x4a, 0xb0, 0xb0, 0x13, 0x53, 0x5d, 0xf9, 0xe6, 0x9f, 0x06, 0x8c, 0x6d, 0x78, 0x5e,
-	0x07, 0xfd, 0x08, 0x66, 0xa9, 0xc7, 0x4d, 0x91, 0x0e, 0xc4, 0x36, 0xf5, 0x5d, 0x5f, 0x95, 0xcf,
-	0xda, 0x13, 0xb9, 0xfa, 0xc7, 0x51, 0x7e, 0x58, 0x73, 0x90, 0x40, 0xfd, 0xa4, 0x44, 0x3d, 0x5e,
-	0x91, 0x42, 0x9b, 0xea, 0x39, 0x60, 0x07, 0xa6, 0x07, 0xa7, 0x53, 0x25, 0x76, 0xe5, 0xac, 0xe9,
-	0xa6, 0xcf, 0x9c, 0x6a, 0xaa, 0x15, 0x9b, 0x67, 0x79, 0x42, 0x78, 0xed, 0x5f, 0xc2, 0x73, 0xef,
-	0x40, 0x26, 0xac, 0x2f, 0x5b, 0xf2, 0x3d, 0x8a, 0x89, 0xd0, 0x50, 0x4f, 0x53, 0xc1, 0xe9, 0xbe,
-	0x10, 0x7f, 0xe7, 0xc4, 0x2d, 0xcb, 0x29, 0x1d, 0xd3, 0x19, 0xa0, 0x53, 0xeb, 0xde, 0xf8, 0xbd,
-	0x01, 0x10, 0xbd, 0x9b, 0xa0, 0x57, 0xe1, 0x72, 0x65, 0x7d, 0xad, 0x6a, 0x36, 0x37, 0x57, 0x36,
-	0xb7, 0x9a, 0xe6, 0xd6, 0x5a, 0x73, 0xa3, 0xb6, 0x5a, 0xbf, 0x5d, 0xaf, 0x55, 0x33, 0x23, 0xb9,
-	0xf4, 0xc1, 0x61, 0x21, 0xb5, 0x45, 0x59, 0x97, 0x58, 0xce, 0x8e, 0x43, 0x6c, 0xf4, 0x25, 0x98,
-	0x1b, 0x94, 0x16, 0xad, 0x5a, 0x35, 0x63, 0xe4, 0xa6, 0x0e, 0x0e, 0x0b, 0x13, 0xea, 0x9c, 0x48,
-	0x6c, 0xb4, 0x00, 0x17, 0x87, 0xe5, 0xea, 0x6b, 0x6f, 0x64, 0x46, 0x73, 0xd3, 0x07, 0x87, 0x85,
-	0xc9, 0xf0, 0x40, 0x89, 0x8a, 0x80, 0xe2, 0x92, 0x1a, 0x2f, 0x91, 0x83, 0x83, 0xc3, 0x42, 0x52,
-	0x79, 0x21, 0x37, 0xf6, 0xee, 0x6f, 0xe7, 0x47, 0x6e, 0x7c, 0x1f, 0xa0, 0x4e, 0x77, 0x7c, 0x6c,
-	0xc9, 0x68, 0xcb, 0xc1, 0xa5, 0xfa, 0xda, 0xed, 0xc6, 0xca, 0xea, 0x66, 0x7d, 0x7d, 0x6d, 0x70,
-	0xd9, 0xc7, 0xc6, 0xaa, 0xeb, 0x5b, 0x95, 0xb7, 0x6a, 0x66, 0xb3, 0xfe, 0xc6, 0x5a, 0xc6, 0x40,
-	0x97, 0xe1, 0xc2, 0xc0, 0xd8, 0xb7, 0xd7, 0x36, 0xeb, 0x6f, 0xd7, 0x32, 0xa3, 0x95, 0xdb, 0x1f,
-	0x3e, 0x9a, 0x37, 0x1e, 0x3c, 0x9a, 0x37, 0xfe, 0xfe, 0x68, 0xde, 0x78, 0xef, 0xf1, 0xfc, 0xc8,
-	0x83, 0xc7, 0xf3, 0x23, 0x7f, 0x79, 0x3c, 0x3f, 0xf2, 0xdd, 0x57, 0xdb, 0x0e, 0xdf, 0xed, 0xb5,
-	0x4a, 0x96, 0xe7, 0xea, 0x97, 0xef, 0xf2, 0x89, 0x15, 0x4f, 0x3e, 0x35, 0xb7, 0x92, 0x72, 0x93,
-	0xf9, 0xca, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x81, 0x47, 0x51, 0xf7, 0xe2, 0x17, 0x00, 0x00,
+	// 2061 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x52, 0x34, 0x25, 0x3e, 0x4a, 0xa2, 0x34, 0xfe, 0xa3, 0xe9, 0x44, 0xa2, 0x19, 0xb7,
+	0x71, 0xdc, 0x88, 0x8a, 0xdd, 0xd4, 0x07, 0xb5, 0x48, 0x20, 0x8a, 0x74, 0xcc, 0xd4, 0x91, 0xd5,
+	0xa5, 0xa4, 0x36, 0x45, 0xdb, 0xc5, 0x70, 0x77, 0x44, 0x4d, 0xb5, 0xdc, 0x65, 0x76, 0x86, 0x8e,
+	0xd9, 0x73, 0x0f, 0x81, 0x8a, 0x02, 0x01, 0x0a, 0x14, 0x05, 0x0a, 0xa3, 0x06, 0x7a, 0x49, 0x6f,
+	0x3e, 0x18, 0xbd, 0xf7, 0x96, 0x16, 0x28, 0x60, 0xf8, 0x50, 0x14, 0x01, 0xea, 0x16, 0xf6, 0x21,
+	0x41, 0x7b, 0x29, 0x7a, 0xea, 0xb1, 0x98, 0x9f, 0xfd, 0x21, 0x29, 0x59, 0x96, 0x63, 0x14, 0x41,
+	0x7b, 0x21, 0x76, 0x66, 0xde, 0xfb, 0xe6, 0xbd, 0x37, 0xef, 0x67, 0xde, 0x10, 0xce, 0xdb, 0x3e,
+	0xeb, 0xf8, 0x6c, 0x89, 0x71, 0xbc, 0x4b, 0xbd, 0xf6, 0xd2, 0xcd, 0x4b, 0x2d, 0xc2, 0xf1, 0xa5,
+	0x70, 0x5c, 0xe9, 0x06, 0x3e, 0xf7, 0xd1, 0x29, 0x45, 0x55, 0x09, 0x67, 0x35, 0x55, 0xf1, 0x44,
+	0xdb, 0x6f, 0xfb, 0x92, 0x64, 0x49, 0x7c, 0x29, 0xea, 0xe2, 0x99, 0xb6, 0xef, 0xb7, 0x5d, 0xb2,
+	0x24, 0x47, 0xad, 0xde, 0xf6, 0x12, 0xf6, 0xfa, 0x7a, 0x69, 0x7e, 0x78, 0xc9, 0xe9, 0x05, 0x98,
+	0x53, 0xdf, 0xd3, 0xeb, 0x0b, 0xc3, 0xeb, 0x9c, 0x76, 0x08, 0xe3, 0xb8, 0xd3, 0x0d, 0xb1, 0x95,
+	0x24, 0x96, 0xda, 0x54, 0x8b, 0xa5, 0xb1, 0xb5, 0x2a, 0x2d, 0xcc, 0x48, 0xa4, 0x87, 0xed, 0xd3,
+	0x10, 0x7b, 0x0e, 0x77, 0xa8, 0xe7, 0x2f, 0xc9, 0x5f, 0x3d, 0xf5, 0x02, 0x27, 0x9e, 0x43, 0x82,
+	0x0e, 0xf5, 0xf8, 0x12, 0xef, 0x77, 0x09, 0x53, 0xbf, 0x7a, 0xf5, 0x6c, 0x62, 0x15, 0xb7, 0x6c,
+	0x9a, 0x5c, 0x2c, 0xff, 0xcc, 0x80, 0x99, 0x6b, 0x94, 0x71, 0x3f, 0xa0, 0x36, 0x76, 0x1b, 0xde,
+	0xb6, 0x8f, 0xbe, 0x0e, 0x99, 0x1d, 0x82, 0x1d, 0x12, 0x14, 0x8c, 0x92, 0x71, 0x21, 0x77, 0xb9,
+	0x50, 0x89, 0x01, 0x2a, 0x8a, 0xf7, 0x9a, 0x5c, 0xaf, 0x66, 0x3f, 0x7e, 0xb8, 0x30, 0xf6, 0xd1,
+	0xa7, 0x77, 0x2f, 0x1a, 0xa6, 0x66, 0x41, 0x35, 0xc8, 0xdc, 0xc4, 0x2e, 0x23, 0xbc, 0x90, 0x2a,
+	0x8d, 0x5f, 0xc8, 0x5d, 0x3e, 0x57, 0xd9, 0xdf, 0xe6, 0x95, 0x2d, 0xec, 0x52, 0x07, 0x73, 0x7f,
+	0x10, 0x45, 0xf1, 0x96, 0x7f, 0x9e, 0x82, 0xfc, 0xaa, 0xdf, 0xe9, 0x50, 0xc6, 0xa8, 0xef, 0x99,
+	0x98, 0x13, 0x86, 0xde, 0x86, 0x74, 0x80, 0x39, 0x91, 0x42, 0x65, 0xab, 0x57, 0x04, 0xd3, 0x27,
+	0x0f, 0x17, 0xce, 0x2a, 0x78, 0xe6, 0xec, 0x56, 0xa8, 0xbf, 0xd4, 0xc1, 0x7c, 0xa7, 0x72, 0x9d,
+	0xb4, 0xb1, 0xdd, 0xaf, 0x11, 0xfb, 0xc1, 0xbd, 0x45, 0xd0, 0xbb, 0xd7, 0x88, 0xad, 0x76, 0x90,
+	0x18, 0xe8, 0x5b, 0x30, 0xd9, 0xc1, 0xb7, 0x2c, 0x89, 0x97, 0xfa, 0x5c, 0x78, 0x13, 0x1d, 0x7c,
+	0x4b, 0xc8, 0x87, 0x7e, 0x00, 0x79, 0x01, 0x69, 0xef, 0x60, 0xaf, 0x4d, 0x14, 0xf2, 0xf8, 0xe7,
+	0x42, 0x9e, 0xee, 0xe0, 0x5b, 0xab, 0x12, 0x4d, 0xe0, 0x2f, 0xa7, 0x3f, 0xbb, 0xb3, 0x60, 0x94,
+	0x7f, 0x67, 0x00, 0xc4, 0x86, 0x41, 0x18, 0x66, 0xed, 0x68, 0x24, 0x37, 0x65, 0xfa, 0xd0, 0x5e,
+	0x3e, 0xc8, 0xee, 0x43, 0x66, 0xad, 0x4e, 0x0b, 0xf1, 0xee, 0x3f, 0x5c, 0x30, 0xd4, 0xae, 0x79,
+	0x7b, 0xc4, 0xec, 0xb9, 0x5e, 0xd7, 0xc1, 0x9c, 0x58, 0xc2, 0x87, 0xa5, 0xb5, 0x72, 0x97, 0x8b,
+	0x15, 0xe5, 0xe0, 0x95, 0xd0, 0xc1, 0x2b, 0x1b, 0xa1, 0x83, 0x2b, 0xc0, 0x0f, 0xff, 0x1a, 0x02,
+	0x82, 0xe2, 0x16, 0xeb, 0x5a, 0x87, 0x8f, 0x0c, 0xc8, 0xd5, 0x08, 0xb3, 0x03, 0xda, 0x15, 0x21,
+	0x83, 0x0a, 0x30, 0xd1, 0xf1, 0x3d, 0xba, 0xab, 0x1d, 0x2e, 0x6b, 0x86, 0x43, 0x54, 0x84, 0x49,
+	0xea, 0x10, 0x8f, 0x53, 0xde, 0x57, 0xc7, 0x64, 0x46, 0x63, 0xc1, 0xf5, 0x3e, 0x69, 0x31, 0x1a,
+	0xda, 0xd9, 0x0c, 0x87, 0xe8, 0x15, 0x98, 0x65, 0xc4, 0xee, 0x05, 0x94, 0xf7, 0x2d, 0xdb, 0xf7,
+	0x38, 0xb6, 0x79, 0x21, 0x2d, 0x49, 0xf2, 0xe1, 0xfc, 0xaa, 0x9a, 0x16, 0x20, 0x0e, 0xe1, 0x98,
+	0xba, 0xac, 0x70, 0x4c, 0x81, 0xe8, 0xa1, 0x16, 0xf5, 0xf6, 0x04, 0x64, 0x23, 0x47, 0x45, 0xab,
+	0x30, 0xeb, 0x77, 0x49, 0x20, 0xbe, 0x2d, 0xec, 0x38, 0x01, 0x61, 0x4c, 0x7b, 0x63, 0xe1, 0xc1,
+	0xbd, 0xc5, 0x13, 0xda, 0xe0, 0x2b, 0x6a, 0xa5, 0xc9, 0x03, 0xea, 0xb5, 0xcd, 0x7c, 0xc8, 0xa1,
+	0xa7, 0xd1, 0xbb, 0xe2, 0xc8, 0x3c, 0x46, 0x3c, 0xd6, 0x63, 0x56, 0xb7, 0xd7, 0xda, 0x25, 0x7d,
+	0x6d, 0xd4, 0x13, 0x23, 0x46, 0x5d, 0xf1, 0xfa, 0xd5, 0xc2, 0x1f, 0x62, 0x68, 0x3b, 0xe8, 0x77,
+	0xb9, 0x5f, 0x59, 0xef, 0xb5, 0xbe, 0x49, 0xfa, 0x66, 0x3e, 0xc2, 0x59, 0x97, 0x30, 0xe8, 0x14,
+	0x64, 0x7e, 0x88, 0xa9, 0x4b, 0x1c, 0x69, 0x91, 0x49, 0x53, 0x8f, 0xd0, 0x32, 0x64, 0x18, 0xc7,
+	0xbc, 0xc7, 0xa4, 0x19, 0x66, 0x2e, 0x97, 0x0f, 0xf2, 0x8d, 0xaa, 0xef, 0x39, 0x4d, 0x49, 0x69,
+	0x6a, 0x0e, 0xb4, 0x0a, 0x19, 0xee, 0xef, 0x12, 0x4f, 0x1b, 0xa8, 0xfa, 0x15, 0xed, 0xcd, 0x27,
+	0x47, 0xbd, 0xb9, 0xe1, 0xf1, 0x84, 0x1f, 0x37, 0x3c, 0x6e, 0x6a, 0x56, 0xf4, 0x3d, 0x98, 0x75,
+	0x88, 0x4b, 0xda, 0xd2, 0x72, 0x6c, 0x07, 0x07, 0x84, 0x15, 0x32, 0x12, 0xee, 0xd2, 0x91, 0x83,
+	0xc3, 0xcc, 0x47, 0x50, 0x4d, 0x89, 0x84, 0xd6, 0x21, 0xe7, 0xc4, 0xee, 0x54, 0x98, 0x90, 0xc6,
+	0x7c, 0xe9, 0x20, 0x1d, 0x13, 0x9e, 0x97, 0xcc, 0x3c, 0x49, 0x08, 0xe1, 0x41, 0x3d, 0xaf, 0xe5,
+	0x7b, 0x0e, 0xf5, 0xda, 0xd6, 0x0e, 0xa1, 0xed, 0x1d, 0x5e, 0x98, 0x2c, 0x19, 0x17, 0xc6, 0xcd,
+	0x7c, 0x34, 0x7f, 0x4d, 0x4e, 0xa3, 0x75, 0x98, 0x89, 0x49, 0x65, 0x84, 0x64, 0x8f, 0x1a, 0x21,
+	0xd3, 0x11, 0x80, 0x20, 0x41, 0xef, 0x00, 0xc4, 0x31, 0x58, 0x00, 0x89, 0x56, 0x3e, 0x3c, 0x9a,
+	0x93, 0xca, 0x24, 0x00, 0x90, 0x07, 0xc7, 0x3b, 0xd4, 0xb3, 0x18, 0x71, 0xb7, 0x2d, 0x6d, 0x39,
+	0x81, 0x9b, 0x93, 0xe6, 0x7f, 0xe3, 0x08, 0xa7, 0xf9, 0xc9, 0xbd, 0xc5, 0xbc, 0x1a, 0x2d, 0x32,
+	0x67, 0xb7, 0xf4, 0x5a, 0xe5, 0xf5, 0x2b, 0xe6, 0x5c, 0x87, 0x7a, 0x4d, 0xe2, 0x6e, 0xd7, 0x22,
+	0x60, 0xf4, 0x0d, 0x38, 0x1b, 0x1b, 0xc4, 0xf7, 0xac, 0x1d, 0xdf, 0x75, 0xac, 0x80, 0x6c, 0x5b,
+	0xb6, 0xdf, 0xf3, 0x78, 0x61, 0x4a, 0x9a, 0xf1, 0x74, 0x44, 0x72, 0xc3, 0xbb, 0xe6, 0xbb, 0x8e,
+	0x49, 0xb6, 0x57, 0xc5, 0x32, 0x7a, 0x09, 0x62, 0x6b, 0x58, 0xd4, 0x61, 0x85, 0xe9, 0xd2, 0xf8,
+	0x85, 0xb4, 0x39, 0x15, 0x4d, 0x36, 0x1c, 0xb6, 0x3c, 0xf9, 0xc1, 0x9d, 0x85, 0xb1, 0xcf, 0xee,
+	0x2c, 0x8c, 0x95, 0xaf, 0xc2, 0xd4, 0x16, 0x76, 0x75, 0x68, 0x11, 0x86, 0xae, 0x40, 0x16, 0x87,
+	0x83, 0x82, 0x51, 0x1a, 0x7f, 0x62, 0x68, 0xc6, 0xa4, 0xe5, 0xdf, 0x18, 0x90, 0xa9, 0x6d, 0xad,
+	0x63, 0x1a, 0xa0, 0x3a, 0xcc, 0xc5, 0xbe, 0xfa, 0xb4, 0x51, 0x1e, 0xbb, 0x77, 0x18, 0xe6, 0x6b,
+	0x30, 0x77, 0x33, 0x4c, 0x1c, 0x11, 0x8c, 0x2a, 0x35, 0xe7, 0x1e, 0xdc, 0x5b, 0x7c, 0x51, 0xc3,
+	0x44, 0xc9, 0x65, 0x08, 0xef, 0xe6, 0xd0, 0x7c, 0x42, 0xe7, 0xb7, 0x61, 0x42, 0x89, 0xca, 0xd0,
+	0x9b, 0x70, 0xac, 0x2b, 0x3e, 0xa4, 0xaa, 0xb9, 0xcb, 0xf3, 0x07, 0xfa, 0xbc, 0xa4, 0x4f, 0x7a,
+	0x88, 0xe2, 0x2b, 0xff, 0x24, 0x05, 0x50, 0xdb, 0xda, 0xda, 0x08, 0x68, 0xd7, 0x25, 0xfc, 0x79,
+	0xe9, 0xbe, 0x09, 0x27, 0x63, 0xdd, 0x59, 0x60, 0x1f, 0x5d, 0xff, 0xe3, 0x11, 0x7f, 0x33, 0xb0,
+	0xf7, 0x85, 0x75, 0x18, 0x8f, 0x60, 0xc7, 0x8f, 0x0e, 0x5b, 0x63, 0x7c, 0xd4, 0xb2, 0xdf, 0x81,
+	0x5c, 0x6c, 0x0c, 0x86, 0x1a, 0x30, 0xc9, 0xf5, 0xb7, 0x36, 0x70, 0xf9, 0x60, 0x03, 0x87, 0x6c,
+	0x49, 0x23, 0x47, 0xec, 0xe5, 0x7f, 0x1b, 0x00, 0x89, 0x18, 0xf9, 0x62, 0xfa, 0x18, 0x6a, 0x40,
+	0x46, 0x27, 0xe7, 0xf1, 0x67, 0x4d, 0xce, 0x1a, 0x20, 0x61, 0xd4, 0x9f, 0xa6, 0xe0, 0xf8, 0x66,
+	0x18, 0xbd, 0x5f, 0x7c, 0x1b, 0x6c, 0xc2, 0x04, 0xf1, 0x78, 0x40, 0xa5, 0x11, 0xc4, 0x99, 0xbf,
+	0x76, 0xd0, 0x99, 0xef, 0xa3, 0x54, 0xdd, 0xe3, 0x41, 0x3f, 0xe9, 0x01, 0x21, 0x56, 0xc2, 0x1e,
+	0xbf, 0x1c, 0x87, 0xc2, 0x41, 0xac, 0xe8, 0x65, 0xc8, 0xdb, 0x01, 0x91, 0x13, 0x61, 0xdd, 0x31,
+	0x64, 0xc2, 0x9c, 0x09, 0xa7, 0x75, 0xd9, 0x31, 0x41, 0x5c, 0xd4, 0x84, 0x73, 0x09, 0xd2, 0x67,
+	0xbb, 0x99, 0xcd, 0xc4, 0x08, 0xb2, 0xf0, 0x6c, 0x40, 0x9e, 0x7a, 0x94, 0x53, 0xec, 0x5a, 0x2d,
+	0xec, 0x62, 0xcf, 0x0e, 0x6f, 0xb0, 0x47, 0xaa, 0xf9, 0x33, 0x1a, 0xa3, 0xaa, 0x20, 0x50, 0x1d,
+	0x26, 0x42, 0xb4, 0xf4, 0xd1, 0xd1, 0x42, 0x5e, 0x74, 0x0e, 0xa6, 0x92, 0x85, 0x41, 0xde, 0x46,
+	0xd2, 0x66, 0x2e, 0x51, 0x17, 0x0e, 0xab, 0x3c, 0x99, 0x27, 0x56, 0x1e, 0x7d, 0xe1, 0xfb, 0xd5,
+	0x38, 0xcc, 0x99, 0xc4, 0xf9, 0xdf, 0x3f, 0x96, 0x75, 0x00, 0x15, 0xaa, 0x22, 0x93, 0x16, 0xd2,
+	0xcf, 0x1a, 0xef, 0x59, 0x05, 0x52, 0x63, 0xfc, 0xbf, 0x75, 0x42, 0x7f, 0x49, 0xc1, 0x54, 0xf2,
+	0x84, 0xfe, 0x2f, 0x8b, 0x16, 0x5a, 0x8b, 0xd3, 0x54, 0x5a, 0xa6, 0xa9, 0x57, 0x0e, 0x4a, 0x53,
+	0x23, 0xde, 0x7c, 0x48, 0x7e, 0xba, 0x7b, 0x0c, 0x32, 0xeb, 0x38, 0xc0, 0x1d, 0x86, 0x6e, 0x8c,
+	0xdc, 0x6d, 0x55, 0x6f, 0x79, 0x66, 0xc4, 0x99, 0x6b, 0xfa, 0xf9, 0x43, 0xf9, 0xf2, 0x2f, 0x0e,
+	0xba, 0xda, 0x7e, 0x09, 0x66, 0x44, 0x8f, 0x1c, 0x29, 0xa4, 0x8c, 0x3b, 0x2d, 0x5b, 0xdd, 0x48,
+	0x7b, 0x86, 0x16, 0x20, 0x27, 0xc8, 0xe2, 0x3c, 0x2c, 0x68, 0xa0, 0x83, 0x6f, 0xd5, 0xd5, 0x0c,
+	0x5a, 0x04, 0xb4, 0x13, 0xbd, 0x59, 0x58, 0xb1, 0x21, 0x04, 0xdd, 0x5c, 0xbc, 0x12, 0x92, 0xbf,
+	0x08, 0x20, 0xa4, 0xb0, 0x1c, 0xe2, 0xf9, 0x1d, 0xdd, 0xe8, 0x65, 0xc5, 0x4c, 0x4d, 0x4c, 0xa0,
+	0x1f, 0x1b, 0xea, 0x8a, 0x3c, 0xd4, 0x49, 0xeb, 0x0e, 0x65, 0xe3, 0x29, 0x82, 0xe2, 0x5f, 0x0f,
+	0x17, 0x8a, 0x7d, 0xdc, 0x71, 0x97, 0xcb, 0xfb, 0xe0, 0x94, 0xf7, 0x6b, 0xee, 0xc5, 0xc5, 0x79,
+	0xb0, 0x13, 0x17, 0x62, 0x9c, 0x69, 0xbb, 0x7e, 0x0b, 0xbb, 0x96, 0x4b, 0xdf, 0xeb, 0x51, 0xc7,
+	0xd2, 0x47, 0x69, 0xd9, 0xb8, 0x2b, 0xbb, 0x9a, 0x6c, 0xb5, 0x71, 0xe4, 0x08, 0x1d, 0xb9, 0xb5,
+	0x7f, 0xed, 0x75, 0x25, 0xc1, 0x29, 0xb5, 0xd7, 0x75, 0xb9, 0x55, 0x53, 0xed, 0xb4, 0x8a, 0xbb,
+	0x68, 0xcf, 0x80, 0x17, 0x62, 0x8f, 0xdd, 0x47, 0x92, 0xc9, 0xe7, 0x2d, 0xc9, 0x99, 0x68, 0xbb,
+	0x61, 0x61, 0x96, 0xcf, 0x8b, 0x90, 0xdf, 0xfb, 0xf4, 0xee, 0xc5, 0xb3, 0x31, 0xe7, 0xd2, 0xad,
+	0xe8, 0x95, 0x4f, 0xf9, 0xa9, 0xb8, 0xbd, 0xa3, 0xb8, 0x92, 0x9a, 0x84, 0x75, 0x7d, 0x8f, 0xc9,
+	0x46, 0x2a, 0xd1, 0xf0, 0x18, 0x4f, 0x6e, 0xa4, 0x62, 0xfe, 0x81, 0x46, 0x2a, 0x91, 0x67, 0xde,
+	0x88, 0x0b, 0x59, 0x4a, 0x87, 0x81, 0xc6, 0x12, 0x2f, 0x75, 0x89, 0x8e, 0x8c, 0x0e, 0x40, 0x84,
+	0x4c, 0x32, 0x7d, 0x8d, 0x95, 0xff, 0x68, 0xc0, 0x99, 0x91, 0x90, 0x8c, 0x44, 0xb6, 0x01, 0x05,
+	0x89, 0x45, 0xe9, 0xda, 0x7d, 0x2d, 0xfa, 0xb3, 0x45, 0xf8, 0x5c, 0x30, 0xbc, 0xfa, 0x9c, 0x2a,
+	0xb2, 0x4e, 0xc7, 0xbf, 0x37, 0xe0, 0x44, 0x52, 0x80, 0x48, 0x95, 0x26, 0x4c, 0x25, 0xb7, 0xd6,
+	0x4a, 0x9c, 0x7f, 0x1a, 0x25, 0x92, 0xf2, 0x0f, 0x80, 0xa0, 0xad, 0x38, 0xed, 0xa9, 0xe7, 0xc5,
+	0x4b, 0x4f, 0x6d, 0x94, 0x50, 0xb0, 0x7d, 0xd3, 0x9f, 0x3a, 0x9b, 0x7f, 0x18, 0x90, 0x5e, 0xf7,
+	0x7d, 0x17, 0xbd, 0x07, 0x73, 0x9e, 0xcf, 0x2d, 0x91, 0x22, 0x88, 0x63, 0xe9, 0xf7, 0x0f, 0x55,
+	0x52, 0xea, 0x4f, 0xb4, 0xd5, 0xdf, 0x1f, 0x2e, 0x8c, 0x72, 0x0e, 0x1a, 0x50, 0x3f, 0xb3, 0x79,
+	0x3e, 0xaf, 0x4a, 0xa2, 0x0d, 0x49, 0x83, 0xb6, 0x61, 0x7a, 0x70, 0x3b, 0x55, 0x76, 0x56, 0x0e,
+	0xdb, 0x6e, 0xfa, 0xd0, 0xad, 0xa6, 0x5a, 0x89, 0x7d, 0x96, 0x27, 0xc5, 0xa9, 0xfd, 0x53, 0x9c,
+	0xdc, 0x9f, 0x0c, 0x38, 0x2e, 0x27, 0xe9, 0x8f, 0x88, 0x7c, 0x49, 0x31, 0x89, 0xed, 0x07, 0x0e,
+	0x9a, 0x81, 0x14, 0x75, 0xa4, 0xb6, 0x69, 0x33, 0x45, 0x1d, 0x54, 0x81, 0x63, 0xfe, 0xfb, 0x1e,
+	0x09, 0x0a, 0xa9, 0x43, 0x6a, 0xaa, 0x22, 0x93, 0x49, 0xde, 0x77, 0x7a, 0x2e, 0xb1, 0xb0, 0xad,
+	0xea, 0xba, 0x7a, 0x9f, 0x9b, 0x56, 0xb3, 0x2b, 0x6a, 0x12, 0xbd, 0x09, 0xd9, 0x28, 0xee, 0x0b,
+	0xe9, 0xa7, 0x2d, 0x86, 0x31, 0xcf, 0xf2, 0x69, 0xa1, 0xc9, 0x83, 0xd1, 0xa4, 0x52, 0x7e, 0x17,
+	0x66, 0x23, 0xee, 0x4d, 0xf9, 0xf8, 0xc8, 0x84, 0xcf, 0xab, 0x77, 0xc8, 0xb0, 0x95, 0x2b, 0x25,
+	0x1f, 0xb5, 0xc5, 0xab, 0x78, 0x65, 0x88, 0x67, 0xc0, 0x4f, 0x34, 0xef, 0xc5, 0xdf, 0x1a, 0x00,
+	0xf1, 0x23, 0x19, 0x7a, 0x15, 0x4e, 0x57, 0x6f, 0xac, 0xd5, 0xac, 0xe6, 0xc6, 0xca, 0xc6, 0x66,
+	0xd3, 0xda, 0x5c, 0x6b, 0xae, 0xd7, 0x57, 0x1b, 0x57, 0x1b, 0xf5, 0xda, 0xec, 0x58, 0x31, 0xbf,
+	0x77, 0xbb, 0x94, 0xdb, 0xf4, 0x58, 0x97, 0xd8, 0x74, 0x9b, 0x12, 0x07, 0x7d, 0x19, 0x4e, 0x0c,
+	0x52, 0x8b, 0x51, 0xbd, 0x36, 0x6b, 0x14, 0xa7, 0xf6, 0x6e, 0x97, 0x26, 0x55, 0x53, 0x40, 0x1c,
+	0x74, 0x01, 0x4e, 0x8e, 0xd2, 0x35, 0xd6, 0xde, 0x9a, 0x4d, 0x15, 0xa7, 0xf7, 0x6e, 0x97, 0xb2,
+	0x51, 0xf7, 0x80, 0xca, 0x80, 0x92, 0x94, 0x1a, 0x6f, 0xbc, 0x08, 0x7b, 0xb7, 0x4b, 0x19, 0xe5,
+	0x5e, 0xc5, 0xf4, 0x07, 0xbf, 0x9e, 0x1f, 0xbb, 0xf8, 0x7d, 0x80, 0x86, 0xb7, 0x1d, 0x60, 0x5b,
+	0x86, 0x51, 0x11, 0x4e, 0x35, 0xd6, 0xae, 0x9a, 0x2b, 0xab, 0x1b, 0x8d, 0x1b, 0x6b, 0x83, 0x62,
+	0x0f, 0xad, 0xd5, 0x6e, 0x6c, 0x56, 0xaf, 0xd7, 0xad, 0x66, 0xe3, 0xad, 0xb5, 0x59, 0x03, 0x9d,
+	0x86, 0xe3, 0x03, 0x6b, 0xdf, 0x5e, 0xdb, 0x68, 0xbc, 0x53, 0x9f, 0x4d, 0x55, 0xaf, 0x7e, 0xfc,
+	0x68, 0xde, 0xb8, 0xff, 0x68, 0xde, 0xf8, 0xdb, 0xa3, 0x79, 0xe3, 0xc3, 0xc7, 0xf3, 0x63, 0xf7,
+	0x1f, 0xcf, 0x8f, 0xfd, 0xf9, 0xf1, 0xfc, 0xd8, 0x77, 0x5f, 0x6d, 0x53, 0xbe, 0xd3, 0x6b, 0x55,
+	0x6c, 0xbf, 0xa3, 0xff, 0xe6, 0x58, 0xda, 0x37, 0x95, 0xcb, 0xff, 0x15, 0x5a, 0x19, 0x79, 0xa3,
+	0xf8, 0xea, 0x7f, 0x06, 0x00, 0x1e, 0x5f, 0x5a, 0xc9, 0xcf, 0x19, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1403,824 +1494,815 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 13065 bytes of a gzipped FileDescriptorSet
+		// 12925 bytes of a gzipped FileDescriptorSet
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x94, 0x5b, 0xd7,
-		0x75, 0x18, 0x3c, 0x17, 0x6f, 0x6c, 0x60, 0x80, 0x3b, 0x67, 0x86, 0x24, 0x08, 0x8a, 0x9c, 0x21,
-		0x64, 0x49, 0x14, 0x25, 0x0e, 0xc5, 0x91, 0x48, 0x89, 0x43, 0xdb, 0xfa, 0xf0, 0x22, 0x09, 0x6a,
-		0x06, 0x18, 0x5f, 0x60, 0x28, 0x51, 0x49, 0x7c, 0x73, 0x07, 0x38, 0x33, 0x73, 0x45, 0xe0, 0x5e,
-		0x18, 0xf7, 0x82, 0x9a, 0xd1, 0xb7, 0x56, 0x96, 0x1d, 0x3b, 0xf9, 0x1c, 0x39, 0x4e, 0x9c, 0x2f,
-		0x59, 0x89, 0x12, 0x9b, 0x8e, 0xed, 0x7c, 0xf1, 0xe7, 0xbc, 0xda, 0x24, 0x76, 0x5e, 0xed, 0x6a,
-		0x1e, 0x6d, 0xd3, 0x26, 0x6e, 0xda, 0xe5, 0x64, 0xad, 0xb6, 0x69, 0xd6, 0x8a, 0xdb, 0x38, 0x59,
-		0xb1, 0xfc, 0x48, 0xf3, 0x52, 0xda, 0x74, 0xb9, 0x5d, 0xee, 0x3a, 0xaf, 0xfb, 0xc0, 0x63, 0x80,
-		0xa1, 0x65, 0x57, 0x4d, 0xfa, 0x43, 0xe2, 0xdc, 0x73, 0xf6, 0xde, 0x67, 0x9f, 0x7d, 0xf6, 0xd9,
-		0x67, 0xef, 0x7d, 0x1e, 0x80, 0xf7, 0xdf, 0x86, 0xa5, 0x1d, 0xd3, 0xdc, 0x69, 0xe3, 0xf3, 0xdd,
-		0x9e, 0x69, 0x9b, 0x5b, 0xfd, 0xed, 0xf3, 0x2d, 0x6c, 0x35, 0x7b, 0x7a, 0xd7, 0x36, 0x7b, 0xcb,
-		0xb4, 0x0c, 0xa5, 0x19, 0xc4, 0xb2, 0x80, 0xc8, 0x7d, 0x5a, 0x82, 0xb9, 0xab, 0x7a, 0x1b, 0x97,
-		0x1c, 0xc8, 0x3a, 0xb6, 0xd1, 0x53, 0x10, 0xda, 0xd6, 0xdb, 0x38, 0x23, 0x2d, 0x05, 0xcf, 0x24,
-		0x56, 0xde, 0xb4, 0x3c, 0x80, 0xb5, 0xec, 0xc7, 0xd8, 0x20, 0xc5, 0x0a, 0xc5, 0x38, 0xfb, 0x7f,
-		0xc7, 0xde, 0xf9, 0xe5, 0x4f, 0x7f, 0x55, 0x92, 0xdf, 0x45, 0xfe, 0x9f, 0xed, 0xa0, 0xdb, 0xec,
-		0x1b, 0x3d, 0xb1, 0x4c, 0xf0, 0x3c, 0xfc, 0xdc, 0xb9, 0x40, 0x4a, 0x54, 0x82, 0xa4, 0xba, 0xc5,
-		0xaa, 0x85, 0x6d, 0x15, 0xef, 0xd9, 0xd8, 0xb0, 0x74, 0xd3, 0xc8, 0x9e, 0x1b, 0x81, 0x35, 0xc4,
-		0x6d, 0x59, 0x80, 0xe7, 0x7e, 0x33, 0x0c, 0xf3, 0x23, 0x58, 0x43, 0x08, 0x42, 0x86, 0xd6, 0x21,
-		0xdd, 0x91, 0xce, 0xc4, 0x15, 0xfa, 0x37, 0xca, 0x40, 0xb4, 0xab, 0x35, 0x6f, 0x6b, 0x3b, 0x38,
-		0x13, 0xa0, 0xc5, 0xe2, 0x13, 0x9d, 0x02, 0x68, 0xe1, 0x2e, 0x36, 0x5a, 0xd8, 0x68, 0xee, 0x67,
-		0x82, 0x4b, 0xc1, 0x33, 0x71, 0xc5, 0x53, 0x82, 0x1e, 0x81, 0xb9, 0x6e, 0x7f, 0xab, 0xad, 0x37,
-		0x55, 0x0f, 0x18, 0x2c, 0x05, 0xcf, 0x84, 0x15, 0x99, 0x55, 0x94, 0x5c, 0xe0, 0x87, 0x20, 0xfd,
-		0x22, 0xd6, 0x6e, 0x7b, 0x41, 0x13, 0x14, 0x34, 0x45, 0x8a, 0x4b, 0x3e, 0xaa, 0x66, 0xd7, 0xd6,
-		0x4d, 0xc3, 0x0b, 0x9a, 0xa6, 0x8d, 0xcb, 0xac, 0xc2, 0x03, 0x5c, 0x84, 0x64, 0x07, 0x5b, 0x96,
-		0xb6, 0x83, 0x55, 0x7b, 0xbf, 0x8b, 0x33, 0x21, 0x3a, 0x4e, 0x4b, 0x43, 0xe3, 0x34, 0x38, 0x46,
-		0x09, 0x8e, 0xd5, 0xd8, 0xef, 0x62, 0x94, 0x87, 0x38, 0x36, 0xfa, 0x1d, 0x46, 0x21, 0x3c, 0x66,
-		0xa4, 0xcb, 0x46, 0xbf, 0x33, 0x48, 0x25, 0x46, 0xd0, 0x38, 0x89, 0xa8, 0x85, 0x7b, 0x77, 0xf4,
-		0x26, 0xce, 0x44, 0x28, 0x81, 0x87, 0x86, 0x08, 0xd4, 0x59, 0xfd, 0x20, 0x0d, 0x81, 0x87, 0x8a,
-		0x10, 0x77, 0xc6, 0x3b, 0x13, 0xa5, 0x44, 0x1e, 0x18, 0xa1, 0x6f, 0xb8, 0xdd, 0x1a, 0x24, 0xe1,
-		0xe2, 0xa1, 0x4b, 0x10, 0x65, 0x32, 0xb2, 0x32, 0xb1, 0x25, 0xe9, 0x4c, 0x62, 0xe5, 0xbe, 0x91,
-		0x2a, 0x5b, 0x63, 0x30, 0x8a, 0x00, 0x46, 0x15, 0x90, 0x2d, 0xb3, 0xdf, 0x6b, 0x62, 0xb5, 0x69,
-		0xb6, 0xb0, 0xaa, 0x1b, 0xdb, 0x66, 0x26, 0x4e, 0x09, 0x2c, 0x0e, 0x77, 0x84, 0x02, 0x16, 0xcd,
-		0x16, 0xae, 0x18, 0xdb, 0xa6, 0x92, 0xb2, 0x7c, 0xdf, 0xe8, 0x28, 0x44, 0xac, 0x7d, 0xc3, 0xd6,
-		0xf6, 0x32, 0x49, 0xaa, 0x4e, 0xfc, 0x0b, 0xad, 0x40, 0x14, 0xb7, 0x74, 0xd2, 0x5c, 0x26, 0xb5,
-		0x24, 0x9d, 0x49, 0xad, 0x64, 0x86, 0x65, 0xcc, 0xea, 0x15, 0x01, 0x98, 0xfb, 0xef, 0x11, 0x48,
-		0x4f, 0xa3, 0xc3, 0x57, 0x20, 0xbc, 0x4d, 0x24, 0x93, 0x09, 0x1c, 0x46, 0x6e, 0x0c, 0xc7, 0x2f,
-		0xf8, 0xc8, 0x3d, 0x0a, 0x3e, 0x0f, 0x09, 0x03, 0x5b, 0x36, 0x6e, 0x31, 0x2d, 0x0a, 0x4e, 0xa9,
-		0x87, 0xc0, 0x90, 0x86, 0xd5, 0x30, 0x74, 0x4f, 0x6a, 0xf8, 0x1c, 0xa4, 0x1d, 0x96, 0xd4, 0x9e,
-		0x66, 0xec, 0x08, 0x7d, 0x3e, 0x3f, 0x89, 0x93, 0x65, 0xc7, 0x78, 0x28, 0x04, 0x4d, 0x49, 0x61,
-		0xdf, 0x37, 0x2a, 0x01, 0x98, 0x06, 0x36, 0xb7, 0xd5, 0x16, 0x6e, 0xb6, 0x33, 0xb1, 0x31, 0x52,
-		0xaa, 0x11, 0x90, 0x21, 0x29, 0x99, 0xac, 0xb4, 0xd9, 0x46, 0x97, 0x5d, 0xf5, 0x8c, 0x8e, 0xd1,
-		0xae, 0x75, 0x36, 0x31, 0x87, 0x34, 0x74, 0x13, 0x52, 0x3d, 0x4c, 0xe6, 0x0a, 0x6e, 0xf1, 0x9e,
-		0xc5, 0x29, 0x13, 0xcb, 0x13, 0x7b, 0xa6, 0x70, 0x34, 0xd6, 0xb1, 0xd9, 0x9e, 0xf7, 0x13, 0xdd,
-		0x0f, 0x4e, 0x81, 0x4a, 0xd5, 0x0a, 0xa8, 0xa5, 0x49, 0x8a, 0xc2, 0x2a, 0x51, 0xaf, 0x3c, 0xc0,
-		0x1d, 0xdd, 0xd2, 0xb7, 0xf4, 0xb6, 0x6e, 0x13, 0xb3, 0x45, 0xb4, 0xf7, 0xf4, 0xf0, 0xbc, 0xd8,
-		0xef, 0x6c, 0x99, 0xed, 0x9b, 0x0e, 0xa0, 0xe2, 0x41, 0xca, 0xbe, 0x04, 0x29, 0xbf, 0x84, 0xd1,
-		0x02, 0x84, 0x2d, 0x5b, 0xeb, 0xd9, 0x54, 0x91, 0xc3, 0x0a, 0xfb, 0x40, 0x32, 0x04, 0xb1, 0xd1,
-		0xa2, 0x96, 0x38, 0xac, 0x90, 0x3f, 0xd1, 0xff, 0xe5, 0xca, 0x2c, 0x48, 0x65, 0xf6, 0xe0, 0xb0,
-		0x52, 0xf8, 0x28, 0x0f, 0x8a, 0x2e, 0xfb, 0x24, 0xcc, 0xfa, 0x64, 0x30, 0x6d, 0xd3, 0xb9, 0xdf,
-		0x09, 0xc1, 0x91, 0x91, 0xb4, 0xd1, 0x73, 0xb0, 0xd0, 0x37, 0x74, 0xc3, 0xc6, 0xbd, 0x6e, 0x0f,
-		0x13, 0xad, 0x67, 0x6d, 0x65, 0x3e, 0x1f, 0x1d, 0xa3, 0xb7, 0x9b, 0x5e, 0x68, 0x46, 0x45, 0x99,
-		0xef, 0x0f, 0x17, 0xa2, 0x5b, 0x90, 0x20, 0x2a, 0xa6, 0xf5, 0x34, 0x4a, 0x90, 0x4d, 0xe8, 0x95,
-		0xe9, 0xba, 0xbc, 0x5c, 0x72, 0x31, 0x0b, 0xc1, 0xf7, 0x4a, 0x01, 0xc5, 0x4b, 0x0b, 0x3d, 0x09,
-		0xb1, 0x6d, 0xac, 0xd9, 0xfd, 0x1e, 0xb6, 0x32, 0x2b, 0x54, 0x94, 0x27, 0x86, 0xe7, 0x39, 0x03,
-		0xa8, 0x63, 0x5b, 0x71, 0x80, 0x51, 0x07, 0x92, 0x77, 0x70, 0x4f, 0xdf, 0xd6, 0x9b, 0x8c, 0xa9,
-		0x20, 0xd5, 0x80, 0xa7, 0xa6, 0x64, 0xea, 0xa6, 0x07, 0xb5, 0x6e, 0x6b, 0x36, 0x5e, 0x85, 0xcd,
-		0xea, 0xcd, 0xb2, 0x52, 0xb9, 0x5a, 0x29, 0x97, 0x18, 0x9b, 0x3e, 0xf2, 0xd9, 0x1f, 0x90, 0x20,
-		0xe1, 0xe9, 0x09, 0xb1, 0xa8, 0x46, 0xbf, 0xb3, 0x85, 0x7b, 0x7c, 0xbc, 0xf8, 0x17, 0x3a, 0x01,
-		0xf1, 0xed, 0x7e, 0xbb, 0xcd, 0xf4, 0x96, 0xad, 0xdd, 0x31, 0x52, 0x40, 0x75, 0x16, 0x41, 0x88,
-		0x5b, 0x22, 0x6a, 0x26, 0xc9, 0xdf, 0x28, 0x0b, 0x31, 0xa1, 0xd7, 0x99, 0xf0, 0x92, 0x74, 0x26,
-		0xa6, 0x38, 0xdf, 0xac, 0xae, 0x8b, 0x35, 0x1b, 0xb7, 0x32, 0x11, 0x51, 0xc7, 0xbe, 0x6f, 0x84,
-		0x62, 0x21, 0x39, 0x9c, 0x7b, 0x02, 0xe6, 0x86, 0xba, 0x82, 0xd2, 0x90, 0x28, 0x95, 0x8b, 0x6b,
-		0x79, 0x25, 0xdf, 0xa8, 0xd4, 0xaa, 0xf2, 0x0c, 0x4a, 0x81, 0xa7, 0x77, 0xb2, 0x74, 0x36, 0x1e,
-		0x7b, 0x35, 0x2a, 0xbf, 0xf3, 0x9d, 0xef, 0x7c, 0x67, 0x20, 0xf7, 0x1b, 0x11, 0x58, 0x18, 0x65,
-		0x47, 0x47, 0x9a, 0x74, 0xb7, 0xd3, 0x41, 0x5f, 0xa7, 0xf3, 0x10, 0x6e, 0x6b, 0x5b, 0xb8, 0x9d,
-		0x09, 0xd1, 0x41, 0x78, 0x64, 0x2a, 0x4b, 0xbd, 0xbc, 0x46, 0x50, 0x14, 0x86, 0x89, 0xde, 0xca,
-		0x45, 0x13, 0xa6, 0x14, 0xce, 0x4e, 0x47, 0x81, 0xd8, 0x57, 0x2e, 0xc6, 0x13, 0x10, 0x27, 0xff,
-		0x32, 0xb9, 0x47, 0x98, 0xdc, 0x49, 0x01, 0x95, 0x7b, 0x16, 0x62, 0xd4, 0x74, 0xb6, 0xb0, 0x33,
-		0x26, 0xe2, 0x9b, 0x18, 0x9b, 0x16, 0xde, 0xd6, 0xfa, 0x6d, 0x5b, 0xbd, 0xa3, 0xb5, 0xfb, 0x98,
-		0x1a, 0xc1, 0xb8, 0x92, 0xe4, 0x85, 0x37, 0x49, 0x19, 0x5a, 0x84, 0x04, 0xb3, 0xb4, 0xba, 0xd1,
-		0xc2, 0x7b, 0x74, 0x15, 0x0e, 0x2b, 0xcc, 0xf8, 0x56, 0x48, 0x09, 0x69, 0xfe, 0x05, 0xcb, 0x34,
-		0x84, 0xb9, 0xa2, 0x4d, 0x90, 0x02, 0xda, 0xfc, 0x93, 0x83, 0x0e, 0xc0, 0xc9, 0xd1, 0xdd, 0x1b,
-		0xb2, 0xaf, 0x0f, 0x41, 0x9a, 0x42, 0x3c, 0xce, 0xa7, 0xb2, 0xd6, 0xce, 0xcc, 0x51, 0x35, 0x48,
-		0xb1, 0xe2, 0x1a, 0x2f, 0xcd, 0xfd, 0x62, 0x00, 0x42, 0x74, 0xb1, 0x49, 0x43, 0xa2, 0x71, 0x6b,
-		0xa3, 0xac, 0x96, 0x6a, 0x9b, 0x85, 0xb5, 0xb2, 0x2c, 0x91, 0xa1, 0xa7, 0x05, 0x57, 0xd7, 0x6a,
-		0xf9, 0x86, 0x1c, 0x70, 0xbe, 0x2b, 0xd5, 0xc6, 0xa5, 0x27, 0xe4, 0xa0, 0x83, 0xb0, 0xc9, 0x0a,
-		0x42, 0x5e, 0x80, 0xc7, 0x57, 0xe4, 0x30, 0x92, 0x21, 0xc9, 0x08, 0x54, 0x9e, 0x2b, 0x97, 0x2e,
-		0x3d, 0x21, 0x47, 0xfc, 0x25, 0x8f, 0xaf, 0xc8, 0x51, 0x34, 0x0b, 0x71, 0x5a, 0x52, 0xa8, 0xd5,
-		0xd6, 0xe4, 0x98, 0x43, 0xb3, 0xde, 0x50, 0x2a, 0xd5, 0x6b, 0x72, 0xdc, 0xa1, 0x79, 0x4d, 0xa9,
-		0x6d, 0x6e, 0xc8, 0xe0, 0x50, 0x58, 0x2f, 0xd7, 0xeb, 0xf9, 0x6b, 0x65, 0x39, 0xe1, 0x40, 0x14,
-		0x6e, 0x35, 0xca, 0x75, 0x39, 0xe9, 0x63, 0xeb, 0xf1, 0x15, 0x79, 0xd6, 0x69, 0xa2, 0x5c, 0xdd,
-		0x5c, 0x97, 0x53, 0x68, 0x0e, 0x66, 0x59, 0x13, 0x82, 0x89, 0xf4, 0x40, 0xd1, 0xa5, 0x27, 0x64,
-		0xd9, 0x65, 0x84, 0x51, 0x99, 0xf3, 0x15, 0x5c, 0x7a, 0x42, 0x46, 0xb9, 0x22, 0x84, 0xa9, 0x1a,
-		0x22, 0x04, 0xa9, 0xb5, 0x7c, 0xa1, 0xbc, 0xa6, 0xd6, 0x36, 0xc8, 0xa4, 0xc9, 0xaf, 0xc9, 0x92,
-		0x5b, 0xa6, 0x94, 0x37, 0xca, 0xf9, 0x46, 0xb9, 0x24, 0x07, 0xbd, 0x65, 0x6f, 0xdb, 0xac, 0x28,
-		0xe5, 0x92, 0x1c, 0xc8, 0x35, 0x61, 0x61, 0xd4, 0x22, 0x3b, 0x72, 0x0a, 0x79, 0x74, 0x21, 0x30,
-		0x46, 0x17, 0x28, 0xad, 0x41, 0x5d, 0xc8, 0x7d, 0x3c, 0x08, 0xf3, 0x23, 0x1c, 0x8d, 0x91, 0x8d,
-		0x3c, 0x0d, 0x61, 0xa6, 0xcb, 0xcc, 0x52, 0x3f, 0x3c, 0xd2, 0x63, 0xa1, 0x9a, 0x3d, 0xe4, 0x7e,
-		0x51, 0x3c, 0xaf, 0xcb, 0x1a, 0x1c, 0xe3, 0xb2, 0x12, 0x12, 0x43, 0x0a, 0xfb, 0x2d, 0x43, 0x0e,
-		0x01, 0xf3, 0x99, 0x2e, 0x4d, 0xe3, 0x33, 0xd1, 0xb2, 0xc3, 0x39, 0x06, 0xe1, 0x89, 0x8e, 0x41,
-		0xe4, 0x5e, 0x1c, 0x83, 0x2b, 0x30, 0x37, 0xc4, 0xcb, 0xd4, 0x0b, 0xf4, 0xbb, 0x25, 0xc8, 0x8c,
-		0x93, 0xef, 0x04, 0xab, 0x1a, 0xf0, 0x59, 0xd5, 0x2b, 0x83, 0x83, 0x70, 0x7a, 0xfc, 0x38, 0x0e,
-		0xa9, 0xcb, 0x2f, 0x48, 0x70, 0x74, 0x74, 0x74, 0x33, 0x92, 0x87, 0xb7, 0x42, 0xa4, 0x83, 0xed,
-		0x5d, 0x53, 0x78, 0xeb, 0x0f, 0x8e, 0xf0, 0x01, 0x49, 0xf5, 0xa0, 0xbe, 0x70, 0x2c, 0xaf, 0x13,
-		0x19, 0x1c, 0x17, 0xa2, 0x30, 0x6e, 0x06, 0x39, 0x65, 0x0b, 0x99, 0x12, 0xb1, 0xec, 0x1e, 0xd6,
-		0x3a, 0xb9, 0xef, 0x0a, 0xc0, 0x91, 0x91, 0x4d, 0x8d, 0x64, 0xfb, 0x24, 0x80, 0x6e, 0x74, 0xfb,
-		0x36, 0xf3, 0xcf, 0x99, 0x69, 0x8f, 0xd3, 0x12, 0x6a, 0x0d, 0x89, 0xd9, 0xee, 0xdb, 0x4e, 0x3d,
-		0x5b, 0x76, 0x81, 0x15, 0x51, 0x80, 0xa7, 0x5c, 0xb6, 0x43, 0x94, 0xed, 0x53, 0x63, 0xfa, 0x3d,
-		0xa4, 0xe9, 0x8f, 0x81, 0xdc, 0x6c, 0xeb, 0xd8, 0xb0, 0x55, 0xc6, 0xb8, 0x6e, 0xec, 0xb0, 0xe5,
-		0x7b, 0x35, 0xbc, 0xad, 0xb5, 0x2d, 0xac, 0xa4, 0x59, 0x75, 0x5d, 0xd4, 0x12, 0x0c, 0xaa, 0x4e,
-		0x3d, 0x0f, 0x46, 0xc4, 0x87, 0xc1, 0xaa, 0x1d, 0x8c, 0xdc, 0xcf, 0xc4, 0x21, 0xe1, 0x89, 0x0c,
-		0xd1, 0x69, 0x48, 0xbe, 0xa0, 0xdd, 0xd1, 0x54, 0x91, 0x1a, 0x60, 0x92, 0x48, 0x90, 0xb2, 0x0d,
-		0x9e, 0x1e, 0x78, 0x0c, 0x16, 0x28, 0x88, 0xd9, 0xb7, 0x71, 0x4f, 0x6d, 0xb6, 0x35, 0xcb, 0xa2,
-		0x42, 0x8b, 0x51, 0x50, 0x44, 0xea, 0x6a, 0xa4, 0xaa, 0x28, 0x6a, 0xd0, 0x45, 0x98, 0xa7, 0x18,
-		0x9d, 0x7e, 0xdb, 0xd6, 0xbb, 0x6d, 0x4c, 0x93, 0x1e, 0x16, 0x5d, 0xc3, 0x1c, 0xce, 0xe6, 0x08,
-		0xc4, 0x3a, 0x07, 0x20, 0x1c, 0x59, 0xa8, 0x04, 0x27, 0x29, 0xda, 0x0e, 0x36, 0x70, 0x4f, 0xb3,
-		0xb1, 0x8a, 0xdf, 0xd1, 0xd7, 0xda, 0x96, 0xaa, 0x19, 0x2d, 0x75, 0x57, 0xb3, 0x76, 0x33, 0x0b,
-		0x84, 0x40, 0x21, 0x90, 0x91, 0x94, 0xe3, 0x04, 0xf0, 0x1a, 0x87, 0x2b, 0x53, 0xb0, 0xbc, 0xd1,
-		0xba, 0xae, 0x59, 0xbb, 0x68, 0x15, 0x8e, 0x52, 0x2a, 0x96, 0xdd, 0xd3, 0x8d, 0x1d, 0xb5, 0xb9,
-		0x8b, 0x9b, 0xb7, 0xd5, 0xbe, 0xbd, 0xfd, 0x54, 0xe6, 0x84, 0xb7, 0x7d, 0xca, 0x61, 0x9d, 0xc2,
-		0x14, 0x09, 0xc8, 0xa6, 0xbd, 0xfd, 0x14, 0xaa, 0x43, 0x92, 0x0c, 0x46, 0x47, 0x7f, 0x09, 0xab,
-		0xdb, 0x66, 0x8f, 0x2e, 0xca, 0xa9, 0x11, 0xb6, 0xce, 0x23, 0xc1, 0xe5, 0x1a, 0x47, 0x58, 0x37,
-		0x5b, 0x78, 0x35, 0x5c, 0xdf, 0x28, 0x97, 0x4b, 0x4a, 0x42, 0x50, 0xb9, 0x6a, 0xf6, 0x88, 0x42,
-		0xed, 0x98, 0x8e, 0x80, 0x13, 0x4c, 0xa1, 0x76, 0x4c, 0x21, 0xde, 0x8b, 0x30, 0xdf, 0x6c, 0xb2,
-		0x3e, 0xeb, 0x4d, 0x95, 0x67, 0x09, 0xac, 0x8c, 0xec, 0x13, 0x56, 0xb3, 0x79, 0x8d, 0x01, 0x70,
-		0x8d, 0xb7, 0xd0, 0x65, 0x38, 0xe2, 0x0a, 0xcb, 0x8b, 0x38, 0x37, 0xd4, 0xcb, 0x41, 0xd4, 0x8b,
-		0x30, 0xdf, 0xdd, 0x1f, 0x46, 0x44, 0xbe, 0x16, 0xbb, 0xfb, 0x83, 0x68, 0x0f, 0xd0, 0x34, 0x51,
-		0x0f, 0x37, 0xa9, 0xef, 0x78, 0xcc, 0x0b, 0xed, 0xa9, 0x40, 0xcb, 0x20, 0x37, 0x9b, 0x2a, 0x36,
-		0xb4, 0xad, 0x36, 0x56, 0xb5, 0x1e, 0x36, 0x34, 0x2b, 0xb3, 0x48, 0x81, 0x43, 0x76, 0xaf, 0x8f,
-		0x95, 0x54, 0xb3, 0x59, 0xa6, 0x95, 0x79, 0x5a, 0x87, 0xce, 0xc2, 0x9c, 0xb9, 0xf5, 0x42, 0x93,
-		0x29, 0x96, 0xda, 0xed, 0xe1, 0x6d, 0x7d, 0x2f, 0xf3, 0x26, 0x2a, 0xa5, 0x34, 0xa9, 0xa0, 0x6a,
-		0xb5, 0x41, 0x8b, 0xd1, 0xc3, 0x20, 0x37, 0xad, 0x5d, 0xad, 0xd7, 0xa5, 0xa6, 0xda, 0xea, 0x6a,
-		0x4d, 0x9c, 0x79, 0x80, 0x81, 0xb2, 0xf2, 0xaa, 0x28, 0x26, 0x8a, 0x6d, 0xbd, 0xa8, 0x6f, 0xdb,
-		0x82, 0xe2, 0x43, 0x4c, 0xb1, 0x69, 0x19, 0xa7, 0x76, 0x06, 0xe4, 0xee, 0x6e, 0xd7, 0xdf, 0xf0,
-		0x19, 0x0a, 0x96, 0xea, 0xee, 0x76, 0xbd, 0xed, 0xde, 0x0f, 0xb3, 0x04, 0xd2, 0x6d, 0xf4, 0x61,
-		0xe6, 0xd0, 0x75, 0x77, 0x3d, 0x2d, 0x3e, 0x01, 0x47, 0x09, 0x50, 0x07, 0xdb, 0x5a, 0x4b, 0xb3,
-		0x35, 0x0f, 0xf4, 0xa3, 0x14, 0x7a, 0xa1, 0xbb, 0xdb, 0x5d, 0xe7, 0x95, 0x3e, 0x3e, 0x7b, 0xfd,
-		0xad, 0x7d, 0x47, 0x3f, 0xce, 0x31, 0x3e, 0x49, 0x99, 0xd0, 0x90, 0x7b, 0x8e, 0x67, 0xbe, 0x6e,
-		0xd1, 0x5b, 0x6e, 0x15, 0x92, 0x5e, 0xbd, 0x47, 0x71, 0x60, 0x9a, 0x2f, 0x4b, 0xc4, 0xab, 0x2a,
-		0xd6, 0x4a, 0xc4, 0x1f, 0x7a, 0xbe, 0x2c, 0x07, 0x88, 0x5f, 0xb6, 0x56, 0x69, 0x94, 0x55, 0x65,
-		0xb3, 0xda, 0xa8, 0xac, 0x97, 0xe5, 0xa0, 0x27, 0x52, 0xb8, 0x11, 0x8a, 0x9d, 0x95, 0x1f, 0xb9,
-		0x11, 0x8a, 0x3d, 0x28, 0x3f, 0x44, 0xc5, 0x33, 0xa4, 0x94, 0xb9, 0xd7, 0x82, 0x90, 0xf2, 0xa7,
-		0x0a, 0xd0, 0x9b, 0xe1, 0x98, 0xc8, 0x05, 0x5a, 0xd8, 0x56, 0x5f, 0xd4, 0x7b, 0x74, 0xb2, 0x76,
-		0x34, 0xb6, 0x8c, 0x3a, 0x4a, 0xb9, 0xc0, 0xa1, 0xea, 0xd8, 0x7e, 0x56, 0xef, 0x91, 0xa9, 0xd8,
-		0xd1, 0x6c, 0xb4, 0x06, 0x8b, 0x86, 0xa9, 0x5a, 0xb6, 0x66, 0xb4, 0xb4, 0x5e, 0xcb, 0x9b, 0x89,
-		0xd5, 0x9a, 0x4d, 0x6c, 0x59, 0x26, 0x5b, 0x32, 0x1d, 0x2a, 0xf7, 0x19, 0x66, 0x9d, 0x03, 0xbb,
-		0xab, 0x47, 0x9e, 0x83, 0x0e, 0xcc, 0x89, 0xe0, 0xb8, 0x39, 0x71, 0x02, 0xe2, 0x1d, 0xad, 0xab,
-		0x62, 0xc3, 0xee, 0xed, 0xd3, 0x60, 0x20, 0xa6, 0xc4, 0x3a, 0x5a, 0xb7, 0x4c, 0xbe, 0xd1, 0x4d,
-		0x78, 0xd0, 0x05, 0x55, 0xdb, 0x78, 0x47, 0x6b, 0xee, 0xab, 0xd4, 0xf3, 0xa7, 0x79, 0x2b, 0xb5,
-		0x69, 0x1a, 0xdb, 0x6d, 0xbd, 0x69, 0x5b, 0xd4, 0x76, 0x30, 0xfb, 0x97, 0x73, 0x31, 0xd6, 0x28,
-		0xc2, 0x0d, 0xcb, 0x34, 0xa8, 0xc3, 0x5f, 0x14, 0xd0, 0x3e, 0xb5, 0x49, 0xbe, 0x21, 0xd4, 0xc6,
-		0x3f, 0xf4, 0x21, 0x39, 0x7c, 0x23, 0x14, 0x0b, 0xcb, 0x91, 0x1b, 0xa1, 0x58, 0x44, 0x8e, 0xde,
-		0x08, 0xc5, 0x62, 0x72, 0xfc, 0x46, 0x28, 0x16, 0x97, 0x21, 0xf7, 0xd1, 0x59, 0x48, 0x7a, 0xe3,
-		0x17, 0x12, 0x0e, 0x36, 0xe9, 0x82, 0x2b, 0x51, 0x93, 0x7c, 0xff, 0x81, 0xd1, 0xce, 0x72, 0x91,
-		0xac, 0xc4, 0xab, 0x11, 0x16, 0x2c, 0x28, 0x0c, 0x93, 0xf8, 0x44, 0x64, 0x92, 0x61, 0xe6, 0x59,
-		0xc5, 0x14, 0xfe, 0x85, 0xae, 0x41, 0xe4, 0x05, 0x8b, 0xd2, 0x66, 0x8e, 0xdd, 0x9b, 0x0e, 0xa6,
-		0x7d, 0xa3, 0x4e, 0x89, 0xc7, 0x6f, 0xd4, 0xd5, 0x6a, 0x4d, 0x59, 0xcf, 0xaf, 0x29, 0x1c, 0x1d,
-		0x1d, 0x87, 0x50, 0x5b, 0x7b, 0x69, 0xdf, 0xbf, 0x66, 0xd3, 0x22, 0xb4, 0x0c, 0xe9, 0xbe, 0xc1,
-		0x82, 0x7f, 0x32, 0xc6, 0x04, 0x2a, 0xed, 0x85, 0x4a, 0xb9, 0xb5, 0x6b, 0x04, 0x7e, 0x4a, 0xbd,
-		0x3a, 0x09, 0xa1, 0x17, 0xb1, 0x76, 0xdb, 0xb7, 0xb2, 0x52, 0xfd, 0xa0, 0xc5, 0xe8, 0x0c, 0x24,
-		0x5b, 0x78, 0xab, 0xbf, 0xa3, 0xf6, 0x70, 0x4b, 0x6b, 0xda, 0xfe, 0x35, 0x25, 0x41, 0xab, 0x14,
-		0x5a, 0x83, 0x9e, 0x81, 0x38, 0x19, 0x27, 0x83, 0x8e, 0xf3, 0x1c, 0x15, 0xc3, 0xb9, 0x83, 0xc5,
-		0xc0, 0x87, 0x59, 0x20, 0x29, 0x2e, 0x3e, 0xba, 0x0e, 0x51, 0x5b, 0xeb, 0xed, 0x60, 0xdb, 0xca,
-		0xcc, 0x2f, 0x05, 0xcf, 0xa4, 0x46, 0xe4, 0xee, 0x46, 0x90, 0x6a, 0x50, 0x14, 0x1a, 0x7e, 0x0b,
-		0x74, 0xf4, 0x2c, 0xc8, 0x3c, 0x45, 0xac, 0xf2, 0xd8, 0xd9, 0xca, 0x2c, 0x50, 0x25, 0x7c, 0xf4,
-		0x60, 0x92, 0x3c, 0xc3, 0x5c, 0x62, 0x48, 0x4a, 0x1a, 0xfb, 0xbe, 0xfd, 0x73, 0xe3, 0xc8, 0x61,
-		0xe6, 0xc6, 0x26, 0xa4, 0xf9, 0xdf, 0xaa, 0xd5, 0xef, 0x76, 0xcd, 0x9e, 0x9d, 0x39, 0x4a, 0xf1,
-		0x27, 0x30, 0x24, 0x88, 0x31, 0x1c, 0x25, 0xb5, 0xed, 0xfb, 0xfe, 0xfa, 0x4d, 0xb9, 0xec, 0xf3,
-		0x90, 0xf2, 0x0b, 0xc3, 0x9b, 0xa0, 0x0f, 0x4e, 0x99, 0xa0, 0x27, 0x81, 0x8a, 0x88, 0xfe, 0xc8,
-		0xf2, 0xc4, 0x3e, 0xb2, 0x3f, 0x18, 0x80, 0x94, 0xbf, 0x63, 0xe8, 0x1a, 0x20, 0x31, 0x62, 0xba,
-		0x61, 0xf7, 0xcc, 0x56, 0xbf, 0x89, 0x5b, 0x7c, 0xd2, 0x8e, 0x6f, 0x67, 0x8e, 0xe3, 0x54, 0x1c,
-		0x14, 0x2f, 0x21, 0xcf, 0x4c, 0x08, 0x4c, 0x49, 0xa8, 0xe4, 0xce, 0x91, 0xf3, 0x30, 0x2f, 0x08,
-		0x10, 0x62, 0x2f, 0x6a, 0x3d, 0x83, 0xb8, 0xc9, 0xcc, 0x71, 0x47, 0x9e, 0xaa, 0x67, 0x59, 0x0d,
-		0xca, 0x83, 0x50, 0x17, 0xb5, 0x87, 0x3b, 0xe6, 0x1d, 0xdc, 0xe2, 0x39, 0xa8, 0xf1, 0xcd, 0xa6,
-		0x38, 0x82, 0xc2, 0xe0, 0x73, 0xe7, 0x21, 0x4c, 0x4d, 0x10, 0x02, 0xe0, 0x46, 0x48, 0x9e, 0x41,
-		0x31, 0x08, 0x15, 0x6b, 0x0a, 0x59, 0x22, 0x65, 0x48, 0xb2, 0x52, 0x75, 0xa3, 0x52, 0x2e, 0x96,
-		0xe5, 0x40, 0xee, 0x22, 0x44, 0x98, 0x5d, 0x21, 0xcb, 0xa7, 0x63, 0x59, 0xe4, 0x19, 0xfe, 0xc9,
-		0x69, 0x48, 0xa2, 0x76, 0x73, 0xbd, 0x50, 0x56, 0xe4, 0x40, 0x6e, 0x13, 0xd2, 0x03, 0xf3, 0x10,
-		0x1d, 0x81, 0x39, 0xa5, 0xdc, 0x28, 0x57, 0x1b, 0x95, 0x5a, 0x55, 0xdd, 0xac, 0x3e, 0x53, 0xad,
-		0x3d, 0x5b, 0x95, 0x67, 0xfc, 0xc5, 0x62, 0x2d, 0x96, 0xd0, 0x02, 0xc8, 0x6e, 0x71, 0xbd, 0xb6,
-		0xa9, 0x50, 0x6e, 0xbe, 0x3b, 0x00, 0xf2, 0xe0, 0xa4, 0x44, 0xc7, 0x60, 0xbe, 0x91, 0x57, 0xae,
-		0x95, 0x1b, 0x2a, 0xcb, 0xa2, 0x38, 0xa4, 0x17, 0x40, 0xf6, 0x56, 0x5c, 0xad, 0xd0, 0x24, 0xd1,
-		0x22, 0x9c, 0xf0, 0x96, 0x96, 0x9f, 0x6b, 0x94, 0xab, 0x75, 0xda, 0x78, 0xbe, 0x7a, 0x8d, 0x38,
-		0x06, 0x03, 0xf4, 0x44, 0xde, 0x26, 0x48, 0x58, 0xf5, 0xd3, 0x2b, 0xaf, 0x95, 0xe4, 0xd0, 0x60,
-		0x71, 0xad, 0x5a, 0xae, 0x5d, 0x95, 0xc3, 0x83, 0xad, 0xd3, 0x5c, 0x4e, 0x04, 0x65, 0xe1, 0xe8,
-		0x60, 0xa9, 0x5a, 0xae, 0x36, 0x94, 0x5b, 0x72, 0x74, 0xb0, 0xe1, 0x7a, 0x59, 0xb9, 0x59, 0x29,
-		0x96, 0xe5, 0x18, 0x3a, 0x0a, 0xc8, 0xcf, 0x51, 0xe3, 0x7a, 0xad, 0x24, 0xc7, 0x47, 0xad, 0x5a,
-		0x48, 0x9e, 0xcf, 0xfd, 0xb4, 0x04, 0x49, 0x6f, 0x5e, 0xc5, 0x67, 0x54, 0xa4, 0x37, 0xda, 0x82,
-		0x9b, 0xfb, 0xdd, 0x00, 0x24, 0x3c, 0x09, 0x16, 0x12, 0xc8, 0x6a, 0xed, 0xb6, 0xf9, 0xa2, 0xaa,
-		0xb5, 0x75, 0xcd, 0xe2, 0x6b, 0x22, 0xd0, 0xa2, 0x3c, 0x29, 0x99, 0x76, 0x0d, 0x9a, 0xde, 0x7d,
-		0x89, 0xdc, 0xb3, 0xfb, 0x12, 0x7d, 0x03, 0xba, 0x2f, 0x61, 0x39, 0x92, 0xfb, 0xfd, 0x00, 0xc8,
-		0x83, 0xf9, 0x92, 0x01, 0xb9, 0x49, 0xe3, 0xe4, 0xe6, 0xed, 0x5f, 0xe0, 0x30, 0xfd, 0x1b, 0x5c,
-		0xd5, 0x83, 0x63, 0x57, 0xf5, 0x11, 0x8b, 0x55, 0xe8, 0x8d, 0xbc, 0x58, 0x79, 0xd5, 0xf5, 0xdf,
-		0x4a, 0x90, 0xf2, 0xa7, 0x77, 0x7c, 0x12, 0xcb, 0x1d, 0x46, 0x62, 0xfe, 0x11, 0x39, 0x3d, 0x6e,
-		0x44, 0xbe, 0x21, 0xfd, 0xfa, 0xe1, 0x20, 0xcc, 0xfa, 0xf2, 0x3f, 0xd3, 0x72, 0xf7, 0x0e, 0x98,
-		0xd3, 0x5b, 0xb8, 0xd3, 0x35, 0x6d, 0x6c, 0x34, 0xf7, 0xd5, 0x36, 0xbe, 0x83, 0xdb, 0x54, 0x0c,
-		0xa9, 0x11, 0xbb, 0xbe, 0xbe, 0x16, 0x96, 0x2b, 0x2e, 0xde, 0x1a, 0x41, 0x5b, 0x9d, 0xaf, 0x94,
-		0xca, 0xeb, 0x1b, 0xb5, 0x46, 0xb9, 0x5a, 0xbc, 0x25, 0x2c, 0xb9, 0x22, 0xeb, 0x03, 0x60, 0x3e,
-		0x81, 0xdf, 0xff, 0xc6, 0x08, 0x3c, 0x37, 0x40, 0x1e, 0xec, 0x0d, 0x31, 0xe8, 0x23, 0xfa, 0x23,
-		0xcf, 0xa0, 0x79, 0x48, 0x57, 0x6b, 0x6a, 0xbd, 0x52, 0x2a, 0xab, 0xe5, 0xab, 0x57, 0xcb, 0xc5,
-		0x46, 0x9d, 0xed, 0x5e, 0x38, 0xd0, 0x0d, 0x39, 0xe0, 0x1d, 0x9b, 0x1f, 0x09, 0xc2, 0xfc, 0x08,
-		0x4e, 0x50, 0x9e, 0xa7, 0x09, 0x59, 0x1e, 0xf3, 0xdc, 0x34, 0xdc, 0x2f, 0x93, 0x08, 0x7f, 0x43,
-		0xeb, 0xd9, 0x3c, 0xab, 0xf8, 0x30, 0x10, 0xf1, 0x1a, 0x36, 0x71, 0xf1, 0x7b, 0x7c, 0x57, 0x88,
-		0xb9, 0x20, 0x69, 0xb7, 0x9c, 0x6d, 0x0c, 0x3d, 0x0a, 0xa8, 0x6b, 0x5a, 0xba, 0xad, 0xdf, 0xc1,
-		0xc4, 0x87, 0xe2, 0xc0, 0x64, 0xe2, 0x86, 0x14, 0x59, 0xd4, 0x54, 0x0c, 0xdb, 0x81, 0x36, 0xf0,
-		0x8e, 0x36, 0x00, 0x4d, 0x42, 0x90, 0xa0, 0x22, 0x8b, 0x1a, 0x07, 0xfa, 0x34, 0x24, 0x5b, 0x66,
-		0x7f, 0xab, 0x8d, 0x39, 0x1c, 0x31, 0xc9, 0x92, 0x92, 0x60, 0x65, 0x0e, 0x08, 0x4f, 0x9d, 0xb9,
-		0x7b, 0x57, 0x49, 0x25, 0xc1, 0xca, 0x18, 0xc8, 0x43, 0x90, 0xd6, 0x76, 0x76, 0x7a, 0x84, 0xb8,
-		0x20, 0xc4, 0x92, 0x81, 0x29, 0xa7, 0x98, 0x02, 0x66, 0x6f, 0x40, 0x4c, 0xc8, 0x81, 0xc4, 0xc0,
-		0x44, 0x12, 0x6a, 0x97, 0xe5, 0xbb, 0x03, 0x67, 0xe2, 0x4a, 0xcc, 0x10, 0x95, 0xa7, 0x21, 0xa9,
-		0x5b, 0xee, 0x39, 0xa8, 0x4c, 0x60, 0x29, 0x70, 0x26, 0xa6, 0x24, 0x74, 0xcb, 0x3d, 0xeb, 0xf4,
-		0x91, 0x39, 0x00, 0x57, 0xd9, 0xd0, 0xf7, 0x49, 0x90, 0x62, 0x0b, 0x4c, 0xb7, 0x87, 0x2d, 0x6c,
-		0x34, 0x45, 0x68, 0xf8, 0xf0, 0x01, 0x2a, 0xca, 0xcc, 0xdc, 0x06, 0x47, 0x28, 0x3c, 0xfd, 0x5e,
-		0x49, 0x7a, 0x45, 0x0a, 0xbd, 0x22, 0x49, 0x1f, 0x93, 0x66, 0x51, 0xac, 0xfc, 0xdc, 0xc6, 0x5a,
-		0xa5, 0x58, 0x69, 0x64, 0xde, 0x13, 0xa5, 0xdf, 0x95, 0x75, 0xfe, 0xfd, 0xf9, 0xa8, 0xbf, 0xfe,
-		0xd5, 0xe8, 0xcf, 0x4b, 0xc1, 0xd8, 0xab, 0x51, 0x65, 0x76, 0xdb, 0x4b, 0x0f, 0xb5, 0xbd, 0x27,
-		0x3b, 0x02, 0xe3, 0x82, 0x49, 0x97, 0x9b, 0x32, 0x3f, 0xcf, 0x51, 0x78, 0x98, 0x32, 0x12, 0xa1,
-		0x8c, 0x24, 0x50, 0xa4, 0xb8, 0x56, 0xab, 0x97, 0x4b, 0x94, 0x8d, 0x38, 0x0a, 0xd5, 0x36, 0xca,
-		0xd5, 0xcc, 0xe7, 0x45, 0x93, 0xee, 0x21, 0x90, 0x57, 0x24, 0x38, 0x26, 0xb6, 0x6e, 0xf9, 0x5a,
-		0x8b, 0x8d, 0xa6, 0xd9, 0x12, 0xde, 0x6d, 0x6a, 0xe5, 0xc2, 0x41, 0x8d, 0x2b, 0x1c, 0x95, 0x8a,
-		0xa4, 0xcc, 0x11, 0x0b, 0xe7, 0x86, 0x44, 0x92, 0xaf, 0x96, 0x38, 0x2f, 0x09, 0x14, 0xd9, 0xc8,
-		0x17, 0x9f, 0x29, 0x97, 0x5c, 0x6e, 0x8e, 0xf4, 0x46, 0x51, 0x41, 0xdf, 0x06, 0xe9, 0xbe, 0xbd,
-		0xfd, 0x14, 0xd1, 0x0d, 0xbd, 0xc5, 0xf6, 0xd2, 0x43, 0xe3, 0x36, 0x61, 0x5d, 0x8e, 0x36, 0xed,
-		0xed, 0xa7, 0x6e, 0x3a, 0x18, 0x5c, 0x28, 0x8c, 0x95, 0x38, 0x0a, 0x55, 0x6b, 0xd5, 0xb2, 0x60,
-		0x83, 0xee, 0x3b, 0xdf, 0x72, 0xd9, 0x48, 0xf5, 0x7d, 0xa8, 0xe8, 0xdb, 0x40, 0x16, 0x29, 0x22,
-		0x47, 0x24, 0xe1, 0x71, 0xfb, 0xc8, 0x2e, 0x03, 0x3c, 0xd1, 0xe4, 0x08, 0xe3, 0x41, 0x0f, 0x07,
-		0x0b, 0x28, 0xbd, 0x56, 0xae, 0x5e, 0x6b, 0x5c, 0x57, 0x37, 0x94, 0x32, 0xdd, 0x0e, 0xcc, 0xbc,
-		0x47, 0x34, 0x9f, 0xee, 0xf8, 0x11, 0xd1, 0xb7, 0x4b, 0x90, 0x60, 0x2e, 0x10, 0xcb, 0x4b, 0xb1,
-		0xc4, 0xc2, 0x83, 0x07, 0xb5, 0x4d, 0x3d, 0x20, 0x0a, 0x5d, 0xb8, 0x4c, 0x9b, 0x0d, 0x0a, 0x85,
-		0x38, 0x86, 0xd0, 0x5a, 0xf9, 0x5a, 0xbe, 0x78, 0x4b, 0x2d, 0x94, 0xeb, 0x0d, 0x62, 0xc9, 0x6a,
-		0x0a, 0xd3, 0x51, 0x40, 0xe1, 0xfc, 0xda, 0x5a, 0xed, 0x59, 0x57, 0x10, 0xf0, 0x82, 0x43, 0x06,
-		0xfd, 0x94, 0x04, 0x0b, 0xd8, 0xd8, 0x36, 0x7b, 0x4d, 0xba, 0x85, 0x4d, 0x66, 0xb4, 0x65, 0xef,
-		0xb7, 0xd9, 0x8c, 0x1e, 0x19, 0x94, 0x7b, 0x35, 0x93, 0xe2, 0x55, 0x29, 0x5a, 0x9d, 0x60, 0x15,
-		0x2a, 0xef, 0x95, 0x02, 0xaf, 0x10, 0xc6, 0x02, 0x94, 0xb7, 0xd0, 0x2b, 0x52, 0x98, 0x72, 0x18,
-		0x7d, 0x45, 0x8a, 0xbd, 0x22, 0xc5, 0x3f, 0x26, 0xcd, 0xa1, 0x64, 0xbd, 0x71, 0x6b, 0xad, 0xac,
-		0x32, 0x6e, 0x29, 0x87, 0x29, 0x14, 0xa7, 0x65, 0x2b, 0x8f, 0xad, 0x3c, 0x91, 0xf9, 0x02, 0xe5,
-		0xf2, 0x0b, 0x51, 0x05, 0xe1, 0x21, 0xf2, 0xe8, 0x57, 0x24, 0x38, 0x2e, 0x36, 0xcd, 0x2d, 0xba,
-		0x91, 0xa6, 0x7a, 0xb6, 0xdc, 0x62, 0x94, 0xe5, 0xf2, 0x41, 0x2c, 0xbb, 0xfb, 0x6e, 0xbc, 0x70,
-		0x99, 0x07, 0xbc, 0x83, 0xdb, 0x72, 0x85, 0x4b, 0xac, 0x27, 0x1f, 0x93, 0xd2, 0x08, 0xca, 0xcf,
-		0x6d, 0xd4, 0x94, 0x86, 0x9a, 0x5f, 0x5b, 0xa3, 0xfc, 0x1e, 0x41, 0x32, 0x2f, 0x69, 0xd4, 0x36,
-		0xd4, 0xb5, 0xf2, 0xcd, 0xf2, 0x9a, 0xcb, 0xf6, 0xb1, 0xd6, 0x68, 0x82, 0xd9, 0x8f, 0x4a, 0x30,
-		0x37, 0xd4, 0x7c, 0xee, 0x5d, 0x12, 0x1c, 0x1b, 0xc3, 0x02, 0x7a, 0x00, 0x4e, 0x97, 0xca, 0x57,
-		0xf3, 0x9b, 0x6b, 0x0d, 0xb5, 0x7e, 0x6b, 0xbd, 0x50, 0x5b, 0x53, 0x6f, 0x56, 0xea, 0x95, 0x42,
-		0x65, 0xad, 0xd2, 0xf0, 0x2e, 0x60, 0x29, 0xf0, 0x30, 0xc8, 0xc2, 0xb5, 0x41, 0xf6, 0xe4, 0x00,
-		0x09, 0x0a, 0xd7, 0x6a, 0xc5, 0xfc, 0x1a, 0x05, 0x0a, 0x8a, 0x98, 0xb3, 0xd8, 0x90, 0x43, 0x37,
-		0x62, 0x31, 0x89, 0xaf, 0x6d, 0xdf, 0x0c, 0xb3, 0x3e, 0xe3, 0x47, 0x42, 0x24, 0x1a, 0x5a, 0x11,
-		0x7d, 0xae, 0x97, 0xab, 0x45, 0x6f, 0x48, 0x97, 0x04, 0xc7, 0xd8, 0xc9, 0x12, 0xf9, 0x12, 0xa6,
-		0x50, 0x0e, 0x90, 0x45, 0x95, 0xab, 0xa3, 0xb3, 0x5d, 0x1d, 0xcc, 0x3d, 0x09, 0x31, 0x61, 0xcc,
-		0x48, 0xa0, 0x46, 0xe3, 0xad, 0x81, 0x30, 0x31, 0x06, 0xd4, 0x92, 0xc9, 0x12, 0x61, 0x90, 0x59,
-		0x38, 0x39, 0x90, 0xbb, 0x09, 0x47, 0x46, 0x1a, 0x22, 0x74, 0x3f, 0x2c, 0x8a, 0x2d, 0x72, 0x16,
-		0x02, 0xaa, 0xe5, 0x6a, 0xb1, 0x56, 0x22, 0x41, 0xb3, 0x4b, 0x13, 0x80, 0x5b, 0x24, 0xc6, 0xa5,
-		0xb0, 0x56, 0x72, 0x20, 0x57, 0x81, 0x94, 0xdf, 0x9c, 0xa0, 0x13, 0x70, 0x6c, 0xb3, 0x71, 0xf5,
-		0x29, 0xf5, 0x66, 0x7e, 0xad, 0x52, 0xca, 0x0f, 0x84, 0xc7, 0x00, 0xdc, 0xa6, 0xc8, 0x01, 0xc2,
-		0x28, 0xb1, 0x35, 0x72, 0x30, 0x17, 0x8a, 0x49, 0xb2, 0x94, 0xab, 0x43, 0x7a, 0xc0, 0x30, 0xa0,
-		0xfb, 0x20, 0xc3, 0xe3, 0xd5, 0x51, 0x5c, 0x51, 0x09, 0xf9, 0x4c, 0x05, 0x8b, 0xdc, 0x4b, 0xe5,
-		0xb5, 0xca, 0x7a, 0xa5, 0x41, 0xf9, 0xbb, 0x0e, 0xe0, 0xce, 0x78, 0xe2, 0xc1, 0xdc, 0xa8, 0xd7,
-		0xaa, 0xea, 0x55, 0x12, 0xf6, 0x37, 0x3c, 0xa4, 0xe2, 0xc0, 0x66, 0xb8, 0x2c, 0x91, 0xe8, 0x74,
-		0xd8, 0x0c, 0xc8, 0x81, 0xdc, 0xb3, 0x80, 0x86, 0x67, 0x2b, 0x5a, 0x82, 0xfb, 0xca, 0xd5, 0xab,
-		0x35, 0xa5, 0x58, 0x56, 0xab, 0xf9, 0x75, 0xc2, 0x1f, 0x9b, 0x9b, 0x2e, 0xe9, 0x59, 0x70, 0xa7,
-		0xa6, 0xc8, 0x49, 0xb8, 0xb3, 0x57, 0x0e, 0x9c, 0xfd, 0xa1, 0x00, 0xf1, 0x8c, 0xde, 0x57, 0xcd,
-		0x7e, 0x4f, 0x00, 0x9d, 0x8c, 0xbd, 0x1a, 0x45, 0xd1, 0xe5, 0xee, 0xd6, 0x72, 0xb3, 0xdb, 0xcd,
-		0xa6, 0xc9, 0x1f, 0xc5, 0x6e, 0xf7, 0xaa, 0xf0, 0xf7, 0x16, 0x63, 0x5f, 0x88, 0xa2, 0x18, 0x29,
-		0x7d, 0x41, 0xbb, 0xa3, 0x65, 0x65, 0xf2, 0xd7, 0x0d, 0xed, 0x8e, 0xe6, 0x00, 0x9c, 0x88, 0x7d,
-		0x31, 0x8a, 0x22, 0xa4, 0x78, 0xc7, 0xcc, 0xa6, 0xc8, 0xbf, 0xd7, 0x4c, 0xa7, 0xf2, 0xfe, 0xd8,
-		0x97, 0xa2, 0x08, 0x48, 0x61, 0x77, 0xdf, 0xde, 0x35, 0x8d, 0x2c, 0x22, 0x7f, 0x6f, 0xd0, 0xbf,
-		0x1d, 0xa0, 0x8b, 0xb1, 0xdf, 0x89, 0xa1, 0xcc, 0xb2, 0xde, 0xe9, 0x92, 0xff, 0x7a, 0xd8, 0xb2,
-		0x54, 0x27, 0xb6, 0xc1, 0x76, 0xf6, 0x28, 0xad, 0xa9, 0xb0, 0x1a, 0x8f, 0x9b, 0xb0, 0x1c, 0xfb,
-		0x8e, 0x2a, 0x92, 0x05, 0x67, 0x6a, 0xa7, 0x6f, 0x6b, 0x5b, 0x6d, 0x9c, 0x3d, 0x26, 0x38, 0x5c,
-		0x67, 0x05, 0x1e, 0x5e, 0xbe, 0xb3, 0xca, 0x79, 0x21, 0x36, 0xe6, 0x02, 0xe7, 0x85, 0xfe, 0x2d,
-		0x80, 0xce, 0x46, 0x62, 0xef, 0xab, 0xca, 0x1f, 0xa8, 0x9e, 0x8d, 0xc4, 0x3e, 0x50, 0x95, 0xbf,
-		0xaf, 0x7a, 0x23, 0x12, 0xfb, 0x7c, 0x54, 0x7e, 0x35, 0x9a, 0xfb, 0xf3, 0x20, 0x20, 0xb7, 0x6d,
-		0x27, 0xd9, 0xf8, 0x1c, 0xc4, 0x9c, 0xec, 0x25, 0x3b, 0x60, 0xfe, 0xe6, 0x03, 0x0c, 0x99, 0x40,
-		0xf3, 0x14, 0x0d, 0x64, 0x33, 0x1d, 0x6a, 0x28, 0x0f, 0xe9, 0x8e, 0x6e, 0xe8, 0x9d, 0x7e, 0x47,
-		0x15, 0x29, 0xbd, 0x89, 0xa9, 0x2a, 0x8e, 0xc0, 0xbf, 0x29, 0x09, 0x6d, 0xcf, 0x47, 0x22, 0x3c,
-		0x91, 0x04, 0x43, 0xe0, 0xdf, 0xd9, 0xbf, 0x95, 0x20, 0x33, 0x8e, 0xd9, 0x7b, 0xca, 0x36, 0x56,
-		0x61, 0xc1, 0xbc, 0x83, 0x7b, 0x3d, 0xbd, 0x45, 0x37, 0x11, 0x9d, 0x18, 0x24, 0x34, 0x39, 0x06,
-		0x99, 0xf7, 0x20, 0x3a, 0x83, 0x5a, 0x20, 0xae, 0xe2, 0x1e, 0xf1, 0x92, 0x04, 0xa5, 0xf0, 0x64,
-		0x4a, 0xb3, 0x14, 0x45, 0xd0, 0xb8, 0x41, 0x6c, 0x01, 0x09, 0xfb, 0x03, 0x72, 0xd0, 0x0d, 0x74,
-		0x72, 0x1f, 0x0e, 0x42, 0xca, 0x7f, 0x4e, 0x1a, 0x95, 0x20, 0xd6, 0x36, 0xf9, 0x01, 0x42, 0x36,
-		0xda, 0x67, 0x26, 0x1c, 0xad, 0x5e, 0x5e, 0xe3, 0xf0, 0x8a, 0x83, 0x99, 0xfd, 0x37, 0x12, 0xc4,
-		0x44, 0x31, 0x3a, 0x0a, 0xa1, 0xae, 0x66, 0xef, 0x52, 0x72, 0xe1, 0x42, 0x40, 0x96, 0x14, 0xfa,
-		0x4d, 0xca, 0xad, 0xae, 0xc6, 0x0e, 0x4f, 0xf2, 0x72, 0xf2, 0x4d, 0x82, 0x8d, 0x36, 0xd6, 0x5a,
-		0x74, 0xfb, 0xdb, 0xec, 0x74, 0xb0, 0x61, 0x5b, 0x22, 0xd8, 0xe0, 0xe5, 0x45, 0x5e, 0x8c, 0x1e,
-		0x81, 0x39, 0xbb, 0xa7, 0xe9, 0x6d, 0x1f, 0x6c, 0x88, 0xc2, 0xca, 0xa2, 0xc2, 0x01, 0x5e, 0x85,
-		0xe3, 0x82, 0x6e, 0x0b, 0xdb, 0x5a, 0x73, 0x17, 0xb7, 0x5c, 0xa4, 0x08, 0x3d, 0x37, 0x73, 0x8c,
-		0x03, 0x94, 0x78, 0xbd, 0xc0, 0x3d, 0xdb, 0xf7, 0xdd, 0x93, 0xd8, 0x41, 0x98, 0xdf, 0x93, 0xb8,
-		0x30, 0xe6, 0x9e, 0xc4, 0xe0, 0x31, 0x75, 0xcf, 0x25, 0x89, 0xb3, 0x23, 0x50, 0xfc, 0x12, 0x75,
-		0xa3, 0x86, 0xcf, 0x04, 0x60, 0x4e, 0x9c, 0x13, 0x68, 0x39, 0x63, 0xb4, 0x0e, 0xa0, 0x19, 0x86,
-		0x69, 0x7b, 0x47, 0x69, 0x38, 0xac, 0x1b, 0xc2, 0x5b, 0xce, 0x3b, 0x48, 0x8a, 0x87, 0x40, 0xf6,
-		0x4b, 0x12, 0x80, 0x5b, 0x35, 0x76, 0xb8, 0x16, 0x21, 0xc1, 0x7b, 0x45, 0xef, 0x9a, 0xb0, 0x54,
-		0x3a, 0xb0, 0xa2, 0xab, 0x7a, 0x9b, 0x1e, 0x07, 0xda, 0xc2, 0x3b, 0xba, 0xc1, 0x8f, 0x42, 0xb2,
-		0x0f, 0x71, 0x1c, 0x28, 0xe4, 0x1e, 0x15, 0x56, 0x20, 0x66, 0xe1, 0x8e, 0x66, 0xd8, 0x7a, 0x93,
-		0x4f, 0xd6, 0x4b, 0x87, 0x62, 0x7e, 0xb9, 0xce, 0xb1, 0x15, 0x87, 0x4e, 0xee, 0x0c, 0xc4, 0x44,
-		0xa9, 0xb3, 0x02, 0xce, 0xa0, 0x28, 0x04, 0xeb, 0x65, 0xe2, 0x03, 0xd0, 0x85, 0xa8, 0x92, 0xaf,
-		0xcb, 0x81, 0xb3, 0xbf, 0x1a, 0x80, 0xa8, 0xb0, 0x1e, 0xf3, 0x90, 0x2e, 0x97, 0x2a, 0x03, 0x8b,
-		0xe9, 0x3c, 0xa4, 0x44, 0x21, 0x5f, 0x4c, 0xde, 0x13, 0xf5, 0x16, 0x6e, 0x28, 0xb5, 0x46, 0x6d,
-		0x45, 0xfe, 0xd3, 0xe1, 0xc2, 0xc7, 0xe5, 0xcf, 0x47, 0xd1, 0x1c, 0x24, 0x45, 0xe1, 0xca, 0x63,
-		0x2b, 0x8f, 0xcb, 0xaf, 0x0e, 0x16, 0x3d, 0x21, 0x7f, 0x21, 0x8a, 0x8e, 0x80, 0xec, 0xb6, 0x5c,
-		0x6f, 0xe4, 0x0b, 0x6b, 0x65, 0xf9, 0x7b, 0xab, 0x64, 0x25, 0x15, 0xc5, 0x17, 0xd4, 0x06, 0x59,
-		0x28, 0x6b, 0xd5, 0xb5, 0x5b, 0xb2, 0xe4, 0xad, 0x58, 0xf1, 0x54, 0x04, 0xd0, 0x49, 0x38, 0x26,
-		0x2a, 0x2e, 0x5f, 0xbe, 0x7c, 0xf9, 0x49, 0x4f, 0xe5, 0xdd, 0xf7, 0x47, 0x06, 0xab, 0x9f, 0xf2,
-		0x54, 0x7f, 0x78, 0xb8, 0xfa, 0xb2, 0xa7, 0xfa, 0x47, 0xdf, 0x1f, 0x41, 0xf3, 0x90, 0x10, 0xd5,
-		0xeb, 0xf9, 0xe7, 0xe4, 0xaf, 0x7e, 0xf5, 0xab, 0x5f, 0x8d, 0x9e, 0xdd, 0x04, 0x79, 0xc8, 0x21,
-		0x5c, 0x00, 0xd9, 0xe7, 0x01, 0x12, 0xa9, 0xcf, 0x0c, 0x94, 0x52, 0x27, 0x4f, 0x96, 0x88, 0x83,
-		0xe5, 0x29, 0x65, 0x0e, 0xa1, 0x1c, 0x28, 0x7c, 0x1b, 0xcc, 0x37, 0xcd, 0xce, 0xa0, 0x22, 0x14,
-		0xe4, 0x81, 0x43, 0x4f, 0xd6, 0x75, 0xe9, 0xf9, 0x73, 0x1c, 0x68, 0xc7, 0x6c, 0x6b, 0xc6, 0xce,
-		0xb2, 0xd9, 0xdb, 0x71, 0xaf, 0x50, 0x91, 0xe0, 0xd5, 0xf2, 0x5c, 0xa4, 0xea, 0x6e, 0xfd, 0xad,
-		0x24, 0x7d, 0x2c, 0x10, 0xbc, 0xb6, 0x51, 0xf8, 0xc9, 0x40, 0xf6, 0x1a, 0x43, 0xdc, 0x10, 0x6a,
-		0xa6, 0xe0, 0xed, 0x36, 0x6e, 0x12, 0x5d, 0x80, 0x3f, 0x7b, 0x04, 0x16, 0x76, 0xcc, 0x1d, 0x93,
-		0x52, 0x3a, 0x4f, 0xfe, 0xe2, 0x77, 0xb0, 0xe2, 0x4e, 0x69, 0x76, 0xe2, 0x85, 0xad, 0xd5, 0x2a,
-		0xcc, 0x73, 0x60, 0x95, 0xc6, 0xd2, 0xec, 0x5c, 0x06, 0x3a, 0xf0, 0xb0, 0x60, 0xe6, 0xe7, 0xfe,
-		0x84, 0x26, 0x41, 0x95, 0x39, 0x8e, 0x4a, 0xea, 0xd8, 0xd1, 0x8d, 0x55, 0x05, 0x8e, 0xf8, 0xe8,
-		0xb1, 0x3c, 0x06, 0xee, 0x4d, 0xa0, 0xf8, 0x9b, 0x9c, 0xe2, 0xbc, 0x87, 0x62, 0x9d, 0xa3, 0xae,
-		0x16, 0x61, 0xf6, 0x30, 0xb4, 0xfe, 0x05, 0xa7, 0x95, 0xc4, 0x5e, 0x22, 0xd7, 0x20, 0x4d, 0x89,
-		0x34, 0xfb, 0x96, 0x6d, 0x76, 0x68, 0x92, 0xe8, 0x60, 0x32, 0xff, 0xf2, 0x4f, 0x98, 0x0d, 0x4f,
-		0x11, 0xb4, 0xa2, 0x83, 0xb5, 0xba, 0x0a, 0x34, 0x27, 0xd0, 0xc2, 0xcd, 0xf6, 0x04, 0x0a, 0xbf,
-		0xc5, 0x19, 0x71, 0xe0, 0x57, 0x6f, 0x92, 0x10, 0xb1, 0xdf, 0xa1, 0x39, 0x1c, 0x2f, 0x27, 0x93,
-		0x8f, 0x05, 0x66, 0x7e, 0xf7, 0xdd, 0x6c, 0x99, 0x98, 0x77, 0x08, 0x78, 0x78, 0xf2, 0x8c, 0xe2,
-		0x0e, 0xb6, 0x6d, 0xdc, 0xb3, 0x54, 0xad, 0x3d, 0x8a, 0x3d, 0xcf, 0x49, 0xaa, 0xcc, 0x0f, 0x7f,
-		0xd9, 0x3f, 0x8a, 0xd7, 0x18, 0x66, 0xbe, 0xdd, 0x5e, 0xdd, 0x84, 0x63, 0x23, 0xb4, 0x62, 0x0a,
-		0x9a, 0x3f, 0xc2, 0x69, 0x2e, 0x0c, 0x69, 0x06, 0x21, 0xbb, 0x01, 0xa2, 0xdc, 0x19, 0xcb, 0x29,
-		0x68, 0x7e, 0x90, 0xd3, 0x44, 0x1c, 0x57, 0x0c, 0x29, 0xa1, 0x78, 0x03, 0xe6, 0xee, 0xe0, 0xde,
-		0x96, 0x69, 0xf1, 0xd3, 0x6b, 0x53, 0x90, 0xfb, 0x10, 0x27, 0x97, 0xe6, 0x88, 0xf4, 0x38, 0x1b,
-		0xa1, 0x75, 0x19, 0x62, 0xdb, 0x5a, 0x13, 0x4f, 0x41, 0xe2, 0x2e, 0x27, 0x11, 0x25, 0xf0, 0x04,
-		0x35, 0x0f, 0xc9, 0x1d, 0x93, 0xa7, 0xf1, 0x26, 0xa3, 0x7f, 0x98, 0xa3, 0x27, 0x04, 0x0e, 0x27,
-		0xd1, 0x35, 0xbb, 0xfd, 0xb6, 0x66, 0x4f, 0xc3, 0xc1, 0x8f, 0x0a, 0x12, 0x02, 0x87, 0x93, 0x38,
-		0x84, 0x58, 0x3f, 0x22, 0x48, 0x58, 0x1e, 0x79, 0x3e, 0x0d, 0x09, 0xd3, 0x68, 0xef, 0x9b, 0xc6,
-		0x34, 0x4c, 0x7c, 0x94, 0x53, 0x00, 0x8e, 0x42, 0x08, 0x5c, 0x81, 0xf8, 0xb4, 0x03, 0xf1, 0xe3,
-		0x5f, 0x16, 0xd3, 0x43, 0x8c, 0xc0, 0x35, 0x48, 0x0b, 0x03, 0xa5, 0x9b, 0xc6, 0x14, 0x24, 0x3e,
-		0xce, 0x49, 0xa4, 0x3c, 0x68, 0xbc, 0x1b, 0x36, 0xb6, 0xec, 0x1d, 0x3c, 0x0d, 0x91, 0xff, 0x5f,
-		0x74, 0x83, 0xa3, 0x70, 0x51, 0x6e, 0x61, 0xa3, 0xb9, 0x3b, 0x1d, 0x85, 0x4f, 0x08, 0x51, 0x0a,
-		0x1c, 0x42, 0xa2, 0x08, 0xb3, 0x1d, 0xad, 0x67, 0xed, 0x6a, 0xed, 0xa9, 0x86, 0xe3, 0x27, 0x38,
-		0x8d, 0xa4, 0x83, 0xc4, 0x25, 0xd2, 0x37, 0x0e, 0x43, 0xe6, 0x27, 0x85, 0x44, 0x3c, 0x68, 0x7c,
-		0xea, 0x59, 0x34, 0xf4, 0x52, 0x0f, 0x43, 0xed, 0xa7, 0xc4, 0xd4, 0x63, 0xb8, 0xeb, 0x5e, 0x8a,
-		0x57, 0x20, 0x6e, 0xe9, 0x2f, 0x4d, 0x45, 0xe6, 0xa7, 0xc5, 0x48, 0x53, 0x04, 0x82, 0x7c, 0x0b,
-		0x8e, 0x8f, 0x5c, 0x26, 0xa6, 0x20, 0xf6, 0x33, 0x9c, 0xd8, 0xd1, 0x11, 0x4b, 0x05, 0x37, 0x09,
-		0x87, 0x25, 0xf9, 0x0f, 0x84, 0x49, 0xc0, 0x03, 0xb4, 0x36, 0x60, 0xa1, 0x6f, 0x58, 0xda, 0xf6,
-		0xe1, 0xa4, 0xf6, 0x0f, 0x85, 0xd4, 0x18, 0xae, 0x4f, 0x6a, 0x0d, 0x38, 0xca, 0x29, 0x1e, 0x6e,
-		0x5c, 0x7f, 0x56, 0x18, 0x56, 0x86, 0xbd, 0xe9, 0x1f, 0xdd, 0x6f, 0x82, 0xac, 0x23, 0x4e, 0xe1,
-		0x8b, 0x5b, 0x6a, 0x47, 0xeb, 0x4e, 0x41, 0xf9, 0xe7, 0x38, 0x65, 0x61, 0xf1, 0x1d, 0x67, 0xde,
-		0x5a, 0xd7, 0xba, 0x84, 0xf8, 0x73, 0x90, 0x11, 0xc4, 0xfb, 0x46, 0x0f, 0x37, 0xcd, 0x1d, 0x43,
-		0x7f, 0x09, 0xb7, 0xa6, 0x20, 0xfd, 0xf3, 0x03, 0x43, 0xb5, 0xe9, 0x41, 0x27, 0x94, 0x2b, 0x20,
-		0x3b, 0xbe, 0x8a, 0xaa, 0x77, 0xe8, 0x6e, 0xe7, 0xc1, 0x14, 0x3f, 0x29, 0x46, 0xca, 0xc1, 0xab,
-		0x50, 0xb4, 0xd5, 0x32, 0xb0, 0x0b, 0x35, 0xd3, 0xaa, 0xe4, 0xa7, 0x38, 0xa1, 0x59, 0x17, 0x8b,
-		0x1b, 0x8e, 0xa6, 0xd9, 0xe9, 0x6a, 0xbd, 0x69, 0xec, 0xdf, 0x2f, 0x08, 0xc3, 0xc1, 0x51, 0xb8,
-		0xe1, 0x20, 0x1e, 0x1d, 0x59, 0xed, 0xa7, 0xa0, 0xf0, 0x8b, 0xc2, 0x70, 0x08, 0x1c, 0x4e, 0x42,
-		0x38, 0x0c, 0x53, 0x90, 0xf8, 0x25, 0x41, 0x42, 0xe0, 0x10, 0x12, 0x6f, 0x73, 0x17, 0xda, 0x1e,
-		0xde, 0xd1, 0x2d, 0x9b, 0x5f, 0x79, 0x3b, 0x98, 0xd4, 0x2f, 0x7f, 0xd9, 0xef, 0x84, 0x29, 0x1e,
-		0x54, 0x62, 0x89, 0x78, 0xda, 0x9d, 0x6e, 0x2b, 0x4d, 0x66, 0xec, 0x57, 0x84, 0x25, 0xf2, 0xa0,
-		0x11, 0xde, 0x3c, 0x1e, 0x22, 0x11, 0x7b, 0x93, 0xc4, 0xad, 0x53, 0x90, 0xfb, 0x47, 0x03, 0xcc,
-		0xd5, 0x05, 0x2e, 0xa1, 0xe9, 0xf1, 0x7f, 0xfa, 0xc6, 0x6d, 0xbc, 0x3f, 0x95, 0x76, 0xfe, 0xe3,
-		0x01, 0xff, 0x67, 0x93, 0x61, 0x32, 0x1b, 0x92, 0x1e, 0xf0, 0xa7, 0xd0, 0xa4, 0x2b, 0xb5, 0x99,
-		0x77, 0xbd, 0xc6, 0xfb, 0xeb, 0x77, 0xa7, 0x56, 0xd7, 0x88, 0x92, 0xfb, 0x9d, 0x9e, 0xc9, 0xc4,
-		0xde, 0xfd, 0x9a, 0xa3, 0xe7, 0x3e, 0x9f, 0x67, 0xf5, 0x2a, 0xcc, 0xfa, 0x1c, 0x9e, 0xc9, 0xa4,
-		0xde, 0xc3, 0x49, 0x25, 0xbd, 0xfe, 0xce, 0xea, 0x45, 0x08, 0x11, 0xe7, 0x65, 0x32, 0xfa, 0x77,
-		0x70, 0x74, 0x0a, 0xbe, 0xfa, 0x16, 0x88, 0x09, 0xa7, 0x65, 0x32, 0xea, 0x77, 0x72, 0x54, 0x07,
-		0x85, 0xa0, 0x0b, 0x87, 0x65, 0x32, 0xfa, 0xff, 0x23, 0xd0, 0x05, 0x0a, 0x41, 0x9f, 0x5e, 0x84,
-		0xbf, 0xfe, 0xbe, 0x10, 0x5f, 0x74, 0x84, 0xec, 0xae, 0x40, 0x94, 0x7b, 0x2a, 0x93, 0xb1, 0xbf,
-		0x8b, 0x37, 0x2e, 0x30, 0x56, 0x9f, 0x84, 0xf0, 0x94, 0x02, 0x7f, 0x3f, 0x47, 0x65, 0xf0, 0xab,
-		0x45, 0x48, 0x78, 0xbc, 0x93, 0xc9, 0xe8, 0xdf, 0xc3, 0xd1, 0xbd, 0x58, 0x84, 0x75, 0xee, 0x9d,
-		0x4c, 0x26, 0xf0, 0xbd, 0x82, 0x75, 0x8e, 0x41, 0xc4, 0x26, 0x1c, 0x93, 0xc9, 0xd8, 0x1f, 0x10,
-		0x52, 0x17, 0x28, 0xab, 0x4f, 0x43, 0xdc, 0x59, 0x6c, 0x26, 0xe3, 0x7f, 0x1f, 0xc7, 0x77, 0x71,
-		0x88, 0x04, 0x3c, 0x8b, 0xdd, 0x64, 0x12, 0xff, 0xaf, 0x90, 0x80, 0x07, 0x8b, 0x4c, 0xa3, 0x41,
-		0x07, 0x66, 0x32, 0xa5, 0xef, 0x17, 0xd3, 0x68, 0xc0, 0x7f, 0x21, 0xa3, 0x49, 0x6d, 0xfe, 0x64,
-		0x12, 0x3f, 0x20, 0x46, 0x93, 0xc2, 0x13, 0x36, 0x06, 0x3d, 0x82, 0xc9, 0x34, 0x7e, 0x48, 0xb0,
-		0x31, 0xe0, 0x10, 0xac, 0x6e, 0x00, 0x1a, 0xf6, 0x06, 0x26, 0xd3, 0x7b, 0x85, 0xd3, 0x9b, 0x1b,
-		0x72, 0x06, 0x56, 0x9f, 0x85, 0xa3, 0xa3, 0x3d, 0x81, 0xc9, 0x54, 0x7f, 0xf8, 0xb5, 0x81, 0xd8,
-		0xcd, 0xeb, 0x08, 0xac, 0x36, 0xdc, 0x25, 0xc5, 0xeb, 0x05, 0x4c, 0x26, 0xfb, 0x23, 0xaf, 0xf9,
-		0x0d, 0xb7, 0xd7, 0x09, 0x58, 0xcd, 0x03, 0xb8, 0x0b, 0xf0, 0x64, 0x5a, 0x1f, 0xe2, 0xb4, 0x3c,
-		0x48, 0x64, 0x6a, 0xf0, 0xf5, 0x77, 0x32, 0xfe, 0x5d, 0x31, 0x35, 0x38, 0x06, 0x99, 0x1a, 0x62,
-		0xe9, 0x9d, 0x8c, 0xfd, 0x61, 0x31, 0x35, 0x04, 0x0a, 0xd1, 0x6c, 0xcf, 0xea, 0x36, 0x99, 0xc2,
-		0x47, 0x85, 0x66, 0x7b, 0xb0, 0x56, 0xab, 0x30, 0x37, 0xb4, 0x20, 0x4e, 0x26, 0xf5, 0x31, 0x4e,
-		0x4a, 0x1e, 0x5c, 0x0f, 0xbd, 0x8b, 0x17, 0x5f, 0x0c, 0x27, 0x53, 0xfb, 0xb1, 0x81, 0xc5, 0x8b,
-		0xaf, 0x85, 0xab, 0x57, 0x20, 0x66, 0xf4, 0xdb, 0x6d, 0x32, 0x79, 0xd0, 0xc1, 0x57, 0x9e, 0x33,
-		0x5f, 0xf8, 0x0a, 0x97, 0x8e, 0x40, 0x58, 0xbd, 0x08, 0x61, 0xdc, 0xd9, 0xc2, 0xad, 0x49, 0x98,
-		0x5f, 0xfc, 0x8a, 0x30, 0x98, 0x04, 0x7a, 0xf5, 0x69, 0x00, 0x96, 0x1a, 0xa1, 0xa7, 0xfc, 0x27,
-		0xe0, 0x7e, 0xe9, 0x2b, 0xfc, 0x4a, 0xa0, 0x8b, 0xe2, 0x12, 0x60, 0x17, 0x0c, 0x0f, 0x26, 0xf0,
-		0x65, 0x3f, 0x01, 0x3a, 0x22, 0x97, 0x21, 0xfa, 0x82, 0x65, 0x1a, 0xb6, 0xb6, 0x33, 0x09, 0xfb,
-		0xcf, 0x38, 0xb6, 0x80, 0x27, 0x02, 0xeb, 0x98, 0x3d, 0x6c, 0x6b, 0x3b, 0xd6, 0x24, 0xdc, 0xff,
-		0xcc, 0x71, 0x1d, 0x04, 0x82, 0xdc, 0xd4, 0x2c, 0x7b, 0x9a, 0x7e, 0xff, 0xb9, 0x40, 0x16, 0x08,
-		0x84, 0x69, 0xf2, 0xf7, 0x6d, 0xbc, 0x3f, 0x09, 0xf7, 0x2f, 0x04, 0xd3, 0x1c, 0x7e, 0xf5, 0x2d,
-		0x10, 0x27, 0x7f, 0xb2, 0x8b, 0xc3, 0x13, 0x90, 0xff, 0x92, 0x23, 0xbb, 0x18, 0xa4, 0x65, 0xcb,
-		0x6e, 0xd9, 0xfa, 0x64, 0x61, 0xff, 0x15, 0x1f, 0x69, 0x01, 0xbf, 0x9a, 0x87, 0x84, 0x65, 0xb7,
-		0x5a, 0x7d, 0xee, 0x9f, 0x4e, 0x40, 0xff, 0xeb, 0xaf, 0x38, 0x29, 0x0b, 0x07, 0x87, 0x8c, 0xf6,
-		0x8b, 0xb7, 0xed, 0xae, 0x49, 0xcf, 0x84, 0x4d, 0xa2, 0xf0, 0x1a, 0xa7, 0xe0, 0x41, 0x59, 0x2d,
-		0x42, 0x92, 0xf4, 0x45, 0x1c, 0xad, 0x99, 0x44, 0xe2, 0x6f, 0xb8, 0x00, 0x7c, 0x48, 0x85, 0x6f,
-		0xfd, 0xad, 0xcf, 0x9d, 0x92, 0x3e, 0xf3, 0xb9, 0x53, 0xd2, 0x7f, 0xfa, 0xdc, 0x29, 0xe9, 0x03,
-		0x7f, 0x7c, 0x6a, 0xe6, 0x33, 0x7f, 0x7c, 0x6a, 0xe6, 0xf7, 0xff, 0xf8, 0xd4, 0xcc, 0xe8, 0x2c,
-		0x31, 0x5c, 0x33, 0xaf, 0x99, 0x2c, 0x3f, 0xfc, 0xfc, 0x03, 0x3b, 0xba, 0xbd, 0xdb, 0xdf, 0x5a,
-		0x6e, 0x9a, 0x9d, 0xf3, 0x4d, 0xd3, 0xea, 0x98, 0xd6, 0x79, 0x7f, 0x5e, 0x97, 0xfe, 0x05, 0xff,
-		0x43, 0x22, 0x31, 0xb3, 0x3f, 0x9d, 0xab, 0x19, 0xfb, 0xe3, 0x1e, 0xde, 0xba, 0x04, 0xc1, 0xbc,
-		0xb1, 0x8f, 0x8e, 0x33, 0x03, 0xa7, 0xf6, 0x7b, 0x6d, 0x7e, 0xd9, 0x34, 0x4a, 0xbe, 0x37, 0x7b,
-		0x6d, 0xff, 0x25, 0x83, 0x24, 0xbf, 0x64, 0x50, 0xf8, 0x1e, 0xe9, 0x70, 0x3d, 0x89, 0xe5, 0x8d,
-		0x7d, 0xda, 0x91, 0x0d, 0xe9, 0xf9, 0x47, 0x27, 0xe6, 0xb9, 0x6f, 0x1b, 0xe6, 0x8b, 0x06, 0x61,
-		0xbb, 0xbb, 0x25, 0x72, 0xdc, 0xa7, 0x06, 0x73, 0xdc, 0xcf, 0xe2, 0x76, 0xfb, 0x19, 0x02, 0xd7,
-		0x20, 0x28, 0x5b, 0x11, 0xf6, 0x50, 0x02, 0x7c, 0x7f, 0x00, 0x4e, 0x0d, 0xa5, 0xb3, 0xb9, 0x12,
-		0x8c, 0x13, 0xc2, 0x2a, 0xc4, 0x4a, 0x42, 0xb7, 0x32, 0x10, 0xb5, 0x70, 0xd3, 0x34, 0x5a, 0xec,
-		0xb4, 0x78, 0x50, 0x11, 0x9f, 0x44, 0x10, 0x86, 0x66, 0x98, 0x16, 0xbf, 0xbc, 0xcd, 0x3e, 0x0a,
-		0x1f, 0x3c, 0xa4, 0x20, 0x66, 0x45, 0x4b, 0x42, 0x1a, 0x17, 0xa6, 0x94, 0x86, 0xe8, 0x84, 0x2f,
-		0xf3, 0x3f, 0xad, 0x54, 0x7e, 0x28, 0x00, 0x8b, 0x83, 0x52, 0x21, 0x33, 0xcb, 0xb2, 0xb5, 0x4e,
-		0x77, 0x9c, 0x58, 0xae, 0x40, 0xbc, 0x21, 0x60, 0x0e, 0x2d, 0x97, 0xbb, 0x87, 0x94, 0x4b, 0xca,
-		0x69, 0x4a, 0x08, 0x66, 0x65, 0x4a, 0xc1, 0x38, 0xfd, 0xb8, 0x27, 0xc9, 0x7c, 0x30, 0x0e, 0xc7,
-		0xd9, 0x74, 0x52, 0xd9, 0x54, 0x62, 0x1f, 0x5c, 0x26, 0x49, 0x6f, 0xd5, 0xe4, 0x7d, 0x92, 0xdc,
-		0x33, 0x30, 0x5f, 0x21, 0xd6, 0x82, 0x44, 0x41, 0xee, 0x0e, 0xcf, 0xc8, 0x1b, 0xed, 0x4b, 0x3e,
-		0x87, 0x9f, 0xef, 0x3b, 0x7a, 0x8b, 0x72, 0xef, 0x92, 0x40, 0xae, 0x37, 0xb5, 0xb6, 0xd6, 0xfb,
-		0x5a, 0x49, 0xa1, 0x27, 0x01, 0xd8, 0x59, 0x44, 0xe7, 0x7d, 0xac, 0xd4, 0x4a, 0x66, 0xd9, 0xdb,
-		0xb9, 0x65, 0xd6, 0x12, 0xbd, 0xe9, 0x15, 0xa7, 0xb0, 0xe4, 0xcf, 0xb3, 0xcf, 0x01, 0xb8, 0x15,
-		0xe8, 0x04, 0x1c, 0xab, 0x17, 0xf3, 0x6b, 0x79, 0x45, 0x1c, 0x23, 0xaa, 0x6f, 0x94, 0x8b, 0xec,
-		0xad, 0x99, 0x19, 0x74, 0x14, 0x90, 0xb7, 0xd2, 0xb9, 0x1d, 0x73, 0x04, 0xe6, 0xbc, 0xe5, 0xec,
-		0xe1, 0x8f, 0xc0, 0xea, 0x75, 0x48, 0xb3, 0x27, 0x05, 0x54, 0xad, 0xd5, 0xc2, 0x2d, 0x55, 0x37,
-		0xd0, 0x84, 0x3b, 0xf9, 0x99, 0xdf, 0xfe, 0x77, 0x61, 0xda, 0xb5, 0x59, 0x86, 0x98, 0x27, 0x78,
-		0x15, 0x83, 0xf8, 0x9c, 0x7a, 0xa7, 0xdb, 0xc6, 0x74, 0x4b, 0x5b, 0xd5, 0x85, 0xfc, 0x27, 0xbb,
-		0x33, 0x84, 0x5e, 0xf0, 0x4c, 0x5c, 0x99, 0x77, 0xd1, 0x9d, 0xd1, 0x5b, 0x7d, 0xc6, 0x3d, 0xad,
-		0xe8, 0x30, 0x38, 0x91, 0xe2, 0xa7, 0x39, 0x87, 0x22, 0x9b, 0x21, 0x58, 0x5c, 0x83, 0x39, 0xad,
-		0xd9, 0xc4, 0x5d, 0x1f, 0x7f, 0x13, 0x56, 0x10, 0xd1, 0x5b, 0x99, 0x63, 0xba, 0xac, 0x3d, 0x09,
-		0x11, 0x8b, 0x0e, 0xca, 0x24, 0x12, 0x82, 0x1d, 0x0e, 0xbe, 0x5a, 0x16, 0xa7, 0x73, 0x9d, 0x1e,
-		0x4d, 0x20, 0xf0, 0xaf, 0x38, 0x81, 0x24, 0x45, 0x13, 0xbd, 0x31, 0x60, 0x8e, 0xbd, 0xec, 0x84,
-		0x3d, 0xbd, 0x39, 0x38, 0x8b, 0xf2, 0x4f, 0x3e, 0xf9, 0x18, 0xdd, 0xcf, 0x3f, 0xed, 0x57, 0xba,
-		0x11, 0x93, 0x45, 0x91, 0x39, 0x6d, 0xb7, 0xbf, 0x18, 0x52, 0xa2, 0x3d, 0xde, 0xef, 0x83, 0x1b,
-		0xfb, 0x55, 0xde, 0xd8, 0xa9, 0x51, 0x1a, 0xee, 0x69, 0x69, 0x96, 0x53, 0x65, 0x15, 0xab, 0x05,
-		0x98, 0xa5, 0xef, 0x40, 0x3a, 0xc2, 0x39, 0xb8, 0x95, 0x5f, 0xfb, 0xe4, 0x63, 0x6c, 0xa2, 0x11,
-		0x24, 0x2e, 0x9a, 0x42, 0x79, 0x9c, 0xd5, 0x7b, 0xfe, 0x91, 0xe1, 0xf5, 0x9b, 0xfd, 0x73, 0x8e,
-		0x52, 0xbf, 0xe2, 0x65, 0xd5, 0xb5, 0x4e, 0x21, 0x98, 0xd3, 0x3a, 0xba, 0x61, 0x9e, 0xa7, 0xff,
-		0xe7, 0x56, 0x29, 0x4c, 0x3f, 0xa6, 0xd8, 0xb6, 0xbd, 0xc4, 0x8c, 0xc5, 0x64, 0xbd, 0xfd, 0xcb,
-		0xef, 0xfe, 0x78, 0xd8, 0x35, 0x28, 0xab, 0xeb, 0xc3, 0x27, 0x75, 0x27, 0xd3, 0xf8, 0x2b, 0x41,
-		0x63, 0xf0, 0xe0, 0xed, 0xea, 0x9b, 0x21, 0xe6, 0x90, 0x99, 0xe4, 0xbb, 0x09, 0x22, 0x0e, 0x06,
-		0xf1, 0xdc, 0x98, 0xd2, 0x4e, 0xe3, 0xa7, 0xbf, 0x26, 0xf0, 0x99, 0x0d, 0xab, 0x92, 0xde, 0x5c,
-		0x83, 0x54, 0xcb, 0x34, 0x6c, 0xd5, 0xec, 0xe8, 0x36, 0xee, 0x74, 0xed, 0x89, 0x9e, 0xef, 0xdf,
-		0x30, 0x22, 0x31, 0x65, 0x96, 0xe0, 0xd5, 0x04, 0x1a, 0xe1, 0x84, 0x3d, 0x0e, 0x35, 0x0d, 0x27,
-		0xff, 0xc5, 0xe1, 0x84, 0xe2, 0x10, 0x4e, 0xee, 0x49, 0x3b, 0xac, 0xd6, 0x6d, 0xbe, 0xdc, 0xd9,
-		0x7b, 0x4c, 0x0b, 0x1c, 0xed, 0xf8, 0x67, 0x21, 0x38, 0xc5, 0x81, 0xb7, 0x34, 0x0b, 0x9f, 0xbf,
-		0x73, 0x61, 0x0b, 0xdb, 0xda, 0x85, 0xf3, 0x4d, 0x53, 0x17, 0xbe, 0xce, 0x3c, 0x5f, 0xce, 0x48,
-		0xfd, 0x32, 0xaf, 0xcf, 0x8e, 0x3c, 0x10, 0x90, 0x1d, 0xbf, 0x0c, 0x66, 0x87, 0x75, 0x30, 0xd7,
-		0x86, 0x50, 0xd1, 0xd4, 0xe9, 0x1d, 0xd4, 0x16, 0x36, 0xcc, 0x0e, 0x5f, 0x90, 0xd8, 0x07, 0xba,
-		0x0e, 0x11, 0xad, 0x63, 0xf6, 0x0d, 0x9b, 0x2d, 0x46, 0x85, 0xc7, 0x7e, 0xeb, 0xb3, 0x8b, 0x33,
-		0x7f, 0xf0, 0xd9, 0xc5, 0x23, 0x8c, 0xac, 0xd5, 0xba, 0xbd, 0xac, 0x9b, 0xe7, 0x3b, 0x9a, 0xbd,
-		0x4b, 0x4c, 0xc0, 0xef, 0x7d, 0xea, 0x1c, 0xf0, 0xf6, 0x2a, 0x86, 0xfd, 0x89, 0xcf, 0xff, 0xec,
-		0x59, 0x49, 0xe1, 0xf8, 0xab, 0xa1, 0x57, 0x3f, 0xb2, 0x28, 0xe5, 0xba, 0x10, 0x2d, 0xe1, 0xe6,
-		0x01, 0x0d, 0x56, 0x06, 0x1a, 0xbc, 0xc0, 0x1b, 0x3c, 0x31, 0xdc, 0x20, 0xbb, 0xdb, 0x56, 0xc2,
-		0x4d, 0x4f, 0xb3, 0x25, 0xdc, 0x1c, 0x68, 0xb1, 0x02, 0xb1, 0x8a, 0x61, 0xb3, 0x07, 0x69, 0xde,
-		0x02, 0x41, 0xdd, 0x60, 0xef, 0x18, 0xc4, 0x0b, 0x8f, 0x1c, 0xa2, 0x2b, 0x0a, 0xc1, 0xcb, 0xd5,
-		0x20, 0x56, 0xc2, 0x4d, 0x46, 0xaa, 0x08, 0xc1, 0x16, 0x6e, 0x72, 0x52, 0xf7, 0xc0, 0x24, 0xc1,
-		0x2e, 0x94, 0x7e, 0xff, 0x8f, 0x4e, 0xcd, 0xbc, 0xf3, 0x73, 0xa7, 0x66, 0xc6, 0xaa, 0x53, 0x6e,
-		0xb2, 0x3a, 0x39, 0x5a, 0xf4, 0xf1, 0x10, 0x9c, 0xa4, 0x4f, 0xa4, 0xf5, 0x3a, 0xba, 0x61, 0x9f,
-		0x6f, 0xf6, 0xf6, 0xbb, 0xb6, 0x49, 0x8c, 0x8a, 0xb9, 0xcd, 0x95, 0x68, 0xce, 0xad, 0x5e, 0x66,
-		0xd5, 0xa3, 0x55, 0x28, 0xb7, 0x0d, 0xe1, 0x0d, 0x82, 0x47, 0x06, 0xc9, 0x36, 0x6d, 0xad, 0xcd,
-		0x7d, 0x45, 0xf6, 0x41, 0x4a, 0xd9, 0xb3, 0x6a, 0x01, 0x56, 0xaa, 0x8b, 0x17, 0xd5, 0xda, 0x58,
-		0xdb, 0x66, 0x8f, 0xc9, 0x04, 0x69, 0x90, 0x11, 0x23, 0x05, 0xf4, 0xdd, 0x98, 0x05, 0x08, 0x6b,
-		0x7d, 0x76, 0xfa, 0x2d, 0x48, 0xa2, 0x0f, 0xfa, 0x91, 0x7b, 0x06, 0xa2, 0xfc, 0xd4, 0x03, 0x92,
-		0x21, 0x78, 0x1b, 0xef, 0xd3, 0x76, 0x92, 0x0a, 0xf9, 0x13, 0x2d, 0x43, 0x98, 0x32, 0xcf, 0xef,
-		0xef, 0x65, 0x96, 0x87, 0xb8, 0x5f, 0xa6, 0x4c, 0x2a, 0x0c, 0x2c, 0x77, 0x03, 0x62, 0x25, 0x93,
-		0x68, 0xb6, 0x9f, 0x5a, 0x9c, 0x51, 0xa3, 0x3c, 0x77, 0xfb, 0xb6, 0xb8, 0x63, 0x4d, 0x3f, 0xd0,
-		0x51, 0x88, 0xb0, 0xc7, 0x85, 0xf8, 0x09, 0x3e, 0xfe, 0x95, 0x2b, 0x42, 0x94, 0xd2, 0xae, 0x75,
-		0x9d, 0x27, 0x00, 0x25, 0xcf, 0x13, 0x80, 0x9c, 0x7c, 0xc0, 0x65, 0x16, 0x41, 0xa8, 0xa5, 0xd9,
-		0x1a, 0xef, 0x37, 0xfd, 0x3b, 0xf7, 0x56, 0x88, 0x71, 0x22, 0x16, 0x5a, 0x81, 0xa0, 0xd9, 0x15,
-		0x07, 0x54, 0xb3, 0xe3, 0xba, 0x52, 0xeb, 0x16, 0x42, 0x44, 0x97, 0x14, 0x02, 0x5c, 0xa8, 0x8e,
-		0x55, 0x8b, 0x27, 0x7c, 0x6a, 0xd1, 0xc1, 0xf6, 0xd6, 0xb6, 0xed, 0xfe, 0xc1, 0x86, 0x73, 0x48,
-		0x15, 0x1c, 0x45, 0xb9, 0x1b, 0x80, 0x53, 0x9e, 0xda, 0x3b, 0xb8, 0x67, 0xe9, 0xa6, 0xc1, 0xb4,
-		0x89, 0x6b, 0x0a, 0xf2, 0x30, 0xc8, 0xeb, 0xc7, 0xa8, 0xca, 0x5b, 0x20, 0x98, 0xef, 0x76, 0x51,
-		0x16, 0x62, 0xf4, 0xbb, 0x69, 0x32, 0x5d, 0x09, 0x29, 0xce, 0x37, 0xa9, 0xb3, 0xcc, 0x6d, 0xfb,
-		0x45, 0xad, 0xe7, 0x3c, 0xe6, 0x27, 0xbe, 0x73, 0x97, 0x21, 0x5e, 0x34, 0x0d, 0x0b, 0x1b, 0x56,
-		0x9f, 0x46, 0x20, 0x5b, 0x6d, 0xb3, 0x79, 0x9b, 0x53, 0x60, 0x1f, 0x44, 0xd8, 0x5a, 0xb7, 0x4b,
-		0x31, 0x43, 0x0a, 0xf9, 0x93, 0xcd, 0xec, 0x42, 0x6d, 0xac, 0x78, 0x2e, 0x1e, 0x4e, 0x3c, 0xbc,
-		0x83, 0x8e, 0x7c, 0xfe, 0x9b, 0x04, 0xf7, 0x0d, 0x4f, 0xa4, 0xdb, 0x78, 0xdf, 0x3a, 0xec, 0x3c,
-		0x7a, 0x0e, 0xe2, 0x1b, 0xf4, 0x09, 0xe7, 0x67, 0xf0, 0x3e, 0xca, 0x42, 0x14, 0xb7, 0x56, 0x2e,
-		0x5e, 0xbc, 0x70, 0x99, 0x69, 0xf9, 0xf5, 0x19, 0x45, 0x14, 0xa0, 0x53, 0x10, 0xb7, 0x70, 0xb3,
-		0xbb, 0x72, 0xf1, 0xd2, 0xed, 0x0b, 0x4c, 0xad, 0xae, 0xcf, 0x28, 0x6e, 0xd1, 0x6a, 0x8c, 0xf4,
-		0xf8, 0xd5, 0x8f, 0x2e, 0x4a, 0x85, 0x30, 0x04, 0xad, 0x7e, 0xe7, 0xeb, 0xa6, 0x1b, 0x7f, 0x11,
-		0x81, 0x25, 0x4f, 0x2d, 0x5b, 0xb0, 0xf8, 0x45, 0x23, 0xe7, 0xd9, 0x6f, 0xd9, 0xd3, 0x7f, 0x0a,
-		0x31, 0x66, 0x25, 0x3a, 0x50, 0x8a, 0xb9, 0x9f, 0x97, 0x20, 0x79, 0x53, 0x50, 0xae, 0x63, 0x1b,
-		0x5d, 0x01, 0x70, 0x5a, 0x12, 0x53, 0xe5, 0xc4, 0xf2, 0x60, 0x5b, 0xcb, 0x0e, 0x8e, 0xe2, 0x01,
-		0x47, 0x4f, 0x52, 0x05, 0xec, 0x9a, 0x16, 0x7f, 0x95, 0x6d, 0x02, 0xaa, 0x03, 0x8c, 0x1e, 0x05,
-		0x44, 0xad, 0x9a, 0x7a, 0xc7, 0xb4, 0x75, 0x63, 0x47, 0xed, 0x9a, 0x2f, 0xf2, 0xe7, 0x32, 0x83,
-		0x8a, 0x4c, 0x6b, 0x6e, 0xd2, 0x8a, 0x0d, 0x52, 0x4e, 0x98, 0x8e, 0x3b, 0x54, 0x48, 0x30, 0xad,
-		0xb5, 0x5a, 0x3d, 0x6c, 0x59, 0xdc, 0x70, 0x89, 0x4f, 0x74, 0x05, 0xa2, 0xdd, 0xfe, 0x96, 0x2a,
-		0xac, 0x44, 0x62, 0xe5, 0xbe, 0x51, 0x73, 0x5e, 0xe8, 0x06, 0x9f, 0xf5, 0x91, 0x6e, 0x7f, 0x8b,
-		0x68, 0xca, 0x69, 0x48, 0x8e, 0x60, 0x26, 0x71, 0xc7, 0xe5, 0x83, 0xbe, 0x1a, 0xce, 0x7b, 0xa0,
-		0x76, 0x7b, 0xba, 0xd9, 0xd3, 0xed, 0x7d, 0x7a, 0x88, 0x35, 0xa8, 0xc8, 0xa2, 0x62, 0x83, 0x97,
-		0xe7, 0x6e, 0x43, 0xba, 0x4e, 0x43, 0x23, 0x97, 0xf3, 0x8b, 0x2e, 0x7f, 0xd2, 0x64, 0xfe, 0xc6,
-		0x72, 0x16, 0x18, 0xe2, 0xec, 0xec, 0xbf, 0x97, 0x20, 0x51, 0x20, 0x13, 0xb7, 0x52, 0xba, 0xda,
-		0xd6, 0x76, 0xd0, 0x05, 0x38, 0x52, 0x58, 0xab, 0x15, 0x9f, 0x51, 0x2b, 0x25, 0xf5, 0xea, 0x5a,
-		0xde, 0x73, 0x95, 0x23, 0x7b, 0xf4, 0xe5, 0xbb, 0x4b, 0xc8, 0x03, 0xbb, 0x69, 0xd0, 0x4c, 0x00,
-		0x3a, 0x0f, 0x0b, 0x7e, 0x94, 0x7c, 0xa1, 0x5e, 0xae, 0x36, 0x64, 0x29, 0x7b, 0xe4, 0xe5, 0xbb,
-		0x4b, 0x73, 0x1e, 0x8c, 0xfc, 0x96, 0x85, 0x0d, 0x7b, 0x18, 0xa1, 0x58, 0x5b, 0x5f, 0xaf, 0x34,
-		0xe4, 0xc0, 0x10, 0x42, 0xd1, 0xec, 0x74, 0x74, 0x1b, 0x3d, 0x0c, 0x73, 0x7e, 0x84, 0x6a, 0x65,
-		0x4d, 0x0e, 0x66, 0xd1, 0xcb, 0x77, 0x97, 0x52, 0x1e, 0xe8, 0xaa, 0xde, 0xce, 0xc6, 0xde, 0xfb,
-		0x63, 0xa7, 0x66, 0x3e, 0xf1, 0xff, 0x9d, 0x92, 0x0a, 0xeb, 0x63, 0xe7, 0xdc, 0xe3, 0x87, 0x9b,
-		0x73, 0xfe, 0x75, 0xfb, 0x2b, 0xf7, 0xf9, 0xcc, 0x0d, 0xf7, 0x11, 0x3d, 0xc6, 0x78, 0xda, 0xe9,
-		0x36, 0x29, 0x33, 0x94, 0x3d, 0xd8, 0x3d, 0xc8, 0x4e, 0x58, 0x14, 0xb2, 0x13, 0x0d, 0x43, 0xee,
-		0x32, 0xcc, 0x6e, 0x68, 0x3d, 0xbb, 0x8e, 0xed, 0xeb, 0x58, 0x6b, 0xe1, 0x9e, 0xdf, 0x7f, 0x98,
-		0x15, 0xfe, 0x03, 0x82, 0x10, 0x75, 0x12, 0xd8, 0xfa, 0x49, 0xff, 0xce, 0xed, 0x42, 0x88, 0xde,
-		0x55, 0x75, 0x7c, 0x0b, 0x8e, 0xc1, 0x7c, 0x0b, 0xb2, 0x32, 0xec, 0xdb, 0xfc, 0x2e, 0x7f, 0x52,
-		0x61, 0x1f, 0xe8, 0x09, 0xe1, 0x21, 0x04, 0x0f, 0xf6, 0x10, 0xf8, 0xf4, 0xe2, 0x7e, 0x42, 0x1b,
-		0xa2, 0x7c, 0x88, 0x1d, 0x46, 0x24, 0x97, 0x11, 0xb4, 0x0e, 0xe9, 0xae, 0xd6, 0xb3, 0xe9, 0xeb,
-		0x57, 0xbb, 0xb4, 0x17, 0x7c, 0x06, 0x2f, 0x0e, 0xdb, 0x13, 0x5f, 0x67, 0x79, 0x2b, 0xb3, 0x5d,
-		0x6f, 0x61, 0xee, 0x4f, 0x43, 0x10, 0xe1, 0xc2, 0x78, 0x0b, 0x44, 0xb9, 0x58, 0xf9, 0x9c, 0x3b,
-		0xb9, 0x3c, 0xbc, 0xcc, 0x2e, 0x3b, 0xcb, 0x21, 0xa7, 0x27, 0x70, 0xd0, 0x83, 0x10, 0x6b, 0xee,
-		0x6a, 0xba, 0xa1, 0xea, 0x2d, 0xee, 0x1c, 0x27, 0x3e, 0xf7, 0xd9, 0xc5, 0x68, 0x91, 0x94, 0x55,
-		0x4a, 0x4a, 0x94, 0x56, 0x56, 0x5a, 0xc4, 0xa7, 0xd9, 0xc5, 0xfa, 0xce, 0xae, 0xcd, 0xed, 0x06,
-		0xff, 0x42, 0x4f, 0x41, 0x88, 0x28, 0x04, 0xbf, 0xe7, 0x91, 0x1d, 0x8a, 0x7a, 0x9c, 0xc4, 0x5d,
-		0x21, 0x46, 0x1a, 0xfe, 0xc0, 0x7f, 0x5c, 0x94, 0x14, 0x8a, 0x81, 0x8a, 0x30, 0xdb, 0xd6, 0x2c,
-		0x5b, 0xa5, 0xeb, 0x31, 0x69, 0x9e, 0x5d, 0xf0, 0x38, 0x3e, 0x2c, 0x10, 0x2e, 0x58, 0xce, 0x7a,
-		0x82, 0x60, 0xb1, 0xa2, 0x16, 0x3a, 0x03, 0x32, 0x25, 0xd2, 0xa4, 0x33, 0x90, 0x79, 0x89, 0x11,
-		0x2a, 0xf7, 0x14, 0x29, 0x67, 0x13, 0x93, 0xfa, 0x8a, 0x27, 0x20, 0x4e, 0x9f, 0x78, 0xa3, 0x20,
-		0xec, 0x82, 0x74, 0x8c, 0x14, 0xd0, 0xca, 0x87, 0x20, 0xed, 0x5a, 0x7d, 0x06, 0x12, 0x63, 0x54,
-		0xdc, 0x62, 0x0a, 0xf8, 0x18, 0x2c, 0x18, 0x78, 0xcf, 0x56, 0x07, 0xa1, 0xe3, 0x14, 0x1a, 0x91,
-		0xba, 0x9b, 0x7e, 0x8c, 0x07, 0x20, 0xd5, 0x14, 0xc2, 0x67, 0xb0, 0x40, 0x61, 0x67, 0x9d, 0x52,
-		0x0a, 0x76, 0x1c, 0x62, 0x5a, 0xb7, 0xcb, 0x00, 0x12, 0xdc, 0xea, 0x77, 0xbb, 0xb4, 0xea, 0x2c,
-		0xcc, 0xd1, 0x3e, 0xf6, 0xb0, 0xd5, 0x6f, 0xdb, 0x9c, 0x48, 0x92, 0xc2, 0xa4, 0x49, 0x85, 0xc2,
-		0xca, 0x29, 0xec, 0xfd, 0x30, 0x8b, 0xef, 0xe8, 0x2d, 0x6c, 0x34, 0x31, 0x83, 0x9b, 0xa5, 0x70,
-		0x49, 0x51, 0x48, 0x81, 0x1e, 0x06, 0xc7, 0x9a, 0xab, 0x62, 0xa5, 0x49, 0x31, 0x7a, 0xa2, 0x3c,
-		0xcf, 0x8a, 0x73, 0x19, 0x08, 0x95, 0x34, 0x5b, 0x23, 0xee, 0x92, 0xbd, 0xc7, 0x96, 0xcf, 0xa4,
-		0x42, 0xfe, 0xcc, 0xfd, 0x52, 0x10, 0x42, 0x37, 0x4d, 0x1b, 0xa3, 0xc7, 0x3d, 0xae, 0x6c, 0x6a,
-		0x94, 0x3e, 0xd7, 0xf5, 0x1d, 0x03, 0xb7, 0xd6, 0xad, 0x1d, 0xcf, 0x3b, 0xcd, 0xae, 0x3a, 0x05,
-		0x7c, 0xea, 0xb4, 0x00, 0xe1, 0x9e, 0xd9, 0x37, 0x5a, 0xe2, 0x3a, 0x05, 0xfd, 0x40, 0x65, 0x88,
-		0x39, 0x5a, 0x12, 0x9a, 0xa4, 0x25, 0x69, 0xa2, 0x25, 0x44, 0x87, 0x79, 0x81, 0x12, 0xdd, 0xe2,
-		0xca, 0x52, 0x80, 0xb8, 0x63, 0xbc, 0xb8, 0xb6, 0x4d, 0xa7, 0xb0, 0x2e, 0x1a, 0x59, 0x22, 0x9d,
-		0xb1, 0x77, 0x84, 0xc7, 0x34, 0x4e, 0x76, 0x2a, 0xb8, 0xf4, 0x7c, 0x6a, 0xc5, 0xdf, 0x8c, 0x8e,
-		0xd2, 0x7e, 0xb9, 0x6a, 0xc5, 0xde, 0x8d, 0xbe, 0x0f, 0xe2, 0x96, 0xbe, 0x63, 0xd0, 0x1b, 0x4a,
-		0x5c, 0xf3, 0xdc, 0x02, 0x52, 0xeb, 0x5e, 0xb3, 0x67, 0x9a, 0xe6, 0xf9, 0x79, 0x83, 0xf3, 0x30,
-		0xef, 0xfe, 0xb0, 0x80, 0x4b, 0x85, 0x69, 0x19, 0x72, 0xaa, 0xea, 0xa2, 0x26, 0xf7, 0xeb, 0x12,
-		0x44, 0xf8, 0x8a, 0xe5, 0x0e, 0x83, 0x34, 0x7a, 0x18, 0x02, 0xe3, 0x86, 0x21, 0x78, 0xef, 0xc3,
-		0x90, 0x07, 0x70, 0xd8, 0xb4, 0xf8, 0xcb, 0xc0, 0x23, 0xdc, 0x2a, 0xc6, 0x62, 0x5d, 0xdf, 0xe1,
-		0xf3, 0xde, 0x83, 0x94, 0xfb, 0x43, 0x89, 0x78, 0xf8, 0xbc, 0x1e, 0xe5, 0x61, 0x56, 0xf0, 0xa5,
-		0x6e, 0xb7, 0xb5, 0x1d, 0xae, 0x8a, 0x27, 0xc7, 0x32, 0x47, 0x56, 0x61, 0x25, 0xc1, 0xf9, 0xa1,
-		0xfe, 0xc4, 0xc8, 0x61, 0x0d, 0x8c, 0x19, 0x56, 0x9f, 0x1e, 0x05, 0xef, 0x4d, 0x8f, 0x7c, 0x23,
-		0x1e, 0x1a, 0x18, 0xf1, 0xdc, 0x1f, 0x49, 0xfc, 0x37, 0x09, 0x5a, 0xec, 0x3a, 0xd6, 0xff, 0xaa,
-		0xa1, 0x7a, 0x9e, 0xeb, 0x56, 0x0b, 0xb7, 0xd4, 0xa1, 0x31, 0xbb, 0x7f, 0x98, 0xa2, 0x9f, 0x67,
-		0x77, 0xec, 0x90, 0xa0, 0x52, 0x77, 0xc7, 0xf0, 0x53, 0x01, 0x98, 0x1b, 0x82, 0xff, 0xbb, 0x37,
-		0x96, 0xfe, 0xd9, 0x1b, 0x9e, 0x72, 0xf6, 0x46, 0xc6, 0xce, 0xde, 0x4f, 0x06, 0x68, 0x02, 0xa0,
-		0x6b, 0x5a, 0x5a, 0xfb, 0x1b, 0x61, 0x7b, 0x4f, 0x40, 0xbc, 0x6b, 0xb6, 0x55, 0x56, 0xc3, 0x2e,
-		0xb4, 0xc5, 0xba, 0x66, 0x5b, 0x19, 0x52, 0xb3, 0xf0, 0xeb, 0x64, 0x98, 0x23, 0xaf, 0xc3, 0x20,
-		0x44, 0x07, 0x27, 0x54, 0x0f, 0x92, 0x4c, 0x14, 0xdc, 0x6b, 0x7a, 0x8c, 0xc8, 0x80, 0xba, 0x61,
-		0xd2, 0xb0, 0x97, 0xc7, 0xd8, 0x66, 0x90, 0x0a, 0x87, 0x23, 0x18, 0xcc, 0xc9, 0x18, 0x95, 0x39,
-		0xf2, 0x5a, 0x2c, 0x85, 0xc3, 0xe5, 0x7e, 0x50, 0x02, 0x58, 0x23, 0x92, 0xa5, 0xfd, 0x25, 0xfe,
-		0x8e, 0x45, 0x59, 0x50, 0x7d, 0x2d, 0x9f, 0x1a, 0x37, 0x68, 0xbc, 0xfd, 0xa4, 0xe5, 0xe5, 0xbb,
-		0x08, 0xb3, 0xae, 0x6e, 0x5b, 0x58, 0x30, 0x73, 0xea, 0x80, 0xa8, 0xb4, 0x8e, 0x6d, 0x25, 0x79,
-		0xc7, 0xf3, 0x95, 0xfb, 0xa7, 0x12, 0xc4, 0x29, 0x4f, 0xeb, 0xd8, 0xd6, 0x7c, 0x63, 0x28, 0xdd,
-		0xfb, 0x18, 0x9e, 0x04, 0x60, 0x64, 0x2c, 0xfd, 0x25, 0xcc, 0x35, 0x2b, 0x4e, 0x4b, 0xea, 0xfa,
-		0x4b, 0x18, 0x5d, 0x72, 0x04, 0x1e, 0x3c, 0x58, 0xe0, 0x22, 0x6a, 0xe5, 0x62, 0x3f, 0x06, 0x51,
-		0xfa, 0x3c, 0xcb, 0x9e, 0xc5, 0x03, 0xd1, 0x88, 0xd1, 0xef, 0x34, 0xf6, 0xac, 0xdc, 0x0b, 0x10,
-		0x6d, 0xec, 0xb1, 0x7c, 0xe2, 0x09, 0x88, 0xf7, 0x4c, 0x93, 0x7b, 0x7f, 0xcc, 0xeb, 0x8e, 0x91,
-		0x02, 0xea, 0xec, 0x88, 0x1c, 0x5a, 0xc0, 0xcd, 0xa1, 0xb9, 0x49, 0xc0, 0xe0, 0x54, 0x49, 0x40,
-		0x12, 0x7d, 0xce, 0xfa, 0x66, 0x12, 0x7a, 0x14, 0x8e, 0xd5, 0x2b, 0xd7, 0xaa, 0xe5, 0x92, 0xba,
-		0x5e, 0xbf, 0x36, 0xf0, 0x6c, 0x42, 0x36, 0xfd, 0xf2, 0xdd, 0xa5, 0x04, 0x0f, 0x3b, 0xc7, 0x41,
-		0x6f, 0x28, 0xe5, 0x9b, 0xb5, 0x46, 0x59, 0x96, 0x18, 0xf4, 0x46, 0x0f, 0xdf, 0x31, 0x6d, 0xf6,
-		0x9b, 0x57, 0x8f, 0xc1, 0xf1, 0x11, 0xd0, 0x4e, 0xf0, 0x39, 0xf7, 0xf2, 0xdd, 0xa5, 0xd9, 0x8d,
-		0x1e, 0x66, 0x5a, 0x46, 0x31, 0x96, 0x21, 0x33, 0x8c, 0x51, 0xdb, 0xa8, 0xd5, 0xf3, 0x6b, 0xf2,
-		0x52, 0x56, 0x7e, 0xf9, 0xee, 0x52, 0x52, 0x98, 0x0c, 0x02, 0xff, 0x0d, 0x88, 0x3e, 0x63, 0xbe,
-		0xac, 0x31, 0x8b, 0xeb, 0xba, 0x5a, 0x4f, 0xeb, 0x1c, 0x36, 0xfc, 0x9c, 0x70, 0x5c, 0x23, 0xf7,
-		0xd7, 0x01, 0x48, 0x3b, 0xc1, 0xcd, 0x06, 0x6d, 0x01, 0x3d, 0xee, 0xcd, 0xf8, 0x25, 0xc6, 0xae,
-		0x1d, 0x0c, 0x5a, 0x24, 0x04, 0xdf, 0x0c, 0x31, 0xe1, 0x24, 0xf3, 0x49, 0xb5, 0x34, 0x62, 0x7d,
-		0xe3, 0x10, 0x1c, 0xd5, 0xc1, 0x40, 0x4f, 0x43, 0xdc, 0x99, 0x62, 0xce, 0x33, 0xfd, 0xe3, 0xe7,
-		0x24, 0xc7, 0x77, 0x71, 0xd0, 0x65, 0x37, 0x8c, 0x0b, 0x8d, 0x0b, 0x0c, 0x6f, 0x32, 0x00, 0x8e,
-		0xec, 0x84, 0x70, 0x8f, 0x41, 0x48, 0xdb, 0x6a, 0xea, 0xdc, 0x00, 0xdf, 0x37, 0x8c, 0x97, 0x2f,
-		0x14, 0x2b, 0x1c, 0x89, 0x42, 0x12, 0x6e, 0xb5, 0xbe, 0xbd, 0xcb, 0xf2, 0x3b, 0x91, 0x71, 0xdc,
-		0xe6, 0x05, 0x88, 0xe0, 0xd6, 0xc1, 0xc9, 0x55, 0x78, 0x36, 0x86, 0x0b, 0x9c, 0xbe, 0x95, 0xbc,
-		0xa7, 0xb2, 0x60, 0x9a, 0xb9, 0x27, 0xb1, 0x8e, 0xb6, 0x57, 0xa0, 0xf1, 0xf4, 0x31, 0x88, 0x92,
-		0xca, 0x1d, 0xfe, 0x60, 0x61, 0x50, 0x89, 0x74, 0xb4, 0xbd, 0x6b, 0x9a, 0x75, 0x23, 0x14, 0x0b,
-		0xca, 0xa1, 0xdc, 0x4f, 0x10, 0x57, 0xc7, 0x27, 0x56, 0xf4, 0x08, 0x20, 0x82, 0xa1, 0xed, 0x60,
-		0x95, 0xcc, 0x7d, 0x3a, 0x3e, 0x82, 0x6e, 0xba, 0xa3, 0xed, 0xe5, 0x77, 0x70, 0xb5, 0xdf, 0xa1,
-		0x0c, 0x58, 0x68, 0x1d, 0x64, 0x01, 0x2c, 0x54, 0x83, 0x8f, 0xdf, 0xf1, 0xe1, 0x9f, 0x9f, 0xe2,
-		0x00, 0x6c, 0x05, 0x79, 0x85, 0xac, 0x20, 0x29, 0x46, 0xcf, 0x39, 0xe1, 0xe3, 0xeb, 0x4a, 0xd0,
-		0xdf, 0x95, 0xdc, 0xd3, 0x90, 0x1e, 0x18, 0x42, 0x94, 0x83, 0x59, 0x9e, 0xf2, 0xa2, 0xa7, 0x27,
-		0x58, 0x88, 0x14, 0x57, 0x12, 0x2c, 0xb5, 0x45, 0x4f, 0x93, 0xac, 0xc6, 0x7e, 0xf9, 0x23, 0x8b,
-		0x12, 0xdd, 0x37, 0x7a, 0x04, 0x66, 0x7d, 0x83, 0x28, 0xd2, 0xd0, 0x92, 0x9b, 0x86, 0x76, 0x81,
-		0x9f, 0x87, 0x24, 0xb1, 0x60, 0xb8, 0xc5, 0x61, 0x1f, 0x84, 0x34, 0xb3, 0xb0, 0x83, 0xb2, 0x66,
-		0x1e, 0xd3, 0xba, 0x10, 0x78, 0x4e, 0xb8, 0x50, 0x7e, 0xb1, 0x27, 0x04, 0xd4, 0x35, 0xcd, 0xca,
-		0xd5, 0x00, 0x5c, 0xad, 0x40, 0x79, 0x38, 0x49, 0xac, 0x8f, 0xf7, 0xb4, 0x2f, 0x7f, 0x0c, 0xde,
-		0xe7, 0x72, 0x66, 0x09, 0x90, 0x7b, 0xa2, 0x97, 0x3d, 0x09, 0x7f, 0x9d, 0x42, 0xe4, 0xce, 0x43,
-		0x7a, 0x40, 0x5f, 0xc8, 0x8a, 0xec, 0x6a, 0x19, 0xdb, 0xe8, 0x70, 0x0b, 0x0a, 0x6f, 0xfb, 0xc4,
-		0xe7, 0x4e, 0x49, 0x5f, 0x1f, 0xeb, 0xf3, 0x1f, 0x6e, 0xc1, 0x09, 0x4f, 0x25, 0x51, 0x79, 0x5f,
-		0xea, 0x2b, 0xed, 0x51, 0x75, 0x52, 0x39, 0x29, 0x85, 0x75, 0x60, 0xc6, 0x39, 0x7b, 0xb0, 0xa1,
-		0x9b, 0x9c, 0xdf, 0x9a, 0x9c, 0x61, 0x1b, 0xbd, 0x0d, 0xf0, 0x6b, 0x71, 0x88, 0x2a, 0xf8, 0x1d,
-		0x7d, 0x6c, 0xd9, 0x68, 0x05, 0x42, 0xb8, 0xb9, 0x6b, 0x8e, 0xca, 0xba, 0x92, 0x0e, 0x2e, 0x73,
-		0xb8, 0x72, 0x73, 0xd7, 0xbc, 0x3e, 0xa3, 0x50, 0x58, 0x74, 0x11, 0xc2, 0xdb, 0xed, 0x3e, 0x4f,
-		0x98, 0x0d, 0x58, 0x49, 0x2f, 0xd2, 0x55, 0x02, 0x74, 0x7d, 0x46, 0x61, 0xd0, 0xa4, 0x29, 0xfa,
-		0x13, 0x84, 0xc1, 0x83, 0x9b, 0xaa, 0x18, 0xdb, 0xb4, 0x29, 0x02, 0x8b, 0x0a, 0x00, 0xba, 0xa1,
-		0xdb, 0x2a, 0x4d, 0x26, 0x71, 0x3b, 0x75, 0x7a, 0x3c, 0xa6, 0x6e, 0xd3, 0xf4, 0xd3, 0xf5, 0x19,
-		0x25, 0xae, 0x8b, 0x0f, 0xc2, 0xee, 0x3b, 0xfa, 0xb8, 0x27, 0xec, 0xd5, 0x58, 0x76, 0xdf, 0x46,
-		0x80, 0x08, 0xbb, 0x14, 0x9a, 0x98, 0x75, 0xf6, 0x73, 0x12, 0xf6, 0x1e, 0xff, 0xd5, 0xa5, 0xc5,
-		0x71, 0x98, 0xf4, 0x37, 0x25, 0x1a, 0x7b, 0xd7, 0x67, 0x94, 0x68, 0x93, 0xfd, 0x89, 0x9e, 0x72,
-		0x9c, 0xbe, 0xc4, 0xb0, 0x9f, 0xe5, 0xc3, 0x65, 0x89, 0xa6, 0x19, 0xe1, 0xfc, 0xa1, 0x2a, 0xa4,
-		0xda, 0xba, 0x65, 0xab, 0x96, 0xa1, 0x75, 0xad, 0x5d, 0xd3, 0x16, 0xef, 0xb9, 0x3f, 0x30, 0x8e,
-		0xc2, 0x9a, 0x6e, 0xd9, 0x75, 0x01, 0x7c, 0x7d, 0x46, 0x99, 0x6d, 0x7b, 0x0b, 0x08, 0x3d, 0x73,
-		0x7b, 0x1b, 0xf7, 0x1c, 0x82, 0x34, 0xb3, 0x73, 0x00, 0xbd, 0x1a, 0x81, 0x16, 0xf8, 0x84, 0x9e,
-		0xe9, 0x2d, 0x40, 0xdf, 0x04, 0xf3, 0x6d, 0x53, 0x6b, 0x39, 0xe4, 0xd4, 0xe6, 0x6e, 0xdf, 0xb8,
-		0x4d, 0xd3, 0x40, 0x89, 0x95, 0x87, 0xc7, 0x32, 0x69, 0x6a, 0x2d, 0x41, 0xa2, 0x48, 0x10, 0xae,
-		0xcf, 0x28, 0x73, 0xed, 0xc1, 0x42, 0xf4, 0x76, 0x58, 0xd0, 0xba, 0xdd, 0xf6, 0xfe, 0x20, 0xf5,
-		0x34, 0xa5, 0x7e, 0x76, 0x1c, 0xf5, 0x3c, 0xc1, 0x19, 0x24, 0x8f, 0xb4, 0xa1, 0x52, 0xd4, 0x00,
-		0xb9, 0xdb, 0xc3, 0xf4, 0x86, 0x5d, 0x97, 0xfb, 0x35, 0xf4, 0xa1, 0xf4, 0xc4, 0xca, 0x43, 0xe3,
-		0x68, 0x6f, 0x30, 0x78, 0xe1, 0x06, 0x5d, 0x9f, 0x51, 0xd2, 0x5d, 0x7f, 0x11, 0xa3, 0x6a, 0x36,
-		0x31, 0xfd, 0x65, 0x09, 0x4e, 0x75, 0x6e, 0x12, 0x55, 0x0a, 0xef, 0xa7, 0xea, 0x2b, 0x42, 0x65,
-		0x48, 0xb0, 0xe0, 0x57, 0x25, 0xd6, 0x93, 0xfe, 0x62, 0x47, 0x62, 0x25, 0x37, 0x76, 0x86, 0x52,
-		0xd0, 0x9b, 0xa6, 0x8d, 0xaf, 0xcf, 0x28, 0x80, 0x9d, 0x2f, 0xa4, 0xc1, 0x11, 0xfa, 0xda, 0xfc,
-		0xbe, 0xea, 0xb7, 0xd4, 0x99, 0x79, 0x4a, 0xf0, 0x91, 0x71, 0x04, 0xe9, 0xcf, 0xc2, 0xed, 0xdf,
-		0xf4, 0x1a, 0xee, 0xeb, 0x33, 0xca, 0xfc, 0x9d, 0xe1, 0x62, 0xa2, 0x62, 0xdb, 0xba, 0xa1, 0xb5,
-		0xf5, 0x97, 0x30, 0x5b, 0x73, 0xe9, 0x8f, 0xb7, 0x1c, 0xa0, 0x62, 0x57, 0x39, 0x34, 0x5d, 0x89,
-		0x89, 0x8a, 0x6d, 0x7b, 0x0b, 0x0a, 0x51, 0x7e, 0x36, 0xd8, 0x79, 0x81, 0x39, 0x2a, 0xc7, 0xd8,
-		0x6f, 0x05, 0xdc, 0x08, 0xc5, 0x40, 0x4e, 0xe4, 0x1e, 0x82, 0x84, 0xc7, 0x30, 0xa1, 0x0c, 0x44,
-		0xf9, 0xd9, 0x20, 0x71, 0xca, 0x98, 0x7f, 0xe6, 0x52, 0x90, 0xf4, 0x1a, 0xa3, 0xdc, 0x07, 0x24,
-		0x07, 0x93, 0xbe, 0x0d, 0x93, 0xf1, 0xe7, 0xc0, 0xe3, 0xae, 0x6f, 0x74, 0xbf, 0x58, 0x0b, 0x45,
-		0x3d, 0xdb, 0xf0, 0x4d, 0xd2, 0x42, 0xbe, 0x14, 0xa3, 0x45, 0x48, 0x74, 0x57, 0xba, 0x0e, 0x48,
-		0x90, 0x82, 0x40, 0x77, 0xa5, 0x2b, 0x00, 0x4e, 0x43, 0x92, 0xf4, 0x5b, 0xf5, 0x7a, 0x68, 0x71,
-		0x25, 0x41, 0xca, 0x38, 0x48, 0xee, 0x77, 0x02, 0x20, 0x0f, 0x1a, 0x30, 0x27, 0x39, 0x2e, 0x1d,
-		0x3a, 0x39, 0x7e, 0x7c, 0x30, 0x2d, 0xef, 0x66, 0xe2, 0xd7, 0x40, 0x76, 0x13, 0xca, 0x6c, 0xa9,
-		0x19, 0xef, 0x71, 0x0e, 0xb8, 0xc6, 0x4a, 0xba, 0x39, 0xe0, 0x2b, 0x5f, 0xf5, 0x6d, 0x8f, 0x8a,
-		0xdf, 0xe8, 0x1d, 0x1c, 0x70, 0xc7, 0xeb, 0xd9, 0xec, 0xb6, 0x34, 0x1b, 0x8b, 0x8c, 0x9c, 0x67,
-		0xa7, 0xf4, 0x41, 0x48, 0x6b, 0xdd, 0xae, 0x6a, 0xd9, 0x9a, 0x8d, 0xb9, 0x73, 0xc2, 0x72, 0x1d,
-		0xb3, 0x5a, 0xb7, 0x4b, 0x7f, 0x8f, 0x90, 0x39, 0x27, 0x0f, 0x40, 0x8a, 0xd8, 0x74, 0x5d, 0x6b,
-		0x0b, 0xdf, 0x22, 0xc2, 0x7c, 0x18, 0x5e, 0xca, 0xdd, 0x89, 0x96, 0x33, 0xe2, 0xd4, 0x9e, 0x3b,
-		0x51, 0x9c, 0xe4, 0x89, 0xe2, 0x10, 0x7f, 0xb3, 0x87, 0xc9, 0x47, 0x3c, 0xaf, 0x34, 0x7a, 0x9b,
-		0x62, 0x81, 0x46, 0x7c, 0x77, 0x58, 0x7a, 0x26, 0xa6, 0xb0, 0x8f, 0x9c, 0x02, 0x29, 0xbf, 0xed,
-		0x47, 0x29, 0x08, 0xd8, 0x7b, 0xbc, 0x95, 0x80, 0xbd, 0x47, 0x7c, 0x6b, 0xcf, 0xcb, 0xa0, 0xc3,
-		0xab, 0x1d, 0xc7, 0x73, 0xb3, 0x2b, 0xb9, 0x34, 0xcc, 0xfa, 0xd6, 0x84, 0xdc, 0x51, 0x58, 0x18,
-		0x65, 0xe2, 0x73, 0xbb, 0x4e, 0xb9, 0xcf, 0x54, 0xa3, 0x8b, 0x10, 0x73, 0x6c, 0xfc, 0x88, 0x78,
-		0x9c, 0x36, 0x2b, 0x80, 0x15, 0x07, 0xd4, 0xb7, 0x81, 0x10, 0xf0, 0x6d, 0x20, 0xe4, 0xbe, 0x15,
-		0x32, 0xe3, 0xec, 0xf7, 0x40, 0x5a, 0x31, 0xe4, 0x08, 0xec, 0x28, 0x44, 0xf8, 0x43, 0x98, 0x01,
-		0xba, 0x65, 0xc6, 0xbf, 0x88, 0x20, 0x99, 0x2d, 0x0f, 0xb2, 0x9d, 0x34, 0xfa, 0x91, 0x53, 0xe1,
-		0xf8, 0x58, 0x1b, 0x3e, 0x7e, 0xf3, 0x8d, 0x11, 0xe2, 0x9b, 0x6f, 0x4d, 0xc1, 0x8e, 0x45, 0xfb,
-		0x2a, 0x8e, 0xce, 0xb0, 0xaf, 0xdc, 0x2b, 0x41, 0x38, 0x3a, 0xda, 0x92, 0xa3, 0x25, 0x48, 0x12,
-		0x47, 0xd7, 0xf6, 0xfb, 0xc4, 0xd0, 0xd1, 0xf6, 0x1a, 0xdc, 0x21, 0xe6, 0x9b, 0x17, 0x01, 0x67,
-		0xf3, 0x02, 0x6d, 0xc2, 0x5c, 0xdb, 0x6c, 0x6a, 0x6d, 0xd5, 0xb3, 0x79, 0xc4, 0x27, 0xd1, 0xfd,
-		0x43, 0xc2, 0xf6, 0x27, 0x29, 0x89, 0xc1, 0xe1, 0xfa, 0x9f, 0xa6, 0x34, 0xd6, 0x9c, 0x7d, 0x26,
-		0x54, 0x82, 0x44, 0x47, 0xb7, 0xb6, 0xf0, 0xae, 0x76, 0x47, 0x37, 0x7b, 0x7c, 0x36, 0x0d, 0x2b,
-		0xcd, 0xba, 0x0b, 0x23, 0xf6, 0xb4, 0x3c, 0x68, 0x9e, 0x21, 0x09, 0x8f, 0xdc, 0x6a, 0x8b, 0x1c,
-		0xda, 0x9a, 0x8c, 0xdb, 0xb5, 0x8a, 0x8e, 0xdd, 0xb5, 0x1a, 0xb5, 0x45, 0x14, 0x1b, 0xbd, 0x45,
-		0xf4, 0x5e, 0xef, 0xd0, 0xf8, 0xd7, 0xbe, 0xa1, 0x5d, 0x23, 0x54, 0x87, 0x05, 0x8e, 0xdf, 0xf2,
-		0xc9, 0x7e, 0xc4, 0xe1, 0x0a, 0x36, 0xbf, 0x06, 0x65, 0x8e, 0x04, 0xfa, 0x78, 0xb1, 0x07, 0xef,
-		0x4d, 0xec, 0x62, 0xdb, 0x36, 0xe4, 0xd9, 0xb6, 0xfd, 0xdf, 0x6c, 0x28, 0xde, 0x1d, 0x84, 0xb9,
-		0x21, 0x47, 0x62, 0xe4, 0x7e, 0xf4, 0xb8, 0x1c, 0xb0, 0xe8, 0x58, 0xf0, 0xd0, 0x1d, 0xe3, 0x63,
-		0x1d, 0x9a, 0x3c, 0xd6, 0xe1, 0xd7, 0x71, 0xac, 0x23, 0xf7, 0x36, 0xd6, 0x5f, 0xd7, 0x51, 0xf8,
-		0x90, 0x04, 0xd9, 0xf1, 0xde, 0xd7, 0xc8, 0xe1, 0x38, 0xd4, 0x96, 0xc5, 0xb8, 0x35, 0xee, 0x01,
-		0x48, 0x0d, 0xf8, 0x86, 0x4c, 0x95, 0x67, 0x7d, 0x61, 0x7b, 0xee, 0x3d, 0x41, 0x67, 0xe1, 0xf1,
-		0x39, 0x70, 0x23, 0x66, 0xeb, 0xdb, 0x60, 0xbe, 0x85, 0x9b, 0x7a, 0xeb, 0x5e, 0x27, 0xeb, 0x1c,
-		0xc7, 0xfe, 0x3f, 0x73, 0x75, 0x58, 0x4b, 0x7e, 0x00, 0x20, 0xa6, 0x60, 0xab, 0x4b, 0xfc, 0x31,
-		0x54, 0x80, 0x38, 0xde, 0x6b, 0xe2, 0xae, 0xed, 0x1e, 0xe3, 0x18, 0x15, 0x22, 0x30, 0xe8, 0xb2,
-		0x80, 0x24, 0x01, 0xb2, 0x83, 0x86, 0x1e, 0xe7, 0x39, 0x80, 0xf1, 0xe1, 0x3c, 0x47, 0xf7, 0x26,
-		0x01, 0x2e, 0x89, 0x24, 0x40, 0x70, 0x6c, 0x7c, 0xcb, 0xb0, 0x06, 0xb2, 0x00, 0x8f, 0xf3, 0x2c,
-		0x40, 0x68, 0x42, 0x63, 0xbe, 0x34, 0x40, 0xd1, 0x97, 0x06, 0x88, 0x4c, 0xe8, 0xe6, 0x98, 0x3c,
-		0xc0, 0x25, 0x91, 0x07, 0x88, 0x4e, 0xe0, 0x78, 0x20, 0x11, 0xf0, 0x16, 0x4f, 0x22, 0x20, 0x3e,
-		0x9c, 0xdf, 0xf5, 0xa1, 0x8e, 0xc8, 0x04, 0x5c, 0x76, 0x32, 0x01, 0xc9, 0xb1, 0x59, 0x04, 0x8e,
-		0x3c, 0x98, 0x0a, 0xa8, 0x0d, 0xa5, 0x02, 0x66, 0xf9, 0x8f, 0xc5, 0x8f, 0x23, 0x31, 0x21, 0x17,
-		0x50, 0x1b, 0xca, 0x05, 0xa4, 0x26, 0x10, 0x9c, 0x90, 0x0c, 0xf8, 0xe6, 0xd1, 0xc9, 0x80, 0xf1,
-		0xe1, 0x3a, 0x67, 0x73, 0xba, 0x6c, 0x80, 0x3a, 0x26, 0x1b, 0x20, 0x8f, 0x8d, 0x5c, 0x19, 0xf9,
-		0xa9, 0xd3, 0x01, 0x9b, 0x23, 0xd2, 0x01, 0x2c, 0x70, 0x3f, 0x33, 0x96, 0xf8, 0x14, 0xf9, 0x80,
-		0xcd, 0x11, 0xf9, 0x00, 0x34, 0x91, 0xec, 0xc4, 0x84, 0xc0, 0x55, 0x7f, 0x42, 0x60, 0x7e, 0x8c,
-		0xd7, 0xe9, 0xce, 0xf6, 0x31, 0x19, 0x81, 0xad, 0x71, 0x19, 0x81, 0x05, 0xfe, 0x7b, 0x31, 0xe3,
-		0x28, 0x1e, 0x22, 0x25, 0x50, 0x1b, 0x4a, 0x09, 0x1c, 0x99, 0xa0, 0x69, 0xd3, 0xe7, 0x04, 0xd8,
-		0xaf, 0x08, 0xb2, 0xdf, 0x0f, 0x04, 0x39, 0x71, 0x23, 0x14, 0x4b, 0xc8, 0xc9, 0xdc, 0xc3, 0xc4,
-		0x83, 0x19, 0xb0, 0x73, 0x24, 0x56, 0xc0, 0xbd, 0x9e, 0xd9, 0x13, 0xb7, 0x3a, 0xe8, 0x47, 0xee,
-		0x0c, 0x89, 0x11, 0x5d, 0x9b, 0x76, 0x40, 0xfe, 0x80, 0xc6, 0x64, 0x1e, 0x3b, 0x96, 0xfb, 0x65,
-		0xc9, 0xc5, 0xa5, 0x19, 0x04, 0x6f, 0x7c, 0x19, 0xe7, 0xf1, 0xa5, 0x27, 0xab, 0x10, 0xf0, 0x67,
-		0x15, 0x16, 0x21, 0x41, 0x62, 0xad, 0x81, 0x84, 0x81, 0xd6, 0x75, 0x12, 0x06, 0xe2, 0xc8, 0x16,
-		0xcb, 0x3d, 0xf0, 0x65, 0x89, 0xed, 0x5f, 0xa6, 0x9d, 0xe3, 0x6b, 0x2c, 0xd4, 0x45, 0xe7, 0x60,
-		0xde, 0x03, 0xeb, 0xc4, 0x70, 0x2c, 0x7a, 0x96, 0x1d, 0xe8, 0x3c, 0x0f, 0xe6, 0xfe, 0xb9, 0xe4,
-		0x4a, 0xc8, 0xcd, 0x34, 0x8c, 0x4a, 0x0a, 0x48, 0xaf, 0x53, 0x52, 0x20, 0x70, 0xcf, 0x49, 0x01,
-		0x6f, 0x4c, 0x1a, 0xf4, 0xc7, 0xa4, 0xff, 0x55, 0x72, 0xc7, 0xc4, 0x09, 0xf1, 0x9b, 0x66, 0x0b,
-		0xf3, 0x28, 0x91, 0xfe, 0x4d, 0x5c, 0x92, 0xb6, 0x29, 0x7e, 0xf8, 0x8d, 0xfc, 0x49, 0xa0, 0x9c,
-		0x85, 0x27, 0xce, 0xd7, 0x15, 0x27, 0xc0, 0x0c, 0x7b, 0x6f, 0x8e, 0xf0, 0xeb, 0x14, 0x11, 0xf7,
-		0x3a, 0x85, 0x73, 0x59, 0x3d, 0xea, 0xb9, 0xac, 0x8e, 0x9e, 0x82, 0x38, 0x4d, 0xf6, 0xab, 0x66,
-		0x57, 0xfc, 0x30, 0xff, 0x89, 0xf1, 0x57, 0x29, 0x2c, 0x7a, 0xc8, 0x9b, 0x5d, 0xbf, 0x70, 0x3d,
-		0x8e, 0xb8, 0xcf, 0xe3, 0xb8, 0x0f, 0xe2, 0x84, 0x7b, 0xf6, 0x43, 0xb2, 0xc0, 0x5f, 0x3a, 0x10,
-		0x05, 0xb9, 0x9f, 0x0c, 0x40, 0x7a, 0x60, 0xa1, 0x19, 0xd9, 0xf7, 0x51, 0x1b, 0xd7, 0xd3, 0xc9,
-		0xe3, 0x14, 0xc0, 0x8e, 0x66, 0xa9, 0x2f, 0x6a, 0x86, 0x8d, 0x5b, 0x5c, 0x28, 0x9e, 0x12, 0x94,
-		0x85, 0x18, 0xf9, 0xea, 0x5b, 0xb8, 0xc5, 0xb3, 0x2f, 0xce, 0x37, 0xba, 0x0e, 0x11, 0x7c, 0x87,
-		0x3e, 0x0e, 0xcd, 0x7e, 0xf7, 0xe7, 0xe8, 0x70, 0x38, 0x4c, 0xaa, 0x0b, 0x19, 0x32, 0xd8, 0x5f,
-		0xfc, 0xec, 0xa2, 0xcc, 0xa0, 0x1f, 0x75, 0xae, 0xcf, 0x29, 0x1c, 0xdf, 0x2f, 0x85, 0xd8, 0x80,
-		0x14, 0x68, 0x1e, 0x30, 0x29, 0xc2, 0x7b, 0x22, 0x53, 0x76, 0xaa, 0x5c, 0x99, 0xed, 0xe0, 0x4e,
-		0xd7, 0x34, 0xdb, 0x2a, 0x9b, 0xe3, 0x79, 0x48, 0xf9, 0xd7, 0x55, 0xf6, 0xe3, 0xef, 0xb6, 0xa6,
-		0x1b, 0xaa, 0xcf, 0x09, 0x4e, 0xb2, 0x42, 0x36, 0xa7, 0xbc, 0x2f, 0x7f, 0xe7, 0x36, 0xe0, 0xc8,
-		0xc8, 0x75, 0x15, 0x3d, 0x09, 0x71, 0x77, 0x49, 0x66, 0x17, 0x03, 0x0e, 0xc8, 0xb4, 0xb8, 0xb0,
-		0xb9, 0x5f, 0x95, 0x5c, 0x92, 0xfe, 0xdc, 0x4d, 0x19, 0x22, 0xec, 0x94, 0x26, 0x3f, 0x91, 0x73,
-		0x6e, 0xba, 0x15, 0x79, 0x99, 0x1d, 0xe1, 0x54, 0x38, 0x72, 0xee, 0xed, 0x10, 0x61, 0x25, 0x28,
-		0x01, 0x51, 0xdf, 0x2f, 0x1e, 0xe4, 0x8b, 0xc5, 0xf2, 0x86, 0x78, 0xdc, 0xb9, 0x40, 0x1f, 0x11,
-		0x26, 0xc5, 0x4a, 0xf9, 0x46, 0xb9, 0xd8, 0x90, 0x83, 0x68, 0x0e, 0x66, 0xd9, 0xdf, 0xfc, 0x77,
-		0x09, 0xe4, 0x90, 0xa7, 0xa8, 0x5e, 0xae, 0x96, 0xca, 0x8a, 0x1c, 0xce, 0x5d, 0x80, 0xe3, 0x63,
-		0xd7, 0x70, 0x37, 0x31, 0x23, 0x79, 0x12, 0x33, 0xb9, 0x57, 0x02, 0x24, 0xa8, 0x19, 0xb7, 0x30,
-		0xa3, 0x1b, 0x03, 0x1d, 0x5f, 0x39, 0xc4, 0xaa, 0x3e, 0xd0, 0x7b, 0x12, 0xc7, 0xf4, 0xf0, 0x36,
-		0xb6, 0x9b, 0xbb, 0xcc, 0x51, 0x60, 0x16, 0x68, 0x56, 0x99, 0xe5, 0xa5, 0x14, 0xc9, 0x62, 0x60,
-		0x2f, 0xe0, 0xa6, 0xad, 0x32, 0x25, 0xb2, 0x68, 0x30, 0x11, 0x27, 0x60, 0xa4, 0xb4, 0xce, 0x0a,
-		0x73, 0xdf, 0x7a, 0x28, 0x59, 0xc6, 0x21, 0xac, 0x94, 0x1b, 0xca, 0x2d, 0x39, 0x88, 0x10, 0xa4,
-		0xe8, 0x9f, 0x6a, 0xbd, 0x9a, 0xdf, 0xa8, 0x5f, 0xaf, 0x11, 0x59, 0xce, 0x43, 0x5a, 0xc8, 0x52,
-		0x14, 0x86, 0x73, 0x8f, 0xc0, 0xb1, 0x31, 0x5e, 0xc5, 0x88, 0x63, 0xb3, 0x1f, 0x95, 0xbc, 0xd0,
-		0x7e, 0xcf, 0xa0, 0x06, 0x11, 0xcb, 0xd6, 0xec, 0xbe, 0xc5, 0x85, 0xf8, 0xe4, 0xb4, 0x6e, 0xc6,
-		0xb2, 0xf8, 0xa3, 0x4e, 0xd1, 0x15, 0x4e, 0x26, 0x77, 0x11, 0x52, 0xfe, 0x9a, 0xf1, 0x32, 0x70,
-		0x95, 0x28, 0x90, 0xbb, 0x02, 0x68, 0xd8, 0xfb, 0x18, 0x11, 0x5e, 0x4a, 0xa3, 0xc2, 0xcb, 0x1f,
-		0x97, 0xe0, 0xc4, 0x01, 0x9e, 0x06, 0x7a, 0xdb, 0x40, 0x27, 0x2f, 0x1f, 0xc6, 0x4f, 0x59, 0x66,
-		0x65, 0x03, 0xdd, 0x7c, 0x1c, 0x92, 0xde, 0xf2, 0xe9, 0x3a, 0xf9, 0xc5, 0x80, 0x3b, 0x89, 0xfd,
-		0x71, 0xb0, 0x6b, 0x02, 0xa5, 0xaf, 0xd1, 0x04, 0xbe, 0x19, 0xc0, 0xde, 0x13, 0xe7, 0xb6, 0xf9,
-		0x3a, 0x7a, 0x72, 0x44, 0x7e, 0x11, 0x37, 0x1b, 0x7b, 0x7c, 0x12, 0xc4, 0x6d, 0xfe, 0x97, 0x85,
-		0xea, 0xde, 0xa4, 0x40, 0x9f, 0xae, 0xb1, 0x16, 0x0f, 0x98, 0xa7, 0x5d, 0x8c, 0xdd, 0xe4, 0x01,
-		0x2b, 0xb6, 0xd0, 0x2d, 0x38, 0x36, 0xe0, 0x28, 0x38, 0xa4, 0x43, 0xd3, 0xfa, 0x0b, 0x47, 0xfc,
-		0xfe, 0x82, 0x20, 0xed, 0x5d, 0xed, 0xc3, 0xfe, 0xd5, 0xfe, 0x16, 0x80, 0x9b, 0x1c, 0x70, 0x0f,
-		0x1a, 0x4a, 0xde, 0x83, 0x86, 0x17, 0x21, 0x4c, 0x34, 0x49, 0xc8, 0x69, 0xd8, 0x14, 0x13, 0x4d,
-		0xf0, 0x24, 0x17, 0x18, 0x74, 0x4e, 0x07, 0x34, 0x9c, 0xa0, 0x1d, 0xd3, 0xc4, 0x5b, 0xfc, 0x4d,
-		0x9c, 0x1e, 0x9b, 0xea, 0x1d, 0xdd, 0xd4, 0x4b, 0x10, 0xa6, 0x23, 0x3f, 0xf2, 0xf6, 0xe6, 0xb7,
-		0x00, 0x68, 0xb6, 0xdd, 0xd3, 0xb7, 0xfa, 0x6e, 0x03, 0x8b, 0xa3, 0x35, 0x27, 0x2f, 0xe0, 0x0a,
-		0xf7, 0x71, 0x15, 0x5a, 0x70, 0x51, 0x3d, 0x6a, 0xe4, 0x21, 0x98, 0xab, 0x42, 0xca, 0x8f, 0x3b,
-		0xfa, 0x36, 0xea, 0xf0, 0x2f, 0xfe, 0xba, 0xde, 0x11, 0x7f, 0x20, 0x8b, 0x7e, 0xe4, 0xde, 0x19,
-		0x80, 0xa4, 0x57, 0xf1, 0xfe, 0xfe, 0xb9, 0x20, 0xb9, 0xef, 0x90, 0x20, 0xe6, 0x74, 0xff, 0x80,
-		0xe3, 0xd5, 0xee, 0xad, 0x64, 0x67, 0xf3, 0x82, 0x6d, 0x13, 0x05, 0x9d, 0x6d, 0xa2, 0x2b, 0xce,
-		0xf2, 0x37, 0x2e, 0x21, 0xe2, 0x95, 0xb5, 0x38, 0xe2, 0xc8, 0x57, 0xfb, 0x2b, 0xd3, 0x5d, 0xfe,
-		0x5b, 0x80, 0xb0, 0xf7, 0xe2, 0x1e, 0xfb, 0xc8, 0xb5, 0x3c, 0x47, 0x92, 0xd8, 0x44, 0xf4, 0xde,
-		0x12, 0x94, 0x0e, 0x7d, 0x4b, 0xd0, 0x69, 0x25, 0xe0, 0x6d, 0xe5, 0xfb, 0x25, 0x88, 0x89, 0x39,
-		0x81, 0xde, 0xea, 0x3d, 0xeb, 0x26, 0xb6, 0x36, 0xc7, 0xda, 0x23, 0x4e, 0xdf, 0x73, 0xd4, 0x6d,
-		0xe8, 0x88, 0x77, 0xf0, 0xb0, 0x47, 0xbc, 0xb9, 0x67, 0xf7, 0xd7, 0x12, 0xc8, 0x83, 0x33, 0xf6,
-		0x6b, 0xe6, 0x6e, 0x78, 0x99, 0x0b, 0x8e, 0x58, 0xe6, 0xc6, 0x9d, 0xdb, 0x0e, 0x8d, 0x3b, 0xb7,
-		0x3d, 0xdc, 0xeb, 0xf0, 0x3d, 0xf6, 0xfa, 0xdd, 0x01, 0x48, 0x78, 0xd2, 0xa3, 0xe8, 0x09, 0xdf,
-		0x19, 0xf0, 0xa5, 0x83, 0x52, 0xa9, 0x9e, 0x43, 0xe0, 0x3e, 0x31, 0x05, 0x0e, 0x2f, 0xa6, 0xd7,
-		0xff, 0x3e, 0xd8, 0xe8, 0x2b, 0xb3, 0xe1, 0x31, 0x57, 0x66, 0xbf, 0x5d, 0x82, 0x98, 0xe3, 0x76,
-		0x1f, 0x76, 0x13, 0xf3, 0x28, 0x44, 0xb8, 0x67, 0xc9, 0x76, 0x31, 0xf9, 0xd7, 0xc8, 0xb4, 0x72,
-		0x16, 0x62, 0x1d, 0x6c, 0x6b, 0xd4, 0x0e, 0xb2, 0x55, 0xcd, 0xf9, 0x3e, 0x7b, 0x19, 0x12, 0x9e,
-		0x0d, 0x60, 0x62, 0x1a, 0xab, 0xe5, 0x67, 0xe5, 0x99, 0x6c, 0xf4, 0xe5, 0xbb, 0x4b, 0xc1, 0x2a,
-		0x7e, 0x91, 0xcc, 0x66, 0xa5, 0x5c, 0xbc, 0x5e, 0x2e, 0x3e, 0x23, 0x4b, 0xd9, 0xc4, 0xcb, 0x77,
-		0x97, 0xa2, 0x0a, 0xa6, 0x19, 0xc5, 0xb3, 0xcf, 0x40, 0x7a, 0x60, 0x60, 0xfc, 0x6e, 0x0b, 0x82,
-		0x54, 0x69, 0x73, 0x63, 0xad, 0x52, 0xcc, 0x37, 0xca, 0x2a, 0x3b, 0x29, 0x8c, 0x8e, 0xc1, 0xfc,
-		0x5a, 0xe5, 0xda, 0xf5, 0x86, 0x5a, 0x5c, 0xab, 0x94, 0xab, 0x0d, 0x35, 0xdf, 0x68, 0xe4, 0x8b,
-		0xcf, 0xc8, 0x81, 0x95, 0xbb, 0x09, 0x08, 0xe5, 0x0b, 0xc5, 0x0a, 0x2a, 0x42, 0x88, 0xa6, 0x42,
-		0x0e, 0x3c, 0x01, 0x96, 0x3d, 0x38, 0x37, 0x8c, 0xae, 0x42, 0x98, 0x66, 0x49, 0xd0, 0xc1, 0x47,
-		0xc2, 0xb2, 0x13, 0x92, 0xc5, 0x84, 0x19, 0x3a, 0x23, 0x0f, 0x3c, 0x23, 0x96, 0x3d, 0x38, 0x77,
-		0x8c, 0xd6, 0x20, 0x2a, 0x82, 0xe4, 0x49, 0x07, 0xb7, 0xb2, 0x13, 0x13, 0xba, 0xa4, 0x6b, 0x2c,
-		0xd9, 0x70, 0xf0, 0xf1, 0xb1, 0xec, 0x84, 0xac, 0x32, 0xaa, 0x38, 0x37, 0xa8, 0x26, 0x9c, 0x08,
-		0xcb, 0x4e, 0xca, 0x13, 0x23, 0x05, 0xe2, 0x6e, 0x1a, 0x67, 0xf2, 0xa1, 0xb8, 0xec, 0x14, 0x09,
-		0x73, 0xf4, 0x76, 0x98, 0xf5, 0x87, 0xba, 0xd3, 0x9d, 0x3a, 0xcb, 0x4e, 0x99, 0x91, 0x26, 0xf4,
-		0xfd, 0x71, 0xef, 0x74, 0xa7, 0xd0, 0xb2, 0x53, 0x26, 0xa8, 0xd1, 0x0b, 0x30, 0x37, 0x1c, 0x97,
-		0x4e, 0x7f, 0x28, 0x2d, 0x7b, 0x88, 0x94, 0x35, 0xea, 0x00, 0x1a, 0x11, 0xcf, 0x1e, 0xe2, 0x8c,
-		0x5a, 0xf6, 0x30, 0x19, 0x6c, 0xd4, 0x82, 0xf4, 0x60, 0x90, 0x38, 0xed, 0x99, 0xb5, 0xec, 0xd4,
-		0xd9, 0x6c, 0xd6, 0x8a, 0x3f, 0xb8, 0x9c, 0xf6, 0x0c, 0x5b, 0x76, 0xea, 0xe4, 0x36, 0xda, 0x04,
-		0xf0, 0xc4, 0x87, 0x53, 0x9c, 0x69, 0xcb, 0x4e, 0x93, 0xe6, 0x46, 0x5d, 0x98, 0x1f, 0x15, 0x38,
-		0x1e, 0xe6, 0x88, 0x5b, 0xf6, 0x50, 0xd9, 0x6f, 0xa2, 0xcf, 0xfe, 0x10, 0x70, 0xba, 0x23, 0x6f,
-		0xd9, 0x29, 0xd3, 0xe0, 0x85, 0xfc, 0xd8, 0x73, 0xce, 0x0f, 0x1d, 0x78, 0xce, 0xd9, 0x3d, 0xb9,
-		0xec, 0x9c, 0x6d, 0xfe, 0xdc, 0x79, 0x78, 0x13, 0x7f, 0xb3, 0xc7, 0xb2, 0xb5, 0xdb, 0xba, 0xb1,
-		0xe3, 0x3c, 0xec, 0xc4, 0xbf, 0xf9, 0x21, 0xe7, 0xa3, 0xfc, 0x5d, 0x20, 0x51, 0x3a, 0xe1, 0x79,
-		0xa7, 0xb1, 0xaf, 0x82, 0x4e, 0xba, 0x81, 0x31, 0xf9, 0xf8, 0xf2, 0x01, 0x4f, 0x47, 0x4d, 0x78,
-		0xa0, 0x6a, 0xc4, 0xd3, 0x52, 0xd9, 0x03, 0x5f, 0x35, 0xc8, 0x1e, 0x74, 0xee, 0x9b, 0x78, 0xb1,
-		0xa9, 0xeb, 0xba, 0x65, 0x9b, 0x3d, 0xbd, 0xa9, 0xb5, 0xe9, 0xf2, 0x72, 0x65, 0xda, 0x7b, 0x60,
-		0x85, 0x38, 0x71, 0x57, 0xf8, 0xeb, 0x53, 0xfc, 0x6e, 0x52, 0x09, 0x22, 0x77, 0xb4, 0x36, 0xbb,
-		0x85, 0xe5, 0x7d, 0xbe, 0x6e, 0x50, 0xe6, 0x1e, 0x3f, 0xca, 0x4b, 0x85, 0xe1, 0xe6, 0x7e, 0x90,
-		0xde, 0x60, 0xe9, 0x74, 0x74, 0x8b, 0xe8, 0xa2, 0x42, 0x43, 0xe9, 0x1b, 0x10, 0xea, 0x69, 0x36,
-		0x0f, 0x30, 0x0b, 0x97, 0x0e, 0xfd, 0x12, 0x14, 0x6b, 0x81, 0xd2, 0x40, 0x6f, 0x83, 0x58, 0x47,
-		0xdb, 0x53, 0x29, 0xbd, 0xc0, 0xd7, 0x44, 0x2f, 0xda, 0xd1, 0xf6, 0x08, 0x7f, 0xe8, 0xed, 0xf4,
-		0x07, 0x28, 0xd5, 0xe6, 0xae, 0x66, 0xec, 0x60, 0x46, 0x39, 0xf8, 0x35, 0x51, 0x9e, 0xed, 0x68,
-		0x7b, 0x45, 0x4a, 0x8d, 0xd0, 0xe7, 0x8f, 0x6c, 0xfd, 0x86, 0xc4, 0xb3, 0x06, 0x54, 0x30, 0x48,
-		0x03, 0xb9, 0xe9, 0x7c, 0xd1, 0x46, 0xc5, 0x16, 0xc7, 0x43, 0xe3, 0xe4, 0x3e, 0x20, 0xd6, 0xc2,
-		0x2c, 0x61, 0xef, 0x33, 0x9f, 0x5d, 0x94, 0x58, 0xab, 0xe9, 0xe6, 0x90, 0xd8, 0x13, 0x2c, 0x19,
-		0xa2, 0x52, 0x1f, 0x36, 0x30, 0xd1, 0x87, 0x9d, 0x15, 0x3e, 0x2c, 0x23, 0x08, 0x0c, 0x9b, 0xd4,
-		0xf3, 0x3e, 0x7c, 0x42, 0x82, 0x44, 0xc9, 0xf3, 0xd4, 0x66, 0x06, 0xa2, 0x1d, 0xd3, 0xd0, 0x6f,
-		0xe3, 0x9e, 0xb3, 0x47, 0xc5, 0x3e, 0x89, 0x9f, 0xc9, 0x7e, 0x55, 0xde, 0xde, 0x17, 0xef, 0x19,
-		0x89, 0x6f, 0x82, 0xf5, 0x22, 0xde, 0xb2, 0x74, 0x21, 0x67, 0x45, 0x7c, 0xa2, 0x87, 0x41, 0xb6,
-		0x70, 0xb3, 0xdf, 0xd3, 0xed, 0x7d, 0xb5, 0x69, 0x1a, 0xb6, 0xd6, 0xb4, 0x79, 0x1c, 0x9e, 0x16,
-		0xe5, 0x45, 0x56, 0x4c, 0x88, 0xb4, 0xb0, 0xad, 0xe9, 0x6d, 0x76, 0x32, 0x33, 0xae, 0x88, 0x4f,
-		0xce, 0xea, 0xdd, 0xa8, 0x37, 0x0e, 0x2d, 0x82, 0x6c, 0x76, 0x71, 0xcf, 0x77, 0x20, 0x85, 0x69,
-		0x63, 0xe6, 0xf7, 0x3e, 0x75, 0x6e, 0x81, 0x0b, 0x9c, 0x1f, 0x66, 0x60, 0xbf, 0x2c, 0xa1, 0xa4,
-		0x05, 0x86, 0x38, 0xa9, 0x72, 0xcb, 0xb7, 0x2b, 0xd5, 0xdf, 0x72, 0x1f, 0xae, 0x59, 0x18, 0x12,
-		0x6a, 0xde, 0xd8, 0x2f, 0x64, 0x3e, 0xed, 0x92, 0x76, 0xe3, 0xd4, 0x67, 0xf0, 0xbe, 0x77, 0x8b,
-		0x8a, 0x92, 0x21, 0x2e, 0xfc, 0x0b, 0x9a, 0xde, 0xc6, 0x2d, 0x9e, 0xd6, 0xe0, 0x5f, 0x68, 0xd5,
-		0x49, 0x2f, 0xb2, 0x5f, 0x55, 0xcd, 0x8d, 0xd3, 0x8d, 0x82, 0x69, 0xb4, 0xfc, 0x79, 0x44, 0x54,
-		0x84, 0x88, 0x6d, 0xde, 0xc6, 0x06, 0x17, 0xd0, 0xe1, 0x1e, 0x73, 0xe3, 0xa8, 0xe8, 0x9b, 0x41,
-		0x6e, 0xe1, 0x36, 0xde, 0x61, 0x37, 0x34, 0x77, 0xb5, 0x1e, 0x66, 0xef, 0x03, 0xdc, 0xd3, 0x83,
-		0x6e, 0x69, 0x87, 0x54, 0x9d, 0x52, 0x42, 0x1b, 0xfe, 0xc7, 0x5c, 0xa3, 0x7c, 0xf3, 0x78, 0x4c,
-		0x1f, 0x3d, 0x9a, 0xe7, 0xb5, 0x3c, 0xbe, 0xc7, 0x5f, 0x1f, 0x06, 0xb9, 0x6f, 0x6c, 0x99, 0x06,
-		0xfd, 0x89, 0x50, 0x1e, 0x45, 0xc5, 0xd8, 0x4e, 0xa5, 0x53, 0xce, 0x77, 0x2a, 0x37, 0x20, 0xe5,
-		0x82, 0xd2, 0x19, 0x12, 0x3f, 0xec, 0x0c, 0x99, 0x75, 0x08, 0x10, 0x10, 0xb4, 0x0e, 0xe0, 0xce,
-		0x41, 0xba, 0x55, 0x96, 0x18, 0x3f, 0x62, 0xee, 0x6c, 0xf6, 0x76, 0xc6, 0x43, 0x00, 0x19, 0x30,
-		0xdf, 0xd1, 0x0d, 0xd5, 0xc2, 0xed, 0x6d, 0x95, 0x4b, 0x8e, 0xd0, 0x4d, 0x50, 0xf1, 0xbf, 0xf5,
-		0x10, 0xa3, 0xf9, 0x07, 0x9f, 0x3a, 0x97, 0x76, 0x9f, 0xc4, 0x5b, 0x7a, 0x6c, 0xf9, 0x89, 0x4b,
-		0xca, 0x5c, 0x47, 0x37, 0xea, 0xb8, 0xbd, 0x5d, 0x72, 0x08, 0xa3, 0x37, 0xc3, 0x09, 0x57, 0x20,
-		0xa6, 0xa1, 0xee, 0x9a, 0xed, 0x96, 0xda, 0xc3, 0xdb, 0x6a, 0x93, 0x3e, 0x36, 0x98, 0xa4, 0x62,
-		0x3c, 0xe6, 0x80, 0xd4, 0x8c, 0xeb, 0x66, 0xbb, 0xa5, 0xe0, 0xed, 0x22, 0xa9, 0x46, 0xf7, 0x83,
-		0x2b, 0x0d, 0x55, 0x6f, 0x59, 0x99, 0xd9, 0xa5, 0xe0, 0x99, 0x90, 0x92, 0x74, 0x0a, 0x2b, 0x2d,
-		0x6b, 0x35, 0xf6, 0xde, 0x8f, 0x2c, 0xce, 0xbc, 0xfa, 0x91, 0xc5, 0x99, 0xdc, 0x55, 0xfa, 0xb0,
-		0x15, 0x9f, 0x5a, 0xd8, 0x42, 0x97, 0x20, 0xae, 0x89, 0x0f, 0x76, 0xeb, 0xec, 0x80, 0xa9, 0xe9,
-		0x82, 0xe6, 0x7e, 0x42, 0x82, 0x48, 0xe9, 0xe6, 0x86, 0xa6, 0xf7, 0x50, 0x19, 0xe6, 0x5c, 0x5d,
-		0x9d, 0x76, 0x96, 0xbb, 0xea, 0x2d, 0xa6, 0x79, 0x75, 0xdc, 0xe9, 0xb5, 0x78, 0xe1, 0xf4, 0xef,
-		0x7d, 0xea, 0xdc, 0x49, 0x4e, 0xe6, 0xe6, 0xc0, 0x41, 0x36, 0x41, 0x6f, 0xf0, 0x80, 0x9b, 0xa7,
-		0xcf, 0x37, 0x20, 0xca, 0x58, 0xb5, 0xd0, 0xd3, 0x10, 0xee, 0x92, 0x3f, 0x78, 0x52, 0xfe, 0xd4,
-		0x58, 0x9d, 0xa7, 0xf0, 0x5e, 0x0d, 0x61, 0x78, 0xb9, 0xf7, 0x05, 0x00, 0x4a, 0x37, 0x6f, 0x36,
-		0x7a, 0x7a, 0xb7, 0x8d, 0xed, 0xd7, 0xab, 0xef, 0x9b, 0x70, 0xc4, 0x73, 0x21, 0xbb, 0xd7, 0x3c,
-		0x7c, 0xff, 0xe7, 0xdd, 0xbb, 0xd9, 0xbd, 0xe6, 0x48, 0xb2, 0x2d, 0xcb, 0x76, 0xc8, 0x06, 0x0f,
-		0x4f, 0xb6, 0x64, 0xd9, 0xc3, 0x92, 0x7d, 0x0e, 0x12, 0xae, 0x30, 0x2c, 0x54, 0x81, 0x98, 0xcd,
-		0xff, 0xe6, 0x02, 0xce, 0x8d, 0x17, 0xb0, 0x40, 0xf3, 0x0a, 0xd9, 0x41, 0xcf, 0xfd, 0xad, 0x04,
-		0xe0, 0x99, 0x23, 0x6f, 0x4c, 0x1d, 0x23, 0x11, 0x3c, 0x37, 0xce, 0xc1, 0x7b, 0x7e, 0x12, 0x94,
-		0x11, 0xf0, 0x08, 0xf5, 0xfd, 0x01, 0x98, 0xdf, 0x14, 0xb3, 0xf7, 0x8d, 0x2f, 0x83, 0x4d, 0x88,
-		0x62, 0xc3, 0xee, 0xe9, 0xce, 0xb6, 0xd2, 0x63, 0xe3, 0xc6, 0x7c, 0x44, 0xa7, 0xca, 0x86, 0xdd,
-		0xdb, 0xf7, 0x6a, 0x80, 0xa0, 0xe5, 0x91, 0xc7, 0x07, 0x83, 0x90, 0x19, 0x87, 0x8a, 0x1e, 0x82,
-		0x74, 0xb3, 0x87, 0x69, 0x81, 0xff, 0x9a, 0x69, 0x4a, 0x14, 0xf3, 0x65, 0x47, 0x01, 0xe2, 0xa8,
-		0x11, 0xe5, 0x22, 0xa0, 0xf7, 0xe6, 0x99, 0xa5, 0x5c, 0x0a, 0x74, 0xe1, 0x69, 0x40, 0x5a, 0x5c,
-		0x43, 0xd9, 0xd2, 0xda, 0x9a, 0xd1, 0x14, 0x1e, 0xec, 0xa1, 0xd6, 0x7c, 0x71, 0x95, 0xa5, 0xc0,
-		0x48, 0xa0, 0x32, 0x44, 0x05, 0xb5, 0xd0, 0xe1, 0xa9, 0x09, 0x5c, 0x74, 0x1a, 0x92, 0xde, 0x85,
-		0x81, 0x7a, 0x23, 0x21, 0x25, 0xe1, 0x59, 0x17, 0x26, 0xad, 0x3c, 0x91, 0x03, 0x57, 0x1e, 0xee,
-		0xf0, 0xfd, 0x28, 0x3d, 0x2d, 0xde, 0xfa, 0xbb, 0x3f, 0x2c, 0x1b, 0x00, 0x6c, 0xaa, 0x12, 0x4b,
-		0xca, 0x47, 0xe6, 0x1e, 0xe6, 0x7b, 0x9c, 0x11, 0x29, 0x59, 0xf6, 0x37, 0x6a, 0x84, 0xfe, 0x30,
-		0x00, 0x49, 0xef, 0x08, 0xfd, 0xbd, 0x5c, 0xb4, 0x50, 0xd5, 0x35, 0x53, 0xec, 0x46, 0xcd, 0xc3,
-		0xe3, 0xcc, 0xd4, 0x90, 0x36, 0x4f, 0xb0, 0x4f, 0x1f, 0x0a, 0x42, 0x84, 0x1f, 0x79, 0xab, 0x0d,
-		0xf9, 0xb6, 0xd2, 0xa4, 0x47, 0x04, 0x66, 0xc5, 0x23, 0x02, 0x23, 0x5d, 0xdb, 0x07, 0x20, 0x45,
-		0x62, 0x64, 0xdf, 0x39, 0x3a, 0xe9, 0xcc, 0x2c, 0x0d, 0x75, 0xdd, 0x53, 0xe3, 0x68, 0x11, 0x12,
-		0x04, 0xcc, 0xb5, 0xc3, 0x04, 0x06, 0x3a, 0xda, 0x5e, 0x99, 0x95, 0xa0, 0x73, 0x80, 0x76, 0x9d,
-		0x9c, 0x85, 0xea, 0x0a, 0x82, 0xc0, 0xcd, 0xb9, 0x35, 0x02, 0xfc, 0x24, 0x00, 0xe1, 0x42, 0x65,
-		0xaf, 0x60, 0xf3, 0x37, 0xc6, 0x49, 0x49, 0x89, 0xbe, 0x84, 0xfd, 0x1e, 0x89, 0xb9, 0xc8, 0x03,
-		0x91, 0x34, 0x8f, 0x50, 0x1a, 0x53, 0x4c, 0x8a, 0xbf, 0xfa, 0xec, 0x62, 0x76, 0x5f, 0xeb, 0xb4,
-		0x57, 0x73, 0x23, 0xe8, 0xe4, 0x46, 0x05, 0xf7, 0xc4, 0x71, 0xf6, 0x47, 0xe2, 0xab, 0x6f, 0x22,
-		0xea, 0xfd, 0xf2, 0xe7, 0x7f, 0xf6, 0xec, 0x09, 0xcf, 0xc3, 0xd3, 0x7b, 0x4e, 0x46, 0x8b, 0x8d,
-		0x09, 0xf1, 0x54, 0x91, 0xbb, 0x6a, 0x38, 0x87, 0xe5, 0xd7, 0x01, 0x3c, 0xce, 0xbd, 0x74, 0x70,
-		0xd0, 0xe0, 0xe2, 0xfb, 0x82, 0x06, 0xcf, 0x9c, 0x7a, 0xab, 0x6b, 0xb4, 0xc5, 0xbb, 0x11, 0x23,
-		0x9e, 0x45, 0x5f, 0x2e, 0x9a, 0xba, 0x8f, 0x84, 0x40, 0xa2, 0x53, 0x75, 0x26, 0xf7, 0xaf, 0x25,
-		0x38, 0x3e, 0xa4, 0x7e, 0x0e, 0xcb, 0x4d, 0x40, 0x3d, 0x4f, 0x25, 0x1d, 0x46, 0xb1, 0x3b, 0x7b,
-		0x6f, 0xda, 0x3c, 0xd7, 0x1b, 0xb2, 0xdc, 0xaf, 0xcf, 0xea, 0xc3, 0x4d, 0xcf, 0x6f, 0x4b, 0xb0,
-		0xe0, 0x65, 0xc0, 0xe9, 0x4a, 0x1d, 0x92, 0xde, 0xa6, 0x79, 0x27, 0xde, 0x34, 0x4d, 0x27, 0xbc,
-		0xfc, 0xfb, 0x88, 0xa0, 0x9b, 0xee, 0x14, 0x67, 0xa9, 0xb4, 0x0b, 0x53, 0x0b, 0x45, 0x30, 0x36,
-		0x72, 0xaa, 0xb3, 0xb1, 0xf9, 0x92, 0x04, 0xa1, 0x0d, 0xd3, 0x6c, 0xa3, 0x77, 0xc0, 0x9c, 0x61,
-		0xda, 0x2a, 0x99, 0x0e, 0xb8, 0xa5, 0xf2, 0x58, 0x9f, 0x99, 0xcf, 0xf2, 0x81, 0xb2, 0xfa, 0xe2,
-		0x67, 0x17, 0x87, 0x31, 0x47, 0x3d, 0x4c, 0x9f, 0x36, 0x4c, 0xbb, 0x40, 0x81, 0x1a, 0x2c, 0x1d,
-		0xb0, 0x0d, 0xb3, 0xfe, 0xe6, 0x98, 0x89, 0xcd, 0x4f, 0x6a, 0x6e, 0x76, 0x62, 0x53, 0xc9, 0x2d,
-		0x4f, 0x3b, 0xec, 0x2d, 0xe7, 0xbf, 0x20, 0x23, 0x77, 0x0b, 0xe4, 0x9b, 0x83, 0xa7, 0x7e, 0xca,
-		0x10, 0x15, 0xa7, 0x7c, 0xa4, 0x29, 0x0f, 0x10, 0x79, 0xc5, 0xc9, 0x71, 0xcf, 0xfe, 0xa2, 0x04,
-		0xe0, 0xe6, 0x4d, 0xd0, 0xa3, 0x70, 0xac, 0x50, 0xab, 0x96, 0xd4, 0x7a, 0x23, 0xdf, 0xd8, 0xac,
-		0xfb, 0x7f, 0xf0, 0x45, 0x3c, 0x82, 0x64, 0x75, 0x71, 0x53, 0xdf, 0xd6, 0x71, 0x0b, 0x3d, 0x08,
-		0x0b, 0x7e, 0x68, 0xf2, 0x55, 0x2e, 0xc9, 0x52, 0x36, 0xf9, 0xf2, 0xdd, 0xa5, 0x18, 0xf3, 0x13,
-		0x71, 0x0b, 0x9d, 0x81, 0x23, 0xc3, 0x70, 0x95, 0xea, 0x35, 0x39, 0x90, 0x9d, 0x7d, 0xf9, 0xee,
-		0x52, 0xdc, 0x71, 0x28, 0x51, 0x0e, 0x90, 0x17, 0x92, 0xd3, 0x0b, 0x66, 0xe1, 0xe5, 0xbb, 0x4b,
-		0x11, 0x36, 0x0a, 0xd9, 0xd0, 0x7b, 0x7f, 0xec, 0xd4, 0xcc, 0xd9, 0x6f, 0x01, 0xa8, 0x18, 0xdb,
-		0x3d, 0xad, 0x49, 0xb5, 0x2d, 0x0b, 0x47, 0x2b, 0xd5, 0xab, 0x4a, 0xbe, 0xd8, 0xa8, 0xd4, 0xaa,
-		0x03, 0xbf, 0x53, 0xe3, 0xaf, 0x2b, 0xd5, 0x36, 0x0b, 0x6b, 0x65, 0xb5, 0x5e, 0xb9, 0x56, 0x65,
-		0xfb, 0xaf, 0xbe, 0xba, 0x67, 0xab, 0x8d, 0xca, 0x7a, 0x59, 0x0e, 0x14, 0xae, 0x8e, 0xcd, 0xef,
-		0x3f, 0x7a, 0xe0, 0x53, 0xfb, 0xae, 0xc5, 0xf3, 0x25, 0xf9, 0xff, 0x67, 0x00, 0x00, 0x00, 0xff,
-		0xff, 0x3f, 0x33, 0xd2, 0x2f, 0x27, 0xb7, 0x00, 0x00,
+		0x75, 0x18, 0x3c, 0x17, 0x6f, 0x6c, 0xbc, 0xee, 0x9c, 0x19, 0x92, 0x20, 0x28, 0x91, 0x23, 0xc8,
+		0x92, 0x28, 0xca, 0x1a, 0x4a, 0x94, 0x48, 0x89, 0x23, 0xdb, 0xfa, 0xf0, 0x22, 0x09, 0x6a, 0x06,
+		0x18, 0x5f, 0x60, 0x28, 0x51, 0x49, 0x7c, 0x73, 0x07, 0xb8, 0x33, 0x03, 0x11, 0xb8, 0x17, 0xc6,
+		0xbd, 0xa0, 0x38, 0xfe, 0x91, 0xe5, 0xc4, 0xce, 0x57, 0x47, 0x8e, 0x13, 0xa7, 0xc9, 0x4a, 0x94,
+		0x38, 0x4c, 0x6c, 0xa7, 0x71, 0x9d, 0x57, 0xeb, 0xc4, 0xce, 0xab, 0x5d, 0xcd, 0xa3, 0x6d, 0xda,
+		0x24, 0x4d, 0x5b, 0xc7, 0x6b, 0x35, 0x4d, 0xb3, 0x56, 0xdc, 0xc6, 0xc9, 0x8a, 0x64, 0xc7, 0x69,
+		0x5e, 0x4a, 0x9b, 0x2e, 0xb7, 0xcb, 0x5d, 0xfb, 0x3c, 0xee, 0x03, 0x8f, 0x01, 0x86, 0x96, 0x5d,
+		0x35, 0xe9, 0x1f, 0x72, 0xee, 0x3e, 0x7b, 0xef, 0xb3, 0xcf, 0x3e, 0xfb, 0xec, 0xb3, 0xcf, 0x3e,
+		0x0f, 0xc0, 0x47, 0x77, 0x61, 0x65, 0xd7, 0x34, 0x77, 0xbb, 0xfa, 0xd9, 0xfe, 0xc0, 0xb4, 0xcd,
+		0xed, 0xe1, 0xce, 0xd9, 0xb6, 0x6e, 0xb5, 0x06, 0x9d, 0xbe, 0x6d, 0x0e, 0x56, 0x29, 0x8c, 0x64,
+		0x18, 0xc6, 0xaa, 0xc0, 0xc8, 0x7f, 0x1d, 0x2c, 0x5e, 0xea, 0x74, 0xf5, 0xb2, 0x83, 0xd8, 0xd0,
+		0x6d, 0xf2, 0x24, 0x84, 0x76, 0x3a, 0x5d, 0x3d, 0x2b, 0xad, 0x04, 0x4f, 0x27, 0xce, 0xbd, 0x69,
+		0x75, 0x84, 0x68, 0xd5, 0x4f, 0xb1, 0x89, 0x60, 0x85, 0x52, 0x9c, 0x49, 0xc6, 0xde, 0xfd, 0xc5,
+		0xdf, 0xfc, 0xb2, 0x24, 0x7f, 0x33, 0xfe, 0x9b, 0xff, 0xb5, 0x30, 0x2c, 0x4d, 0xc0, 0x25, 0x04,
+		0x42, 0x86, 0xd6, 0x43, 0xfe, 0xd2, 0xe9, 0xb8, 0x42, 0xff, 0x26, 0x59, 0x88, 0xf6, 0xb5, 0xd6,
+		0x0d, 0x6d, 0x57, 0xcf, 0x06, 0x28, 0x58, 0x7c, 0x92, 0x93, 0x00, 0x6d, 0xbd, 0xaf, 0x1b, 0x6d,
+		0xdd, 0x68, 0xed, 0x67, 0x83, 0x2b, 0xc1, 0xd3, 0x71, 0xc5, 0x03, 0x21, 0x0f, 0xc1, 0x62, 0x7f,
+		0xb8, 0xdd, 0xed, 0xb4, 0x54, 0x0f, 0x1a, 0xac, 0x04, 0x4f, 0x87, 0x15, 0x99, 0x15, 0x94, 0x5d,
+		0xe4, 0x07, 0x20, 0xf3, 0xa2, 0xae, 0xdd, 0xf0, 0xa2, 0x26, 0x28, 0x6a, 0x1a, 0xc1, 0x65, 0x1f,
+		0x57, 0xb3, 0x6f, 0x77, 0x4c, 0xc3, 0x8b, 0x9a, 0xa1, 0x95, 0xcb, 0xac, 0xc0, 0x83, 0x5c, 0x82,
+		0x64, 0x4f, 0xb7, 0x2c, 0x6d, 0x57, 0x57, 0xed, 0xfd, 0xbe, 0x9e, 0x0d, 0x51, 0xc5, 0xad, 0x8c,
+		0x29, 0x6e, 0x54, 0x69, 0x09, 0x4e, 0xd5, 0xdc, 0xef, 0xeb, 0xa4, 0x00, 0x71, 0xdd, 0x18, 0xf6,
+		0x18, 0x87, 0xf0, 0x14, 0xd5, 0x57, 0x8c, 0x61, 0x6f, 0x94, 0x4b, 0x0c, 0xc9, 0x38, 0x8b, 0xa8,
+		0xa5, 0x0f, 0x6e, 0x76, 0x5a, 0x7a, 0x36, 0x42, 0x19, 0x3c, 0x30, 0xc6, 0xa0, 0xc1, 0xca, 0x47,
+		0x79, 0x08, 0x3a, 0x52, 0x82, 0xb8, 0x7e, 0xcb, 0xd6, 0x0d, 0xab, 0x63, 0x1a, 0xd9, 0x28, 0x65,
+		0x72, 0xdf, 0x04, 0x03, 0xd0, 0xbb, 0xed, 0x51, 0x16, 0x2e, 0x1d, 0xb9, 0x00, 0x51, 0xa6, 0x23,
+		0x2b, 0x1b, 0x5b, 0x91, 0x4e, 0x27, 0xce, 0xdd, 0x35, 0xd1, 0x86, 0xea, 0x0c, 0x47, 0x11, 0xc8,
+		0xa4, 0x0a, 0xb2, 0x65, 0x0e, 0x07, 0x2d, 0x5d, 0x6d, 0x99, 0x6d, 0x5d, 0xed, 0x18, 0x3b, 0x66,
+		0x36, 0x4e, 0x19, 0x9c, 0x1a, 0x6f, 0x08, 0x45, 0x2c, 0x99, 0x6d, 0xbd, 0x6a, 0xec, 0x98, 0x4a,
+		0xda, 0xf2, 0x7d, 0x93, 0xa3, 0x10, 0xb1, 0xf6, 0x0d, 0x5b, 0xbb, 0x95, 0x4d, 0x52, 0x73, 0xe2,
+		0x5f, 0xe4, 0x1c, 0x44, 0xf5, 0x76, 0x07, 0xab, 0xcb, 0xa6, 0x57, 0xa4, 0xd3, 0xe9, 0x73, 0xd9,
+		0x71, 0x1d, 0xb3, 0x72, 0x45, 0x20, 0xe6, 0xff, 0x67, 0x04, 0x32, 0xf3, 0xd8, 0xf0, 0x53, 0x10,
+		0xde, 0x41, 0xcd, 0x64, 0x03, 0x87, 0xd1, 0x1b, 0xa3, 0xf1, 0x2b, 0x3e, 0x72, 0x87, 0x8a, 0x2f,
+		0x40, 0xc2, 0xd0, 0x2d, 0x5b, 0x6f, 0x33, 0x2b, 0x0a, 0xce, 0x69, 0x87, 0xc0, 0x88, 0xc6, 0xcd,
+		0x30, 0x74, 0x47, 0x66, 0xf8, 0x1c, 0x64, 0x1c, 0x91, 0xd4, 0x81, 0x66, 0xec, 0x0a, 0x7b, 0x3e,
+		0x3b, 0x4b, 0x92, 0xd5, 0x8a, 0xa0, 0x53, 0x90, 0x4c, 0x49, 0xeb, 0xbe, 0x6f, 0x52, 0x06, 0x30,
+		0x0d, 0xdd, 0xdc, 0x51, 0xdb, 0x7a, 0xab, 0x9b, 0x8d, 0x4d, 0xd1, 0x52, 0x1d, 0x51, 0xc6, 0xb4,
+		0x64, 0x32, 0x68, 0xab, 0x4b, 0x2e, 0xba, 0xe6, 0x19, 0x9d, 0x62, 0x5d, 0x1b, 0x6c, 0x60, 0x8e,
+		0x59, 0xe8, 0x16, 0xa4, 0x07, 0x3a, 0x8e, 0x15, 0xbd, 0xcd, 0x5b, 0x16, 0xa7, 0x42, 0xac, 0xce,
+		0x6c, 0x99, 0xc2, 0xc9, 0x58, 0xc3, 0x52, 0x03, 0xef, 0x27, 0xb9, 0x17, 0x1c, 0x80, 0x4a, 0xcd,
+		0x0a, 0xa8, 0xa7, 0x49, 0x0a, 0x60, 0x0d, 0xcd, 0xab, 0x00, 0x70, 0xb3, 0x63, 0x75, 0xb6, 0x3b,
+		0xdd, 0x8e, 0x8d, 0x6e, 0x0b, 0xad, 0xf7, 0x9e, 0xf1, 0x71, 0xb1, 0xdf, 0xdb, 0x36, 0xbb, 0xd7,
+		0x1c, 0x44, 0xc5, 0x43, 0x94, 0x7b, 0x17, 0xa4, 0xfd, 0x1a, 0x26, 0xcb, 0x10, 0xb6, 0x6c, 0x6d,
+		0x60, 0x53, 0x43, 0x0e, 0x2b, 0xec, 0x83, 0xc8, 0x10, 0xd4, 0x8d, 0x36, 0xf5, 0xc4, 0x61, 0x05,
+		0xff, 0x24, 0xff, 0x9f, 0xab, 0xb3, 0x20, 0xd5, 0xd9, 0xfd, 0xe3, 0x46, 0xe1, 0xe3, 0x3c, 0xaa,
+		0xba, 0xdc, 0x13, 0x90, 0xf2, 0xe9, 0x60, 0xde, 0xaa, 0xf3, 0xbf, 0x15, 0x82, 0x23, 0x13, 0x79,
+		0x93, 0xe7, 0x60, 0x79, 0x68, 0x74, 0x0c, 0x5b, 0x1f, 0xf4, 0x07, 0x3a, 0x5a, 0x3d, 0xab, 0x2b,
+		0xfb, 0x4a, 0x74, 0x8a, 0xdd, 0x6e, 0x79, 0xb1, 0x19, 0x17, 0x65, 0x69, 0x38, 0x0e, 0x24, 0xd7,
+		0x21, 0x81, 0x26, 0xa6, 0x0d, 0x34, 0xca, 0x90, 0x0d, 0xe8, 0x73, 0xf3, 0x35, 0x79, 0xb5, 0xec,
+		0x52, 0x16, 0x83, 0xef, 0x93, 0x02, 0x8a, 0x97, 0x17, 0x79, 0x02, 0x62, 0x3b, 0xba, 0x66, 0x0f,
+		0x07, 0xba, 0x95, 0x3d, 0x47, 0x55, 0x79, 0x62, 0x7c, 0x9c, 0x33, 0x84, 0x86, 0x6e, 0x2b, 0x0e,
+		0x32, 0xe9, 0x41, 0xf2, 0xa6, 0x3e, 0xe8, 0xec, 0x74, 0x5a, 0x4c, 0xa8, 0x20, 0xb5, 0x80, 0x27,
+		0xe7, 0x14, 0xea, 0x9a, 0x87, 0xb4, 0x61, 0x6b, 0xb6, 0xbe, 0x06, 0x5b, 0xb5, 0x6b, 0x15, 0xa5,
+		0x7a, 0xa9, 0x5a, 0x29, 0x33, 0x31, 0x7d, 0xec, 0x73, 0xdf, 0x23, 0x41, 0xc2, 0xd3, 0x12, 0xf4,
+		0xa8, 0xc6, 0xb0, 0xb7, 0xad, 0x0f, 0x78, 0x7f, 0xf1, 0x2f, 0x72, 0x02, 0xe2, 0x3b, 0xc3, 0x6e,
+		0x97, 0xd9, 0x2d, 0x9b, 0xbb, 0x63, 0x08, 0xa0, 0x36, 0x4b, 0x20, 0xc4, 0x3d, 0x11, 0x75, 0x93,
+		0xf8, 0x37, 0xc9, 0x41, 0x4c, 0xd8, 0x75, 0x36, 0xbc, 0x22, 0x9d, 0x8e, 0x29, 0xce, 0x37, 0x2b,
+		0xeb, 0xeb, 0x9a, 0xad, 0xb7, 0xb3, 0x11, 0x51, 0xc6, 0xbe, 0xaf, 0x86, 0x62, 0x21, 0x39, 0x9c,
+		0x7f, 0x1c, 0x16, 0xc7, 0x9a, 0x42, 0x32, 0x90, 0x28, 0x57, 0x4a, 0xeb, 0x05, 0xa5, 0xd0, 0xac,
+		0xd6, 0x6b, 0xf2, 0x02, 0x49, 0x83, 0xa7, 0x75, 0xb2, 0x74, 0x26, 0x1e, 0x7b, 0x35, 0x2a, 0xbf,
+		0xfb, 0xdd, 0xef, 0x7e, 0x77, 0x20, 0xff, 0xab, 0x11, 0x58, 0x9e, 0xe4, 0x47, 0x27, 0xba, 0x74,
+		0xb7, 0xd1, 0x41, 0x5f, 0xa3, 0x0b, 0x10, 0xee, 0x6a, 0xdb, 0x7a, 0x37, 0x1b, 0xa2, 0x9d, 0xf0,
+		0xd0, 0x5c, 0x9e, 0x7a, 0x75, 0x1d, 0x49, 0x14, 0x46, 0x49, 0xde, 0xc6, 0x55, 0x13, 0xa6, 0x1c,
+		0xce, 0xcc, 0xc7, 0x01, 0xfd, 0x2b, 0x57, 0xe3, 0x09, 0x88, 0xe3, 0xff, 0x4c, 0xef, 0x11, 0xa6,
+		0x77, 0x04, 0x50, 0xbd, 0xe7, 0x20, 0x46, 0x5d, 0x67, 0x5b, 0x77, 0xfa, 0x44, 0x7c, 0xa3, 0xb3,
+		0x69, 0xeb, 0x3b, 0xda, 0xb0, 0x6b, 0xab, 0x37, 0xb5, 0xee, 0x50, 0xa7, 0x4e, 0x30, 0xae, 0x24,
+		0x39, 0xf0, 0x1a, 0xc2, 0xc8, 0x29, 0x48, 0x30, 0x4f, 0xdb, 0x31, 0xda, 0xfa, 0x2d, 0x3a, 0x0b,
+		0x87, 0x15, 0xe6, 0x7c, 0xab, 0x08, 0xc1, 0xea, 0x5f, 0xb0, 0x4c, 0x43, 0xb8, 0x2b, 0x5a, 0x05,
+		0x02, 0x68, 0xf5, 0x4f, 0x8c, 0x06, 0x00, 0x77, 0x4f, 0x6e, 0xde, 0x98, 0x7f, 0x7d, 0x00, 0x32,
+		0x14, 0xe3, 0x31, 0x3e, 0x94, 0xb5, 0x6e, 0x76, 0x91, 0x9a, 0x41, 0x9a, 0x81, 0xeb, 0x1c, 0x9a,
+		0xff, 0xb9, 0x00, 0x84, 0xe8, 0x64, 0x93, 0x81, 0x44, 0xf3, 0xfa, 0x66, 0x45, 0x2d, 0xd7, 0xb7,
+		0x8a, 0xeb, 0x15, 0x59, 0xc2, 0xae, 0xa7, 0x80, 0x4b, 0xeb, 0xf5, 0x42, 0x53, 0x0e, 0x38, 0xdf,
+		0xd5, 0x5a, 0xf3, 0xc2, 0xe3, 0x72, 0xd0, 0x21, 0xd8, 0x62, 0x80, 0x90, 0x17, 0xe1, 0xb1, 0x73,
+		0x72, 0x98, 0xc8, 0x90, 0x64, 0x0c, 0xaa, 0xcf, 0x55, 0xca, 0x17, 0x1e, 0x97, 0x23, 0x7e, 0xc8,
+		0x63, 0xe7, 0xe4, 0x28, 0x49, 0x41, 0x9c, 0x42, 0x8a, 0xf5, 0xfa, 0xba, 0x1c, 0x73, 0x78, 0x36,
+		0x9a, 0x4a, 0xb5, 0x76, 0x59, 0x8e, 0x3b, 0x3c, 0x2f, 0x2b, 0xf5, 0xad, 0x4d, 0x19, 0x1c, 0x0e,
+		0x1b, 0x95, 0x46, 0xa3, 0x70, 0xb9, 0x22, 0x27, 0x1c, 0x8c, 0xe2, 0xf5, 0x66, 0xa5, 0x21, 0x27,
+		0x7d, 0x62, 0x3d, 0x76, 0x4e, 0x4e, 0x39, 0x55, 0x54, 0x6a, 0x5b, 0x1b, 0x72, 0x9a, 0x2c, 0x42,
+		0x8a, 0x55, 0x21, 0x84, 0xc8, 0x8c, 0x80, 0x2e, 0x3c, 0x2e, 0xcb, 0xae, 0x20, 0x8c, 0xcb, 0xa2,
+		0x0f, 0x70, 0xe1, 0x71, 0x99, 0xe4, 0x4b, 0x10, 0xa6, 0x66, 0x48, 0x08, 0xa4, 0xd7, 0x0b, 0xc5,
+		0xca, 0xba, 0x5a, 0xdf, 0xc4, 0x41, 0x53, 0x58, 0x97, 0x25, 0x17, 0xa6, 0x54, 0x36, 0x2b, 0x85,
+		0x66, 0xa5, 0x2c, 0x07, 0xbd, 0xb0, 0xb7, 0x6f, 0x55, 0x95, 0x4a, 0x59, 0x0e, 0xe4, 0x5b, 0xb0,
+		0x3c, 0x69, 0x92, 0x9d, 0x38, 0x84, 0x3c, 0xb6, 0x10, 0x98, 0x62, 0x0b, 0x94, 0xd7, 0xa8, 0x2d,
+		0xe4, 0x3f, 0x16, 0x84, 0xa5, 0x09, 0x81, 0xc6, 0xc4, 0x4a, 0x9e, 0x86, 0x30, 0xb3, 0x65, 0xe6,
+		0xa9, 0x1f, 0x9c, 0x18, 0xb1, 0x50, 0xcb, 0x1e, 0x0b, 0xbf, 0x28, 0x9d, 0x37, 0x64, 0x0d, 0x4e,
+		0x09, 0x59, 0x91, 0xc5, 0x98, 0xc1, 0x7e, 0xc3, 0x58, 0x40, 0xc0, 0x62, 0xa6, 0x0b, 0xf3, 0xc4,
+		0x4c, 0x14, 0x76, 0xb8, 0xc0, 0x20, 0x3c, 0x33, 0x30, 0x88, 0xdc, 0x49, 0x60, 0xf0, 0x14, 0x2c,
+		0x8e, 0xc9, 0x32, 0xf7, 0x04, 0xfd, 0x1e, 0x09, 0xb2, 0xd3, 0xf4, 0x3b, 0xc3, 0xab, 0x06, 0x7c,
+		0x5e, 0xf5, 0xa9, 0xd1, 0x4e, 0xb8, 0x67, 0x7a, 0x3f, 0x8e, 0x99, 0xcb, 0xcf, 0x4a, 0x70, 0x74,
+		0xf2, 0xea, 0x66, 0xa2, 0x0c, 0x6f, 0x83, 0x48, 0x4f, 0xb7, 0xf7, 0x4c, 0x11, 0xad, 0xdf, 0x3f,
+		0x21, 0x06, 0xc4, 0xe2, 0x51, 0x7b, 0xe1, 0x54, 0xe4, 0xe2, 0xa8, 0xac, 0xa7, 0xa6, 0xad, 0xb5,
+		0x46, 0x25, 0x65, 0x13, 0x99, 0x12, 0xb1, 0xec, 0x81, 0xae, 0xf5, 0xf2, 0xdf, 0x16, 0x80, 0x23,
+		0x13, 0xab, 0x9a, 0x28, 0xf6, 0xdd, 0x00, 0x1d, 0xa3, 0x3f, 0xb4, 0x59, 0x7c, 0xce, 0x5c, 0x7b,
+		0x9c, 0x42, 0xa8, 0x37, 0x44, 0xb7, 0x3d, 0xb4, 0x9d, 0x72, 0x36, 0xed, 0x02, 0x03, 0x51, 0x84,
+		0x27, 0x5d, 0xb1, 0x43, 0x54, 0xec, 0x93, 0x53, 0xda, 0x3d, 0x66, 0xe9, 0x8f, 0x80, 0xdc, 0xea,
+		0x76, 0x74, 0xc3, 0x56, 0x99, 0xe0, 0x1d, 0x63, 0x97, 0x4d, 0xdf, 0x6b, 0xe1, 0x1d, 0xad, 0x6b,
+		0xe9, 0x4a, 0x86, 0x15, 0x37, 0x44, 0x29, 0x52, 0x50, 0x73, 0x1a, 0x78, 0x28, 0x22, 0x3e, 0x0a,
+		0x56, 0xec, 0x50, 0xe4, 0x7f, 0x2a, 0x0e, 0x09, 0xcf, 0xca, 0x90, 0xdc, 0x03, 0xc9, 0x17, 0xb4,
+		0x9b, 0x9a, 0x2a, 0x52, 0x03, 0x4c, 0x13, 0x09, 0x84, 0x6d, 0x32, 0x10, 0x79, 0x04, 0x96, 0x29,
+		0x8a, 0x39, 0xb4, 0xf5, 0x81, 0xda, 0xea, 0x6a, 0x96, 0x45, 0x95, 0x16, 0xa3, 0xa8, 0x04, 0xcb,
+		0xea, 0x58, 0x54, 0x12, 0x25, 0xe4, 0x3c, 0x2c, 0x51, 0x8a, 0xde, 0xb0, 0x6b, 0x77, 0xfa, 0x5d,
+		0x5d, 0xc5, 0xd4, 0x85, 0x95, 0x05, 0xaf, 0x64, 0x8b, 0x88, 0xb1, 0xc1, 0x11, 0x50, 0x22, 0x8b,
+		0x94, 0xe1, 0x6e, 0x4a, 0xb6, 0xab, 0x1b, 0xfa, 0x40, 0xb3, 0x75, 0x55, 0x7f, 0xe7, 0x50, 0xeb,
+		0x5a, 0xaa, 0x66, 0xb4, 0xd5, 0x3d, 0xcd, 0xda, 0xcb, 0x2e, 0x23, 0x83, 0x62, 0x20, 0x2b, 0x29,
+		0xc7, 0x11, 0xf1, 0x32, 0xc7, 0xab, 0x50, 0xb4, 0x82, 0xd1, 0xbe, 0xa2, 0x59, 0x7b, 0x64, 0x0d,
+		0x8e, 0x52, 0x2e, 0x96, 0x3d, 0xe8, 0x18, 0xbb, 0x6a, 0x6b, 0x4f, 0x6f, 0xdd, 0x50, 0x87, 0xf6,
+		0xce, 0x93, 0xd9, 0x13, 0xde, 0xfa, 0xa9, 0x84, 0x0d, 0x8a, 0x53, 0x42, 0x94, 0x2d, 0x7b, 0xe7,
+		0x49, 0xd2, 0x80, 0x24, 0x76, 0x46, 0xaf, 0xf3, 0x2e, 0x5d, 0xdd, 0x31, 0x07, 0x74, 0x52, 0x4e,
+		0x4f, 0xf0, 0x75, 0x1e, 0x0d, 0xae, 0xd6, 0x39, 0xc1, 0x86, 0xd9, 0xd6, 0xd7, 0xc2, 0x8d, 0xcd,
+		0x4a, 0xa5, 0xac, 0x24, 0x04, 0x97, 0x4b, 0xe6, 0x00, 0x0d, 0x6a, 0xd7, 0x74, 0x14, 0x9c, 0x60,
+		0x06, 0xb5, 0x6b, 0x0a, 0xf5, 0x9e, 0x87, 0xa5, 0x56, 0x8b, 0xb5, 0xb9, 0xd3, 0x52, 0x79, 0x96,
+		0xc0, 0xca, 0xca, 0x3e, 0x65, 0xb5, 0x5a, 0x97, 0x19, 0x02, 0xb7, 0x78, 0x8b, 0x5c, 0x84, 0x23,
+		0xae, 0xb2, 0xbc, 0x84, 0x8b, 0x63, 0xad, 0x1c, 0x25, 0x3d, 0x0f, 0x4b, 0xfd, 0xfd, 0x71, 0x42,
+		0xe2, 0xab, 0xb1, 0xbf, 0x3f, 0x4a, 0x76, 0x1f, 0x4d, 0x13, 0x0d, 0xf4, 0x16, 0x8d, 0x1d, 0x8f,
+		0x79, 0xb1, 0x3d, 0x05, 0x64, 0x15, 0xe4, 0x56, 0x4b, 0xd5, 0x0d, 0x6d, 0xbb, 0xab, 0xab, 0xda,
+		0x40, 0x37, 0x34, 0x2b, 0x7b, 0x8a, 0x22, 0x87, 0xec, 0xc1, 0x50, 0x57, 0xd2, 0xad, 0x56, 0x85,
+		0x16, 0x16, 0x68, 0x19, 0x39, 0x03, 0x8b, 0xe6, 0xf6, 0x0b, 0x2d, 0x66, 0x58, 0x6a, 0x7f, 0xa0,
+		0xef, 0x74, 0x6e, 0x65, 0xdf, 0x44, 0xb5, 0x94, 0xc1, 0x02, 0x6a, 0x56, 0x9b, 0x14, 0x4c, 0x1e,
+		0x04, 0xb9, 0x65, 0xed, 0x69, 0x83, 0x3e, 0x75, 0xd5, 0x56, 0x5f, 0x6b, 0xe9, 0xd9, 0xfb, 0x18,
+		0x2a, 0x83, 0xd7, 0x04, 0x18, 0x0d, 0xdb, 0x7a, 0xb1, 0xb3, 0x63, 0x0b, 0x8e, 0x0f, 0x30, 0xc3,
+		0xa6, 0x30, 0xce, 0xed, 0x34, 0xc8, 0xfd, 0xbd, 0xbe, 0xbf, 0xe2, 0xd3, 0x14, 0x2d, 0xdd, 0xdf,
+		0xeb, 0x7b, 0xeb, 0xbd, 0x17, 0x52, 0xfd, 0x3d, 0x6f, 0xa5, 0x0f, 0xb2, 0x80, 0xae, 0xbf, 0xe7,
+		0xa9, 0xf1, 0x71, 0x38, 0x8a, 0x48, 0x3d, 0xdd, 0xd6, 0xda, 0x9a, 0xad, 0x79, 0xb0, 0xdf, 0x4c,
+		0xb1, 0x97, 0xfb, 0x7b, 0xfd, 0x0d, 0x5e, 0xe8, 0x93, 0x73, 0x30, 0xdc, 0xde, 0x77, 0xec, 0xe3,
+		0x61, 0x26, 0x27, 0xc2, 0x84, 0x85, 0xdc, 0xf1, 0x7a, 0xe6, 0xab, 0xb6, 0x7a, 0xcb, 0xaf, 0x41,
+		0xd2, 0x6b, 0xf7, 0x24, 0x0e, 0xcc, 0xf2, 0x65, 0x09, 0xa3, 0xaa, 0x52, 0xbd, 0x8c, 0xf1, 0xd0,
+		0xf3, 0x15, 0x39, 0x80, 0x71, 0xd9, 0x7a, 0xb5, 0x59, 0x51, 0x95, 0xad, 0x5a, 0xb3, 0xba, 0x51,
+		0x91, 0x83, 0x9e, 0x95, 0xc2, 0xd5, 0x50, 0xec, 0x8c, 0xfc, 0xd0, 0xd5, 0x50, 0xec, 0x7e, 0xf9,
+		0x01, 0xaa, 0x9e, 0x31, 0xa3, 0xcc, 0xbf, 0x16, 0x84, 0xb4, 0x3f, 0x55, 0x40, 0xde, 0x02, 0xc7,
+		0x44, 0x2e, 0xd0, 0xd2, 0x6d, 0xf5, 0xc5, 0xce, 0x80, 0x0e, 0xd6, 0x9e, 0xc6, 0xa6, 0x51, 0xc7,
+		0x28, 0x97, 0x39, 0x56, 0x43, 0xb7, 0x9f, 0xed, 0x0c, 0x70, 0x28, 0xf6, 0x34, 0x9b, 0xac, 0xc3,
+		0x29, 0xc3, 0x54, 0x2d, 0x5b, 0x33, 0xda, 0xda, 0xa0, 0xad, 0xba, 0x09, 0x5c, 0x55, 0x6b, 0xb5,
+		0x74, 0xcb, 0x32, 0xd9, 0x94, 0xe9, 0x70, 0xb9, 0xcb, 0x30, 0x1b, 0x1c, 0xd9, 0x9d, 0x3d, 0x0a,
+		0x1c, 0x75, 0x64, 0x4c, 0x04, 0xa7, 0x8d, 0x89, 0x13, 0x10, 0xef, 0x69, 0x7d, 0x55, 0x37, 0xec,
+		0xc1, 0x3e, 0x5d, 0x0c, 0xc4, 0x94, 0x58, 0x4f, 0xeb, 0x57, 0xf0, 0x9b, 0x5c, 0x83, 0xfb, 0x5d,
+		0x54, 0xb5, 0xab, 0xef, 0x6a, 0xad, 0x7d, 0x95, 0x46, 0xfe, 0x34, 0x6f, 0xa5, 0xb6, 0x4c, 0x63,
+		0xa7, 0xdb, 0x69, 0xd9, 0x56, 0x36, 0xe1, 0xf8, 0xbf, 0xbc, 0x4b, 0xb1, 0x4e, 0x09, 0xae, 0x5a,
+		0xa6, 0x41, 0x03, 0xfe, 0x92, 0xc0, 0xf6, 0x99, 0x4d, 0xf2, 0x0d, 0x61, 0x36, 0xfe, 0xae, 0x0f,
+		0xc9, 0xe1, 0xab, 0xa1, 0x58, 0x58, 0x8e, 0x5c, 0x0d, 0xc5, 0x22, 0x72, 0xf4, 0x6a, 0x28, 0x16,
+		0x93, 0xe3, 0x57, 0x43, 0xb1, 0xb8, 0x0c, 0xf9, 0x8f, 0xa4, 0x20, 0xe9, 0x5d, 0xbf, 0xe0, 0x72,
+		0xb0, 0x45, 0x27, 0x5c, 0x89, 0xba, 0xe4, 0x7b, 0x0f, 0x5c, 0xed, 0xac, 0x96, 0x70, 0x26, 0x5e,
+		0x8b, 0xb0, 0xc5, 0x82, 0xc2, 0x28, 0x31, 0x26, 0xc2, 0x41, 0xa6, 0xb3, 0xc8, 0x2a, 0xa6, 0xf0,
+		0x2f, 0x72, 0x19, 0x22, 0x2f, 0x58, 0x94, 0x37, 0x0b, 0xec, 0xde, 0x74, 0x30, 0xef, 0xab, 0x0d,
+		0xca, 0x3c, 0x7e, 0xb5, 0xa1, 0xd6, 0xea, 0xca, 0x46, 0x61, 0x5d, 0xe1, 0xe4, 0xe4, 0x38, 0x84,
+		0xba, 0xda, 0xbb, 0xf6, 0xfd, 0x73, 0x36, 0x05, 0x91, 0x55, 0xc8, 0x0c, 0x0d, 0xb6, 0xf8, 0xc7,
+		0x3e, 0x46, 0xac, 0x8c, 0x17, 0x2b, 0xed, 0x96, 0xae, 0x23, 0xfe, 0x9c, 0x76, 0x75, 0x37, 0x84,
+		0x30, 0xab, 0xee, 0x9b, 0x59, 0xa9, 0x7d, 0x50, 0x30, 0x39, 0x0d, 0xc9, 0xb6, 0xbe, 0x3d, 0xdc,
+		0x55, 0x07, 0x7a, 0x5b, 0x6b, 0xd9, 0xfe, 0x39, 0x25, 0x41, 0x8b, 0x14, 0x5a, 0x42, 0x9e, 0x81,
+		0x38, 0xf6, 0x93, 0x41, 0xfb, 0x79, 0x91, 0xaa, 0xe1, 0xe1, 0x83, 0xd5, 0xc0, 0xbb, 0x59, 0x10,
+		0x29, 0x2e, 0x3d, 0xb9, 0x02, 0x51, 0x5b, 0x1b, 0xec, 0xea, 0xb6, 0x95, 0x5d, 0x5a, 0x09, 0x9e,
+		0x4e, 0x9f, 0x5b, 0x9d, 0x87, 0x55, 0x93, 0x92, 0xd0, 0xe5, 0xb7, 0x20, 0x27, 0xcf, 0x82, 0xcc,
+		0x53, 0xc4, 0x2a, 0x5f, 0x3b, 0x5b, 0xd9, 0x65, 0x6a, 0x84, 0x6f, 0x3e, 0x98, 0x25, 0xcf, 0x30,
+		0x97, 0x19, 0x91, 0x92, 0xd1, 0x7d, 0xdf, 0xfe, 0xb1, 0x71, 0xe4, 0x30, 0x63, 0x63, 0x0b, 0x32,
+		0xfc, 0x6f, 0xd5, 0x1a, 0xf6, 0xfb, 0xe6, 0xc0, 0xce, 0x1e, 0x5d, 0x91, 0x66, 0x0b, 0x24, 0x98,
+		0x31, 0x1a, 0x25, 0xbd, 0xe3, 0xfb, 0xfe, 0xea, 0x0d, 0xb9, 0xdc, 0xf3, 0x90, 0xf6, 0x2b, 0xc3,
+		0x9b, 0xa0, 0x0f, 0xce, 0x99, 0xa0, 0xc7, 0x85, 0x8a, 0x58, 0xfd, 0xe1, 0xf4, 0xc4, 0x3e, 0x72,
+		0xdf, 0x1b, 0x80, 0xb4, 0xbf, 0x61, 0xe4, 0x32, 0x10, 0xd1, 0x63, 0x1d, 0xc3, 0x1e, 0x98, 0xed,
+		0x61, 0x4b, 0x6f, 0x67, 0xa5, 0x19, 0xf5, 0x2c, 0x72, 0x9a, 0xaa, 0x43, 0xe2, 0x65, 0xe4, 0x19,
+		0x09, 0x81, 0x39, 0x19, 0x95, 0xdd, 0x31, 0x72, 0x16, 0x96, 0x04, 0x03, 0x64, 0xf6, 0xa2, 0x36,
+		0x30, 0x30, 0x4c, 0x66, 0x81, 0x3b, 0xf1, 0x14, 0x3d, 0xcb, 0x4a, 0x48, 0x01, 0x84, 0xb9, 0xa8,
+		0x03, 0xbd, 0x67, 0x62, 0x12, 0x2d, 0x34, 0xa3, 0xda, 0x34, 0x27, 0x50, 0x18, 0x7e, 0xfe, 0x2c,
+		0x84, 0xa9, 0x0b, 0x22, 0x00, 0xdc, 0x09, 0xc9, 0x0b, 0x24, 0x06, 0xa1, 0x52, 0x5d, 0xc1, 0x29,
+		0x52, 0x86, 0x24, 0x83, 0xaa, 0x9b, 0xd5, 0x4a, 0xa9, 0x22, 0x07, 0xf2, 0xe7, 0x21, 0xc2, 0xfc,
+		0x0a, 0x4e, 0x9f, 0x8e, 0x67, 0x91, 0x17, 0xf8, 0x27, 0xe7, 0x21, 0x89, 0xd2, 0xad, 0x8d, 0x62,
+		0x45, 0x91, 0x03, 0xf9, 0x2d, 0xc8, 0x8c, 0x8c, 0x43, 0x72, 0x04, 0x16, 0x95, 0x4a, 0xb3, 0x52,
+		0xc3, 0x8c, 0x83, 0xba, 0x55, 0x7b, 0xa6, 0x56, 0x7f, 0x16, 0xd3, 0x75, 0x3e, 0xb0, 0x98, 0x8b,
+		0x25, 0xb2, 0x0c, 0xb2, 0x0b, 0x6e, 0xd4, 0xb7, 0x14, 0x2a, 0xcd, 0xb7, 0x07, 0x40, 0x1e, 0x1d,
+		0x94, 0xe4, 0x18, 0x2c, 0x35, 0x0b, 0xca, 0xe5, 0x4a, 0x53, 0x65, 0x59, 0x14, 0x87, 0xf5, 0x32,
+		0xc8, 0xde, 0x82, 0x4b, 0x55, 0x9a, 0x24, 0x3a, 0x05, 0x27, 0xbc, 0xd0, 0xca, 0x73, 0xcd, 0x4a,
+		0xad, 0x41, 0x2b, 0x2f, 0xd4, 0x2e, 0x63, 0x60, 0x30, 0xc2, 0x4f, 0xe4, 0x6d, 0x82, 0x28, 0xaa,
+		0x9f, 0x5f, 0x65, 0xbd, 0x2c, 0x87, 0x46, 0xc1, 0xf5, 0x5a, 0xa5, 0x7e, 0x49, 0x0e, 0x8f, 0xd6,
+		0x4e, 0x73, 0x39, 0x11, 0x92, 0x83, 0xa3, 0xa3, 0x50, 0xb5, 0x52, 0x6b, 0x2a, 0xd7, 0xe5, 0xe8,
+		0x68, 0xc5, 0x8d, 0x8a, 0x72, 0xad, 0x5a, 0xaa, 0xc8, 0x31, 0x72, 0x14, 0x88, 0x5f, 0xa2, 0xe6,
+		0x95, 0x7a, 0x59, 0x8e, 0x4f, 0x9a, 0xb5, 0x88, 0xbc, 0x94, 0xff, 0x49, 0x09, 0x92, 0xde, 0xbc,
+		0x8a, 0xcf, 0xa9, 0x48, 0x6f, 0xb4, 0x09, 0x37, 0xff, 0xdb, 0x01, 0x48, 0x78, 0x12, 0x2c, 0xb8,
+		0x90, 0xd5, 0xba, 0x5d, 0xf3, 0x45, 0x55, 0xeb, 0x76, 0x34, 0x8b, 0xcf, 0x89, 0x40, 0x41, 0x05,
+		0x84, 0xcc, 0x3b, 0x07, 0xcd, 0x1f, 0xbe, 0x44, 0xee, 0x38, 0x7c, 0x89, 0xbe, 0x01, 0xc3, 0x97,
+		0xb0, 0x1c, 0xc9, 0xff, 0x6e, 0x00, 0xe4, 0xd1, 0x7c, 0xc9, 0x88, 0xde, 0xa4, 0x69, 0x7a, 0xf3,
+		0xb6, 0x2f, 0x70, 0x98, 0xf6, 0x8d, 0xce, 0xea, 0xc1, 0xa9, 0xb3, 0xfa, 0x84, 0xc9, 0x2a, 0xf4,
+		0x46, 0x9e, 0xac, 0xbc, 0xe6, 0xfa, 0x1f, 0x24, 0x48, 0xfb, 0xd3, 0x3b, 0x3e, 0x8d, 0xe5, 0x0f,
+		0xa3, 0x31, 0x7f, 0x8f, 0xdc, 0x33, 0xad, 0x47, 0xbe, 0x26, 0xed, 0xfa, 0xfe, 0x20, 0xa4, 0x7c,
+		0xf9, 0x9f, 0x79, 0xa5, 0x7b, 0x27, 0x2c, 0x76, 0xda, 0x7a, 0xaf, 0x6f, 0xda, 0x78, 0x22, 0x42,
+		0xed, 0xea, 0x37, 0xf5, 0x2e, 0x55, 0x43, 0x7a, 0xc2, 0xae, 0xaf, 0xaf, 0x86, 0xd5, 0xaa, 0x4b,
+		0xb7, 0x8e, 0x64, 0x6b, 0x4b, 0xd5, 0x72, 0x65, 0x63, 0xb3, 0xde, 0xac, 0xd4, 0x4a, 0xd7, 0x85,
+		0x27, 0x57, 0xe4, 0xce, 0x08, 0x9a, 0x4f, 0xe1, 0xf7, 0xbe, 0x31, 0x16, 0x9e, 0x9b, 0x20, 0x8f,
+		0xb6, 0x06, 0x1d, 0xfa, 0x84, 0xf6, 0xc8, 0x0b, 0x64, 0x09, 0x32, 0xb5, 0xba, 0xda, 0xa8, 0x96,
+		0x2b, 0x6a, 0xe5, 0xd2, 0xa5, 0x4a, 0xa9, 0xd9, 0x60, 0xbb, 0x17, 0x0e, 0x76, 0x53, 0x0e, 0x78,
+		0xfb, 0xe6, 0x07, 0x82, 0xb0, 0x34, 0x41, 0x12, 0x52, 0xe0, 0x69, 0x42, 0x96, 0xc7, 0x7c, 0x78,
+		0x1e, 0xe9, 0x57, 0x71, 0x85, 0xbf, 0xa9, 0x0d, 0x6c, 0x9e, 0x55, 0x7c, 0x10, 0x50, 0xbd, 0x86,
+		0x8d, 0x21, 0xfe, 0x80, 0xef, 0x0a, 0xb1, 0x10, 0x24, 0xe3, 0xc2, 0xd9, 0xc6, 0xd0, 0x9b, 0x81,
+		0xf4, 0x4d, 0xab, 0x63, 0x77, 0x6e, 0xe2, 0x01, 0x0d, 0xb1, 0x85, 0x84, 0x03, 0x37, 0xa4, 0xc8,
+		0xa2, 0xa4, 0x6a, 0xd8, 0x0e, 0xb6, 0xa1, 0xef, 0x6a, 0x23, 0xd8, 0xb8, 0x04, 0x09, 0x2a, 0xb2,
+		0x28, 0x71, 0xb0, 0xef, 0x81, 0x64, 0xdb, 0x1c, 0x62, 0x66, 0x86, 0xe1, 0xa1, 0x4b, 0x96, 0x94,
+		0x04, 0x83, 0x39, 0x28, 0x3c, 0x75, 0xe6, 0xee, 0x5d, 0x25, 0x95, 0x04, 0x83, 0x31, 0x94, 0x07,
+		0x20, 0xa3, 0xed, 0xee, 0x0e, 0x90, 0xb9, 0x60, 0xc4, 0x92, 0x81, 0x69, 0x07, 0x4c, 0x11, 0x73,
+		0x57, 0x21, 0x26, 0xf4, 0x80, 0x6b, 0x60, 0xd4, 0x84, 0xda, 0x67, 0xf9, 0xee, 0x00, 0x6e, 0x67,
+		0x19, 0xa2, 0xf0, 0x1e, 0x48, 0x76, 0x2c, 0xd5, 0x3d, 0x9e, 0x11, 0x58, 0x09, 0x9c, 0x8e, 0x29,
+		0x89, 0x8e, 0xe5, 0x6c, 0xb5, 0xe6, 0xbf, 0x23, 0x03, 0xe0, 0x1a, 0x1b, 0xf9, 0x2e, 0x09, 0xd2,
+		0x6c, 0x82, 0xe9, 0x0f, 0x74, 0x4b, 0x37, 0x5a, 0x62, 0x69, 0xf8, 0xe0, 0x01, 0x26, 0xca, 0xdc,
+		0xdc, 0x26, 0x27, 0x28, 0x3e, 0xfd, 0x3e, 0x49, 0x7a, 0x59, 0x0a, 0xbd, 0x2c, 0x49, 0x1f, 0x95,
+		0x52, 0x24, 0x56, 0x79, 0x6e, 0x73, 0xbd, 0x5a, 0xaa, 0x36, 0xb3, 0xef, 0x8d, 0xd2, 0xef, 0xea,
+		0x06, 0xff, 0x7e, 0x25, 0xea, 0x2f, 0x7f, 0x35, 0xfa, 0x33, 0x52, 0x30, 0xf6, 0x6a, 0x54, 0x49,
+		0xed, 0x78, 0xf9, 0x91, 0xae, 0xf7, 0x64, 0x47, 0x60, 0xda, 0x62, 0xd2, 0x95, 0xa6, 0xc2, 0xcf,
+		0x73, 0x14, 0x1f, 0xa4, 0x82, 0x44, 0xa8, 0x20, 0x09, 0x12, 0x29, 0xad, 0xd7, 0x1b, 0x95, 0x32,
+		0x15, 0x23, 0x4e, 0x42, 0xf5, 0xcd, 0x4a, 0x2d, 0xfb, 0x8a, 0xa8, 0xd2, 0x3d, 0x04, 0xf2, 0xb2,
+		0x04, 0xc7, 0xc4, 0xd6, 0x2d, 0x9f, 0x6b, 0x75, 0xa3, 0x65, 0xb6, 0x45, 0x74, 0x9b, 0x3e, 0xf7,
+		0xe8, 0x41, 0x95, 0x2b, 0x9c, 0x94, 0xaa, 0xa4, 0xc2, 0x09, 0x8b, 0x0f, 0x8f, 0xa9, 0xa4, 0x50,
+		0x2b, 0x73, 0x59, 0x12, 0x24, 0xb2, 0x59, 0x28, 0x3d, 0x53, 0x29, 0xbb, 0xd2, 0x1c, 0x19, 0x4c,
+		0xe2, 0x42, 0xbe, 0x09, 0x32, 0x98, 0x71, 0x45, 0xdb, 0xe8, 0xb4, 0xd9, 0x5e, 0x7a, 0x68, 0xda,
+		0x26, 0xac, 0x2b, 0x11, 0xa6, 0x60, 0xaf, 0x39, 0x14, 0xc5, 0x07, 0x3d, 0xa2, 0xc4, 0x49, 0xa8,
+		0x56, 0xaf, 0x55, 0x84, 0x18, 0x74, 0xdf, 0xf9, 0xba, 0x2b, 0x46, 0x7a, 0xe8, 0x23, 0x25, 0xdf,
+		0x04, 0xb2, 0x48, 0x11, 0x39, 0x2a, 0x09, 0x4f, 0xdb, 0x47, 0x76, 0x05, 0xe0, 0x89, 0x26, 0x47,
+		0x19, 0xf7, 0x7b, 0x24, 0x58, 0x26, 0x99, 0xf5, 0x4a, 0xed, 0x72, 0xf3, 0x8a, 0xba, 0xa9, 0x54,
+		0xe8, 0x76, 0x60, 0xf6, 0xbd, 0xa2, 0xfa, 0x4c, 0xcf, 0x4f, 0x48, 0xbe, 0x45, 0x82, 0x04, 0x0b,
+		0x81, 0x58, 0x5e, 0x8a, 0x25, 0x16, 0xee, 0x3f, 0xa8, 0x6e, 0x1a, 0x01, 0x51, 0xec, 0xe2, 0x45,
+		0x5a, 0x6d, 0x50, 0x18, 0xc4, 0x31, 0x42, 0xd6, 0x2b, 0x97, 0x0b, 0xa5, 0xeb, 0x6a, 0xb1, 0xd2,
+		0x68, 0xa2, 0x27, 0xab, 0x2b, 0xcc, 0x46, 0x81, 0x84, 0x0b, 0xeb, 0xeb, 0xf5, 0x67, 0x5d, 0x45,
+		0xc0, 0x0b, 0x0e, 0x1b, 0xf2, 0x13, 0x12, 0x2c, 0xeb, 0xc6, 0x8e, 0x89, 0xa7, 0xbd, 0x0c, 0x9a,
+		0xfd, 0x57, 0x2d, 0x7b, 0xbf, 0xcb, 0x46, 0xf4, 0xc4, 0x45, 0xb9, 0xd7, 0x32, 0x29, 0x5d, 0x8d,
+		0x92, 0x35, 0x90, 0xaa, 0x58, 0x7d, 0x9f, 0x14, 0x78, 0x19, 0x05, 0x0b, 0x50, 0xd9, 0x42, 0x2f,
+		0x4b, 0x61, 0x2a, 0x61, 0xf4, 0x65, 0x29, 0xf6, 0xb2, 0x14, 0xff, 0xa8, 0xb4, 0x48, 0x92, 0x8d,
+		0xe6, 0xf5, 0xf5, 0x8a, 0xca, 0xa4, 0xa5, 0x12, 0xa6, 0x49, 0x9c, 0xc2, 0xce, 0x3d, 0x72, 0xee,
+		0xf1, 0xec, 0xe7, 0xa9, 0x94, 0x9f, 0x8f, 0x2a, 0x44, 0x1f, 0x63, 0x4f, 0x7e, 0x51, 0x82, 0xe3,
+		0x62, 0xd3, 0xdc, 0xa2, 0x1b, 0x69, 0xaa, 0x67, 0xcb, 0x2d, 0x46, 0x45, 0xae, 0x1c, 0x24, 0xb2,
+		0xbb, 0xef, 0xc6, 0x81, 0xab, 0x7c, 0xc1, 0x3b, 0xba, 0x2d, 0x57, 0xbc, 0xc0, 0x5a, 0xf2, 0x51,
+		0x29, 0x43, 0xa0, 0xf2, 0xdc, 0x66, 0x5d, 0x69, 0xaa, 0x85, 0xf5, 0x75, 0x2a, 0xef, 0x11, 0x22,
+		0x73, 0x48, 0xb3, 0xbe, 0xa9, 0xae, 0x57, 0xae, 0x55, 0xd6, 0x5d, 0xb1, 0x8f, 0xb5, 0x27, 0x33,
+		0xcc, 0x7d, 0x44, 0x82, 0xc5, 0xb1, 0xea, 0xf3, 0xdf, 0x2c, 0xc1, 0xb1, 0x29, 0x22, 0x90, 0xfb,
+		0xe0, 0x9e, 0x72, 0xe5, 0x52, 0x61, 0x6b, 0xbd, 0xa9, 0x36, 0xae, 0x6f, 0x14, 0xeb, 0xeb, 0xea,
+		0xb5, 0x6a, 0xa3, 0x5a, 0xac, 0xae, 0x57, 0x9b, 0xde, 0x09, 0x2c, 0x0d, 0x1e, 0x01, 0xd9, 0x72,
+		0x6d, 0x54, 0x3c, 0x39, 0x80, 0x8b, 0xc2, 0xf5, 0x7a, 0xa9, 0xb0, 0x4e, 0x91, 0x82, 0x62, 0xcd,
+		0x59, 0x6a, 0xca, 0xa1, 0xab, 0xb1, 0x98, 0xc4, 0xe7, 0xb6, 0xaf, 0x87, 0x94, 0xcf, 0xf9, 0xe1,
+		0x12, 0x89, 0x2e, 0xad, 0xd0, 0x9e, 0x1b, 0x95, 0x5a, 0xc9, 0xbb, 0xa4, 0x4b, 0x82, 0xe3, 0xec,
+		0x64, 0x09, 0xbf, 0x84, 0x2b, 0x94, 0x03, 0x38, 0xa9, 0x72, 0x73, 0x74, 0xb6, 0xab, 0x83, 0xf9,
+		0x27, 0x20, 0x26, 0x9c, 0x19, 0x2e, 0xd4, 0xe8, 0x7a, 0x6b, 0x64, 0x99, 0x18, 0x03, 0xea, 0xc9,
+		0x64, 0x09, 0x05, 0x64, 0x1e, 0x4e, 0x0e, 0xe4, 0xaf, 0xc1, 0x91, 0x89, 0x8e, 0x88, 0xdc, 0x0b,
+		0xa7, 0xc4, 0x16, 0x39, 0x5b, 0x02, 0xaa, 0x95, 0x5a, 0xa9, 0x5e, 0xc6, 0x45, 0xb3, 0xcb, 0x13,
+		0x80, 0x7b, 0x24, 0x26, 0xa5, 0xf0, 0x56, 0x72, 0x20, 0x5f, 0x85, 0xb4, 0xdf, 0x9d, 0x90, 0x13,
+		0x70, 0x6c, 0xab, 0x79, 0xe9, 0x49, 0xf5, 0x5a, 0x61, 0xbd, 0x5a, 0x2e, 0x8c, 0x2c, 0x8f, 0x01,
+		0xb8, 0x4f, 0x91, 0x03, 0x28, 0x28, 0xfa, 0x1a, 0x39, 0x98, 0x0f, 0xc5, 0x24, 0x59, 0xca, 0x37,
+		0x20, 0x33, 0xe2, 0x18, 0xc8, 0x5d, 0x90, 0xe5, 0xeb, 0xd5, 0x49, 0x52, 0x2d, 0xc1, 0xa8, 0xab,
+		0x60, 0x2b, 0xf7, 0x72, 0x65, 0xbd, 0xba, 0x51, 0x6d, 0x52, 0xf9, 0xae, 0x00, 0xb8, 0x23, 0x1e,
+		0x23, 0x98, 0xab, 0x8d, 0x7a, 0x4d, 0xbd, 0x84, 0xcb, 0xfe, 0xa6, 0x87, 0x55, 0x1c, 0xd8, 0x08,
+		0x97, 0x25, 0x5c, 0x9d, 0x8e, 0xbb, 0x01, 0x39, 0x90, 0x7f, 0x16, 0xc8, 0xf8, 0x68, 0x25, 0x2b,
+		0x70, 0x57, 0xa5, 0x76, 0xa9, 0xae, 0x94, 0x2a, 0x6a, 0xad, 0xb0, 0x81, 0xf2, 0xb1, 0xb1, 0xe9,
+		0xb2, 0x4e, 0x81, 0x3b, 0x34, 0x45, 0x4e, 0xc2, 0x1d, 0xbd, 0x72, 0xe0, 0x4c, 0x04, 0x03, 0xa3,
+		0xf7, 0xd7, 0xce, 0x44, 0x62, 0xef, 0xaf, 0xc9, 0x1f, 0xc4, 0xff, 0x3f, 0x58, 0x93, 0xbf, 0xab,
+		0x76, 0x35, 0x12, 0x7b, 0x25, 0x2a, 0xbf, 0x1a, 0xcd, 0xff, 0x59, 0x10, 0x88, 0x3b, 0xfe, 0x9c,
+		0xd4, 0xda, 0x73, 0x10, 0x73, 0x72, 0x75, 0xec, 0x7c, 0xf3, 0x5b, 0x0e, 0x18, 0xb6, 0x82, 0xcc,
+		0x03, 0x1a, 0xc9, 0xdd, 0x39, 0xdc, 0x30, 0x31, 0xd3, 0xeb, 0x18, 0x9d, 0xde, 0xb0, 0xa7, 0x8a,
+		0x04, 0xd6, 0xcc, 0xc4, 0x0c, 0x27, 0xe0, 0xdf, 0x94, 0x85, 0x76, 0xcb, 0xc7, 0x22, 0x3c, 0x93,
+		0x05, 0x23, 0xe0, 0xdf, 0xb9, 0xbf, 0x91, 0x20, 0x3b, 0x4d, 0xd8, 0x3b, 0xca, 0xad, 0xd5, 0x60,
+		0xd9, 0xbc, 0xa9, 0x0f, 0x06, 0x9d, 0x36, 0xdd, 0x32, 0x73, 0x22, 0xee, 0xd0, 0xec, 0x88, 0x7b,
+		0xc9, 0x43, 0xc8, 0xc1, 0x16, 0x29, 0x62, 0x60, 0x74, 0x0b, 0x63, 0x02, 0xc1, 0x29, 0x3c, 0x9b,
+		0x53, 0x8a, 0x92, 0x08, 0x1e, 0x57, 0xd1, 0xf2, 0x71, 0x91, 0x1b, 0x90, 0x83, 0x6e, 0x58, 0x9f,
+		0xff, 0xd9, 0x00, 0xa4, 0xfd, 0xa7, 0x82, 0x49, 0x19, 0x62, 0x5d, 0x93, 0x1f, 0x97, 0x63, 0xbd,
+		0x7d, 0x7a, 0xc6, 0x41, 0xe2, 0xd5, 0x75, 0x8e, 0xaf, 0x38, 0x94, 0xb9, 0x7f, 0x27, 0x41, 0x4c,
+		0x80, 0xc9, 0x51, 0x08, 0xf5, 0x35, 0x7b, 0x8f, 0xb2, 0x0b, 0x17, 0x03, 0xb2, 0xa4, 0xd0, 0x6f,
+		0x84, 0x5b, 0x7d, 0x8d, 0x1d, 0x15, 0xe4, 0x70, 0xfc, 0xc6, 0xd0, 0xba, 0xab, 0x6b, 0x6d, 0xba,
+		0xd9, 0x6b, 0xf6, 0x7a, 0xba, 0x61, 0x5b, 0x22, 0xb4, 0xe6, 0xf0, 0x12, 0x07, 0xe3, 0x99, 0x73,
+		0x7b, 0xa0, 0x75, 0xba, 0x3e, 0xdc, 0x10, 0xc5, 0x95, 0x45, 0x81, 0x83, 0xbc, 0x06, 0xc7, 0x05,
+		0xdf, 0xb6, 0x6e, 0x6b, 0xad, 0x3d, 0xbd, 0xed, 0x12, 0x45, 0xe8, 0x29, 0x91, 0x63, 0x1c, 0xa1,
+		0xcc, 0xcb, 0x05, 0xed, 0xc8, 0x31, 0xfd, 0x4f, 0x07, 0x60, 0x51, 0x6c, 0x56, 0xb7, 0x1d, 0xd5,
+		0x6d, 0x00, 0x68, 0x86, 0x61, 0xda, 0x5e, 0xe5, 0x8d, 0xaf, 0x2d, 0xc6, 0xe8, 0x56, 0x0b, 0x0e,
+		0x91, 0xe2, 0x61, 0x90, 0xfb, 0x13, 0x09, 0xc0, 0x2d, 0x9a, 0xaa, 0xc5, 0x53, 0x90, 0xe0, 0x27,
+		0xc0, 0xe9, 0x0d, 0x04, 0x96, 0xcf, 0x05, 0x06, 0xc2, 0x6d, 0x6d, 0x4c, 0xf5, 0x6e, 0xeb, 0xbb,
+		0x1d, 0x83, 0x9f, 0xc7, 0x63, 0x1f, 0xe2, 0x4c, 0x4a, 0xc8, 0x3d, 0xaf, 0xaa, 0x40, 0xcc, 0xd2,
+		0x7b, 0x9a, 0x61, 0x77, 0x5a, 0x7c, 0x0c, 0x5d, 0x38, 0x94, 0xf0, 0xab, 0x0d, 0x4e, 0xad, 0x38,
+		0x7c, 0xf2, 0xa7, 0x21, 0x26, 0xa0, 0x8e, 0x1b, 0x5e, 0x20, 0x51, 0x08, 0x36, 0x2a, 0x38, 0x11,
+		0x51, 0x6f, 0x58, 0x2d, 0x34, 0xe4, 0xc0, 0x99, 0x5f, 0x0a, 0x40, 0x54, 0x0c, 0xea, 0x25, 0xc8,
+		0x54, 0xca, 0xd5, 0x11, 0x8f, 0xbe, 0x04, 0x69, 0x01, 0xe4, 0x1e, 0xed, 0xbd, 0x51, 0x2f, 0x70,
+		0x53, 0xa9, 0x37, 0xeb, 0xe7, 0xe4, 0x3f, 0x1e, 0x07, 0x3e, 0x26, 0xbf, 0x12, 0x25, 0x8b, 0x90,
+		0x14, 0xc0, 0x73, 0x8f, 0x9c, 0x7b, 0x4c, 0x7e, 0x75, 0x14, 0xf4, 0xb8, 0xfc, 0xf9, 0x28, 0x39,
+		0x02, 0xb2, 0x5b, 0x73, 0xa3, 0x59, 0xc0, 0xf3, 0x71, 0xdf, 0x59, 0x43, 0x77, 0x2e, 0xc0, 0x8f,
+		0xaa, 0x4d, 0xf4, 0xd6, 0xf5, 0xda, 0xfa, 0x75, 0x59, 0xf2, 0x16, 0x9c, 0xf3, 0x14, 0x04, 0xc8,
+		0xdd, 0x70, 0x4c, 0x14, 0x5c, 0xbc, 0x78, 0xf1, 0xe2, 0x13, 0x9e, 0xc2, 0xdb, 0x1f, 0x88, 0x8c,
+		0x16, 0x3f, 0xe9, 0x29, 0xfe, 0xa1, 0xf1, 0xe2, 0x8b, 0x9e, 0xe2, 0x1f, 0xfe, 0x40, 0x84, 0x2c,
+		0x41, 0x42, 0x14, 0x6f, 0x14, 0x9e, 0x93, 0xbf, 0xfc, 0xe5, 0x2f, 0x7f, 0x39, 0x7a, 0x66, 0x0b,
+		0xe4, 0xb1, 0xa8, 0x64, 0x19, 0x64, 0x5f, 0x18, 0x82, 0x5a, 0x5f, 0x18, 0x81, 0xd2, 0x48, 0x43,
+		0x96, 0x70, 0x96, 0xf7, 0x40, 0x59, 0x54, 0x22, 0x07, 0x8a, 0xdf, 0x04, 0x4b, 0x2d, 0xb3, 0x37,
+		0x6a, 0x08, 0x45, 0x79, 0xe4, 0xe4, 0x8d, 0x75, 0x45, 0x7a, 0xfe, 0x61, 0x8e, 0xb4, 0x6b, 0x76,
+		0x35, 0x63, 0x77, 0xd5, 0x1c, 0xec, 0xba, 0xf7, 0x6a, 0x70, 0x05, 0x65, 0x79, 0x6e, 0xd7, 0xf4,
+		0xb7, 0xff, 0x46, 0x92, 0x3e, 0x1a, 0x08, 0x5e, 0xde, 0x2c, 0xfe, 0x78, 0x20, 0x77, 0x99, 0x11,
+		0x6e, 0x0a, 0x33, 0x53, 0xf4, 0x9d, 0xae, 0xde, 0x42, 0x5b, 0x80, 0x3f, 0x7d, 0x08, 0x96, 0x77,
+		0xcd, 0x5d, 0x93, 0x72, 0x3a, 0x8b, 0x7f, 0x31, 0x21, 0x48, 0xdc, 0x81, 0xe6, 0x66, 0xde, 0xe2,
+		0x59, 0xab, 0xc1, 0x12, 0x47, 0x56, 0xe9, 0x82, 0x8e, 0x1d, 0x0e, 0x20, 0x07, 0x9e, 0x58, 0xcb,
+		0xfe, 0xf4, 0x1f, 0xd1, 0x4c, 0x9c, 0xb2, 0xc8, 0x49, 0xb1, 0x8c, 0x9d, 0x1f, 0x58, 0x53, 0xe0,
+		0x88, 0x8f, 0x1f, 0x5b, 0x4c, 0xeb, 0x83, 0x19, 0x1c, 0x7f, 0x8d, 0x73, 0x5c, 0xf2, 0x70, 0x6c,
+		0x70, 0xd2, 0xb5, 0x12, 0xa4, 0x0e, 0xc3, 0xeb, 0x5f, 0x71, 0x5e, 0x49, 0xdd, 0xcb, 0xe4, 0x32,
+		0x64, 0x28, 0x93, 0xd6, 0xd0, 0xb2, 0xcd, 0x1e, 0xcd, 0x54, 0x1c, 0xcc, 0xe6, 0x5f, 0xff, 0x11,
+		0x73, 0xad, 0x69, 0x24, 0x2b, 0x39, 0x54, 0x6b, 0x6b, 0x40, 0x17, 0xa6, 0x78, 0x0c, 0x7b, 0x06,
+		0x87, 0x5f, 0xe7, 0x82, 0x38, 0xf8, 0x6b, 0xd7, 0x70, 0x9d, 0x32, 0xec, 0xd1, 0x44, 0x82, 0x57,
+		0x92, 0xd9, 0x67, 0xd3, 0xb2, 0xbf, 0xfd, 0x1e, 0xe6, 0xbd, 0x97, 0x1c, 0x06, 0x1e, 0x99, 0x3c,
+		0xbd, 0xb8, 0xab, 0xdb, 0xb6, 0x3e, 0xb0, 0x54, 0xad, 0x3b, 0x49, 0x3c, 0xcf, 0x71, 0x9e, 0xec,
+		0xf7, 0x7f, 0xd1, 0xdf, 0x8b, 0x97, 0x19, 0x65, 0xa1, 0xdb, 0x5d, 0xdb, 0x82, 0x63, 0x13, 0xac,
+		0x62, 0x0e, 0x9e, 0x3f, 0xc0, 0x79, 0x2e, 0x8f, 0x59, 0x06, 0xb2, 0xdd, 0x04, 0x01, 0x77, 0xfa,
+		0x72, 0x0e, 0x9e, 0x1f, 0xe2, 0x3c, 0x09, 0xa7, 0x15, 0x5d, 0x8a, 0x1c, 0xaf, 0xc2, 0xe2, 0x4d,
+		0x7d, 0xb0, 0x6d, 0x5a, 0xfc, 0x08, 0xd5, 0x1c, 0xec, 0x7e, 0x90, 0xb3, 0xcb, 0x70, 0x42, 0x7a,
+		0xa6, 0x0a, 0x79, 0x5d, 0x84, 0xd8, 0x8e, 0xd6, 0xd2, 0xe7, 0x60, 0x71, 0x9b, 0xb3, 0x88, 0x22,
+		0x3e, 0x92, 0x16, 0x20, 0xb9, 0x6b, 0xf2, 0x5c, 0xd2, 0x6c, 0xf2, 0x1f, 0xe2, 0xe4, 0x09, 0x41,
+		0xc3, 0x59, 0xf4, 0xcd, 0xfe, 0xb0, 0x8b, 0x89, 0xa6, 0xd9, 0x2c, 0x7e, 0x58, 0xb0, 0x10, 0x34,
+		0x9c, 0xc5, 0x21, 0xd4, 0xfa, 0x61, 0xc1, 0xc2, 0xf2, 0xe8, 0xf3, 0x69, 0x3c, 0xaa, 0xdd, 0xdd,
+		0x37, 0x8d, 0x79, 0x84, 0xf8, 0x08, 0xe7, 0x00, 0x9c, 0x04, 0x19, 0x3c, 0x05, 0xf1, 0x79, 0x3b,
+		0xe2, 0x47, 0xbf, 0x28, 0x86, 0x87, 0xe8, 0x81, 0xcb, 0x90, 0x11, 0x0e, 0x0a, 0xf7, 0x24, 0x67,
+		0xb3, 0xf8, 0x18, 0x67, 0x91, 0xf6, 0x90, 0xf1, 0x66, 0xd8, 0xba, 0x65, 0xef, 0xea, 0xf3, 0x30,
+		0xf9, 0x87, 0xa2, 0x19, 0x9c, 0x84, 0xab, 0x72, 0x5b, 0x37, 0x5a, 0x7b, 0xf3, 0x71, 0xf8, 0xb8,
+		0x50, 0xa5, 0xa0, 0x41, 0x16, 0x25, 0x48, 0xf5, 0xb4, 0x81, 0xb5, 0xa7, 0x75, 0xe7, 0xea, 0x8e,
+		0x1f, 0xe3, 0x3c, 0x92, 0x0e, 0x11, 0xd7, 0xc8, 0xd0, 0x38, 0x0c, 0x9b, 0x1f, 0x17, 0x1a, 0x19,
+		0x1a, 0x3e, 0x46, 0x9b, 0xb0, 0x6c, 0xd9, 0x34, 0xea, 0x3e, 0x0c, 0xb7, 0x9f, 0x10, 0x43, 0x8f,
+		0xd1, 0x6e, 0x78, 0x39, 0x3e, 0x05, 0x71, 0xab, 0xf3, 0xae, 0xb9, 0xd8, 0xfc, 0xa4, 0xe8, 0x69,
+		0x4a, 0x80, 0xc4, 0xd7, 0xe1, 0xf8, 0xc4, 0x69, 0x62, 0x0e, 0x66, 0x3f, 0xc5, 0x99, 0x1d, 0x9d,
+		0x30, 0x55, 0x70, 0x97, 0x70, 0x58, 0x96, 0xff, 0x48, 0xb8, 0x04, 0x7d, 0x84, 0xd7, 0x26, 0x66,
+		0xf7, 0x2d, 0x6d, 0xe7, 0x70, 0x5a, 0xfb, 0xc7, 0x42, 0x6b, 0x8c, 0xd6, 0xa7, 0xb5, 0x26, 0x1c,
+		0xe5, 0x1c, 0x0f, 0xd7, 0xaf, 0x9f, 0x10, 0x8e, 0x95, 0x51, 0x6f, 0xf9, 0x7b, 0xf7, 0xeb, 0x20,
+		0xe7, 0xa8, 0x53, 0xa4, 0x91, 0x2d, 0x15, 0x0f, 0x62, 0xcd, 0xe6, 0xfc, 0xd3, 0x9c, 0xb3, 0xf0,
+		0xf8, 0x4e, 0x1e, 0xda, 0xda, 0xd0, 0xfa, 0xc8, 0xfc, 0x39, 0xc8, 0x0a, 0xe6, 0x43, 0x63, 0xa0,
+		0xb7, 0xcc, 0x5d, 0xa3, 0xf3, 0x2e, 0xbd, 0x3d, 0x07, 0xeb, 0x9f, 0x19, 0xe9, 0xaa, 0x2d, 0x0f,
+		0x39, 0x72, 0xae, 0x82, 0xec, 0xc4, 0x2a, 0x6a, 0xa7, 0x47, 0xb7, 0xdc, 0x0e, 0xe6, 0xf8, 0x49,
+		0xd1, 0x53, 0x0e, 0x5d, 0x95, 0x92, 0xad, 0x55, 0x80, 0xdd, 0xea, 0x98, 0xd7, 0x24, 0x3f, 0xc5,
+		0x19, 0xa5, 0x5c, 0x2a, 0xee, 0x38, 0x5a, 0x66, 0xaf, 0xaf, 0x0d, 0xe6, 0xf1, 0x7f, 0x3f, 0x2b,
+		0x1c, 0x07, 0x27, 0xe1, 0x8e, 0x03, 0x23, 0x3a, 0x9c, 0xed, 0xe7, 0xe0, 0xf0, 0x73, 0xc2, 0x71,
+		0x08, 0x1a, 0xce, 0x42, 0x04, 0x0c, 0x73, 0xb0, 0xf8, 0x79, 0xc1, 0x42, 0xd0, 0x20, 0x8b, 0xb7,
+		0xbb, 0x13, 0xed, 0x40, 0xdf, 0xed, 0x58, 0x36, 0xbf, 0x77, 0x75, 0x30, 0xab, 0x5f, 0xf8, 0xa2,
+		0x3f, 0x08, 0x53, 0x3c, 0xa4, 0xe8, 0x89, 0x78, 0xee, 0x97, 0xee, 0x6d, 0xcc, 0x16, 0xec, 0x17,
+		0x85, 0x27, 0xf2, 0x90, 0xa1, 0x6c, 0x9e, 0x08, 0x11, 0xd5, 0xde, 0xc2, 0xe5, 0xe4, 0x1c, 0xec,
+		0xfe, 0xc9, 0x88, 0x70, 0x0d, 0x41, 0x8b, 0x3c, 0x3d, 0xf1, 0xcf, 0xd0, 0xb8, 0xa1, 0xef, 0xcf,
+		0x65, 0x9d, 0xff, 0x74, 0x24, 0xfe, 0xd9, 0x62, 0x94, 0xcc, 0x87, 0x64, 0x46, 0xe2, 0x29, 0x32,
+		0xeb, 0x5e, 0x67, 0xf6, 0x9b, 0x5f, 0xe3, 0xed, 0xf5, 0x87, 0x53, 0x6b, 0xeb, 0x20, 0x73, 0x88,
+		0x1b, 0xc0, 0xce, 0x64, 0xf6, 0x9e, 0xd7, 0x1c, 0x3b, 0xf7, 0xc5, 0x3c, 0x6b, 0x97, 0x20, 0xe5,
+		0x0b, 0x78, 0x66, 0xb3, 0x7a, 0x2f, 0x67, 0x95, 0xf4, 0xc6, 0x3b, 0x6b, 0xe7, 0x21, 0x84, 0xc1,
+		0xcb, 0x6c, 0xf2, 0x6f, 0xe5, 0xe4, 0x14, 0x7d, 0xed, 0xad, 0x10, 0x13, 0x41, 0xcb, 0x6c, 0xd2,
+		0xff, 0x9f, 0x93, 0x3a, 0x24, 0x48, 0x2e, 0x02, 0x96, 0xd9, 0xe4, 0x7f, 0x4f, 0x90, 0x0b, 0x12,
+		0x24, 0x9f, 0x5f, 0x85, 0xbf, 0xf2, 0xfe, 0x10, 0x23, 0x17, 0x24, 0x6b, 0x78, 0x25, 0x84, 0x45,
+		0x2a, 0xb3, 0xa9, 0xbf, 0x8d, 0x57, 0x2e, 0x28, 0xd6, 0x9e, 0x80, 0xf0, 0x9c, 0x0a, 0xff, 0x00,
+		0x27, 0x65, 0xf8, 0x6b, 0x25, 0x48, 0x78, 0xa2, 0x93, 0xd9, 0xe4, 0xdf, 0xc1, 0xc9, 0xbd, 0x54,
+		0x28, 0x3a, 0x8f, 0x4e, 0x66, 0x33, 0xf8, 0x4e, 0x21, 0x3a, 0xa7, 0x40, 0xb5, 0x89, 0xc0, 0x64,
+		0x36, 0xf5, 0x07, 0x85, 0xd6, 0x05, 0xc9, 0xda, 0xd3, 0x10, 0x77, 0x26, 0x9b, 0xd9, 0xf4, 0xdf,
+		0xc5, 0xe9, 0x5d, 0x1a, 0xd4, 0xc0, 0xd0, 0x38, 0x04, 0x8b, 0xbf, 0x2f, 0x34, 0xe0, 0xa1, 0xc2,
+		0x61, 0x34, 0x1a, 0xc0, 0xcc, 0xe6, 0xf4, 0xdd, 0x62, 0x18, 0x8d, 0xc4, 0x2f, 0xd8, 0x9b, 0xd4,
+		0xe7, 0xcf, 0x66, 0xf1, 0x3d, 0xa2, 0x37, 0x29, 0x3e, 0x8a, 0x31, 0x1a, 0x11, 0xcc, 0xe6, 0xf1,
+		0x7d, 0x42, 0x8c, 0x91, 0x80, 0x60, 0x6d, 0x13, 0xc8, 0x78, 0x34, 0x30, 0x9b, 0xdf, 0xcb, 0x9c,
+		0xdf, 0xe2, 0x58, 0x30, 0xb0, 0xf6, 0x2c, 0x1c, 0x9d, 0x1c, 0x09, 0xcc, 0xe6, 0xfa, 0xfd, 0xaf,
+		0x8d, 0xac, 0xdd, 0xbc, 0x81, 0xc0, 0x5a, 0x13, 0x96, 0x27, 0x45, 0x01, 0xb3, 0xd9, 0xfe, 0xc0,
+		0x6b, 0x7e, 0xc7, 0xed, 0x0d, 0x02, 0xd6, 0x0a, 0x00, 0xee, 0x04, 0x3c, 0x9b, 0xd7, 0x0f, 0x72,
+		0x5e, 0x1e, 0x22, 0x1c, 0x1a, 0x7c, 0xfe, 0x9d, 0x4d, 0x7f, 0x5b, 0x0c, 0x0d, 0x4e, 0x81, 0x43,
+		0x43, 0x4c, 0xbd, 0xb3, 0xa9, 0x7f, 0x48, 0x0c, 0x0d, 0x41, 0x82, 0x96, 0xed, 0x99, 0xdd, 0x66,
+		0x73, 0xf8, 0x88, 0xb0, 0x6c, 0x0f, 0xd5, 0x5a, 0x0d, 0x16, 0xc7, 0x26, 0xc4, 0xd9, 0xac, 0x3e,
+		0xca, 0x59, 0xc9, 0xa3, 0xf3, 0xa1, 0x77, 0xf2, 0xe2, 0x93, 0xe1, 0x6c, 0x6e, 0x3f, 0x32, 0x32,
+		0x79, 0xf1, 0xb9, 0x70, 0xed, 0x29, 0x88, 0x19, 0xc3, 0x6e, 0x17, 0x07, 0x0f, 0x39, 0xf8, 0xde,
+		0x6d, 0xf6, 0xf3, 0x5f, 0xe2, 0xda, 0x11, 0x04, 0x6b, 0xe7, 0x21, 0xac, 0xf7, 0xb6, 0xf5, 0xf6,
+		0x2c, 0xca, 0x2f, 0x7c, 0x49, 0x38, 0x4c, 0xc4, 0x5e, 0x7b, 0x1a, 0x80, 0xa5, 0x46, 0xe8, 0x51,
+		0xf3, 0x19, 0xb4, 0x7f, 0xf2, 0x25, 0x7e, 0x2f, 0xcd, 0x25, 0x71, 0x19, 0xb0, 0x5b, 0x6e, 0x07,
+		0x33, 0xf8, 0xa2, 0x9f, 0x01, 0xed, 0x91, 0x8b, 0x10, 0xc5, 0xdd, 0x65, 0x5b, 0xdb, 0x9d, 0x45,
+		0xfd, 0xa7, 0x9c, 0x5a, 0xe0, 0xa3, 0xc2, 0x7a, 0xe6, 0x40, 0xb7, 0xb5, 0x5d, 0x6b, 0x16, 0xed,
+		0x7f, 0xe5, 0xb4, 0x0e, 0x01, 0x12, 0xb7, 0x34, 0xcb, 0x9e, 0xa7, 0xdd, 0x7f, 0x26, 0x88, 0x05,
+		0x01, 0x0a, 0x8d, 0x7f, 0xdf, 0xd0, 0xf7, 0x67, 0xd1, 0xfe, 0xb9, 0x10, 0x9a, 0xe3, 0xaf, 0xbd,
+		0x15, 0xe2, 0xf8, 0x27, 0xbb, 0xbd, 0x3a, 0x83, 0xf8, 0x2f, 0x38, 0xb1, 0x4b, 0x81, 0x35, 0x5b,
+		0x76, 0xdb, 0xee, 0xcc, 0x56, 0xf6, 0x5f, 0xf2, 0x9e, 0x16, 0xf8, 0x6b, 0x05, 0x48, 0x58, 0x76,
+		0xbb, 0x3d, 0xe4, 0xf1, 0xe9, 0x0c, 0xf2, 0xbf, 0xfa, 0x92, 0x93, 0xb2, 0x70, 0x68, 0xb0, 0xb7,
+		0x5f, 0xbc, 0x61, 0xf7, 0x4d, 0x7a, 0x30, 0x69, 0x16, 0x87, 0xd7, 0x38, 0x07, 0x0f, 0xc9, 0x5a,
+		0x09, 0x92, 0xd8, 0x16, 0x71, 0xbe, 0x63, 0x16, 0x8b, 0xbf, 0xe6, 0x0a, 0xf0, 0x11, 0x15, 0xbf,
+		0xf1, 0xd7, 0x3f, 0x77, 0x52, 0xfa, 0xf4, 0xe7, 0x4e, 0x4a, 0xff, 0xe5, 0x73, 0x27, 0xa5, 0x0f,
+		0xfe, 0xe1, 0xc9, 0x85, 0x4f, 0xff, 0xe1, 0xc9, 0x85, 0xdf, 0xfd, 0xc3, 0x93, 0x0b, 0x93, 0xb3,
+		0xc4, 0x70, 0xd9, 0xbc, 0x6c, 0xb2, 0xfc, 0xf0, 0xf3, 0xf7, 0xed, 0x76, 0xec, 0xbd, 0xe1, 0xf6,
+		0x6a, 0xcb, 0xec, 0x9d, 0x6d, 0x99, 0x56, 0xcf, 0xb4, 0xce, 0xfa, 0xf3, 0xba, 0xf4, 0x2f, 0xf8,
+		0x5f, 0x12, 0x1c, 0x67, 0x6c, 0xdc, 0x74, 0xae, 0x66, 0xec, 0x4f, 0x7b, 0x8d, 0xe9, 0x02, 0x04,
+		0x0b, 0xc6, 0x3e, 0x39, 0xce, 0x1c, 0x9c, 0x3a, 0x1c, 0x74, 0xf9, 0x8d, 0xc7, 0x28, 0x7e, 0x6f,
+		0x0d, 0xba, 0xfe, 0x93, 0xee, 0x49, 0x7e, 0xd2, 0xbd, 0xf8, 0x1d, 0xd2, 0xe1, 0x5a, 0x12, 0x2b,
+		0x18, 0xfb, 0xb4, 0x21, 0x9b, 0xd2, 0xf3, 0x6f, 0x9e, 0x99, 0xe7, 0xbe, 0x61, 0x98, 0x2f, 0x1a,
+		0x28, 0x76, 0x7f, 0x5b, 0xe4, 0xb8, 0x4f, 0x8e, 0xe6, 0xb8, 0x9f, 0xd5, 0xbb, 0xdd, 0x67, 0x10,
+		0x0f, 0xf7, 0xd8, 0xad, 0xed, 0x08, 0xbb, 0xad, 0x0f, 0xdf, 0x1d, 0x80, 0x93, 0x63, 0xe9, 0x6c,
+		0x6e, 0x04, 0xd3, 0x94, 0xb0, 0x06, 0xb1, 0xb2, 0xb0, 0xad, 0x2c, 0x3e, 0x68, 0xd4, 0x32, 0x8d,
+		0x36, 0x3b, 0xb2, 0x1c, 0x54, 0xc4, 0x27, 0x2a, 0xc2, 0xd0, 0x0c, 0xd3, 0xe2, 0x37, 0x88, 0xd9,
+		0x47, 0xf1, 0x43, 0x87, 0x54, 0x44, 0x4a, 0xd4, 0x24, 0xb4, 0xf1, 0xe8, 0x9c, 0xda, 0x10, 0x8d,
+		0xf0, 0x65, 0xfe, 0xe7, 0xd5, 0xca, 0xf7, 0x05, 0xe0, 0xd4, 0xa8, 0x56, 0x70, 0x64, 0x59, 0xb6,
+		0xd6, 0xeb, 0x4f, 0x53, 0xcb, 0x53, 0x10, 0x6f, 0x0a, 0x9c, 0x43, 0xeb, 0xe5, 0xf6, 0x21, 0xf5,
+		0x92, 0x76, 0xaa, 0x12, 0x8a, 0x39, 0x37, 0xa7, 0x62, 0x9c, 0x76, 0xdc, 0x91, 0x66, 0x3e, 0x14,
+		0x87, 0xe3, 0x6c, 0x38, 0xa9, 0x6c, 0x28, 0xb1, 0x0f, 0xae, 0x93, 0xa4, 0xb7, 0x68, 0xf6, 0x3e,
+		0x49, 0xfe, 0x19, 0x58, 0xaa, 0xa2, 0xb7, 0xc0, 0x55, 0x90, 0xbb, 0xc3, 0x33, 0xf1, 0x5a, 0xf5,
+		0x8a, 0x2f, 0xe0, 0xe7, 0xfb, 0x8e, 0x5e, 0x10, 0x9e, 0x97, 0x91, 0x1b, 0x2d, 0xad, 0xab, 0x0d,
+		0xbe, 0x52, 0x56, 0xe4, 0x09, 0x00, 0x76, 0x20, 0xce, 0x79, 0xa4, 0x09, 0x77, 0xe2, 0xbd, 0x8d,
+		0x5b, 0x65, 0x35, 0xd1, 0xeb, 0x46, 0x71, 0x8a, 0x8b, 0x7f, 0x9e, 0x79, 0x0e, 0xc0, 0x2d, 0xc0,
+		0x23, 0x23, 0x8d, 0x52, 0x61, 0xbd, 0xa0, 0x88, 0xb3, 0x2c, 0x8d, 0xcd, 0x4a, 0x89, 0x3d, 0x78,
+		0xb2, 0x80, 0xe7, 0x31, 0xbc, 0x85, 0xce, 0x15, 0x8d, 0x23, 0xb0, 0xe8, 0x85, 0xb3, 0xd7, 0x27,
+		0x02, 0x6b, 0x57, 0x30, 0x53, 0x80, 0x87, 0x72, 0x55, 0xad, 0xdd, 0xd6, 0xdb, 0x6a, 0xc7, 0x20,
+		0x33, 0x2e, 0x86, 0x67, 0x7f, 0xe3, 0x77, 0xc2, 0xb4, 0x69, 0x29, 0x46, 0x58, 0x40, 0xba, 0xaa,
+		0x81, 0x31, 0x67, 0xa7, 0xd7, 0xef, 0xea, 0x74, 0xa7, 0x59, 0xed, 0x08, 0xfd, 0xcf, 0x0e, 0x67,
+		0x90, 0x1f, 0xee, 0x5a, 0x2f, 0xb9, 0xe4, 0x4e, 0xef, 0xad, 0x3d, 0xe3, 0x1e, 0x99, 0x73, 0x04,
+		0x9c, 0xc9, 0xf1, 0x37, 0xb9, 0x84, 0x22, 0x9b, 0x21, 0x44, 0x5c, 0x87, 0x45, 0xbc, 0x4d, 0xd9,
+		0xf7, 0xc9, 0x37, 0x63, 0x06, 0x11, 0xad, 0x95, 0x39, 0xa5, 0x2b, 0xda, 0x13, 0x10, 0xb1, 0x68,
+		0xa7, 0xcc, 0x62, 0x21, 0xc4, 0xe1, 0xe8, 0x98, 0xfe, 0x62, 0x66, 0xe0, 0xb4, 0x68, 0x06, 0x83,
+		0x7f, 0xc3, 0x19, 0x24, 0x29, 0x99, 0x68, 0x8d, 0x01, 0x8b, 0xec, 0x79, 0x21, 0xdd, 0xd3, 0x9a,
+		0x83, 0xb3, 0x28, 0xff, 0xec, 0x93, 0x8f, 0xd0, 0xfd, 0xfc, 0x7b, 0xfc, 0x46, 0x37, 0x61, 0xb0,
+		0x28, 0x32, 0xe7, 0xed, 0xb6, 0x57, 0x87, 0xb4, 0xa8, 0x8f, 0xb7, 0xfb, 0xe0, 0xca, 0x7e, 0x89,
+		0x57, 0x76, 0x72, 0x92, 0x85, 0x7b, 0x6a, 0x4a, 0x71, 0xae, 0xac, 0x60, 0xad, 0x08, 0x29, 0x3c,
+		0x02, 0xe0, 0x2a, 0xe7, 0xe0, 0x5a, 0x7e, 0xf9, 0x93, 0x8f, 0xb0, 0x81, 0x86, 0x44, 0x5c, 0x35,
+		0xc5, 0xca, 0x34, 0xaf, 0xf7, 0xfc, 0x43, 0xe3, 0xf3, 0x37, 0xfb, 0xef, 0x61, 0xca, 0xfd, 0x29,
+		0xaf, 0xa8, 0xae, 0x77, 0x0a, 0xc1, 0x22, 0x1e, 0x62, 0x32, 0xcf, 0xd2, 0x7f, 0xb9, 0x57, 0x0a,
+		0xd3, 0x8f, 0x39, 0xb6, 0x6d, 0x2f, 0x30, 0x67, 0x31, 0xdb, 0x6e, 0xff, 0xe2, 0xdb, 0x3f, 0x16,
+		0x76, 0x1d, 0xca, 0xda, 0xc6, 0xf8, 0x71, 0xd1, 0xd9, 0x3c, 0xfe, 0x52, 0xf0, 0x18, 0x3d, 0xfd,
+		0xb9, 0xf6, 0x16, 0xdc, 0x0b, 0xe5, 0x6c, 0x66, 0xc5, 0x6e, 0x82, 0x89, 0x43, 0x81, 0x91, 0x1b,
+		0x33, 0xda, 0x79, 0xe2, 0xf4, 0xd7, 0x04, 0x3d, 0xf3, 0x61, 0x78, 0xd8, 0x7a, 0xed, 0x32, 0xa4,
+		0xdb, 0xa6, 0x61, 0xab, 0x66, 0xaf, 0x63, 0xeb, 0xbd, 0xbe, 0x3d, 0x33, 0xf2, 0xfd, 0x6b, 0xc6,
+		0x24, 0xa6, 0xa4, 0x90, 0xae, 0x2e, 0xc8, 0x50, 0x12, 0xf6, 0x42, 0xd1, 0x3c, 0x92, 0xfc, 0x37,
+		0x47, 0x12, 0x4a, 0x83, 0x92, 0xdc, 0x91, 0x75, 0x58, 0xed, 0x1b, 0x7c, 0xba, 0xb3, 0x6f, 0x31,
+		0x2b, 0x70, 0xac, 0xe3, 0x5f, 0x84, 0xe0, 0x24, 0x47, 0xde, 0xd6, 0x2c, 0xfd, 0xec, 0xcd, 0x47,
+		0xb7, 0x75, 0x5b, 0x7b, 0xf4, 0x6c, 0xcb, 0xec, 0x88, 0x58, 0x67, 0x89, 0x95, 0xaf, 0x62, 0xf9,
+		0x2a, 0x2f, 0xcf, 0x4d, 0x3c, 0x10, 0x90, 0x9b, 0x3e, 0x0d, 0xe6, 0xc6, 0x6d, 0x30, 0xdf, 0x85,
+		0x50, 0xc9, 0xec, 0xd0, 0x8b, 0x90, 0x6d, 0xdd, 0x30, 0x7b, 0x7c, 0x42, 0x62, 0x1f, 0xe4, 0x0a,
+		0x44, 0xb4, 0x9e, 0x39, 0x34, 0x6c, 0x36, 0x19, 0x15, 0x1f, 0xf9, 0xf5, 0xcf, 0x9e, 0x5a, 0xf8,
+		0xbd, 0xcf, 0x9e, 0x3a, 0xc2, 0xd8, 0x5a, 0xed, 0x1b, 0xab, 0x1d, 0xf3, 0x6c, 0x4f, 0xb3, 0xf7,
+		0xd0, 0x05, 0x7c, 0xe6, 0x53, 0x0f, 0x03, 0xaf, 0xaf, 0x6a, 0xd8, 0x1f, 0x7f, 0xe5, 0x13, 0x67,
+		0x24, 0x85, 0xd3, 0xaf, 0x85, 0x5e, 0xfd, 0xf0, 0x29, 0x29, 0xdf, 0x87, 0x68, 0x59, 0x6f, 0x1d,
+		0x50, 0x61, 0x75, 0xa4, 0xc2, 0x47, 0x79, 0x85, 0x27, 0xc6, 0x2b, 0x64, 0x17, 0xac, 0xca, 0x7a,
+		0xcb, 0x53, 0x6d, 0x59, 0x6f, 0x8d, 0xd4, 0x58, 0x85, 0x58, 0xd5, 0xb0, 0xd9, 0xab, 0x28, 0x6f,
+		0x85, 0x60, 0xc7, 0x60, 0x97, 0xe9, 0xe3, 0xc5, 0x87, 0x0e, 0xd1, 0x14, 0x05, 0xe9, 0xf2, 0x75,
+		0x88, 0x95, 0xf5, 0x16, 0x63, 0x55, 0x82, 0x60, 0x5b, 0x6f, 0x65, 0xa5, 0x3b, 0x15, 0x12, 0xa9,
+		0x8b, 0xe5, 0xdf, 0xfd, 0x83, 0x93, 0x0b, 0xef, 0xfe, 0xdc, 0xc9, 0x85, 0xa9, 0xe6, 0x94, 0x9f,
+		0x6d, 0x4e, 0x8e, 0x15, 0x7d, 0x2c, 0x04, 0x77, 0xd3, 0x77, 0xba, 0x06, 0xbd, 0x8e, 0x61, 0x9f,
+		0x6d, 0x0d, 0xf6, 0xfb, 0xb6, 0x89, 0x4e, 0xc5, 0xdc, 0xe1, 0x46, 0xb4, 0xe8, 0x16, 0xaf, 0xb2,
+		0xe2, 0xc9, 0x26, 0x94, 0xdf, 0x81, 0xf0, 0x26, 0xd2, 0x61, 0x27, 0xd9, 0xa6, 0xad, 0x75, 0x79,
+		0xac, 0xc8, 0x3e, 0x10, 0xca, 0xde, 0xf6, 0x0a, 0x30, 0x68, 0x47, 0x3c, 0xeb, 0xd5, 0xd5, 0xb5,
+		0x1d, 0xf6, 0xa2, 0x49, 0x90, 0x2e, 0x32, 0x62, 0x08, 0xa0, 0x8f, 0x97, 0x2c, 0x43, 0x58, 0x1b,
+		0xb2, 0x43, 0x69, 0x41, 0x5c, 0x7d, 0xd0, 0x8f, 0xfc, 0x33, 0x10, 0xe5, 0xa7, 0x1e, 0xf0, 0x1c,
+		0xd6, 0x0d, 0x7d, 0x9f, 0xd6, 0x93, 0x54, 0xf0, 0x4f, 0xb2, 0x0a, 0x61, 0x2a, 0x3c, 0xbf, 0x44,
+		0x96, 0x5d, 0x1d, 0x93, 0x7e, 0x95, 0x0a, 0xa9, 0x30, 0xb4, 0xfc, 0x55, 0x88, 0x95, 0x4d, 0xb4,
+		0x6c, 0x3f, 0xb7, 0x38, 0xe3, 0x46, 0x65, 0xee, 0x0f, 0x6d, 0x71, 0xd1, 0x97, 0x7e, 0xe0, 0xd5,
+		0x79, 0xf6, 0xc2, 0x0d, 0x3f, 0x58, 0xc7, 0xbf, 0xf2, 0x25, 0x88, 0x52, 0xde, 0xf5, 0xbe, 0xf3,
+		0x0e, 0x9d, 0xe4, 0x79, 0x87, 0x8e, 0xb3, 0x0f, 0xb8, 0xc2, 0x12, 0x08, 0xb5, 0x35, 0x5b, 0xe3,
+		0xed, 0xa6, 0x7f, 0xe7, 0xdf, 0x06, 0x31, 0xce, 0xc4, 0x22, 0xe7, 0x20, 0x68, 0xf6, 0xc5, 0xb9,
+		0xd1, 0xdc, 0xb4, 0xa6, 0xd4, 0xfb, 0xc5, 0x10, 0xda, 0x92, 0x82, 0xc8, 0xc5, 0xda, 0x54, 0xb3,
+		0x78, 0xdc, 0x67, 0x16, 0x3d, 0xdd, 0xde, 0xde, 0xb1, 0xdd, 0x3f, 0x58, 0x77, 0x8e, 0x99, 0x82,
+		0x63, 0x28, 0xb7, 0x03, 0x70, 0xd2, 0x53, 0x7a, 0x53, 0x1f, 0x60, 0xda, 0x8f, 0x59, 0x13, 0xb7,
+		0x14, 0xe2, 0x11, 0x90, 0x97, 0x4f, 0x31, 0x95, 0xb7, 0x42, 0xb0, 0xd0, 0xef, 0xe3, 0xab, 0x71,
+		0xf4, 0xbb, 0x65, 0x32, 0x5b, 0x09, 0x29, 0xce, 0x37, 0x96, 0x59, 0xe6, 0x8e, 0xfd, 0xa2, 0x36,
+		0x70, 0x5e, 0x94, 0x13, 0xdf, 0xf9, 0x8b, 0x10, 0x2f, 0x99, 0x86, 0xa5, 0x1b, 0xd6, 0x90, 0xae,
+		0x40, 0xb6, 0xbb, 0x66, 0xeb, 0x06, 0xe7, 0xc0, 0x3e, 0x50, 0xd9, 0x5a, 0xbf, 0x4f, 0x29, 0x43,
+		0x0a, 0xfe, 0xc9, 0x46, 0x76, 0xb1, 0x3e, 0x55, 0x3d, 0xe7, 0x0f, 0xa7, 0x1e, 0xde, 0x40, 0x47,
+		0x3f, 0xff, 0x43, 0x82, 0xbb, 0xc6, 0x07, 0xd2, 0x0d, 0x7d, 0xdf, 0x3a, 0xec, 0x38, 0x7a, 0x0e,
+		0xe2, 0x9b, 0xf4, 0x1d, 0xe1, 0x67, 0xf4, 0x7d, 0x92, 0xc3, 0x23, 0xb4, 0xe7, 0xce, 0x9f, 0x7f,
+		0xf4, 0x22, 0xb3, 0xf2, 0x2b, 0x0b, 0x8a, 0x00, 0x90, 0x93, 0x10, 0xb7, 0xf4, 0x56, 0xff, 0xdc,
+		0xf9, 0x0b, 0x37, 0x1e, 0x65, 0x66, 0x75, 0x65, 0x41, 0x71, 0x41, 0x6b, 0x31, 0x6c, 0xf1, 0xab,
+		0x1f, 0x39, 0x25, 0x15, 0xc3, 0x10, 0xb4, 0x86, 0xbd, 0xaf, 0x9a, 0x6d, 0xfc, 0x79, 0x04, 0x56,
+		0x3c, 0xa5, 0x6c, 0xc2, 0xe2, 0xb7, 0x5d, 0x9c, 0xb7, 0xa0, 0x65, 0x4f, 0xfb, 0x29, 0xc6, 0x94,
+		0x99, 0xe8, 0x40, 0x2d, 0xe6, 0x7f, 0x46, 0x82, 0xe4, 0x35, 0xc1, 0x19, 0x6f, 0x3e, 0x3d, 0x05,
+		0xe0, 0xd4, 0x24, 0x86, 0xca, 0x89, 0xd5, 0xd1, 0xba, 0x56, 0x1d, 0x1a, 0xc5, 0x83, 0x8e, 0x57,
+		0xfa, 0xfa, 0x03, 0xb3, 0x6f, 0x5a, 0xfc, 0x69, 0xb0, 0x19, 0xa4, 0x0e, 0x32, 0xde, 0x33, 0xa3,
+		0x5e, 0x4d, 0xbd, 0x69, 0xda, 0x78, 0xa0, 0xa7, 0x6f, 0xbe, 0xc8, 0xdf, 0x6c, 0x0c, 0x2a, 0x32,
+		0x2d, 0xb9, 0x46, 0x0b, 0x36, 0x11, 0x8e, 0x42, 0xc7, 0x1d, 0x2e, 0xb8, 0x98, 0xd6, 0xda, 0xed,
+		0x81, 0x6e, 0x59, 0xdc, 0x71, 0x89, 0x4f, 0x7c, 0x8f, 0xac, 0x3f, 0xdc, 0x56, 0x85, 0x97, 0xc0,
+		0x47, 0xe1, 0x26, 0x8c, 0x79, 0x61, 0x1b, 0x7c, 0xd4, 0x47, 0xfa, 0xc3, 0x6d, 0xb4, 0x94, 0x7b,
+		0x20, 0x39, 0x41, 0x98, 0xc4, 0x4d, 0x57, 0x0e, 0xfa, 0x74, 0x35, 0x6f, 0x81, 0xda, 0x1f, 0x74,
+		0xcc, 0x01, 0x5e, 0x26, 0x09, 0x31, 0xa1, 0x45, 0xc1, 0x26, 0x87, 0xe7, 0x6f, 0x40, 0xa6, 0x41,
+		0x97, 0x46, 0xae, 0xe4, 0xe7, 0x5d, 0xf9, 0xa4, 0xd9, 0xf2, 0x4d, 0x95, 0x2c, 0x30, 0x26, 0xd9,
+		0x99, 0xff, 0x28, 0x41, 0xa2, 0x88, 0x03, 0xb7, 0x5a, 0xbe, 0xd4, 0xd5, 0x76, 0xc9, 0xa3, 0x70,
+		0xa4, 0xb8, 0x5e, 0x2f, 0x3d, 0xa3, 0x56, 0xcb, 0xea, 0xa5, 0xf5, 0x82, 0xe7, 0x3e, 0x41, 0xee,
+		0xe8, 0x4b, 0xb7, 0x57, 0x88, 0x07, 0x77, 0xcb, 0xa0, 0x99, 0x00, 0x72, 0x16, 0x96, 0xfd, 0x24,
+		0x85, 0x62, 0x03, 0xef, 0x34, 0x4a, 0xb9, 0x23, 0x2f, 0xdd, 0x5e, 0x59, 0xf4, 0x50, 0x14, 0xb6,
+		0x2d, 0xdd, 0xb0, 0xc7, 0x09, 0x4a, 0xf5, 0x8d, 0x0d, 0xbc, 0xd4, 0x31, 0x46, 0x80, 0x07, 0x99,
+		0x3b, 0x36, 0x79, 0x10, 0x16, 0xfd, 0x04, 0xb5, 0xea, 0xba, 0x1c, 0xcc, 0x91, 0x97, 0x6e, 0xaf,
+		0xa4, 0x3d, 0xd8, 0xb5, 0x4e, 0x37, 0x17, 0x7b, 0xdf, 0x8f, 0x9c, 0x5c, 0xf8, 0xf8, 0x3f, 0x38,
+		0x29, 0x15, 0x37, 0xa6, 0x8e, 0xb9, 0xc7, 0x0e, 0x37, 0xe6, 0xfc, 0xf3, 0xf6, 0x97, 0xee, 0x82,
+		0xbb, 0x46, 0x0b, 0x7d, 0xce, 0x78, 0xde, 0xe1, 0x36, 0x2b, 0x33, 0x94, 0x3b, 0x38, 0x3c, 0xc8,
+		0xcd, 0x98, 0x14, 0x72, 0x33, 0x1d, 0x43, 0xfe, 0x22, 0xa4, 0xf0, 0xd6, 0x63, 0x43, 0xb7, 0xaf,
+		0xe8, 0x5a, 0x5b, 0x1f, 0xf8, 0xe3, 0x87, 0x94, 0x88, 0x1f, 0x08, 0x84, 0x68, 0x90, 0xc0, 0xe6,
+		0x4f, 0xfa, 0x77, 0x7e, 0x0f, 0x42, 0x48, 0xea, 0xc6, 0x16, 0x9c, 0x82, 0x7e, 0x20, 0x74, 0x7b,
+		0xdf, 0xe6, 0x17, 0xca, 0x93, 0x0a, 0xfb, 0x20, 0x8f, 0x8b, 0x08, 0x21, 0x78, 0x70, 0x84, 0xc0,
+		0x87, 0x17, 0x8f, 0x13, 0xba, 0x10, 0xe5, 0x5d, 0xec, 0x08, 0x22, 0xb9, 0x82, 0x90, 0x0d, 0xc8,
+		0xf4, 0xb5, 0x81, 0x4d, 0x9f, 0x60, 0xda, 0xa3, 0xad, 0xe0, 0x23, 0xf8, 0xd4, 0xb8, 0x3f, 0xf1,
+		0x35, 0x96, 0xd7, 0x92, 0xea, 0x7b, 0x81, 0xf9, 0x3f, 0x0e, 0x41, 0x84, 0x2b, 0xe3, 0xad, 0x10,
+		0xe5, 0x6a, 0xe5, 0x63, 0xee, 0xee, 0xd5, 0xf1, 0x69, 0x76, 0xd5, 0x99, 0x0e, 0x39, 0x3f, 0x41,
+		0x43, 0xee, 0x87, 0x58, 0x6b, 0x4f, 0xeb, 0x18, 0x6a, 0xa7, 0xcd, 0x83, 0xe3, 0xc4, 0xe7, 0x3e,
+		0x7b, 0x2a, 0x5a, 0x42, 0x58, 0xb5, 0xac, 0x44, 0x69, 0x61, 0xb5, 0x8d, 0x31, 0xcd, 0x9e, 0xde,
+		0xd9, 0xdd, 0xb3, 0xb9, 0xdf, 0xe0, 0x5f, 0xf8, 0x36, 0x3f, 0x1a, 0x04, 0xbf, 0x7e, 0x91, 0x1b,
+		0x5b, 0xf5, 0x38, 0x89, 0xbb, 0x62, 0x0c, 0x2b, 0xfe, 0xe0, 0x7f, 0x3e, 0x25, 0x29, 0x94, 0x82,
+		0x94, 0x20, 0xd5, 0xd5, 0x2c, 0x5b, 0xa5, 0xf3, 0x31, 0x56, 0xcf, 0xee, 0x5d, 0x1c, 0x1f, 0x57,
+		0x08, 0x57, 0x2c, 0x17, 0x3d, 0x81, 0x54, 0x0c, 0xd4, 0xc6, 0x47, 0xc9, 0x28, 0x93, 0x16, 0x1d,
+		0x81, 0x2c, 0x4a, 0x8c, 0x50, 0xbd, 0xa7, 0x11, 0xce, 0x06, 0x26, 0x8d, 0x15, 0x4f, 0x40, 0x9c,
+		0xbe, 0x33, 0x46, 0x51, 0xd8, 0x2d, 0xdd, 0x18, 0x02, 0x68, 0xe1, 0x03, 0x90, 0x71, 0xbd, 0x3e,
+		0x43, 0x89, 0x31, 0x2e, 0x2e, 0x98, 0x22, 0x3e, 0x02, 0xcb, 0x86, 0x7e, 0xcb, 0x56, 0x47, 0xb1,
+		0xe3, 0x14, 0x9b, 0x60, 0xd9, 0x35, 0x3f, 0xc5, 0x7d, 0x90, 0x6e, 0x09, 0xe5, 0x33, 0x5c, 0xa0,
+		0xb8, 0x29, 0x07, 0x4a, 0xd1, 0x8e, 0x43, 0x4c, 0xeb, 0xf7, 0x19, 0x42, 0x82, 0x7b, 0xfd, 0x7e,
+		0x9f, 0x16, 0x9d, 0x81, 0x45, 0xda, 0xc6, 0x81, 0x6e, 0xe1, 0xc5, 0x1e, 0x86, 0x93, 0xa4, 0x38,
+		0x19, 0x2c, 0x50, 0x18, 0x9c, 0xe2, 0xde, 0x0b, 0x29, 0xfd, 0x26, 0x5e, 0x91, 0x6e, 0xe9, 0x0c,
+		0x2f, 0x45, 0xf1, 0x92, 0x02, 0x48, 0x91, 0x1e, 0x04, 0xc7, 0x9b, 0xab, 0x62, 0xa6, 0x49, 0x33,
+		0x7e, 0x02, 0x5e, 0x60, 0xe0, 0x7c, 0x16, 0x42, 0x65, 0xcd, 0xd6, 0x30, 0x5c, 0xb2, 0x6f, 0xb1,
+		0xe9, 0x33, 0xa9, 0xe0, 0x9f, 0xf9, 0x9f, 0x0f, 0x42, 0xe8, 0x9a, 0x69, 0xeb, 0xe4, 0x31, 0x4f,
+		0x28, 0x9b, 0x9e, 0x64, 0xcf, 0x8d, 0xce, 0xae, 0xa1, 0xb7, 0x37, 0xac, 0x5d, 0xcf, 0x63, 0xc1,
+		0xae, 0x39, 0x05, 0x7c, 0xe6, 0xb4, 0x0c, 0xe1, 0x81, 0x39, 0x34, 0xda, 0xe2, 0x3a, 0x05, 0xfd,
+		0x20, 0x15, 0x88, 0x39, 0x56, 0x12, 0x9a, 0x65, 0x25, 0x19, 0xb4, 0x12, 0xb4, 0x61, 0x0e, 0x50,
+		0xa2, 0xdb, 0xdc, 0x58, 0x8a, 0x10, 0x77, 0x9c, 0x57, 0x36, 0x7c, 0x08, 0x83, 0x75, 0xc9, 0x70,
+		0x8a, 0x74, 0xfa, 0xde, 0x51, 0x1e, 0xb3, 0x38, 0xd9, 0x29, 0xe0, 0xda, 0xf3, 0x99, 0x15, 0x7f,
+		0xb8, 0x38, 0x4a, 0xdb, 0xe5, 0x9a, 0x15, 0x7b, 0xbc, 0xf8, 0x2e, 0x3c, 0x07, 0xb9, 0x6b, 0xd0,
+		0x8b, 0x43, 0xdc, 0xf2, 0x5c, 0x00, 0x96, 0xba, 0x77, 0xbd, 0x99, 0xa5, 0xb9, 0x00, 0x7c, 0xb1,
+		0xc7, 0xf9, 0x50, 0x5d, 0x2e, 0xcc, 0xca, 0x88, 0x53, 0xd4, 0x10, 0x25, 0xf9, 0x5f, 0x91, 0x20,
+		0xc2, 0x67, 0x2c, 0xb7, 0x1b, 0xa4, 0xc9, 0xdd, 0x10, 0x98, 0xd6, 0x0d, 0xc1, 0x3b, 0xef, 0x86,
+		0x02, 0x80, 0x23, 0xa6, 0xc5, 0x9f, 0xa7, 0x9d, 0x10, 0x56, 0x31, 0x11, 0x1b, 0x9d, 0x5d, 0x3e,
+		0xee, 0x3d, 0x44, 0xf9, 0xdf, 0x97, 0x20, 0xee, 0x94, 0x93, 0x02, 0xa4, 0x84, 0x5c, 0xea, 0x4e,
+		0x57, 0xdb, 0xe5, 0xa6, 0x78, 0xf7, 0x54, 0xe1, 0x70, 0x16, 0x56, 0x12, 0x5c, 0x1e, 0xfc, 0x98,
+		0xdc, 0xad, 0x81, 0x29, 0xdd, 0xea, 0xb3, 0xa3, 0xe0, 0x9d, 0xd9, 0x91, 0xaf, 0xc7, 0x43, 0x23,
+		0x3d, 0x9e, 0xff, 0x03, 0x89, 0x3f, 0x8c, 0xdf, 0xd6, 0xdb, 0xff, 0x27, 0xbb, 0xea, 0x79, 0x6e,
+		0x5b, 0x98, 0xf2, 0x1c, 0xeb, 0xb3, 0x7b, 0xc7, 0x39, 0xfa, 0x65, 0x76, 0xfb, 0x8e, 0x08, 0x2e,
+		0x0d, 0xb7, 0x0f, 0x3f, 0x15, 0x80, 0xc5, 0x31, 0xfc, 0xbf, 0x7d, 0x7d, 0xe9, 0x1f, 0xbd, 0xe1,
+		0x39, 0x47, 0x6f, 0x64, 0xea, 0xe8, 0xfd, 0x64, 0x80, 0x26, 0x00, 0xfa, 0xa6, 0xa5, 0x75, 0xbf,
+		0x16, 0xbe, 0xf7, 0x04, 0xc4, 0xfb, 0x66, 0x57, 0x65, 0x25, 0xec, 0x42, 0x5b, 0xac, 0x6f, 0x76,
+		0x95, 0x31, 0x33, 0x0b, 0xbf, 0x4e, 0x8e, 0x39, 0xf2, 0x3a, 0x74, 0x42, 0x74, 0x74, 0x40, 0x0d,
+		0x20, 0xc9, 0x54, 0xc1, 0xa3, 0xa6, 0x47, 0x50, 0x07, 0xf8, 0x57, 0x56, 0x1a, 0x8f, 0xf2, 0x98,
+		0xd8, 0x0c, 0x53, 0x89, 0xec, 0x39, 0x14, 0x2c, 0xc8, 0xc8, 0x06, 0xa6, 0x51, 0x30, 0x2b, 0x56,
+		0x38, 0x5e, 0xfe, 0x7b, 0x25, 0x80, 0x75, 0xd4, 0x2c, 0x6d, 0x2f, 0xc6, 0x3b, 0x16, 0x15, 0x41,
+		0xf5, 0xd5, 0x7c, 0x72, 0x5a, 0xa7, 0xf1, 0xfa, 0x93, 0x96, 0x57, 0xee, 0x12, 0xa4, 0x5c, 0xdb,
+		0xb6, 0x74, 0x21, 0xcc, 0xc9, 0x03, 0x56, 0xa5, 0x78, 0x5f, 0x35, 0x79, 0xd3, 0xf3, 0x95, 0xff,
+		0xe7, 0x12, 0xc4, 0xa9, 0x4c, 0xf8, 0xbe, 0xaa, 0xaf, 0x0f, 0xa5, 0x3b, 0xef, 0xc3, 0xbb, 0x01,
+		0x18, 0x1b, 0x3c, 0x7f, 0xc4, 0x2d, 0x2b, 0x4e, 0x21, 0x78, 0xaa, 0x88, 0x5c, 0x70, 0x14, 0x1e,
+		0x3c, 0x58, 0xe1, 0x62, 0xd5, 0xca, 0xd5, 0x7e, 0x0c, 0xa2, 0xf4, 0x8d, 0x90, 0x5b, 0x16, 0x5f,
+		0x88, 0xe2, 0xdb, 0xdc, 0xcd, 0x5b, 0x56, 0xfe, 0x05, 0x88, 0x36, 0x6f, 0xb1, 0x7c, 0xe2, 0x09,
+		0x88, 0x0f, 0x4c, 0x93, 0x47, 0x7f, 0x2c, 0xea, 0x8e, 0x21, 0x80, 0x06, 0x3b, 0x22, 0x87, 0x16,
+		0x70, 0x73, 0x68, 0x6e, 0x12, 0x30, 0x38, 0x57, 0x12, 0x10, 0x57, 0x9f, 0x29, 0xdf, 0x48, 0x22,
+		0x6f, 0x86, 0x63, 0x8d, 0xea, 0xe5, 0x5a, 0xa5, 0xac, 0x6e, 0x34, 0x2e, 0x8f, 0xdc, 0xdd, 0xcf,
+		0x65, 0x5e, 0xba, 0xbd, 0x92, 0xe0, 0xcb, 0xce, 0x69, 0xd8, 0x9b, 0x4a, 0xe5, 0x5a, 0xbd, 0x59,
+		0x91, 0x25, 0x86, 0xbd, 0x39, 0xd0, 0x6f, 0x9a, 0x36, 0xfb, 0xe1, 0xa5, 0x47, 0xe0, 0xf8, 0x04,
+		0x6c, 0x67, 0xf1, 0xb9, 0xf8, 0xd2, 0xed, 0x95, 0xd4, 0xe6, 0x40, 0x67, 0x56, 0x46, 0x29, 0x56,
+		0x21, 0x3b, 0x4e, 0x51, 0xdf, 0xac, 0x37, 0x0a, 0xeb, 0xf2, 0x4a, 0x4e, 0x7e, 0xe9, 0xf6, 0x4a,
+		0x52, 0xb8, 0x0c, 0xc4, 0xff, 0x1a, 0xac, 0x3e, 0x63, 0x70, 0xf7, 0x68, 0xe1, 0xd9, 0xbe, 0x36,
+		0xd0, 0x7a, 0x87, 0x5d, 0x7e, 0xce, 0x38, 0xae, 0x91, 0xff, 0xab, 0x00, 0x64, 0x9c, 0xc5, 0xcd,
+		0x26, 0xad, 0x81, 0x3c, 0xe6, 0xcd, 0xf8, 0x25, 0xa6, 0xce, 0x1d, 0x0c, 0x5b, 0x24, 0x04, 0x71,
+		0x1b, 0x8a, 0x07, 0xc9, 0x7c, 0x50, 0xad, 0x4c, 0x98, 0xdf, 0x38, 0x06, 0x27, 0x75, 0x28, 0xc8,
+		0xd3, 0x10, 0x77, 0x86, 0x98, 0xf3, 0x56, 0xfc, 0xf4, 0x31, 0xc9, 0xe9, 0x5d, 0x1a, 0x7c, 0xbe,
+		0x5d, 0x2c, 0xe3, 0x42, 0xd3, 0x16, 0x86, 0xd7, 0x18, 0x02, 0x27, 0x16, 0xf8, 0xe4, 0x11, 0x08,
+		0x69, 0xdb, 0xad, 0x4e, 0x36, 0x3c, 0x9e, 0x72, 0x61, 0x74, 0x85, 0x62, 0xa9, 0xca, 0x89, 0x28,
+		0x26, 0x4a, 0xab, 0x0d, 0xed, 0x3d, 0x96, 0xdf, 0x89, 0x4c, 0x93, 0xb6, 0x20, 0x50, 0x84, 0xb4,
+		0x0e, 0x4d, 0xbe, 0xca, 0xb3, 0x31, 0x5c, 0xe1, 0xf4, 0xc1, 0xde, 0x5b, 0x2a, 0x5b, 0x4c, 0xb3,
+		0xf0, 0x24, 0xd6, 0xd3, 0x6e, 0x15, 0xf1, 0x1b, 0x47, 0x30, 0x16, 0xee, 0xf2, 0x57, 0xf3, 0x82,
+		0x4a, 0xa4, 0xa7, 0xdd, 0xba, 0xac, 0xe1, 0xad, 0xf9, 0xa0, 0x1c, 0xca, 0xff, 0x18, 0x86, 0x3a,
+		0x3e, 0xb5, 0x92, 0x87, 0x80, 0x20, 0x05, 0x6e, 0x30, 0xe2, 0xd8, 0xa7, 0xfd, 0x23, 0xf8, 0xe2,
+		0x83, 0x04, 0x85, 0x5d, 0xbd, 0x36, 0xec, 0x51, 0x01, 0x2c, 0x82, 0xbb, 0x91, 0x1c, 0x59, 0x98,
+		0x06, 0xef, 0xbf, 0xe3, 0xe3, 0xbf, 0x81, 0xc4, 0x11, 0xd8, 0x0c, 0xf2, 0x32, 0xce, 0x20, 0x69,
+		0xc6, 0x4f, 0x94, 0xf8, 0x9b, 0x12, 0xf4, 0x37, 0x25, 0xff, 0x34, 0x64, 0x46, 0xba, 0x90, 0xe4,
+		0x21, 0xc5, 0x53, 0x5e, 0xf4, 0xf4, 0x04, 0x5b, 0x22, 0xc5, 0x95, 0x04, 0x4b, 0x6d, 0xd1, 0xd3,
+		0x24, 0x6b, 0xb1, 0x5f, 0xc0, 0x3c, 0x2b, 0xee, 0x1b, 0x3d, 0x04, 0x29, 0x5f, 0x27, 0x8a, 0x34,
+		0xb4, 0xe4, 0xa6, 0xa1, 0x5d, 0xe4, 0xe7, 0x21, 0x89, 0x1e, 0x4c, 0x6f, 0x73, 0xdc, 0xfb, 0x21,
+		0xc3, 0x3c, 0xec, 0xa8, 0xae, 0x59, 0xc4, 0xb4, 0x21, 0x14, 0x9e, 0x87, 0x94, 0x8b, 0xe7, 0xaa,
+		0x3d, 0x21, 0xb0, 0x2e, 0x6b, 0x56, 0xbe, 0x0e, 0xe0, 0x5a, 0x05, 0x29, 0xc0, 0xdd, 0xe8, 0x7d,
+		0xbc, 0xa7, 0x7d, 0xf9, 0x8b, 0xe4, 0xbe, 0x90, 0x33, 0x87, 0x48, 0xee, 0x89, 0x5e, 0xf6, 0x2e,
+		0xf9, 0x15, 0x8a, 0x91, 0x3f, 0x0b, 0x99, 0x11, 0x7b, 0xc1, 0x19, 0xd9, 0xb5, 0x32, 0xb6, 0xd1,
+		0xe1, 0x02, 0x8a, 0x6f, 0xff, 0xf8, 0xe7, 0x4e, 0x4a, 0x5f, 0x1d, 0xef, 0xf3, 0x9f, 0xae, 0xc3,
+		0x09, 0x4f, 0x21, 0x9a, 0xbc, 0x2f, 0xf5, 0x95, 0x71, 0x0b, 0x57, 0xb1, 0x70, 0x56, 0x0a, 0xeb,
+		0xc0, 0x8c, 0x73, 0xee, 0x60, 0x47, 0x37, 0x3b, 0xbf, 0x35, 0x3b, 0xc3, 0x36, 0x79, 0x1b, 0xe0,
+		0x97, 0xe3, 0x10, 0x55, 0xf4, 0x77, 0x0e, 0x75, 0x0b, 0x1f, 0xd2, 0x08, 0xe9, 0xad, 0x3d, 0x73,
+		0x52, 0xd6, 0x15, 0x1b, 0xb8, 0xca, 0xf1, 0x2a, 0xad, 0x3d, 0xf3, 0xca, 0x82, 0x42, 0x71, 0xc9,
+		0x79, 0x08, 0xef, 0x74, 0x87, 0x3c, 0x61, 0x36, 0xe2, 0x25, 0xbd, 0x44, 0x97, 0x10, 0xe9, 0xca,
+		0x82, 0xc2, 0xb0, 0xb1, 0x2a, 0xfa, 0x3b, 0x78, 0xc1, 0x83, 0xab, 0xc2, 0xe7, 0x0b, 0xb0, 0x2a,
+		0xc4, 0x25, 0x45, 0x80, 0x8e, 0xd1, 0xb1, 0x55, 0x9a, 0x4c, 0xca, 0x86, 0xc7, 0x1d, 0x8e, 0x9f,
+		0xb2, 0x63, 0xd3, 0xf4, 0x13, 0x6e, 0x56, 0x74, 0xc4, 0x07, 0x8a, 0xfb, 0xce, 0xa1, 0x3e, 0x10,
+		0xfe, 0x6a, 0xaa, 0xb8, 0x6f, 0x47, 0x24, 0x14, 0x97, 0x62, 0xa3, 0x5b, 0x67, 0xbf, 0x69, 0x60,
+		0xdf, 0xca, 0xc6, 0xc6, 0x1d, 0xab, 0x97, 0x92, 0xfe, 0xb0, 0x41, 0xf3, 0x16, 0xee, 0xa0, 0xb4,
+		0xd8, 0x9f, 0xe4, 0x49, 0x27, 0xe8, 0x4b, 0x8c, 0xc7, 0x59, 0x3e, 0x5a, 0x96, 0x68, 0x5a, 0x10,
+		0xc1, 0x1f, 0xa9, 0x41, 0xba, 0xdb, 0xb1, 0x6c, 0xd5, 0x32, 0xb4, 0xbe, 0xb5, 0x67, 0xda, 0xe2,
+		0x51, 0xf1, 0xfb, 0xa6, 0x71, 0x58, 0xef, 0x58, 0x76, 0x43, 0x20, 0x5f, 0x59, 0x50, 0x52, 0x5d,
+		0x2f, 0x00, 0xf9, 0x99, 0x3b, 0x3b, 0xfa, 0xc0, 0x61, 0x98, 0x4d, 0x1d, 0xcc, 0xaf, 0x8e, 0xd8,
+		0x82, 0x1e, 0xf9, 0x99, 0x5e, 0x00, 0xf9, 0x3a, 0x58, 0xea, 0x9a, 0x5a, 0xdb, 0x61, 0xa7, 0xb6,
+		0xf6, 0x86, 0xc6, 0x0d, 0x9a, 0x06, 0xc2, 0x9f, 0xab, 0x99, 0x26, 0xa4, 0xa9, 0xb5, 0x05, 0x8b,
+		0x12, 0x12, 0x5c, 0x59, 0x50, 0x16, 0xbb, 0xa3, 0x40, 0xf2, 0x0e, 0x58, 0xd6, 0xfa, 0xfd, 0xee,
+		0xfe, 0x28, 0xf7, 0x0c, 0xe5, 0x7e, 0x66, 0x1a, 0xf7, 0x02, 0xd2, 0x8c, 0xb2, 0x27, 0xda, 0x18,
+		0x94, 0x34, 0x31, 0x81, 0xa5, 0xd3, 0x1b, 0x76, 0x7d, 0x1e, 0xd7, 0xd0, 0xd7, 0xba, 0xf1, 0x07,
+		0x26, 0xa7, 0xf0, 0xde, 0x64, 0xf8, 0x22, 0x0c, 0xba, 0xb2, 0x80, 0xb9, 0x2e, 0x1f, 0x88, 0x71,
+		0x35, 0x5b, 0x3a, 0xfd, 0x79, 0x03, 0xce, 0x75, 0x71, 0x16, 0x57, 0x8a, 0xef, 0xe7, 0xea, 0x03,
+		0x91, 0x0a, 0x24, 0xd8, 0xe2, 0x17, 0xb7, 0x82, 0x74, 0xfa, 0xb3, 0x11, 0x89, 0x73, 0xf9, 0xa9,
+		0x23, 0x94, 0xa2, 0x62, 0x5e, 0xed, 0xca, 0x82, 0x02, 0xba, 0xf3, 0x45, 0x34, 0x38, 0x42, 0x9f,
+		0x3c, 0xdf, 0x57, 0xfd, 0x9e, 0x3a, 0xbb, 0x44, 0x19, 0x3e, 0x34, 0x8d, 0x21, 0xfd, 0x6d, 0xb2,
+		0xfd, 0x6b, 0x5e, 0xc7, 0x7d, 0x65, 0x41, 0x59, 0xba, 0x39, 0x0e, 0x46, 0x13, 0xdb, 0xe9, 0x18,
+		0x5a, 0x17, 0x7f, 0xce, 0x83, 0xc5, 0x4f, 0xcb, 0x07, 0x9b, 0xd8, 0x25, 0x8e, 0x4d, 0x67, 0x62,
+		0x34, 0xb1, 0x1d, 0x2f, 0xa0, 0x18, 0xe5, 0x67, 0x83, 0x9d, 0x67, 0x80, 0xa3, 0x72, 0x8c, 0x3d,
+		0x58, 0x7f, 0x35, 0x14, 0x03, 0x39, 0x91, 0x7f, 0x00, 0x12, 0x1e, 0xc7, 0x84, 0xfb, 0x5e, 0xfc,
+		0x6c, 0x90, 0x38, 0x65, 0xcc, 0x3f, 0xf3, 0x69, 0x48, 0x7a, 0x9d, 0x51, 0xfe, 0x83, 0x12, 0x24,
+		0x3c, 0x7e, 0x06, 0x29, 0xbd, 0x39, 0xf0, 0xb8, 0x1b, 0x1b, 0xdd, 0x2b, 0xe6, 0x42, 0x51, 0xce,
+		0x36, 0x7c, 0x93, 0x14, 0xc8, 0xa7, 0x62, 0x7c, 0xe4, 0xa5, 0x7f, 0xae, 0xef, 0xa0, 0x04, 0x29,
+		0x0a, 0xf4, 0xcf, 0xf5, 0x05, 0xc2, 0x3d, 0x90, 0xc4, 0x76, 0xab, 0xde, 0x08, 0x2d, 0xae, 0x24,
+		0x10, 0xc6, 0x51, 0xf2, 0xbf, 0x15, 0x00, 0x79, 0xd4, 0x81, 0x39, 0xc9, 0x71, 0xe9, 0xd0, 0xc9,
+		0xf1, 0xe3, 0xa3, 0x69, 0x79, 0x37, 0x13, 0xbf, 0x0e, 0xb2, 0x9b, 0x50, 0x66, 0x53, 0xcd, 0xf4,
+		0x88, 0x73, 0x24, 0x34, 0x56, 0x32, 0x2d, 0x3f, 0x80, 0x5c, 0xf2, 0x6d, 0x8f, 0x8a, 0x1f, 0x8a,
+		0x1d, 0xed, 0x70, 0x27, 0xea, 0xd9, 0xea, 0xb7, 0x35, 0x5b, 0x17, 0x19, 0x39, 0x97, 0x12, 0x83,
+		0x13, 0xcc, 0x5f, 0x5b, 0xb6, 0x66, 0xeb, 0x3c, 0x38, 0x61, 0xb9, 0x8e, 0x94, 0xd6, 0xef, 0xd3,
+		0x1f, 0xc5, 0x63, 0xc1, 0xc9, 0x7d, 0x90, 0x46, 0x9f, 0xde, 0xd1, 0xba, 0x22, 0xb6, 0x88, 0xb0,
+		0x18, 0x86, 0x43, 0x79, 0x38, 0xd1, 0x86, 0xa4, 0xd7, 0x9f, 0x3b, 0xab, 0x38, 0xc9, 0xb3, 0x8a,
+		0x23, 0xfc, 0xcd, 0x1e, 0xa6, 0x1f, 0xf1, 0xea, 0xd1, 0xe4, 0x6d, 0x8a, 0x65, 0xba, 0xe2, 0xbb,
+		0xc9, 0xd2, 0x33, 0x31, 0x85, 0x7d, 0xe4, 0x15, 0x48, 0xfb, 0x7d, 0x3f, 0x49, 0x43, 0xc0, 0xbe,
+		0xc5, 0x6b, 0x09, 0xd8, 0xb7, 0x30, 0xb6, 0xf6, 0x3c, 0x4f, 0x39, 0x3e, 0xdb, 0x71, 0x3a, 0x37,
+		0xbb, 0x92, 0xcf, 0x40, 0x4a, 0xf0, 0x64, 0x69, 0x80, 0xa3, 0xb0, 0x3c, 0xc9, 0xc5, 0xe7, 0xf7,
+		0x60, 0x79, 0x92, 0xab, 0x26, 0xe7, 0x21, 0xe6, 0xf8, 0xf8, 0x09, 0xeb, 0x71, 0x5a, 0xad, 0x40,
+		0x56, 0x1c, 0x54, 0xdf, 0x06, 0x42, 0xc0, 0xb7, 0x81, 0x90, 0xff, 0x46, 0xc8, 0x4e, 0xf3, 0xdf,
+		0x23, 0x69, 0xc5, 0x90, 0xa3, 0xb0, 0xa3, 0x10, 0xe1, 0xaf, 0x31, 0x06, 0xe8, 0x96, 0x19, 0xff,
+		0x42, 0x45, 0x32, 0x5f, 0x1e, 0xa4, 0x60, 0xf6, 0x91, 0x57, 0xe1, 0xf8, 0x54, 0x1f, 0x3e, 0x7d,
+		0xf3, 0x8d, 0x31, 0xe2, 0x9b, 0x6f, 0x2d, 0x21, 0x8e, 0x45, 0xdb, 0x2a, 0x8e, 0xce, 0xb0, 0xaf,
+		0xfc, 0xcb, 0x41, 0x38, 0x3a, 0xd9, 0x93, 0x93, 0x15, 0x48, 0x62, 0xa0, 0x6b, 0xfb, 0x63, 0x62,
+		0xe8, 0x69, 0xb7, 0x9a, 0x3c, 0x20, 0xe6, 0x9b, 0x17, 0x01, 0x67, 0xf3, 0x82, 0x6c, 0xc1, 0x62,
+		0xd7, 0x6c, 0x69, 0x5d, 0xd5, 0xb3, 0x79, 0xc4, 0x07, 0xd1, 0xbd, 0x63, 0xca, 0xf6, 0x27, 0x29,
+		0xd1, 0xe1, 0x70, 0xfb, 0xcf, 0x50, 0x1e, 0xeb, 0xce, 0x3e, 0x13, 0x29, 0x43, 0xa2, 0xd7, 0xb1,
+		0xb6, 0xf5, 0x3d, 0xed, 0x66, 0xc7, 0x1c, 0xf0, 0xd1, 0x34, 0x6e, 0x34, 0x1b, 0x2e, 0x8e, 0xd8,
+		0xd3, 0xf2, 0x90, 0x79, 0xba, 0x24, 0x3c, 0x71, 0xab, 0x2d, 0x72, 0x68, 0x6f, 0x32, 0x6d, 0xd7,
+		0x2a, 0x3a, 0x75, 0xd7, 0x6a, 0xd2, 0x16, 0x51, 0x6c, 0xf2, 0x16, 0xd1, 0xfb, 0xbc, 0x5d, 0xe3,
+		0x9f, 0xfb, 0xc6, 0x76, 0x8d, 0x48, 0x03, 0x96, 0x39, 0x7d, 0xdb, 0xa7, 0xfb, 0x09, 0x87, 0x2b,
+		0xd8, 0xf8, 0x1a, 0xd5, 0x39, 0x11, 0xe4, 0xd3, 0xd5, 0x1e, 0xbc, 0x33, 0xb5, 0x8b, 0x6d, 0xdb,
+		0x90, 0x67, 0xdb, 0xf6, 0xff, 0xb2, 0xae, 0x78, 0x4f, 0x10, 0x16, 0xc7, 0x02, 0x89, 0x89, 0xfb,
+		0xd1, 0xd3, 0x72, 0xc0, 0xa2, 0x61, 0xc1, 0x43, 0x37, 0x8c, 0xf7, 0x75, 0x68, 0x76, 0x5f, 0x87,
+		0x5f, 0xc7, 0xbe, 0x8e, 0xdc, 0x59, 0x5f, 0x7f, 0x55, 0x7b, 0xe1, 0x07, 0x25, 0xc8, 0x4d, 0x8f,
+		0xbe, 0x26, 0x76, 0xc7, 0xa1, 0xb6, 0x2c, 0xa6, 0xcd, 0x71, 0xf7, 0x41, 0x7a, 0x24, 0x36, 0x64,
+		0xa6, 0x9c, 0xf2, 0x2d, 0xdb, 0xf3, 0xef, 0x0d, 0xc2, 0xf2, 0xa4, 0x00, 0x6e, 0xc2, 0x68, 0x7d,
+		0x3b, 0xfe, 0x18, 0x48, 0xab, 0xd3, 0xbe, 0xd3, 0xc1, 0xba, 0xc8, 0xa9, 0xff, 0xdf, 0x58, 0x1d,
+		0xb7, 0x92, 0xef, 0x01, 0x88, 0x29, 0xba, 0xd5, 0xc7, 0x78, 0x0c, 0x37, 0x40, 0xf4, 0x5b, 0x2d,
+		0xbd, 0x6f, 0xbb, 0xc7, 0x38, 0x26, 0x2d, 0x11, 0x18, 0x76, 0x45, 0x60, 0xe2, 0x02, 0xd9, 0x21,
+		0xc3, 0xbd, 0x20, 0x9a, 0x03, 0x98, 0xbe, 0x9c, 0xe7, 0xe4, 0xde, 0x24, 0xc0, 0x05, 0x91, 0x04,
+		0x08, 0x4e, 0x5d, 0xdf, 0x32, 0xaa, 0x91, 0x2c, 0xc0, 0x63, 0x3c, 0x0b, 0x10, 0x9a, 0x51, 0x99,
+		0x2f, 0x0d, 0x50, 0xf2, 0xa5, 0x01, 0x22, 0x33, 0x9a, 0x39, 0x25, 0x0f, 0x70, 0x41, 0xe4, 0x01,
+		0xa2, 0x33, 0x24, 0x1e, 0x49, 0x04, 0xbc, 0xd5, 0x93, 0x08, 0x88, 0x8f, 0xe7, 0x77, 0x7d, 0xa4,
+		0x13, 0x32, 0x01, 0x17, 0x9d, 0x4c, 0x40, 0x72, 0x6a, 0x16, 0x81, 0x13, 0x8f, 0xa6, 0x02, 0xea,
+		0x63, 0xa9, 0x80, 0x14, 0xff, 0xc5, 0xf2, 0x69, 0x2c, 0x66, 0xe4, 0x02, 0xea, 0x63, 0xb9, 0x80,
+		0xf4, 0x0c, 0x86, 0x33, 0x92, 0x01, 0x5f, 0x3f, 0x39, 0x19, 0x30, 0x7d, 0xb9, 0xce, 0xc5, 0x9c,
+		0x2f, 0x1b, 0xa0, 0x4e, 0xc9, 0x06, 0xc8, 0x53, 0x57, 0xae, 0x8c, 0xfd, 0xdc, 0xe9, 0x80, 0xad,
+		0x09, 0xe9, 0x00, 0xb6, 0x70, 0x3f, 0x3d, 0x95, 0xf9, 0x1c, 0xf9, 0x80, 0xad, 0x09, 0xf9, 0x00,
+		0x32, 0x93, 0xed, 0xcc, 0x84, 0xc0, 0x25, 0x7f, 0x42, 0x60, 0x69, 0x4a, 0xd4, 0xe9, 0x8e, 0xf6,
+		0x29, 0x19, 0x81, 0xed, 0x69, 0x19, 0x81, 0x65, 0xfe, 0xa3, 0x25, 0xd3, 0x38, 0x1e, 0x22, 0x25,
+		0x50, 0x1f, 0x4b, 0x09, 0x1c, 0x99, 0x61, 0x69, 0xf3, 0xe7, 0x04, 0xd8, 0x4f, 0xd9, 0xb1, 0x1f,
+		0xb1, 0x03, 0x39, 0x71, 0x35, 0x14, 0x4b, 0xc8, 0xc9, 0xfc, 0x83, 0xb0, 0x28, 0x58, 0x39, 0x7e,
+		0x0e, 0xd7, 0x0a, 0xfa, 0x60, 0x60, 0x0e, 0xc4, 0xad, 0x0e, 0xfa, 0x91, 0x3f, 0x0d, 0x49, 0x07,
+		0xf5, 0xe0, 0xfc, 0x01, 0x5d, 0x93, 0x79, 0xfc, 0x58, 0xfe, 0x17, 0x24, 0x48, 0x7a, 0x5d, 0x94,
+		0x6f, 0x7d, 0x19, 0xe7, 0xeb, 0x4b, 0x4f, 0x56, 0x21, 0xe0, 0xcf, 0x2a, 0xe0, 0x8f, 0x01, 0xf5,
+		0xc7, 0x12, 0x06, 0x5a, 0xdf, 0x49, 0x18, 0x88, 0x23, 0x5b, 0x2c, 0xf7, 0xc0, 0xa7, 0x25, 0xb6,
+		0x7f, 0x99, 0x71, 0x8e, 0xaf, 0xb1, 0xa5, 0x2e, 0x79, 0x18, 0x96, 0x3c, 0xb8, 0xce, 0x1a, 0x8e,
+		0xad, 0x9e, 0x65, 0x07, 0xbb, 0xc0, 0x17, 0x73, 0xff, 0x52, 0x82, 0xc5, 0x31, 0x17, 0x39, 0x31,
+		0x29, 0x20, 0xbd, 0x4e, 0x49, 0x81, 0xc0, 0x1d, 0x27, 0x05, 0xbc, 0x6b, 0xd2, 0xa0, 0x7f, 0x4d,
+		0xfa, 0xdf, 0x25, 0x48, 0xf9, 0x3c, 0x35, 0x76, 0x41, 0xcb, 0x6c, 0xeb, 0x7c, 0x95, 0x48, 0xff,
+		0xc6, 0x90, 0xa4, 0x6b, 0x8a, 0x5f, 0x1f, 0xc3, 0x3f, 0x11, 0xcb, 0x99, 0x78, 0xe2, 0x7c, 0x5e,
+		0x71, 0x16, 0x98, 0x61, 0xef, 0xcd, 0x11, 0x7e, 0x9d, 0x22, 0xe2, 0x5e, 0xa7, 0x70, 0x2e, 0xab,
+		0x47, 0x3d, 0x97, 0xd5, 0xc9, 0x93, 0x10, 0xa7, 0xc9, 0x7e, 0xd5, 0xec, 0xb3, 0xc9, 0x7a, 0x24,
+		0xb4, 0xf1, 0x5f, 0xa5, 0xb0, 0xe8, 0x21, 0x6f, 0xfa, 0x97, 0x27, 0xe2, 0x88, 0xfb, 0x22, 0x8e,
+		0xbb, 0x20, 0x8e, 0xd2, 0xb3, 0x5f, 0x33, 0x05, 0xfe, 0xd2, 0x81, 0x00, 0xe4, 0x7f, 0x3c, 0x00,
+		0x99, 0x91, 0x89, 0x66, 0x62, 0xdb, 0x27, 0x6d, 0x5c, 0xcf, 0xa7, 0x8f, 0x93, 0x00, 0xbb, 0x9a,
+		0xa5, 0xbe, 0xa8, 0x19, 0xb6, 0xde, 0xe6, 0x4a, 0xf1, 0x40, 0xf0, 0xea, 0x04, 0x7e, 0x0d, 0x2d,
+		0xbd, 0xcd, 0xb3, 0x2f, 0xce, 0x37, 0xde, 0xcd, 0xd2, 0x6f, 0xd2, 0x37, 0x9b, 0xd9, 0x8f, 0xcf,
+		0x1c, 0x1d, 0x5f, 0x0e, 0x63, 0x71, 0x31, 0x8b, 0x9d, 0xfd, 0x85, 0xcf, 0x9e, 0x92, 0x19, 0xf6,
+		0x9b, 0x9d, 0xeb, 0x73, 0x0a, 0xa7, 0xf7, 0x6b, 0x21, 0x36, 0xa2, 0x05, 0x9a, 0x07, 0x4c, 0x8a,
+		0xe5, 0x3d, 0xea, 0x94, 0x9d, 0x2a, 0xc7, 0xdb, 0xbb, 0xbd, 0xbe, 0x69, 0x76, 0x55, 0x36, 0xc6,
+		0x0b, 0x90, 0x76, 0x74, 0xc5, 0x66, 0x53, 0xfa, 0x0b, 0xe4, 0x36, 0xa6, 0xc6, 0x7c, 0x41, 0x70,
+		0x92, 0x01, 0xd9, 0x98, 0xf2, 0x3e, 0xc8, 0x9d, 0xdf, 0x84, 0x23, 0x82, 0x85, 0x6f, 0x5e, 0x25,
+		0x4f, 0x40, 0xdc, 0x9d, 0x92, 0xd9, 0xc5, 0x80, 0x03, 0x32, 0x2d, 0x2e, 0x6e, 0xfe, 0x97, 0x24,
+		0x38, 0x32, 0x71, 0x66, 0x25, 0x15, 0x88, 0xb0, 0x53, 0x9a, 0xfc, 0x44, 0xce, 0xc3, 0xf3, 0xcd,
+		0xc8, 0xab, 0xec, 0x08, 0xa7, 0xc2, 0x89, 0xf3, 0xef, 0x80, 0x08, 0x83, 0x90, 0x04, 0x44, 0x7d,
+		0xcf, 0xee, 0x17, 0x4a, 0xa5, 0xca, 0xa6, 0x78, 0xdc, 0xb9, 0x48, 0x1f, 0x11, 0x46, 0xb0, 0x52,
+		0xb9, 0x5a, 0x29, 0x35, 0xe5, 0x20, 0xfe, 0x04, 0x3f, 0xfb, 0x9b, 0x3f, 0x8e, 0x2f, 0x87, 0x3c,
+		0xa0, 0x46, 0xa5, 0x56, 0xae, 0x28, 0x72, 0x38, 0xff, 0x28, 0x1c, 0x17, 0x72, 0x8c, 0x67, 0x84,
+		0x9c, 0xc4, 0x8c, 0xe4, 0x49, 0xcc, 0xe4, 0x5f, 0x0e, 0x40, 0x4e, 0xd0, 0x4c, 0xc8, 0xf1, 0x5c,
+		0x1d, 0x69, 0xf8, 0xb9, 0x43, 0xcc, 0xea, 0x23, 0xad, 0xc7, 0x75, 0xcc, 0x40, 0xdf, 0xd1, 0xed,
+		0xd6, 0x1e, 0x0b, 0x14, 0x98, 0x07, 0x4a, 0x29, 0x29, 0x0e, 0xa5, 0x44, 0x16, 0x43, 0x7b, 0x41,
+		0x6f, 0xd9, 0x2a, 0x33, 0x22, 0x8b, 0x2e, 0x26, 0xe2, 0x4a, 0x8a, 0x41, 0x1b, 0x0c, 0x98, 0xff,
+		0xc6, 0x43, 0xe9, 0x32, 0x0e, 0x61, 0xa5, 0x82, 0x3f, 0x7c, 0x17, 0x24, 0x04, 0xd2, 0xf4, 0x4f,
+		0xb5, 0x51, 0x2b, 0x6c, 0x36, 0xae, 0xd4, 0x51, 0x97, 0x4b, 0x90, 0x11, 0xba, 0x14, 0xc0, 0x70,
+		0xfe, 0x21, 0x38, 0x36, 0x25, 0xaa, 0x98, 0x70, 0x6c, 0xf6, 0x23, 0x92, 0x17, 0xdb, 0x1f, 0x19,
+		0xd4, 0x21, 0x62, 0xd9, 0x9a, 0x3d, 0xb4, 0xb8, 0x12, 0x9f, 0x98, 0x37, 0xcc, 0x58, 0x15, 0x7f,
+		0x34, 0x28, 0xb9, 0xc2, 0xd9, 0xe4, 0xcf, 0x43, 0xda, 0x5f, 0x32, 0x5d, 0x07, 0xae, 0x11, 0x05,
+		0xf2, 0x4f, 0x01, 0x19, 0x8f, 0x3e, 0x26, 0x2c, 0x2f, 0xa5, 0x49, 0xcb, 0xcb, 0x1f, 0x95, 0xe0,
+		0xc4, 0x01, 0x91, 0x06, 0x79, 0xfb, 0x48, 0x23, 0x2f, 0x1e, 0x26, 0x4e, 0x59, 0x65, 0xb0, 0x91,
+		0x66, 0x3e, 0x06, 0x49, 0x2f, 0x7c, 0xbe, 0x46, 0x7e, 0x21, 0x00, 0x47, 0x26, 0x06, 0x2d, 0x1e,
+		0x17, 0x28, 0x7d, 0x85, 0x2e, 0xf0, 0x2d, 0x00, 0xf6, 0x2d, 0x71, 0x6e, 0x9b, 0xcf, 0xa3, 0x77,
+		0x4f, 0xc8, 0x2f, 0xea, 0xad, 0xe6, 0x2d, 0x3e, 0x08, 0xe2, 0x36, 0xff, 0x0b, 0xf3, 0x27, 0x9e,
+		0xa4, 0xc0, 0x90, 0xce, 0xb1, 0x56, 0x36, 0x78, 0xa8, 0xc9, 0x58, 0xbe, 0xe9, 0x07, 0x5b, 0xe4,
+		0x3a, 0x1c, 0x1b, 0x09, 0x14, 0x1c, 0xd6, 0xa1, 0x79, 0xe3, 0x85, 0x23, 0xfe, 0x78, 0x41, 0xb0,
+		0xf6, 0xce, 0xf6, 0x61, 0xff, 0x6c, 0x7f, 0x1d, 0xc0, 0x4d, 0x0e, 0xb8, 0x07, 0x0d, 0x25, 0xef,
+		0x41, 0xc3, 0xf3, 0x10, 0x46, 0x4b, 0x12, 0x7a, 0x1a, 0x77, 0xc5, 0x68, 0x09, 0x9e, 0xe4, 0x02,
+		0xc3, 0xce, 0x77, 0x80, 0x8c, 0x27, 0x68, 0xa7, 0x54, 0xf1, 0x56, 0x7f, 0x15, 0xf7, 0x4c, 0x4d,
+		0xf5, 0x4e, 0xae, 0xea, 0x5d, 0x10, 0xa6, 0x3d, 0x3f, 0xf1, 0xf6, 0xe6, 0x37, 0x00, 0x68, 0xb6,
+		0x3d, 0xe8, 0x6c, 0x0f, 0xdd, 0x0a, 0x4e, 0x4d, 0xb6, 0x9c, 0x82, 0xc0, 0x2b, 0xde, 0xc5, 0x4d,
+		0x68, 0xd9, 0x25, 0xf5, 0x98, 0x91, 0x87, 0x61, 0xbe, 0x06, 0x69, 0x3f, 0xed, 0xe4, 0xdb, 0xa8,
+		0xe3, 0x3f, 0x3b, 0xeb, 0x46, 0x47, 0xfc, 0x81, 0x2c, 0xfa, 0x91, 0x7f, 0x77, 0x00, 0x92, 0x5e,
+		0xc3, 0xfb, 0xbb, 0x17, 0x82, 0xe4, 0xbf, 0x55, 0x82, 0x98, 0xd3, 0xfc, 0x03, 0x8e, 0x57, 0xbb,
+		0xb7, 0x92, 0x9d, 0xcd, 0x0b, 0xb6, 0x4d, 0x14, 0x74, 0xb6, 0x89, 0x9e, 0x72, 0xa6, 0xbf, 0x69,
+		0x09, 0x11, 0xaf, 0xae, 0xc5, 0x11, 0x47, 0x3e, 0xdb, 0x3f, 0x35, 0xdf, 0xe5, 0x3f, 0xdc, 0xc2,
+		0xf2, 0x5c, 0xdc, 0x63, 0x1f, 0xf9, 0xb6, 0xe7, 0x48, 0x12, 0x1b, 0x88, 0xde, 0x5b, 0x82, 0xd2,
+		0xa1, 0x6f, 0x09, 0x3a, 0xb5, 0x04, 0xbc, 0xb5, 0x7c, 0xb7, 0x04, 0x31, 0x31, 0x26, 0xc8, 0xdb,
+		0xbc, 0x67, 0xdd, 0xc4, 0xd6, 0xe6, 0x54, 0x7f, 0xc4, 0xf9, 0xbb, 0x24, 0xe3, 0x47, 0xbc, 0x83,
+		0x87, 0x3d, 0xe2, 0xcd, 0x23, 0xbb, 0xbf, 0x92, 0x40, 0x1e, 0x1d, 0xb1, 0x5f, 0xb1, 0x74, 0xe3,
+		0xd3, 0x5c, 0x70, 0xc2, 0x34, 0x37, 0xed, 0xdc, 0x76, 0x68, 0xda, 0xb9, 0xed, 0xf1, 0x56, 0x87,
+		0xef, 0xb0, 0xd5, 0xef, 0x09, 0x40, 0xc2, 0x93, 0x1e, 0x25, 0x8f, 0xfb, 0xce, 0x80, 0xaf, 0x1c,
+		0x94, 0x4a, 0xf5, 0x1c, 0x02, 0xf7, 0xa9, 0x29, 0x70, 0x78, 0x35, 0xbd, 0xfe, 0xf7, 0xc1, 0x26,
+		0x5f, 0x99, 0x0d, 0x4f, 0xb9, 0x32, 0xfb, 0x2d, 0x12, 0xc4, 0x9c, 0xb0, 0xfb, 0xb0, 0x9b, 0x98,
+		0x47, 0x21, 0xc2, 0x23, 0x4b, 0xb6, 0x8b, 0xc9, 0xbf, 0x26, 0xa6, 0x95, 0x73, 0x10, 0xeb, 0xe9,
+		0xb6, 0x46, 0xfd, 0x20, 0x9b, 0xd5, 0x9c, 0xef, 0x33, 0x17, 0x21, 0xe1, 0xd9, 0x00, 0x46, 0xd7,
+		0x58, 0xab, 0x3c, 0x2b, 0x2f, 0xe4, 0xa2, 0x2f, 0xdd, 0x5e, 0x09, 0xd6, 0xf4, 0x17, 0x71, 0x34,
+		0x2b, 0x95, 0xd2, 0x95, 0x4a, 0xe9, 0x19, 0x59, 0xca, 0x25, 0x5e, 0xba, 0xbd, 0x12, 0x55, 0x74,
+		0x9a, 0x51, 0x3c, 0xf3, 0x0c, 0x64, 0x46, 0x3a, 0xc6, 0x1f, 0xb6, 0x10, 0x48, 0x97, 0xb7, 0xf0,
+		0x57, 0xc4, 0x0a, 0xcd, 0x8a, 0xca, 0x4e, 0x0a, 0xe3, 0xef, 0xa1, 0xac, 0x57, 0x2f, 0x5f, 0x69,
+		0xaa, 0xa5, 0xf5, 0x6a, 0xa5, 0xd6, 0x54, 0x0b, 0xcd, 0x66, 0xa1, 0xf4, 0x8c, 0x1c, 0x38, 0x77,
+		0x3b, 0x01, 0x21, 0x3c, 0xce, 0x47, 0x4a, 0x10, 0xa2, 0xa9, 0x90, 0x03, 0x4f, 0x80, 0xe5, 0x0e,
+		0xce, 0x0d, 0x93, 0x4b, 0x10, 0xa6, 0x59, 0x12, 0x72, 0xf0, 0x91, 0xb0, 0xdc, 0x8c, 0x64, 0x31,
+		0x0a, 0x43, 0x47, 0xe4, 0x81, 0x67, 0xc4, 0x72, 0x07, 0xe7, 0x8e, 0xc9, 0x3a, 0x44, 0xc5, 0x22,
+		0x79, 0xd6, 0xc1, 0xad, 0xdc, 0xcc, 0x84, 0x2e, 0x36, 0x8d, 0x25, 0x1b, 0x0e, 0x3e, 0x3e, 0x96,
+		0x9b, 0x91, 0x55, 0xc6, 0x07, 0x45, 0xf8, 0x72, 0x74, 0xc6, 0x89, 0xb0, 0xdc, 0xac, 0x3c, 0x31,
+		0x51, 0x20, 0xee, 0xa6, 0x71, 0x66, 0x1f, 0x8a, 0xcb, 0xcd, 0x91, 0x30, 0x27, 0xef, 0x80, 0x94,
+		0x7f, 0xa9, 0x3b, 0xdf, 0xa9, 0xb3, 0xdc, 0x9c, 0x19, 0x69, 0xe4, 0xef, 0x5f, 0xf7, 0xce, 0x77,
+		0x0a, 0x2d, 0x37, 0x67, 0x82, 0x9a, 0xbc, 0x00, 0x8b, 0xe3, 0xeb, 0xd2, 0xf9, 0x0f, 0xa5, 0xe5,
+		0x0e, 0x91, 0xb2, 0x26, 0x3d, 0x20, 0x13, 0xd6, 0xb3, 0x87, 0x38, 0xa3, 0x96, 0x3b, 0x4c, 0x06,
+		0x9b, 0xb4, 0x21, 0x33, 0xba, 0x48, 0x9c, 0xf7, 0xcc, 0x5a, 0x6e, 0xee, 0x6c, 0x36, 0xab, 0xc5,
+		0xbf, 0xb8, 0x9c, 0xf7, 0x0c, 0x5b, 0x6e, 0xee, 0xe4, 0x36, 0xd9, 0x02, 0xf0, 0xac, 0x0f, 0xe7,
+		0x38, 0xd3, 0x96, 0x9b, 0x27, 0xcd, 0x4d, 0xfa, 0xb0, 0x34, 0x69, 0xe1, 0x78, 0x98, 0x23, 0x6e,
+		0xb9, 0x43, 0x65, 0xbf, 0xd1, 0x9e, 0xfd, 0x4b, 0xc0, 0xf9, 0x8e, 0xbc, 0xe5, 0xe6, 0x4c, 0x83,
+		0x17, 0x0b, 0x53, 0xcf, 0x39, 0x3f, 0x70, 0xe0, 0x39, 0x67, 0xf7, 0xe4, 0xb2, 0x73, 0xb6, 0xf9,
+		0xdf, 0x3f, 0x06, 0x6f, 0xe2, 0x6f, 0xf6, 0x58, 0xb6, 0x76, 0xa3, 0x63, 0xec, 0x3a, 0x0f, 0x3b,
+		0xf1, 0x6f, 0x7e, 0xc8, 0xf9, 0x28, 0xc3, 0x5a, 0x15, 0xd0, 0x19, 0xcf, 0x3b, 0x4d, 0x7d, 0x15,
+		0x74, 0xd6, 0x0d, 0x8c, 0xd9, 0xc7, 0x97, 0x0f, 0x78, 0x3a, 0x6a, 0xc6, 0x03, 0x55, 0x13, 0x9e,
+		0x96, 0xca, 0x1d, 0xf8, 0xaa, 0x41, 0xee, 0xa0, 0x73, 0xdf, 0x18, 0xc5, 0xa6, 0xaf, 0x74, 0x2c,
+		0xdb, 0x1c, 0x74, 0x5a, 0x5a, 0x97, 0x4e, 0x2f, 0x4f, 0xcd, 0x7b, 0x0f, 0xac, 0x18, 0xc7, 0x70,
+		0x85, 0xbf, 0x3e, 0xc5, 0x48, 0x48, 0x19, 0x22, 0x37, 0xb5, 0x2e, 0xbb, 0x85, 0xe5, 0x7d, 0xbe,
+		0x6e, 0x54, 0xe7, 0x9e, 0x38, 0xca, 0xcb, 0x85, 0xd1, 0xe6, 0xbf, 0x97, 0xde, 0x60, 0xe9, 0xf5,
+		0x3a, 0x16, 0xda, 0xa2, 0x42, 0x97, 0xd2, 0x57, 0x21, 0x34, 0xd0, 0x6c, 0xbe, 0xc0, 0x2c, 0x5e,
+		0x38, 0xf4, 0x4b, 0x50, 0xac, 0x06, 0xca, 0x83, 0xbc, 0x1d, 0xf0, 0x02, 0x83, 0x4a, 0xf9, 0x05,
+		0xbe, 0x22, 0x7e, 0x78, 0x8f, 0x03, 0xe5, 0x23, 0xef, 0xa0, 0xbf, 0x0b, 0x89, 0xfb, 0xb8, 0xc6,
+		0xae, 0xce, 0x38, 0x07, 0xbf, 0x22, 0xce, 0xa9, 0x9e, 0x76, 0xab, 0x44, 0xb9, 0x21, 0x7f, 0xfe,
+		0xc8, 0xd6, 0xaf, 0x4a, 0x00, 0xae, 0x62, 0x88, 0x86, 0x5b, 0x1c, 0xe2, 0x8b, 0x56, 0x2a, 0xb6,
+		0x38, 0x1e, 0x98, 0xa6, 0xf7, 0x11, 0xb5, 0x16, 0x53, 0x28, 0xde, 0xa7, 0x3f, 0x7b, 0x4a, 0x62,
+		0xb5, 0x66, 0x5a, 0x63, 0x6a, 0x4f, 0xb0, 0x64, 0x88, 0x4a, 0x63, 0xd8, 0xc0, 0xcc, 0x18, 0x36,
+		0x25, 0x62, 0x58, 0xc6, 0x10, 0x18, 0x35, 0x96, 0xf3, 0x36, 0x7c, 0x5c, 0x82, 0x44, 0xd9, 0xf3,
+		0xd4, 0x26, 0xee, 0x51, 0x99, 0x46, 0xe7, 0x86, 0x3e, 0x70, 0xf6, 0xa8, 0xd8, 0x27, 0xc6, 0x99,
+		0xec, 0xa7, 0xcd, 0xed, 0x7d, 0xf1, 0x9e, 0x91, 0xf8, 0x46, 0xaa, 0x17, 0xf5, 0x6d, 0xab, 0x23,
+		0xf4, 0xac, 0x88, 0x4f, 0x3c, 0x70, 0x60, 0xe9, 0xad, 0x21, 0xa6, 0xce, 0xd5, 0x96, 0x69, 0xd8,
+		0x5a, 0xcb, 0xe6, 0xeb, 0xf0, 0x8c, 0x80, 0x97, 0x18, 0x18, 0x99, 0xb4, 0x75, 0x5b, 0xeb, 0x74,
+		0xd9, 0xc9, 0xcc, 0xb8, 0x22, 0x3e, 0xb9, 0xa8, 0xb7, 0xa3, 0xde, 0x75, 0x68, 0x09, 0x64, 0xb3,
+		0xaf, 0x0f, 0x7c, 0x07, 0x52, 0x98, 0x35, 0x66, 0x3f, 0xf3, 0xa9, 0x87, 0x97, 0xb9, 0xc2, 0xf9,
+		0x61, 0x06, 0xf6, 0xcb, 0x12, 0x4a, 0x46, 0x50, 0x70, 0x30, 0xb9, 0xee, 0xdb, 0x95, 0x1a, 0x6e,
+		0xbb, 0x0f, 0xd7, 0x2c, 0x8f, 0x29, 0xb5, 0x60, 0xec, 0x17, 0xb3, 0xbf, 0xe9, 0xb2, 0x76, 0xd7,
+		0xa9, 0xf8, 0x54, 0x8c, 0x67, 0x8b, 0x8a, 0xb2, 0xc1, 0x10, 0xfe, 0x05, 0xad, 0xd3, 0xd5, 0xdb,
+		0x3c, 0xad, 0xc1, 0xbf, 0xc8, 0x9a, 0x93, 0x5e, 0x64, 0x3f, 0x76, 0x9a, 0x9f, 0x66, 0x1b, 0x45,
+		0xd3, 0x68, 0xfb, 0xf3, 0x88, 0xa4, 0x04, 0x11, 0xdb, 0xbc, 0xa1, 0x1b, 0x5c, 0x41, 0x87, 0x7b,
+		0xcc, 0x8d, 0x93, 0x92, 0xaf, 0x07, 0xb9, 0xad, 0x77, 0xf5, 0x5d, 0xaa, 0x39, 0x6b, 0x4f, 0xc3,
+		0xfb, 0xd2, 0x91, 0x3b, 0x7d, 0xd0, 0x2d, 0xe3, 0xb0, 0x6a, 0x50, 0x4e, 0x64, 0xd3, 0xff, 0x98,
+		0x6b, 0x94, 0x6f, 0x1e, 0x4f, 0x69, 0xa3, 0xc7, 0xf2, 0xbc, 0x9e, 0xc7, 0xcb, 0x02, 0x2d, 0x68,
+		0x68, 0x6c, 0x9b, 0x06, 0xfd, 0xe5, 0x4e, 0xbe, 0x8a, 0x8a, 0xb1, 0x9d, 0x4a, 0x07, 0xce, 0x77,
+		0x2a, 0x37, 0x21, 0xed, 0xa2, 0xd2, 0x11, 0x12, 0x3f, 0xec, 0x08, 0x49, 0x39, 0x0c, 0x10, 0x05,
+		0x7f, 0xd4, 0xd3, 0x1d, 0x83, 0x59, 0xe0, 0x27, 0x42, 0x66, 0x8e, 0x66, 0x6f, 0x63, 0x3c, 0x0c,
+		0x88, 0x01, 0x4b, 0xbd, 0x8e, 0xa1, 0x5a, 0x7a, 0x77, 0x47, 0xe5, 0x9a, 0x43, 0xbe, 0x09, 0xaa,
+		0xfe, 0xb7, 0x1d, 0xa2, 0x37, 0x7f, 0xef, 0x53, 0x0f, 0x67, 0xdc, 0x27, 0xf1, 0x56, 0x1e, 0x59,
+		0x7d, 0xfc, 0x82, 0xb2, 0xd8, 0xeb, 0x18, 0x0d, 0xbd, 0xbb, 0x53, 0x76, 0x18, 0x93, 0xb7, 0xc0,
+		0x09, 0x57, 0x21, 0xa6, 0xa1, 0xee, 0x99, 0xdd, 0xb6, 0x3a, 0xd0, 0x77, 0xd4, 0x16, 0x7d, 0x6c,
+		0x30, 0x49, 0xd5, 0x78, 0xcc, 0x41, 0xa9, 0x1b, 0x57, 0xcc, 0x6e, 0x5b, 0xd1, 0x77, 0x4a, 0x58,
+		0x8c, 0x3b, 0x59, 0x2e, 0x75, 0xa7, 0x8d, 0xc7, 0x42, 0x82, 0x78, 0x36, 0xdd, 0x01, 0x56, 0xdb,
+		0xd6, 0x5a, 0xec, 0x7d, 0x1f, 0x3e, 0xb5, 0xf0, 0xea, 0x87, 0x4f, 0x2d, 0xe4, 0x2f, 0xd1, 0x87,
+		0xad, 0xf8, 0xd0, 0xd2, 0x2d, 0x72, 0x01, 0xe2, 0x9a, 0xf8, 0x60, 0xb7, 0xce, 0x0e, 0x18, 0x9a,
+		0x2e, 0x2a, 0x5e, 0xb8, 0x8b, 0x94, 0xaf, 0x6d, 0x6a, 0x9d, 0x01, 0xa9, 0xe0, 0x53, 0xad, 0xc2,
+		0x56, 0xe7, 0x1d, 0xe5, 0xae, 0x79, 0x8b, 0x61, 0x5e, 0x9b, 0x76, 0x7a, 0x2d, 0x5e, 0xbc, 0xe7,
+		0x33, 0x9f, 0x7a, 0xf8, 0x6e, 0xce, 0xe6, 0xda, 0xc8, 0x41, 0x36, 0xc1, 0x6f, 0xf4, 0x80, 0x9b,
+		0xa7, 0xcd, 0x57, 0x21, 0xca, 0x44, 0xb5, 0xc8, 0xd3, 0x10, 0xee, 0xe3, 0x1f, 0x59, 0xc9, 0xf7,
+		0x7a, 0xeb, 0xb8, 0xcd, 0x53, 0x7c, 0xaf, 0x85, 0x30, 0xba, 0xfc, 0xfb, 0x03, 0x00, 0xe5, 0x6b,
+		0xd7, 0x9a, 0x83, 0x4e, 0xbf, 0xab, 0xdb, 0xaf, 0x57, 0xdb, 0xb7, 0xe0, 0x88, 0xdb, 0x76, 0x6b,
+		0xd0, 0x3a, 0x7c, 0xfb, 0x97, 0x1c, 0xfa, 0xc6, 0xa0, 0x35, 0x91, 0x6d, 0xdb, 0xb2, 0x1d, 0xb6,
+		0xc1, 0xc3, 0xb3, 0x2d, 0x5b, 0xf6, 0xb8, 0x66, 0x9f, 0x83, 0x84, 0xab, 0x0c, 0x8b, 0x54, 0x21,
+		0x66, 0xf3, 0xbf, 0xb9, 0x82, 0xf3, 0xd3, 0x15, 0x2c, 0xc8, 0xbc, 0x4a, 0x76, 0xc8, 0xf3, 0x7f,
+		0x23, 0x01, 0x78, 0xc6, 0xc8, 0x1b, 0xd3, 0xc6, 0x70, 0x05, 0xcf, 0x9d, 0x73, 0xf0, 0x8e, 0x9f,
+		0x04, 0x65, 0x0c, 0x3c, 0x4a, 0xfd, 0x40, 0x00, 0x96, 0xb6, 0xc4, 0xe8, 0x7d, 0xe3, 0xeb, 0x60,
+		0x0b, 0xa2, 0xba, 0x61, 0x0f, 0x3a, 0xce, 0xb6, 0xd2, 0x23, 0xd3, 0xfa, 0x7c, 0x42, 0xa3, 0x2a,
+		0x86, 0x3d, 0xd8, 0xf7, 0x5a, 0x80, 0xe0, 0xe5, 0xd1, 0xc7, 0x87, 0x82, 0x90, 0x9d, 0x46, 0x8a,
+		0x8f, 0xe3, 0xb4, 0x06, 0x3a, 0x05, 0xf8, 0xaf, 0x99, 0xa6, 0x05, 0x98, 0x4f, 0x3b, 0x0a, 0x60,
+		0xa0, 0x86, 0xc6, 0x85, 0xa8, 0x77, 0x16, 0x99, 0xa5, 0x5d, 0x0e, 0x74, 0xe2, 0x69, 0x42, 0x46,
+		0x5c, 0x43, 0xd9, 0xd6, 0xba, 0x1a, 0x5e, 0xfa, 0x0e, 0x1e, 0x7e, 0xce, 0x17, 0x57, 0x59, 0x8a,
+		0x8c, 0x05, 0xa9, 0x40, 0x54, 0x70, 0x0b, 0x1d, 0x9e, 0x9b, 0xa0, 0xc5, 0xeb, 0x46, 0xde, 0x89,
+		0x81, 0x46, 0x23, 0x21, 0x25, 0xe1, 0xc0, 0xaa, 0xed, 0x59, 0x33, 0x4f, 0xe4, 0xc0, 0x99, 0x87,
+		0x07, 0x7c, 0x3f, 0x4c, 0x4f, 0x8b, 0xb7, 0xff, 0xf6, 0x77, 0xcb, 0x26, 0x00, 0x1b, 0xaa, 0xe8,
+		0x49, 0xb3, 0xa1, 0x3b, 0x1d, 0xef, 0x71, 0xc6, 0xa4, 0x6c, 0xd9, 0x5f, 0xab, 0x1e, 0xfa, 0xfd,
+		0x00, 0x24, 0xbd, 0x3d, 0xf4, 0x77, 0x72, 0xd2, 0x22, 0x35, 0xd7, 0x4d, 0xb1, 0x1b, 0x35, 0x0f,
+		0x4e, 0x73, 0x53, 0x63, 0xd6, 0x3c, 0xc3, 0x3f, 0x7d, 0x22, 0x0c, 0x11, 0x7e, 0xe4, 0xad, 0x3e,
+		0x16, 0xdb, 0x4a, 0xb3, 0x1e, 0x11, 0x48, 0x89, 0x47, 0x04, 0x26, 0x86, 0xb6, 0xf7, 0x01, 0x3e,
+		0x2d, 0xa0, 0xfa, 0xce, 0xd1, 0xe1, 0x5e, 0x03, 0x2e, 0x75, 0x9d, 0xd6, 0x5b, 0x78, 0x94, 0x10,
+		0xd1, 0x5c, 0x3f, 0x8c, 0x38, 0x78, 0x79, 0xa9, 0xc2, 0x20, 0xe4, 0x61, 0x20, 0x7b, 0x4e, 0xce,
+		0x42, 0x75, 0x15, 0x81, 0x78, 0x8b, 0x6e, 0x89, 0x40, 0xc7, 0x67, 0x58, 0x4c, 0xa3, 0xad, 0xb2,
+		0x57, 0xb0, 0xf9, 0x1b, 0xe3, 0x08, 0x29, 0x23, 0x80, 0xbc, 0x57, 0x62, 0x21, 0xf2, 0xc8, 0x4a,
+		0x9a, 0xaf, 0x50, 0x9a, 0x73, 0x0c, 0x8a, 0xbf, 0xfc, 0xec, 0xa9, 0xdc, 0xbe, 0xd6, 0xeb, 0xae,
+		0xe5, 0x27, 0xf0, 0xc9, 0x4f, 0x5a, 0xdc, 0x63, 0xe0, 0xec, 0x5f, 0x89, 0xa3, 0x18, 0xc7, 0x77,
+		0xbb, 0xe6, 0x36, 0xde, 0xc0, 0xea, 0xbc, 0x73, 0xd8, 0x69, 0xab, 0xbc, 0x2b, 0xd5, 0x96, 0xd6,
+		0xa7, 0xab, 0x9a, 0x78, 0xb1, 0x7a, 0xe8, 0x11, 0x3a, 0x16, 0xb5, 0x9f, 0x7f, 0x9c, 0x49, 0x70,
+		0x94, 0xd5, 0xb5, 0x4e, 0xab, 0x6a, 0xb0, 0x9a, 0x4a, 0x5a, 0x9f, 0xbc, 0x24, 0xc1, 0x5d, 0xae,
+		0xc5, 0x4e, 0x90, 0x24, 0xf6, 0x7a, 0x4b, 0x72, 0xdc, 0xa9, 0x6e, 0x54, 0x98, 0xb5, 0x37, 0xe1,
+		0x90, 0x7f, 0xe9, 0x95, 0x4f, 0x9c, 0x39, 0xe1, 0x52, 0x9e, 0xbd, 0xe5, 0x64, 0xf9, 0x98, 0x9d,
+		0x62, 0xf4, 0x4e, 0xdc, 0x99, 0xd4, 0xb9, 0x40, 0xb0, 0x01, 0xe0, 0x59, 0xf0, 0x48, 0x07, 0x2f,
+		0xa4, 0x5c, 0x7a, 0xdf, 0x42, 0xca, 0xe3, 0x67, 0xde, 0xe6, 0x4e, 0x64, 0xe2, 0x2d, 0x8d, 0x09,
+		0x4f, 0xc5, 0xaf, 0xe2, 0x93, 0xeb, 0xbe, 0x21, 0xc6, 0x89, 0xa8, 0xfb, 0x5a, 0xc8, 0xff, 0x5b,
+		0x09, 0xcf, 0x99, 0x8d, 0x0c, 0x49, 0x47, 0xe4, 0x16, 0x90, 0x81, 0xa7, 0x90, 0x9a, 0xb6, 0xd8,
+		0xb1, 0xbe, 0xb3, 0x11, 0xbe, 0x38, 0x18, 0x2d, 0x7d, 0x9d, 0x66, 0x64, 0xee, 0x8e, 0x7f, 0x43,
+		0xc2, 0x9b, 0x33, 0xed, 0x71, 0xed, 0x37, 0x20, 0xe9, 0xad, 0x9a, 0x37, 0xe2, 0x4d, 0xf3, 0x34,
+		0xc2, 0x2b, 0xbf, 0x8f, 0x09, 0xb9, 0xe6, 0xba, 0x3d, 0x96, 0x5e, 0x7c, 0x74, 0x6e, 0xa5, 0x08,
+		0xc1, 0x26, 0xba, 0x3f, 0xd6, 0x37, 0x7f, 0x22, 0x41, 0x68, 0xd3, 0x34, 0xbb, 0xe4, 0x9d, 0xb0,
+		0x68, 0x98, 0xb6, 0x8a, 0x2e, 0x42, 0x6f, 0xab, 0x3c, 0xff, 0xc1, 0xa6, 0x94, 0xca, 0x81, 0xba,
+		0xfa, 0xc2, 0x67, 0x4f, 0x8d, 0x53, 0x4e, 0x7a, 0xac, 0x3f, 0x63, 0x98, 0x76, 0x91, 0x22, 0x35,
+		0x29, 0x0e, 0xd9, 0x81, 0x94, 0xbf, 0x3a, 0x36, 0xed, 0x14, 0x66, 0x55, 0x97, 0x9a, 0x59, 0x55,
+		0x72, 0xdb, 0x53, 0x0f, 0x7b, 0xdf, 0xfa, 0xcf, 0xb1, 0xe7, 0x7e, 0x47, 0x82, 0x25, 0x0a, 0xec,
+		0xbc, 0x4b, 0xa7, 0x99, 0x14, 0x45, 0x6f, 0x99, 0x83, 0x36, 0x9e, 0xe3, 0xe0, 0xaf, 0x5e, 0x85,
+		0x94, 0x40, 0xa7, 0x8d, 0x0f, 0x43, 0x99, 0x2f, 0x1a, 0xfc, 0xf4, 0xc3, 0x41, 0x73, 0x2a, 0x43,
+		0xa3, 0x4e, 0xde, 0x6c, 0x0f, 0xbb, 0xba, 0xaa, 0xb5, 0xd8, 0xbc, 0x1e, 0xe4, 0xbf, 0x41, 0x43,
+		0xa1, 0x05, 0x06, 0xf4, 0xbf, 0x0e, 0x14, 0x9a, 0x77, 0x32, 0x74, 0x69, 0xd6, 0x8e, 0x61, 0x4b,
+		0x3e, 0x33, 0xee, 0x54, 0xf2, 0xd7, 0x41, 0xbe, 0x36, 0x7a, 0xc4, 0xab, 0x02, 0x51, 0x71, 0xa4,
+		0x4b, 0x9a, 0xf3, 0xb4, 0x98, 0xd7, 0x4e, 0x38, 0xed, 0x99, 0x9f, 0x93, 0x00, 0xdc, 0x24, 0x19,
+		0xbe, 0x61, 0x55, 0xac, 0xd7, 0xca, 0x6a, 0xa3, 0x59, 0x68, 0x6e, 0x35, 0xfc, 0xbf, 0xee, 0x23,
+		0x5e, 0xbc, 0xb2, 0xfa, 0x7a, 0xab, 0xb3, 0xd3, 0xd1, 0xdb, 0xe4, 0x7e, 0x58, 0xf6, 0x63, 0xe3,
+		0x57, 0xa5, 0x2c, 0x4b, 0xb9, 0xe4, 0x4b, 0xb7, 0x57, 0x62, 0x6c, 0x51, 0xa0, 0xe3, 0xfb, 0xad,
+		0x47, 0xc6, 0xf1, 0xf0, 0x97, 0x81, 0x02, 0xb9, 0xd4, 0x4b, 0xb7, 0x57, 0xe2, 0xce, 0xea, 0x81,
+		0xe4, 0x81, 0x78, 0x31, 0x39, 0xbf, 0x60, 0x0e, 0x5e, 0xba, 0xbd, 0x12, 0x61, 0xe6, 0x95, 0x0b,
+		0xe1, 0xbb, 0x56, 0x67, 0xbe, 0x01, 0xa0, 0x6a, 0xec, 0x0c, 0xb4, 0x16, 0x1d, 0x46, 0x39, 0x38,
+		0x5a, 0xad, 0x5d, 0x52, 0x0a, 0xa5, 0x66, 0xb5, 0x5e, 0xf3, 0x8b, 0x3d, 0x52, 0x56, 0xae, 0x6f,
+		0x15, 0xd7, 0x2b, 0x2a, 0xbe, 0xa4, 0xc5, 0x36, 0xdb, 0x7d, 0x65, 0xcf, 0xd6, 0x9a, 0xd5, 0x8d,
+		0x8a, 0x1c, 0x28, 0x5e, 0x9a, 0xba, 0x99, 0xf3, 0xe6, 0x03, 0x7f, 0x57, 0xc1, 0x75, 0xe5, 0xbe,
+		0x1d, 0x9d, 0xff, 0x3d, 0x00, 0xf5, 0x4a, 0xe8, 0x9b, 0x29, 0xb7, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2444,6 +2526,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2500,6 +2588,39 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3337,6 +3458,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3556,6 +3697,55 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3949,6 +4139,10 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4008,6 +4202,30 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func (m *ValidatorUpdates) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6683,6 +6901,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -7172,6 +7458,171 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
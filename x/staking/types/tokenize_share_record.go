@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the module account names
+// holding tokenized delegations.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// GetTokenizeShareModuleAccountName returns the name of the module account
+// holding the delegation of the tokenize share record with the given id.
+func GetTokenizeShareModuleAccountName(id uint64) string {
+	return TokenizeShareModuleAccountPrefix + strconv.FormatUint(id, 10)
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return address.Module(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens minted for the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator), r.Id)
+}
@@ -2,10 +2,13 @@ package decode
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/cosmos/cosmos-proto/anyutil"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
 	v1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
 	errorsmod "cosmossdk.io/errors"
 
@@ -99,6 +102,16 @@ func (d *Decoder) Decode(txBytes []byte) (*DecodedTx, error) {
 	var signers [][]byte
 	var msgs []proto.Message
 	seenSigners := map[string]struct{}{}
+	addSigners := func(ss [][]byte) {
+		for _, s := range ss {
+			_, seen := seenSigners[string(s)]
+			if seen {
+				continue
+			}
+			signers = append(signers, s)
+			seenSigners[string(s)] = struct{}{}
+		}
+	}
 	for _, anyMsg := range body.Messages {
 		msg, signerErr := anyutil.Unpack(anyMsg, fileResolver, d.signingCtx.TypeResolver())
 		if signerErr != nil {
@@ -109,14 +122,27 @@ func (d *Decoder) Decode(txBytes []byte) (*DecodedTx, error) {
 		if signerErr != nil {
 			return nil, errorsmod.Wrap(ErrTxDecode, signerErr.Error())
 		}
-		for _, s := range ss {
-			_, seen := seenSigners[string(s)]
-			if seen {
-				continue
-			}
-			signers = append(signers, s)
-			seenSigners[string(s)] = struct{}{}
+		addSigners(ss)
+	}
+
+	// Extension options annotated with cosmos.msg.v1.signer, e.g. the fee shares
+	// of several payers, add their signers to the list of signers. The other
+	// extension options are left to the ante handler.
+	for _, anyOpt := range body.ExtensionOptions {
+		desc, err := fileResolver.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(anyOpt.TypeUrl, "/")))
+		if err != nil || !proto.HasExtension(desc.Options(), msgv1.E_Signer) {
+			continue
+		}
+
+		opt, signerErr := anyutil.Unpack(anyOpt, fileResolver, d.signingCtx.TypeResolver())
+		if signerErr != nil {
+			return nil, errorsmod.Wrap(ErrTxDecode, signerErr.Error())
+		}
+		ss, signerErr := d.signingCtx.GetSigners(opt)
+		if signerErr != nil {
+			return nil, errorsmod.Wrap(ErrTxDecode, signerErr.Error())
 		}
+		addSigners(ss)
 	}
 
 	// If a fee payer is specified in the AuthInfo, it must be added to the list of signers
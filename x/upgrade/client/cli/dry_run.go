@@ -0,0 +1,287 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const FlagHeight = "height"
+
+// DryRunApp is the application an upgrade is dry-run against.
+type DryRunApp interface {
+	CommitMultiStore() storetypes.CommitMultiStore
+	GetUpgradeKeeper() *keeper.Keeper
+	Logger() log.Logger
+	Close() error
+}
+
+// DryRunAppCreator creates the application an upgrade is dry-run against. The
+// application must not load its latest version, as the dry-run loads the
+// version before the upgrade height along with the store upgrades of the plan.
+type DryRunAppCreator func(log.Logger, dbm.DB, servertypes.AppOptions) DryRunApp
+
+// ModuleVersionChange is the consensus version of a module before and after an
+// upgrade. A zero version means the module was not in the version map.
+type ModuleVersionChange struct {
+	Module string `json:"module"`
+	From   uint64 `json:"from"`
+	To     uint64 `json:"to"`
+}
+
+// DryRunResult is the outcome of an upgrade dry-run.
+type DryRunResult struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+	// FromAppHash is the app hash of the state the upgrade is applied to.
+	FromAppHash cmtbytes.HexBytes `json:"from_app_hash"`
+	// AppHash is the app hash of the state once the upgrade is applied, before
+	// the other state transitions of the upgrade block.
+	AppHash        cmtbytes.HexBytes     `json:"app_hash,omitempty"`
+	ModuleVersions []ModuleVersionChange `json:"module_versions"`
+	GasUsed        uint64                `json:"gas_used"`
+	// Error is the error returned by the upgrade handler, or the value and
+	// stack trace of its panic.
+	Error string `json:"error,omitempty"`
+}
+
+// NewUpgradeCmd returns the command dry-running the upgrades registered by the
+// application.
+func NewUpgradeCmd(appCreator DryRunAppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Upgrade subcommands run against the application state",
+	}
+
+	cmd.AddCommand(NewDryRunCmd(appCreator, defaultNodeHome))
+
+	return cmd
+}
+
+// NewDryRunCmd returns a command applying the upgrade handler and store upgrades
+// of a plan to the local application state, without committing it.
+func NewDryRunCmd(appCreator DryRunAppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run [plan-name]",
+		Short: "Dry-run an upgrade against the local application state",
+		Long: `Dry-run an upgrade against the local application state.
+The state before the upgrade height is loaded along with the store upgrades registered for the plan,
+and the registered upgrade handler is applied to it. The resulting app hash, module version map and
+gas used are reported, as well as any error or panic of the handler. Nothing is committed.
+
+The node must be stopped, as the application database is opened by the command.
+When --height is not set, the upgrade is applied at the height following the latest one.`,
+		Example: fmt.Sprintf("%s dry-run v2 --height 1000000", types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			height, err := cmd.Flags().GetInt64(FlagHeight)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := readChainID(serverCtx.Config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+
+			latestHeight := rootmulti.GetLatestVersion(db)
+			if height == 0 {
+				height = latestHeight + 1
+			}
+			if height > latestHeight+1 {
+				return fmt.Errorf("invalid upgrade height %d, the state is available up to height %d", height, latestHeight)
+			}
+
+			app := appCreator(serverCtx.Logger, db, serverCtx.Viper)
+			defer app.Close()
+
+			result, err := DryRunUpgrade(app, chainID, args[0], height)
+			if err != nil {
+				return err
+			}
+
+			if err := printDryRunResult(cmd, output, result); err != nil {
+				return err
+			}
+			if result.Error != "" {
+				return fmt.Errorf("upgrade %s failed", result.Name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "The height at which the upgrade is applied (defaults to the height following the latest one)")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+// DryRunUpgrade applies the upgrade handler of the named plan at the given
+// height to the state of the application, without committing it. The state at
+// the height before is loaded along with the store upgrades registered for the
+// plan.
+func DryRunUpgrade(app DryRunApp, chainID, name string, height int64) (*DryRunResult, error) {
+	k := app.GetUpgradeKeeper()
+	if !k.HasHandler(name) {
+		return nil, fmt.Errorf("no upgrade handler registered for %s", name)
+	}
+
+	rootMultiStore, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return nil, errors.New("currently only support the dry-run of upgrades of rootmulti.Store type")
+	}
+
+	if height <= 1 {
+		return nil, fmt.Errorf("invalid upgrade height %d, the upgrade is applied to the state of the previous height", height)
+	}
+
+	// the store upgrades are applied in memory only, and discarded as the
+	// multistore is never committed
+	if err := rootMultiStore.LoadVersionAndUpgrade(height-1, k.GetStoreUpgrades(name)); err != nil {
+		return nil, err
+	}
+	commitInfo, err := rootMultiStore.GetCommitInfo(height - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DryRunResult{
+		Name:        name,
+		Height:      height,
+		FromAppHash: commitInfo.Hash(),
+	}
+
+	blockTime := commitInfo.Timestamp
+	ctx := sdk.NewContext(rootMultiStore, cmtproto.Header{ChainID: chainID, Height: height, Time: blockTime}, false, app.Logger()).
+		WithHeaderInfo(header.Info{ChainID: chainID, Height: height, Time: blockTime}).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+
+	fromVM, err := k.GetModuleVersionMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := applyUpgrade(cacheCtx, k, types.Plan{Name: name, Height: height}); err != nil {
+		result.Error = err.Error()
+		result.GasUsed = cacheCtx.GasMeter().GasConsumed()
+		return result, nil
+	}
+	result.GasUsed = cacheCtx.GasMeter().GasConsumed()
+
+	// the cached state is written to the working state of the multistore to
+	// compute its hash
+	write()
+	result.AppHash = rootMultiStore.WorkingHash()
+
+	toVM, err := k.GetModuleVersionMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	modules := make(map[string]struct{}, len(toVM))
+	for module := range fromVM {
+		modules[module] = struct{}{}
+	}
+	for module := range toVM {
+		modules[module] = struct{}{}
+	}
+	for module := range modules {
+		result.ModuleVersions = append(result.ModuleVersions, ModuleVersionChange{Module: module, From: fromVM[module], To: toVM[module]})
+	}
+	sort.Slice(result.ModuleVersions, func(i, j int) bool {
+		return result.ModuleVersions[i].Module < result.ModuleVersions[j].Module
+	})
+
+	return result, nil
+}
+
+// applyUpgrade applies the upgrade, returning its panic as an error.
+func applyUpgrade(ctx sdk.Context, k *keeper.Keeper, plan types.Plan) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v\n%s", r, debug.Stack())
+		}
+	}()
+
+	return k.ApplyUpgrade(ctx, plan)
+}
+
+func printDryRunResult(cmd *cobra.Command, output string, result *DryRunResult) error {
+	if output == flags.OutputFormatJSON {
+		bz, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		cmd.Println(string(bz))
+		return nil
+	}
+
+	cmd.Printf("upgrade %s at height %d\n", result.Name, result.Height)
+	cmd.Printf("app hash before: %s\n", result.FromAppHash)
+	cmd.Printf("gas used: %d\n", result.GasUsed)
+	if result.Error != "" {
+		cmd.Printf("error: %s\n", result.Error)
+		return nil
+	}
+
+	cmd.Printf("app hash after: %s\n", result.AppHash)
+	cmd.Println("module versions:")
+	for _, change := range result.ModuleVersions {
+		if change.From == change.To {
+			cmd.Printf("  %s: %d\n", change.Module, change.To)
+		} else {
+			cmd.Printf("  %s: %d -> %d\n", change.Module, change.From, change.To)
+		}
+	}
+	return nil
+}
+
+func readChainID(genFile string) (string, error) {
+	file, err := os.Open(filepath.Clean(genFile))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return genutiltypes.ParseChainIDFromGenesis(file)
+}
+
+func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("application", backendType, dataDir)
+}
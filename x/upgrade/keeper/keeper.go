@@ -37,15 +37,16 @@ import (
 const UpgradeInfoFileName string = "upgrade-info.json"
 
 type Keeper struct {
-	homePath           string                          // root directory of app config
-	skipUpgradeHeights map[int64]bool                  // map of heights to skip for an upgrade
-	storeService       corestore.KVStoreService        // key to access x/upgrade store
-	cdc                codec.BinaryCodec               // App-wide binary codec
-	upgradeHandlers    map[string]types.UpgradeHandler // map of plan name to upgrade handler
-	versionSetter      xp.ProtocolVersionSetter        // implements setting the protocol version field on BaseApp
-	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
-	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
-	initVersionMap     module.VersionMap               // the module version map at init genesis
+	homePath           string                               // root directory of app config
+	skipUpgradeHeights map[int64]bool                       // map of heights to skip for an upgrade
+	storeService       corestore.KVStoreService             // key to access x/upgrade store
+	cdc                codec.BinaryCodec                    // App-wide binary codec
+	upgradeHandlers    map[string]types.UpgradeHandler      // map of plan name to upgrade handler
+	storeUpgrades      map[string]*storetypes.StoreUpgrades // map of plan name to store upgrades
+	versionSetter      xp.ProtocolVersionSetter             // implements setting the protocol version field on BaseApp
+	downgradeVerified  bool                                 // tells if we've already sanity checked that this binary version isn't being used against an old state.
+	authority          string                               // the address capable of executing and canceling an upgrade. Usually the gov module account
+	initVersionMap     module.VersionMap                    // the module version map at init genesis
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -62,6 +63,7 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeService corestore.KVStore
 		storeService:       storeService,
 		cdc:                cdc,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
+		storeUpgrades:      map[string]*storetypes.StoreUpgrades{},
 		versionSetter:      vs,
 		authority:          authority,
 	}
@@ -98,6 +100,20 @@ func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandl
 	k.upgradeHandlers[name] = upgradeHandler
 }
 
+// SetStoreUpgrades sets the store upgrades of the upgrade specified by name, so
+// that they can be applied without the upgrade info written to disk, such as
+// when dry-running the upgrade. The application must still set a store loader
+// applying them at the upgrade height.
+func (k Keeper) SetStoreUpgrades(name string, storeUpgrades storetypes.StoreUpgrades) {
+	k.storeUpgrades[name] = &storeUpgrades
+}
+
+// GetStoreUpgrades returns the store upgrades of the upgrade specified by name,
+// or nil if none were set.
+func (k Keeper) GetStoreUpgrades(name string) *storetypes.StoreUpgrades {
+	return k.storeUpgrades[name]
+}
+
 // setProtocolVersion sets the protocol version to state
 func (k Keeper) setProtocolVersion(ctx context.Context, v uint64) error {
 	store := k.storeService.OpenKVStore(ctx)
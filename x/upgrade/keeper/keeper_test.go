@@ -251,6 +251,17 @@ func (s *KeeperTestSuite) TestUpgradedConsensusState() {
 	s.Require().NoError(err)
 }
 
+func (s *KeeperTestSuite) TestStoreUpgrades() {
+	s.Require().Nil(s.upgradeKeeper.GetStoreUpgrades("v2"))
+
+	s.upgradeKeeper.SetStoreUpgrades("v2", storetypes.StoreUpgrades{Added: []string{"foo"}, Deleted: []string{"bar"}})
+	storeUpgrades := s.upgradeKeeper.GetStoreUpgrades("v2")
+	s.Require().NotNil(storeUpgrades)
+	s.Require().True(storeUpgrades.IsAdded("foo"))
+	s.Require().True(storeUpgrades.IsDeleted("bar"))
+	s.Require().Nil(s.upgradeKeeper.GetStoreUpgrades("v3"))
+}
+
 func (s *KeeperTestSuite) TestDowngradeVerified() {
 	s.upgradeKeeper.SetDowngradeVerified(true)
 	ok := s.upgradeKeeper.DowngradeVerified()